@@ -302,6 +302,15 @@ type EncryptionBlock struct {
 	WifiUserName      string `protobuf:"bytes,3,opt,name=wifiUserName,proto3" json:"wifiUserName,omitempty"` // If the authentication type is EAP
 	WifiPassword      string `protobuf:"bytes,4,opt,name=wifiPassword,proto3" json:"wifiPassword,omitempty"`
 	ProtectedUserData string `protobuf:"bytes,5,opt,name=protectedUserData,proto3" json:"protectedUserData,omitempty"`
+	// UEFI Secure Boot keys, each as an EFI_SIGNATURE_LIST, e.g. from
+	// cert-to-efi-sig-list; the platform key, key exchange keys, and the
+	// allowed and forbidden signature databases
+	UefiPK  []byte `protobuf:"bytes,6,opt,name=uefiPK,proto3" json:"uefiPK,omitempty"`
+	UefiKEK []byte `protobuf:"bytes,7,opt,name=uefiKEK,proto3" json:"uefiKEK,omitempty"`
+	UefiDb  []byte `protobuf:"bytes,8,opt,name=uefiDb,proto3" json:"uefiDb,omitempty"`
+	UefiDbx []byte `protobuf:"bytes,9,opt,name=uefiDbx,proto3" json:"uefiDbx,omitempty"`
+	// AES-256 key of the backups of a volume
+	BackupKey []byte `protobuf:"bytes,10,opt,name=backupKey,proto3" json:"backupKey,omitempty"`
 }
 
 func (x *EncryptionBlock) Reset() {
@@ -371,6 +380,41 @@ func (x *EncryptionBlock) GetProtectedUserData() string {
 	return ""
 }
 
+func (x *EncryptionBlock) GetUefiPK() []byte {
+	if x != nil {
+		return x.UefiPK
+	}
+	return nil
+}
+
+func (x *EncryptionBlock) GetUefiKEK() []byte {
+	if x != nil {
+		return x.UefiKEK
+	}
+	return nil
+}
+
+func (x *EncryptionBlock) GetUefiDb() []byte {
+	if x != nil {
+		return x.UefiDb
+	}
+	return nil
+}
+
+func (x *EncryptionBlock) GetUefiDbx() []byte {
+	if x != nil {
+		return x.UefiDbx
+	}
+	return nil
+}
+
+func (x *EncryptionBlock) GetBackupKey() []byte {
+	if x != nil {
+		return x.BackupKey
+	}
+	return nil
+}
+
 var File_config_acipherinfo_proto protoreflect.FileDescriptor
 
 var file_config_acipherinfo_proto_rawDesc = []byte{
@@ -413,7 +457,7 @@ var file_config_acipherinfo_proto_rawDesc = []byte{
 	0x72, 0x44, 0x61, 0x74, 0x61, 0x12, 0x28, 0x0a, 0x0f, 0x63, 0x6c, 0x65, 0x61, 0x72, 0x54, 0x65,
 	0x78, 0x74, 0x53, 0x68, 0x61, 0x32, 0x35, 0x36, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x0f,
 	0x63, 0x6c, 0x65, 0x61, 0x72, 0x54, 0x65, 0x78, 0x74, 0x53, 0x68, 0x61, 0x32, 0x35, 0x36, 0x22,
-	0xc3, 0x01, 0x0a, 0x0f, 0x45, 0x6e, 0x63, 0x72, 0x79, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x42, 0x6c,
+	0xc5, 0x02, 0x0a, 0x0f, 0x45, 0x6e, 0x63, 0x72, 0x79, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x42, 0x6c,
 	0x6f, 0x63, 0x6b, 0x12, 0x1a, 0x0a, 0x08, 0x64, 0x73, 0x41, 0x50, 0x49, 0x4b, 0x65, 0x79, 0x18,
 	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x64, 0x73, 0x41, 0x50, 0x49, 0x4b, 0x65, 0x79, 0x12,
 	0x1e, 0x0a, 0x0a, 0x64, 0x73, 0x50, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x18, 0x02, 0x20,
@@ -425,18 +469,26 @@ var file_config_acipherinfo_proto_rawDesc = []byte{
 	0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x12, 0x2c, 0x0a, 0x11, 0x70, 0x72, 0x6f, 0x74, 0x65,
 	0x63, 0x74, 0x65, 0x64, 0x55, 0x73, 0x65, 0x72, 0x44, 0x61, 0x74, 0x61, 0x18, 0x05, 0x20, 0x01,
 	0x28, 0x09, 0x52, 0x11, 0x70, 0x72, 0x6f, 0x74, 0x65, 0x63, 0x74, 0x65, 0x64, 0x55, 0x73, 0x65,
-	0x72, 0x44, 0x61, 0x74, 0x61, 0x2a, 0x2f, 0x0a, 0x11, 0x4b, 0x65, 0x79, 0x45, 0x78, 0x63, 0x68,
-	0x61, 0x6e, 0x67, 0x65, 0x53, 0x63, 0x68, 0x65, 0x6d, 0x65, 0x12, 0x0c, 0x0a, 0x08, 0x4b, 0x45,
-	0x41, 0x5f, 0x4e, 0x4f, 0x4e, 0x45, 0x10, 0x00, 0x12, 0x0c, 0x0a, 0x08, 0x4b, 0x45, 0x41, 0x5f,
-	0x45, 0x43, 0x44, 0x48, 0x10, 0x01, 0x2a, 0x33, 0x0a, 0x10, 0x45, 0x6e, 0x63, 0x72, 0x79, 0x70,
-	0x74, 0x69, 0x6f, 0x6e, 0x53, 0x63, 0x68, 0x65, 0x6d, 0x65, 0x12, 0x0b, 0x0a, 0x07, 0x53, 0x41,
-	0x5f, 0x4e, 0x4f, 0x4e, 0x45, 0x10, 0x00, 0x12, 0x12, 0x0a, 0x0e, 0x53, 0x41, 0x5f, 0x41, 0x45,
-	0x53, 0x5f, 0x32, 0x35, 0x36, 0x5f, 0x43, 0x46, 0x42, 0x10, 0x01, 0x42, 0x3d, 0x0a, 0x15, 0x6f,
-	0x72, 0x67, 0x2e, 0x6c, 0x66, 0x65, 0x64, 0x67, 0x65, 0x2e, 0x65, 0x76, 0x65, 0x2e, 0x63, 0x6f,
-	0x6e, 0x66, 0x69, 0x67, 0x5a, 0x24, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d,
-	0x2f, 0x6c, 0x66, 0x2d, 0x65, 0x64, 0x67, 0x65, 0x2f, 0x65, 0x76, 0x65, 0x2f, 0x61, 0x70, 0x69,
-	0x2f, 0x67, 0x6f, 0x2f, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74,
-	0x6f, 0x33,
+	0x72, 0x44, 0x61, 0x74, 0x61, 0x12, 0x16, 0x0a, 0x06, 0x75, 0x65, 0x66, 0x69, 0x50, 0x4b, 0x18,
+	0x06, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x06, 0x75, 0x65, 0x66, 0x69, 0x50, 0x4b, 0x12, 0x18, 0x0a,
+	0x07, 0x75, 0x65, 0x66, 0x69, 0x4b, 0x45, 0x4b, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x07,
+	0x75, 0x65, 0x66, 0x69, 0x4b, 0x45, 0x4b, 0x12, 0x16, 0x0a, 0x06, 0x75, 0x65, 0x66, 0x69, 0x44,
+	0x62, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x06, 0x75, 0x65, 0x66, 0x69, 0x44, 0x62, 0x12,
+	0x18, 0x0a, 0x07, 0x75, 0x65, 0x66, 0x69, 0x44, 0x62, 0x78, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0c,
+	0x52, 0x07, 0x75, 0x65, 0x66, 0x69, 0x44, 0x62, 0x78, 0x12, 0x1c, 0x0a, 0x09, 0x62, 0x61, 0x63,
+	0x6b, 0x75, 0x70, 0x4b, 0x65, 0x79, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x09, 0x62, 0x61,
+	0x63, 0x6b, 0x75, 0x70, 0x4b, 0x65, 0x79, 0x2a, 0x2f, 0x0a, 0x11, 0x4b, 0x65, 0x79, 0x45, 0x78,
+	0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x53, 0x63, 0x68, 0x65, 0x6d, 0x65, 0x12, 0x0c, 0x0a, 0x08,
+	0x4b, 0x45, 0x41, 0x5f, 0x4e, 0x4f, 0x4e, 0x45, 0x10, 0x00, 0x12, 0x0c, 0x0a, 0x08, 0x4b, 0x45,
+	0x41, 0x5f, 0x45, 0x43, 0x44, 0x48, 0x10, 0x01, 0x2a, 0x33, 0x0a, 0x10, 0x45, 0x6e, 0x63, 0x72,
+	0x79, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x63, 0x68, 0x65, 0x6d, 0x65, 0x12, 0x0b, 0x0a, 0x07,
+	0x53, 0x41, 0x5f, 0x4e, 0x4f, 0x4e, 0x45, 0x10, 0x00, 0x12, 0x12, 0x0a, 0x0e, 0x53, 0x41, 0x5f,
+	0x41, 0x45, 0x53, 0x5f, 0x32, 0x35, 0x36, 0x5f, 0x43, 0x46, 0x42, 0x10, 0x01, 0x42, 0x3d, 0x0a,
+	0x15, 0x6f, 0x72, 0x67, 0x2e, 0x6c, 0x66, 0x65, 0x64, 0x67, 0x65, 0x2e, 0x65, 0x76, 0x65, 0x2e,
+	0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x5a, 0x24, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63,
+	0x6f, 0x6d, 0x2f, 0x6c, 0x66, 0x2d, 0x65, 0x64, 0x67, 0x65, 0x2f, 0x65, 0x76, 0x65, 0x2f, 0x61,
+	0x70, 0x69, 0x2f, 0x67, 0x6f, 0x2f, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x62, 0x06, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
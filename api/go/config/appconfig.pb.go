@@ -12,6 +12,7 @@ package config
 import (
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
 	reflect "reflect"
 	sync "sync"
 )
@@ -76,32 +77,668 @@ func (MetaDataType) EnumDescriptor() ([]byte, []int) {
 	return file_config_appconfig_proto_rawDescGZIP(), []int{0}
 }
 
+// Readiness of an app instance which other app instances wait for
+type AppReadiness int32
+
+const (
+	// Same as APP_READINESS_RUNNING
+	AppReadiness_APP_READINESS_UNSPECIFIED AppReadiness = 0
+	// The app instance is running
+	AppReadiness_APP_READINESS_RUNNING AppReadiness = 1
+	// The app instance is running and its health checks, if any, pass
+	AppReadiness_APP_READINESS_HEALTH_OK AppReadiness = 2
+)
+
+// Enum value maps for AppReadiness.
+var (
+	AppReadiness_name = map[int32]string{
+		0: "APP_READINESS_UNSPECIFIED",
+		1: "APP_READINESS_RUNNING",
+		2: "APP_READINESS_HEALTH_OK",
+	}
+	AppReadiness_value = map[string]int32{
+		"APP_READINESS_UNSPECIFIED": 0,
+		"APP_READINESS_RUNNING":     1,
+		"APP_READINESS_HEALTH_OK":   2,
+	}
+)
+
+func (x AppReadiness) Enum() *AppReadiness {
+	p := new(AppReadiness)
+	*p = x
+	return p
+}
+
+func (x AppReadiness) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (AppReadiness) Descriptor() protoreflect.EnumDescriptor {
+	return file_config_appconfig_proto_enumTypes[1].Descriptor()
+}
+
+func (AppReadiness) Type() protoreflect.EnumType {
+	return &file_config_appconfig_proto_enumTypes[1]
+}
+
+func (x AppReadiness) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use AppReadiness.Descriptor instead.
+func (AppReadiness) EnumDescriptor() ([]byte, []int) {
+	return file_config_appconfig_proto_rawDescGZIP(), []int{1}
+}
+
+// When the domain of an app instance is restarted after it halted by itself
+type RestartMode int32
+
+const (
+	// Restarted after timer.boot.retry
+	RestartMode_RESTART_MODE_UNSPECIFIED RestartMode = 0
+	// Never restarted
+	RestartMode_RESTART_MODE_NEVER RestartMode = 1
+	// Restarted if it failed, i.e., crashed or exited with an error
+	RestartMode_RESTART_MODE_ON_FAILURE RestartMode = 2
+	// Always restarted
+	RestartMode_RESTART_MODE_ALWAYS RestartMode = 3
+)
+
+// Enum value maps for RestartMode.
+var (
+	RestartMode_name = map[int32]string{
+		0: "RESTART_MODE_UNSPECIFIED",
+		1: "RESTART_MODE_NEVER",
+		2: "RESTART_MODE_ON_FAILURE",
+		3: "RESTART_MODE_ALWAYS",
+	}
+	RestartMode_value = map[string]int32{
+		"RESTART_MODE_UNSPECIFIED": 0,
+		"RESTART_MODE_NEVER":       1,
+		"RESTART_MODE_ON_FAILURE":  2,
+		"RESTART_MODE_ALWAYS":      3,
+	}
+)
+
+func (x RestartMode) Enum() *RestartMode {
+	p := new(RestartMode)
+	*p = x
+	return p
+}
+
+func (x RestartMode) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (RestartMode) Descriptor() protoreflect.EnumDescriptor {
+	return file_config_appconfig_proto_enumTypes[2].Descriptor()
+}
+
+func (RestartMode) Type() protoreflect.EnumType {
+	return &file_config_appconfig_proto_enumTypes[2]
+}
+
+func (x RestartMode) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use RestartMode.Descriptor instead.
+func (RestartMode) EnumDescriptor() ([]byte, []int) {
+	return file_config_appconfig_proto_rawDescGZIP(), []int{2}
+}
+
+type HealthCheckType int32
+
+const (
+	// The HEALTHCHECK of the OCI image, if any
+	HealthCheckType_HEALTH_CHECK_TYPE_IMAGE HealthCheckType = 0
+	// No health check, even if the image has one
+	HealthCheckType_HEALTH_CHECK_TYPE_NONE HealthCheckType = 1
+	// A command executed in the container which exits with 0 if healthy
+	HealthCheckType_HEALTH_CHECK_TYPE_EXEC HealthCheckType = 2
+	// A TCP connection to the port
+	HealthCheckType_HEALTH_CHECK_TYPE_TCP HealthCheckType = 3
+	// A HTTP GET of the path on the port which returns 2xx or 3xx
+	HealthCheckType_HEALTH_CHECK_TYPE_HTTP HealthCheckType = 4
+)
+
+// Enum value maps for HealthCheckType.
+var (
+	HealthCheckType_name = map[int32]string{
+		0: "HEALTH_CHECK_TYPE_IMAGE",
+		1: "HEALTH_CHECK_TYPE_NONE",
+		2: "HEALTH_CHECK_TYPE_EXEC",
+		3: "HEALTH_CHECK_TYPE_TCP",
+		4: "HEALTH_CHECK_TYPE_HTTP",
+	}
+	HealthCheckType_value = map[string]int32{
+		"HEALTH_CHECK_TYPE_IMAGE": 0,
+		"HEALTH_CHECK_TYPE_NONE":  1,
+		"HEALTH_CHECK_TYPE_EXEC":  2,
+		"HEALTH_CHECK_TYPE_TCP":   3,
+		"HEALTH_CHECK_TYPE_HTTP":  4,
+	}
+)
+
+func (x HealthCheckType) Enum() *HealthCheckType {
+	p := new(HealthCheckType)
+	*p = x
+	return p
+}
+
+func (x HealthCheckType) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (HealthCheckType) Descriptor() protoreflect.EnumDescriptor {
+	return file_config_appconfig_proto_enumTypes[3].Descriptor()
+}
+
+func (HealthCheckType) Type() protoreflect.EnumType {
+	return &file_config_appconfig_proto_enumTypes[3]
+}
+
+func (x HealthCheckType) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use HealthCheckType.Descriptor instead.
+func (HealthCheckType) EnumDescriptor() ([]byte, []int) {
+	return file_config_appconfig_proto_rawDescGZIP(), []int{3}
+}
+
+type ConsoleType int32
+
+const (
+	ConsoleType_CONSOLE_TYPE_UNSPECIFIED ConsoleType = 0
+	// The VNC server of the app instance, which has to enable VNC
+	ConsoleType_CONSOLE_TYPE_VNC ConsoleType = 1
+	// The serial console of the app instance
+	ConsoleType_CONSOLE_TYPE_SERIAL ConsoleType = 2
+)
+
+// Enum value maps for ConsoleType.
+var (
+	ConsoleType_name = map[int32]string{
+		0: "CONSOLE_TYPE_UNSPECIFIED",
+		1: "CONSOLE_TYPE_VNC",
+		2: "CONSOLE_TYPE_SERIAL",
+	}
+	ConsoleType_value = map[string]int32{
+		"CONSOLE_TYPE_UNSPECIFIED": 0,
+		"CONSOLE_TYPE_VNC":         1,
+		"CONSOLE_TYPE_SERIAL":      2,
+	}
+)
+
+func (x ConsoleType) Enum() *ConsoleType {
+	p := new(ConsoleType)
+	*p = x
+	return p
+}
+
+func (x ConsoleType) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ConsoleType) Descriptor() protoreflect.EnumDescriptor {
+	return file_config_appconfig_proto_enumTypes[4].Descriptor()
+}
+
+func (ConsoleType) Type() protoreflect.EnumType {
+	return &file_config_appconfig_proto_enumTypes[4]
+}
+
+func (x ConsoleType) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ConsoleType.Descriptor instead.
+func (ConsoleType) EnumDescriptor() ([]byte, []int) {
+	return file_config_appconfig_proto_rawDescGZIP(), []int{4}
+}
+
 type InstanceOpsCmd struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Counter uint32 `protobuf:"varint,2,opt,name=counter,proto3" json:"counter,omitempty"`
-	OpsTime string `protobuf:"bytes,4,opt,name=opsTime,proto3" json:"opsTime,omitempty"` // Not currently used
+	Counter uint32 `protobuf:"varint,2,opt,name=counter,proto3" json:"counter,omitempty"`
+	OpsTime string `protobuf:"bytes,4,opt,name=opsTime,proto3" json:"opsTime,omitempty"` // Not currently used
+}
+
+func (x *InstanceOpsCmd) Reset() {
+	*x = InstanceOpsCmd{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_config_appconfig_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *InstanceOpsCmd) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*InstanceOpsCmd) ProtoMessage() {}
+
+func (x *InstanceOpsCmd) ProtoReflect() protoreflect.Message {
+	mi := &file_config_appconfig_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use InstanceOpsCmd.ProtoReflect.Descriptor instead.
+func (*InstanceOpsCmd) Descriptor() ([]byte, []int) {
+	return file_config_appconfig_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *InstanceOpsCmd) GetCounter() uint32 {
+	if x != nil {
+		return x.Counter
+	}
+	return 0
+}
+
+func (x *InstanceOpsCmd) GetOpsTime() string {
+	if x != nil {
+		return x.OpsTime
+	}
+	return ""
+}
+
+// An app instance is started after the app instance it depends on is ready,
+// and it is halted before it
+type AppDependency struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	AppUuid   string       `protobuf:"bytes,1,opt,name=app_uuid,json=appUuid,proto3" json:"app_uuid,omitempty"`
+	Readiness AppReadiness `protobuf:"varint,2,opt,name=readiness,proto3,enum=org.lfedge.eve.config.AppReadiness" json:"readiness,omitempty"`
+}
+
+func (x *AppDependency) Reset() {
+	*x = AppDependency{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_config_appconfig_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AppDependency) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AppDependency) ProtoMessage() {}
+
+func (x *AppDependency) ProtoReflect() protoreflect.Message {
+	mi := &file_config_appconfig_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AppDependency.ProtoReflect.Descriptor instead.
+func (*AppDependency) Descriptor() ([]byte, []int) {
+	return file_config_appconfig_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *AppDependency) GetAppUuid() string {
+	if x != nil {
+		return x.AppUuid
+	}
+	return ""
+}
+
+func (x *AppDependency) GetReadiness() AppReadiness {
+	if x != nil {
+		return x.Readiness
+	}
+	return AppReadiness_APP_READINESS_UNSPECIFIED
+}
+
+// Restart policy of an app instance. The restarts are delayed by a backoff
+// which is doubled after each restart within crash_loop_window_seconds.
+// crash_loop_restarts restarts within that window are a crash loop: the
+// app instance is no longer restarted and is reported in the CRASH_LOOP
+// state, until it is restarted by a restart command or deactivated.
+type RestartPolicy struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Mode RestartMode `protobuf:"varint,1,opt,name=mode,proto3,enum=org.lfedge.eve.config.RestartMode" json:"mode,omitempty"`
+	// Maximum number of restarts with RESTART_MODE_ON_FAILURE; 0 is unlimited
+	MaxAttempts uint32 `protobuf:"varint,2,opt,name=max_attempts,json=maxAttempts,proto3" json:"max_attempts,omitempty"`
+	// Delay of the first restart; 10 seconds if 0
+	BackoffSeconds uint32 `protobuf:"varint,3,opt,name=backoff_seconds,json=backoffSeconds,proto3" json:"backoff_seconds,omitempty"`
+	// Maximum delay of a restart; 300 seconds if 0
+	MaxBackoffSeconds uint32 `protobuf:"varint,4,opt,name=max_backoff_seconds,json=maxBackoffSeconds,proto3" json:"max_backoff_seconds,omitempty"`
+	// Number of restarts which are a crash loop; 5 if 0
+	CrashLoopRestarts uint32 `protobuf:"varint,5,opt,name=crash_loop_restarts,json=crashLoopRestarts,proto3" json:"crash_loop_restarts,omitempty"`
+	// Window of the crash loop detection; 600 seconds if 0
+	CrashLoopWindowSeconds uint32 `protobuf:"varint,6,opt,name=crash_loop_window_seconds,json=crashLoopWindowSeconds,proto3" json:"crash_loop_window_seconds,omitempty"`
+}
+
+func (x *RestartPolicy) Reset() {
+	*x = RestartPolicy{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_config_appconfig_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RestartPolicy) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RestartPolicy) ProtoMessage() {}
+
+func (x *RestartPolicy) ProtoReflect() protoreflect.Message {
+	mi := &file_config_appconfig_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RestartPolicy.ProtoReflect.Descriptor instead.
+func (*RestartPolicy) Descriptor() ([]byte, []int) {
+	return file_config_appconfig_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *RestartPolicy) GetMode() RestartMode {
+	if x != nil {
+		return x.Mode
+	}
+	return RestartMode_RESTART_MODE_UNSPECIFIED
+}
+
+func (x *RestartPolicy) GetMaxAttempts() uint32 {
+	if x != nil {
+		return x.MaxAttempts
+	}
+	return 0
+}
+
+func (x *RestartPolicy) GetBackoffSeconds() uint32 {
+	if x != nil {
+		return x.BackoffSeconds
+	}
+	return 0
+}
+
+func (x *RestartPolicy) GetMaxBackoffSeconds() uint32 {
+	if x != nil {
+		return x.MaxBackoffSeconds
+	}
+	return 0
+}
+
+func (x *RestartPolicy) GetCrashLoopRestarts() uint32 {
+	if x != nil {
+		return x.CrashLoopRestarts
+	}
+	return 0
+}
+
+func (x *RestartPolicy) GetCrashLoopWindowSeconds() uint32 {
+	if x != nil {
+		return x.CrashLoopWindowSeconds
+	}
+	return 0
+}
+
+// A volume of the app instance mounted in a container of its pod
+type PodMount struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	VolumeUuid string `protobuf:"bytes,1,opt,name=volume_uuid,json=volumeUuid,proto3" json:"volume_uuid,omitempty"` // One of the volumeRefList of the app instance
+	MountDir   string `protobuf:"bytes,2,opt,name=mount_dir,json=mountDir,proto3" json:"mount_dir,omitempty"`
+	ReadOnly   bool   `protobuf:"varint,3,opt,name=read_only,json=readOnly,proto3" json:"read_only,omitempty"`
+}
+
+func (x *PodMount) Reset() {
+	*x = PodMount{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_config_appconfig_proto_msgTypes[3]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PodMount) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PodMount) ProtoMessage() {}
+
+func (x *PodMount) ProtoReflect() protoreflect.Message {
+	mi := &file_config_appconfig_proto_msgTypes[3]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PodMount.ProtoReflect.Descriptor instead.
+func (*PodMount) Descriptor() ([]byte, []int) {
+	return file_config_appconfig_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *PodMount) GetVolumeUuid() string {
+	if x != nil {
+		return x.VolumeUuid
+	}
+	return ""
+}
+
+func (x *PodMount) GetMountDir() string {
+	if x != nil {
+		return x.MountDir
+	}
+	return ""
+}
+
+func (x *PodMount) GetReadOnly() bool {
+	if x != nil {
+		return x.ReadOnly
+	}
+	return false
+}
+
+// A container of the pod of an app instance with the NOHYPER
+// virtualization mode, which runs next to the main container of the app
+// instance in its network namespace. The main container is the first
+// volumeRef as before.
+type PodContainer struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Unique within the pod; a DNS label
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// The volume with the OCI image of the container, which is one of the
+	// volumeRefList of the app instance. It is not mounted in the main
+	// container.
+	VolumeUuid string `protobuf:"bytes,2,opt,name=volume_uuid,json=volumeUuid,proto3" json:"volume_uuid,omitempty"`
+	// An init container runs to completion before the main container starts,
+	// in the order of pod_containers; otherwise it is a sidecar which runs
+	// with the main container
+	Init bool `protobuf:"varint,3,opt,name=init,proto3" json:"init,omitempty"`
+	// Override the entrypoint and the command of the image
+	Args []string          `protobuf:"bytes,4,rep,name=args,proto3" json:"args,omitempty"`
+	Env  map[string]string `protobuf:"bytes,5,rep,name=env,proto3" json:"env,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	// Limits of the container within those of the app instance; 0 is none
+	Memory uint32 `protobuf:"varint,6,opt,name=memory,proto3" json:"memory,omitempty"` // in kbytes
+	Vcpus  uint32 `protobuf:"varint,7,opt,name=vcpus,proto3" json:"vcpus,omitempty"`
+	// When a sidecar is restarted after it exits, always if unspecified; the
+	// backoff is the one of restart_policy of the app instance. Init
+	// containers are not restarted.
+	RestartMode RestartMode `protobuf:"varint,8,opt,name=restart_mode,json=restartMode,proto3,enum=org.lfedge.eve.config.RestartMode" json:"restart_mode,omitempty"`
+	Mounts      []*PodMount `protobuf:"bytes,9,rep,name=mounts,proto3" json:"mounts,omitempty"`
+}
+
+func (x *PodContainer) Reset() {
+	*x = PodContainer{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_config_appconfig_proto_msgTypes[4]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PodContainer) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PodContainer) ProtoMessage() {}
+
+func (x *PodContainer) ProtoReflect() protoreflect.Message {
+	mi := &file_config_appconfig_proto_msgTypes[4]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PodContainer.ProtoReflect.Descriptor instead.
+func (*PodContainer) Descriptor() ([]byte, []int) {
+	return file_config_appconfig_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *PodContainer) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *PodContainer) GetVolumeUuid() string {
+	if x != nil {
+		return x.VolumeUuid
+	}
+	return ""
+}
+
+func (x *PodContainer) GetInit() bool {
+	if x != nil {
+		return x.Init
+	}
+	return false
+}
+
+func (x *PodContainer) GetArgs() []string {
+	if x != nil {
+		return x.Args
+	}
+	return nil
+}
+
+func (x *PodContainer) GetEnv() map[string]string {
+	if x != nil {
+		return x.Env
+	}
+	return nil
+}
+
+func (x *PodContainer) GetMemory() uint32 {
+	if x != nil {
+		return x.Memory
+	}
+	return 0
+}
+
+func (x *PodContainer) GetVcpus() uint32 {
+	if x != nil {
+		return x.Vcpus
+	}
+	return 0
+}
+
+func (x *PodContainer) GetRestartMode() RestartMode {
+	if x != nil {
+		return x.RestartMode
+	}
+	return RestartMode_RESTART_MODE_UNSPECIFIED
+}
+
+func (x *PodContainer) GetMounts() []*PodMount {
+	if x != nil {
+		return x.Mounts
+	}
+	return nil
+}
+
+// The health check of the main container of an app instance with the
+// NOHYPER virtualization mode, which overrides the HEALTHCHECK of its OCI
+// image. The probes connect to the port on localhost in the network
+// namespace of the container. Failures within start_period_seconds after
+// the start do not count, and retries consecutive failures make the app
+// instance unhealthy. The zero values of the timings and retries are
+// those of the image, else those of Docker.
+type HealthCheck struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Type               HealthCheckType `protobuf:"varint,1,opt,name=type,proto3,enum=org.lfedge.eve.config.HealthCheckType" json:"type,omitempty"`
+	ExecCommand        []string        `protobuf:"bytes,2,rep,name=exec_command,json=execCommand,proto3" json:"exec_command,omitempty"`
+	Port               uint32          `protobuf:"varint,3,opt,name=port,proto3" json:"port,omitempty"`
+	HttpPath           string          `protobuf:"bytes,4,opt,name=http_path,json=httpPath,proto3" json:"http_path,omitempty"`
+	IntervalSeconds    uint32          `protobuf:"varint,5,opt,name=interval_seconds,json=intervalSeconds,proto3" json:"interval_seconds,omitempty"` // 30 seconds if 0
+	TimeoutSeconds     uint32          `protobuf:"varint,6,opt,name=timeout_seconds,json=timeoutSeconds,proto3" json:"timeout_seconds,omitempty"`    // 30 seconds if 0
+	StartPeriodSeconds uint32          `protobuf:"varint,7,opt,name=start_period_seconds,json=startPeriodSeconds,proto3" json:"start_period_seconds,omitempty"`
+	Retries            uint32          `protobuf:"varint,8,opt,name=retries,proto3" json:"retries,omitempty"` // 3 if 0
+	// Restart the app instance once it is unhealthy
+	Restart bool `protobuf:"varint,9,opt,name=restart,proto3" json:"restart,omitempty"`
 }
 
-func (x *InstanceOpsCmd) Reset() {
-	*x = InstanceOpsCmd{}
+func (x *HealthCheck) Reset() {
+	*x = HealthCheck{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_config_appconfig_proto_msgTypes[0]
+		mi := &file_config_appconfig_proto_msgTypes[5]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *InstanceOpsCmd) String() string {
+func (x *HealthCheck) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*InstanceOpsCmd) ProtoMessage() {}
+func (*HealthCheck) ProtoMessage() {}
 
-func (x *InstanceOpsCmd) ProtoReflect() protoreflect.Message {
-	mi := &file_config_appconfig_proto_msgTypes[0]
+func (x *HealthCheck) ProtoReflect() protoreflect.Message {
+	mi := &file_config_appconfig_proto_msgTypes[5]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -112,25 +749,167 @@ func (x *InstanceOpsCmd) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use InstanceOpsCmd.ProtoReflect.Descriptor instead.
-func (*InstanceOpsCmd) Descriptor() ([]byte, []int) {
-	return file_config_appconfig_proto_rawDescGZIP(), []int{0}
+// Deprecated: Use HealthCheck.ProtoReflect.Descriptor instead.
+func (*HealthCheck) Descriptor() ([]byte, []int) {
+	return file_config_appconfig_proto_rawDescGZIP(), []int{5}
 }
 
-func (x *InstanceOpsCmd) GetCounter() uint32 {
+func (x *HealthCheck) GetType() HealthCheckType {
 	if x != nil {
-		return x.Counter
+		return x.Type
+	}
+	return HealthCheckType_HEALTH_CHECK_TYPE_IMAGE
+}
+
+func (x *HealthCheck) GetExecCommand() []string {
+	if x != nil {
+		return x.ExecCommand
+	}
+	return nil
+}
+
+func (x *HealthCheck) GetPort() uint32 {
+	if x != nil {
+		return x.Port
 	}
 	return 0
 }
 
-func (x *InstanceOpsCmd) GetOpsTime() string {
+func (x *HealthCheck) GetHttpPath() string {
 	if x != nil {
-		return x.OpsTime
+		return x.HttpPath
+	}
+	return ""
+}
+
+func (x *HealthCheck) GetIntervalSeconds() uint32 {
+	if x != nil {
+		return x.IntervalSeconds
+	}
+	return 0
+}
+
+func (x *HealthCheck) GetTimeoutSeconds() uint32 {
+	if x != nil {
+		return x.TimeoutSeconds
+	}
+	return 0
+}
+
+func (x *HealthCheck) GetStartPeriodSeconds() uint32 {
+	if x != nil {
+		return x.StartPeriodSeconds
+	}
+	return 0
+}
+
+func (x *HealthCheck) GetRetries() uint32 {
+	if x != nil {
+		return x.Retries
+	}
+	return 0
+}
+
+func (x *HealthCheck) GetRestart() bool {
+	if x != nil {
+		return x.Restart
+	}
+	return false
+}
+
+// A console session which the controller authorized for an app instance.
+// The controller opens it over the remote console tunnel by sending a line
+// with the id and the token of the session, which EVE checks against the
+// SHA-256 of the token. A session can be used once at a time and ends at
+// not_after, or after max_duration_seconds if not 0.
+type ConsoleSession struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id                 string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"` // UUID
+	Type               ConsoleType            `protobuf:"varint,2,opt,name=type,proto3,enum=org.lfedge.eve.config.ConsoleType" json:"type,omitempty"`
+	TokenSha256        []byte                 `protobuf:"bytes,3,opt,name=token_sha256,json=tokenSha256,proto3" json:"token_sha256,omitempty"`
+	NotAfter           *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=not_after,json=notAfter,proto3" json:"not_after,omitempty"`
+	MaxDurationSeconds uint32                 `protobuf:"varint,5,opt,name=max_duration_seconds,json=maxDurationSeconds,proto3" json:"max_duration_seconds,omitempty"`
+	// Record the output of the console, which is uploaded to the controller
+	Record bool `protobuf:"varint,6,opt,name=record,proto3" json:"record,omitempty"`
+}
+
+func (x *ConsoleSession) Reset() {
+	*x = ConsoleSession{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_config_appconfig_proto_msgTypes[6]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ConsoleSession) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ConsoleSession) ProtoMessage() {}
+
+func (x *ConsoleSession) ProtoReflect() protoreflect.Message {
+	mi := &file_config_appconfig_proto_msgTypes[6]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ConsoleSession.ProtoReflect.Descriptor instead.
+func (*ConsoleSession) Descriptor() ([]byte, []int) {
+	return file_config_appconfig_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *ConsoleSession) GetId() string {
+	if x != nil {
+		return x.Id
 	}
 	return ""
 }
 
+func (x *ConsoleSession) GetType() ConsoleType {
+	if x != nil {
+		return x.Type
+	}
+	return ConsoleType_CONSOLE_TYPE_UNSPECIFIED
+}
+
+func (x *ConsoleSession) GetTokenSha256() []byte {
+	if x != nil {
+		return x.TokenSha256
+	}
+	return nil
+}
+
+func (x *ConsoleSession) GetNotAfter() *timestamppb.Timestamp {
+	if x != nil {
+		return x.NotAfter
+	}
+	return nil
+}
+
+func (x *ConsoleSession) GetMaxDurationSeconds() uint32 {
+	if x != nil {
+		return x.MaxDurationSeconds
+	}
+	return 0
+}
+
+func (x *ConsoleSession) GetRecord() bool {
+	if x != nil {
+		return x.Record
+	}
+	return false
+}
+
 // The complete configuration for an Application Instance
 // When changing key fields such as the drives/volumeRefs or the number
 // of interfaces, the controller is required to issue a purge command i.e.,
@@ -219,12 +998,35 @@ type AppInstanceConfig struct {
 	// Non-Zero value -> After EVE is ready to start application instance, wait for the
 	// given amount of time before starting the respective application instance.
 	StartDelayInSeconds uint32 `protobuf:"varint,19,opt,name=start_delay_in_seconds,json=startDelayInSeconds,proto3" json:"start_delay_in_seconds,omitempty"`
+	// start_after lists the app instances which have to be ready before this
+	// app instance is started. When the app instances are deactivated, or the
+	// device reboots, this app instance is halted before them. A cycle of
+	// dependencies is reported as an error of the app instances in the cycle.
+	StartAfter []*AppDependency `protobuf:"bytes,20,rep,name=start_after,json=startAfter,proto3" json:"start_after,omitempty"`
+	// restart_policy tells if the app instance is restarted when it halts
+	// or crashes by itself. Changing it does not require a restart.
+	RestartPolicy *RestartPolicy `protobuf:"bytes,21,opt,name=restart_policy,json=restartPolicy,proto3" json:"restart_policy,omitempty"`
+	// secure_boot_keys is an EncryptionBlock with the UEFI Secure Boot keys
+	// which are enrolled in the NVRAM of the app instance before it boots.
+	// Only used with secureBoot in fixedresources. A change of the keys
+	// recreates the NVRAM.
+	SecureBootKeys *CipherBlock `protobuf:"bytes,22,opt,name=secure_boot_keys,json=secureBootKeys,proto3" json:"secure_boot_keys,omitempty"`
+	// pod_containers are the init and sidecar containers which run with the
+	// main container of an app instance with the NOHYPER virtualization mode
+	PodContainers []*PodContainer `protobuf:"bytes,23,rep,name=pod_containers,json=podContainers,proto3" json:"pod_containers,omitempty"`
+	// health_check of the main container of an app instance with the
+	// NOHYPER virtualization mode
+	HealthCheck *HealthCheck `protobuf:"bytes,24,opt,name=health_check,json=healthCheck,proto3" json:"health_check,omitempty"`
+	// console_sessions are the console sessions the controller may open
+	// through the device. With the app.console.brokered setting they are the
+	// only way to reach the consoles of the app instance.
+	ConsoleSessions []*ConsoleSession `protobuf:"bytes,25,rep,name=console_sessions,json=consoleSessions,proto3" json:"console_sessions,omitempty"`
 }
 
 func (x *AppInstanceConfig) Reset() {
 	*x = AppInstanceConfig{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_config_appconfig_proto_msgTypes[1]
+		mi := &file_config_appconfig_proto_msgTypes[7]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -237,7 +1039,7 @@ func (x *AppInstanceConfig) String() string {
 func (*AppInstanceConfig) ProtoMessage() {}
 
 func (x *AppInstanceConfig) ProtoReflect() protoreflect.Message {
-	mi := &file_config_appconfig_proto_msgTypes[1]
+	mi := &file_config_appconfig_proto_msgTypes[7]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -250,7 +1052,7 @@ func (x *AppInstanceConfig) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use AppInstanceConfig.ProtoReflect.Descriptor instead.
 func (*AppInstanceConfig) Descriptor() ([]byte, []int) {
-	return file_config_appconfig_proto_rawDescGZIP(), []int{1}
+	return file_config_appconfig_proto_rawDescGZIP(), []int{7}
 }
 
 func (x *AppInstanceConfig) GetUuidandversion() *UUIDandVersion {
@@ -372,6 +1174,48 @@ func (x *AppInstanceConfig) GetStartDelayInSeconds() uint32 {
 	return 0
 }
 
+func (x *AppInstanceConfig) GetStartAfter() []*AppDependency {
+	if x != nil {
+		return x.StartAfter
+	}
+	return nil
+}
+
+func (x *AppInstanceConfig) GetRestartPolicy() *RestartPolicy {
+	if x != nil {
+		return x.RestartPolicy
+	}
+	return nil
+}
+
+func (x *AppInstanceConfig) GetSecureBootKeys() *CipherBlock {
+	if x != nil {
+		return x.SecureBootKeys
+	}
+	return nil
+}
+
+func (x *AppInstanceConfig) GetPodContainers() []*PodContainer {
+	if x != nil {
+		return x.PodContainers
+	}
+	return nil
+}
+
+func (x *AppInstanceConfig) GetHealthCheck() *HealthCheck {
+	if x != nil {
+		return x.HealthCheck
+	}
+	return nil
+}
+
+func (x *AppInstanceConfig) GetConsoleSessions() []*ConsoleSession {
+	if x != nil {
+		return x.ConsoleSessions
+	}
+	return nil
+}
+
 // Reference to a Volume specified separately in the API
 // If a volume is purged (re-created from scratch) it will either have a new
 // UUID or a new generationCount
@@ -385,12 +1229,16 @@ type VolumeRef struct {
 	// Indicates volume mount point inside container
 	// if mount_dir is empty then it will be mounted on /mnt
 	MountDir string `protobuf:"bytes,3,opt,name=mount_dir,json=mountDir,proto3" json:"mount_dir,omitempty"`
+	// For a shared volume the owner app instance is started before the other
+	// app instances which refer to the volume. At most one app instance can
+	// be the owner.
+	SharedOwner bool `protobuf:"varint,4,opt,name=shared_owner,json=sharedOwner,proto3" json:"shared_owner,omitempty"`
 }
 
 func (x *VolumeRef) Reset() {
 	*x = VolumeRef{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_config_appconfig_proto_msgTypes[2]
+		mi := &file_config_appconfig_proto_msgTypes[8]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -403,7 +1251,7 @@ func (x *VolumeRef) String() string {
 func (*VolumeRef) ProtoMessage() {}
 
 func (x *VolumeRef) ProtoReflect() protoreflect.Message {
-	mi := &file_config_appconfig_proto_msgTypes[2]
+	mi := &file_config_appconfig_proto_msgTypes[8]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -416,7 +1264,7 @@ func (x *VolumeRef) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use VolumeRef.ProtoReflect.Descriptor instead.
 func (*VolumeRef) Descriptor() ([]byte, []int) {
-	return file_config_appconfig_proto_rawDescGZIP(), []int{2}
+	return file_config_appconfig_proto_rawDescGZIP(), []int{8}
 }
 
 func (x *VolumeRef) GetUuid() string {
@@ -440,6 +1288,13 @@ func (x *VolumeRef) GetMountDir() string {
 	return ""
 }
 
+func (x *VolumeRef) GetSharedOwner() bool {
+	if x != nil {
+		return x.SharedOwner
+	}
+	return false
+}
+
 var File_config_appconfig_proto protoreflect.FileDescriptor
 
 var file_config_appconfig_proto_rawDesc = []byte{
@@ -453,84 +1308,242 @@ var file_config_appconfig_proto_rawDesc = []byte{
 	0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x0f, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2f,
 	0x76, 0x6d, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x16, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67,
 	0x2f, 0x6e, 0x65, 0x74, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
-	0x22, 0x44, 0x0a, 0x0e, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x4f, 0x70, 0x73, 0x43,
-	0x6d, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x65, 0x72, 0x18, 0x02, 0x20,
-	0x01, 0x28, 0x0d, 0x52, 0x07, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x65, 0x72, 0x12, 0x18, 0x0a, 0x07,
-	0x6f, 0x70, 0x73, 0x54, 0x69, 0x6d, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6f,
-	0x70, 0x73, 0x54, 0x69, 0x6d, 0x65, 0x22, 0xbf, 0x07, 0x0a, 0x11, 0x41, 0x70, 0x70, 0x49, 0x6e,
-	0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x4d, 0x0a, 0x0e,
-	0x75, 0x75, 0x69, 0x64, 0x61, 0x6e, 0x64, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x0b, 0x32, 0x25, 0x2e, 0x6f, 0x72, 0x67, 0x2e, 0x6c, 0x66, 0x65, 0x64, 0x67,
-	0x65, 0x2e, 0x65, 0x76, 0x65, 0x2e, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e, 0x55, 0x55, 0x49,
-	0x44, 0x61, 0x6e, 0x64, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x0e, 0x75, 0x75, 0x69,
-	0x64, 0x61, 0x6e, 0x64, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x20, 0x0a, 0x0b, 0x64,
-	0x69, 0x73, 0x70, 0x6c, 0x61, 0x79, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x0b, 0x64, 0x69, 0x73, 0x70, 0x6c, 0x61, 0x79, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x47, 0x0a,
-	0x0e, 0x66, 0x69, 0x78, 0x65, 0x64, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x73, 0x18,
-	0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x6f, 0x72, 0x67, 0x2e, 0x6c, 0x66, 0x65, 0x64,
-	0x67, 0x65, 0x2e, 0x65, 0x76, 0x65, 0x2e, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e, 0x56, 0x6d,
-	0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x0e, 0x66, 0x69, 0x78, 0x65, 0x64, 0x72, 0x65, 0x73,
-	0x6f, 0x75, 0x72, 0x63, 0x65, 0x73, 0x12, 0x34, 0x0a, 0x06, 0x64, 0x72, 0x69, 0x76, 0x65, 0x73,
-	0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x6f, 0x72, 0x67, 0x2e, 0x6c, 0x66, 0x65,
-	0x64, 0x67, 0x65, 0x2e, 0x65, 0x76, 0x65, 0x2e, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e, 0x44,
-	0x72, 0x69, 0x76, 0x65, 0x52, 0x06, 0x64, 0x72, 0x69, 0x76, 0x65, 0x73, 0x12, 0x1a, 0x0a, 0x08,
-	0x61, 0x63, 0x74, 0x69, 0x76, 0x61, 0x74, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08,
-	0x61, 0x63, 0x74, 0x69, 0x76, 0x61, 0x74, 0x65, 0x12, 0x45, 0x0a, 0x0a, 0x69, 0x6e, 0x74, 0x65,
-	0x72, 0x66, 0x61, 0x63, 0x65, 0x73, 0x18, 0x06, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x25, 0x2e, 0x6f,
-	0x72, 0x67, 0x2e, 0x6c, 0x66, 0x65, 0x64, 0x67, 0x65, 0x2e, 0x65, 0x76, 0x65, 0x2e, 0x63, 0x6f,
-	0x6e, 0x66, 0x69, 0x67, 0x2e, 0x4e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x41, 0x64, 0x61, 0x70,
-	0x74, 0x65, 0x72, 0x52, 0x0a, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x66, 0x61, 0x63, 0x65, 0x73, 0x12,
-	0x3a, 0x0a, 0x08, 0x61, 0x64, 0x61, 0x70, 0x74, 0x65, 0x72, 0x73, 0x18, 0x07, 0x20, 0x03, 0x28,
-	0x0b, 0x32, 0x1e, 0x2e, 0x6f, 0x72, 0x67, 0x2e, 0x6c, 0x66, 0x65, 0x64, 0x67, 0x65, 0x2e, 0x65,
-	0x76, 0x65, 0x2e, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e, 0x41, 0x64, 0x61, 0x70, 0x74, 0x65,
-	0x72, 0x52, 0x08, 0x61, 0x64, 0x61, 0x70, 0x74, 0x65, 0x72, 0x73, 0x12, 0x3f, 0x0a, 0x07, 0x72,
-	0x65, 0x73, 0x74, 0x61, 0x72, 0x74, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x25, 0x2e, 0x6f,
-	0x72, 0x67, 0x2e, 0x6c, 0x66, 0x65, 0x64, 0x67, 0x65, 0x2e, 0x65, 0x76, 0x65, 0x2e, 0x63, 0x6f,
-	0x6e, 0x66, 0x69, 0x67, 0x2e, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x4f, 0x70, 0x73,
-	0x43, 0x6d, 0x64, 0x52, 0x07, 0x72, 0x65, 0x73, 0x74, 0x61, 0x72, 0x74, 0x12, 0x3b, 0x0a, 0x05,
-	0x70, 0x75, 0x72, 0x67, 0x65, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x25, 0x2e, 0x6f, 0x72,
+	0x1a, 0x1f, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75,
+	0x66, 0x2f, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x22, 0x44, 0x0a, 0x0e, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x4f, 0x70, 0x73,
+	0x43, 0x6d, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x65, 0x72, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x0d, 0x52, 0x07, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x65, 0x72, 0x12, 0x18, 0x0a,
+	0x07, 0x6f, 0x70, 0x73, 0x54, 0x69, 0x6d, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07,
+	0x6f, 0x70, 0x73, 0x54, 0x69, 0x6d, 0x65, 0x22, 0x6d, 0x0a, 0x0d, 0x41, 0x70, 0x70, 0x44, 0x65,
+	0x70, 0x65, 0x6e, 0x64, 0x65, 0x6e, 0x63, 0x79, 0x12, 0x19, 0x0a, 0x08, 0x61, 0x70, 0x70, 0x5f,
+	0x75, 0x75, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x61, 0x70, 0x70, 0x55,
+	0x75, 0x69, 0x64, 0x12, 0x41, 0x0a, 0x09, 0x72, 0x65, 0x61, 0x64, 0x69, 0x6e, 0x65, 0x73, 0x73,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x23, 0x2e, 0x6f, 0x72, 0x67, 0x2e, 0x6c, 0x66, 0x65,
+	0x64, 0x67, 0x65, 0x2e, 0x65, 0x76, 0x65, 0x2e, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e, 0x41,
+	0x70, 0x70, 0x52, 0x65, 0x61, 0x64, 0x69, 0x6e, 0x65, 0x73, 0x73, 0x52, 0x09, 0x72, 0x65, 0x61,
+	0x64, 0x69, 0x6e, 0x65, 0x73, 0x73, 0x22, 0xae, 0x02, 0x0a, 0x0d, 0x52, 0x65, 0x73, 0x74, 0x61,
+	0x72, 0x74, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x12, 0x36, 0x0a, 0x04, 0x6d, 0x6f, 0x64, 0x65,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x22, 0x2e, 0x6f, 0x72, 0x67, 0x2e, 0x6c, 0x66, 0x65,
+	0x64, 0x67, 0x65, 0x2e, 0x65, 0x76, 0x65, 0x2e, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e, 0x52,
+	0x65, 0x73, 0x74, 0x61, 0x72, 0x74, 0x4d, 0x6f, 0x64, 0x65, 0x52, 0x04, 0x6d, 0x6f, 0x64, 0x65,
+	0x12, 0x21, 0x0a, 0x0c, 0x6d, 0x61, 0x78, 0x5f, 0x61, 0x74, 0x74, 0x65, 0x6d, 0x70, 0x74, 0x73,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0b, 0x6d, 0x61, 0x78, 0x41, 0x74, 0x74, 0x65, 0x6d,
+	0x70, 0x74, 0x73, 0x12, 0x27, 0x0a, 0x0f, 0x62, 0x61, 0x63, 0x6b, 0x6f, 0x66, 0x66, 0x5f, 0x73,
+	0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0e, 0x62, 0x61,
+	0x63, 0x6b, 0x6f, 0x66, 0x66, 0x53, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x12, 0x2e, 0x0a, 0x13,
+	0x6d, 0x61, 0x78, 0x5f, 0x62, 0x61, 0x63, 0x6b, 0x6f, 0x66, 0x66, 0x5f, 0x73, 0x65, 0x63, 0x6f,
+	0x6e, 0x64, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x11, 0x6d, 0x61, 0x78, 0x42, 0x61,
+	0x63, 0x6b, 0x6f, 0x66, 0x66, 0x53, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x12, 0x2e, 0x0a, 0x13,
+	0x63, 0x72, 0x61, 0x73, 0x68, 0x5f, 0x6c, 0x6f, 0x6f, 0x70, 0x5f, 0x72, 0x65, 0x73, 0x74, 0x61,
+	0x72, 0x74, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x11, 0x63, 0x72, 0x61, 0x73, 0x68,
+	0x4c, 0x6f, 0x6f, 0x70, 0x52, 0x65, 0x73, 0x74, 0x61, 0x72, 0x74, 0x73, 0x12, 0x39, 0x0a, 0x19,
+	0x63, 0x72, 0x61, 0x73, 0x68, 0x5f, 0x6c, 0x6f, 0x6f, 0x70, 0x5f, 0x77, 0x69, 0x6e, 0x64, 0x6f,
+	0x77, 0x5f, 0x73, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0d, 0x52,
+	0x16, 0x63, 0x72, 0x61, 0x73, 0x68, 0x4c, 0x6f, 0x6f, 0x70, 0x57, 0x69, 0x6e, 0x64, 0x6f, 0x77,
+	0x53, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x22, 0x65, 0x0a, 0x08, 0x50, 0x6f, 0x64, 0x4d, 0x6f,
+	0x75, 0x6e, 0x74, 0x12, 0x1f, 0x0a, 0x0b, 0x76, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x5f, 0x75, 0x75,
+	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x76, 0x6f, 0x6c, 0x75, 0x6d, 0x65,
+	0x55, 0x75, 0x69, 0x64, 0x12, 0x1b, 0x0a, 0x09, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x5f, 0x64, 0x69,
+	0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x44, 0x69,
+	0x72, 0x12, 0x1b, 0x0a, 0x09, 0x72, 0x65, 0x61, 0x64, 0x5f, 0x6f, 0x6e, 0x6c, 0x79, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x72, 0x65, 0x61, 0x64, 0x4f, 0x6e, 0x6c, 0x79, 0x22, 0x91,
+	0x03, 0x0a, 0x0c, 0x50, 0x6f, 0x64, 0x43, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x12,
+	0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e,
+	0x61, 0x6d, 0x65, 0x12, 0x1f, 0x0a, 0x0b, 0x76, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x5f, 0x75, 0x75,
+	0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x76, 0x6f, 0x6c, 0x75, 0x6d, 0x65,
+	0x55, 0x75, 0x69, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x69, 0x6e, 0x69, 0x74, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x08, 0x52, 0x04, 0x69, 0x6e, 0x69, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x61, 0x72, 0x67, 0x73,
+	0x18, 0x04, 0x20, 0x03, 0x28, 0x09, 0x52, 0x04, 0x61, 0x72, 0x67, 0x73, 0x12, 0x3e, 0x0a, 0x03,
+	0x65, 0x6e, 0x76, 0x18, 0x05, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2c, 0x2e, 0x6f, 0x72, 0x67, 0x2e,
+	0x6c, 0x66, 0x65, 0x64, 0x67, 0x65, 0x2e, 0x65, 0x76, 0x65, 0x2e, 0x63, 0x6f, 0x6e, 0x66, 0x69,
+	0x67, 0x2e, 0x50, 0x6f, 0x64, 0x43, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x2e, 0x45,
+	0x6e, 0x76, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x03, 0x65, 0x6e, 0x76, 0x12, 0x16, 0x0a, 0x06,
+	0x6d, 0x65, 0x6d, 0x6f, 0x72, 0x79, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x06, 0x6d, 0x65,
+	0x6d, 0x6f, 0x72, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x63, 0x70, 0x75, 0x73, 0x18, 0x07, 0x20,
+	0x01, 0x28, 0x0d, 0x52, 0x05, 0x76, 0x63, 0x70, 0x75, 0x73, 0x12, 0x45, 0x0a, 0x0c, 0x72, 0x65,
+	0x73, 0x74, 0x61, 0x72, 0x74, 0x5f, 0x6d, 0x6f, 0x64, 0x65, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0e,
+	0x32, 0x22, 0x2e, 0x6f, 0x72, 0x67, 0x2e, 0x6c, 0x66, 0x65, 0x64, 0x67, 0x65, 0x2e, 0x65, 0x76,
+	0x65, 0x2e, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e, 0x52, 0x65, 0x73, 0x74, 0x61, 0x72, 0x74,
+	0x4d, 0x6f, 0x64, 0x65, 0x52, 0x0b, 0x72, 0x65, 0x73, 0x74, 0x61, 0x72, 0x74, 0x4d, 0x6f, 0x64,
+	0x65, 0x12, 0x37, 0x0a, 0x06, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x73, 0x18, 0x09, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x1f, 0x2e, 0x6f, 0x72, 0x67, 0x2e, 0x6c, 0x66, 0x65, 0x64, 0x67, 0x65, 0x2e, 0x65,
+	0x76, 0x65, 0x2e, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e, 0x50, 0x6f, 0x64, 0x4d, 0x6f, 0x75,
+	0x6e, 0x74, 0x52, 0x06, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x73, 0x1a, 0x36, 0x0a, 0x08, 0x45, 0x6e,
+	0x76, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75,
+	0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02,
+	0x38, 0x01, 0x22, 0xd7, 0x02, 0x0a, 0x0b, 0x48, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x43, 0x68, 0x65,
+	0x63, 0x6b, 0x12, 0x3a, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e,
+	0x32, 0x26, 0x2e, 0x6f, 0x72, 0x67, 0x2e, 0x6c, 0x66, 0x65, 0x64, 0x67, 0x65, 0x2e, 0x65, 0x76,
+	0x65, 0x2e, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e, 0x48, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x43,
+	0x68, 0x65, 0x63, 0x6b, 0x54, 0x79, 0x70, 0x65, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x21,
+	0x0a, 0x0c, 0x65, 0x78, 0x65, 0x63, 0x5f, 0x63, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x18, 0x02,
+	0x20, 0x03, 0x28, 0x09, 0x52, 0x0b, 0x65, 0x78, 0x65, 0x63, 0x43, 0x6f, 0x6d, 0x6d, 0x61, 0x6e,
+	0x64, 0x12, 0x12, 0x0a, 0x04, 0x70, 0x6f, 0x72, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x52,
+	0x04, 0x70, 0x6f, 0x72, 0x74, 0x12, 0x1b, 0x0a, 0x09, 0x68, 0x74, 0x74, 0x70, 0x5f, 0x70, 0x61,
+	0x74, 0x68, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x68, 0x74, 0x74, 0x70, 0x50, 0x61,
+	0x74, 0x68, 0x12, 0x29, 0x0a, 0x10, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x76, 0x61, 0x6c, 0x5f, 0x73,
+	0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0f, 0x69, 0x6e,
+	0x74, 0x65, 0x72, 0x76, 0x61, 0x6c, 0x53, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x12, 0x27, 0x0a,
+	0x0f, 0x74, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x5f, 0x73, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73,
+	0x18, 0x06, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0e, 0x74, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x53,
+	0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x12, 0x30, 0x0a, 0x14, 0x73, 0x74, 0x61, 0x72, 0x74, 0x5f,
+	0x70, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x5f, 0x73, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x18, 0x07,
+	0x20, 0x01, 0x28, 0x0d, 0x52, 0x12, 0x73, 0x74, 0x61, 0x72, 0x74, 0x50, 0x65, 0x72, 0x69, 0x6f,
+	0x64, 0x53, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x72, 0x65, 0x74, 0x72,
+	0x69, 0x65, 0x73, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x07, 0x72, 0x65, 0x74, 0x72, 0x69,
+	0x65, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x72, 0x65, 0x73, 0x74, 0x61, 0x72, 0x74, 0x18, 0x09, 0x20,
+	0x01, 0x28, 0x08, 0x52, 0x07, 0x72, 0x65, 0x73, 0x74, 0x61, 0x72, 0x74, 0x22, 0xfe, 0x01, 0x0a,
+	0x0e, 0x43, 0x6f, 0x6e, 0x73, 0x6f, 0x6c, 0x65, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x12,
+	0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12,
+	0x36, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x22, 0x2e,
+	0x6f, 0x72, 0x67, 0x2e, 0x6c, 0x66, 0x65, 0x64, 0x67, 0x65, 0x2e, 0x65, 0x76, 0x65, 0x2e, 0x63,
+	0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e, 0x43, 0x6f, 0x6e, 0x73, 0x6f, 0x6c, 0x65, 0x54, 0x79, 0x70,
+	0x65, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x21, 0x0a, 0x0c, 0x74, 0x6f, 0x6b, 0x65, 0x6e,
+	0x5f, 0x73, 0x68, 0x61, 0x32, 0x35, 0x36, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x0b, 0x74,
+	0x6f, 0x6b, 0x65, 0x6e, 0x53, 0x68, 0x61, 0x32, 0x35, 0x36, 0x12, 0x37, 0x0a, 0x09, 0x6e, 0x6f,
+	0x74, 0x5f, 0x61, 0x66, 0x74, 0x65, 0x72, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e,
+	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e,
+	0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x08, 0x6e, 0x6f, 0x74, 0x41, 0x66,
+	0x74, 0x65, 0x72, 0x12, 0x30, 0x0a, 0x14, 0x6d, 0x61, 0x78, 0x5f, 0x64, 0x75, 0x72, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x5f, 0x73, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28,
+	0x0d, 0x52, 0x12, 0x6d, 0x61, 0x78, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x65,
+	0x63, 0x6f, 0x6e, 0x64, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x18,
+	0x06, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x72, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x22, 0x86, 0x0b,
+	0x0a, 0x11, 0x41, 0x70, 0x70, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x43, 0x6f, 0x6e,
+	0x66, 0x69, 0x67, 0x12, 0x4d, 0x0a, 0x0e, 0x75, 0x75, 0x69, 0x64, 0x61, 0x6e, 0x64, 0x76, 0x65,
+	0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x25, 0x2e, 0x6f, 0x72,
 	0x67, 0x2e, 0x6c, 0x66, 0x65, 0x64, 0x67, 0x65, 0x2e, 0x65, 0x76, 0x65, 0x2e, 0x63, 0x6f, 0x6e,
-	0x66, 0x69, 0x67, 0x2e, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x4f, 0x70, 0x73, 0x43,
-	0x6d, 0x64, 0x52, 0x05, 0x70, 0x75, 0x72, 0x67, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x75, 0x73, 0x65,
-	0x72, 0x44, 0x61, 0x74, 0x61, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x75, 0x73, 0x65,
-	0x72, 0x44, 0x61, 0x74, 0x61, 0x12, 0x24, 0x0a, 0x0d, 0x72, 0x65, 0x6d, 0x6f, 0x74, 0x65, 0x43,
-	0x6f, 0x6e, 0x73, 0x6f, 0x6c, 0x65, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0d, 0x72, 0x65,
-	0x6d, 0x6f, 0x74, 0x65, 0x43, 0x6f, 0x6e, 0x73, 0x6f, 0x6c, 0x65, 0x12, 0x42, 0x0a, 0x0a, 0x63,
-	0x69, 0x70, 0x68, 0x65, 0x72, 0x44, 0x61, 0x74, 0x61, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x0b, 0x32,
-	0x22, 0x2e, 0x6f, 0x72, 0x67, 0x2e, 0x6c, 0x66, 0x65, 0x64, 0x67, 0x65, 0x2e, 0x65, 0x76, 0x65,
-	0x2e, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e, 0x43, 0x69, 0x70, 0x68, 0x65, 0x72, 0x42, 0x6c,
-	0x6f, 0x63, 0x6b, 0x52, 0x0a, 0x63, 0x69, 0x70, 0x68, 0x65, 0x72, 0x44, 0x61, 0x74, 0x61, 0x12,
-	0x2e, 0x0a, 0x12, 0x63, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x53, 0x74, 0x61, 0x74, 0x73, 0x49,
-	0x50, 0x41, 0x64, 0x64, 0x72, 0x18, 0x0f, 0x20, 0x01, 0x28, 0x09, 0x52, 0x12, 0x63, 0x6f, 0x6c,
-	0x6c, 0x65, 0x63, 0x74, 0x53, 0x74, 0x61, 0x74, 0x73, 0x49, 0x50, 0x41, 0x64, 0x64, 0x72, 0x12,
-	0x46, 0x0a, 0x0d, 0x76, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x52, 0x65, 0x66, 0x4c, 0x69, 0x73, 0x74,
-	0x18, 0x10, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x6f, 0x72, 0x67, 0x2e, 0x6c, 0x66, 0x65,
-	0x64, 0x67, 0x65, 0x2e, 0x65, 0x76, 0x65, 0x2e, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e, 0x56,
-	0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x52, 0x65, 0x66, 0x52, 0x0d, 0x76, 0x6f, 0x6c, 0x75, 0x6d, 0x65,
-	0x52, 0x65, 0x66, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x47, 0x0a, 0x0c, 0x6d, 0x65, 0x74, 0x61, 0x44,
-	0x61, 0x74, 0x61, 0x54, 0x79, 0x70, 0x65, 0x18, 0x11, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x23, 0x2e,
+	0x66, 0x69, 0x67, 0x2e, 0x55, 0x55, 0x49, 0x44, 0x61, 0x6e, 0x64, 0x56, 0x65, 0x72, 0x73, 0x69,
+	0x6f, 0x6e, 0x52, 0x0e, 0x75, 0x75, 0x69, 0x64, 0x61, 0x6e, 0x64, 0x76, 0x65, 0x72, 0x73, 0x69,
+	0x6f, 0x6e, 0x12, 0x20, 0x0a, 0x0b, 0x64, 0x69, 0x73, 0x70, 0x6c, 0x61, 0x79, 0x6e, 0x61, 0x6d,
+	0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x64, 0x69, 0x73, 0x70, 0x6c, 0x61, 0x79,
+	0x6e, 0x61, 0x6d, 0x65, 0x12, 0x47, 0x0a, 0x0e, 0x66, 0x69, 0x78, 0x65, 0x64, 0x72, 0x65, 0x73,
+	0x6f, 0x75, 0x72, 0x63, 0x65, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x6f,
+	0x72, 0x67, 0x2e, 0x6c, 0x66, 0x65, 0x64, 0x67, 0x65, 0x2e, 0x65, 0x76, 0x65, 0x2e, 0x63, 0x6f,
+	0x6e, 0x66, 0x69, 0x67, 0x2e, 0x56, 0x6d, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x0e, 0x66,
+	0x69, 0x78, 0x65, 0x64, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x73, 0x12, 0x34, 0x0a,
+	0x06, 0x64, 0x72, 0x69, 0x76, 0x65, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1c, 0x2e,
+	0x6f, 0x72, 0x67, 0x2e, 0x6c, 0x66, 0x65, 0x64, 0x67, 0x65, 0x2e, 0x65, 0x76, 0x65, 0x2e, 0x63,
+	0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e, 0x44, 0x72, 0x69, 0x76, 0x65, 0x52, 0x06, 0x64, 0x72, 0x69,
+	0x76, 0x65, 0x73, 0x12, 0x1a, 0x0a, 0x08, 0x61, 0x63, 0x74, 0x69, 0x76, 0x61, 0x74, 0x65, 0x18,
+	0x05, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x61, 0x63, 0x74, 0x69, 0x76, 0x61, 0x74, 0x65, 0x12,
+	0x45, 0x0a, 0x0a, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x66, 0x61, 0x63, 0x65, 0x73, 0x18, 0x06, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x25, 0x2e, 0x6f, 0x72, 0x67, 0x2e, 0x6c, 0x66, 0x65, 0x64, 0x67, 0x65,
+	0x2e, 0x65, 0x76, 0x65, 0x2e, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e, 0x4e, 0x65, 0x74, 0x77,
+	0x6f, 0x72, 0x6b, 0x41, 0x64, 0x61, 0x70, 0x74, 0x65, 0x72, 0x52, 0x0a, 0x69, 0x6e, 0x74, 0x65,
+	0x72, 0x66, 0x61, 0x63, 0x65, 0x73, 0x12, 0x3a, 0x0a, 0x08, 0x61, 0x64, 0x61, 0x70, 0x74, 0x65,
+	0x72, 0x73, 0x18, 0x07, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x6f, 0x72, 0x67, 0x2e, 0x6c,
+	0x66, 0x65, 0x64, 0x67, 0x65, 0x2e, 0x65, 0x76, 0x65, 0x2e, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67,
+	0x2e, 0x41, 0x64, 0x61, 0x70, 0x74, 0x65, 0x72, 0x52, 0x08, 0x61, 0x64, 0x61, 0x70, 0x74, 0x65,
+	0x72, 0x73, 0x12, 0x3f, 0x0a, 0x07, 0x72, 0x65, 0x73, 0x74, 0x61, 0x72, 0x74, 0x18, 0x09, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x25, 0x2e, 0x6f, 0x72, 0x67, 0x2e, 0x6c, 0x66, 0x65, 0x64, 0x67, 0x65,
+	0x2e, 0x65, 0x76, 0x65, 0x2e, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e, 0x49, 0x6e, 0x73, 0x74,
+	0x61, 0x6e, 0x63, 0x65, 0x4f, 0x70, 0x73, 0x43, 0x6d, 0x64, 0x52, 0x07, 0x72, 0x65, 0x73, 0x74,
+	0x61, 0x72, 0x74, 0x12, 0x3b, 0x0a, 0x05, 0x70, 0x75, 0x72, 0x67, 0x65, 0x18, 0x0a, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x25, 0x2e, 0x6f, 0x72, 0x67, 0x2e, 0x6c, 0x66, 0x65, 0x64, 0x67, 0x65, 0x2e,
+	0x65, 0x76, 0x65, 0x2e, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e, 0x49, 0x6e, 0x73, 0x74, 0x61,
+	0x6e, 0x63, 0x65, 0x4f, 0x70, 0x73, 0x43, 0x6d, 0x64, 0x52, 0x05, 0x70, 0x75, 0x72, 0x67, 0x65,
+	0x12, 0x1a, 0x0a, 0x08, 0x75, 0x73, 0x65, 0x72, 0x44, 0x61, 0x74, 0x61, 0x18, 0x0b, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x08, 0x75, 0x73, 0x65, 0x72, 0x44, 0x61, 0x74, 0x61, 0x12, 0x24, 0x0a, 0x0d,
+	0x72, 0x65, 0x6d, 0x6f, 0x74, 0x65, 0x43, 0x6f, 0x6e, 0x73, 0x6f, 0x6c, 0x65, 0x18, 0x0c, 0x20,
+	0x01, 0x28, 0x08, 0x52, 0x0d, 0x72, 0x65, 0x6d, 0x6f, 0x74, 0x65, 0x43, 0x6f, 0x6e, 0x73, 0x6f,
+	0x6c, 0x65, 0x12, 0x42, 0x0a, 0x0a, 0x63, 0x69, 0x70, 0x68, 0x65, 0x72, 0x44, 0x61, 0x74, 0x61,
+	0x18, 0x0d, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x22, 0x2e, 0x6f, 0x72, 0x67, 0x2e, 0x6c, 0x66, 0x65,
+	0x64, 0x67, 0x65, 0x2e, 0x65, 0x76, 0x65, 0x2e, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e, 0x43,
+	0x69, 0x70, 0x68, 0x65, 0x72, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x52, 0x0a, 0x63, 0x69, 0x70, 0x68,
+	0x65, 0x72, 0x44, 0x61, 0x74, 0x61, 0x12, 0x2e, 0x0a, 0x12, 0x63, 0x6f, 0x6c, 0x6c, 0x65, 0x63,
+	0x74, 0x53, 0x74, 0x61, 0x74, 0x73, 0x49, 0x50, 0x41, 0x64, 0x64, 0x72, 0x18, 0x0f, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x12, 0x63, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x53, 0x74, 0x61, 0x74, 0x73,
+	0x49, 0x50, 0x41, 0x64, 0x64, 0x72, 0x12, 0x46, 0x0a, 0x0d, 0x76, 0x6f, 0x6c, 0x75, 0x6d, 0x65,
+	0x52, 0x65, 0x66, 0x4c, 0x69, 0x73, 0x74, 0x18, 0x10, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x20, 0x2e,
 	0x6f, 0x72, 0x67, 0x2e, 0x6c, 0x66, 0x65, 0x64, 0x67, 0x65, 0x2e, 0x65, 0x76, 0x65, 0x2e, 0x63,
-	0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e, 0x4d, 0x65, 0x74, 0x61, 0x44, 0x61, 0x74, 0x61, 0x54, 0x79,
-	0x70, 0x65, 0x52, 0x0c, 0x6d, 0x65, 0x74, 0x61, 0x44, 0x61, 0x74, 0x61, 0x54, 0x79, 0x70, 0x65,
-	0x12, 0x21, 0x0a, 0x0c, 0x70, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x5f, 0x6c, 0x69, 0x73, 0x74,
-	0x18, 0x12, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0b, 0x70, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x4c,
-	0x69, 0x73, 0x74, 0x12, 0x33, 0x0a, 0x16, 0x73, 0x74, 0x61, 0x72, 0x74, 0x5f, 0x64, 0x65, 0x6c,
-	0x61, 0x79, 0x5f, 0x69, 0x6e, 0x5f, 0x73, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x18, 0x13, 0x20,
-	0x01, 0x28, 0x0d, 0x52, 0x13, 0x73, 0x74, 0x61, 0x72, 0x74, 0x44, 0x65, 0x6c, 0x61, 0x79, 0x49,
-	0x6e, 0x53, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x22, 0x66, 0x0a, 0x09, 0x56, 0x6f, 0x6c, 0x75,
-	0x6d, 0x65, 0x52, 0x65, 0x66, 0x12, 0x12, 0x0a, 0x04, 0x75, 0x75, 0x69, 0x64, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x04, 0x75, 0x75, 0x69, 0x64, 0x12, 0x28, 0x0a, 0x0f, 0x67, 0x65, 0x6e,
-	0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01,
-	0x28, 0x03, 0x52, 0x0f, 0x67, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x43, 0x6f,
-	0x75, 0x6e, 0x74, 0x12, 0x1b, 0x0a, 0x09, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x5f, 0x64, 0x69, 0x72,
-	0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x44, 0x69, 0x72,
-	0x2a, 0x66, 0x0a, 0x0c, 0x4d, 0x65, 0x74, 0x61, 0x44, 0x61, 0x74, 0x61, 0x54, 0x79, 0x70, 0x65,
-	0x12, 0x11, 0x0a, 0x0d, 0x4d, 0x65, 0x74, 0x61, 0x44, 0x61, 0x74, 0x61, 0x44, 0x72, 0x69, 0x76,
-	0x65, 0x10, 0x00, 0x12, 0x10, 0x0a, 0x0c, 0x4d, 0x65, 0x74, 0x61, 0x44, 0x61, 0x74, 0x61, 0x4e,
-	0x6f, 0x6e, 0x65, 0x10, 0x01, 0x12, 0x15, 0x0a, 0x11, 0x4d, 0x65, 0x74, 0x61, 0x44, 0x61, 0x74,
-	0x61, 0x4f, 0x70, 0x65, 0x6e, 0x53, 0x74, 0x61, 0x63, 0x6b, 0x10, 0x02, 0x12, 0x1a, 0x0a, 0x16,
-	0x4d, 0x65, 0x74, 0x61, 0x44, 0x61, 0x74, 0x61, 0x44, 0x72, 0x69, 0x76, 0x65, 0x4d, 0x75, 0x6c,
-	0x74, 0x69, 0x70, 0x61, 0x72, 0x74, 0x10, 0x03, 0x42, 0x3d, 0x0a, 0x15, 0x6f, 0x72, 0x67, 0x2e,
+	0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e, 0x56, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x52, 0x65, 0x66, 0x52,
+	0x0d, 0x76, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x52, 0x65, 0x66, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x47,
+	0x0a, 0x0c, 0x6d, 0x65, 0x74, 0x61, 0x44, 0x61, 0x74, 0x61, 0x54, 0x79, 0x70, 0x65, 0x18, 0x11,
+	0x20, 0x01, 0x28, 0x0e, 0x32, 0x23, 0x2e, 0x6f, 0x72, 0x67, 0x2e, 0x6c, 0x66, 0x65, 0x64, 0x67,
+	0x65, 0x2e, 0x65, 0x76, 0x65, 0x2e, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e, 0x4d, 0x65, 0x74,
+	0x61, 0x44, 0x61, 0x74, 0x61, 0x54, 0x79, 0x70, 0x65, 0x52, 0x0c, 0x6d, 0x65, 0x74, 0x61, 0x44,
+	0x61, 0x74, 0x61, 0x54, 0x79, 0x70, 0x65, 0x12, 0x21, 0x0a, 0x0c, 0x70, 0x72, 0x6f, 0x66, 0x69,
+	0x6c, 0x65, 0x5f, 0x6c, 0x69, 0x73, 0x74, 0x18, 0x12, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0b, 0x70,
+	0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x33, 0x0a, 0x16, 0x73, 0x74,
+	0x61, 0x72, 0x74, 0x5f, 0x64, 0x65, 0x6c, 0x61, 0x79, 0x5f, 0x69, 0x6e, 0x5f, 0x73, 0x65, 0x63,
+	0x6f, 0x6e, 0x64, 0x73, 0x18, 0x13, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x13, 0x73, 0x74, 0x61, 0x72,
+	0x74, 0x44, 0x65, 0x6c, 0x61, 0x79, 0x49, 0x6e, 0x53, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x12,
+	0x45, 0x0a, 0x0b, 0x73, 0x74, 0x61, 0x72, 0x74, 0x5f, 0x61, 0x66, 0x74, 0x65, 0x72, 0x18, 0x14,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x24, 0x2e, 0x6f, 0x72, 0x67, 0x2e, 0x6c, 0x66, 0x65, 0x64, 0x67,
+	0x65, 0x2e, 0x65, 0x76, 0x65, 0x2e, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e, 0x41, 0x70, 0x70,
+	0x44, 0x65, 0x70, 0x65, 0x6e, 0x64, 0x65, 0x6e, 0x63, 0x79, 0x52, 0x0a, 0x73, 0x74, 0x61, 0x72,
+	0x74, 0x41, 0x66, 0x74, 0x65, 0x72, 0x12, 0x4b, 0x0a, 0x0e, 0x72, 0x65, 0x73, 0x74, 0x61, 0x72,
+	0x74, 0x5f, 0x70, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x18, 0x15, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x24,
+	0x2e, 0x6f, 0x72, 0x67, 0x2e, 0x6c, 0x66, 0x65, 0x64, 0x67, 0x65, 0x2e, 0x65, 0x76, 0x65, 0x2e,
+	0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e, 0x52, 0x65, 0x73, 0x74, 0x61, 0x72, 0x74, 0x50, 0x6f,
+	0x6c, 0x69, 0x63, 0x79, 0x52, 0x0d, 0x72, 0x65, 0x73, 0x74, 0x61, 0x72, 0x74, 0x50, 0x6f, 0x6c,
+	0x69, 0x63, 0x79, 0x12, 0x4c, 0x0a, 0x10, 0x73, 0x65, 0x63, 0x75, 0x72, 0x65, 0x5f, 0x62, 0x6f,
+	0x6f, 0x74, 0x5f, 0x6b, 0x65, 0x79, 0x73, 0x18, 0x16, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x22, 0x2e,
+	0x6f, 0x72, 0x67, 0x2e, 0x6c, 0x66, 0x65, 0x64, 0x67, 0x65, 0x2e, 0x65, 0x76, 0x65, 0x2e, 0x63,
+	0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e, 0x43, 0x69, 0x70, 0x68, 0x65, 0x72, 0x42, 0x6c, 0x6f, 0x63,
+	0x6b, 0x52, 0x0e, 0x73, 0x65, 0x63, 0x75, 0x72, 0x65, 0x42, 0x6f, 0x6f, 0x74, 0x4b, 0x65, 0x79,
+	0x73, 0x12, 0x4a, 0x0a, 0x0e, 0x70, 0x6f, 0x64, 0x5f, 0x63, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e,
+	0x65, 0x72, 0x73, 0x18, 0x17, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x23, 0x2e, 0x6f, 0x72, 0x67, 0x2e,
+	0x6c, 0x66, 0x65, 0x64, 0x67, 0x65, 0x2e, 0x65, 0x76, 0x65, 0x2e, 0x63, 0x6f, 0x6e, 0x66, 0x69,
+	0x67, 0x2e, 0x50, 0x6f, 0x64, 0x43, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x52, 0x0d,
+	0x70, 0x6f, 0x64, 0x43, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x73, 0x12, 0x45, 0x0a,
+	0x0c, 0x68, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x5f, 0x63, 0x68, 0x65, 0x63, 0x6b, 0x18, 0x18, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x22, 0x2e, 0x6f, 0x72, 0x67, 0x2e, 0x6c, 0x66, 0x65, 0x64, 0x67, 0x65,
+	0x2e, 0x65, 0x76, 0x65, 0x2e, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e, 0x48, 0x65, 0x61, 0x6c,
+	0x74, 0x68, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x52, 0x0b, 0x68, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x43,
+	0x68, 0x65, 0x63, 0x6b, 0x12, 0x50, 0x0a, 0x10, 0x63, 0x6f, 0x6e, 0x73, 0x6f, 0x6c, 0x65, 0x5f,
+	0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x19, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x25,
+	0x2e, 0x6f, 0x72, 0x67, 0x2e, 0x6c, 0x66, 0x65, 0x64, 0x67, 0x65, 0x2e, 0x65, 0x76, 0x65, 0x2e,
+	0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e, 0x43, 0x6f, 0x6e, 0x73, 0x6f, 0x6c, 0x65, 0x53, 0x65,
+	0x73, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x0f, 0x63, 0x6f, 0x6e, 0x73, 0x6f, 0x6c, 0x65, 0x53, 0x65,
+	0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x22, 0x89, 0x01, 0x0a, 0x09, 0x56, 0x6f, 0x6c, 0x75, 0x6d,
+	0x65, 0x52, 0x65, 0x66, 0x12, 0x12, 0x0a, 0x04, 0x75, 0x75, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x04, 0x75, 0x75, 0x69, 0x64, 0x12, 0x28, 0x0a, 0x0f, 0x67, 0x65, 0x6e, 0x65,
+	0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x0f, 0x67, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x43, 0x6f, 0x75,
+	0x6e, 0x74, 0x12, 0x1b, 0x0a, 0x09, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x5f, 0x64, 0x69, 0x72, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x44, 0x69, 0x72, 0x12,
+	0x21, 0x0a, 0x0c, 0x73, 0x68, 0x61, 0x72, 0x65, 0x64, 0x5f, 0x6f, 0x77, 0x6e, 0x65, 0x72, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0b, 0x73, 0x68, 0x61, 0x72, 0x65, 0x64, 0x4f, 0x77, 0x6e,
+	0x65, 0x72, 0x2a, 0x66, 0x0a, 0x0c, 0x4d, 0x65, 0x74, 0x61, 0x44, 0x61, 0x74, 0x61, 0x54, 0x79,
+	0x70, 0x65, 0x12, 0x11, 0x0a, 0x0d, 0x4d, 0x65, 0x74, 0x61, 0x44, 0x61, 0x74, 0x61, 0x44, 0x72,
+	0x69, 0x76, 0x65, 0x10, 0x00, 0x12, 0x10, 0x0a, 0x0c, 0x4d, 0x65, 0x74, 0x61, 0x44, 0x61, 0x74,
+	0x61, 0x4e, 0x6f, 0x6e, 0x65, 0x10, 0x01, 0x12, 0x15, 0x0a, 0x11, 0x4d, 0x65, 0x74, 0x61, 0x44,
+	0x61, 0x74, 0x61, 0x4f, 0x70, 0x65, 0x6e, 0x53, 0x74, 0x61, 0x63, 0x6b, 0x10, 0x02, 0x12, 0x1a,
+	0x0a, 0x16, 0x4d, 0x65, 0x74, 0x61, 0x44, 0x61, 0x74, 0x61, 0x44, 0x72, 0x69, 0x76, 0x65, 0x4d,
+	0x75, 0x6c, 0x74, 0x69, 0x70, 0x61, 0x72, 0x74, 0x10, 0x03, 0x2a, 0x65, 0x0a, 0x0c, 0x41, 0x70,
+	0x70, 0x52, 0x65, 0x61, 0x64, 0x69, 0x6e, 0x65, 0x73, 0x73, 0x12, 0x1d, 0x0a, 0x19, 0x41, 0x50,
+	0x50, 0x5f, 0x52, 0x45, 0x41, 0x44, 0x49, 0x4e, 0x45, 0x53, 0x53, 0x5f, 0x55, 0x4e, 0x53, 0x50,
+	0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x19, 0x0a, 0x15, 0x41, 0x50, 0x50,
+	0x5f, 0x52, 0x45, 0x41, 0x44, 0x49, 0x4e, 0x45, 0x53, 0x53, 0x5f, 0x52, 0x55, 0x4e, 0x4e, 0x49,
+	0x4e, 0x47, 0x10, 0x01, 0x12, 0x1b, 0x0a, 0x17, 0x41, 0x50, 0x50, 0x5f, 0x52, 0x45, 0x41, 0x44,
+	0x49, 0x4e, 0x45, 0x53, 0x53, 0x5f, 0x48, 0x45, 0x41, 0x4c, 0x54, 0x48, 0x5f, 0x4f, 0x4b, 0x10,
+	0x02, 0x2a, 0x79, 0x0a, 0x0b, 0x52, 0x65, 0x73, 0x74, 0x61, 0x72, 0x74, 0x4d, 0x6f, 0x64, 0x65,
+	0x12, 0x1c, 0x0a, 0x18, 0x52, 0x45, 0x53, 0x54, 0x41, 0x52, 0x54, 0x5f, 0x4d, 0x4f, 0x44, 0x45,
+	0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x16,
+	0x0a, 0x12, 0x52, 0x45, 0x53, 0x54, 0x41, 0x52, 0x54, 0x5f, 0x4d, 0x4f, 0x44, 0x45, 0x5f, 0x4e,
+	0x45, 0x56, 0x45, 0x52, 0x10, 0x01, 0x12, 0x1b, 0x0a, 0x17, 0x52, 0x45, 0x53, 0x54, 0x41, 0x52,
+	0x54, 0x5f, 0x4d, 0x4f, 0x44, 0x45, 0x5f, 0x4f, 0x4e, 0x5f, 0x46, 0x41, 0x49, 0x4c, 0x55, 0x52,
+	0x45, 0x10, 0x02, 0x12, 0x17, 0x0a, 0x13, 0x52, 0x45, 0x53, 0x54, 0x41, 0x52, 0x54, 0x5f, 0x4d,
+	0x4f, 0x44, 0x45, 0x5f, 0x41, 0x4c, 0x57, 0x41, 0x59, 0x53, 0x10, 0x03, 0x2a, 0x9d, 0x01, 0x0a,
+	0x0f, 0x48, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x54, 0x79, 0x70, 0x65,
+	0x12, 0x1b, 0x0a, 0x17, 0x48, 0x45, 0x41, 0x4c, 0x54, 0x48, 0x5f, 0x43, 0x48, 0x45, 0x43, 0x4b,
+	0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x49, 0x4d, 0x41, 0x47, 0x45, 0x10, 0x00, 0x12, 0x1a, 0x0a,
+	0x16, 0x48, 0x45, 0x41, 0x4c, 0x54, 0x48, 0x5f, 0x43, 0x48, 0x45, 0x43, 0x4b, 0x5f, 0x54, 0x59,
+	0x50, 0x45, 0x5f, 0x4e, 0x4f, 0x4e, 0x45, 0x10, 0x01, 0x12, 0x1a, 0x0a, 0x16, 0x48, 0x45, 0x41,
+	0x4c, 0x54, 0x48, 0x5f, 0x43, 0x48, 0x45, 0x43, 0x4b, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x45,
+	0x58, 0x45, 0x43, 0x10, 0x02, 0x12, 0x19, 0x0a, 0x15, 0x48, 0x45, 0x41, 0x4c, 0x54, 0x48, 0x5f,
+	0x43, 0x48, 0x45, 0x43, 0x4b, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x54, 0x43, 0x50, 0x10, 0x03,
+	0x12, 0x1a, 0x0a, 0x16, 0x48, 0x45, 0x41, 0x4c, 0x54, 0x48, 0x5f, 0x43, 0x48, 0x45, 0x43, 0x4b,
+	0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x48, 0x54, 0x54, 0x50, 0x10, 0x04, 0x2a, 0x5a, 0x0a, 0x0b,
+	0x43, 0x6f, 0x6e, 0x73, 0x6f, 0x6c, 0x65, 0x54, 0x79, 0x70, 0x65, 0x12, 0x1c, 0x0a, 0x18, 0x43,
+	0x4f, 0x4e, 0x53, 0x4f, 0x4c, 0x45, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x55, 0x4e, 0x53, 0x50,
+	0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x14, 0x0a, 0x10, 0x43, 0x4f, 0x4e,
+	0x53, 0x4f, 0x4c, 0x45, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x56, 0x4e, 0x43, 0x10, 0x01, 0x12,
+	0x17, 0x0a, 0x13, 0x43, 0x4f, 0x4e, 0x53, 0x4f, 0x4c, 0x45, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f,
+	0x53, 0x45, 0x52, 0x49, 0x41, 0x4c, 0x10, 0x02, 0x42, 0x3d, 0x0a, 0x15, 0x6f, 0x72, 0x67, 0x2e,
 	0x6c, 0x66, 0x65, 0x64, 0x67, 0x65, 0x2e, 0x65, 0x76, 0x65, 0x2e, 0x63, 0x6f, 0x6e, 0x66, 0x69,
 	0x67, 0x5a, 0x24, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x6c, 0x66,
 	0x2d, 0x65, 0x64, 0x67, 0x65, 0x2f, 0x65, 0x76, 0x65, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x67, 0x6f,
@@ -549,36 +1562,62 @@ func file_config_appconfig_proto_rawDescGZIP() []byte {
 	return file_config_appconfig_proto_rawDescData
 }
 
-var file_config_appconfig_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
-var file_config_appconfig_proto_msgTypes = make([]protoimpl.MessageInfo, 3)
+var file_config_appconfig_proto_enumTypes = make([]protoimpl.EnumInfo, 5)
+var file_config_appconfig_proto_msgTypes = make([]protoimpl.MessageInfo, 10)
 var file_config_appconfig_proto_goTypes = []interface{}{
-	(MetaDataType)(0),         // 0: org.lfedge.eve.config.MetaDataType
-	(*InstanceOpsCmd)(nil),    // 1: org.lfedge.eve.config.InstanceOpsCmd
-	(*AppInstanceConfig)(nil), // 2: org.lfedge.eve.config.AppInstanceConfig
-	(*VolumeRef)(nil),         // 3: org.lfedge.eve.config.VolumeRef
-	(*UUIDandVersion)(nil),    // 4: org.lfedge.eve.config.UUIDandVersion
-	(*VmConfig)(nil),          // 5: org.lfedge.eve.config.VmConfig
-	(*Drive)(nil),             // 6: org.lfedge.eve.config.Drive
-	(*NetworkAdapter)(nil),    // 7: org.lfedge.eve.config.NetworkAdapter
-	(*Adapter)(nil),           // 8: org.lfedge.eve.config.Adapter
-	(*CipherBlock)(nil),       // 9: org.lfedge.eve.config.CipherBlock
+	(MetaDataType)(0),             // 0: org.lfedge.eve.config.MetaDataType
+	(AppReadiness)(0),             // 1: org.lfedge.eve.config.AppReadiness
+	(RestartMode)(0),              // 2: org.lfedge.eve.config.RestartMode
+	(HealthCheckType)(0),          // 3: org.lfedge.eve.config.HealthCheckType
+	(ConsoleType)(0),              // 4: org.lfedge.eve.config.ConsoleType
+	(*InstanceOpsCmd)(nil),        // 5: org.lfedge.eve.config.InstanceOpsCmd
+	(*AppDependency)(nil),         // 6: org.lfedge.eve.config.AppDependency
+	(*RestartPolicy)(nil),         // 7: org.lfedge.eve.config.RestartPolicy
+	(*PodMount)(nil),              // 8: org.lfedge.eve.config.PodMount
+	(*PodContainer)(nil),          // 9: org.lfedge.eve.config.PodContainer
+	(*HealthCheck)(nil),           // 10: org.lfedge.eve.config.HealthCheck
+	(*ConsoleSession)(nil),        // 11: org.lfedge.eve.config.ConsoleSession
+	(*AppInstanceConfig)(nil),     // 12: org.lfedge.eve.config.AppInstanceConfig
+	(*VolumeRef)(nil),             // 13: org.lfedge.eve.config.VolumeRef
+	nil,                           // 14: org.lfedge.eve.config.PodContainer.EnvEntry
+	(*timestamppb.Timestamp)(nil), // 15: google.protobuf.Timestamp
+	(*UUIDandVersion)(nil),        // 16: org.lfedge.eve.config.UUIDandVersion
+	(*VmConfig)(nil),              // 17: org.lfedge.eve.config.VmConfig
+	(*Drive)(nil),                 // 18: org.lfedge.eve.config.Drive
+	(*NetworkAdapter)(nil),        // 19: org.lfedge.eve.config.NetworkAdapter
+	(*Adapter)(nil),               // 20: org.lfedge.eve.config.Adapter
+	(*CipherBlock)(nil),           // 21: org.lfedge.eve.config.CipherBlock
 }
 var file_config_appconfig_proto_depIdxs = []int32{
-	4,  // 0: org.lfedge.eve.config.AppInstanceConfig.uuidandversion:type_name -> org.lfedge.eve.config.UUIDandVersion
-	5,  // 1: org.lfedge.eve.config.AppInstanceConfig.fixedresources:type_name -> org.lfedge.eve.config.VmConfig
-	6,  // 2: org.lfedge.eve.config.AppInstanceConfig.drives:type_name -> org.lfedge.eve.config.Drive
-	7,  // 3: org.lfedge.eve.config.AppInstanceConfig.interfaces:type_name -> org.lfedge.eve.config.NetworkAdapter
-	8,  // 4: org.lfedge.eve.config.AppInstanceConfig.adapters:type_name -> org.lfedge.eve.config.Adapter
-	1,  // 5: org.lfedge.eve.config.AppInstanceConfig.restart:type_name -> org.lfedge.eve.config.InstanceOpsCmd
-	1,  // 6: org.lfedge.eve.config.AppInstanceConfig.purge:type_name -> org.lfedge.eve.config.InstanceOpsCmd
-	9,  // 7: org.lfedge.eve.config.AppInstanceConfig.cipherData:type_name -> org.lfedge.eve.config.CipherBlock
-	3,  // 8: org.lfedge.eve.config.AppInstanceConfig.volumeRefList:type_name -> org.lfedge.eve.config.VolumeRef
-	0,  // 9: org.lfedge.eve.config.AppInstanceConfig.metaDataType:type_name -> org.lfedge.eve.config.MetaDataType
-	10, // [10:10] is the sub-list for method output_type
-	10, // [10:10] is the sub-list for method input_type
-	10, // [10:10] is the sub-list for extension type_name
-	10, // [10:10] is the sub-list for extension extendee
-	0,  // [0:10] is the sub-list for field type_name
+	1,  // 0: org.lfedge.eve.config.AppDependency.readiness:type_name -> org.lfedge.eve.config.AppReadiness
+	2,  // 1: org.lfedge.eve.config.RestartPolicy.mode:type_name -> org.lfedge.eve.config.RestartMode
+	14, // 2: org.lfedge.eve.config.PodContainer.env:type_name -> org.lfedge.eve.config.PodContainer.EnvEntry
+	2,  // 3: org.lfedge.eve.config.PodContainer.restart_mode:type_name -> org.lfedge.eve.config.RestartMode
+	8,  // 4: org.lfedge.eve.config.PodContainer.mounts:type_name -> org.lfedge.eve.config.PodMount
+	3,  // 5: org.lfedge.eve.config.HealthCheck.type:type_name -> org.lfedge.eve.config.HealthCheckType
+	4,  // 6: org.lfedge.eve.config.ConsoleSession.type:type_name -> org.lfedge.eve.config.ConsoleType
+	15, // 7: org.lfedge.eve.config.ConsoleSession.not_after:type_name -> google.protobuf.Timestamp
+	16, // 8: org.lfedge.eve.config.AppInstanceConfig.uuidandversion:type_name -> org.lfedge.eve.config.UUIDandVersion
+	17, // 9: org.lfedge.eve.config.AppInstanceConfig.fixedresources:type_name -> org.lfedge.eve.config.VmConfig
+	18, // 10: org.lfedge.eve.config.AppInstanceConfig.drives:type_name -> org.lfedge.eve.config.Drive
+	19, // 11: org.lfedge.eve.config.AppInstanceConfig.interfaces:type_name -> org.lfedge.eve.config.NetworkAdapter
+	20, // 12: org.lfedge.eve.config.AppInstanceConfig.adapters:type_name -> org.lfedge.eve.config.Adapter
+	5,  // 13: org.lfedge.eve.config.AppInstanceConfig.restart:type_name -> org.lfedge.eve.config.InstanceOpsCmd
+	5,  // 14: org.lfedge.eve.config.AppInstanceConfig.purge:type_name -> org.lfedge.eve.config.InstanceOpsCmd
+	21, // 15: org.lfedge.eve.config.AppInstanceConfig.cipherData:type_name -> org.lfedge.eve.config.CipherBlock
+	13, // 16: org.lfedge.eve.config.AppInstanceConfig.volumeRefList:type_name -> org.lfedge.eve.config.VolumeRef
+	0,  // 17: org.lfedge.eve.config.AppInstanceConfig.metaDataType:type_name -> org.lfedge.eve.config.MetaDataType
+	6,  // 18: org.lfedge.eve.config.AppInstanceConfig.start_after:type_name -> org.lfedge.eve.config.AppDependency
+	7,  // 19: org.lfedge.eve.config.AppInstanceConfig.restart_policy:type_name -> org.lfedge.eve.config.RestartPolicy
+	21, // 20: org.lfedge.eve.config.AppInstanceConfig.secure_boot_keys:type_name -> org.lfedge.eve.config.CipherBlock
+	9,  // 21: org.lfedge.eve.config.AppInstanceConfig.pod_containers:type_name -> org.lfedge.eve.config.PodContainer
+	10, // 22: org.lfedge.eve.config.AppInstanceConfig.health_check:type_name -> org.lfedge.eve.config.HealthCheck
+	11, // 23: org.lfedge.eve.config.AppInstanceConfig.console_sessions:type_name -> org.lfedge.eve.config.ConsoleSession
+	24, // [24:24] is the sub-list for method output_type
+	24, // [24:24] is the sub-list for method input_type
+	24, // [24:24] is the sub-list for extension type_name
+	24, // [24:24] is the sub-list for extension extendee
+	0,  // [0:24] is the sub-list for field type_name
 }
 
 func init() { file_config_appconfig_proto_init() }
@@ -605,7 +1644,7 @@ func file_config_appconfig_proto_init() {
 			}
 		}
 		file_config_appconfig_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*AppInstanceConfig); i {
+			switch v := v.(*AppDependency); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -617,6 +1656,78 @@ func file_config_appconfig_proto_init() {
 			}
 		}
 		file_config_appconfig_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RestartPolicy); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_config_appconfig_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PodMount); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_config_appconfig_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PodContainer); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_config_appconfig_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*HealthCheck); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_config_appconfig_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ConsoleSession); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_config_appconfig_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AppInstanceConfig); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_config_appconfig_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*VolumeRef); i {
 			case 0:
 				return &v.state
@@ -634,8 +1745,8 @@ func file_config_appconfig_proto_init() {
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_config_appconfig_proto_rawDesc,
-			NumEnums:      1,
-			NumMessages:   3,
+			NumEnums:      5,
+			NumMessages:   10,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
@@ -114,6 +114,12 @@ type BaseOSConfig struct {
 	Activate      bool     `protobuf:"varint,4,opt,name=activate,proto3" json:"activate,omitempty"`
 	BaseOSVersion string   `protobuf:"bytes,10,opt,name=baseOSVersion,proto3" json:"baseOSVersion,omitempty"` // deprecated 11; OSVerDetails baseOSDetails
 	VolumeID      string   `protobuf:"bytes,12,opt,name=volumeID,proto3" json:"volumeID,omitempty"`           // UUID for Volume with BaseOS image
+	// Prefetch the drives ahead of the activation of this version
+	Schedule *ContentSchedule `protobuf:"bytes,13,opt,name=schedule,proto3" json:"schedule,omitempty"`
+	// Optional binary diff of the image of the drives against the image
+	// which runs on the device. It is downloaded instead of the image
+	// when it applies; the image is downloaded if the delta fails.
+	Delta *BaseOSDelta `protobuf:"bytes,14,opt,name=delta,proto3" json:"delta,omitempty"`
 }
 
 func (x *BaseOSConfig) Reset() {
@@ -183,6 +189,93 @@ func (x *BaseOSConfig) GetVolumeID() string {
 	return ""
 }
 
+func (x *BaseOSConfig) GetSchedule() *ContentSchedule {
+	if x != nil {
+		return x.Schedule
+	}
+	return nil
+}
+
+func (x *BaseOSConfig) GetDelta() *BaseOSDelta {
+	if x != nil {
+		return x.Delta
+	}
+	return nil
+}
+
+// BaseOSDelta is a bsdiff (BSDIFF40) patch which turns the image of the
+// running base OS into the image of a new version
+type BaseOSDelta struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Drive         *Drive `protobuf:"bytes,1,opt,name=drive,proto3" json:"drive,omitempty"`                                         // the patch
+	BaseSha256    string `protobuf:"bytes,2,opt,name=base_sha256,json=baseSha256,proto3" json:"base_sha256,omitempty"`             // sha256 of the image the patch applies to
+	BaseSizeBytes uint64 `protobuf:"varint,3,opt,name=base_size_bytes,json=baseSizeBytes,proto3" json:"base_size_bytes,omitempty"` // size of the image the patch applies to
+	ImageSha256   string `protobuf:"bytes,4,opt,name=image_sha256,json=imageSha256,proto3" json:"image_sha256,omitempty"`          // sha256 of the patched image
+}
+
+func (x *BaseOSDelta) Reset() {
+	*x = BaseOSDelta{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_config_baseosconfig_proto_msgTypes[3]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BaseOSDelta) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BaseOSDelta) ProtoMessage() {}
+
+func (x *BaseOSDelta) ProtoReflect() protoreflect.Message {
+	mi := &file_config_baseosconfig_proto_msgTypes[3]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BaseOSDelta.ProtoReflect.Descriptor instead.
+func (*BaseOSDelta) Descriptor() ([]byte, []int) {
+	return file_config_baseosconfig_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *BaseOSDelta) GetDrive() *Drive {
+	if x != nil {
+		return x.Drive
+	}
+	return nil
+}
+
+func (x *BaseOSDelta) GetBaseSha256() string {
+	if x != nil {
+		return x.BaseSha256
+	}
+	return ""
+}
+
+func (x *BaseOSDelta) GetBaseSizeBytes() uint64 {
+	if x != nil {
+		return x.BaseSizeBytes
+	}
+	return 0
+}
+
+func (x *BaseOSDelta) GetImageSha256() string {
+	if x != nil {
+		return x.ImageSha256
+	}
+	return ""
+}
+
 type BaseOS struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -204,7 +297,7 @@ type BaseOS struct {
 func (x *BaseOS) Reset() {
 	*x = BaseOS{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_config_baseosconfig_proto_msgTypes[3]
+		mi := &file_config_baseosconfig_proto_msgTypes[4]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -217,7 +310,7 @@ func (x *BaseOS) String() string {
 func (*BaseOS) ProtoMessage() {}
 
 func (x *BaseOS) ProtoReflect() protoreflect.Message {
-	mi := &file_config_baseosconfig_proto_msgTypes[3]
+	mi := &file_config_baseosconfig_proto_msgTypes[4]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -230,7 +323,7 @@ func (x *BaseOS) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use BaseOS.ProtoReflect.Descriptor instead.
 func (*BaseOS) Descriptor() ([]byte, []int) {
-	return file_config_baseosconfig_proto_rawDescGZIP(), []int{3}
+	return file_config_baseosconfig_proto_rawDescGZIP(), []int{4}
 }
 
 func (x *BaseOS) GetContentTreeUuid() string {
@@ -256,36 +349,56 @@ var file_config_baseosconfig_proto_rawDesc = []byte{
 	0x69, 0x67, 0x1a, 0x16, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2f, 0x64, 0x65, 0x76, 0x63, 0x6f,
 	0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x14, 0x63, 0x6f, 0x6e, 0x66,
 	0x69, 0x67, 0x2f, 0x73, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
-	0x22, 0x0b, 0x0a, 0x09, 0x4f, 0x53, 0x4b, 0x65, 0x79, 0x54, 0x61, 0x67, 0x73, 0x22, 0x0e, 0x0a,
-	0x0c, 0x4f, 0x53, 0x56, 0x65, 0x72, 0x44, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x73, 0x22, 0xf1, 0x01,
-	0x0a, 0x0c, 0x42, 0x61, 0x73, 0x65, 0x4f, 0x53, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x4d,
-	0x0a, 0x0e, 0x75, 0x75, 0x69, 0x64, 0x61, 0x6e, 0x64, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x25, 0x2e, 0x6f, 0x72, 0x67, 0x2e, 0x6c, 0x66, 0x65,
-	0x64, 0x67, 0x65, 0x2e, 0x65, 0x76, 0x65, 0x2e, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e, 0x55,
-	0x55, 0x49, 0x44, 0x61, 0x6e, 0x64, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x0e, 0x75,
-	0x75, 0x69, 0x64, 0x61, 0x6e, 0x64, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x34, 0x0a,
-	0x06, 0x64, 0x72, 0x69, 0x76, 0x65, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1c, 0x2e,
-	0x6f, 0x72, 0x67, 0x2e, 0x6c, 0x66, 0x65, 0x64, 0x67, 0x65, 0x2e, 0x65, 0x76, 0x65, 0x2e, 0x63,
-	0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e, 0x44, 0x72, 0x69, 0x76, 0x65, 0x52, 0x06, 0x64, 0x72, 0x69,
-	0x76, 0x65, 0x73, 0x12, 0x1a, 0x0a, 0x08, 0x61, 0x63, 0x74, 0x69, 0x76, 0x61, 0x74, 0x65, 0x18,
-	0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x61, 0x63, 0x74, 0x69, 0x76, 0x61, 0x74, 0x65, 0x12,
-	0x24, 0x0a, 0x0d, 0x62, 0x61, 0x73, 0x65, 0x4f, 0x53, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e,
-	0x18, 0x0a, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x62, 0x61, 0x73, 0x65, 0x4f, 0x53, 0x56, 0x65,
-	0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x1a, 0x0a, 0x08, 0x76, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x49,
-	0x44, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x76, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x49,
-	0x44, 0x22, 0x7c, 0x0a, 0x06, 0x42, 0x61, 0x73, 0x65, 0x4f, 0x53, 0x12, 0x2a, 0x0a, 0x11, 0x63,
-	0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x5f, 0x74, 0x72, 0x65, 0x65, 0x5f, 0x75, 0x75, 0x69, 0x64,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0f, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x54,
-	0x72, 0x65, 0x65, 0x55, 0x75, 0x69, 0x64, 0x12, 0x46, 0x0a, 0x0c, 0x72, 0x65, 0x74, 0x72, 0x79,
-	0x5f, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x23, 0x2e,
+	0x1a, 0x15, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2f, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c,
+	0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x0b, 0x0a, 0x09, 0x4f, 0x53, 0x4b, 0x65, 0x79,
+	0x54, 0x61, 0x67, 0x73, 0x22, 0x0e, 0x0a, 0x0c, 0x4f, 0x53, 0x56, 0x65, 0x72, 0x44, 0x65, 0x74,
+	0x61, 0x69, 0x6c, 0x73, 0x22, 0xef, 0x02, 0x0a, 0x0c, 0x42, 0x61, 0x73, 0x65, 0x4f, 0x53, 0x43,
+	0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x4d, 0x0a, 0x0e, 0x75, 0x75, 0x69, 0x64, 0x61, 0x6e, 0x64,
+	0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x25, 0x2e,
 	0x6f, 0x72, 0x67, 0x2e, 0x6c, 0x66, 0x65, 0x64, 0x67, 0x65, 0x2e, 0x65, 0x76, 0x65, 0x2e, 0x63,
-	0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e, 0x44, 0x65, 0x76, 0x69, 0x63, 0x65, 0x4f, 0x70, 0x73, 0x43,
-	0x6d, 0x64, 0x52, 0x0b, 0x72, 0x65, 0x74, 0x72, 0x79, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x42,
-	0x3d, 0x0a, 0x15, 0x6f, 0x72, 0x67, 0x2e, 0x6c, 0x66, 0x65, 0x64, 0x67, 0x65, 0x2e, 0x65, 0x76,
-	0x65, 0x2e, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x5a, 0x24, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62,
-	0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x6c, 0x66, 0x2d, 0x65, 0x64, 0x67, 0x65, 0x2f, 0x65, 0x76, 0x65,
-	0x2f, 0x61, 0x70, 0x69, 0x2f, 0x67, 0x6f, 0x2f, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x62, 0x06,
-	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e, 0x55, 0x55, 0x49, 0x44, 0x61, 0x6e, 0x64, 0x56, 0x65, 0x72,
+	0x73, 0x69, 0x6f, 0x6e, 0x52, 0x0e, 0x75, 0x75, 0x69, 0x64, 0x61, 0x6e, 0x64, 0x76, 0x65, 0x72,
+	0x73, 0x69, 0x6f, 0x6e, 0x12, 0x34, 0x0a, 0x06, 0x64, 0x72, 0x69, 0x76, 0x65, 0x73, 0x18, 0x03,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x6f, 0x72, 0x67, 0x2e, 0x6c, 0x66, 0x65, 0x64, 0x67,
+	0x65, 0x2e, 0x65, 0x76, 0x65, 0x2e, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e, 0x44, 0x72, 0x69,
+	0x76, 0x65, 0x52, 0x06, 0x64, 0x72, 0x69, 0x76, 0x65, 0x73, 0x12, 0x1a, 0x0a, 0x08, 0x61, 0x63,
+	0x74, 0x69, 0x76, 0x61, 0x74, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x61, 0x63,
+	0x74, 0x69, 0x76, 0x61, 0x74, 0x65, 0x12, 0x24, 0x0a, 0x0d, 0x62, 0x61, 0x73, 0x65, 0x4f, 0x53,
+	0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x62,
+	0x61, 0x73, 0x65, 0x4f, 0x53, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x1a, 0x0a, 0x08,
+	0x76, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x49, 0x44, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08,
+	0x76, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x49, 0x44, 0x12, 0x42, 0x0a, 0x08, 0x73, 0x63, 0x68, 0x65,
+	0x64, 0x75, 0x6c, 0x65, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x26, 0x2e, 0x6f, 0x72, 0x67,
+	0x2e, 0x6c, 0x66, 0x65, 0x64, 0x67, 0x65, 0x2e, 0x65, 0x76, 0x65, 0x2e, 0x63, 0x6f, 0x6e, 0x66,
+	0x69, 0x67, 0x2e, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75,
+	0x6c, 0x65, 0x52, 0x08, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x12, 0x38, 0x0a, 0x05,
+	0x64, 0x65, 0x6c, 0x74, 0x61, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x22, 0x2e, 0x6f, 0x72,
+	0x67, 0x2e, 0x6c, 0x66, 0x65, 0x64, 0x67, 0x65, 0x2e, 0x65, 0x76, 0x65, 0x2e, 0x63, 0x6f, 0x6e,
+	0x66, 0x69, 0x67, 0x2e, 0x42, 0x61, 0x73, 0x65, 0x4f, 0x53, 0x44, 0x65, 0x6c, 0x74, 0x61, 0x52,
+	0x05, 0x64, 0x65, 0x6c, 0x74, 0x61, 0x22, 0xad, 0x01, 0x0a, 0x0b, 0x42, 0x61, 0x73, 0x65, 0x4f,
+	0x53, 0x44, 0x65, 0x6c, 0x74, 0x61, 0x12, 0x32, 0x0a, 0x05, 0x64, 0x72, 0x69, 0x76, 0x65, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x6f, 0x72, 0x67, 0x2e, 0x6c, 0x66, 0x65, 0x64,
+	0x67, 0x65, 0x2e, 0x65, 0x76, 0x65, 0x2e, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e, 0x44, 0x72,
+	0x69, 0x76, 0x65, 0x52, 0x05, 0x64, 0x72, 0x69, 0x76, 0x65, 0x12, 0x1f, 0x0a, 0x0b, 0x62, 0x61,
+	0x73, 0x65, 0x5f, 0x73, 0x68, 0x61, 0x32, 0x35, 0x36, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0a, 0x62, 0x61, 0x73, 0x65, 0x53, 0x68, 0x61, 0x32, 0x35, 0x36, 0x12, 0x26, 0x0a, 0x0f, 0x62,
+	0x61, 0x73, 0x65, 0x5f, 0x73, 0x69, 0x7a, 0x65, 0x5f, 0x62, 0x79, 0x74, 0x65, 0x73, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x04, 0x52, 0x0d, 0x62, 0x61, 0x73, 0x65, 0x53, 0x69, 0x7a, 0x65, 0x42, 0x79,
+	0x74, 0x65, 0x73, 0x12, 0x21, 0x0a, 0x0c, 0x69, 0x6d, 0x61, 0x67, 0x65, 0x5f, 0x73, 0x68, 0x61,
+	0x32, 0x35, 0x36, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x69, 0x6d, 0x61, 0x67, 0x65,
+	0x53, 0x68, 0x61, 0x32, 0x35, 0x36, 0x22, 0x7c, 0x0a, 0x06, 0x42, 0x61, 0x73, 0x65, 0x4f, 0x53,
+	0x12, 0x2a, 0x0a, 0x11, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x5f, 0x74, 0x72, 0x65, 0x65,
+	0x5f, 0x75, 0x75, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0f, 0x63, 0x6f, 0x6e,
+	0x74, 0x65, 0x6e, 0x74, 0x54, 0x72, 0x65, 0x65, 0x55, 0x75, 0x69, 0x64, 0x12, 0x46, 0x0a, 0x0c,
+	0x72, 0x65, 0x74, 0x72, 0x79, 0x5f, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x23, 0x2e, 0x6f, 0x72, 0x67, 0x2e, 0x6c, 0x66, 0x65, 0x64, 0x67, 0x65, 0x2e,
+	0x65, 0x76, 0x65, 0x2e, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e, 0x44, 0x65, 0x76, 0x69, 0x63,
+	0x65, 0x4f, 0x70, 0x73, 0x43, 0x6d, 0x64, 0x52, 0x0b, 0x72, 0x65, 0x74, 0x72, 0x79, 0x55, 0x70,
+	0x64, 0x61, 0x74, 0x65, 0x42, 0x3d, 0x0a, 0x15, 0x6f, 0x72, 0x67, 0x2e, 0x6c, 0x66, 0x65, 0x64,
+	0x67, 0x65, 0x2e, 0x65, 0x76, 0x65, 0x2e, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x5a, 0x24, 0x67,
+	0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x6c, 0x66, 0x2d, 0x65, 0x64, 0x67,
+	0x65, 0x2f, 0x65, 0x76, 0x65, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x67, 0x6f, 0x2f, 0x63, 0x6f, 0x6e,
+	0x66, 0x69, 0x67, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -300,25 +413,30 @@ func file_config_baseosconfig_proto_rawDescGZIP() []byte {
 	return file_config_baseosconfig_proto_rawDescData
 }
 
-var file_config_baseosconfig_proto_msgTypes = make([]protoimpl.MessageInfo, 4)
+var file_config_baseosconfig_proto_msgTypes = make([]protoimpl.MessageInfo, 5)
 var file_config_baseosconfig_proto_goTypes = []interface{}{
-	(*OSKeyTags)(nil),      // 0: org.lfedge.eve.config.OSKeyTags
-	(*OSVerDetails)(nil),   // 1: org.lfedge.eve.config.OSVerDetails
-	(*BaseOSConfig)(nil),   // 2: org.lfedge.eve.config.BaseOSConfig
-	(*BaseOS)(nil),         // 3: org.lfedge.eve.config.BaseOS
-	(*UUIDandVersion)(nil), // 4: org.lfedge.eve.config.UUIDandVersion
-	(*Drive)(nil),          // 5: org.lfedge.eve.config.Drive
-	(*DeviceOpsCmd)(nil),   // 6: org.lfedge.eve.config.DeviceOpsCmd
+	(*OSKeyTags)(nil),       // 0: org.lfedge.eve.config.OSKeyTags
+	(*OSVerDetails)(nil),    // 1: org.lfedge.eve.config.OSVerDetails
+	(*BaseOSConfig)(nil),    // 2: org.lfedge.eve.config.BaseOSConfig
+	(*BaseOSDelta)(nil),     // 3: org.lfedge.eve.config.BaseOSDelta
+	(*BaseOS)(nil),          // 4: org.lfedge.eve.config.BaseOS
+	(*UUIDandVersion)(nil),  // 5: org.lfedge.eve.config.UUIDandVersion
+	(*Drive)(nil),           // 6: org.lfedge.eve.config.Drive
+	(*ContentSchedule)(nil), // 7: org.lfedge.eve.config.ContentSchedule
+	(*DeviceOpsCmd)(nil),    // 8: org.lfedge.eve.config.DeviceOpsCmd
 }
 var file_config_baseosconfig_proto_depIdxs = []int32{
-	4, // 0: org.lfedge.eve.config.BaseOSConfig.uuidandversion:type_name -> org.lfedge.eve.config.UUIDandVersion
-	5, // 1: org.lfedge.eve.config.BaseOSConfig.drives:type_name -> org.lfedge.eve.config.Drive
-	6, // 2: org.lfedge.eve.config.BaseOS.retry_update:type_name -> org.lfedge.eve.config.DeviceOpsCmd
-	3, // [3:3] is the sub-list for method output_type
-	3, // [3:3] is the sub-list for method input_type
-	3, // [3:3] is the sub-list for extension type_name
-	3, // [3:3] is the sub-list for extension extendee
-	0, // [0:3] is the sub-list for field type_name
+	5, // 0: org.lfedge.eve.config.BaseOSConfig.uuidandversion:type_name -> org.lfedge.eve.config.UUIDandVersion
+	6, // 1: org.lfedge.eve.config.BaseOSConfig.drives:type_name -> org.lfedge.eve.config.Drive
+	7, // 2: org.lfedge.eve.config.BaseOSConfig.schedule:type_name -> org.lfedge.eve.config.ContentSchedule
+	3, // 3: org.lfedge.eve.config.BaseOSConfig.delta:type_name -> org.lfedge.eve.config.BaseOSDelta
+	6, // 4: org.lfedge.eve.config.BaseOSDelta.drive:type_name -> org.lfedge.eve.config.Drive
+	8, // 5: org.lfedge.eve.config.BaseOS.retry_update:type_name -> org.lfedge.eve.config.DeviceOpsCmd
+	6, // [6:6] is the sub-list for method output_type
+	6, // [6:6] is the sub-list for method input_type
+	6, // [6:6] is the sub-list for extension type_name
+	6, // [6:6] is the sub-list for extension extendee
+	0, // [0:6] is the sub-list for field type_name
 }
 
 func init() { file_config_baseosconfig_proto_init() }
@@ -328,6 +446,7 @@ func file_config_baseosconfig_proto_init() {
 	}
 	file_config_devcommon_proto_init()
 	file_config_storage_proto_init()
+	file_config_schedule_proto_init()
 	if !protoimpl.UnsafeEnabled {
 		file_config_baseosconfig_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*OSKeyTags); i {
@@ -366,6 +485,18 @@ func file_config_baseosconfig_proto_init() {
 			}
 		}
 		file_config_baseosconfig_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BaseOSDelta); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_config_baseosconfig_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*BaseOS); i {
 			case 0:
 				return &v.state
@@ -384,7 +515,7 @@ func file_config_baseosconfig_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_config_baseosconfig_proto_rawDesc,
 			NumEnums:      0,
-			NumMessages:   4,
+			NumMessages:   5,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
@@ -0,0 +1,192 @@
+// Copyright(c) 2022 Zededa, Inc.
+// All rights reserved.
+
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.26.0
+// 	protoc        v3.18.1
+// source: config/localoperations.proto
+
+package config
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// LocalOperationsPolicy sets which operations a Local profile server may
+// request using the api/v1/localconfig API.
+// Nothing is permitted when the policy is not set.
+// When an operation is no longer permitted, the local changes made using
+// it are reverted.
+type LocalOperationsPolicy struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Activate and deactivate app instances
+	AppActivate bool `protobuf:"varint,1,opt,name=app_activate,json=appActivate,proto3" json:"app_activate,omitempty"`
+	// Keys of the configuration properties which may be overridden,
+	// e.g., "timer.metric.interval"
+	GlobalSettings []string `protobuf:"bytes,2,rep,name=global_settings,json=globalSettings,proto3" json:"global_settings,omitempty"`
+	// Add, change and remove port maps of app instances
+	PortMaps bool `protobuf:"varint,3,opt,name=port_maps,json=portMaps,proto3" json:"port_maps,omitempty"`
+	// Update EVE from a datastore on the local network
+	BaseOsUpdate bool `protobuf:"varint,4,opt,name=base_os_update,json=baseOsUpdate,proto3" json:"base_os_update,omitempty"`
+}
+
+func (x *LocalOperationsPolicy) Reset() {
+	*x = LocalOperationsPolicy{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_config_localoperations_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *LocalOperationsPolicy) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LocalOperationsPolicy) ProtoMessage() {}
+
+func (x *LocalOperationsPolicy) ProtoReflect() protoreflect.Message {
+	mi := &file_config_localoperations_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LocalOperationsPolicy.ProtoReflect.Descriptor instead.
+func (*LocalOperationsPolicy) Descriptor() ([]byte, []int) {
+	return file_config_localoperations_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *LocalOperationsPolicy) GetAppActivate() bool {
+	if x != nil {
+		return x.AppActivate
+	}
+	return false
+}
+
+func (x *LocalOperationsPolicy) GetGlobalSettings() []string {
+	if x != nil {
+		return x.GlobalSettings
+	}
+	return nil
+}
+
+func (x *LocalOperationsPolicy) GetPortMaps() bool {
+	if x != nil {
+		return x.PortMaps
+	}
+	return false
+}
+
+func (x *LocalOperationsPolicy) GetBaseOsUpdate() bool {
+	if x != nil {
+		return x.BaseOsUpdate
+	}
+	return false
+}
+
+var File_config_localoperations_proto protoreflect.FileDescriptor
+
+var file_config_localoperations_proto_rawDesc = []byte{
+	0x0a, 0x1c, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2f, 0x6c, 0x6f, 0x63, 0x61, 0x6c, 0x6f, 0x70,
+	0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x15,
+	0x6f, 0x72, 0x67, 0x2e, 0x6c, 0x66, 0x65, 0x64, 0x67, 0x65, 0x2e, 0x65, 0x76, 0x65, 0x2e, 0x63,
+	0x6f, 0x6e, 0x66, 0x69, 0x67, 0x22, 0xa6, 0x01, 0x0a, 0x15, 0x4c, 0x6f, 0x63, 0x61, 0x6c, 0x4f,
+	0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x12,
+	0x21, 0x0a, 0x0c, 0x61, 0x70, 0x70, 0x5f, 0x61, 0x63, 0x74, 0x69, 0x76, 0x61, 0x74, 0x65, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0b, 0x61, 0x70, 0x70, 0x41, 0x63, 0x74, 0x69, 0x76, 0x61,
+	0x74, 0x65, 0x12, 0x27, 0x0a, 0x0f, 0x67, 0x6c, 0x6f, 0x62, 0x61, 0x6c, 0x5f, 0x73, 0x65, 0x74,
+	0x74, 0x69, 0x6e, 0x67, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0e, 0x67, 0x6c, 0x6f,
+	0x62, 0x61, 0x6c, 0x53, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x73, 0x12, 0x1b, 0x0a, 0x09, 0x70,
+	0x6f, 0x72, 0x74, 0x5f, 0x6d, 0x61, 0x70, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08,
+	0x70, 0x6f, 0x72, 0x74, 0x4d, 0x61, 0x70, 0x73, 0x12, 0x24, 0x0a, 0x0e, 0x62, 0x61, 0x73, 0x65,
+	0x5f, 0x6f, 0x73, 0x5f, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08,
+	0x52, 0x0c, 0x62, 0x61, 0x73, 0x65, 0x4f, 0x73, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x42, 0x3d,
+	0x0a, 0x15, 0x6f, 0x72, 0x67, 0x2e, 0x6c, 0x66, 0x65, 0x64, 0x67, 0x65, 0x2e, 0x65, 0x76, 0x65,
+	0x2e, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x5a, 0x24, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e,
+	0x63, 0x6f, 0x6d, 0x2f, 0x6c, 0x66, 0x2d, 0x65, 0x64, 0x67, 0x65, 0x2f, 0x65, 0x76, 0x65, 0x2f,
+	0x61, 0x70, 0x69, 0x2f, 0x67, 0x6f, 0x2f, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x62, 0x06, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_config_localoperations_proto_rawDescOnce sync.Once
+	file_config_localoperations_proto_rawDescData = file_config_localoperations_proto_rawDesc
+)
+
+func file_config_localoperations_proto_rawDescGZIP() []byte {
+	file_config_localoperations_proto_rawDescOnce.Do(func() {
+		file_config_localoperations_proto_rawDescData = protoimpl.X.CompressGZIP(file_config_localoperations_proto_rawDescData)
+	})
+	return file_config_localoperations_proto_rawDescData
+}
+
+var file_config_localoperations_proto_msgTypes = make([]protoimpl.MessageInfo, 1)
+var file_config_localoperations_proto_goTypes = []interface{}{
+	(*LocalOperationsPolicy)(nil), // 0: org.lfedge.eve.config.LocalOperationsPolicy
+}
+var file_config_localoperations_proto_depIdxs = []int32{
+	0, // [0:0] is the sub-list for method output_type
+	0, // [0:0] is the sub-list for method input_type
+	0, // [0:0] is the sub-list for extension type_name
+	0, // [0:0] is the sub-list for extension extendee
+	0, // [0:0] is the sub-list for field type_name
+}
+
+func init() { file_config_localoperations_proto_init() }
+func file_config_localoperations_proto_init() {
+	if File_config_localoperations_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_config_localoperations_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*LocalOperationsPolicy); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_config_localoperations_proto_rawDesc,
+			NumEnums:      0,
+			NumMessages:   1,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_config_localoperations_proto_goTypes,
+		DependencyIndexes: file_config_localoperations_proto_depIdxs,
+		MessageInfos:      file_config_localoperations_proto_msgTypes,
+	}.Build()
+	File_config_localoperations_proto = out.File
+	file_config_localoperations_proto_rawDesc = nil
+	file_config_localoperations_proto_goTypes = nil
+	file_config_localoperations_proto_depIdxs = nil
+}
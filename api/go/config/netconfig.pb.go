@@ -23,6 +23,69 @@ const (
 	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
 )
 
+// Class of traffic which can be restricted from using a cellular connection
+// when a data quota is running out.
+// Management traffic between EVE and the controller (config, info messages,
+// certificates, attestation) is never restricted.
+type CellularTrafficClass int32
+
+const (
+	CellularTrafficClass_CELLULAR_TRAFFIC_CLASS_UNSPECIFIED CellularTrafficClass = 0
+	// Logs uploaded to the controller, including flow logs.
+	CellularTrafficClass_CELLULAR_TRAFFIC_CLASS_LOGS CellularTrafficClass = 1
+	// Device and application metrics.
+	CellularTrafficClass_CELLULAR_TRAFFIC_CLASS_METRICS CellularTrafficClass = 2
+	// Downloads of base OS and application images.
+	CellularTrafficClass_CELLULAR_TRAFFIC_CLASS_DOWNLOADS CellularTrafficClass = 3
+	// Traffic of applications forwarded by the device.
+	CellularTrafficClass_CELLULAR_TRAFFIC_CLASS_APPS CellularTrafficClass = 4
+)
+
+// Enum value maps for CellularTrafficClass.
+var (
+	CellularTrafficClass_name = map[int32]string{
+		0: "CELLULAR_TRAFFIC_CLASS_UNSPECIFIED",
+		1: "CELLULAR_TRAFFIC_CLASS_LOGS",
+		2: "CELLULAR_TRAFFIC_CLASS_METRICS",
+		3: "CELLULAR_TRAFFIC_CLASS_DOWNLOADS",
+		4: "CELLULAR_TRAFFIC_CLASS_APPS",
+	}
+	CellularTrafficClass_value = map[string]int32{
+		"CELLULAR_TRAFFIC_CLASS_UNSPECIFIED": 0,
+		"CELLULAR_TRAFFIC_CLASS_LOGS":        1,
+		"CELLULAR_TRAFFIC_CLASS_METRICS":     2,
+		"CELLULAR_TRAFFIC_CLASS_DOWNLOADS":   3,
+		"CELLULAR_TRAFFIC_CLASS_APPS":        4,
+	}
+)
+
+func (x CellularTrafficClass) Enum() *CellularTrafficClass {
+	p := new(CellularTrafficClass)
+	*p = x
+	return p
+}
+
+func (x CellularTrafficClass) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (CellularTrafficClass) Descriptor() protoreflect.EnumDescriptor {
+	return file_config_netconfig_proto_enumTypes[0].Descriptor()
+}
+
+func (CellularTrafficClass) Type() protoreflect.EnumType {
+	return &file_config_netconfig_proto_enumTypes[0]
+}
+
+func (x CellularTrafficClass) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use CellularTrafficClass.Descriptor instead.
+func (CellularTrafficClass) EnumDescriptor() ([]byte, []int) {
+	return file_config_netconfig_proto_rawDescGZIP(), []int{0}
+}
+
 type NetworkConfig struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -326,6 +389,12 @@ type CellularConfig struct {
 	// Enable this option to have location info periodically obtained from this
 	// modem and published to controller and to applications.
 	LocationTracking bool `protobuf:"varint,3,opt,name=location_tracking,json=locationTracking,proto3" json:"location_tracking,omitempty"`
+	// Per-SIM settings for modems with multiple SIM slots.
+	// If empty, the modem uses whatever SIM slot is currently active.
+	// The first profile is the primary one.
+	SimProfiles []*CellularSimProfile `protobuf:"bytes,4,rep,name=sim_profiles,json=simProfiles,proto3" json:"sim_profiles,omitempty"`
+	// Failover between SIM profiles (see sim_profiles).
+	Failover *CellularFailover `protobuf:"bytes,5,opt,name=failover,proto3" json:"failover,omitempty"`
 }
 
 func (x *CellularConfig) Reset() {
@@ -381,6 +450,259 @@ func (x *CellularConfig) GetLocationTracking() bool {
 	return false
 }
 
+func (x *CellularConfig) GetSimProfiles() []*CellularSimProfile {
+	if x != nil {
+		return x.SimProfiles
+	}
+	return nil
+}
+
+func (x *CellularConfig) GetFailover() *CellularFailover {
+	if x != nil {
+		return x.Failover
+	}
+	return nil
+}
+
+// CellularDataQuota limits the amount of data transferred (Rx+Tx) using a SIM card
+// in one billing cycle.
+// Data usage is accounted by EVE from the modem packet counters and persisted
+// across reboots, hence it can differ from the usage accounted by the operator.
+type CellularDataQuota struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Quota in bytes for one billing cycle. Zero means no quota.
+	LimitBytes uint64 `protobuf:"varint,1,opt,name=limit_bytes,json=limitBytes,proto3" json:"limit_bytes,omitempty"`
+	// Day of the month (1-31) when a billing cycle starts (at midnight UTC).
+	// For months with less days the cycle starts on the last day of the month.
+	// Default is 1.
+	BillingCycleDay uint32 `protobuf:"varint,2,opt,name=billing_cycle_day,json=billingCycleDay,proto3" json:"billing_cycle_day,omitempty"`
+	// Percentage of limit_bytes after which only the traffic classes listed
+	// in soft_threshold_allowed can use the SIM card.
+	// Default is 80.
+	SoftThresholdPercent uint32 `protobuf:"varint,3,opt,name=soft_threshold_percent,json=softThresholdPercent,proto3" json:"soft_threshold_percent,omitempty"`
+	// Traffic classes which can still use the SIM card after the soft threshold
+	// was crossed.
+	SoftThresholdAllowed []CellularTrafficClass `protobuf:"varint,4,rep,packed,name=soft_threshold_allowed,json=softThresholdAllowed,proto3,enum=org.lfedge.eve.config.CellularTrafficClass" json:"soft_threshold_allowed,omitempty"`
+	// Traffic classes which can still use the SIM card after the quota was exhausted.
+	HardThresholdAllowed []CellularTrafficClass `protobuf:"varint,5,rep,packed,name=hard_threshold_allowed,json=hardThresholdAllowed,proto3,enum=org.lfedge.eve.config.CellularTrafficClass" json:"hard_threshold_allowed,omitempty"`
+}
+
+func (x *CellularDataQuota) Reset() {
+	*x = CellularDataQuota{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_config_netconfig_proto_msgTypes[4]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CellularDataQuota) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CellularDataQuota) ProtoMessage() {}
+
+func (x *CellularDataQuota) ProtoReflect() protoreflect.Message {
+	mi := &file_config_netconfig_proto_msgTypes[4]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CellularDataQuota.ProtoReflect.Descriptor instead.
+func (*CellularDataQuota) Descriptor() ([]byte, []int) {
+	return file_config_netconfig_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *CellularDataQuota) GetLimitBytes() uint64 {
+	if x != nil {
+		return x.LimitBytes
+	}
+	return 0
+}
+
+func (x *CellularDataQuota) GetBillingCycleDay() uint32 {
+	if x != nil {
+		return x.BillingCycleDay
+	}
+	return 0
+}
+
+func (x *CellularDataQuota) GetSoftThresholdPercent() uint32 {
+	if x != nil {
+		return x.SoftThresholdPercent
+	}
+	return 0
+}
+
+func (x *CellularDataQuota) GetSoftThresholdAllowed() []CellularTrafficClass {
+	if x != nil {
+		return x.SoftThresholdAllowed
+	}
+	return nil
+}
+
+func (x *CellularDataQuota) GetHardThresholdAllowed() []CellularTrafficClass {
+	if x != nil {
+		return x.HardThresholdAllowed
+	}
+	return nil
+}
+
+// CellularSimProfile : settings for one SIM slot of a cellular modem.
+type CellularSimProfile struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// SIM slot number, starting with 1.
+	SimSlot uint32 `protobuf:"varint,1,opt,name=sim_slot,json=simSlot,proto3" json:"sim_slot,omitempty"`
+	// APN to use with this SIM card. If empty, CellularConfig.APN is used.
+	Apn string `protobuf:"bytes,2,opt,name=apn,proto3" json:"apn,omitempty"`
+	// Optional data quota of the SIM card.
+	Quota *CellularDataQuota `protobuf:"bytes,3,opt,name=quota,proto3" json:"quota,omitempty"`
+}
+
+func (x *CellularSimProfile) Reset() {
+	*x = CellularSimProfile{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_config_netconfig_proto_msgTypes[5]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CellularSimProfile) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CellularSimProfile) ProtoMessage() {}
+
+func (x *CellularSimProfile) ProtoReflect() protoreflect.Message {
+	mi := &file_config_netconfig_proto_msgTypes[5]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CellularSimProfile.ProtoReflect.Descriptor instead.
+func (*CellularSimProfile) Descriptor() ([]byte, []int) {
+	return file_config_netconfig_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *CellularSimProfile) GetSimSlot() uint32 {
+	if x != nil {
+		return x.SimSlot
+	}
+	return 0
+}
+
+func (x *CellularSimProfile) GetApn() string {
+	if x != nil {
+		return x.Apn
+	}
+	return ""
+}
+
+func (x *CellularSimProfile) GetQuota() *CellularDataQuota {
+	if x != nil {
+		return x.Quota
+	}
+	return nil
+}
+
+// CellularFailover : switching between SIM profiles of a dual-SIM modem.
+type CellularFailover struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Enable failover to the next SIM profile.
+	Enable bool `protobuf:"varint,1,opt,name=enable,proto3" json:"enable,omitempty"`
+	// Switch to the next SIM profile when the connectivity probe keeps failing
+	// for this many seconds. Zero means the default of 900 seconds.
+	// Requires connectivity probing to be enabled.
+	ProbeFailureTimeout uint32 `protobuf:"varint,2,opt,name=probe_failure_timeout,json=probeFailureTimeout,proto3" json:"probe_failure_timeout,omitempty"`
+	// Switch to the next SIM profile when the quota of the current one is exhausted.
+	OnQuotaExhausted bool `protobuf:"varint,3,opt,name=on_quota_exhausted,json=onQuotaExhausted,proto3" json:"on_quota_exhausted,omitempty"`
+	// Try to revert to the primary SIM profile after this many seconds.
+	// Zero means to stay with the backup SIM profile until it fails as well.
+	RevertInterval uint32 `protobuf:"varint,4,opt,name=revert_interval,json=revertInterval,proto3" json:"revert_interval,omitempty"`
+}
+
+func (x *CellularFailover) Reset() {
+	*x = CellularFailover{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_config_netconfig_proto_msgTypes[6]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CellularFailover) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CellularFailover) ProtoMessage() {}
+
+func (x *CellularFailover) ProtoReflect() protoreflect.Message {
+	mi := &file_config_netconfig_proto_msgTypes[6]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CellularFailover.ProtoReflect.Descriptor instead.
+func (*CellularFailover) Descriptor() ([]byte, []int) {
+	return file_config_netconfig_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *CellularFailover) GetEnable() bool {
+	if x != nil {
+		return x.Enable
+	}
+	return false
+}
+
+func (x *CellularFailover) GetProbeFailureTimeout() uint32 {
+	if x != nil {
+		return x.ProbeFailureTimeout
+	}
+	return 0
+}
+
+func (x *CellularFailover) GetOnQuotaExhausted() bool {
+	if x != nil {
+		return x.OnQuotaExhausted
+	}
+	return false
+}
+
+func (x *CellularFailover) GetRevertInterval() uint32 {
+	if x != nil {
+		return x.RevertInterval
+	}
+	return 0
+}
+
 // CellularConnectivityProbe is used to periodically check the connectivity status of a cellular network
 // by probing a remote endpoint.
 // Whenever the probe fails, the cellular connection is automatically restarted. If the probe keeps failing
@@ -401,7 +723,7 @@ type CellularConnectivityProbe struct {
 func (x *CellularConnectivityProbe) Reset() {
 	*x = CellularConnectivityProbe{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_config_netconfig_proto_msgTypes[4]
+		mi := &file_config_netconfig_proto_msgTypes[7]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -414,7 +736,7 @@ func (x *CellularConnectivityProbe) String() string {
 func (*CellularConnectivityProbe) ProtoMessage() {}
 
 func (x *CellularConnectivityProbe) ProtoReflect() protoreflect.Message {
-	mi := &file_config_netconfig_proto_msgTypes[4]
+	mi := &file_config_netconfig_proto_msgTypes[7]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -427,7 +749,7 @@ func (x *CellularConnectivityProbe) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CellularConnectivityProbe.ProtoReflect.Descriptor instead.
 func (*CellularConnectivityProbe) Descriptor() ([]byte, []int) {
-	return file_config_netconfig_proto_rawDescGZIP(), []int{4}
+	return file_config_netconfig_proto_rawDescGZIP(), []int{7}
 }
 
 func (x *CellularConnectivityProbe) GetDisable() bool {
@@ -463,7 +785,7 @@ type WifiConfig struct {
 func (x *WifiConfig) Reset() {
 	*x = WifiConfig{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_config_netconfig_proto_msgTypes[5]
+		mi := &file_config_netconfig_proto_msgTypes[8]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -476,7 +798,7 @@ func (x *WifiConfig) String() string {
 func (*WifiConfig) ProtoMessage() {}
 
 func (x *WifiConfig) ProtoReflect() protoreflect.Message {
-	mi := &file_config_netconfig_proto_msgTypes[5]
+	mi := &file_config_netconfig_proto_msgTypes[8]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -489,7 +811,7 @@ func (x *WifiConfig) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use WifiConfig.ProtoReflect.Descriptor instead.
 func (*WifiConfig) Descriptor() ([]byte, []int) {
-	return file_config_netconfig_proto_rawDescGZIP(), []int{5}
+	return file_config_netconfig_proto_rawDescGZIP(), []int{8}
 }
 
 func (x *WifiConfig) GetWifiSSID() string {
@@ -553,7 +875,7 @@ type WifiConfigCryptoblock struct {
 func (x *WifiConfigCryptoblock) Reset() {
 	*x = WifiConfigCryptoblock{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_config_netconfig_proto_msgTypes[6]
+		mi := &file_config_netconfig_proto_msgTypes[9]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -566,7 +888,7 @@ func (x *WifiConfigCryptoblock) String() string {
 func (*WifiConfigCryptoblock) ProtoMessage() {}
 
 func (x *WifiConfigCryptoblock) ProtoReflect() protoreflect.Message {
-	mi := &file_config_netconfig_proto_msgTypes[6]
+	mi := &file_config_netconfig_proto_msgTypes[9]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -579,7 +901,7 @@ func (x *WifiConfigCryptoblock) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use WifiConfigCryptoblock.ProtoReflect.Descriptor instead.
 func (*WifiConfigCryptoblock) Descriptor() ([]byte, []int) {
-	return file_config_netconfig_proto_rawDescGZIP(), []int{5, 0}
+	return file_config_netconfig_proto_rawDescGZIP(), []int{8, 0}
 }
 
 func (x *WifiConfigCryptoblock) GetIdentity() string {
@@ -662,7 +984,7 @@ var file_config_netconfig_proto_rawDesc = []byte{
 	0x77, 0x69, 0x66, 0x69, 0x43, 0x66, 0x67, 0x18, 0x0a, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x21, 0x2e,
 	0x6f, 0x72, 0x67, 0x2e, 0x6c, 0x66, 0x65, 0x64, 0x67, 0x65, 0x2e, 0x65, 0x76, 0x65, 0x2e, 0x63,
 	0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e, 0x57, 0x69, 0x66, 0x69, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67,
-	0x52, 0x07, 0x77, 0x69, 0x66, 0x69, 0x43, 0x66, 0x67, 0x22, 0x97, 0x01, 0x0a, 0x0e, 0x43, 0x65,
+	0x52, 0x07, 0x77, 0x69, 0x66, 0x69, 0x43, 0x66, 0x67, 0x22, 0xaa, 0x02, 0x0a, 0x0e, 0x43, 0x65,
 	0x6c, 0x6c, 0x75, 0x6c, 0x61, 0x72, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x10, 0x0a, 0x03,
 	0x41, 0x50, 0x4e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x41, 0x50, 0x4e, 0x12, 0x46,
 	0x0a, 0x05, 0x70, 0x72, 0x6f, 0x62, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x30, 0x2e,
@@ -672,42 +994,106 @@ var file_config_netconfig_proto_rawDesc = []byte{
 	0x05, 0x70, 0x72, 0x6f, 0x62, 0x65, 0x12, 0x2b, 0x0a, 0x11, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69,
 	0x6f, 0x6e, 0x5f, 0x74, 0x72, 0x61, 0x63, 0x6b, 0x69, 0x6e, 0x67, 0x18, 0x03, 0x20, 0x01, 0x28,
 	0x08, 0x52, 0x10, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x72, 0x61, 0x63, 0x6b,
-	0x69, 0x6e, 0x67, 0x22, 0x5a, 0x0a, 0x19, 0x43, 0x65, 0x6c, 0x6c, 0x75, 0x6c, 0x61, 0x72, 0x43,
-	0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x69, 0x76, 0x69, 0x74, 0x79, 0x50, 0x72, 0x6f, 0x62, 0x65,
-	0x12, 0x18, 0x0a, 0x07, 0x64, 0x69, 0x73, 0x61, 0x62, 0x6c, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x08, 0x52, 0x07, 0x64, 0x69, 0x73, 0x61, 0x62, 0x6c, 0x65, 0x12, 0x23, 0x0a, 0x0d, 0x70, 0x72,
-	0x6f, 0x62, 0x65, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x0c, 0x70, 0x72, 0x6f, 0x62, 0x65, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x22,
-	0x92, 0x03, 0x0a, 0x0a, 0x57, 0x69, 0x66, 0x69, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x1a,
-	0x0a, 0x08, 0x77, 0x69, 0x66, 0x69, 0x53, 0x53, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x08, 0x77, 0x69, 0x66, 0x69, 0x53, 0x53, 0x49, 0x44, 0x12, 0x42, 0x0a, 0x09, 0x6b, 0x65,
-	0x79, 0x53, 0x63, 0x68, 0x65, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x24, 0x2e,
-	0x6f, 0x72, 0x67, 0x2e, 0x6c, 0x66, 0x65, 0x64, 0x67, 0x65, 0x2e, 0x65, 0x76, 0x65, 0x2e, 0x63,
-	0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e, 0x57, 0x69, 0x46, 0x69, 0x4b, 0x65, 0x79, 0x53, 0x63, 0x68,
-	0x65, 0x6d, 0x65, 0x52, 0x09, 0x6b, 0x65, 0x79, 0x53, 0x63, 0x68, 0x65, 0x6d, 0x65, 0x12, 0x1a,
-	0x0a, 0x08, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x08, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x61,
-	0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x70, 0x61,
-	0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x12, 0x45, 0x0a, 0x06, 0x63, 0x72, 0x79, 0x70, 0x74, 0x6f,
-	0x18, 0x14, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2d, 0x2e, 0x6f, 0x72, 0x67, 0x2e, 0x6c, 0x66, 0x65,
-	0x64, 0x67, 0x65, 0x2e, 0x65, 0x76, 0x65, 0x2e, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e, 0x57,
-	0x69, 0x66, 0x69, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e, 0x63, 0x72, 0x79, 0x70, 0x74, 0x6f,
-	0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x52, 0x06, 0x63, 0x72, 0x79, 0x70, 0x74, 0x6f, 0x12, 0x1a, 0x0a,
-	0x08, 0x70, 0x72, 0x69, 0x6f, 0x72, 0x69, 0x74, 0x79, 0x18, 0x19, 0x20, 0x01, 0x28, 0x05, 0x52,
-	0x08, 0x70, 0x72, 0x69, 0x6f, 0x72, 0x69, 0x74, 0x79, 0x12, 0x42, 0x0a, 0x0a, 0x63, 0x69, 0x70,
-	0x68, 0x65, 0x72, 0x44, 0x61, 0x74, 0x61, 0x18, 0x1e, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x22, 0x2e,
-	0x6f, 0x72, 0x67, 0x2e, 0x6c, 0x66, 0x65, 0x64, 0x67, 0x65, 0x2e, 0x65, 0x76, 0x65, 0x2e, 0x63,
-	0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e, 0x43, 0x69, 0x70, 0x68, 0x65, 0x72, 0x42, 0x6c, 0x6f, 0x63,
-	0x6b, 0x52, 0x0a, 0x63, 0x69, 0x70, 0x68, 0x65, 0x72, 0x44, 0x61, 0x74, 0x61, 0x1a, 0x45, 0x0a,
-	0x0b, 0x63, 0x72, 0x79, 0x70, 0x74, 0x6f, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x12, 0x1a, 0x0a, 0x08,
-	0x69, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08,
+	0x69, 0x6e, 0x67, 0x12, 0x4c, 0x0a, 0x0c, 0x73, 0x69, 0x6d, 0x5f, 0x70, 0x72, 0x6f, 0x66, 0x69,
+	0x6c, 0x65, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x29, 0x2e, 0x6f, 0x72, 0x67, 0x2e,
+	0x6c, 0x66, 0x65, 0x64, 0x67, 0x65, 0x2e, 0x65, 0x76, 0x65, 0x2e, 0x63, 0x6f, 0x6e, 0x66, 0x69,
+	0x67, 0x2e, 0x43, 0x65, 0x6c, 0x6c, 0x75, 0x6c, 0x61, 0x72, 0x53, 0x69, 0x6d, 0x50, 0x72, 0x6f,
+	0x66, 0x69, 0x6c, 0x65, 0x52, 0x0b, 0x73, 0x69, 0x6d, 0x50, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65,
+	0x73, 0x12, 0x43, 0x0a, 0x08, 0x66, 0x61, 0x69, 0x6c, 0x6f, 0x76, 0x65, 0x72, 0x18, 0x05, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x27, 0x2e, 0x6f, 0x72, 0x67, 0x2e, 0x6c, 0x66, 0x65, 0x64, 0x67, 0x65,
+	0x2e, 0x65, 0x76, 0x65, 0x2e, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e, 0x43, 0x65, 0x6c, 0x6c,
+	0x75, 0x6c, 0x61, 0x72, 0x46, 0x61, 0x69, 0x6c, 0x6f, 0x76, 0x65, 0x72, 0x52, 0x08, 0x66, 0x61,
+	0x69, 0x6c, 0x6f, 0x76, 0x65, 0x72, 0x22, 0xdc, 0x02, 0x0a, 0x11, 0x43, 0x65, 0x6c, 0x6c, 0x75,
+	0x6c, 0x61, 0x72, 0x44, 0x61, 0x74, 0x61, 0x51, 0x75, 0x6f, 0x74, 0x61, 0x12, 0x1f, 0x0a, 0x0b,
+	0x6c, 0x69, 0x6d, 0x69, 0x74, 0x5f, 0x62, 0x79, 0x74, 0x65, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x04, 0x52, 0x0a, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x42, 0x79, 0x74, 0x65, 0x73, 0x12, 0x2a, 0x0a,
+	0x11, 0x62, 0x69, 0x6c, 0x6c, 0x69, 0x6e, 0x67, 0x5f, 0x63, 0x79, 0x63, 0x6c, 0x65, 0x5f, 0x64,
+	0x61, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0f, 0x62, 0x69, 0x6c, 0x6c, 0x69, 0x6e,
+	0x67, 0x43, 0x79, 0x63, 0x6c, 0x65, 0x44, 0x61, 0x79, 0x12, 0x34, 0x0a, 0x16, 0x73, 0x6f, 0x66,
+	0x74, 0x5f, 0x74, 0x68, 0x72, 0x65, 0x73, 0x68, 0x6f, 0x6c, 0x64, 0x5f, 0x70, 0x65, 0x72, 0x63,
+	0x65, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x14, 0x73, 0x6f, 0x66, 0x74, 0x54,
+	0x68, 0x72, 0x65, 0x73, 0x68, 0x6f, 0x6c, 0x64, 0x50, 0x65, 0x72, 0x63, 0x65, 0x6e, 0x74, 0x12,
+	0x61, 0x0a, 0x16, 0x73, 0x6f, 0x66, 0x74, 0x5f, 0x74, 0x68, 0x72, 0x65, 0x73, 0x68, 0x6f, 0x6c,
+	0x64, 0x5f, 0x61, 0x6c, 0x6c, 0x6f, 0x77, 0x65, 0x64, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0e, 0x32,
+	0x2b, 0x2e, 0x6f, 0x72, 0x67, 0x2e, 0x6c, 0x66, 0x65, 0x64, 0x67, 0x65, 0x2e, 0x65, 0x76, 0x65,
+	0x2e, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e, 0x43, 0x65, 0x6c, 0x6c, 0x75, 0x6c, 0x61, 0x72,
+	0x54, 0x72, 0x61, 0x66, 0x66, 0x69, 0x63, 0x43, 0x6c, 0x61, 0x73, 0x73, 0x52, 0x14, 0x73, 0x6f,
+	0x66, 0x74, 0x54, 0x68, 0x72, 0x65, 0x73, 0x68, 0x6f, 0x6c, 0x64, 0x41, 0x6c, 0x6c, 0x6f, 0x77,
+	0x65, 0x64, 0x12, 0x61, 0x0a, 0x16, 0x68, 0x61, 0x72, 0x64, 0x5f, 0x74, 0x68, 0x72, 0x65, 0x73,
+	0x68, 0x6f, 0x6c, 0x64, 0x5f, 0x61, 0x6c, 0x6c, 0x6f, 0x77, 0x65, 0x64, 0x18, 0x05, 0x20, 0x03,
+	0x28, 0x0e, 0x32, 0x2b, 0x2e, 0x6f, 0x72, 0x67, 0x2e, 0x6c, 0x66, 0x65, 0x64, 0x67, 0x65, 0x2e,
+	0x65, 0x76, 0x65, 0x2e, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e, 0x43, 0x65, 0x6c, 0x6c, 0x75,
+	0x6c, 0x61, 0x72, 0x54, 0x72, 0x61, 0x66, 0x66, 0x69, 0x63, 0x43, 0x6c, 0x61, 0x73, 0x73, 0x52,
+	0x14, 0x68, 0x61, 0x72, 0x64, 0x54, 0x68, 0x72, 0x65, 0x73, 0x68, 0x6f, 0x6c, 0x64, 0x41, 0x6c,
+	0x6c, 0x6f, 0x77, 0x65, 0x64, 0x22, 0x81, 0x01, 0x0a, 0x12, 0x43, 0x65, 0x6c, 0x6c, 0x75, 0x6c,
+	0x61, 0x72, 0x53, 0x69, 0x6d, 0x50, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x12, 0x19, 0x0a, 0x08,
+	0x73, 0x69, 0x6d, 0x5f, 0x73, 0x6c, 0x6f, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x07,
+	0x73, 0x69, 0x6d, 0x53, 0x6c, 0x6f, 0x74, 0x12, 0x10, 0x0a, 0x03, 0x61, 0x70, 0x6e, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x61, 0x70, 0x6e, 0x12, 0x3e, 0x0a, 0x05, 0x71, 0x75, 0x6f,
+	0x74, 0x61, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x28, 0x2e, 0x6f, 0x72, 0x67, 0x2e, 0x6c,
+	0x66, 0x65, 0x64, 0x67, 0x65, 0x2e, 0x65, 0x76, 0x65, 0x2e, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67,
+	0x2e, 0x43, 0x65, 0x6c, 0x6c, 0x75, 0x6c, 0x61, 0x72, 0x44, 0x61, 0x74, 0x61, 0x51, 0x75, 0x6f,
+	0x74, 0x61, 0x52, 0x05, 0x71, 0x75, 0x6f, 0x74, 0x61, 0x22, 0xb5, 0x01, 0x0a, 0x10, 0x43, 0x65,
+	0x6c, 0x6c, 0x75, 0x6c, 0x61, 0x72, 0x46, 0x61, 0x69, 0x6c, 0x6f, 0x76, 0x65, 0x72, 0x12, 0x16,
+	0x0a, 0x06, 0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06,
+	0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x12, 0x32, 0x0a, 0x15, 0x70, 0x72, 0x6f, 0x62, 0x65, 0x5f,
+	0x66, 0x61, 0x69, 0x6c, 0x75, 0x72, 0x65, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x13, 0x70, 0x72, 0x6f, 0x62, 0x65, 0x46, 0x61, 0x69, 0x6c,
+	0x75, 0x72, 0x65, 0x54, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x12, 0x2c, 0x0a, 0x12, 0x6f, 0x6e,
+	0x5f, 0x71, 0x75, 0x6f, 0x74, 0x61, 0x5f, 0x65, 0x78, 0x68, 0x61, 0x75, 0x73, 0x74, 0x65, 0x64,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x10, 0x6f, 0x6e, 0x51, 0x75, 0x6f, 0x74, 0x61, 0x45,
+	0x78, 0x68, 0x61, 0x75, 0x73, 0x74, 0x65, 0x64, 0x12, 0x27, 0x0a, 0x0f, 0x72, 0x65, 0x76, 0x65,
+	0x72, 0x74, 0x5f, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x76, 0x61, 0x6c, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x0d, 0x52, 0x0e, 0x72, 0x65, 0x76, 0x65, 0x72, 0x74, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x76, 0x61,
+	0x6c, 0x22, 0x5a, 0x0a, 0x19, 0x43, 0x65, 0x6c, 0x6c, 0x75, 0x6c, 0x61, 0x72, 0x43, 0x6f, 0x6e,
+	0x6e, 0x65, 0x63, 0x74, 0x69, 0x76, 0x69, 0x74, 0x79, 0x50, 0x72, 0x6f, 0x62, 0x65, 0x12, 0x18,
+	0x0a, 0x07, 0x64, 0x69, 0x73, 0x61, 0x62, 0x6c, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52,
+	0x07, 0x64, 0x69, 0x73, 0x61, 0x62, 0x6c, 0x65, 0x12, 0x23, 0x0a, 0x0d, 0x70, 0x72, 0x6f, 0x62,
+	0x65, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0c, 0x70, 0x72, 0x6f, 0x62, 0x65, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x22, 0x92, 0x03,
+	0x0a, 0x0a, 0x57, 0x69, 0x66, 0x69, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x1a, 0x0a, 0x08,
+	0x77, 0x69, 0x66, 0x69, 0x53, 0x53, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08,
+	0x77, 0x69, 0x66, 0x69, 0x53, 0x53, 0x49, 0x44, 0x12, 0x42, 0x0a, 0x09, 0x6b, 0x65, 0x79, 0x53,
+	0x63, 0x68, 0x65, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x24, 0x2e, 0x6f, 0x72,
+	0x67, 0x2e, 0x6c, 0x66, 0x65, 0x64, 0x67, 0x65, 0x2e, 0x65, 0x76, 0x65, 0x2e, 0x63, 0x6f, 0x6e,
+	0x66, 0x69, 0x67, 0x2e, 0x57, 0x69, 0x46, 0x69, 0x4b, 0x65, 0x79, 0x53, 0x63, 0x68, 0x65, 0x6d,
+	0x65, 0x52, 0x09, 0x6b, 0x65, 0x79, 0x53, 0x63, 0x68, 0x65, 0x6d, 0x65, 0x12, 0x1a, 0x0a, 0x08,
+	0x69, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08,
 	0x69, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x61, 0x73, 0x73,
-	0x77, 0x6f, 0x72, 0x64, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x70, 0x61, 0x73, 0x73,
-	0x77, 0x6f, 0x72, 0x64, 0x42, 0x3d, 0x0a, 0x15, 0x6f, 0x72, 0x67, 0x2e, 0x6c, 0x66, 0x65, 0x64,
-	0x67, 0x65, 0x2e, 0x65, 0x76, 0x65, 0x2e, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x5a, 0x24, 0x67,
-	0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x6c, 0x66, 0x2d, 0x65, 0x64, 0x67,
-	0x65, 0x2f, 0x65, 0x76, 0x65, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x67, 0x6f, 0x2f, 0x63, 0x6f, 0x6e,
-	0x66, 0x69, 0x67, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x77, 0x6f, 0x72, 0x64, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x70, 0x61, 0x73, 0x73,
+	0x77, 0x6f, 0x72, 0x64, 0x12, 0x45, 0x0a, 0x06, 0x63, 0x72, 0x79, 0x70, 0x74, 0x6f, 0x18, 0x14,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x2d, 0x2e, 0x6f, 0x72, 0x67, 0x2e, 0x6c, 0x66, 0x65, 0x64, 0x67,
+	0x65, 0x2e, 0x65, 0x76, 0x65, 0x2e, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e, 0x57, 0x69, 0x66,
+	0x69, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e, 0x63, 0x72, 0x79, 0x70, 0x74, 0x6f, 0x62, 0x6c,
+	0x6f, 0x63, 0x6b, 0x52, 0x06, 0x63, 0x72, 0x79, 0x70, 0x74, 0x6f, 0x12, 0x1a, 0x0a, 0x08, 0x70,
+	0x72, 0x69, 0x6f, 0x72, 0x69, 0x74, 0x79, 0x18, 0x19, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x70,
+	0x72, 0x69, 0x6f, 0x72, 0x69, 0x74, 0x79, 0x12, 0x42, 0x0a, 0x0a, 0x63, 0x69, 0x70, 0x68, 0x65,
+	0x72, 0x44, 0x61, 0x74, 0x61, 0x18, 0x1e, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x22, 0x2e, 0x6f, 0x72,
+	0x67, 0x2e, 0x6c, 0x66, 0x65, 0x64, 0x67, 0x65, 0x2e, 0x65, 0x76, 0x65, 0x2e, 0x63, 0x6f, 0x6e,
+	0x66, 0x69, 0x67, 0x2e, 0x43, 0x69, 0x70, 0x68, 0x65, 0x72, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x52,
+	0x0a, 0x63, 0x69, 0x70, 0x68, 0x65, 0x72, 0x44, 0x61, 0x74, 0x61, 0x1a, 0x45, 0x0a, 0x0b, 0x63,
+	0x72, 0x79, 0x70, 0x74, 0x6f, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x12, 0x1a, 0x0a, 0x08, 0x69, 0x64,
+	0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x69, 0x64,
+	0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x61, 0x73, 0x73, 0x77, 0x6f,
+	0x72, 0x64, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x70, 0x61, 0x73, 0x73, 0x77, 0x6f,
+	0x72, 0x64, 0x2a, 0xca, 0x01, 0x0a, 0x14, 0x43, 0x65, 0x6c, 0x6c, 0x75, 0x6c, 0x61, 0x72, 0x54,
+	0x72, 0x61, 0x66, 0x66, 0x69, 0x63, 0x43, 0x6c, 0x61, 0x73, 0x73, 0x12, 0x26, 0x0a, 0x22, 0x43,
+	0x45, 0x4c, 0x4c, 0x55, 0x4c, 0x41, 0x52, 0x5f, 0x54, 0x52, 0x41, 0x46, 0x46, 0x49, 0x43, 0x5f,
+	0x43, 0x4c, 0x41, 0x53, 0x53, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45,
+	0x44, 0x10, 0x00, 0x12, 0x1f, 0x0a, 0x1b, 0x43, 0x45, 0x4c, 0x4c, 0x55, 0x4c, 0x41, 0x52, 0x5f,
+	0x54, 0x52, 0x41, 0x46, 0x46, 0x49, 0x43, 0x5f, 0x43, 0x4c, 0x41, 0x53, 0x53, 0x5f, 0x4c, 0x4f,
+	0x47, 0x53, 0x10, 0x01, 0x12, 0x22, 0x0a, 0x1e, 0x43, 0x45, 0x4c, 0x4c, 0x55, 0x4c, 0x41, 0x52,
+	0x5f, 0x54, 0x52, 0x41, 0x46, 0x46, 0x49, 0x43, 0x5f, 0x43, 0x4c, 0x41, 0x53, 0x53, 0x5f, 0x4d,
+	0x45, 0x54, 0x52, 0x49, 0x43, 0x53, 0x10, 0x02, 0x12, 0x24, 0x0a, 0x20, 0x43, 0x45, 0x4c, 0x4c,
+	0x55, 0x4c, 0x41, 0x52, 0x5f, 0x54, 0x52, 0x41, 0x46, 0x46, 0x49, 0x43, 0x5f, 0x43, 0x4c, 0x41,
+	0x53, 0x53, 0x5f, 0x44, 0x4f, 0x57, 0x4e, 0x4c, 0x4f, 0x41, 0x44, 0x53, 0x10, 0x03, 0x12, 0x1f,
+	0x0a, 0x1b, 0x43, 0x45, 0x4c, 0x4c, 0x55, 0x4c, 0x41, 0x52, 0x5f, 0x54, 0x52, 0x41, 0x46, 0x46,
+	0x49, 0x43, 0x5f, 0x43, 0x4c, 0x41, 0x53, 0x53, 0x5f, 0x41, 0x50, 0x50, 0x53, 0x10, 0x04, 0x42,
+	0x3d, 0x0a, 0x15, 0x6f, 0x72, 0x67, 0x2e, 0x6c, 0x66, 0x65, 0x64, 0x67, 0x65, 0x2e, 0x65, 0x76,
+	0x65, 0x2e, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x5a, 0x24, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62,
+	0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x6c, 0x66, 0x2d, 0x65, 0x64, 0x67, 0x65, 0x2f, 0x65, 0x76, 0x65,
+	0x2f, 0x61, 0x70, 0x69, 0x2f, 0x67, 0x6f, 0x2f, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x62, 0x06,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -722,43 +1108,53 @@ func file_config_netconfig_proto_rawDescGZIP() []byte {
 	return file_config_netconfig_proto_rawDescData
 }
 
-var file_config_netconfig_proto_msgTypes = make([]protoimpl.MessageInfo, 7)
+var file_config_netconfig_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
+var file_config_netconfig_proto_msgTypes = make([]protoimpl.MessageInfo, 10)
 var file_config_netconfig_proto_goTypes = []interface{}{
-	(*NetworkConfig)(nil),             // 0: org.lfedge.eve.config.NetworkConfig
-	(*NetworkAdapter)(nil),            // 1: org.lfedge.eve.config.NetworkAdapter
-	(*WirelessConfig)(nil),            // 2: org.lfedge.eve.config.WirelessConfig
-	(*CellularConfig)(nil),            // 3: org.lfedge.eve.config.CellularConfig
-	(*CellularConnectivityProbe)(nil), // 4: org.lfedge.eve.config.CellularConnectivityProbe
-	(*WifiConfig)(nil),                // 5: org.lfedge.eve.config.WifiConfig
-	(*WifiConfigCryptoblock)(nil),     // 6: org.lfedge.eve.config.WifiConfig.cryptoblock
-	(NetworkType)(0),                  // 7: org.lfedge.eve.config.NetworkType
-	(*Ipspec)(nil),                    // 8: org.lfedge.eve.config.ipspec
-	(*ZnetStaticDNSEntry)(nil),        // 9: org.lfedge.eve.config.ZnetStaticDNSEntry
-	(*ProxyConfig)(nil),               // 10: org.lfedge.eve.config.ProxyConfig
-	(*ACE)(nil),                       // 11: org.lfedge.eve.config.ACE
-	(WirelessType)(0),                 // 12: org.lfedge.eve.config.WirelessType
-	(WiFiKeyScheme)(0),                // 13: org.lfedge.eve.config.WiFiKeyScheme
-	(*CipherBlock)(nil),               // 14: org.lfedge.eve.config.CipherBlock
+	(CellularTrafficClass)(0),         // 0: org.lfedge.eve.config.CellularTrafficClass
+	(*NetworkConfig)(nil),             // 1: org.lfedge.eve.config.NetworkConfig
+	(*NetworkAdapter)(nil),            // 2: org.lfedge.eve.config.NetworkAdapter
+	(*WirelessConfig)(nil),            // 3: org.lfedge.eve.config.WirelessConfig
+	(*CellularConfig)(nil),            // 4: org.lfedge.eve.config.CellularConfig
+	(*CellularDataQuota)(nil),         // 5: org.lfedge.eve.config.CellularDataQuota
+	(*CellularSimProfile)(nil),        // 6: org.lfedge.eve.config.CellularSimProfile
+	(*CellularFailover)(nil),          // 7: org.lfedge.eve.config.CellularFailover
+	(*CellularConnectivityProbe)(nil), // 8: org.lfedge.eve.config.CellularConnectivityProbe
+	(*WifiConfig)(nil),                // 9: org.lfedge.eve.config.WifiConfig
+	(*WifiConfigCryptoblock)(nil),     // 10: org.lfedge.eve.config.WifiConfig.cryptoblock
+	(NetworkType)(0),                  // 11: org.lfedge.eve.config.NetworkType
+	(*Ipspec)(nil),                    // 12: org.lfedge.eve.config.ipspec
+	(*ZnetStaticDNSEntry)(nil),        // 13: org.lfedge.eve.config.ZnetStaticDNSEntry
+	(*ProxyConfig)(nil),               // 14: org.lfedge.eve.config.ProxyConfig
+	(*ACE)(nil),                       // 15: org.lfedge.eve.config.ACE
+	(WirelessType)(0),                 // 16: org.lfedge.eve.config.WirelessType
+	(WiFiKeyScheme)(0),                // 17: org.lfedge.eve.config.WiFiKeyScheme
+	(*CipherBlock)(nil),               // 18: org.lfedge.eve.config.CipherBlock
 }
 var file_config_netconfig_proto_depIdxs = []int32{
-	7,  // 0: org.lfedge.eve.config.NetworkConfig.type:type_name -> org.lfedge.eve.config.NetworkType
-	8,  // 1: org.lfedge.eve.config.NetworkConfig.ip:type_name -> org.lfedge.eve.config.ipspec
-	9,  // 2: org.lfedge.eve.config.NetworkConfig.dns:type_name -> org.lfedge.eve.config.ZnetStaticDNSEntry
-	10, // 3: org.lfedge.eve.config.NetworkConfig.entProxy:type_name -> org.lfedge.eve.config.ProxyConfig
-	2,  // 4: org.lfedge.eve.config.NetworkConfig.wireless:type_name -> org.lfedge.eve.config.WirelessConfig
-	11, // 5: org.lfedge.eve.config.NetworkAdapter.acls:type_name -> org.lfedge.eve.config.ACE
-	12, // 6: org.lfedge.eve.config.WirelessConfig.type:type_name -> org.lfedge.eve.config.WirelessType
-	3,  // 7: org.lfedge.eve.config.WirelessConfig.cellularCfg:type_name -> org.lfedge.eve.config.CellularConfig
-	5,  // 8: org.lfedge.eve.config.WirelessConfig.wifiCfg:type_name -> org.lfedge.eve.config.WifiConfig
-	4,  // 9: org.lfedge.eve.config.CellularConfig.probe:type_name -> org.lfedge.eve.config.CellularConnectivityProbe
-	13, // 10: org.lfedge.eve.config.WifiConfig.keyScheme:type_name -> org.lfedge.eve.config.WiFiKeyScheme
-	6,  // 11: org.lfedge.eve.config.WifiConfig.crypto:type_name -> org.lfedge.eve.config.WifiConfig.cryptoblock
-	14, // 12: org.lfedge.eve.config.WifiConfig.cipherData:type_name -> org.lfedge.eve.config.CipherBlock
-	13, // [13:13] is the sub-list for method output_type
-	13, // [13:13] is the sub-list for method input_type
-	13, // [13:13] is the sub-list for extension type_name
-	13, // [13:13] is the sub-list for extension extendee
-	0,  // [0:13] is the sub-list for field type_name
+	11, // 0: org.lfedge.eve.config.NetworkConfig.type:type_name -> org.lfedge.eve.config.NetworkType
+	12, // 1: org.lfedge.eve.config.NetworkConfig.ip:type_name -> org.lfedge.eve.config.ipspec
+	13, // 2: org.lfedge.eve.config.NetworkConfig.dns:type_name -> org.lfedge.eve.config.ZnetStaticDNSEntry
+	14, // 3: org.lfedge.eve.config.NetworkConfig.entProxy:type_name -> org.lfedge.eve.config.ProxyConfig
+	3,  // 4: org.lfedge.eve.config.NetworkConfig.wireless:type_name -> org.lfedge.eve.config.WirelessConfig
+	15, // 5: org.lfedge.eve.config.NetworkAdapter.acls:type_name -> org.lfedge.eve.config.ACE
+	16, // 6: org.lfedge.eve.config.WirelessConfig.type:type_name -> org.lfedge.eve.config.WirelessType
+	4,  // 7: org.lfedge.eve.config.WirelessConfig.cellularCfg:type_name -> org.lfedge.eve.config.CellularConfig
+	9,  // 8: org.lfedge.eve.config.WirelessConfig.wifiCfg:type_name -> org.lfedge.eve.config.WifiConfig
+	8,  // 9: org.lfedge.eve.config.CellularConfig.probe:type_name -> org.lfedge.eve.config.CellularConnectivityProbe
+	6,  // 10: org.lfedge.eve.config.CellularConfig.sim_profiles:type_name -> org.lfedge.eve.config.CellularSimProfile
+	7,  // 11: org.lfedge.eve.config.CellularConfig.failover:type_name -> org.lfedge.eve.config.CellularFailover
+	0,  // 12: org.lfedge.eve.config.CellularDataQuota.soft_threshold_allowed:type_name -> org.lfedge.eve.config.CellularTrafficClass
+	0,  // 13: org.lfedge.eve.config.CellularDataQuota.hard_threshold_allowed:type_name -> org.lfedge.eve.config.CellularTrafficClass
+	5,  // 14: org.lfedge.eve.config.CellularSimProfile.quota:type_name -> org.lfedge.eve.config.CellularDataQuota
+	17, // 15: org.lfedge.eve.config.WifiConfig.keyScheme:type_name -> org.lfedge.eve.config.WiFiKeyScheme
+	10, // 16: org.lfedge.eve.config.WifiConfig.crypto:type_name -> org.lfedge.eve.config.WifiConfig.cryptoblock
+	18, // 17: org.lfedge.eve.config.WifiConfig.cipherData:type_name -> org.lfedge.eve.config.CipherBlock
+	18, // [18:18] is the sub-list for method output_type
+	18, // [18:18] is the sub-list for method input_type
+	18, // [18:18] is the sub-list for extension type_name
+	18, // [18:18] is the sub-list for extension extendee
+	0,  // [0:18] is the sub-list for field type_name
 }
 
 func init() { file_config_netconfig_proto_init() }
@@ -819,7 +1215,7 @@ func file_config_netconfig_proto_init() {
 			}
 		}
 		file_config_netconfig_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*CellularConnectivityProbe); i {
+			switch v := v.(*CellularDataQuota); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -831,7 +1227,7 @@ func file_config_netconfig_proto_init() {
 			}
 		}
 		file_config_netconfig_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*WifiConfig); i {
+			switch v := v.(*CellularSimProfile); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -843,6 +1239,42 @@ func file_config_netconfig_proto_init() {
 			}
 		}
 		file_config_netconfig_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CellularFailover); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_config_netconfig_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CellularConnectivityProbe); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_config_netconfig_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*WifiConfig); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_config_netconfig_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*WifiConfigCryptoblock); i {
 			case 0:
 				return &v.state
@@ -860,13 +1292,14 @@ func file_config_netconfig_proto_init() {
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_config_netconfig_proto_rawDesc,
-			NumEnums:      0,
-			NumMessages:   7,
+			NumEnums:      1,
+			NumMessages:   10,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
 		GoTypes:           file_config_netconfig_proto_goTypes,
 		DependencyIndexes: file_config_netconfig_proto_depIdxs,
+		EnumInfos:         file_config_netconfig_proto_enumTypes,
 		MessageInfos:      file_config_netconfig_proto_msgTypes,
 	}.Build()
 	File_config_netconfig_proto = out.File
@@ -0,0 +1,433 @@
+// Copyright(c) 2022 Zededa, Inc.
+// All rights reserved.
+
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.26.0
+// 	protoc        v3.18.1
+// source: config/schedule.proto
+
+package config
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+	reflect "reflect"
+	sync "sync"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// TimeWindow is a daily window of time in UTC
+type TimeWindow struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Minutes after midnight when the window opens
+	StartMinute uint32 `protobuf:"varint,1,opt,name=start_minute,json=startMinute,proto3" json:"start_minute,omitempty"`
+	// Minutes after midnight when the window closes. A window which
+	// closes before it opens spans midnight.
+	EndMinute uint32 `protobuf:"varint,2,opt,name=end_minute,json=endMinute,proto3" json:"end_minute,omitempty"`
+	// Days of the week when the window opens; bit 0 is Sunday.
+	// The window opens every day when no bit is set.
+	Weekdays uint32 `protobuf:"varint,3,opt,name=weekdays,proto3" json:"weekdays,omitempty"`
+}
+
+func (x *TimeWindow) Reset() {
+	*x = TimeWindow{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_config_schedule_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TimeWindow) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TimeWindow) ProtoMessage() {}
+
+func (x *TimeWindow) ProtoReflect() protoreflect.Message {
+	mi := &file_config_schedule_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TimeWindow.ProtoReflect.Descriptor instead.
+func (*TimeWindow) Descriptor() ([]byte, []int) {
+	return file_config_schedule_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *TimeWindow) GetStartMinute() uint32 {
+	if x != nil {
+		return x.StartMinute
+	}
+	return 0
+}
+
+func (x *TimeWindow) GetEndMinute() uint32 {
+	if x != nil {
+		return x.EndMinute
+	}
+	return 0
+}
+
+func (x *TimeWindow) GetWeekdays() uint32 {
+	if x != nil {
+		return x.Weekdays
+	}
+	return 0
+}
+
+// DownloadWindow is a window when content which is prefetched may be
+// downloaded
+type DownloadWindow struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Window *TimeWindow `protobuf:"bytes,1,opt,name=window,proto3" json:"window,omitempty"`
+	// The maximum cost of the ports which are used for the download
+	// during the window; see network.download.max.cost
+	MaxPortCost uint32 `protobuf:"varint,2,opt,name=max_port_cost,json=maxPortCost,proto3" json:"max_port_cost,omitempty"`
+}
+
+func (x *DownloadWindow) Reset() {
+	*x = DownloadWindow{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_config_schedule_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DownloadWindow) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DownloadWindow) ProtoMessage() {}
+
+func (x *DownloadWindow) ProtoReflect() protoreflect.Message {
+	mi := &file_config_schedule_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DownloadWindow.ProtoReflect.Descriptor instead.
+func (*DownloadWindow) Descriptor() ([]byte, []int) {
+	return file_config_schedule_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *DownloadWindow) GetWindow() *TimeWindow {
+	if x != nil {
+		return x.Window
+	}
+	return nil
+}
+
+func (x *DownloadWindow) GetMaxPortCost() uint32 {
+	if x != nil {
+		return x.MaxPortCost
+	}
+	return 0
+}
+
+// DeviceSchedule sets when the edge node prefetches content and when it
+// switches to new versions of EVE and of app instances
+type DeviceSchedule struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Content which is prefetched is only downloaded during these
+	// windows unless its prefetch_by deadline cannot be met otherwise.
+	// Prefetched content is downloaded at any time when no window is set.
+	DownloadWindows []*DownloadWindow `protobuf:"bytes,1,rep,name=download_windows,json=downloadWindows,proto3" json:"download_windows,omitempty"`
+	// New versions are only activated during these windows.
+	// Versions are activated at any time when no window is set.
+	MaintenanceWindows []*TimeWindow `protobuf:"bytes,2,rep,name=maintenance_windows,json=maintenanceWindows,proto3" json:"maintenance_windows,omitempty"`
+}
+
+func (x *DeviceSchedule) Reset() {
+	*x = DeviceSchedule{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_config_schedule_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DeviceSchedule) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeviceSchedule) ProtoMessage() {}
+
+func (x *DeviceSchedule) ProtoReflect() protoreflect.Message {
+	mi := &file_config_schedule_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeviceSchedule.ProtoReflect.Descriptor instead.
+func (*DeviceSchedule) Descriptor() ([]byte, []int) {
+	return file_config_schedule_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *DeviceSchedule) GetDownloadWindows() []*DownloadWindow {
+	if x != nil {
+		return x.DownloadWindows
+	}
+	return nil
+}
+
+func (x *DeviceSchedule) GetMaintenanceWindows() []*TimeWindow {
+	if x != nil {
+		return x.MaintenanceWindows
+	}
+	return nil
+}
+
+// ContentSchedule asks to prefetch a content tree ahead of the time when
+// it is activated. A content tree without a schedule is downloaded as soon
+// as it is configured and activated once it is ready.
+type ContentSchedule struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The content tree should be downloaded by this time
+	PrefetchBy *timestamppb.Timestamp `protobuf:"bytes,1,opt,name=prefetch_by,json=prefetchBy,proto3" json:"prefetch_by,omitempty"`
+	// The base OS or app instance using the content tree is not switched
+	// to it before this time, and then only during a maintenance window
+	ActivateAfter *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=activate_after,json=activateAfter,proto3" json:"activate_after,omitempty"`
+}
+
+func (x *ContentSchedule) Reset() {
+	*x = ContentSchedule{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_config_schedule_proto_msgTypes[3]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ContentSchedule) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ContentSchedule) ProtoMessage() {}
+
+func (x *ContentSchedule) ProtoReflect() protoreflect.Message {
+	mi := &file_config_schedule_proto_msgTypes[3]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ContentSchedule.ProtoReflect.Descriptor instead.
+func (*ContentSchedule) Descriptor() ([]byte, []int) {
+	return file_config_schedule_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *ContentSchedule) GetPrefetchBy() *timestamppb.Timestamp {
+	if x != nil {
+		return x.PrefetchBy
+	}
+	return nil
+}
+
+func (x *ContentSchedule) GetActivateAfter() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ActivateAfter
+	}
+	return nil
+}
+
+var File_config_schedule_proto protoreflect.FileDescriptor
+
+var file_config_schedule_proto_rawDesc = []byte{
+	0x0a, 0x15, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2f, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c,
+	0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x15, 0x6f, 0x72, 0x67, 0x2e, 0x6c, 0x66, 0x65,
+	0x64, 0x67, 0x65, 0x2e, 0x65, 0x76, 0x65, 0x2e, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x1a, 0x1f,
+	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f,
+	0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22,
+	0x6a, 0x0a, 0x0a, 0x54, 0x69, 0x6d, 0x65, 0x57, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x12, 0x21, 0x0a,
+	0x0c, 0x73, 0x74, 0x61, 0x72, 0x74, 0x5f, 0x6d, 0x69, 0x6e, 0x75, 0x74, 0x65, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x0d, 0x52, 0x0b, 0x73, 0x74, 0x61, 0x72, 0x74, 0x4d, 0x69, 0x6e, 0x75, 0x74, 0x65,
+	0x12, 0x1d, 0x0a, 0x0a, 0x65, 0x6e, 0x64, 0x5f, 0x6d, 0x69, 0x6e, 0x75, 0x74, 0x65, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x0d, 0x52, 0x09, 0x65, 0x6e, 0x64, 0x4d, 0x69, 0x6e, 0x75, 0x74, 0x65, 0x12,
+	0x1a, 0x0a, 0x08, 0x77, 0x65, 0x65, 0x6b, 0x64, 0x61, 0x79, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x0d, 0x52, 0x08, 0x77, 0x65, 0x65, 0x6b, 0x64, 0x61, 0x79, 0x73, 0x22, 0x6f, 0x0a, 0x0e, 0x44,
+	0x6f, 0x77, 0x6e, 0x6c, 0x6f, 0x61, 0x64, 0x57, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x12, 0x39, 0x0a,
+	0x06, 0x77, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x21, 0x2e,
+	0x6f, 0x72, 0x67, 0x2e, 0x6c, 0x66, 0x65, 0x64, 0x67, 0x65, 0x2e, 0x65, 0x76, 0x65, 0x2e, 0x63,
+	0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x57, 0x69, 0x6e, 0x64, 0x6f, 0x77,
+	0x52, 0x06, 0x77, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x12, 0x22, 0x0a, 0x0d, 0x6d, 0x61, 0x78, 0x5f,
+	0x70, 0x6f, 0x72, 0x74, 0x5f, 0x63, 0x6f, 0x73, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52,
+	0x0b, 0x6d, 0x61, 0x78, 0x50, 0x6f, 0x72, 0x74, 0x43, 0x6f, 0x73, 0x74, 0x22, 0xb6, 0x01, 0x0a,
+	0x0e, 0x44, 0x65, 0x76, 0x69, 0x63, 0x65, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x12,
+	0x50, 0x0a, 0x10, 0x64, 0x6f, 0x77, 0x6e, 0x6c, 0x6f, 0x61, 0x64, 0x5f, 0x77, 0x69, 0x6e, 0x64,
+	0x6f, 0x77, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x25, 0x2e, 0x6f, 0x72, 0x67, 0x2e,
+	0x6c, 0x66, 0x65, 0x64, 0x67, 0x65, 0x2e, 0x65, 0x76, 0x65, 0x2e, 0x63, 0x6f, 0x6e, 0x66, 0x69,
+	0x67, 0x2e, 0x44, 0x6f, 0x77, 0x6e, 0x6c, 0x6f, 0x61, 0x64, 0x57, 0x69, 0x6e, 0x64, 0x6f, 0x77,
+	0x52, 0x0f, 0x64, 0x6f, 0x77, 0x6e, 0x6c, 0x6f, 0x61, 0x64, 0x57, 0x69, 0x6e, 0x64, 0x6f, 0x77,
+	0x73, 0x12, 0x52, 0x0a, 0x13, 0x6d, 0x61, 0x69, 0x6e, 0x74, 0x65, 0x6e, 0x61, 0x6e, 0x63, 0x65,
+	0x5f, 0x77, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x21,
+	0x2e, 0x6f, 0x72, 0x67, 0x2e, 0x6c, 0x66, 0x65, 0x64, 0x67, 0x65, 0x2e, 0x65, 0x76, 0x65, 0x2e,
+	0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x57, 0x69, 0x6e, 0x64, 0x6f,
+	0x77, 0x52, 0x12, 0x6d, 0x61, 0x69, 0x6e, 0x74, 0x65, 0x6e, 0x61, 0x6e, 0x63, 0x65, 0x57, 0x69,
+	0x6e, 0x64, 0x6f, 0x77, 0x73, 0x22, 0x91, 0x01, 0x0a, 0x0f, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x6e,
+	0x74, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x12, 0x3b, 0x0a, 0x0b, 0x70, 0x72, 0x65,
+	0x66, 0x65, 0x74, 0x63, 0x68, 0x5f, 0x62, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a,
+	0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66,
+	0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x0a, 0x70, 0x72, 0x65, 0x66,
+	0x65, 0x74, 0x63, 0x68, 0x42, 0x79, 0x12, 0x41, 0x0a, 0x0e, 0x61, 0x63, 0x74, 0x69, 0x76, 0x61,
+	0x74, 0x65, 0x5f, 0x61, 0x66, 0x74, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a,
+	0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66,
+	0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x0d, 0x61, 0x63, 0x74, 0x69,
+	0x76, 0x61, 0x74, 0x65, 0x41, 0x66, 0x74, 0x65, 0x72, 0x42, 0x3d, 0x0a, 0x15, 0x6f, 0x72, 0x67,
+	0x2e, 0x6c, 0x66, 0x65, 0x64, 0x67, 0x65, 0x2e, 0x65, 0x76, 0x65, 0x2e, 0x63, 0x6f, 0x6e, 0x66,
+	0x69, 0x67, 0x5a, 0x24, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x6c,
+	0x66, 0x2d, 0x65, 0x64, 0x67, 0x65, 0x2f, 0x65, 0x76, 0x65, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x67,
+	0x6f, 0x2f, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_config_schedule_proto_rawDescOnce sync.Once
+	file_config_schedule_proto_rawDescData = file_config_schedule_proto_rawDesc
+)
+
+func file_config_schedule_proto_rawDescGZIP() []byte {
+	file_config_schedule_proto_rawDescOnce.Do(func() {
+		file_config_schedule_proto_rawDescData = protoimpl.X.CompressGZIP(file_config_schedule_proto_rawDescData)
+	})
+	return file_config_schedule_proto_rawDescData
+}
+
+var file_config_schedule_proto_msgTypes = make([]protoimpl.MessageInfo, 4)
+var file_config_schedule_proto_goTypes = []interface{}{
+	(*TimeWindow)(nil),            // 0: org.lfedge.eve.config.TimeWindow
+	(*DownloadWindow)(nil),        // 1: org.lfedge.eve.config.DownloadWindow
+	(*DeviceSchedule)(nil),        // 2: org.lfedge.eve.config.DeviceSchedule
+	(*ContentSchedule)(nil),       // 3: org.lfedge.eve.config.ContentSchedule
+	(*timestamppb.Timestamp)(nil), // 4: google.protobuf.Timestamp
+}
+var file_config_schedule_proto_depIdxs = []int32{
+	0, // 0: org.lfedge.eve.config.DownloadWindow.window:type_name -> org.lfedge.eve.config.TimeWindow
+	1, // 1: org.lfedge.eve.config.DeviceSchedule.download_windows:type_name -> org.lfedge.eve.config.DownloadWindow
+	0, // 2: org.lfedge.eve.config.DeviceSchedule.maintenance_windows:type_name -> org.lfedge.eve.config.TimeWindow
+	4, // 3: org.lfedge.eve.config.ContentSchedule.prefetch_by:type_name -> google.protobuf.Timestamp
+	4, // 4: org.lfedge.eve.config.ContentSchedule.activate_after:type_name -> google.protobuf.Timestamp
+	5, // [5:5] is the sub-list for method output_type
+	5, // [5:5] is the sub-list for method input_type
+	5, // [5:5] is the sub-list for extension type_name
+	5, // [5:5] is the sub-list for extension extendee
+	0, // [0:5] is the sub-list for field type_name
+}
+
+func init() { file_config_schedule_proto_init() }
+func file_config_schedule_proto_init() {
+	if File_config_schedule_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_config_schedule_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TimeWindow); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_config_schedule_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DownloadWindow); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_config_schedule_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DeviceSchedule); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_config_schedule_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ContentSchedule); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_config_schedule_proto_rawDesc,
+			NumEnums:      0,
+			NumMessages:   4,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_config_schedule_proto_goTypes,
+		DependencyIndexes: file_config_schedule_proto_depIdxs,
+		MessageInfos:      file_config_schedule_proto_msgTypes,
+	}.Build()
+	File_config_schedule_proto = out.File
+	file_config_schedule_proto_rawDesc = nil
+	file_config_schedule_proto_goTypes = nil
+	file_config_schedule_proto_depIdxs = nil
+}
@@ -0,0 +1,377 @@
+// Copyright(c) 2022 Zededa, Inc.
+// All rights reserved.
+
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.26.0
+// 	protoc        v3.18.1
+// source: config/signaturepolicy.proto
+
+package config
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// CosignVerifier verifies cosign signatures, or attestations when
+// predicate_type is set, which are stored next to the image in the
+// registry
+type CosignVerifier struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Keyed verification against the PEM public key. When empty the
+	// verification is keyless, against the certificate of the signature.
+	PublicKeyPem string `protobuf:"bytes,1,opt,name=public_key_pem,json=publicKeyPem,proto3" json:"public_key_pem,omitempty"`
+	// Keyless: PEM roots and intermediates which issue the certificates
+	// of the signatures, e.g. the ones of Fulcio
+	RootsPem string `protobuf:"bytes,2,opt,name=roots_pem,json=rootsPem,proto3" json:"roots_pem,omitempty"`
+	// Keyless: PEM public key of the transparency log; required. The
+	// signature has to come with a bundle from the log, and the
+	// certificate has to be valid at the time of the log entry.
+	RekorPublicKeyPem string `protobuf:"bytes,3,opt,name=rekor_public_key_pem,json=rekorPublicKeyPem,proto3" json:"rekor_public_key_pem,omitempty"`
+	// Keyless: email or URI in the subject alternative name of the
+	// certificate; any if empty. Either identity or issuer is required.
+	Identity string `protobuf:"bytes,4,opt,name=identity,proto3" json:"identity,omitempty"`
+	// Keyless: OIDC issuer in the certificate; any if empty
+	Issuer string `protobuf:"bytes,5,opt,name=issuer,proto3" json:"issuer,omitempty"`
+	// Verify an in-toto attestation of this predicate type instead of
+	// a signature
+	PredicateType string `protobuf:"bytes,6,opt,name=predicate_type,json=predicateType,proto3" json:"predicate_type,omitempty"`
+}
+
+func (x *CosignVerifier) Reset() {
+	*x = CosignVerifier{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_config_signaturepolicy_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CosignVerifier) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CosignVerifier) ProtoMessage() {}
+
+func (x *CosignVerifier) ProtoReflect() protoreflect.Message {
+	mi := &file_config_signaturepolicy_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CosignVerifier.ProtoReflect.Descriptor instead.
+func (*CosignVerifier) Descriptor() ([]byte, []int) {
+	return file_config_signaturepolicy_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *CosignVerifier) GetPublicKeyPem() string {
+	if x != nil {
+		return x.PublicKeyPem
+	}
+	return ""
+}
+
+func (x *CosignVerifier) GetRootsPem() string {
+	if x != nil {
+		return x.RootsPem
+	}
+	return ""
+}
+
+func (x *CosignVerifier) GetRekorPublicKeyPem() string {
+	if x != nil {
+		return x.RekorPublicKeyPem
+	}
+	return ""
+}
+
+func (x *CosignVerifier) GetIdentity() string {
+	if x != nil {
+		return x.Identity
+	}
+	return ""
+}
+
+func (x *CosignVerifier) GetIssuer() string {
+	if x != nil {
+		return x.Issuer
+	}
+	return ""
+}
+
+func (x *CosignVerifier) GetPredicateType() string {
+	if x != nil {
+		return x.PredicateType
+	}
+	return ""
+}
+
+// NotationVerifier verifies Notary v2 (Notation) signatures in JWS
+// envelopes
+type NotationVerifier struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// PEM roots which issue the certificates of the signatures
+	RootsPem string `protobuf:"bytes,1,opt,name=roots_pem,json=rootsPem,proto3" json:"roots_pem,omitempty"`
+	// Subjects of the signing certificates, e.g.
+	// "x509.subject: C=US, ST=WA, O=acme"; any if empty
+	TrustedIdentities []string `protobuf:"bytes,2,rep,name=trusted_identities,json=trustedIdentities,proto3" json:"trusted_identities,omitempty"`
+}
+
+func (x *NotationVerifier) Reset() {
+	*x = NotationVerifier{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_config_signaturepolicy_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *NotationVerifier) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*NotationVerifier) ProtoMessage() {}
+
+func (x *NotationVerifier) ProtoReflect() protoreflect.Message {
+	mi := &file_config_signaturepolicy_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use NotationVerifier.ProtoReflect.Descriptor instead.
+func (*NotationVerifier) Descriptor() ([]byte, []int) {
+	return file_config_signaturepolicy_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *NotationVerifier) GetRootsPem() string {
+	if x != nil {
+		return x.RootsPem
+	}
+	return ""
+}
+
+func (x *NotationVerifier) GetTrustedIdentities() []string {
+	if x != nil {
+		return x.TrustedIdentities
+	}
+	return nil
+}
+
+// SignaturePolicy requires OCI images to be verified by any of its
+// verifiers before volumes are created from them
+type SignaturePolicy struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Cosign   []*CosignVerifier   `protobuf:"bytes,1,rep,name=cosign,proto3" json:"cosign,omitempty"`
+	Notation []*NotationVerifier `protobuf:"bytes,2,rep,name=notation,proto3" json:"notation,omitempty"`
+}
+
+func (x *SignaturePolicy) Reset() {
+	*x = SignaturePolicy{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_config_signaturepolicy_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SignaturePolicy) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SignaturePolicy) ProtoMessage() {}
+
+func (x *SignaturePolicy) ProtoReflect() protoreflect.Message {
+	mi := &file_config_signaturepolicy_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SignaturePolicy.ProtoReflect.Descriptor instead.
+func (*SignaturePolicy) Descriptor() ([]byte, []int) {
+	return file_config_signaturepolicy_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *SignaturePolicy) GetCosign() []*CosignVerifier {
+	if x != nil {
+		return x.Cosign
+	}
+	return nil
+}
+
+func (x *SignaturePolicy) GetNotation() []*NotationVerifier {
+	if x != nil {
+		return x.Notation
+	}
+	return nil
+}
+
+var File_config_signaturepolicy_proto protoreflect.FileDescriptor
+
+var file_config_signaturepolicy_proto_rawDesc = []byte{
+	0x0a, 0x1c, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2f, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75,
+	0x72, 0x65, 0x70, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x15,
+	0x6f, 0x72, 0x67, 0x2e, 0x6c, 0x66, 0x65, 0x64, 0x67, 0x65, 0x2e, 0x65, 0x76, 0x65, 0x2e, 0x63,
+	0x6f, 0x6e, 0x66, 0x69, 0x67, 0x22, 0xdf, 0x01, 0x0a, 0x0e, 0x43, 0x6f, 0x73, 0x69, 0x67, 0x6e,
+	0x56, 0x65, 0x72, 0x69, 0x66, 0x69, 0x65, 0x72, 0x12, 0x24, 0x0a, 0x0e, 0x70, 0x75, 0x62, 0x6c,
+	0x69, 0x63, 0x5f, 0x6b, 0x65, 0x79, 0x5f, 0x70, 0x65, 0x6d, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0c, 0x70, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x4b, 0x65, 0x79, 0x50, 0x65, 0x6d, 0x12, 0x1b,
+	0x0a, 0x09, 0x72, 0x6f, 0x6f, 0x74, 0x73, 0x5f, 0x70, 0x65, 0x6d, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x08, 0x72, 0x6f, 0x6f, 0x74, 0x73, 0x50, 0x65, 0x6d, 0x12, 0x2f, 0x0a, 0x14, 0x72,
+	0x65, 0x6b, 0x6f, 0x72, 0x5f, 0x70, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x5f, 0x6b, 0x65, 0x79, 0x5f,
+	0x70, 0x65, 0x6d, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x11, 0x72, 0x65, 0x6b, 0x6f, 0x72,
+	0x50, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x4b, 0x65, 0x79, 0x50, 0x65, 0x6d, 0x12, 0x1a, 0x0a, 0x08,
+	0x69, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08,
+	0x69, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x12, 0x16, 0x0a, 0x06, 0x69, 0x73, 0x73, 0x75,
+	0x65, 0x72, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x69, 0x73, 0x73, 0x75, 0x65, 0x72,
+	0x12, 0x25, 0x0a, 0x0e, 0x70, 0x72, 0x65, 0x64, 0x69, 0x63, 0x61, 0x74, 0x65, 0x5f, 0x74, 0x79,
+	0x70, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x70, 0x72, 0x65, 0x64, 0x69, 0x63,
+	0x61, 0x74, 0x65, 0x54, 0x79, 0x70, 0x65, 0x22, 0x5e, 0x0a, 0x10, 0x4e, 0x6f, 0x74, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x56, 0x65, 0x72, 0x69, 0x66, 0x69, 0x65, 0x72, 0x12, 0x1b, 0x0a, 0x09, 0x72,
+	0x6f, 0x6f, 0x74, 0x73, 0x5f, 0x70, 0x65, 0x6d, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08,
+	0x72, 0x6f, 0x6f, 0x74, 0x73, 0x50, 0x65, 0x6d, 0x12, 0x2d, 0x0a, 0x12, 0x74, 0x72, 0x75, 0x73,
+	0x74, 0x65, 0x64, 0x5f, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x69, 0x65, 0x73, 0x18, 0x02,
+	0x20, 0x03, 0x28, 0x09, 0x52, 0x11, 0x74, 0x72, 0x75, 0x73, 0x74, 0x65, 0x64, 0x49, 0x64, 0x65,
+	0x6e, 0x74, 0x69, 0x74, 0x69, 0x65, 0x73, 0x22, 0x95, 0x01, 0x0a, 0x0f, 0x53, 0x69, 0x67, 0x6e,
+	0x61, 0x74, 0x75, 0x72, 0x65, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x12, 0x3d, 0x0a, 0x06, 0x63,
+	0x6f, 0x73, 0x69, 0x67, 0x6e, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x25, 0x2e, 0x6f, 0x72,
+	0x67, 0x2e, 0x6c, 0x66, 0x65, 0x64, 0x67, 0x65, 0x2e, 0x65, 0x76, 0x65, 0x2e, 0x63, 0x6f, 0x6e,
+	0x66, 0x69, 0x67, 0x2e, 0x43, 0x6f, 0x73, 0x69, 0x67, 0x6e, 0x56, 0x65, 0x72, 0x69, 0x66, 0x69,
+	0x65, 0x72, 0x52, 0x06, 0x63, 0x6f, 0x73, 0x69, 0x67, 0x6e, 0x12, 0x43, 0x0a, 0x08, 0x6e, 0x6f,
+	0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x27, 0x2e, 0x6f,
+	0x72, 0x67, 0x2e, 0x6c, 0x66, 0x65, 0x64, 0x67, 0x65, 0x2e, 0x65, 0x76, 0x65, 0x2e, 0x63, 0x6f,
+	0x6e, 0x66, 0x69, 0x67, 0x2e, 0x4e, 0x6f, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x56, 0x65, 0x72,
+	0x69, 0x66, 0x69, 0x65, 0x72, 0x52, 0x08, 0x6e, 0x6f, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x42,
+	0x3d, 0x0a, 0x15, 0x6f, 0x72, 0x67, 0x2e, 0x6c, 0x66, 0x65, 0x64, 0x67, 0x65, 0x2e, 0x65, 0x76,
+	0x65, 0x2e, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x5a, 0x24, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62,
+	0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x6c, 0x66, 0x2d, 0x65, 0x64, 0x67, 0x65, 0x2f, 0x65, 0x76, 0x65,
+	0x2f, 0x61, 0x70, 0x69, 0x2f, 0x67, 0x6f, 0x2f, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x62, 0x06,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_config_signaturepolicy_proto_rawDescOnce sync.Once
+	file_config_signaturepolicy_proto_rawDescData = file_config_signaturepolicy_proto_rawDesc
+)
+
+func file_config_signaturepolicy_proto_rawDescGZIP() []byte {
+	file_config_signaturepolicy_proto_rawDescOnce.Do(func() {
+		file_config_signaturepolicy_proto_rawDescData = protoimpl.X.CompressGZIP(file_config_signaturepolicy_proto_rawDescData)
+	})
+	return file_config_signaturepolicy_proto_rawDescData
+}
+
+var file_config_signaturepolicy_proto_msgTypes = make([]protoimpl.MessageInfo, 3)
+var file_config_signaturepolicy_proto_goTypes = []interface{}{
+	(*CosignVerifier)(nil),   // 0: org.lfedge.eve.config.CosignVerifier
+	(*NotationVerifier)(nil), // 1: org.lfedge.eve.config.NotationVerifier
+	(*SignaturePolicy)(nil),  // 2: org.lfedge.eve.config.SignaturePolicy
+}
+var file_config_signaturepolicy_proto_depIdxs = []int32{
+	0, // 0: org.lfedge.eve.config.SignaturePolicy.cosign:type_name -> org.lfedge.eve.config.CosignVerifier
+	1, // 1: org.lfedge.eve.config.SignaturePolicy.notation:type_name -> org.lfedge.eve.config.NotationVerifier
+	2, // [2:2] is the sub-list for method output_type
+	2, // [2:2] is the sub-list for method input_type
+	2, // [2:2] is the sub-list for extension type_name
+	2, // [2:2] is the sub-list for extension extendee
+	0, // [0:2] is the sub-list for field type_name
+}
+
+func init() { file_config_signaturepolicy_proto_init() }
+func file_config_signaturepolicy_proto_init() {
+	if File_config_signaturepolicy_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_config_signaturepolicy_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CosignVerifier); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_config_signaturepolicy_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*NotationVerifier); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_config_signaturepolicy_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SignaturePolicy); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_config_signaturepolicy_proto_rawDesc,
+			NumEnums:      0,
+			NumMessages:   3,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_config_signaturepolicy_proto_goTypes,
+		DependencyIndexes: file_config_signaturepolicy_proto_depIdxs,
+		MessageInfos:      file_config_signaturepolicy_proto_msgTypes,
+	}.Build()
+	File_config_signaturepolicy_proto = out.File
+	file_config_signaturepolicy_proto_rawDesc = nil
+	file_config_signaturepolicy_proto_goTypes = nil
+	file_config_signaturepolicy_proto_depIdxs = nil
+}
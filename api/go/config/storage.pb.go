@@ -320,6 +320,7 @@ const (
 	VolumeContentOriginType_VCOT_UNKNOWN  VolumeContentOriginType = 0
 	VolumeContentOriginType_VCOT_BLANK    VolumeContentOriginType = 1 // Create an empty directory/disk with specified size
 	VolumeContentOriginType_VCOT_DOWNLOAD VolumeContentOriginType = 2 // Create from downloadContentTreeID
+	VolumeContentOriginType_VCOT_BACKUP   VolumeContentOriginType = 3 // Restore from a backup of a volume
 )
 
 // Enum value maps for VolumeContentOriginType.
@@ -328,11 +329,13 @@ var (
 		0: "VCOT_UNKNOWN",
 		1: "VCOT_BLANK",
 		2: "VCOT_DOWNLOAD",
+		3: "VCOT_BACKUP",
 	}
 	VolumeContentOriginType_value = map[string]int32{
 		"VCOT_UNKNOWN":  0,
 		"VCOT_BLANK":    1,
 		"VCOT_DOWNLOAD": 2,
+		"VCOT_BACKUP":   3,
 	}
 )
 
@@ -363,6 +366,108 @@ func (VolumeContentOriginType) EnumDescriptor() ([]byte, []int) {
 	return file_config_storage_proto_rawDescGZIP(), []int{5}
 }
 
+// VolumeSharing describes how app instances can share a volume
+type VolumeSharing int32
+
+const (
+	VolumeSharing_VOLUME_SHARING_UNSPECIFIED VolumeSharing = 0 // used by a single app instance
+	// a directory which several app instances read and write at the same time,
+	// bind-mounted into containers and exported to VMs over 9p
+	VolumeSharing_VOLUME_SHARING_READ_WRITE_MANY VolumeSharing = 1
+)
+
+// Enum value maps for VolumeSharing.
+var (
+	VolumeSharing_name = map[int32]string{
+		0: "VOLUME_SHARING_UNSPECIFIED",
+		1: "VOLUME_SHARING_READ_WRITE_MANY",
+	}
+	VolumeSharing_value = map[string]int32{
+		"VOLUME_SHARING_UNSPECIFIED":     0,
+		"VOLUME_SHARING_READ_WRITE_MANY": 1,
+	}
+)
+
+func (x VolumeSharing) Enum() *VolumeSharing {
+	p := new(VolumeSharing)
+	*p = x
+	return p
+}
+
+func (x VolumeSharing) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (VolumeSharing) Descriptor() protoreflect.EnumDescriptor {
+	return file_config_storage_proto_enumTypes[6].Descriptor()
+}
+
+func (VolumeSharing) Type() protoreflect.EnumType {
+	return &file_config_storage_proto_enumTypes[6]
+}
+
+func (x VolumeSharing) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use VolumeSharing.Descriptor instead.
+func (VolumeSharing) EnumDescriptor() ([]byte, []int) {
+	return file_config_storage_proto_rawDescGZIP(), []int{6}
+}
+
+// VolumeFsType is the filesystem a blank volume is formatted with
+type VolumeFsType int32
+
+const (
+	VolumeFsType_VOLUME_FS_TYPE_UNSPECIFIED VolumeFsType = 0 // not formatted
+	VolumeFsType_VOLUME_FS_TYPE_EXT4        VolumeFsType = 1
+	VolumeFsType_VOLUME_FS_TYPE_XFS         VolumeFsType = 2
+	VolumeFsType_VOLUME_FS_TYPE_VFAT        VolumeFsType = 3
+)
+
+// Enum value maps for VolumeFsType.
+var (
+	VolumeFsType_name = map[int32]string{
+		0: "VOLUME_FS_TYPE_UNSPECIFIED",
+		1: "VOLUME_FS_TYPE_EXT4",
+		2: "VOLUME_FS_TYPE_XFS",
+		3: "VOLUME_FS_TYPE_VFAT",
+	}
+	VolumeFsType_value = map[string]int32{
+		"VOLUME_FS_TYPE_UNSPECIFIED": 0,
+		"VOLUME_FS_TYPE_EXT4":        1,
+		"VOLUME_FS_TYPE_XFS":         2,
+		"VOLUME_FS_TYPE_VFAT":        3,
+	}
+)
+
+func (x VolumeFsType) Enum() *VolumeFsType {
+	p := new(VolumeFsType)
+	*p = x
+	return p
+}
+
+func (x VolumeFsType) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (VolumeFsType) Descriptor() protoreflect.EnumDescriptor {
+	return file_config_storage_proto_enumTypes[7].Descriptor()
+}
+
+func (VolumeFsType) Type() protoreflect.EnumType {
+	return &file_config_storage_proto_enumTypes[7]
+}
+
+func (x VolumeFsType) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use VolumeFsType.Descriptor instead.
+func (VolumeFsType) EnumDescriptor() ([]byte, []int) {
+	return file_config_storage_proto_rawDescGZIP(), []int{7}
+}
+
 // DiskConfigType is the desired configuration of disks
 type DiskConfigType int32
 
@@ -374,6 +479,7 @@ const (
 	DiskConfigType_DISK_CONFIG_TYPE_ZFS_OFFLINE DiskConfigType = 4 // included in zfs and offline
 	DiskConfigType_DISK_CONFIG_TYPE_APPDIRECT   DiskConfigType = 5 // for direct assignment
 	DiskConfigType_DISK_CONFIG_TYPE_UNUSED      DiskConfigType = 6 // removed from zfs/app-direct
+	DiskConfigType_DISK_CONFIG_TYPE_ZFS_SPARE   DiskConfigType = 7 // included in zfs as a hot spare
 )
 
 // Enum value maps for DiskConfigType.
@@ -386,6 +492,7 @@ var (
 		4: "DISK_CONFIG_TYPE_ZFS_OFFLINE",
 		5: "DISK_CONFIG_TYPE_APPDIRECT",
 		6: "DISK_CONFIG_TYPE_UNUSED",
+		7: "DISK_CONFIG_TYPE_ZFS_SPARE",
 	}
 	DiskConfigType_value = map[string]int32{
 		"DISK_CONFIG_TYPE_UNSPECIFIED": 0,
@@ -395,6 +502,7 @@ var (
 		"DISK_CONFIG_TYPE_ZFS_OFFLINE": 4,
 		"DISK_CONFIG_TYPE_APPDIRECT":   5,
 		"DISK_CONFIG_TYPE_UNUSED":      6,
+		"DISK_CONFIG_TYPE_ZFS_SPARE":   7,
 	}
 )
 
@@ -409,11 +517,11 @@ func (x DiskConfigType) String() string {
 }
 
 func (DiskConfigType) Descriptor() protoreflect.EnumDescriptor {
-	return file_config_storage_proto_enumTypes[6].Descriptor()
+	return file_config_storage_proto_enumTypes[8].Descriptor()
 }
 
 func (DiskConfigType) Type() protoreflect.EnumType {
-	return &file_config_storage_proto_enumTypes[6]
+	return &file_config_storage_proto_enumTypes[8]
 }
 
 func (x DiskConfigType) Number() protoreflect.EnumNumber {
@@ -422,7 +530,7 @@ func (x DiskConfigType) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use DiskConfigType.Descriptor instead.
 func (DiskConfigType) EnumDescriptor() ([]byte, []int) {
-	return file_config_storage_proto_rawDescGZIP(), []int{6}
+	return file_config_storage_proto_rawDescGZIP(), []int{8}
 }
 
 // DisksArrayType is the desired configuration of disks in DisksConfig
@@ -465,11 +573,11 @@ func (x DisksArrayType) String() string {
 }
 
 func (DisksArrayType) Descriptor() protoreflect.EnumDescriptor {
-	return file_config_storage_proto_enumTypes[7].Descriptor()
+	return file_config_storage_proto_enumTypes[9].Descriptor()
 }
 
 func (DisksArrayType) Type() protoreflect.EnumType {
-	return &file_config_storage_proto_enumTypes[7]
+	return &file_config_storage_proto_enumTypes[9]
 }
 
 func (x DisksArrayType) Number() protoreflect.EnumNumber {
@@ -478,7 +586,7 @@ func (x DisksArrayType) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use DisksArrayType.Descriptor instead.
 func (DisksArrayType) EnumDescriptor() ([]byte, []int) {
-	return file_config_storage_proto_rawDescGZIP(), []int{7}
+	return file_config_storage_proto_rawDescGZIP(), []int{9}
 }
 
 // XXX this will be deprecated when all deployed instances of EVE
@@ -568,6 +676,9 @@ type DatastoreConfig struct {
 	CipherData *CipherBlock `protobuf:"bytes,7,opt,name=cipherData,proto3" json:"cipherData,omitempty"`
 	// Uploaded datastore certificate or certificate chain
 	DsCertPEM [][]byte `protobuf:"bytes,8,rep,name=dsCertPEM,proto3" json:"dsCertPEM,omitempty"`
+	// Verification of the signatures of the OCI images of the datastore,
+	// unless the content tree has a policy of its own
+	SignaturePolicy *SignaturePolicy `protobuf:"bytes,9,opt,name=signature_policy,json=signaturePolicy,proto3" json:"signature_policy,omitempty"`
 }
 
 func (x *DatastoreConfig) Reset() {
@@ -665,6 +776,13 @@ func (x *DatastoreConfig) GetDsCertPEM() [][]byte {
 	return nil
 }
 
+func (x *DatastoreConfig) GetSignaturePolicy() *SignaturePolicy {
+	if x != nil {
+		return x.SignaturePolicy
+	}
+	return nil
+}
+
 // XXX the Image will be deprecated and we will use ContentTree instead
 type Image struct {
 	state         protoimpl.MessageState
@@ -882,6 +1000,11 @@ type ContentTree struct {
 	// instance. In case of new instance using the same content tree, EVE will get
 	// new content tree UUID in the device configuration from the controller.
 	GenerationCount int64 `protobuf:"varint,9,opt,name=generation_count,json=generationCount,proto3" json:"generation_count,omitempty"`
+	// Prefetch the content tree ahead of its activation
+	Schedule *ContentSchedule `protobuf:"bytes,10,opt,name=schedule,proto3" json:"schedule,omitempty"`
+	// Verification of the signatures of the OCI image; overrides the
+	// policy of the datastore
+	SignaturePolicy *SignaturePolicy `protobuf:"bytes,11,opt,name=signature_policy,json=signaturePolicy,proto3" json:"signature_policy,omitempty"`
 }
 
 func (x *ContentTree) Reset() {
@@ -979,6 +1102,86 @@ func (x *ContentTree) GetGenerationCount() int64 {
 	return 0
 }
 
+func (x *ContentTree) GetSchedule() *ContentSchedule {
+	if x != nil {
+		return x.Schedule
+	}
+	return nil
+}
+
+func (x *ContentTree) GetSignaturePolicy() *SignaturePolicy {
+	if x != nil {
+		return x.SignaturePolicy
+	}
+	return nil
+}
+
+// VolumeBackupRef points to a backup which was uploaded by a device
+// to a datastore. The name and sha256 are the ones reported in
+// VolumeBackupInfo of the backed up volume.
+type VolumeBackupRef struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	DatastoreId string `protobuf:"bytes,1,opt,name=datastore_id,json=datastoreId,proto3" json:"datastore_id,omitempty"`
+	Name        string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`     // name of the backup manifest in the datastore
+	Sha256      string `protobuf:"bytes,3,opt,name=sha256,proto3" json:"sha256,omitempty"` // sha256 of the backup manifest
+}
+
+func (x *VolumeBackupRef) Reset() {
+	*x = VolumeBackupRef{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_config_storage_proto_msgTypes[5]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *VolumeBackupRef) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*VolumeBackupRef) ProtoMessage() {}
+
+func (x *VolumeBackupRef) ProtoReflect() protoreflect.Message {
+	mi := &file_config_storage_proto_msgTypes[5]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use VolumeBackupRef.ProtoReflect.Descriptor instead.
+func (*VolumeBackupRef) Descriptor() ([]byte, []int) {
+	return file_config_storage_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *VolumeBackupRef) GetDatastoreId() string {
+	if x != nil {
+		return x.DatastoreId
+	}
+	return ""
+}
+
+func (x *VolumeBackupRef) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *VolumeBackupRef) GetSha256() string {
+	if x != nil {
+		return x.Sha256
+	}
+	return ""
+}
+
 type VolumeContentOrigin struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -986,12 +1189,13 @@ type VolumeContentOrigin struct {
 
 	Type                  VolumeContentOriginType `protobuf:"varint,1,opt,name=type,proto3,enum=org.lfedge.eve.config.VolumeContentOriginType" json:"type,omitempty"`
 	DownloadContentTreeID string                  `protobuf:"bytes,2,opt,name=downloadContentTreeID,proto3" json:"downloadContentTreeID,omitempty"` // UUID for ContentTree
+	Backup                *VolumeBackupRef        `protobuf:"bytes,3,opt,name=backup,proto3" json:"backup,omitempty"`                               // Used with VCOT_BACKUP
 }
 
 func (x *VolumeContentOrigin) Reset() {
 	*x = VolumeContentOrigin{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_config_storage_proto_msgTypes[5]
+		mi := &file_config_storage_proto_msgTypes[6]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1004,7 +1208,7 @@ func (x *VolumeContentOrigin) String() string {
 func (*VolumeContentOrigin) ProtoMessage() {}
 
 func (x *VolumeContentOrigin) ProtoReflect() protoreflect.Message {
-	mi := &file_config_storage_proto_msgTypes[5]
+	mi := &file_config_storage_proto_msgTypes[6]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1017,7 +1221,7 @@ func (x *VolumeContentOrigin) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use VolumeContentOrigin.ProtoReflect.Descriptor instead.
 func (*VolumeContentOrigin) Descriptor() ([]byte, []int) {
-	return file_config_storage_proto_rawDescGZIP(), []int{5}
+	return file_config_storage_proto_rawDescGZIP(), []int{6}
 }
 
 func (x *VolumeContentOrigin) GetType() VolumeContentOriginType {
@@ -1034,6 +1238,312 @@ func (x *VolumeContentOrigin) GetDownloadContentTreeID() string {
 	return ""
 }
 
+func (x *VolumeContentOrigin) GetBackup() *VolumeBackupRef {
+	if x != nil {
+		return x.Backup
+	}
+	return nil
+}
+
+// VolumeBackupPolicy describes when and where a volume is backed up.
+// The backups are encrypted with the backup_key of the volume, hence any
+// device which gets the same key can restore them.
+type VolumeBackupPolicy struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	DatastoreId string `protobuf:"bytes,1,opt,name=datastore_id,json=datastoreId,proto3" json:"datastore_id,omitempty"` // where the backups are uploaded
+	// interval between scheduled backups in seconds,
+	// zero means backups are only taken on demand
+	Interval uint32 `protobuf:"varint,2,opt,name=interval,proto3" json:"interval,omitempty"`
+	// a change of counter takes a backup on demand
+	Counter uint32 `protobuf:"varint,3,opt,name=counter,proto3" json:"counter,omitempty"`
+	// upload only the changes since the previous backup
+	// when the storage of the volume allows it
+	Incremental bool `protobuf:"varint,4,opt,name=incremental,proto3" json:"incremental,omitempty"`
+	// number of incremental backups after which a full one is taken,
+	// zero means a default of 7
+	MaxIncremental uint32 `protobuf:"varint,5,opt,name=max_incremental,json=maxIncremental,proto3" json:"max_incremental,omitempty"`
+}
+
+func (x *VolumeBackupPolicy) Reset() {
+	*x = VolumeBackupPolicy{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_config_storage_proto_msgTypes[7]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *VolumeBackupPolicy) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*VolumeBackupPolicy) ProtoMessage() {}
+
+func (x *VolumeBackupPolicy) ProtoReflect() protoreflect.Message {
+	mi := &file_config_storage_proto_msgTypes[7]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use VolumeBackupPolicy.ProtoReflect.Descriptor instead.
+func (*VolumeBackupPolicy) Descriptor() ([]byte, []int) {
+	return file_config_storage_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *VolumeBackupPolicy) GetDatastoreId() string {
+	if x != nil {
+		return x.DatastoreId
+	}
+	return ""
+}
+
+func (x *VolumeBackupPolicy) GetInterval() uint32 {
+	if x != nil {
+		return x.Interval
+	}
+	return 0
+}
+
+func (x *VolumeBackupPolicy) GetCounter() uint32 {
+	if x != nil {
+		return x.Counter
+	}
+	return 0
+}
+
+func (x *VolumeBackupPolicy) GetIncremental() bool {
+	if x != nil {
+		return x.Incremental
+	}
+	return false
+}
+
+func (x *VolumeBackupPolicy) GetMaxIncremental() uint32 {
+	if x != nil {
+		return x.MaxIncremental
+	}
+	return 0
+}
+
+// VolumeIOLimits caps the disk I/O of app instances on a volume,
+// zero means unlimited. The limits can be changed while the app
+// instances run.
+type VolumeIOLimits struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ReadBytesPerSec  uint64 `protobuf:"varint,1,opt,name=read_bytes_per_sec,json=readBytesPerSec,proto3" json:"read_bytes_per_sec,omitempty"`
+	WriteBytesPerSec uint64 `protobuf:"varint,2,opt,name=write_bytes_per_sec,json=writeBytesPerSec,proto3" json:"write_bytes_per_sec,omitempty"`
+	ReadIops         uint64 `protobuf:"varint,3,opt,name=read_iops,json=readIops,proto3" json:"read_iops,omitempty"`
+	WriteIops        uint64 `protobuf:"varint,4,opt,name=write_iops,json=writeIops,proto3" json:"write_iops,omitempty"`
+}
+
+func (x *VolumeIOLimits) Reset() {
+	*x = VolumeIOLimits{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_config_storage_proto_msgTypes[8]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *VolumeIOLimits) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*VolumeIOLimits) ProtoMessage() {}
+
+func (x *VolumeIOLimits) ProtoReflect() protoreflect.Message {
+	mi := &file_config_storage_proto_msgTypes[8]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use VolumeIOLimits.ProtoReflect.Descriptor instead.
+func (*VolumeIOLimits) Descriptor() ([]byte, []int) {
+	return file_config_storage_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *VolumeIOLimits) GetReadBytesPerSec() uint64 {
+	if x != nil {
+		return x.ReadBytesPerSec
+	}
+	return 0
+}
+
+func (x *VolumeIOLimits) GetWriteBytesPerSec() uint64 {
+	if x != nil {
+		return x.WriteBytesPerSec
+	}
+	return 0
+}
+
+func (x *VolumeIOLimits) GetReadIops() uint64 {
+	if x != nil {
+		return x.ReadIops
+	}
+	return 0
+}
+
+func (x *VolumeIOLimits) GetWriteIops() uint64 {
+	if x != nil {
+		return x.WriteIops
+	}
+	return 0
+}
+
+// VolumeKeyPolicy asks for a data key of the volume's own instead of the
+// key of the vault. The key is wrapped by the vault key and destroyed
+// when the volume is deleted. Only encrypted volumes with a disk image
+// can have keys of their own.
+type VolumeKeyPolicy struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	OwnKey bool `protobuf:"varint,1,opt,name=own_key,json=ownKey,proto3" json:"own_key,omitempty"`
+	// a change of the counter rotates the key of the volume
+	RotateCounter uint32 `protobuf:"varint,2,opt,name=rotate_counter,json=rotateCounter,proto3" json:"rotate_counter,omitempty"`
+}
+
+func (x *VolumeKeyPolicy) Reset() {
+	*x = VolumeKeyPolicy{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_config_storage_proto_msgTypes[9]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *VolumeKeyPolicy) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*VolumeKeyPolicy) ProtoMessage() {}
+
+func (x *VolumeKeyPolicy) ProtoReflect() protoreflect.Message {
+	mi := &file_config_storage_proto_msgTypes[9]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use VolumeKeyPolicy.ProtoReflect.Descriptor instead.
+func (*VolumeKeyPolicy) Descriptor() ([]byte, []int) {
+	return file_config_storage_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *VolumeKeyPolicy) GetOwnKey() bool {
+	if x != nil {
+		return x.OwnKey
+	}
+	return false
+}
+
+func (x *VolumeKeyPolicy) GetRotateCounter() uint32 {
+	if x != nil {
+		return x.RotateCounter
+	}
+	return 0
+}
+
+// VolumeFilesystem asks for a blank volume to be formatted when it is
+// created hence app instances can mount it without partitioning it first.
+type VolumeFilesystem struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Type VolumeFsType `protobuf:"varint,1,opt,name=type,proto3,enum=org.lfedge.eve.config.VolumeFsType" json:"type,omitempty"`
+	// label of the filesystem, up to 16 characters for ext4, 12 for xfs
+	// and 11 for vfat
+	Label string `protobuf:"bytes,2,opt,name=label,proto3" json:"label,omitempty"`
+	// owner of the root directory of the filesystem, vfat has no owners
+	Uid uint32 `protobuf:"varint,3,opt,name=uid,proto3" json:"uid,omitempty"`
+	Gid uint32 `protobuf:"varint,4,opt,name=gid,proto3" json:"gid,omitempty"`
+}
+
+func (x *VolumeFilesystem) Reset() {
+	*x = VolumeFilesystem{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_config_storage_proto_msgTypes[10]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *VolumeFilesystem) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*VolumeFilesystem) ProtoMessage() {}
+
+func (x *VolumeFilesystem) ProtoReflect() protoreflect.Message {
+	mi := &file_config_storage_proto_msgTypes[10]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use VolumeFilesystem.ProtoReflect.Descriptor instead.
+func (*VolumeFilesystem) Descriptor() ([]byte, []int) {
+	return file_config_storage_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *VolumeFilesystem) GetType() VolumeFsType {
+	if x != nil {
+		return x.Type
+	}
+	return VolumeFsType_VOLUME_FS_TYPE_UNSPECIFIED
+}
+
+func (x *VolumeFilesystem) GetLabel() string {
+	if x != nil {
+		return x.Label
+	}
+	return ""
+}
+
+func (x *VolumeFilesystem) GetUid() uint32 {
+	if x != nil {
+		return x.Uid
+	}
+	return 0
+}
+
+func (x *VolumeFilesystem) GetGid() uint32 {
+	if x != nil {
+		return x.Gid
+	}
+	return 0
+}
+
 // The Volume describes a storage volume which should exist on the device.
 // This can currently either be blank or created from a ContentTree
 // If maxSizeBytes is zero it means unlimited by the controller. In that
@@ -1061,12 +1571,26 @@ type Volume struct {
 	Readonly     bool   `protobuf:"varint,6,opt,name=readonly,proto3" json:"readonly,omitempty"`                    // Will be offered to tasks as read-only
 	DisplayName  string `protobuf:"bytes,7,opt,name=displayName,proto3" json:"displayName,omitempty"`               // Optional friendly name echo'ed in info message
 	ClearText    bool   `protobuf:"varint,8,opt,name=clear_text,json=clearText,proto3" json:"clear_text,omitempty"` // Flag to indicate the volume encryption needed or not
+	// backups of the volume, none if not set
+	Backup *VolumeBackupPolicy `protobuf:"bytes,9,opt,name=backup,proto3" json:"backup,omitempty"`
+	// sharing of the volume between app instances, only blank volumes
+	// can be shared
+	Sharing VolumeSharing `protobuf:"varint,10,opt,name=sharing,proto3,enum=org.lfedge.eve.config.VolumeSharing" json:"sharing,omitempty"`
+	// disk I/O limits of the volume, unlimited if not set
+	IoLimits *VolumeIOLimits `protobuf:"bytes,11,opt,name=io_limits,json=ioLimits,proto3" json:"io_limits,omitempty"`
+	// data key of the volume, the key of the vault if not set
+	KeyPolicy *VolumeKeyPolicy `protobuf:"bytes,12,opt,name=key_policy,json=keyPolicy,proto3" json:"key_policy,omitempty"`
+	// filesystem of a blank volume, not formatted if not set
+	Filesystem *VolumeFilesystem `protobuf:"bytes,13,opt,name=filesystem,proto3" json:"filesystem,omitempty"`
+	// backupKey of the EncryptionBlock encrypts the backups of the volume
+	// and decrypts the backup it is restored from; required for both
+	BackupKey *CipherBlock `protobuf:"bytes,14,opt,name=backup_key,json=backupKey,proto3" json:"backup_key,omitempty"`
 }
 
 func (x *Volume) Reset() {
 	*x = Volume{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_config_storage_proto_msgTypes[6]
+		mi := &file_config_storage_proto_msgTypes[11]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1079,7 +1603,7 @@ func (x *Volume) String() string {
 func (*Volume) ProtoMessage() {}
 
 func (x *Volume) ProtoReflect() protoreflect.Message {
-	mi := &file_config_storage_proto_msgTypes[6]
+	mi := &file_config_storage_proto_msgTypes[11]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1092,7 +1616,7 @@ func (x *Volume) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Volume.ProtoReflect.Descriptor instead.
 func (*Volume) Descriptor() ([]byte, []int) {
-	return file_config_storage_proto_rawDescGZIP(), []int{6}
+	return file_config_storage_proto_rawDescGZIP(), []int{11}
 }
 
 func (x *Volume) GetUuid() string {
@@ -1151,11 +1675,55 @@ func (x *Volume) GetClearText() bool {
 	return false
 }
 
+func (x *Volume) GetBackup() *VolumeBackupPolicy {
+	if x != nil {
+		return x.Backup
+	}
+	return nil
+}
+
+func (x *Volume) GetSharing() VolumeSharing {
+	if x != nil {
+		return x.Sharing
+	}
+	return VolumeSharing_VOLUME_SHARING_UNSPECIFIED
+}
+
+func (x *Volume) GetIoLimits() *VolumeIOLimits {
+	if x != nil {
+		return x.IoLimits
+	}
+	return nil
+}
+
+func (x *Volume) GetKeyPolicy() *VolumeKeyPolicy {
+	if x != nil {
+		return x.KeyPolicy
+	}
+	return nil
+}
+
+func (x *Volume) GetFilesystem() *VolumeFilesystem {
+	if x != nil {
+		return x.Filesystem
+	}
+	return nil
+}
+
+func (x *Volume) GetBackupKey() *CipherBlock {
+	if x != nil {
+		return x.BackupKey
+	}
+	return nil
+}
+
 //DiskConfig describe desired configuration of disk
 //If we want change state to online/offline we should define its state
 //If we want to add disk we should define it here and set DiskConfigType to online or offline
 //If we want to remove disk we should set its state to unused or appdirect
 //If we want to replace disk we should fill old_disk to be replaced with disk
+//Disks are located by serial if defined, otherwise by name
+//If we want to add hot spare we should set DiskConfigType to zfs spare
 //Progress of operation is expected to be available in info messages
 type DiskConfig struct {
 	state         protoimpl.MessageState
@@ -1171,7 +1739,7 @@ type DiskConfig struct {
 func (x *DiskConfig) Reset() {
 	*x = DiskConfig{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_config_storage_proto_msgTypes[7]
+		mi := &file_config_storage_proto_msgTypes[12]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1184,7 +1752,7 @@ func (x *DiskConfig) String() string {
 func (*DiskConfig) ProtoMessage() {}
 
 func (x *DiskConfig) ProtoReflect() protoreflect.Message {
-	mi := &file_config_storage_proto_msgTypes[7]
+	mi := &file_config_storage_proto_msgTypes[12]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1197,7 +1765,7 @@ func (x *DiskConfig) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DiskConfig.ProtoReflect.Descriptor instead.
 func (*DiskConfig) Descriptor() ([]byte, []int) {
-	return file_config_storage_proto_rawDescGZIP(), []int{7}
+	return file_config_storage_proto_rawDescGZIP(), []int{12}
 }
 
 func (x *DiskConfig) GetDisk() *evecommon.DiskDescription {
@@ -1243,7 +1811,7 @@ type DisksConfig struct {
 func (x *DisksConfig) Reset() {
 	*x = DisksConfig{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_config_storage_proto_msgTypes[8]
+		mi := &file_config_storage_proto_msgTypes[13]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1256,7 +1824,7 @@ func (x *DisksConfig) String() string {
 func (*DisksConfig) ProtoMessage() {}
 
 func (x *DisksConfig) ProtoReflect() protoreflect.Message {
-	mi := &file_config_storage_proto_msgTypes[8]
+	mi := &file_config_storage_proto_msgTypes[13]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1269,7 +1837,7 @@ func (x *DisksConfig) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DisksConfig.ProtoReflect.Descriptor instead.
 func (*DisksConfig) Descriptor() ([]byte, []int) {
-	return file_config_storage_proto_rawDescGZIP(), []int{8}
+	return file_config_storage_proto_rawDescGZIP(), []int{13}
 }
 
 func (x *DisksConfig) GetDisks() []*DiskConfig {
@@ -1303,210 +1871,316 @@ var file_config_storage_proto_rawDesc = []byte{
 	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x18, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2f, 0x61, 0x63,
 	0x69, 0x70, 0x68, 0x65, 0x72, 0x69, 0x6e, 0x66, 0x6f, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a,
 	0x19, 0x65, 0x76, 0x65, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2f, 0x65, 0x76, 0x65, 0x63, 0x6f,
-	0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x79, 0x0a, 0x0d, 0x53, 0x69,
-	0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x24, 0x0a, 0x0d, 0x69,
-	0x6e, 0x74, 0x65, 0x72, 0x63, 0x65, 0x72, 0x74, 0x73, 0x75, 0x72, 0x6c, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x0d, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x63, 0x65, 0x72, 0x74, 0x73, 0x75, 0x72,
-	0x6c, 0x12, 0x24, 0x0a, 0x0d, 0x73, 0x69, 0x67, 0x6e, 0x65, 0x72, 0x63, 0x65, 0x72, 0x74, 0x75,
-	0x72, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x73, 0x69, 0x67, 0x6e, 0x65, 0x72,
-	0x63, 0x65, 0x72, 0x74, 0x75, 0x72, 0x6c, 0x12, 0x1c, 0x0a, 0x09, 0x73, 0x69, 0x67, 0x6e, 0x61,
-	0x74, 0x75, 0x72, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x09, 0x73, 0x69, 0x67, 0x6e,
-	0x61, 0x74, 0x75, 0x72, 0x65, 0x22, 0xae, 0x02, 0x0a, 0x0f, 0x44, 0x61, 0x74, 0x61, 0x73, 0x74,
-	0x6f, 0x72, 0x65, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18,
-	0x64, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x33, 0x0a, 0x05, 0x64, 0x54, 0x79,
-	0x70, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1d, 0x2e, 0x6f, 0x72, 0x67, 0x2e, 0x6c,
-	0x66, 0x65, 0x64, 0x67, 0x65, 0x2e, 0x65, 0x76, 0x65, 0x2e, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67,
-	0x2e, 0x44, 0x73, 0x54, 0x79, 0x70, 0x65, 0x52, 0x05, 0x64, 0x54, 0x79, 0x70, 0x65, 0x12, 0x12,
-	0x0a, 0x04, 0x66, 0x71, 0x64, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x66, 0x71,
-	0x64, 0x6e, 0x12, 0x16, 0x0a, 0x06, 0x61, 0x70, 0x69, 0x4b, 0x65, 0x79, 0x18, 0x03, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x06, 0x61, 0x70, 0x69, 0x4b, 0x65, 0x79, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x61,
-	0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x70, 0x61,
-	0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x64, 0x70, 0x61, 0x74, 0x68, 0x18,
-	0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x64, 0x70, 0x61, 0x74, 0x68, 0x12, 0x16, 0x0a, 0x06,
-	0x72, 0x65, 0x67, 0x69, 0x6f, 0x6e, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x72, 0x65,
-	0x67, 0x69, 0x6f, 0x6e, 0x12, 0x42, 0x0a, 0x0a, 0x63, 0x69, 0x70, 0x68, 0x65, 0x72, 0x44, 0x61,
-	0x74, 0x61, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x22, 0x2e, 0x6f, 0x72, 0x67, 0x2e, 0x6c,
-	0x66, 0x65, 0x64, 0x67, 0x65, 0x2e, 0x65, 0x76, 0x65, 0x2e, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67,
-	0x2e, 0x43, 0x69, 0x70, 0x68, 0x65, 0x72, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x52, 0x0a, 0x63, 0x69,
-	0x70, 0x68, 0x65, 0x72, 0x44, 0x61, 0x74, 0x61, 0x12, 0x1c, 0x0a, 0x09, 0x64, 0x73, 0x43, 0x65,
-	0x72, 0x74, 0x50, 0x45, 0x4d, 0x18, 0x08, 0x20, 0x03, 0x28, 0x0c, 0x52, 0x09, 0x64, 0x73, 0x43,
-	0x65, 0x72, 0x74, 0x50, 0x45, 0x4d, 0x22, 0xad, 0x02, 0x0a, 0x05, 0x49, 0x6d, 0x61, 0x67, 0x65,
-	0x12, 0x4d, 0x0a, 0x0e, 0x75, 0x75, 0x69, 0x64, 0x61, 0x6e, 0x64, 0x76, 0x65, 0x72, 0x73, 0x69,
-	0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x25, 0x2e, 0x6f, 0x72, 0x67, 0x2e, 0x6c,
-	0x66, 0x65, 0x64, 0x67, 0x65, 0x2e, 0x65, 0x76, 0x65, 0x2e, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67,
-	0x2e, 0x55, 0x55, 0x49, 0x44, 0x61, 0x6e, 0x64, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x52,
-	0x0e, 0x75, 0x75, 0x69, 0x64, 0x61, 0x6e, 0x64, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12,
-	0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e,
-	0x61, 0x6d, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x68, 0x61, 0x32, 0x35, 0x36, 0x18, 0x03, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x68, 0x61, 0x32, 0x35, 0x36, 0x12, 0x37, 0x0a, 0x07, 0x69,
-	0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1d, 0x2e, 0x6f,
-	0x72, 0x67, 0x2e, 0x6c, 0x66, 0x65, 0x64, 0x67, 0x65, 0x2e, 0x65, 0x76, 0x65, 0x2e, 0x63, 0x6f,
-	0x6e, 0x66, 0x69, 0x67, 0x2e, 0x46, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x52, 0x07, 0x69, 0x66, 0x6f,
-	0x72, 0x6d, 0x61, 0x74, 0x12, 0x3e, 0x0a, 0x07, 0x73, 0x69, 0x67, 0x69, 0x6e, 0x66, 0x6f, 0x18,
-	0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x24, 0x2e, 0x6f, 0x72, 0x67, 0x2e, 0x6c, 0x66, 0x65, 0x64,
+	0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x15, 0x63, 0x6f, 0x6e, 0x66,
+	0x69, 0x67, 0x2f, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x1a, 0x1c, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2f, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x74,
+	0x75, 0x72, 0x65, 0x70, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22,
+	0x79, 0x0a, 0x0d, 0x53, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x49, 0x6e, 0x66, 0x6f,
+	0x12, 0x24, 0x0a, 0x0d, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x63, 0x65, 0x72, 0x74, 0x73, 0x75, 0x72,
+	0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x63, 0x65,
+	0x72, 0x74, 0x73, 0x75, 0x72, 0x6c, 0x12, 0x24, 0x0a, 0x0d, 0x73, 0x69, 0x67, 0x6e, 0x65, 0x72,
+	0x63, 0x65, 0x72, 0x74, 0x75, 0x72, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x73,
+	0x69, 0x67, 0x6e, 0x65, 0x72, 0x63, 0x65, 0x72, 0x74, 0x75, 0x72, 0x6c, 0x12, 0x1c, 0x0a, 0x09,
+	0x73, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0c, 0x52,
+	0x09, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x22, 0x81, 0x03, 0x0a, 0x0f, 0x44,
+	0x61, 0x74, 0x61, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x0e,
+	0x0a, 0x02, 0x69, 0x64, 0x18, 0x64, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x33,
+	0x0a, 0x05, 0x64, 0x54, 0x79, 0x70, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1d, 0x2e,
+	0x6f, 0x72, 0x67, 0x2e, 0x6c, 0x66, 0x65, 0x64, 0x67, 0x65, 0x2e, 0x65, 0x76, 0x65, 0x2e, 0x63,
+	0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e, 0x44, 0x73, 0x54, 0x79, 0x70, 0x65, 0x52, 0x05, 0x64, 0x54,
+	0x79, 0x70, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x66, 0x71, 0x64, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x04, 0x66, 0x71, 0x64, 0x6e, 0x12, 0x16, 0x0a, 0x06, 0x61, 0x70, 0x69, 0x4b, 0x65,
+	0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x61, 0x70, 0x69, 0x4b, 0x65, 0x79, 0x12,
+	0x1a, 0x0a, 0x08, 0x70, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x08, 0x70, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x64,
+	0x70, 0x61, 0x74, 0x68, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x64, 0x70, 0x61, 0x74,
+	0x68, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x65, 0x67, 0x69, 0x6f, 0x6e, 0x18, 0x06, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x06, 0x72, 0x65, 0x67, 0x69, 0x6f, 0x6e, 0x12, 0x42, 0x0a, 0x0a, 0x63, 0x69, 0x70,
+	0x68, 0x65, 0x72, 0x44, 0x61, 0x74, 0x61, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x22, 0x2e,
+	0x6f, 0x72, 0x67, 0x2e, 0x6c, 0x66, 0x65, 0x64, 0x67, 0x65, 0x2e, 0x65, 0x76, 0x65, 0x2e, 0x63,
+	0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e, 0x43, 0x69, 0x70, 0x68, 0x65, 0x72, 0x42, 0x6c, 0x6f, 0x63,
+	0x6b, 0x52, 0x0a, 0x63, 0x69, 0x70, 0x68, 0x65, 0x72, 0x44, 0x61, 0x74, 0x61, 0x12, 0x1c, 0x0a,
+	0x09, 0x64, 0x73, 0x43, 0x65, 0x72, 0x74, 0x50, 0x45, 0x4d, 0x18, 0x08, 0x20, 0x03, 0x28, 0x0c,
+	0x52, 0x09, 0x64, 0x73, 0x43, 0x65, 0x72, 0x74, 0x50, 0x45, 0x4d, 0x12, 0x51, 0x0a, 0x10, 0x73,
+	0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x5f, 0x70, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x18,
+	0x09, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x26, 0x2e, 0x6f, 0x72, 0x67, 0x2e, 0x6c, 0x66, 0x65, 0x64,
 	0x67, 0x65, 0x2e, 0x65, 0x76, 0x65, 0x2e, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e, 0x53, 0x69,
-	0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x07, 0x73, 0x69, 0x67,
-	0x69, 0x6e, 0x66, 0x6f, 0x12, 0x12, 0x0a, 0x04, 0x64, 0x73, 0x49, 0x64, 0x18, 0x06, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x04, 0x64, 0x73, 0x49, 0x64, 0x12, 0x1c, 0x0a, 0x09, 0x73, 0x69, 0x7a, 0x65,
-	0x42, 0x79, 0x74, 0x65, 0x73, 0x18, 0x08, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x73, 0x69, 0x7a,
-	0x65, 0x42, 0x79, 0x74, 0x65, 0x73, 0x22, 0x8a, 0x02, 0x0a, 0x05, 0x44, 0x72, 0x69, 0x76, 0x65,
-	0x12, 0x32, 0x0a, 0x05, 0x69, 0x6d, 0x61, 0x67, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32,
-	0x1c, 0x2e, 0x6f, 0x72, 0x67, 0x2e, 0x6c, 0x66, 0x65, 0x64, 0x67, 0x65, 0x2e, 0x65, 0x76, 0x65,
-	0x2e, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e, 0x49, 0x6d, 0x61, 0x67, 0x65, 0x52, 0x05, 0x69,
-	0x6d, 0x61, 0x67, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x72, 0x65, 0x61, 0x64, 0x6f, 0x6e, 0x6c, 0x79,
-	0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x72, 0x65, 0x61, 0x64, 0x6f, 0x6e, 0x6c, 0x79,
-	0x12, 0x1a, 0x0a, 0x08, 0x70, 0x72, 0x65, 0x73, 0x65, 0x72, 0x76, 0x65, 0x18, 0x06, 0x20, 0x01,
-	0x28, 0x08, 0x52, 0x08, 0x70, 0x72, 0x65, 0x73, 0x65, 0x72, 0x76, 0x65, 0x12, 0x3a, 0x0a, 0x07,
-	0x64, 0x72, 0x76, 0x74, 0x79, 0x70, 0x65, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x20, 0x2e,
+	0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x52, 0x0f, 0x73,
+	0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x22, 0xad,
+	0x02, 0x0a, 0x05, 0x49, 0x6d, 0x61, 0x67, 0x65, 0x12, 0x4d, 0x0a, 0x0e, 0x75, 0x75, 0x69, 0x64,
+	0x61, 0x6e, 0x64, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x25, 0x2e, 0x6f, 0x72, 0x67, 0x2e, 0x6c, 0x66, 0x65, 0x64, 0x67, 0x65, 0x2e, 0x65, 0x76,
+	0x65, 0x2e, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e, 0x55, 0x55, 0x49, 0x44, 0x61, 0x6e, 0x64,
+	0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x0e, 0x75, 0x75, 0x69, 0x64, 0x61, 0x6e, 0x64,
+	0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x73,
+	0x68, 0x61, 0x32, 0x35, 0x36, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x68, 0x61,
+	0x32, 0x35, 0x36, 0x12, 0x37, 0x0a, 0x07, 0x69, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x0e, 0x32, 0x1d, 0x2e, 0x6f, 0x72, 0x67, 0x2e, 0x6c, 0x66, 0x65, 0x64, 0x67,
+	0x65, 0x2e, 0x65, 0x76, 0x65, 0x2e, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e, 0x46, 0x6f, 0x72,
+	0x6d, 0x61, 0x74, 0x52, 0x07, 0x69, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x12, 0x3e, 0x0a, 0x07,
+	0x73, 0x69, 0x67, 0x69, 0x6e, 0x66, 0x6f, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x24, 0x2e,
 	0x6f, 0x72, 0x67, 0x2e, 0x6c, 0x66, 0x65, 0x64, 0x67, 0x65, 0x2e, 0x65, 0x76, 0x65, 0x2e, 0x63,
-	0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e, 0x44, 0x72, 0x69, 0x76, 0x65, 0x54, 0x79, 0x70, 0x65, 0x52,
-	0x07, 0x64, 0x72, 0x76, 0x74, 0x79, 0x70, 0x65, 0x12, 0x35, 0x0a, 0x06, 0x74, 0x61, 0x72, 0x67,
-	0x65, 0x74, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1d, 0x2e, 0x6f, 0x72, 0x67, 0x2e, 0x6c,
-	0x66, 0x65, 0x64, 0x67, 0x65, 0x2e, 0x65, 0x76, 0x65, 0x2e, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67,
-	0x2e, 0x54, 0x61, 0x72, 0x67, 0x65, 0x74, 0x52, 0x06, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x12,
-	0x22, 0x0a, 0x0c, 0x6d, 0x61, 0x78, 0x73, 0x69, 0x7a, 0x65, 0x62, 0x79, 0x74, 0x65, 0x73, 0x18,
-	0x0a, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0c, 0x6d, 0x61, 0x78, 0x73, 0x69, 0x7a, 0x65, 0x62, 0x79,
-	0x74, 0x65, 0x73, 0x22, 0xc9, 0x02, 0x0a, 0x0b, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x54,
-	0x72, 0x65, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x75, 0x75, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x04, 0x75, 0x75, 0x69, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x64, 0x73, 0x49, 0x64, 0x18,
-	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x64, 0x73, 0x49, 0x64, 0x12, 0x10, 0x0a, 0x03, 0x55,
-	0x52, 0x4c, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x55, 0x52, 0x4c, 0x12, 0x37, 0x0a,
-	0x07, 0x69, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1d,
-	0x2e, 0x6f, 0x72, 0x67, 0x2e, 0x6c, 0x66, 0x65, 0x64, 0x67, 0x65, 0x2e, 0x65, 0x76, 0x65, 0x2e,
-	0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e, 0x46, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x52, 0x07, 0x69,
-	0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x68, 0x61, 0x32, 0x35, 0x36,
-	0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x68, 0x61, 0x32, 0x35, 0x36, 0x12, 0x22,
-	0x0a, 0x0c, 0x6d, 0x61, 0x78, 0x53, 0x69, 0x7a, 0x65, 0x42, 0x79, 0x74, 0x65, 0x73, 0x18, 0x06,
-	0x20, 0x01, 0x28, 0x04, 0x52, 0x0c, 0x6d, 0x61, 0x78, 0x53, 0x69, 0x7a, 0x65, 0x42, 0x79, 0x74,
-	0x65, 0x73, 0x12, 0x3e, 0x0a, 0x07, 0x73, 0x69, 0x67, 0x69, 0x6e, 0x66, 0x6f, 0x18, 0x07, 0x20,
-	0x01, 0x28, 0x0b, 0x32, 0x24, 0x2e, 0x6f, 0x72, 0x67, 0x2e, 0x6c, 0x66, 0x65, 0x64, 0x67, 0x65,
-	0x2e, 0x65, 0x76, 0x65, 0x2e, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e, 0x53, 0x69, 0x67, 0x6e,
-	0x61, 0x74, 0x75, 0x72, 0x65, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x07, 0x73, 0x69, 0x67, 0x69, 0x6e,
-	0x66, 0x6f, 0x12, 0x20, 0x0a, 0x0b, 0x64, 0x69, 0x73, 0x70, 0x6c, 0x61, 0x79, 0x4e, 0x61, 0x6d,
-	0x65, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x64, 0x69, 0x73, 0x70, 0x6c, 0x61, 0x79,
-	0x4e, 0x61, 0x6d, 0x65, 0x12, 0x29, 0x0a, 0x10, 0x67, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74, 0x69,
-	0x6f, 0x6e, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x09, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0f,
-	0x67, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x22,
-	0x8f, 0x01, 0x0a, 0x13, 0x56, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x6e,
-	0x74, 0x4f, 0x72, 0x69, 0x67, 0x69, 0x6e, 0x12, 0x42, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x2e, 0x2e, 0x6f, 0x72, 0x67, 0x2e, 0x6c, 0x66, 0x65, 0x64,
-	0x67, 0x65, 0x2e, 0x65, 0x76, 0x65, 0x2e, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e, 0x56, 0x6f,
-	0x6c, 0x75, 0x6d, 0x65, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x4f, 0x72, 0x69, 0x67, 0x69,
-	0x6e, 0x54, 0x79, 0x70, 0x65, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x34, 0x0a, 0x15, 0x64,
-	0x6f, 0x77, 0x6e, 0x6c, 0x6f, 0x61, 0x64, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x54, 0x72,
-	0x65, 0x65, 0x49, 0x44, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x15, 0x64, 0x6f, 0x77, 0x6e,
-	0x6c, 0x6f, 0x61, 0x64, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x54, 0x72, 0x65, 0x65, 0x49,
-	0x44, 0x22, 0xd7, 0x02, 0x0a, 0x06, 0x56, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x12, 0x12, 0x0a, 0x04,
-	0x75, 0x75, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x75, 0x75, 0x69, 0x64,
-	0x12, 0x42, 0x0a, 0x06, 0x6f, 0x72, 0x69, 0x67, 0x69, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b,
-	0x32, 0x2a, 0x2e, 0x6f, 0x72, 0x67, 0x2e, 0x6c, 0x66, 0x65, 0x64, 0x67, 0x65, 0x2e, 0x65, 0x76,
-	0x65, 0x2e, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e, 0x56, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x43,
-	0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x4f, 0x72, 0x69, 0x67, 0x69, 0x6e, 0x52, 0x06, 0x6f, 0x72,
-	0x69, 0x67, 0x69, 0x6e, 0x12, 0x4a, 0x0a, 0x09, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c,
-	0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0e, 0x32, 0x2c, 0x2e, 0x6f, 0x72, 0x67, 0x2e, 0x6c, 0x66,
+	0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e, 0x53, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x49,
+	0x6e, 0x66, 0x6f, 0x52, 0x07, 0x73, 0x69, 0x67, 0x69, 0x6e, 0x66, 0x6f, 0x12, 0x12, 0x0a, 0x04,
+	0x64, 0x73, 0x49, 0x64, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x64, 0x73, 0x49, 0x64,
+	0x12, 0x1c, 0x0a, 0x09, 0x73, 0x69, 0x7a, 0x65, 0x42, 0x79, 0x74, 0x65, 0x73, 0x18, 0x08, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x09, 0x73, 0x69, 0x7a, 0x65, 0x42, 0x79, 0x74, 0x65, 0x73, 0x22, 0x8a,
+	0x02, 0x0a, 0x05, 0x44, 0x72, 0x69, 0x76, 0x65, 0x12, 0x32, 0x0a, 0x05, 0x69, 0x6d, 0x61, 0x67,
+	0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x6f, 0x72, 0x67, 0x2e, 0x6c, 0x66,
 	0x65, 0x64, 0x67, 0x65, 0x2e, 0x65, 0x76, 0x65, 0x2e, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e,
-	0x56, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x41, 0x63, 0x63, 0x65, 0x73, 0x73, 0x50, 0x72, 0x6f, 0x74,
-	0x6f, 0x63, 0x6f, 0x6c, 0x73, 0x52, 0x09, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x73,
-	0x12, 0x28, 0x0a, 0x0f, 0x67, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x43, 0x6f,
-	0x75, 0x6e, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0f, 0x67, 0x65, 0x6e, 0x65, 0x72,
-	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x22, 0x0a, 0x0c, 0x6d, 0x61,
-	0x78, 0x73, 0x69, 0x7a, 0x65, 0x62, 0x79, 0x74, 0x65, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03,
-	0x52, 0x0c, 0x6d, 0x61, 0x78, 0x73, 0x69, 0x7a, 0x65, 0x62, 0x79, 0x74, 0x65, 0x73, 0x12, 0x1a,
-	0x0a, 0x08, 0x72, 0x65, 0x61, 0x64, 0x6f, 0x6e, 0x6c, 0x79, 0x18, 0x06, 0x20, 0x01, 0x28, 0x08,
-	0x52, 0x08, 0x72, 0x65, 0x61, 0x64, 0x6f, 0x6e, 0x6c, 0x79, 0x12, 0x20, 0x0a, 0x0b, 0x64, 0x69,
-	0x73, 0x70, 0x6c, 0x61, 0x79, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x0b, 0x64, 0x69, 0x73, 0x70, 0x6c, 0x61, 0x79, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x1d, 0x0a, 0x0a,
-	0x63, 0x6c, 0x65, 0x61, 0x72, 0x5f, 0x74, 0x65, 0x78, 0x74, 0x18, 0x08, 0x20, 0x01, 0x28, 0x08,
-	0x52, 0x09, 0x63, 0x6c, 0x65, 0x61, 0x72, 0x54, 0x65, 0x78, 0x74, 0x22, 0xd3, 0x01, 0x0a, 0x0a,
-	0x44, 0x69, 0x73, 0x6b, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x3a, 0x0a, 0x04, 0x64, 0x69,
-	0x73, 0x6b, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x26, 0x2e, 0x6f, 0x72, 0x67, 0x2e, 0x6c,
-	0x66, 0x65, 0x64, 0x67, 0x65, 0x2e, 0x65, 0x76, 0x65, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e,
-	0x2e, 0x44, 0x69, 0x73, 0x6b, 0x44, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e,
-	0x52, 0x04, 0x64, 0x69, 0x73, 0x6b, 0x12, 0x41, 0x0a, 0x08, 0x6f, 0x6c, 0x64, 0x5f, 0x64, 0x69,
-	0x73, 0x6b, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x26, 0x2e, 0x6f, 0x72, 0x67, 0x2e, 0x6c,
-	0x66, 0x65, 0x64, 0x67, 0x65, 0x2e, 0x65, 0x76, 0x65, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e,
-	0x2e, 0x44, 0x69, 0x73, 0x6b, 0x44, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e,
-	0x52, 0x07, 0x6f, 0x6c, 0x64, 0x44, 0x69, 0x73, 0x6b, 0x12, 0x46, 0x0a, 0x0b, 0x64, 0x69, 0x73,
-	0x6b, 0x5f, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x25,
+	0x49, 0x6d, 0x61, 0x67, 0x65, 0x52, 0x05, 0x69, 0x6d, 0x61, 0x67, 0x65, 0x12, 0x1a, 0x0a, 0x08,
+	0x72, 0x65, 0x61, 0x64, 0x6f, 0x6e, 0x6c, 0x79, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08,
+	0x72, 0x65, 0x61, 0x64, 0x6f, 0x6e, 0x6c, 0x79, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x72, 0x65, 0x73,
+	0x65, 0x72, 0x76, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x70, 0x72, 0x65, 0x73,
+	0x65, 0x72, 0x76, 0x65, 0x12, 0x3a, 0x0a, 0x07, 0x64, 0x72, 0x76, 0x74, 0x79, 0x70, 0x65, 0x18,
+	0x08, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x20, 0x2e, 0x6f, 0x72, 0x67, 0x2e, 0x6c, 0x66, 0x65, 0x64,
+	0x67, 0x65, 0x2e, 0x65, 0x76, 0x65, 0x2e, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e, 0x44, 0x72,
+	0x69, 0x76, 0x65, 0x54, 0x79, 0x70, 0x65, 0x52, 0x07, 0x64, 0x72, 0x76, 0x74, 0x79, 0x70, 0x65,
+	0x12, 0x35, 0x0a, 0x06, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0e,
+	0x32, 0x1d, 0x2e, 0x6f, 0x72, 0x67, 0x2e, 0x6c, 0x66, 0x65, 0x64, 0x67, 0x65, 0x2e, 0x65, 0x76,
+	0x65, 0x2e, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e, 0x54, 0x61, 0x72, 0x67, 0x65, 0x74, 0x52,
+	0x06, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x12, 0x22, 0x0a, 0x0c, 0x6d, 0x61, 0x78, 0x73, 0x69,
+	0x7a, 0x65, 0x62, 0x79, 0x74, 0x65, 0x73, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0c, 0x6d,
+	0x61, 0x78, 0x73, 0x69, 0x7a, 0x65, 0x62, 0x79, 0x74, 0x65, 0x73, 0x22, 0xe0, 0x03, 0x0a, 0x0b,
+	0x43, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x54, 0x72, 0x65, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x75,
+	0x75, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x75, 0x75, 0x69, 0x64, 0x12,
+	0x12, 0x0a, 0x04, 0x64, 0x73, 0x49, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x64,
+	0x73, 0x49, 0x64, 0x12, 0x10, 0x0a, 0x03, 0x55, 0x52, 0x4c, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x03, 0x55, 0x52, 0x4c, 0x12, 0x37, 0x0a, 0x07, 0x69, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1d, 0x2e, 0x6f, 0x72, 0x67, 0x2e, 0x6c, 0x66, 0x65,
+	0x64, 0x67, 0x65, 0x2e, 0x65, 0x76, 0x65, 0x2e, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e, 0x46,
+	0x6f, 0x72, 0x6d, 0x61, 0x74, 0x52, 0x07, 0x69, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x12, 0x16,
+	0x0a, 0x06, 0x73, 0x68, 0x61, 0x32, 0x35, 0x36, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06,
+	0x73, 0x68, 0x61, 0x32, 0x35, 0x36, 0x12, 0x22, 0x0a, 0x0c, 0x6d, 0x61, 0x78, 0x53, 0x69, 0x7a,
+	0x65, 0x42, 0x79, 0x74, 0x65, 0x73, 0x18, 0x06, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0c, 0x6d, 0x61,
+	0x78, 0x53, 0x69, 0x7a, 0x65, 0x42, 0x79, 0x74, 0x65, 0x73, 0x12, 0x3e, 0x0a, 0x07, 0x73, 0x69,
+	0x67, 0x69, 0x6e, 0x66, 0x6f, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x24, 0x2e, 0x6f, 0x72,
+	0x67, 0x2e, 0x6c, 0x66, 0x65, 0x64, 0x67, 0x65, 0x2e, 0x65, 0x76, 0x65, 0x2e, 0x63, 0x6f, 0x6e,
+	0x66, 0x69, 0x67, 0x2e, 0x53, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x49, 0x6e, 0x66,
+	0x6f, 0x52, 0x07, 0x73, 0x69, 0x67, 0x69, 0x6e, 0x66, 0x6f, 0x12, 0x20, 0x0a, 0x0b, 0x64, 0x69,
+	0x73, 0x70, 0x6c, 0x61, 0x79, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0b, 0x64, 0x69, 0x73, 0x70, 0x6c, 0x61, 0x79, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x29, 0x0a, 0x10,
+	0x67, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74,
+	0x18, 0x09, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0f, 0x67, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x42, 0x0a, 0x08, 0x73, 0x63, 0x68, 0x65, 0x64,
+	0x75, 0x6c, 0x65, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x26, 0x2e, 0x6f, 0x72, 0x67, 0x2e,
+	0x6c, 0x66, 0x65, 0x64, 0x67, 0x65, 0x2e, 0x65, 0x76, 0x65, 0x2e, 0x63, 0x6f, 0x6e, 0x66, 0x69,
+	0x67, 0x2e, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c,
+	0x65, 0x52, 0x08, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x12, 0x51, 0x0a, 0x10, 0x73,
+	0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x5f, 0x70, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x18,
+	0x0b, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x26, 0x2e, 0x6f, 0x72, 0x67, 0x2e, 0x6c, 0x66, 0x65, 0x64,
+	0x67, 0x65, 0x2e, 0x65, 0x76, 0x65, 0x2e, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e, 0x53, 0x69,
+	0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x52, 0x0f, 0x73,
+	0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x22, 0x60,
+	0x0a, 0x0f, 0x56, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x42, 0x61, 0x63, 0x6b, 0x75, 0x70, 0x52, 0x65,
+	0x66, 0x12, 0x21, 0x0a, 0x0c, 0x64, 0x61, 0x74, 0x61, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x5f, 0x69,
+	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x64, 0x61, 0x74, 0x61, 0x73, 0x74, 0x6f,
+	0x72, 0x65, 0x49, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x68, 0x61, 0x32,
+	0x35, 0x36, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x68, 0x61, 0x32, 0x35, 0x36,
+	0x22, 0xcf, 0x01, 0x0a, 0x13, 0x56, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x43, 0x6f, 0x6e, 0x74, 0x65,
+	0x6e, 0x74, 0x4f, 0x72, 0x69, 0x67, 0x69, 0x6e, 0x12, 0x42, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x2e, 0x2e, 0x6f, 0x72, 0x67, 0x2e, 0x6c, 0x66, 0x65,
+	0x64, 0x67, 0x65, 0x2e, 0x65, 0x76, 0x65, 0x2e, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e, 0x56,
+	0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x4f, 0x72, 0x69, 0x67,
+	0x69, 0x6e, 0x54, 0x79, 0x70, 0x65, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x34, 0x0a, 0x15,
+	0x64, 0x6f, 0x77, 0x6e, 0x6c, 0x6f, 0x61, 0x64, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x54,
+	0x72, 0x65, 0x65, 0x49, 0x44, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x15, 0x64, 0x6f, 0x77,
+	0x6e, 0x6c, 0x6f, 0x61, 0x64, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x54, 0x72, 0x65, 0x65,
+	0x49, 0x44, 0x12, 0x3e, 0x0a, 0x06, 0x62, 0x61, 0x63, 0x6b, 0x75, 0x70, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x26, 0x2e, 0x6f, 0x72, 0x67, 0x2e, 0x6c, 0x66, 0x65, 0x64, 0x67, 0x65, 0x2e,
+	0x65, 0x76, 0x65, 0x2e, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e, 0x56, 0x6f, 0x6c, 0x75, 0x6d,
+	0x65, 0x42, 0x61, 0x63, 0x6b, 0x75, 0x70, 0x52, 0x65, 0x66, 0x52, 0x06, 0x62, 0x61, 0x63, 0x6b,
+	0x75, 0x70, 0x22, 0xb8, 0x01, 0x0a, 0x12, 0x56, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x42, 0x61, 0x63,
+	0x6b, 0x75, 0x70, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x12, 0x21, 0x0a, 0x0c, 0x64, 0x61, 0x74,
+	0x61, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0b, 0x64, 0x61, 0x74, 0x61, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x49, 0x64, 0x12, 0x1a, 0x0a, 0x08,
+	0x69, 0x6e, 0x74, 0x65, 0x72, 0x76, 0x61, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x08,
+	0x69, 0x6e, 0x74, 0x65, 0x72, 0x76, 0x61, 0x6c, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x6f, 0x75, 0x6e,
+	0x74, 0x65, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x07, 0x63, 0x6f, 0x75, 0x6e, 0x74,
+	0x65, 0x72, 0x12, 0x20, 0x0a, 0x0b, 0x69, 0x6e, 0x63, 0x72, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x61,
+	0x6c, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0b, 0x69, 0x6e, 0x63, 0x72, 0x65, 0x6d, 0x65,
+	0x6e, 0x74, 0x61, 0x6c, 0x12, 0x27, 0x0a, 0x0f, 0x6d, 0x61, 0x78, 0x5f, 0x69, 0x6e, 0x63, 0x72,
+	0x65, 0x6d, 0x65, 0x6e, 0x74, 0x61, 0x6c, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0e, 0x6d,
+	0x61, 0x78, 0x49, 0x6e, 0x63, 0x72, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x61, 0x6c, 0x22, 0xa8, 0x01,
+	0x0a, 0x0e, 0x56, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x49, 0x4f, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x73,
+	0x12, 0x2b, 0x0a, 0x12, 0x72, 0x65, 0x61, 0x64, 0x5f, 0x62, 0x79, 0x74, 0x65, 0x73, 0x5f, 0x70,
+	0x65, 0x72, 0x5f, 0x73, 0x65, 0x63, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0f, 0x72, 0x65,
+	0x61, 0x64, 0x42, 0x79, 0x74, 0x65, 0x73, 0x50, 0x65, 0x72, 0x53, 0x65, 0x63, 0x12, 0x2d, 0x0a,
+	0x13, 0x77, 0x72, 0x69, 0x74, 0x65, 0x5f, 0x62, 0x79, 0x74, 0x65, 0x73, 0x5f, 0x70, 0x65, 0x72,
+	0x5f, 0x73, 0x65, 0x63, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x10, 0x77, 0x72, 0x69, 0x74,
+	0x65, 0x42, 0x79, 0x74, 0x65, 0x73, 0x50, 0x65, 0x72, 0x53, 0x65, 0x63, 0x12, 0x1b, 0x0a, 0x09,
+	0x72, 0x65, 0x61, 0x64, 0x5f, 0x69, 0x6f, 0x70, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52,
+	0x08, 0x72, 0x65, 0x61, 0x64, 0x49, 0x6f, 0x70, 0x73, 0x12, 0x1d, 0x0a, 0x0a, 0x77, 0x72, 0x69,
+	0x74, 0x65, 0x5f, 0x69, 0x6f, 0x70, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x04, 0x52, 0x09, 0x77,
+	0x72, 0x69, 0x74, 0x65, 0x49, 0x6f, 0x70, 0x73, 0x22, 0x51, 0x0a, 0x0f, 0x56, 0x6f, 0x6c, 0x75,
+	0x6d, 0x65, 0x4b, 0x65, 0x79, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x12, 0x17, 0x0a, 0x07, 0x6f,
+	0x77, 0x6e, 0x5f, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x6f, 0x77,
+	0x6e, 0x4b, 0x65, 0x79, 0x12, 0x25, 0x0a, 0x0e, 0x72, 0x6f, 0x74, 0x61, 0x74, 0x65, 0x5f, 0x63,
+	0x6f, 0x75, 0x6e, 0x74, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0d, 0x72, 0x6f,
+	0x74, 0x61, 0x74, 0x65, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x65, 0x72, 0x22, 0x85, 0x01, 0x0a, 0x10,
+	0x56, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x46, 0x69, 0x6c, 0x65, 0x73, 0x79, 0x73, 0x74, 0x65, 0x6d,
+	0x12, 0x37, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x23,
 	0x2e, 0x6f, 0x72, 0x67, 0x2e, 0x6c, 0x66, 0x65, 0x64, 0x67, 0x65, 0x2e, 0x65, 0x76, 0x65, 0x2e,
-	0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e, 0x44, 0x69, 0x73, 0x6b, 0x43, 0x6f, 0x6e, 0x66, 0x69,
-	0x67, 0x54, 0x79, 0x70, 0x65, 0x52, 0x0a, 0x64, 0x69, 0x73, 0x6b, 0x43, 0x6f, 0x6e, 0x66, 0x69,
-	0x67, 0x22, 0xcc, 0x01, 0x0a, 0x0b, 0x44, 0x69, 0x73, 0x6b, 0x73, 0x43, 0x6f, 0x6e, 0x66, 0x69,
-	0x67, 0x12, 0x37, 0x0a, 0x05, 0x64, 0x69, 0x73, 0x6b, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b,
-	0x32, 0x21, 0x2e, 0x6f, 0x72, 0x67, 0x2e, 0x6c, 0x66, 0x65, 0x64, 0x67, 0x65, 0x2e, 0x65, 0x76,
-	0x65, 0x2e, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e, 0x44, 0x69, 0x73, 0x6b, 0x43, 0x6f, 0x6e,
-	0x66, 0x69, 0x67, 0x52, 0x05, 0x64, 0x69, 0x73, 0x6b, 0x73, 0x12, 0x44, 0x0a, 0x0a, 0x61, 0x72,
-	0x72, 0x61, 0x79, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x25,
+	0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e, 0x56, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x46, 0x73, 0x54,
+	0x79, 0x70, 0x65, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x6c, 0x61, 0x62,
+	0x65, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x6c, 0x61, 0x62, 0x65, 0x6c, 0x12,
+	0x10, 0x0a, 0x03, 0x75, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x03, 0x75, 0x69,
+	0x64, 0x12, 0x10, 0x0a, 0x03, 0x67, 0x69, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x03,
+	0x67, 0x69, 0x64, 0x22, 0xf1, 0x05, 0x0a, 0x06, 0x56, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x12, 0x12,
+	0x0a, 0x04, 0x75, 0x75, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x75, 0x75,
+	0x69, 0x64, 0x12, 0x42, 0x0a, 0x06, 0x6f, 0x72, 0x69, 0x67, 0x69, 0x6e, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x2a, 0x2e, 0x6f, 0x72, 0x67, 0x2e, 0x6c, 0x66, 0x65, 0x64, 0x67, 0x65, 0x2e,
+	0x65, 0x76, 0x65, 0x2e, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e, 0x56, 0x6f, 0x6c, 0x75, 0x6d,
+	0x65, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x4f, 0x72, 0x69, 0x67, 0x69, 0x6e, 0x52, 0x06,
+	0x6f, 0x72, 0x69, 0x67, 0x69, 0x6e, 0x12, 0x4a, 0x0a, 0x09, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63,
+	0x6f, 0x6c, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0e, 0x32, 0x2c, 0x2e, 0x6f, 0x72, 0x67, 0x2e,
+	0x6c, 0x66, 0x65, 0x64, 0x67, 0x65, 0x2e, 0x65, 0x76, 0x65, 0x2e, 0x63, 0x6f, 0x6e, 0x66, 0x69,
+	0x67, 0x2e, 0x56, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x41, 0x63, 0x63, 0x65, 0x73, 0x73, 0x50, 0x72,
+	0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x73, 0x52, 0x09, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f,
+	0x6c, 0x73, 0x12, 0x28, 0x0a, 0x0f, 0x67, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x43, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0f, 0x67, 0x65, 0x6e,
+	0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x22, 0x0a, 0x0c,
+	0x6d, 0x61, 0x78, 0x73, 0x69, 0x7a, 0x65, 0x62, 0x79, 0x74, 0x65, 0x73, 0x18, 0x05, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x0c, 0x6d, 0x61, 0x78, 0x73, 0x69, 0x7a, 0x65, 0x62, 0x79, 0x74, 0x65, 0x73,
+	0x12, 0x1a, 0x0a, 0x08, 0x72, 0x65, 0x61, 0x64, 0x6f, 0x6e, 0x6c, 0x79, 0x18, 0x06, 0x20, 0x01,
+	0x28, 0x08, 0x52, 0x08, 0x72, 0x65, 0x61, 0x64, 0x6f, 0x6e, 0x6c, 0x79, 0x12, 0x20, 0x0a, 0x0b,
+	0x64, 0x69, 0x73, 0x70, 0x6c, 0x61, 0x79, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0b, 0x64, 0x69, 0x73, 0x70, 0x6c, 0x61, 0x79, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x1d,
+	0x0a, 0x0a, 0x63, 0x6c, 0x65, 0x61, 0x72, 0x5f, 0x74, 0x65, 0x78, 0x74, 0x18, 0x08, 0x20, 0x01,
+	0x28, 0x08, 0x52, 0x09, 0x63, 0x6c, 0x65, 0x61, 0x72, 0x54, 0x65, 0x78, 0x74, 0x12, 0x41, 0x0a,
+	0x06, 0x62, 0x61, 0x63, 0x6b, 0x75, 0x70, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x29, 0x2e,
+	0x6f, 0x72, 0x67, 0x2e, 0x6c, 0x66, 0x65, 0x64, 0x67, 0x65, 0x2e, 0x65, 0x76, 0x65, 0x2e, 0x63,
+	0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e, 0x56, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x42, 0x61, 0x63, 0x6b,
+	0x75, 0x70, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x52, 0x06, 0x62, 0x61, 0x63, 0x6b, 0x75, 0x70,
+	0x12, 0x3e, 0x0a, 0x07, 0x73, 0x68, 0x61, 0x72, 0x69, 0x6e, 0x67, 0x18, 0x0a, 0x20, 0x01, 0x28,
+	0x0e, 0x32, 0x24, 0x2e, 0x6f, 0x72, 0x67, 0x2e, 0x6c, 0x66, 0x65, 0x64, 0x67, 0x65, 0x2e, 0x65,
+	0x76, 0x65, 0x2e, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e, 0x56, 0x6f, 0x6c, 0x75, 0x6d, 0x65,
+	0x53, 0x68, 0x61, 0x72, 0x69, 0x6e, 0x67, 0x52, 0x07, 0x73, 0x68, 0x61, 0x72, 0x69, 0x6e, 0x67,
+	0x12, 0x42, 0x0a, 0x09, 0x69, 0x6f, 0x5f, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x73, 0x18, 0x0b, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x25, 0x2e, 0x6f, 0x72, 0x67, 0x2e, 0x6c, 0x66, 0x65, 0x64, 0x67, 0x65,
+	0x2e, 0x65, 0x76, 0x65, 0x2e, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e, 0x56, 0x6f, 0x6c, 0x75,
+	0x6d, 0x65, 0x49, 0x4f, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x73, 0x52, 0x08, 0x69, 0x6f, 0x4c, 0x69,
+	0x6d, 0x69, 0x74, 0x73, 0x12, 0x45, 0x0a, 0x0a, 0x6b, 0x65, 0x79, 0x5f, 0x70, 0x6f, 0x6c, 0x69,
+	0x63, 0x79, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x26, 0x2e, 0x6f, 0x72, 0x67, 0x2e, 0x6c,
+	0x66, 0x65, 0x64, 0x67, 0x65, 0x2e, 0x65, 0x76, 0x65, 0x2e, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67,
+	0x2e, 0x56, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x4b, 0x65, 0x79, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79,
+	0x52, 0x09, 0x6b, 0x65, 0x79, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x12, 0x47, 0x0a, 0x0a, 0x66,
+	0x69, 0x6c, 0x65, 0x73, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x27, 0x2e, 0x6f, 0x72, 0x67, 0x2e, 0x6c, 0x66, 0x65, 0x64, 0x67, 0x65, 0x2e, 0x65, 0x76, 0x65,
+	0x2e, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e, 0x56, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x46, 0x69,
+	0x6c, 0x65, 0x73, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x52, 0x0a, 0x66, 0x69, 0x6c, 0x65, 0x73, 0x79,
+	0x73, 0x74, 0x65, 0x6d, 0x12, 0x41, 0x0a, 0x0a, 0x62, 0x61, 0x63, 0x6b, 0x75, 0x70, 0x5f, 0x6b,
+	0x65, 0x79, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x22, 0x2e, 0x6f, 0x72, 0x67, 0x2e, 0x6c,
+	0x66, 0x65, 0x64, 0x67, 0x65, 0x2e, 0x65, 0x76, 0x65, 0x2e, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67,
+	0x2e, 0x43, 0x69, 0x70, 0x68, 0x65, 0x72, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x52, 0x09, 0x62, 0x61,
+	0x63, 0x6b, 0x75, 0x70, 0x4b, 0x65, 0x79, 0x22, 0xd3, 0x01, 0x0a, 0x0a, 0x44, 0x69, 0x73, 0x6b,
+	0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x3a, 0x0a, 0x04, 0x64, 0x69, 0x73, 0x6b, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x26, 0x2e, 0x6f, 0x72, 0x67, 0x2e, 0x6c, 0x66, 0x65, 0x64, 0x67,
+	0x65, 0x2e, 0x65, 0x76, 0x65, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x44, 0x69, 0x73,
+	0x6b, 0x44, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x04, 0x64, 0x69,
+	0x73, 0x6b, 0x12, 0x41, 0x0a, 0x08, 0x6f, 0x6c, 0x64, 0x5f, 0x64, 0x69, 0x73, 0x6b, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x26, 0x2e, 0x6f, 0x72, 0x67, 0x2e, 0x6c, 0x66, 0x65, 0x64, 0x67,
+	0x65, 0x2e, 0x65, 0x76, 0x65, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x44, 0x69, 0x73,
+	0x6b, 0x44, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x07, 0x6f, 0x6c,
+	0x64, 0x44, 0x69, 0x73, 0x6b, 0x12, 0x46, 0x0a, 0x0b, 0x64, 0x69, 0x73, 0x6b, 0x5f, 0x63, 0x6f,
+	0x6e, 0x66, 0x69, 0x67, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x25, 0x2e, 0x6f, 0x72, 0x67,
+	0x2e, 0x6c, 0x66, 0x65, 0x64, 0x67, 0x65, 0x2e, 0x65, 0x76, 0x65, 0x2e, 0x63, 0x6f, 0x6e, 0x66,
+	0x69, 0x67, 0x2e, 0x44, 0x69, 0x73, 0x6b, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x54, 0x79, 0x70,
+	0x65, 0x52, 0x0a, 0x64, 0x69, 0x73, 0x6b, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x22, 0xcc, 0x01,
+	0x0a, 0x0b, 0x44, 0x69, 0x73, 0x6b, 0x73, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x37, 0x0a,
+	0x05, 0x64, 0x69, 0x73, 0x6b, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x21, 0x2e, 0x6f,
+	0x72, 0x67, 0x2e, 0x6c, 0x66, 0x65, 0x64, 0x67, 0x65, 0x2e, 0x65, 0x76, 0x65, 0x2e, 0x63, 0x6f,
+	0x6e, 0x66, 0x69, 0x67, 0x2e, 0x44, 0x69, 0x73, 0x6b, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52,
+	0x05, 0x64, 0x69, 0x73, 0x6b, 0x73, 0x12, 0x44, 0x0a, 0x0a, 0x61, 0x72, 0x72, 0x61, 0x79, 0x5f,
+	0x74, 0x79, 0x70, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x25, 0x2e, 0x6f, 0x72, 0x67,
+	0x2e, 0x6c, 0x66, 0x65, 0x64, 0x67, 0x65, 0x2e, 0x65, 0x76, 0x65, 0x2e, 0x63, 0x6f, 0x6e, 0x66,
+	0x69, 0x67, 0x2e, 0x44, 0x69, 0x73, 0x6b, 0x73, 0x41, 0x72, 0x72, 0x61, 0x79, 0x54, 0x79, 0x70,
+	0x65, 0x52, 0x09, 0x61, 0x72, 0x72, 0x61, 0x79, 0x54, 0x79, 0x70, 0x65, 0x12, 0x3e, 0x0a, 0x08,
+	0x63, 0x68, 0x69, 0x6c, 0x64, 0x72, 0x65, 0x6e, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x22,
 	0x2e, 0x6f, 0x72, 0x67, 0x2e, 0x6c, 0x66, 0x65, 0x64, 0x67, 0x65, 0x2e, 0x65, 0x76, 0x65, 0x2e,
-	0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e, 0x44, 0x69, 0x73, 0x6b, 0x73, 0x41, 0x72, 0x72, 0x61,
-	0x79, 0x54, 0x79, 0x70, 0x65, 0x52, 0x09, 0x61, 0x72, 0x72, 0x61, 0x79, 0x54, 0x79, 0x70, 0x65,
-	0x12, 0x3e, 0x0a, 0x08, 0x63, 0x68, 0x69, 0x6c, 0x64, 0x72, 0x65, 0x6e, 0x18, 0x03, 0x20, 0x03,
-	0x28, 0x0b, 0x32, 0x22, 0x2e, 0x6f, 0x72, 0x67, 0x2e, 0x6c, 0x66, 0x65, 0x64, 0x67, 0x65, 0x2e,
-	0x65, 0x76, 0x65, 0x2e, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e, 0x44, 0x69, 0x73, 0x6b, 0x73,
-	0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x08, 0x63, 0x68, 0x69, 0x6c, 0x64, 0x72, 0x65, 0x6e,
-	0x2a, 0x85, 0x01, 0x0a, 0x06, 0x44, 0x73, 0x54, 0x79, 0x70, 0x65, 0x12, 0x0d, 0x0a, 0x09, 0x44,
-	0x73, 0x55, 0x6e, 0x6b, 0x6e, 0x6f, 0x77, 0x6e, 0x10, 0x00, 0x12, 0x0a, 0x0a, 0x06, 0x44, 0x73,
-	0x48, 0x74, 0x74, 0x70, 0x10, 0x01, 0x12, 0x0b, 0x0a, 0x07, 0x44, 0x73, 0x48, 0x74, 0x74, 0x70,
-	0x73, 0x10, 0x02, 0x12, 0x08, 0x0a, 0x04, 0x44, 0x73, 0x53, 0x33, 0x10, 0x03, 0x12, 0x0a, 0x0a,
-	0x06, 0x44, 0x73, 0x53, 0x46, 0x54, 0x50, 0x10, 0x04, 0x12, 0x17, 0x0a, 0x13, 0x44, 0x73, 0x43,
-	0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x72, 0x79,
-	0x10, 0x05, 0x12, 0x0f, 0x0a, 0x0b, 0x44, 0x73, 0x41, 0x7a, 0x75, 0x72, 0x65, 0x42, 0x6c, 0x6f,
-	0x62, 0x10, 0x06, 0x12, 0x13, 0x0a, 0x0f, 0x44, 0x73, 0x47, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x53,
-	0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x10, 0x07, 0x2a, 0x6b, 0x0a, 0x06, 0x46, 0x6f, 0x72, 0x6d,
-	0x61, 0x74, 0x12, 0x0e, 0x0a, 0x0a, 0x46, 0x6d, 0x74, 0x55, 0x6e, 0x6b, 0x6e, 0x6f, 0x77, 0x6e,
-	0x10, 0x00, 0x12, 0x07, 0x0a, 0x03, 0x52, 0x41, 0x57, 0x10, 0x01, 0x12, 0x08, 0x0a, 0x04, 0x51,
-	0x43, 0x4f, 0x57, 0x10, 0x02, 0x12, 0x09, 0x0a, 0x05, 0x51, 0x43, 0x4f, 0x57, 0x32, 0x10, 0x03,
-	0x12, 0x07, 0x0a, 0x03, 0x56, 0x48, 0x44, 0x10, 0x04, 0x12, 0x08, 0x0a, 0x04, 0x56, 0x4d, 0x44,
-	0x4b, 0x10, 0x05, 0x12, 0x07, 0x0a, 0x03, 0x4f, 0x56, 0x41, 0x10, 0x06, 0x12, 0x08, 0x0a, 0x04,
-	0x56, 0x48, 0x44, 0x58, 0x10, 0x07, 0x12, 0x0d, 0x0a, 0x09, 0x43, 0x4f, 0x4e, 0x54, 0x41, 0x49,
-	0x4e, 0x45, 0x52, 0x10, 0x08, 0x2a, 0x47, 0x0a, 0x06, 0x54, 0x61, 0x72, 0x67, 0x65, 0x74, 0x12,
-	0x0e, 0x0a, 0x0a, 0x54, 0x67, 0x74, 0x55, 0x6e, 0x6b, 0x6e, 0x6f, 0x77, 0x6e, 0x10, 0x00, 0x12,
-	0x08, 0x0a, 0x04, 0x44, 0x69, 0x73, 0x6b, 0x10, 0x01, 0x12, 0x0a, 0x0a, 0x06, 0x4b, 0x65, 0x72,
-	0x6e, 0x65, 0x6c, 0x10, 0x02, 0x12, 0x0a, 0x0a, 0x06, 0x49, 0x6e, 0x69, 0x74, 0x72, 0x64, 0x10,
-	0x03, 0x12, 0x0b, 0x0a, 0x07, 0x52, 0x61, 0x6d, 0x44, 0x69, 0x73, 0x6b, 0x10, 0x04, 0x2a, 0x49,
-	0x0a, 0x09, 0x44, 0x72, 0x69, 0x76, 0x65, 0x54, 0x79, 0x70, 0x65, 0x12, 0x10, 0x0a, 0x0c, 0x55,
-	0x6e, 0x63, 0x6c, 0x61, 0x73, 0x73, 0x69, 0x66, 0x69, 0x65, 0x64, 0x10, 0x00, 0x12, 0x09, 0x0a,
-	0x05, 0x43, 0x44, 0x52, 0x4f, 0x4d, 0x10, 0x01, 0x12, 0x07, 0x0a, 0x03, 0x48, 0x44, 0x44, 0x10,
-	0x02, 0x12, 0x07, 0x0a, 0x03, 0x4e, 0x45, 0x54, 0x10, 0x03, 0x12, 0x0d, 0x0a, 0x09, 0x48, 0x44,
-	0x44, 0x5f, 0x45, 0x4d, 0x50, 0x54, 0x59, 0x10, 0x04, 0x2a, 0x31, 0x0a, 0x15, 0x56, 0x6f, 0x6c,
-	0x75, 0x6d, 0x65, 0x41, 0x63, 0x63, 0x65, 0x73, 0x73, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f,
-	0x6c, 0x73, 0x12, 0x0c, 0x0a, 0x08, 0x56, 0x41, 0x50, 0x5f, 0x4e, 0x4f, 0x4e, 0x45, 0x10, 0x00,
-	0x12, 0x0a, 0x0a, 0x06, 0x56, 0x41, 0x50, 0x5f, 0x39, 0x50, 0x10, 0x01, 0x2a, 0x4e, 0x0a, 0x17,
-	0x56, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x4f, 0x72, 0x69,
-	0x67, 0x69, 0x6e, 0x54, 0x79, 0x70, 0x65, 0x12, 0x10, 0x0a, 0x0c, 0x56, 0x43, 0x4f, 0x54, 0x5f,
-	0x55, 0x4e, 0x4b, 0x4e, 0x4f, 0x57, 0x4e, 0x10, 0x00, 0x12, 0x0e, 0x0a, 0x0a, 0x56, 0x43, 0x4f,
-	0x54, 0x5f, 0x42, 0x4c, 0x41, 0x4e, 0x4b, 0x10, 0x01, 0x12, 0x11, 0x0a, 0x0d, 0x56, 0x43, 0x4f,
-	0x54, 0x5f, 0x44, 0x4f, 0x57, 0x4e, 0x4c, 0x4f, 0x41, 0x44, 0x10, 0x02, 0x2a, 0xec, 0x01, 0x0a,
-	0x0e, 0x44, 0x69, 0x73, 0x6b, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x54, 0x79, 0x70, 0x65, 0x12,
-	0x20, 0x0a, 0x1c, 0x44, 0x49, 0x53, 0x4b, 0x5f, 0x43, 0x4f, 0x4e, 0x46, 0x49, 0x47, 0x5f, 0x54,
-	0x59, 0x50, 0x45, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10,
-	0x00, 0x12, 0x1a, 0x0a, 0x16, 0x44, 0x49, 0x53, 0x4b, 0x5f, 0x43, 0x4f, 0x4e, 0x46, 0x49, 0x47,
-	0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x45, 0x56, 0x45, 0x4f, 0x53, 0x10, 0x01, 0x12, 0x1c, 0x0a,
-	0x18, 0x44, 0x49, 0x53, 0x4b, 0x5f, 0x43, 0x4f, 0x4e, 0x46, 0x49, 0x47, 0x5f, 0x54, 0x59, 0x50,
-	0x45, 0x5f, 0x50, 0x45, 0x52, 0x53, 0x49, 0x53, 0x54, 0x10, 0x02, 0x12, 0x1f, 0x0a, 0x1b, 0x44,
+	0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e, 0x44, 0x69, 0x73, 0x6b, 0x73, 0x43, 0x6f, 0x6e, 0x66,
+	0x69, 0x67, 0x52, 0x08, 0x63, 0x68, 0x69, 0x6c, 0x64, 0x72, 0x65, 0x6e, 0x2a, 0x85, 0x01, 0x0a,
+	0x06, 0x44, 0x73, 0x54, 0x79, 0x70, 0x65, 0x12, 0x0d, 0x0a, 0x09, 0x44, 0x73, 0x55, 0x6e, 0x6b,
+	0x6e, 0x6f, 0x77, 0x6e, 0x10, 0x00, 0x12, 0x0a, 0x0a, 0x06, 0x44, 0x73, 0x48, 0x74, 0x74, 0x70,
+	0x10, 0x01, 0x12, 0x0b, 0x0a, 0x07, 0x44, 0x73, 0x48, 0x74, 0x74, 0x70, 0x73, 0x10, 0x02, 0x12,
+	0x08, 0x0a, 0x04, 0x44, 0x73, 0x53, 0x33, 0x10, 0x03, 0x12, 0x0a, 0x0a, 0x06, 0x44, 0x73, 0x53,
+	0x46, 0x54, 0x50, 0x10, 0x04, 0x12, 0x17, 0x0a, 0x13, 0x44, 0x73, 0x43, 0x6f, 0x6e, 0x74, 0x61,
+	0x69, 0x6e, 0x65, 0x72, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x72, 0x79, 0x10, 0x05, 0x12, 0x0f,
+	0x0a, 0x0b, 0x44, 0x73, 0x41, 0x7a, 0x75, 0x72, 0x65, 0x42, 0x6c, 0x6f, 0x62, 0x10, 0x06, 0x12,
+	0x13, 0x0a, 0x0f, 0x44, 0x73, 0x47, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x53, 0x74, 0x6f, 0x72, 0x61,
+	0x67, 0x65, 0x10, 0x07, 0x2a, 0x6b, 0x0a, 0x06, 0x46, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x12, 0x0e,
+	0x0a, 0x0a, 0x46, 0x6d, 0x74, 0x55, 0x6e, 0x6b, 0x6e, 0x6f, 0x77, 0x6e, 0x10, 0x00, 0x12, 0x07,
+	0x0a, 0x03, 0x52, 0x41, 0x57, 0x10, 0x01, 0x12, 0x08, 0x0a, 0x04, 0x51, 0x43, 0x4f, 0x57, 0x10,
+	0x02, 0x12, 0x09, 0x0a, 0x05, 0x51, 0x43, 0x4f, 0x57, 0x32, 0x10, 0x03, 0x12, 0x07, 0x0a, 0x03,
+	0x56, 0x48, 0x44, 0x10, 0x04, 0x12, 0x08, 0x0a, 0x04, 0x56, 0x4d, 0x44, 0x4b, 0x10, 0x05, 0x12,
+	0x07, 0x0a, 0x03, 0x4f, 0x56, 0x41, 0x10, 0x06, 0x12, 0x08, 0x0a, 0x04, 0x56, 0x48, 0x44, 0x58,
+	0x10, 0x07, 0x12, 0x0d, 0x0a, 0x09, 0x43, 0x4f, 0x4e, 0x54, 0x41, 0x49, 0x4e, 0x45, 0x52, 0x10,
+	0x08, 0x2a, 0x47, 0x0a, 0x06, 0x54, 0x61, 0x72, 0x67, 0x65, 0x74, 0x12, 0x0e, 0x0a, 0x0a, 0x54,
+	0x67, 0x74, 0x55, 0x6e, 0x6b, 0x6e, 0x6f, 0x77, 0x6e, 0x10, 0x00, 0x12, 0x08, 0x0a, 0x04, 0x44,
+	0x69, 0x73, 0x6b, 0x10, 0x01, 0x12, 0x0a, 0x0a, 0x06, 0x4b, 0x65, 0x72, 0x6e, 0x65, 0x6c, 0x10,
+	0x02, 0x12, 0x0a, 0x0a, 0x06, 0x49, 0x6e, 0x69, 0x74, 0x72, 0x64, 0x10, 0x03, 0x12, 0x0b, 0x0a,
+	0x07, 0x52, 0x61, 0x6d, 0x44, 0x69, 0x73, 0x6b, 0x10, 0x04, 0x2a, 0x49, 0x0a, 0x09, 0x44, 0x72,
+	0x69, 0x76, 0x65, 0x54, 0x79, 0x70, 0x65, 0x12, 0x10, 0x0a, 0x0c, 0x55, 0x6e, 0x63, 0x6c, 0x61,
+	0x73, 0x73, 0x69, 0x66, 0x69, 0x65, 0x64, 0x10, 0x00, 0x12, 0x09, 0x0a, 0x05, 0x43, 0x44, 0x52,
+	0x4f, 0x4d, 0x10, 0x01, 0x12, 0x07, 0x0a, 0x03, 0x48, 0x44, 0x44, 0x10, 0x02, 0x12, 0x07, 0x0a,
+	0x03, 0x4e, 0x45, 0x54, 0x10, 0x03, 0x12, 0x0d, 0x0a, 0x09, 0x48, 0x44, 0x44, 0x5f, 0x45, 0x4d,
+	0x50, 0x54, 0x59, 0x10, 0x04, 0x2a, 0x31, 0x0a, 0x15, 0x56, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x41,
+	0x63, 0x63, 0x65, 0x73, 0x73, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x73, 0x12, 0x0c,
+	0x0a, 0x08, 0x56, 0x41, 0x50, 0x5f, 0x4e, 0x4f, 0x4e, 0x45, 0x10, 0x00, 0x12, 0x0a, 0x0a, 0x06,
+	0x56, 0x41, 0x50, 0x5f, 0x39, 0x50, 0x10, 0x01, 0x2a, 0x5f, 0x0a, 0x17, 0x56, 0x6f, 0x6c, 0x75,
+	0x6d, 0x65, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x4f, 0x72, 0x69, 0x67, 0x69, 0x6e, 0x54,
+	0x79, 0x70, 0x65, 0x12, 0x10, 0x0a, 0x0c, 0x56, 0x43, 0x4f, 0x54, 0x5f, 0x55, 0x4e, 0x4b, 0x4e,
+	0x4f, 0x57, 0x4e, 0x10, 0x00, 0x12, 0x0e, 0x0a, 0x0a, 0x56, 0x43, 0x4f, 0x54, 0x5f, 0x42, 0x4c,
+	0x41, 0x4e, 0x4b, 0x10, 0x01, 0x12, 0x11, 0x0a, 0x0d, 0x56, 0x43, 0x4f, 0x54, 0x5f, 0x44, 0x4f,
+	0x57, 0x4e, 0x4c, 0x4f, 0x41, 0x44, 0x10, 0x02, 0x12, 0x0f, 0x0a, 0x0b, 0x56, 0x43, 0x4f, 0x54,
+	0x5f, 0x42, 0x41, 0x43, 0x4b, 0x55, 0x50, 0x10, 0x03, 0x2a, 0x53, 0x0a, 0x0d, 0x56, 0x6f, 0x6c,
+	0x75, 0x6d, 0x65, 0x53, 0x68, 0x61, 0x72, 0x69, 0x6e, 0x67, 0x12, 0x1e, 0x0a, 0x1a, 0x56, 0x4f,
+	0x4c, 0x55, 0x4d, 0x45, 0x5f, 0x53, 0x48, 0x41, 0x52, 0x49, 0x4e, 0x47, 0x5f, 0x55, 0x4e, 0x53,
+	0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x22, 0x0a, 0x1e, 0x56, 0x4f,
+	0x4c, 0x55, 0x4d, 0x45, 0x5f, 0x53, 0x48, 0x41, 0x52, 0x49, 0x4e, 0x47, 0x5f, 0x52, 0x45, 0x41,
+	0x44, 0x5f, 0x57, 0x52, 0x49, 0x54, 0x45, 0x5f, 0x4d, 0x41, 0x4e, 0x59, 0x10, 0x01, 0x2a, 0x78,
+	0x0a, 0x0c, 0x56, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x46, 0x73, 0x54, 0x79, 0x70, 0x65, 0x12, 0x1e,
+	0x0a, 0x1a, 0x56, 0x4f, 0x4c, 0x55, 0x4d, 0x45, 0x5f, 0x46, 0x53, 0x5f, 0x54, 0x59, 0x50, 0x45,
+	0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x17,
+	0x0a, 0x13, 0x56, 0x4f, 0x4c, 0x55, 0x4d, 0x45, 0x5f, 0x46, 0x53, 0x5f, 0x54, 0x59, 0x50, 0x45,
+	0x5f, 0x45, 0x58, 0x54, 0x34, 0x10, 0x01, 0x12, 0x16, 0x0a, 0x12, 0x56, 0x4f, 0x4c, 0x55, 0x4d,
+	0x45, 0x5f, 0x46, 0x53, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x58, 0x46, 0x53, 0x10, 0x02, 0x12,
+	0x17, 0x0a, 0x13, 0x56, 0x4f, 0x4c, 0x55, 0x4d, 0x45, 0x5f, 0x46, 0x53, 0x5f, 0x54, 0x59, 0x50,
+	0x45, 0x5f, 0x56, 0x46, 0x41, 0x54, 0x10, 0x03, 0x2a, 0x8c, 0x02, 0x0a, 0x0e, 0x44, 0x69, 0x73,
+	0x6b, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x54, 0x79, 0x70, 0x65, 0x12, 0x20, 0x0a, 0x1c, 0x44,
 	0x49, 0x53, 0x4b, 0x5f, 0x43, 0x4f, 0x4e, 0x46, 0x49, 0x47, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f,
-	0x5a, 0x46, 0x53, 0x5f, 0x4f, 0x4e, 0x4c, 0x49, 0x4e, 0x45, 0x10, 0x03, 0x12, 0x20, 0x0a, 0x1c,
-	0x44, 0x49, 0x53, 0x4b, 0x5f, 0x43, 0x4f, 0x4e, 0x46, 0x49, 0x47, 0x5f, 0x54, 0x59, 0x50, 0x45,
-	0x5f, 0x5a, 0x46, 0x53, 0x5f, 0x4f, 0x46, 0x46, 0x4c, 0x49, 0x4e, 0x45, 0x10, 0x04, 0x12, 0x1e,
-	0x0a, 0x1a, 0x44, 0x49, 0x53, 0x4b, 0x5f, 0x43, 0x4f, 0x4e, 0x46, 0x49, 0x47, 0x5f, 0x54, 0x59,
-	0x50, 0x45, 0x5f, 0x41, 0x50, 0x50, 0x44, 0x49, 0x52, 0x45, 0x43, 0x54, 0x10, 0x05, 0x12, 0x1b,
-	0x0a, 0x17, 0x44, 0x49, 0x53, 0x4b, 0x5f, 0x43, 0x4f, 0x4e, 0x46, 0x49, 0x47, 0x5f, 0x54, 0x59,
-	0x50, 0x45, 0x5f, 0x55, 0x4e, 0x55, 0x53, 0x45, 0x44, 0x10, 0x06, 0x2a, 0xa2, 0x01, 0x0a, 0x0e,
-	0x44, 0x69, 0x73, 0x6b, 0x73, 0x41, 0x72, 0x72, 0x61, 0x79, 0x54, 0x79, 0x70, 0x65, 0x12, 0x20,
-	0x0a, 0x1c, 0x44, 0x49, 0x53, 0x4b, 0x53, 0x5f, 0x41, 0x52, 0x52, 0x41, 0x59, 0x5f, 0x54, 0x59,
-	0x50, 0x45, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00,
-	0x12, 0x1a, 0x0a, 0x16, 0x44, 0x49, 0x53, 0x4b, 0x53, 0x5f, 0x41, 0x52, 0x52, 0x41, 0x59, 0x5f,
-	0x54, 0x59, 0x50, 0x45, 0x5f, 0x52, 0x41, 0x49, 0x44, 0x30, 0x10, 0x01, 0x12, 0x1a, 0x0a, 0x16,
+	0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x1a, 0x0a,
+	0x16, 0x44, 0x49, 0x53, 0x4b, 0x5f, 0x43, 0x4f, 0x4e, 0x46, 0x49, 0x47, 0x5f, 0x54, 0x59, 0x50,
+	0x45, 0x5f, 0x45, 0x56, 0x45, 0x4f, 0x53, 0x10, 0x01, 0x12, 0x1c, 0x0a, 0x18, 0x44, 0x49, 0x53,
+	0x4b, 0x5f, 0x43, 0x4f, 0x4e, 0x46, 0x49, 0x47, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x50, 0x45,
+	0x52, 0x53, 0x49, 0x53, 0x54, 0x10, 0x02, 0x12, 0x1f, 0x0a, 0x1b, 0x44, 0x49, 0x53, 0x4b, 0x5f,
+	0x43, 0x4f, 0x4e, 0x46, 0x49, 0x47, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x5a, 0x46, 0x53, 0x5f,
+	0x4f, 0x4e, 0x4c, 0x49, 0x4e, 0x45, 0x10, 0x03, 0x12, 0x20, 0x0a, 0x1c, 0x44, 0x49, 0x53, 0x4b,
+	0x5f, 0x43, 0x4f, 0x4e, 0x46, 0x49, 0x47, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x5a, 0x46, 0x53,
+	0x5f, 0x4f, 0x46, 0x46, 0x4c, 0x49, 0x4e, 0x45, 0x10, 0x04, 0x12, 0x1e, 0x0a, 0x1a, 0x44, 0x49,
+	0x53, 0x4b, 0x5f, 0x43, 0x4f, 0x4e, 0x46, 0x49, 0x47, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x41,
+	0x50, 0x50, 0x44, 0x49, 0x52, 0x45, 0x43, 0x54, 0x10, 0x05, 0x12, 0x1b, 0x0a, 0x17, 0x44, 0x49,
+	0x53, 0x4b, 0x5f, 0x43, 0x4f, 0x4e, 0x46, 0x49, 0x47, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x55,
+	0x4e, 0x55, 0x53, 0x45, 0x44, 0x10, 0x06, 0x12, 0x1e, 0x0a, 0x1a, 0x44, 0x49, 0x53, 0x4b, 0x5f,
+	0x43, 0x4f, 0x4e, 0x46, 0x49, 0x47, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x5a, 0x46, 0x53, 0x5f,
+	0x53, 0x50, 0x41, 0x52, 0x45, 0x10, 0x07, 0x2a, 0xa2, 0x01, 0x0a, 0x0e, 0x44, 0x69, 0x73, 0x6b,
+	0x73, 0x41, 0x72, 0x72, 0x61, 0x79, 0x54, 0x79, 0x70, 0x65, 0x12, 0x20, 0x0a, 0x1c, 0x44, 0x49,
+	0x53, 0x4b, 0x53, 0x5f, 0x41, 0x52, 0x52, 0x41, 0x59, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x55,
+	0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x1a, 0x0a, 0x16,
 	0x44, 0x49, 0x53, 0x4b, 0x53, 0x5f, 0x41, 0x52, 0x52, 0x41, 0x59, 0x5f, 0x54, 0x59, 0x50, 0x45,
-	0x5f, 0x52, 0x41, 0x49, 0x44, 0x31, 0x10, 0x02, 0x12, 0x1a, 0x0a, 0x16, 0x44, 0x49, 0x53, 0x4b,
+	0x5f, 0x52, 0x41, 0x49, 0x44, 0x30, 0x10, 0x01, 0x12, 0x1a, 0x0a, 0x16, 0x44, 0x49, 0x53, 0x4b,
 	0x53, 0x5f, 0x41, 0x52, 0x52, 0x41, 0x59, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x52, 0x41, 0x49,
-	0x44, 0x35, 0x10, 0x03, 0x12, 0x1a, 0x0a, 0x16, 0x44, 0x49, 0x53, 0x4b, 0x53, 0x5f, 0x41, 0x52,
-	0x52, 0x41, 0x59, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x52, 0x41, 0x49, 0x44, 0x36, 0x10, 0x04,
-	0x42, 0x3d, 0x0a, 0x15, 0x6f, 0x72, 0x67, 0x2e, 0x6c, 0x66, 0x65, 0x64, 0x67, 0x65, 0x2e, 0x65,
-	0x76, 0x65, 0x2e, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x5a, 0x24, 0x67, 0x69, 0x74, 0x68, 0x75,
-	0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x6c, 0x66, 0x2d, 0x65, 0x64, 0x67, 0x65, 0x2f, 0x65, 0x76,
-	0x65, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x67, 0x6f, 0x2f, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x62,
-	0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x44, 0x31, 0x10, 0x02, 0x12, 0x1a, 0x0a, 0x16, 0x44, 0x49, 0x53, 0x4b, 0x53, 0x5f, 0x41, 0x52,
+	0x52, 0x41, 0x59, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x52, 0x41, 0x49, 0x44, 0x35, 0x10, 0x03,
+	0x12, 0x1a, 0x0a, 0x16, 0x44, 0x49, 0x53, 0x4b, 0x53, 0x5f, 0x41, 0x52, 0x52, 0x41, 0x59, 0x5f,
+	0x54, 0x59, 0x50, 0x45, 0x5f, 0x52, 0x41, 0x49, 0x44, 0x36, 0x10, 0x04, 0x42, 0x3d, 0x0a, 0x15,
+	0x6f, 0x72, 0x67, 0x2e, 0x6c, 0x66, 0x65, 0x64, 0x67, 0x65, 0x2e, 0x65, 0x76, 0x65, 0x2e, 0x63,
+	0x6f, 0x6e, 0x66, 0x69, 0x67, 0x5a, 0x24, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f,
+	0x6d, 0x2f, 0x6c, 0x66, 0x2d, 0x65, 0x64, 0x67, 0x65, 0x2f, 0x65, 0x76, 0x65, 0x2f, 0x61, 0x70,
+	0x69, 0x2f, 0x67, 0x6f, 0x2f, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x62, 0x06, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x33,
 }
 
 var (
@@ -1521,8 +2195,8 @@ func file_config_storage_proto_rawDescGZIP() []byte {
 	return file_config_storage_proto_rawDescData
 }
 
-var file_config_storage_proto_enumTypes = make([]protoimpl.EnumInfo, 8)
-var file_config_storage_proto_msgTypes = make([]protoimpl.MessageInfo, 9)
+var file_config_storage_proto_enumTypes = make([]protoimpl.EnumInfo, 10)
+var file_config_storage_proto_msgTypes = make([]protoimpl.MessageInfo, 14)
 var file_config_storage_proto_goTypes = []interface{}{
 	(DsType)(0),                       // 0: org.lfedge.eve.config.DsType
 	(Format)(0),                       // 1: org.lfedge.eve.config.Format
@@ -1530,46 +2204,66 @@ var file_config_storage_proto_goTypes = []interface{}{
 	(DriveType)(0),                    // 3: org.lfedge.eve.config.DriveType
 	(VolumeAccessProtocols)(0),        // 4: org.lfedge.eve.config.VolumeAccessProtocols
 	(VolumeContentOriginType)(0),      // 5: org.lfedge.eve.config.VolumeContentOriginType
-	(DiskConfigType)(0),               // 6: org.lfedge.eve.config.DiskConfigType
-	(DisksArrayType)(0),               // 7: org.lfedge.eve.config.DisksArrayType
-	(*SignatureInfo)(nil),             // 8: org.lfedge.eve.config.SignatureInfo
-	(*DatastoreConfig)(nil),           // 9: org.lfedge.eve.config.DatastoreConfig
-	(*Image)(nil),                     // 10: org.lfedge.eve.config.Image
-	(*Drive)(nil),                     // 11: org.lfedge.eve.config.Drive
-	(*ContentTree)(nil),               // 12: org.lfedge.eve.config.ContentTree
-	(*VolumeContentOrigin)(nil),       // 13: org.lfedge.eve.config.VolumeContentOrigin
-	(*Volume)(nil),                    // 14: org.lfedge.eve.config.Volume
-	(*DiskConfig)(nil),                // 15: org.lfedge.eve.config.DiskConfig
-	(*DisksConfig)(nil),               // 16: org.lfedge.eve.config.DisksConfig
-	(*CipherBlock)(nil),               // 17: org.lfedge.eve.config.CipherBlock
-	(*UUIDandVersion)(nil),            // 18: org.lfedge.eve.config.UUIDandVersion
-	(*evecommon.DiskDescription)(nil), // 19: org.lfedge.eve.common.DiskDescription
+	(VolumeSharing)(0),                // 6: org.lfedge.eve.config.VolumeSharing
+	(VolumeFsType)(0),                 // 7: org.lfedge.eve.config.VolumeFsType
+	(DiskConfigType)(0),               // 8: org.lfedge.eve.config.DiskConfigType
+	(DisksArrayType)(0),               // 9: org.lfedge.eve.config.DisksArrayType
+	(*SignatureInfo)(nil),             // 10: org.lfedge.eve.config.SignatureInfo
+	(*DatastoreConfig)(nil),           // 11: org.lfedge.eve.config.DatastoreConfig
+	(*Image)(nil),                     // 12: org.lfedge.eve.config.Image
+	(*Drive)(nil),                     // 13: org.lfedge.eve.config.Drive
+	(*ContentTree)(nil),               // 14: org.lfedge.eve.config.ContentTree
+	(*VolumeBackupRef)(nil),           // 15: org.lfedge.eve.config.VolumeBackupRef
+	(*VolumeContentOrigin)(nil),       // 16: org.lfedge.eve.config.VolumeContentOrigin
+	(*VolumeBackupPolicy)(nil),        // 17: org.lfedge.eve.config.VolumeBackupPolicy
+	(*VolumeIOLimits)(nil),            // 18: org.lfedge.eve.config.VolumeIOLimits
+	(*VolumeKeyPolicy)(nil),           // 19: org.lfedge.eve.config.VolumeKeyPolicy
+	(*VolumeFilesystem)(nil),          // 20: org.lfedge.eve.config.VolumeFilesystem
+	(*Volume)(nil),                    // 21: org.lfedge.eve.config.Volume
+	(*DiskConfig)(nil),                // 22: org.lfedge.eve.config.DiskConfig
+	(*DisksConfig)(nil),               // 23: org.lfedge.eve.config.DisksConfig
+	(*CipherBlock)(nil),               // 24: org.lfedge.eve.config.CipherBlock
+	(*SignaturePolicy)(nil),           // 25: org.lfedge.eve.config.SignaturePolicy
+	(*UUIDandVersion)(nil),            // 26: org.lfedge.eve.config.UUIDandVersion
+	(*ContentSchedule)(nil),           // 27: org.lfedge.eve.config.ContentSchedule
+	(*evecommon.DiskDescription)(nil), // 28: org.lfedge.eve.common.DiskDescription
 }
 var file_config_storage_proto_depIdxs = []int32{
 	0,  // 0: org.lfedge.eve.config.DatastoreConfig.dType:type_name -> org.lfedge.eve.config.DsType
-	17, // 1: org.lfedge.eve.config.DatastoreConfig.cipherData:type_name -> org.lfedge.eve.config.CipherBlock
-	18, // 2: org.lfedge.eve.config.Image.uuidandversion:type_name -> org.lfedge.eve.config.UUIDandVersion
-	1,  // 3: org.lfedge.eve.config.Image.iformat:type_name -> org.lfedge.eve.config.Format
-	8,  // 4: org.lfedge.eve.config.Image.siginfo:type_name -> org.lfedge.eve.config.SignatureInfo
-	10, // 5: org.lfedge.eve.config.Drive.image:type_name -> org.lfedge.eve.config.Image
-	3,  // 6: org.lfedge.eve.config.Drive.drvtype:type_name -> org.lfedge.eve.config.DriveType
-	2,  // 7: org.lfedge.eve.config.Drive.target:type_name -> org.lfedge.eve.config.Target
-	1,  // 8: org.lfedge.eve.config.ContentTree.iformat:type_name -> org.lfedge.eve.config.Format
-	8,  // 9: org.lfedge.eve.config.ContentTree.siginfo:type_name -> org.lfedge.eve.config.SignatureInfo
-	5,  // 10: org.lfedge.eve.config.VolumeContentOrigin.type:type_name -> org.lfedge.eve.config.VolumeContentOriginType
-	13, // 11: org.lfedge.eve.config.Volume.origin:type_name -> org.lfedge.eve.config.VolumeContentOrigin
-	4,  // 12: org.lfedge.eve.config.Volume.protocols:type_name -> org.lfedge.eve.config.VolumeAccessProtocols
-	19, // 13: org.lfedge.eve.config.DiskConfig.disk:type_name -> org.lfedge.eve.common.DiskDescription
-	19, // 14: org.lfedge.eve.config.DiskConfig.old_disk:type_name -> org.lfedge.eve.common.DiskDescription
-	6,  // 15: org.lfedge.eve.config.DiskConfig.disk_config:type_name -> org.lfedge.eve.config.DiskConfigType
-	15, // 16: org.lfedge.eve.config.DisksConfig.disks:type_name -> org.lfedge.eve.config.DiskConfig
-	7,  // 17: org.lfedge.eve.config.DisksConfig.array_type:type_name -> org.lfedge.eve.config.DisksArrayType
-	16, // 18: org.lfedge.eve.config.DisksConfig.children:type_name -> org.lfedge.eve.config.DisksConfig
-	19, // [19:19] is the sub-list for method output_type
-	19, // [19:19] is the sub-list for method input_type
-	19, // [19:19] is the sub-list for extension type_name
-	19, // [19:19] is the sub-list for extension extendee
-	0,  // [0:19] is the sub-list for field type_name
+	24, // 1: org.lfedge.eve.config.DatastoreConfig.cipherData:type_name -> org.lfedge.eve.config.CipherBlock
+	25, // 2: org.lfedge.eve.config.DatastoreConfig.signature_policy:type_name -> org.lfedge.eve.config.SignaturePolicy
+	26, // 3: org.lfedge.eve.config.Image.uuidandversion:type_name -> org.lfedge.eve.config.UUIDandVersion
+	1,  // 4: org.lfedge.eve.config.Image.iformat:type_name -> org.lfedge.eve.config.Format
+	10, // 5: org.lfedge.eve.config.Image.siginfo:type_name -> org.lfedge.eve.config.SignatureInfo
+	12, // 6: org.lfedge.eve.config.Drive.image:type_name -> org.lfedge.eve.config.Image
+	3,  // 7: org.lfedge.eve.config.Drive.drvtype:type_name -> org.lfedge.eve.config.DriveType
+	2,  // 8: org.lfedge.eve.config.Drive.target:type_name -> org.lfedge.eve.config.Target
+	1,  // 9: org.lfedge.eve.config.ContentTree.iformat:type_name -> org.lfedge.eve.config.Format
+	10, // 10: org.lfedge.eve.config.ContentTree.siginfo:type_name -> org.lfedge.eve.config.SignatureInfo
+	27, // 11: org.lfedge.eve.config.ContentTree.schedule:type_name -> org.lfedge.eve.config.ContentSchedule
+	25, // 12: org.lfedge.eve.config.ContentTree.signature_policy:type_name -> org.lfedge.eve.config.SignaturePolicy
+	5,  // 13: org.lfedge.eve.config.VolumeContentOrigin.type:type_name -> org.lfedge.eve.config.VolumeContentOriginType
+	15, // 14: org.lfedge.eve.config.VolumeContentOrigin.backup:type_name -> org.lfedge.eve.config.VolumeBackupRef
+	7,  // 15: org.lfedge.eve.config.VolumeFilesystem.type:type_name -> org.lfedge.eve.config.VolumeFsType
+	16, // 16: org.lfedge.eve.config.Volume.origin:type_name -> org.lfedge.eve.config.VolumeContentOrigin
+	4,  // 17: org.lfedge.eve.config.Volume.protocols:type_name -> org.lfedge.eve.config.VolumeAccessProtocols
+	17, // 18: org.lfedge.eve.config.Volume.backup:type_name -> org.lfedge.eve.config.VolumeBackupPolicy
+	6,  // 19: org.lfedge.eve.config.Volume.sharing:type_name -> org.lfedge.eve.config.VolumeSharing
+	18, // 20: org.lfedge.eve.config.Volume.io_limits:type_name -> org.lfedge.eve.config.VolumeIOLimits
+	19, // 21: org.lfedge.eve.config.Volume.key_policy:type_name -> org.lfedge.eve.config.VolumeKeyPolicy
+	20, // 22: org.lfedge.eve.config.Volume.filesystem:type_name -> org.lfedge.eve.config.VolumeFilesystem
+	24, // 23: org.lfedge.eve.config.Volume.backup_key:type_name -> org.lfedge.eve.config.CipherBlock
+	28, // 24: org.lfedge.eve.config.DiskConfig.disk:type_name -> org.lfedge.eve.common.DiskDescription
+	28, // 25: org.lfedge.eve.config.DiskConfig.old_disk:type_name -> org.lfedge.eve.common.DiskDescription
+	8,  // 26: org.lfedge.eve.config.DiskConfig.disk_config:type_name -> org.lfedge.eve.config.DiskConfigType
+	22, // 27: org.lfedge.eve.config.DisksConfig.disks:type_name -> org.lfedge.eve.config.DiskConfig
+	9,  // 28: org.lfedge.eve.config.DisksConfig.array_type:type_name -> org.lfedge.eve.config.DisksArrayType
+	23, // 29: org.lfedge.eve.config.DisksConfig.children:type_name -> org.lfedge.eve.config.DisksConfig
+	30, // [30:30] is the sub-list for method output_type
+	30, // [30:30] is the sub-list for method input_type
+	30, // [30:30] is the sub-list for extension type_name
+	30, // [30:30] is the sub-list for extension extendee
+	0,  // [0:30] is the sub-list for field type_name
 }
 
 func init() { file_config_storage_proto_init() }
@@ -1579,6 +2273,8 @@ func file_config_storage_proto_init() {
 	}
 	file_config_devcommon_proto_init()
 	file_config_acipherinfo_proto_init()
+	file_config_schedule_proto_init()
+	file_config_signaturepolicy_proto_init()
 	if !protoimpl.UnsafeEnabled {
 		file_config_storage_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*SignatureInfo); i {
@@ -1641,7 +2337,7 @@ func file_config_storage_proto_init() {
 			}
 		}
 		file_config_storage_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*VolumeContentOrigin); i {
+			switch v := v.(*VolumeBackupRef); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1653,7 +2349,7 @@ func file_config_storage_proto_init() {
 			}
 		}
 		file_config_storage_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Volume); i {
+			switch v := v.(*VolumeContentOrigin); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1665,7 +2361,7 @@ func file_config_storage_proto_init() {
 			}
 		}
 		file_config_storage_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*DiskConfig); i {
+			switch v := v.(*VolumeBackupPolicy); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1677,6 +2373,66 @@ func file_config_storage_proto_init() {
 			}
 		}
 		file_config_storage_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*VolumeIOLimits); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_config_storage_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*VolumeKeyPolicy); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_config_storage_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*VolumeFilesystem); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_config_storage_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Volume); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_config_storage_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DiskConfig); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_config_storage_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*DisksConfig); i {
 			case 0:
 				return &v.state
@@ -1694,8 +2450,8 @@ func file_config_storage_proto_init() {
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_config_storage_proto_rawDesc,
-			NumEnums:      8,
-			NumMessages:   9,
+			NumEnums:      10,
+			NumMessages:   14,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
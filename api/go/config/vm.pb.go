@@ -85,6 +85,56 @@ func (VmMode) EnumDescriptor() ([]byte, []int) {
 	return file_config_vm_proto_rawDescGZIP(), []int{0}
 }
 
+// The interface of the software TPM of a VM
+type VtpmModel int32
+
+const (
+	VtpmModel_VTPM_NONE VtpmModel = 0 // No TPM
+	VtpmModel_VTPM_TIS  VtpmModel = 1 // TPM Interface Specification
+	VtpmModel_VTPM_CRB  VtpmModel = 2 // Command Response Buffer, e.g. for Windows 11; TIS on arm64
+)
+
+// Enum value maps for VtpmModel.
+var (
+	VtpmModel_name = map[int32]string{
+		0: "VTPM_NONE",
+		1: "VTPM_TIS",
+		2: "VTPM_CRB",
+	}
+	VtpmModel_value = map[string]int32{
+		"VTPM_NONE": 0,
+		"VTPM_TIS":  1,
+		"VTPM_CRB":  2,
+	}
+)
+
+func (x VtpmModel) Enum() *VtpmModel {
+	p := new(VtpmModel)
+	*p = x
+	return p
+}
+
+func (x VtpmModel) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (VtpmModel) Descriptor() protoreflect.EnumDescriptor {
+	return file_config_vm_proto_enumTypes[1].Descriptor()
+}
+
+func (VtpmModel) Type() protoreflect.EnumType {
+	return &file_config_vm_proto_enumTypes[1]
+}
+
+func (x VtpmModel) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use VtpmModel.Descriptor instead.
+func (VtpmModel) EnumDescriptor() ([]byte, []int) {
+	return file_config_vm_proto_rawDescGZIP(), []int{1}
+}
+
 type VmConfig struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -109,6 +159,18 @@ type VmConfig struct {
 	VncDisplay         uint32   `protobuf:"varint,17,opt,name=vncDisplay,proto3" json:"vncDisplay,omitempty"`
 	VncPasswd          string   `protobuf:"bytes,18,opt,name=vncPasswd,proto3" json:"vncPasswd,omitempty"`
 	DisableLogs        bool     `protobuf:"varint,19,opt,name=disableLogs,proto3" json:"disableLogs,omitempty"`
+	// Run the app instance on dedicated CPUs, one per vcpu, isolated from
+	// EVE and from other app instances, with its vcpus scheduled as
+	// SCHED_FIFO. The memory of such an app instance is not ballooned.
+	Realtime bool `protobuf:"varint,20,opt,name=realtime,proto3" json:"realtime,omitempty"`
+	// Attach a software TPM to the VM. Its state is kept encrypted in the
+	// vault across restarts and destroyed when the app instance is deleted.
+	Vtpm VtpmModel `protobuf:"varint,21,opt,name=vtpm,proto3,enum=org.lfedge.eve.config.VtpmModel" json:"vtpm,omitempty"`
+	// Clear the state of the TPM when the app instance is purged
+	VtpmResetOnPurge bool `protobuf:"varint,22,opt,name=vtpmResetOnPurge,proto3" json:"vtpmResetOnPurge,omitempty"`
+	// Boot the VM with UEFI Secure Boot. The VM keeps the variables of its
+	// firmware in a NVRAM of its own.
+	SecureBoot bool `protobuf:"varint,23,opt,name=secureBoot,proto3" json:"secureBoot,omitempty"`
 }
 
 func (x *VmConfig) Reset() {
@@ -276,12 +338,40 @@ func (x *VmConfig) GetDisableLogs() bool {
 	return false
 }
 
+func (x *VmConfig) GetRealtime() bool {
+	if x != nil {
+		return x.Realtime
+	}
+	return false
+}
+
+func (x *VmConfig) GetVtpm() VtpmModel {
+	if x != nil {
+		return x.Vtpm
+	}
+	return VtpmModel_VTPM_NONE
+}
+
+func (x *VmConfig) GetVtpmResetOnPurge() bool {
+	if x != nil {
+		return x.VtpmResetOnPurge
+	}
+	return false
+}
+
+func (x *VmConfig) GetSecureBoot() bool {
+	if x != nil {
+		return x.SecureBoot
+	}
+	return false
+}
+
 var File_config_vm_proto protoreflect.FileDescriptor
 
 var file_config_vm_proto_rawDesc = []byte{
 	0x0a, 0x0f, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2f, 0x76, 0x6d, 0x2e, 0x70, 0x72, 0x6f, 0x74,
 	0x6f, 0x12, 0x15, 0x6f, 0x72, 0x67, 0x2e, 0x6c, 0x66, 0x65, 0x64, 0x67, 0x65, 0x2e, 0x65, 0x76,
-	0x65, 0x2e, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x22, 0xb5, 0x04, 0x0a, 0x08, 0x56, 0x6d, 0x43,
+	0x65, 0x2e, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x22, 0xd3, 0x05, 0x0a, 0x08, 0x56, 0x6d, 0x43,
 	0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x16, 0x0a, 0x06, 0x6b, 0x65, 0x72, 0x6e, 0x65, 0x6c, 0x18,
 	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x6b, 0x65, 0x72, 0x6e, 0x65, 0x6c, 0x12, 0x18, 0x0a,
 	0x07, 0x72, 0x61, 0x6d, 0x64, 0x69, 0x73, 0x6b, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07,
@@ -317,15 +407,29 @@ var file_config_vm_proto_rawDesc = []byte{
 	0x01, 0x28, 0x09, 0x52, 0x09, 0x76, 0x6e, 0x63, 0x50, 0x61, 0x73, 0x73, 0x77, 0x64, 0x12, 0x20,
 	0x0a, 0x0b, 0x64, 0x69, 0x73, 0x61, 0x62, 0x6c, 0x65, 0x4c, 0x6f, 0x67, 0x73, 0x18, 0x13, 0x20,
 	0x01, 0x28, 0x08, 0x52, 0x0b, 0x64, 0x69, 0x73, 0x61, 0x62, 0x6c, 0x65, 0x4c, 0x6f, 0x67, 0x73,
-	0x2a, 0x47, 0x0a, 0x06, 0x56, 0x6d, 0x4d, 0x6f, 0x64, 0x65, 0x12, 0x06, 0x0a, 0x02, 0x50, 0x56,
-	0x10, 0x00, 0x12, 0x07, 0x0a, 0x03, 0x48, 0x56, 0x4d, 0x10, 0x01, 0x12, 0x0a, 0x0a, 0x06, 0x46,
-	0x69, 0x6c, 0x6c, 0x65, 0x72, 0x10, 0x02, 0x12, 0x07, 0x0a, 0x03, 0x46, 0x4d, 0x4c, 0x10, 0x03,
-	0x12, 0x0b, 0x0a, 0x07, 0x4e, 0x4f, 0x48, 0x59, 0x50, 0x45, 0x52, 0x10, 0x04, 0x12, 0x0a, 0x0a,
-	0x06, 0x4c, 0x45, 0x47, 0x41, 0x43, 0x59, 0x10, 0x05, 0x42, 0x3d, 0x0a, 0x15, 0x6f, 0x72, 0x67,
+	0x12, 0x1a, 0x0a, 0x08, 0x72, 0x65, 0x61, 0x6c, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x14, 0x20, 0x01,
+	0x28, 0x08, 0x52, 0x08, 0x72, 0x65, 0x61, 0x6c, 0x74, 0x69, 0x6d, 0x65, 0x12, 0x34, 0x0a, 0x04,
+	0x76, 0x74, 0x70, 0x6d, 0x18, 0x15, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x20, 0x2e, 0x6f, 0x72, 0x67,
 	0x2e, 0x6c, 0x66, 0x65, 0x64, 0x67, 0x65, 0x2e, 0x65, 0x76, 0x65, 0x2e, 0x63, 0x6f, 0x6e, 0x66,
-	0x69, 0x67, 0x5a, 0x24, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x6c,
-	0x66, 0x2d, 0x65, 0x64, 0x67, 0x65, 0x2f, 0x65, 0x76, 0x65, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x67,
-	0x6f, 0x2f, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x69, 0x67, 0x2e, 0x56, 0x74, 0x70, 0x6d, 0x4d, 0x6f, 0x64, 0x65, 0x6c, 0x52, 0x04, 0x76, 0x74,
+	0x70, 0x6d, 0x12, 0x2a, 0x0a, 0x10, 0x76, 0x74, 0x70, 0x6d, 0x52, 0x65, 0x73, 0x65, 0x74, 0x4f,
+	0x6e, 0x50, 0x75, 0x72, 0x67, 0x65, 0x18, 0x16, 0x20, 0x01, 0x28, 0x08, 0x52, 0x10, 0x76, 0x74,
+	0x70, 0x6d, 0x52, 0x65, 0x73, 0x65, 0x74, 0x4f, 0x6e, 0x50, 0x75, 0x72, 0x67, 0x65, 0x12, 0x1e,
+	0x0a, 0x0a, 0x73, 0x65, 0x63, 0x75, 0x72, 0x65, 0x42, 0x6f, 0x6f, 0x74, 0x18, 0x17, 0x20, 0x01,
+	0x28, 0x08, 0x52, 0x0a, 0x73, 0x65, 0x63, 0x75, 0x72, 0x65, 0x42, 0x6f, 0x6f, 0x74, 0x2a, 0x47,
+	0x0a, 0x06, 0x56, 0x6d, 0x4d, 0x6f, 0x64, 0x65, 0x12, 0x06, 0x0a, 0x02, 0x50, 0x56, 0x10, 0x00,
+	0x12, 0x07, 0x0a, 0x03, 0x48, 0x56, 0x4d, 0x10, 0x01, 0x12, 0x0a, 0x0a, 0x06, 0x46, 0x69, 0x6c,
+	0x6c, 0x65, 0x72, 0x10, 0x02, 0x12, 0x07, 0x0a, 0x03, 0x46, 0x4d, 0x4c, 0x10, 0x03, 0x12, 0x0b,
+	0x0a, 0x07, 0x4e, 0x4f, 0x48, 0x59, 0x50, 0x45, 0x52, 0x10, 0x04, 0x12, 0x0a, 0x0a, 0x06, 0x4c,
+	0x45, 0x47, 0x41, 0x43, 0x59, 0x10, 0x05, 0x2a, 0x36, 0x0a, 0x09, 0x56, 0x74, 0x70, 0x6d, 0x4d,
+	0x6f, 0x64, 0x65, 0x6c, 0x12, 0x0d, 0x0a, 0x09, 0x56, 0x54, 0x50, 0x4d, 0x5f, 0x4e, 0x4f, 0x4e,
+	0x45, 0x10, 0x00, 0x12, 0x0c, 0x0a, 0x08, 0x56, 0x54, 0x50, 0x4d, 0x5f, 0x54, 0x49, 0x53, 0x10,
+	0x01, 0x12, 0x0c, 0x0a, 0x08, 0x56, 0x54, 0x50, 0x4d, 0x5f, 0x43, 0x52, 0x42, 0x10, 0x02, 0x42,
+	0x3d, 0x0a, 0x15, 0x6f, 0x72, 0x67, 0x2e, 0x6c, 0x66, 0x65, 0x64, 0x67, 0x65, 0x2e, 0x65, 0x76,
+	0x65, 0x2e, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x5a, 0x24, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62,
+	0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x6c, 0x66, 0x2d, 0x65, 0x64, 0x67, 0x65, 0x2f, 0x65, 0x76, 0x65,
+	0x2f, 0x61, 0x70, 0x69, 0x2f, 0x67, 0x6f, 0x2f, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x62, 0x06,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -340,19 +444,21 @@ func file_config_vm_proto_rawDescGZIP() []byte {
 	return file_config_vm_proto_rawDescData
 }
 
-var file_config_vm_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
+var file_config_vm_proto_enumTypes = make([]protoimpl.EnumInfo, 2)
 var file_config_vm_proto_msgTypes = make([]protoimpl.MessageInfo, 1)
 var file_config_vm_proto_goTypes = []interface{}{
 	(VmMode)(0),      // 0: org.lfedge.eve.config.VmMode
-	(*VmConfig)(nil), // 1: org.lfedge.eve.config.VmConfig
+	(VtpmModel)(0),   // 1: org.lfedge.eve.config.VtpmModel
+	(*VmConfig)(nil), // 2: org.lfedge.eve.config.VmConfig
 }
 var file_config_vm_proto_depIdxs = []int32{
 	0, // 0: org.lfedge.eve.config.VmConfig.virtualizationMode:type_name -> org.lfedge.eve.config.VmMode
-	1, // [1:1] is the sub-list for method output_type
-	1, // [1:1] is the sub-list for method input_type
-	1, // [1:1] is the sub-list for extension type_name
-	1, // [1:1] is the sub-list for extension extendee
-	0, // [0:1] is the sub-list for field type_name
+	1, // 1: org.lfedge.eve.config.VmConfig.vtpm:type_name -> org.lfedge.eve.config.VtpmModel
+	2, // [2:2] is the sub-list for method output_type
+	2, // [2:2] is the sub-list for method input_type
+	2, // [2:2] is the sub-list for extension type_name
+	2, // [2:2] is the sub-list for extension extendee
+	0, // [0:2] is the sub-list for field type_name
 }
 
 func init() { file_config_vm_proto_init() }
@@ -379,7 +485,7 @@ func file_config_vm_proto_init() {
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_config_vm_proto_rawDesc,
-			NumEnums:      1,
+			NumEnums:      2,
 			NumMessages:   1,
 			NumExtensions: 0,
 			NumServices:   0,
@@ -0,0 +1,200 @@
+# -*- coding: utf-8 -*-
+# Generated by the protocol buffer compiler.  DO NOT EDIT!
+# source: batch/batch.proto
+"""Generated protocol buffer code."""
+from google.protobuf import descriptor as _descriptor
+from google.protobuf import message as _message
+from google.protobuf import reflection as _reflection
+from google.protobuf import symbol_database as _symbol_database
+# @@protoc_insertion_point(imports)
+
+_sym_db = _symbol_database.Default()
+
+
+
+
+DESCRIPTOR = _descriptor.FileDescriptor(
+  name='batch/batch.proto',
+  package='org.lfedge.eve.batch',
+  syntax='proto3',
+  serialized_options=b'\n\024org.lfedge.eve.batchZ#github.com/lf-edge/eve/api/go/batch',
+  create_key=_descriptor._internal_create_key,
+  serialized_pb=b'\n\x11\x62\x61tch/batch.proto\x12\x14org.lfedge.eve.batch\">\n\x0c\x42\x61tchRequest\x12.\n\x05items\x18\x01 \x03(\x0b\x32\x1f.org.lfedge.eve.batch.BatchItem\"\'\n\tBatchItem\x12\x0c\n\x04path\x18\x01 \x01(\t\x12\x0c\n\x04\x62ody\x18\x02 \x01(\x0c\"G\n\rBatchResponse\x12\x36\n\x07results\x18\x01 \x03(\x0b\x32%.org.lfedge.eve.batch.BatchItemResult\"&\n\x0f\x42\x61tchItemResult\x12\x13\n\x0bstatus_code\x18\x01 \x01(\rB;\n\x14org.lfedge.eve.batchZ#github.com/lf-edge/eve/api/go/batchb\x06proto3'
+)
+
+
+
+
+_BATCHREQUEST = _descriptor.Descriptor(
+  name='BatchRequest',
+  full_name='org.lfedge.eve.batch.BatchRequest',
+  filename=None,
+  file=DESCRIPTOR,
+  containing_type=None,
+  create_key=_descriptor._internal_create_key,
+  fields=[
+    _descriptor.FieldDescriptor(
+      name='items', full_name='org.lfedge.eve.batch.BatchRequest.items', index=0,
+      number=1, type=11, cpp_type=10, label=3,
+      has_default_value=False, default_value=[],
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
+  ],
+  extensions=[
+  ],
+  nested_types=[],
+  enum_types=[
+  ],
+  serialized_options=None,
+  is_extendable=False,
+  syntax='proto3',
+  extension_ranges=[],
+  oneofs=[
+  ],
+  serialized_start=43,
+  serialized_end=105,
+)
+
+
+_BATCHITEM = _descriptor.Descriptor(
+  name='BatchItem',
+  full_name='org.lfedge.eve.batch.BatchItem',
+  filename=None,
+  file=DESCRIPTOR,
+  containing_type=None,
+  create_key=_descriptor._internal_create_key,
+  fields=[
+    _descriptor.FieldDescriptor(
+      name='path', full_name='org.lfedge.eve.batch.BatchItem.path', index=0,
+      number=1, type=9, cpp_type=9, label=1,
+      has_default_value=False, default_value=b"".decode('utf-8'),
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
+    _descriptor.FieldDescriptor(
+      name='body', full_name='org.lfedge.eve.batch.BatchItem.body', index=1,
+      number=2, type=12, cpp_type=9, label=1,
+      has_default_value=False, default_value=b"",
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
+  ],
+  extensions=[
+  ],
+  nested_types=[],
+  enum_types=[
+  ],
+  serialized_options=None,
+  is_extendable=False,
+  syntax='proto3',
+  extension_ranges=[],
+  oneofs=[
+  ],
+  serialized_start=107,
+  serialized_end=146,
+)
+
+
+_BATCHRESPONSE = _descriptor.Descriptor(
+  name='BatchResponse',
+  full_name='org.lfedge.eve.batch.BatchResponse',
+  filename=None,
+  file=DESCRIPTOR,
+  containing_type=None,
+  create_key=_descriptor._internal_create_key,
+  fields=[
+    _descriptor.FieldDescriptor(
+      name='results', full_name='org.lfedge.eve.batch.BatchResponse.results', index=0,
+      number=1, type=11, cpp_type=10, label=3,
+      has_default_value=False, default_value=[],
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
+  ],
+  extensions=[
+  ],
+  nested_types=[],
+  enum_types=[
+  ],
+  serialized_options=None,
+  is_extendable=False,
+  syntax='proto3',
+  extension_ranges=[],
+  oneofs=[
+  ],
+  serialized_start=148,
+  serialized_end=219,
+)
+
+
+_BATCHITEMRESULT = _descriptor.Descriptor(
+  name='BatchItemResult',
+  full_name='org.lfedge.eve.batch.BatchItemResult',
+  filename=None,
+  file=DESCRIPTOR,
+  containing_type=None,
+  create_key=_descriptor._internal_create_key,
+  fields=[
+    _descriptor.FieldDescriptor(
+      name='status_code', full_name='org.lfedge.eve.batch.BatchItemResult.status_code', index=0,
+      number=1, type=13, cpp_type=3, label=1,
+      has_default_value=False, default_value=0,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
+  ],
+  extensions=[
+  ],
+  nested_types=[],
+  enum_types=[
+  ],
+  serialized_options=None,
+  is_extendable=False,
+  syntax='proto3',
+  extension_ranges=[],
+  oneofs=[
+  ],
+  serialized_start=221,
+  serialized_end=259,
+)
+
+_BATCHREQUEST.fields_by_name['items'].message_type = _BATCHITEM
+_BATCHRESPONSE.fields_by_name['results'].message_type = _BATCHITEMRESULT
+DESCRIPTOR.message_types_by_name['BatchRequest'] = _BATCHREQUEST
+DESCRIPTOR.message_types_by_name['BatchItem'] = _BATCHITEM
+DESCRIPTOR.message_types_by_name['BatchResponse'] = _BATCHRESPONSE
+DESCRIPTOR.message_types_by_name['BatchItemResult'] = _BATCHITEMRESULT
+_sym_db.RegisterFileDescriptor(DESCRIPTOR)
+
+BatchRequest = _reflection.GeneratedProtocolMessageType('BatchRequest', (_message.Message,), {
+  'DESCRIPTOR' : _BATCHREQUEST,
+  '__module__' : 'batch.batch_pb2'
+  # @@protoc_insertion_point(class_scope:org.lfedge.eve.batch.BatchRequest)
+  })
+_sym_db.RegisterMessage(BatchRequest)
+
+BatchItem = _reflection.GeneratedProtocolMessageType('BatchItem', (_message.Message,), {
+  'DESCRIPTOR' : _BATCHITEM,
+  '__module__' : 'batch.batch_pb2'
+  # @@protoc_insertion_point(class_scope:org.lfedge.eve.batch.BatchItem)
+  })
+_sym_db.RegisterMessage(BatchItem)
+
+BatchResponse = _reflection.GeneratedProtocolMessageType('BatchResponse', (_message.Message,), {
+  'DESCRIPTOR' : _BATCHRESPONSE,
+  '__module__' : 'batch.batch_pb2'
+  # @@protoc_insertion_point(class_scope:org.lfedge.eve.batch.BatchResponse)
+  })
+_sym_db.RegisterMessage(BatchResponse)
+
+BatchItemResult = _reflection.GeneratedProtocolMessageType('BatchItemResult', (_message.Message,), {
+  'DESCRIPTOR' : _BATCHITEMRESULT,
+  '__module__' : 'batch.batch_pb2'
+  # @@protoc_insertion_point(class_scope:org.lfedge.eve.batch.BatchItemResult)
+  })
+_sym_db.RegisterMessage(BatchItemResult)
+
+
+DESCRIPTOR._options = None
+# @@protoc_insertion_point(module_scope)
@@ -17,6 +17,7 @@ from config import devcommon_pb2 as config_dot_devcommon__pb2
 from config import storage_pb2 as config_dot_storage__pb2
 from config import vm_pb2 as config_dot_vm__pb2
 from config import netconfig_pb2 as config_dot_netconfig__pb2
+from google.protobuf import timestamp_pb2 as google_dot_protobuf_dot_timestamp__pb2
 
 
 DESCRIPTOR = _descriptor.FileDescriptor(
@@ -25,9 +26,9 @@ DESCRIPTOR = _descriptor.FileDescriptor(
   syntax='proto3',
   serialized_options=b'\n\025org.lfedge.eve.configZ$github.com/lf-edge/eve/api/go/config',
   create_key=_descriptor._internal_create_key,
-  serialized_pb=b'\n\x16\x63onfig/appconfig.proto\x12\x15org.lfedge.eve.config\x1a\x18\x63onfig/acipherinfo.proto\x1a\x16\x63onfig/devcommon.proto\x1a\x14\x63onfig/storage.proto\x1a\x0f\x63onfig/vm.proto\x1a\x16\x63onfig/netconfig.proto\"2\n\x0eInstanceOpsCmd\x12\x0f\n\x07\x63ounter\x18\x02 \x01(\r\x12\x0f\n\x07opsTime\x18\x04 \x01(\t\"\xe2\x05\n\x11\x41ppInstanceConfig\x12=\n\x0euuidandversion\x18\x01 \x01(\x0b\x32%.org.lfedge.eve.config.UUIDandVersion\x12\x13\n\x0b\x64isplayname\x18\x02 \x01(\t\x12\x37\n\x0e\x66ixedresources\x18\x03 \x01(\x0b\x32\x1f.org.lfedge.eve.config.VmConfig\x12,\n\x06\x64rives\x18\x04 \x03(\x0b\x32\x1c.org.lfedge.eve.config.Drive\x12\x10\n\x08\x61\x63tivate\x18\x05 \x01(\x08\x12\x39\n\ninterfaces\x18\x06 \x03(\x0b\x32%.org.lfedge.eve.config.NetworkAdapter\x12\x30\n\x08\x61\x64\x61pters\x18\x07 \x03(\x0b\x32\x1e.org.lfedge.eve.config.Adapter\x12\x36\n\x07restart\x18\t \x01(\x0b\x32%.org.lfedge.eve.config.InstanceOpsCmd\x12\x34\n\x05purge\x18\n \x01(\x0b\x32%.org.lfedge.eve.config.InstanceOpsCmd\x12\x10\n\x08userData\x18\x0b \x01(\t\x12\x15\n\rremoteConsole\x18\x0c \x01(\x08\x12\x36\n\ncipherData\x18\r \x01(\x0b\x32\".org.lfedge.eve.config.CipherBlock\x12\x1a\n\x12\x63ollectStatsIPAddr\x18\x0f \x01(\t\x12\x37\n\rvolumeRefList\x18\x10 \x03(\x0b\x32 .org.lfedge.eve.config.VolumeRef\x12\x39\n\x0cmetaDataType\x18\x11 \x01(\x0e\x32#.org.lfedge.eve.config.MetaDataType\x12\x14\n\x0cprofile_list\x18\x12 \x03(\t\x12\x1e\n\x16start_delay_in_seconds\x18\x13 \x01(\r\"E\n\tVolumeRef\x12\x0c\n\x04uuid\x18\x01 \x01(\t\x12\x17\n\x0fgenerationCount\x18\x02 \x01(\x03\x12\x11\n\tmount_dir\x18\x03 \x01(\t*f\n\x0cMetaDataType\x12\x11\n\rMetaDataDrive\x10\x00\x12\x10\n\x0cMetaDataNone\x10\x01\x12\x15\n\x11MetaDataOpenStack\x10\x02\x12\x1a\n\x16MetaDataDriveMultipart\x10\x03\x42=\n\x15org.lfedge.eve.configZ$github.com/lf-edge/eve/api/go/configb\x06proto3'
+  serialized_pb=b'\n\x16\x63onfig/appconfig.proto\x12\x15org.lfedge.eve.config\x1a\x18\x63onfig/acipherinfo.proto\x1a\x16\x63onfig/devcommon.proto\x1a\x14\x63onfig/storage.proto\x1a\x0f\x63onfig/vm.proto\x1a\x16\x63onfig/netconfig.proto\x1a\x1fgoogle/protobuf/timestamp.proto\"2\n\x0eInstanceOpsCmd\x12\x0f\n\x07\x63ounter\x18\x02 \x01(\r\x12\x0f\n\x07opsTime\x18\x04 \x01(\t\"Y\n\rAppDependency\x12\x10\n\x08\x61pp_uuid\x18\x01 \x01(\t\x12\x36\n\treadiness\x18\x02 \x01(\x0e\x32#.org.lfedge.eve.config.AppReadiness\"\xcd\x01\n\rRestartPolicy\x12\x30\n\x04mode\x18\x01 \x01(\x0e\x32\".org.lfedge.eve.config.RestartMode\x12\x14\n\x0cmax_attempts\x18\x02 \x01(\r\x12\x17\n\x0f\x62\x61\x63koff_seconds\x18\x03 \x01(\r\x12\x1b\n\x13max_backoff_seconds\x18\x04 \x01(\r\x12\x1b\n\x13\x63rash_loop_restarts\x18\x05 \x01(\r\x12!\n\x19\x63rash_loop_window_seconds\x18\x06 \x01(\r\"E\n\x08PodMount\x12\x13\n\x0bvolume_uuid\x18\x01 \x01(\t\x12\x11\n\tmount_dir\x18\x02 \x01(\t\x12\x11\n\tread_only\x18\x03 \x01(\x08\"\xbe\x02\n\x0cPodContainer\x12\x0c\n\x04name\x18\x01 \x01(\t\x12\x13\n\x0bvolume_uuid\x18\x02 \x01(\t\x12\x0c\n\x04init\x18\x03 \x01(\x08\x12\x0c\n\x04\x61rgs\x18\x04 \x03(\t\x12\x39\n\x03\x65nv\x18\x05 \x03(\x0b\x32,.org.lfedge.eve.config.PodContainer.EnvEntry\x12\x0e\n\x06memory\x18\x06 \x01(\r\x12\r\n\x05vcpus\x18\x07 \x01(\r\x12\x38\n\x0crestart_mode\x18\x08 \x01(\x0e\x32\".org.lfedge.eve.config.RestartMode\x12/\n\x06mounts\x18\t \x03(\x0b\x32\x1f.org.lfedge.eve.config.PodMount\x1a*\n\x08\x45nvEntry\x12\x0b\n\x03key\x18\x01 \x01(\t\x12\r\n\x05value\x18\x02 \x01(\t:\x02\x38\x01\"\xed\x01\n\x0bHealthCheck\x12\x34\n\x04type\x18\x01 \x01(\x0e\x32&.org.lfedge.eve.config.HealthCheckType\x12\x14\n\x0c\x65xec_command\x18\x02 \x03(\t\x12\x0c\n\x04port\x18\x03 \x01(\r\x12\x11\n\thttp_path\x18\x04 \x01(\t\x12\x18\n\x10interval_seconds\x18\x05 \x01(\r\x12\x17\n\x0ftimeout_seconds\x18\x06 \x01(\r\x12\x1c\n\x14start_period_seconds\x18\x07 \x01(\r\x12\x0f\n\x07retries\x18\x08 \x01(\r\x12\x0f\n\x07restart\x18\t \x01(\x08\"\xc1\x01\n\x0e\x43onsoleSession\x12\n\n\x02id\x18\x01 \x01(\t\x12\x30\n\x04type\x18\x02 \x01(\x0e\x32\".org.lfedge.eve.config.ConsoleType\x12\x14\n\x0ctoken_sha256\x18\x03 \x01(\x0c\x12-\n\tnot_after\x18\x04 \x01(\x0b\x32\x1a.google.protobuf.Timestamp\x12\x1c\n\x14max_duration_seconds\x18\x05 \x01(\r\x12\x0e\n\x06record\x18\x06 \x01(\x08\"\xd1\x08\n\x11\x41ppInstanceConfig\x12=\n\x0euuidandversion\x18\x01 \x01(\x0b\x32%.org.lfedge.eve.config.UUIDandVersion\x12\x13\n\x0b\x64isplayname\x18\x02 \x01(\t\x12\x37\n\x0e\x66ixedresources\x18\x03 \x01(\x0b\x32\x1f.org.lfedge.eve.config.VmConfig\x12,\n\x06\x64rives\x18\x04 \x03(\x0b\x32\x1c.org.lfedge.eve.config.Drive\x12\x10\n\x08\x61\x63tivate\x18\x05 \x01(\x08\x12\x39\n\ninterfaces\x18\x06 \x03(\x0b\x32%.org.lfedge.eve.config.NetworkAdapter\x12\x30\n\x08\x61\x64\x61pters\x18\x07 \x03(\x0b\x32\x1e.org.lfedge.eve.config.Adapter\x12\x36\n\x07restart\x18\t \x01(\x0b\x32%.org.lfedge.eve.config.InstanceOpsCmd\x12\x34\n\x05purge\x18\n \x01(\x0b\x32%.org.lfedge.eve.config.InstanceOpsCmd\x12\x10\n\x08userData\x18\x0b \x01(\t\x12\x15\n\rremoteConsole\x18\x0c \x01(\x08\x12\x36\n\ncipherData\x18\r \x01(\x0b\x32\".org.lfedge.eve.config.CipherBlock\x12\x1a\n\x12\x63ollectStatsIPAddr\x18\x0f \x01(\t\x12\x37\n\rvolumeRefList\x18\x10 \x03(\x0b\x32 .org.lfedge.eve.config.VolumeRef\x12\x39\n\x0cmetaDataType\x18\x11 \x01(\x0e\x32#.org.lfedge.eve.config.MetaDataType\x12\x14\n\x0cprofile_list\x18\x12 \x03(\t\x12\x1e\n\x16start_delay_in_seconds\x18\x13 \x01(\r\x12\x39\n\x0bstart_after\x18\x14 \x03(\x0b\x32$.org.lfedge.eve.config.AppDependency\x12<\n\x0erestart_policy\x18\x15 \x01(\x0b\x32$.org.lfedge.eve.config.RestartPolicy\x12<\n\x10secure_boot_keys\x18\x16 \x01(\x0b\x32\".org.lfedge.eve.config.CipherBlock\x12;\n\x0epod_containers\x18\x17 \x03(\x0b\x32#.org.lfedge.eve.config.PodContainer\x12\x38\n\x0chealth_check\x18\x18 \x01(\x0b\x32\".org.lfedge.eve.config.HealthCheck\x12?\n\x10\x63onsole_sessions\x18\x19 \x03(\x0b\x32%.org.lfedge.eve.config.ConsoleSession\"[\n\tVolumeRef\x12\x0c\n\x04uuid\x18\x01 \x01(\t\x12\x17\n\x0fgenerationCount\x18\x02 \x01(\x03\x12\x11\n\tmount_dir\x18\x03 \x01(\t\x12\x14\n\x0cshared_owner\x18\x04 \x01(\x08*f\n\x0cMetaDataType\x12\x11\n\rMetaDataDrive\x10\x00\x12\x10\n\x0cMetaDataNone\x10\x01\x12\x15\n\x11MetaDataOpenStack\x10\x02\x12\x1a\n\x16MetaDataDriveMultipart\x10\x03*e\n\x0c\x41ppReadiness\x12\x1d\n\x19\x41PP_READINESS_UNSPECIFIED\x10\x00\x12\x19\n\x15\x41PP_READINESS_RUNNING\x10\x01\x12\x1b\n\x17\x41PP_READINESS_HEALTH_OK\x10\x02*y\n\x0bRestartMode\x12\x1c\n\x18RESTART_MODE_UNSPECIFIED\x10\x00\x12\x16\n\x12RESTART_MODE_NEVER\x10\x01\x12\x1b\n\x17RESTART_MODE_ON_FAILURE\x10\x02\x12\x17\n\x13RESTART_MODE_ALWAYS\x10\x03*\x9d\x01\n\x0fHealthCheckType\x12\x1b\n\x17HEALTH_CHECK_TYPE_IMAGE\x10\x00\x12\x1a\n\x16HEALTH_CHECK_TYPE_NONE\x10\x01\x12\x1a\n\x16HEALTH_CHECK_TYPE_EXEC\x10\x02\x12\x19\n\x15HEALTH_CHECK_TYPE_TCP\x10\x03\x12\x1a\n\x16HEALTH_CHECK_TYPE_HTTP\x10\x04*Z\n\x0b\x43onsoleType\x12\x1c\n\x18\x43ONSOLE_TYPE_UNSPECIFIED\x10\x00\x12\x14\n\x10\x43ONSOLE_TYPE_VNC\x10\x01\x12\x17\n\x13\x43ONSOLE_TYPE_SERIAL\x10\x02\x42=\n\x15org.lfedge.eve.configZ$github.com/lf-edge/eve/api/go/configb\x06proto3'
   ,
-  dependencies=[config_dot_acipherinfo__pb2.DESCRIPTOR,config_dot_devcommon__pb2.DESCRIPTOR,config_dot_storage__pb2.DESCRIPTOR,config_dot_vm__pb2.DESCRIPTOR,config_dot_netconfig__pb2.DESCRIPTOR,])
+  dependencies=[config_dot_acipherinfo__pb2.DESCRIPTOR,config_dot_devcommon__pb2.DESCRIPTOR,config_dot_storage__pb2.DESCRIPTOR,config_dot_vm__pb2.DESCRIPTOR,config_dot_netconfig__pb2.DESCRIPTOR,google_dot_protobuf_dot_timestamp__pb2.DESCRIPTOR,])
 
 _METADATATYPE = _descriptor.EnumDescriptor(
   name='MetaDataType',
@@ -59,16 +60,170 @@ _METADATATYPE = _descriptor.EnumDescriptor(
   ],
   containing_type=None,
   serialized_options=None,
-  serialized_start=1026,
-  serialized_end=1128,
+  serialized_start=2575,
+  serialized_end=2677,
 )
 _sym_db.RegisterEnumDescriptor(_METADATATYPE)
 
 MetaDataType = enum_type_wrapper.EnumTypeWrapper(_METADATATYPE)
+_APPREADINESS = _descriptor.EnumDescriptor(
+  name='AppReadiness',
+  full_name='org.lfedge.eve.config.AppReadiness',
+  filename=None,
+  file=DESCRIPTOR,
+  create_key=_descriptor._internal_create_key,
+  values=[
+    _descriptor.EnumValueDescriptor(
+      name='APP_READINESS_UNSPECIFIED', index=0, number=0,
+      serialized_options=None,
+      type=None,
+      create_key=_descriptor._internal_create_key),
+    _descriptor.EnumValueDescriptor(
+      name='APP_READINESS_RUNNING', index=1, number=1,
+      serialized_options=None,
+      type=None,
+      create_key=_descriptor._internal_create_key),
+    _descriptor.EnumValueDescriptor(
+      name='APP_READINESS_HEALTH_OK', index=2, number=2,
+      serialized_options=None,
+      type=None,
+      create_key=_descriptor._internal_create_key),
+  ],
+  containing_type=None,
+  serialized_options=None,
+  serialized_start=2679,
+  serialized_end=2780,
+)
+_sym_db.RegisterEnumDescriptor(_APPREADINESS)
+
+AppReadiness = enum_type_wrapper.EnumTypeWrapper(_APPREADINESS)
+_RESTARTMODE = _descriptor.EnumDescriptor(
+  name='RestartMode',
+  full_name='org.lfedge.eve.config.RestartMode',
+  filename=None,
+  file=DESCRIPTOR,
+  create_key=_descriptor._internal_create_key,
+  values=[
+    _descriptor.EnumValueDescriptor(
+      name='RESTART_MODE_UNSPECIFIED', index=0, number=0,
+      serialized_options=None,
+      type=None,
+      create_key=_descriptor._internal_create_key),
+    _descriptor.EnumValueDescriptor(
+      name='RESTART_MODE_NEVER', index=1, number=1,
+      serialized_options=None,
+      type=None,
+      create_key=_descriptor._internal_create_key),
+    _descriptor.EnumValueDescriptor(
+      name='RESTART_MODE_ON_FAILURE', index=2, number=2,
+      serialized_options=None,
+      type=None,
+      create_key=_descriptor._internal_create_key),
+    _descriptor.EnumValueDescriptor(
+      name='RESTART_MODE_ALWAYS', index=3, number=3,
+      serialized_options=None,
+      type=None,
+      create_key=_descriptor._internal_create_key),
+  ],
+  containing_type=None,
+  serialized_options=None,
+  serialized_start=2782,
+  serialized_end=2903,
+)
+_sym_db.RegisterEnumDescriptor(_RESTARTMODE)
+
+RestartMode = enum_type_wrapper.EnumTypeWrapper(_RESTARTMODE)
+_HEALTHCHECKTYPE = _descriptor.EnumDescriptor(
+  name='HealthCheckType',
+  full_name='org.lfedge.eve.config.HealthCheckType',
+  filename=None,
+  file=DESCRIPTOR,
+  create_key=_descriptor._internal_create_key,
+  values=[
+    _descriptor.EnumValueDescriptor(
+      name='HEALTH_CHECK_TYPE_IMAGE', index=0, number=0,
+      serialized_options=None,
+      type=None,
+      create_key=_descriptor._internal_create_key),
+    _descriptor.EnumValueDescriptor(
+      name='HEALTH_CHECK_TYPE_NONE', index=1, number=1,
+      serialized_options=None,
+      type=None,
+      create_key=_descriptor._internal_create_key),
+    _descriptor.EnumValueDescriptor(
+      name='HEALTH_CHECK_TYPE_EXEC', index=2, number=2,
+      serialized_options=None,
+      type=None,
+      create_key=_descriptor._internal_create_key),
+    _descriptor.EnumValueDescriptor(
+      name='HEALTH_CHECK_TYPE_TCP', index=3, number=3,
+      serialized_options=None,
+      type=None,
+      create_key=_descriptor._internal_create_key),
+    _descriptor.EnumValueDescriptor(
+      name='HEALTH_CHECK_TYPE_HTTP', index=4, number=4,
+      serialized_options=None,
+      type=None,
+      create_key=_descriptor._internal_create_key),
+  ],
+  containing_type=None,
+  serialized_options=None,
+  serialized_start=2906,
+  serialized_end=3063,
+)
+_sym_db.RegisterEnumDescriptor(_HEALTHCHECKTYPE)
+
+HealthCheckType = enum_type_wrapper.EnumTypeWrapper(_HEALTHCHECKTYPE)
+_CONSOLETYPE = _descriptor.EnumDescriptor(
+  name='ConsoleType',
+  full_name='org.lfedge.eve.config.ConsoleType',
+  filename=None,
+  file=DESCRIPTOR,
+  create_key=_descriptor._internal_create_key,
+  values=[
+    _descriptor.EnumValueDescriptor(
+      name='CONSOLE_TYPE_UNSPECIFIED', index=0, number=0,
+      serialized_options=None,
+      type=None,
+      create_key=_descriptor._internal_create_key),
+    _descriptor.EnumValueDescriptor(
+      name='CONSOLE_TYPE_VNC', index=1, number=1,
+      serialized_options=None,
+      type=None,
+      create_key=_descriptor._internal_create_key),
+    _descriptor.EnumValueDescriptor(
+      name='CONSOLE_TYPE_SERIAL', index=2, number=2,
+      serialized_options=None,
+      type=None,
+      create_key=_descriptor._internal_create_key),
+  ],
+  containing_type=None,
+  serialized_options=None,
+  serialized_start=3065,
+  serialized_end=3155,
+)
+_sym_db.RegisterEnumDescriptor(_CONSOLETYPE)
+
+ConsoleType = enum_type_wrapper.EnumTypeWrapper(_CONSOLETYPE)
 MetaDataDrive = 0
 MetaDataNone = 1
 MetaDataOpenStack = 2
 MetaDataDriveMultipart = 3
+APP_READINESS_UNSPECIFIED = 0
+APP_READINESS_RUNNING = 1
+APP_READINESS_HEALTH_OK = 2
+RESTART_MODE_UNSPECIFIED = 0
+RESTART_MODE_NEVER = 1
+RESTART_MODE_ON_FAILURE = 2
+RESTART_MODE_ALWAYS = 3
+HEALTH_CHECK_TYPE_IMAGE = 0
+HEALTH_CHECK_TYPE_NONE = 1
+HEALTH_CHECK_TYPE_EXEC = 2
+HEALTH_CHECK_TYPE_TCP = 3
+HEALTH_CHECK_TYPE_HTTP = 4
+CONSOLE_TYPE_UNSPECIFIED = 0
+CONSOLE_TYPE_VNC = 1
+CONSOLE_TYPE_SERIAL = 2
 
 
 
@@ -106,8 +261,441 @@ _INSTANCEOPSCMD = _descriptor.Descriptor(
   extension_ranges=[],
   oneofs=[
   ],
-  serialized_start=162,
-  serialized_end=212,
+  serialized_start=195,
+  serialized_end=245,
+)
+
+
+_APPDEPENDENCY = _descriptor.Descriptor(
+  name='AppDependency',
+  full_name='org.lfedge.eve.config.AppDependency',
+  filename=None,
+  file=DESCRIPTOR,
+  containing_type=None,
+  create_key=_descriptor._internal_create_key,
+  fields=[
+    _descriptor.FieldDescriptor(
+      name='app_uuid', full_name='org.lfedge.eve.config.AppDependency.app_uuid', index=0,
+      number=1, type=9, cpp_type=9, label=1,
+      has_default_value=False, default_value=b"".decode('utf-8'),
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
+    _descriptor.FieldDescriptor(
+      name='readiness', full_name='org.lfedge.eve.config.AppDependency.readiness', index=1,
+      number=2, type=14, cpp_type=8, label=1,
+      has_default_value=False, default_value=0,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
+  ],
+  extensions=[
+  ],
+  nested_types=[],
+  enum_types=[
+  ],
+  serialized_options=None,
+  is_extendable=False,
+  syntax='proto3',
+  extension_ranges=[],
+  oneofs=[
+  ],
+  serialized_start=247,
+  serialized_end=336,
+)
+
+
+_RESTARTPOLICY = _descriptor.Descriptor(
+  name='RestartPolicy',
+  full_name='org.lfedge.eve.config.RestartPolicy',
+  filename=None,
+  file=DESCRIPTOR,
+  containing_type=None,
+  create_key=_descriptor._internal_create_key,
+  fields=[
+    _descriptor.FieldDescriptor(
+      name='mode', full_name='org.lfedge.eve.config.RestartPolicy.mode', index=0,
+      number=1, type=14, cpp_type=8, label=1,
+      has_default_value=False, default_value=0,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
+    _descriptor.FieldDescriptor(
+      name='max_attempts', full_name='org.lfedge.eve.config.RestartPolicy.max_attempts', index=1,
+      number=2, type=13, cpp_type=3, label=1,
+      has_default_value=False, default_value=0,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
+    _descriptor.FieldDescriptor(
+      name='backoff_seconds', full_name='org.lfedge.eve.config.RestartPolicy.backoff_seconds', index=2,
+      number=3, type=13, cpp_type=3, label=1,
+      has_default_value=False, default_value=0,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
+    _descriptor.FieldDescriptor(
+      name='max_backoff_seconds', full_name='org.lfedge.eve.config.RestartPolicy.max_backoff_seconds', index=3,
+      number=4, type=13, cpp_type=3, label=1,
+      has_default_value=False, default_value=0,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
+    _descriptor.FieldDescriptor(
+      name='crash_loop_restarts', full_name='org.lfedge.eve.config.RestartPolicy.crash_loop_restarts', index=4,
+      number=5, type=13, cpp_type=3, label=1,
+      has_default_value=False, default_value=0,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
+    _descriptor.FieldDescriptor(
+      name='crash_loop_window_seconds', full_name='org.lfedge.eve.config.RestartPolicy.crash_loop_window_seconds', index=5,
+      number=6, type=13, cpp_type=3, label=1,
+      has_default_value=False, default_value=0,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
+  ],
+  extensions=[
+  ],
+  nested_types=[],
+  enum_types=[
+  ],
+  serialized_options=None,
+  is_extendable=False,
+  syntax='proto3',
+  extension_ranges=[],
+  oneofs=[
+  ],
+  serialized_start=339,
+  serialized_end=544,
+)
+
+
+_PODMOUNT = _descriptor.Descriptor(
+  name='PodMount',
+  full_name='org.lfedge.eve.config.PodMount',
+  filename=None,
+  file=DESCRIPTOR,
+  containing_type=None,
+  create_key=_descriptor._internal_create_key,
+  fields=[
+    _descriptor.FieldDescriptor(
+      name='volume_uuid', full_name='org.lfedge.eve.config.PodMount.volume_uuid', index=0,
+      number=1, type=9, cpp_type=9, label=1,
+      has_default_value=False, default_value=b"".decode('utf-8'),
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
+    _descriptor.FieldDescriptor(
+      name='mount_dir', full_name='org.lfedge.eve.config.PodMount.mount_dir', index=1,
+      number=2, type=9, cpp_type=9, label=1,
+      has_default_value=False, default_value=b"".decode('utf-8'),
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
+    _descriptor.FieldDescriptor(
+      name='read_only', full_name='org.lfedge.eve.config.PodMount.read_only', index=2,
+      number=3, type=8, cpp_type=7, label=1,
+      has_default_value=False, default_value=False,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
+  ],
+  extensions=[
+  ],
+  nested_types=[],
+  enum_types=[
+  ],
+  serialized_options=None,
+  is_extendable=False,
+  syntax='proto3',
+  extension_ranges=[],
+  oneofs=[
+  ],
+  serialized_start=546,
+  serialized_end=615,
+)
+
+
+_PODCONTAINER_ENVENTRY = _descriptor.Descriptor(
+  name='EnvEntry',
+  full_name='org.lfedge.eve.config.PodContainer.EnvEntry',
+  filename=None,
+  file=DESCRIPTOR,
+  containing_type=None,
+  create_key=_descriptor._internal_create_key,
+  fields=[
+    _descriptor.FieldDescriptor(
+      name='key', full_name='org.lfedge.eve.config.PodContainer.EnvEntry.key', index=0,
+      number=1, type=9, cpp_type=9, label=1,
+      has_default_value=False, default_value=b"".decode('utf-8'),
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
+    _descriptor.FieldDescriptor(
+      name='value', full_name='org.lfedge.eve.config.PodContainer.EnvEntry.value', index=1,
+      number=2, type=9, cpp_type=9, label=1,
+      has_default_value=False, default_value=b"".decode('utf-8'),
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
+  ],
+  extensions=[
+  ],
+  nested_types=[],
+  enum_types=[
+  ],
+  serialized_options=b'8\001',
+  is_extendable=False,
+  syntax='proto3',
+  extension_ranges=[],
+  oneofs=[
+  ],
+  serialized_start=894,
+  serialized_end=936,
+)
+
+_PODCONTAINER = _descriptor.Descriptor(
+  name='PodContainer',
+  full_name='org.lfedge.eve.config.PodContainer',
+  filename=None,
+  file=DESCRIPTOR,
+  containing_type=None,
+  create_key=_descriptor._internal_create_key,
+  fields=[
+    _descriptor.FieldDescriptor(
+      name='name', full_name='org.lfedge.eve.config.PodContainer.name', index=0,
+      number=1, type=9, cpp_type=9, label=1,
+      has_default_value=False, default_value=b"".decode('utf-8'),
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
+    _descriptor.FieldDescriptor(
+      name='volume_uuid', full_name='org.lfedge.eve.config.PodContainer.volume_uuid', index=1,
+      number=2, type=9, cpp_type=9, label=1,
+      has_default_value=False, default_value=b"".decode('utf-8'),
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
+    _descriptor.FieldDescriptor(
+      name='init', full_name='org.lfedge.eve.config.PodContainer.init', index=2,
+      number=3, type=8, cpp_type=7, label=1,
+      has_default_value=False, default_value=False,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
+    _descriptor.FieldDescriptor(
+      name='args', full_name='org.lfedge.eve.config.PodContainer.args', index=3,
+      number=4, type=9, cpp_type=9, label=3,
+      has_default_value=False, default_value=[],
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
+    _descriptor.FieldDescriptor(
+      name='env', full_name='org.lfedge.eve.config.PodContainer.env', index=4,
+      number=5, type=11, cpp_type=10, label=3,
+      has_default_value=False, default_value=[],
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
+    _descriptor.FieldDescriptor(
+      name='memory', full_name='org.lfedge.eve.config.PodContainer.memory', index=5,
+      number=6, type=13, cpp_type=3, label=1,
+      has_default_value=False, default_value=0,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
+    _descriptor.FieldDescriptor(
+      name='vcpus', full_name='org.lfedge.eve.config.PodContainer.vcpus', index=6,
+      number=7, type=13, cpp_type=3, label=1,
+      has_default_value=False, default_value=0,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
+    _descriptor.FieldDescriptor(
+      name='restart_mode', full_name='org.lfedge.eve.config.PodContainer.restart_mode', index=7,
+      number=8, type=14, cpp_type=8, label=1,
+      has_default_value=False, default_value=0,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
+    _descriptor.FieldDescriptor(
+      name='mounts', full_name='org.lfedge.eve.config.PodContainer.mounts', index=8,
+      number=9, type=11, cpp_type=10, label=3,
+      has_default_value=False, default_value=[],
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
+  ],
+  extensions=[
+  ],
+  nested_types=[_PODCONTAINER_ENVENTRY, ],
+  enum_types=[
+  ],
+  serialized_options=None,
+  is_extendable=False,
+  syntax='proto3',
+  extension_ranges=[],
+  oneofs=[
+  ],
+  serialized_start=618,
+  serialized_end=936,
+)
+
+
+_HEALTHCHECK = _descriptor.Descriptor(
+  name='HealthCheck',
+  full_name='org.lfedge.eve.config.HealthCheck',
+  filename=None,
+  file=DESCRIPTOR,
+  containing_type=None,
+  create_key=_descriptor._internal_create_key,
+  fields=[
+    _descriptor.FieldDescriptor(
+      name='type', full_name='org.lfedge.eve.config.HealthCheck.type', index=0,
+      number=1, type=14, cpp_type=8, label=1,
+      has_default_value=False, default_value=0,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
+    _descriptor.FieldDescriptor(
+      name='exec_command', full_name='org.lfedge.eve.config.HealthCheck.exec_command', index=1,
+      number=2, type=9, cpp_type=9, label=3,
+      has_default_value=False, default_value=[],
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
+    _descriptor.FieldDescriptor(
+      name='port', full_name='org.lfedge.eve.config.HealthCheck.port', index=2,
+      number=3, type=13, cpp_type=3, label=1,
+      has_default_value=False, default_value=0,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
+    _descriptor.FieldDescriptor(
+      name='http_path', full_name='org.lfedge.eve.config.HealthCheck.http_path', index=3,
+      number=4, type=9, cpp_type=9, label=1,
+      has_default_value=False, default_value=b"".decode('utf-8'),
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
+    _descriptor.FieldDescriptor(
+      name='interval_seconds', full_name='org.lfedge.eve.config.HealthCheck.interval_seconds', index=4,
+      number=5, type=13, cpp_type=3, label=1,
+      has_default_value=False, default_value=0,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
+    _descriptor.FieldDescriptor(
+      name='timeout_seconds', full_name='org.lfedge.eve.config.HealthCheck.timeout_seconds', index=5,
+      number=6, type=13, cpp_type=3, label=1,
+      has_default_value=False, default_value=0,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
+    _descriptor.FieldDescriptor(
+      name='start_period_seconds', full_name='org.lfedge.eve.config.HealthCheck.start_period_seconds', index=6,
+      number=7, type=13, cpp_type=3, label=1,
+      has_default_value=False, default_value=0,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
+    _descriptor.FieldDescriptor(
+      name='retries', full_name='org.lfedge.eve.config.HealthCheck.retries', index=7,
+      number=8, type=13, cpp_type=3, label=1,
+      has_default_value=False, default_value=0,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
+    _descriptor.FieldDescriptor(
+      name='restart', full_name='org.lfedge.eve.config.HealthCheck.restart', index=8,
+      number=9, type=8, cpp_type=7, label=1,
+      has_default_value=False, default_value=False,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
+  ],
+  extensions=[
+  ],
+  nested_types=[],
+  enum_types=[
+  ],
+  serialized_options=None,
+  is_extendable=False,
+  syntax='proto3',
+  extension_ranges=[],
+  oneofs=[
+  ],
+  serialized_start=939,
+  serialized_end=1176,
+)
+
+
+_CONSOLESESSION = _descriptor.Descriptor(
+  name='ConsoleSession',
+  full_name='org.lfedge.eve.config.ConsoleSession',
+  filename=None,
+  file=DESCRIPTOR,
+  containing_type=None,
+  create_key=_descriptor._internal_create_key,
+  fields=[
+    _descriptor.FieldDescriptor(
+      name='id', full_name='org.lfedge.eve.config.ConsoleSession.id', index=0,
+      number=1, type=9, cpp_type=9, label=1,
+      has_default_value=False, default_value=b"".decode('utf-8'),
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
+    _descriptor.FieldDescriptor(
+      name='type', full_name='org.lfedge.eve.config.ConsoleSession.type', index=1,
+      number=2, type=14, cpp_type=8, label=1,
+      has_default_value=False, default_value=0,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
+    _descriptor.FieldDescriptor(
+      name='token_sha256', full_name='org.lfedge.eve.config.ConsoleSession.token_sha256', index=2,
+      number=3, type=12, cpp_type=9, label=1,
+      has_default_value=False, default_value=b"",
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
+    _descriptor.FieldDescriptor(
+      name='not_after', full_name='org.lfedge.eve.config.ConsoleSession.not_after', index=3,
+      number=4, type=11, cpp_type=10, label=1,
+      has_default_value=False, default_value=None,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
+    _descriptor.FieldDescriptor(
+      name='max_duration_seconds', full_name='org.lfedge.eve.config.ConsoleSession.max_duration_seconds', index=4,
+      number=5, type=13, cpp_type=3, label=1,
+      has_default_value=False, default_value=0,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
+    _descriptor.FieldDescriptor(
+      name='record', full_name='org.lfedge.eve.config.ConsoleSession.record', index=5,
+      number=6, type=8, cpp_type=7, label=1,
+      has_default_value=False, default_value=False,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
+  ],
+  extensions=[
+  ],
+  nested_types=[],
+  enum_types=[
+  ],
+  serialized_options=None,
+  is_extendable=False,
+  syntax='proto3',
+  extension_ranges=[],
+  oneofs=[
+  ],
+  serialized_start=1179,
+  serialized_end=1372,
 )
 
 
@@ -238,6 +826,48 @@ _APPINSTANCECONFIG = _descriptor.Descriptor(
       message_type=None, enum_type=None, containing_type=None,
       is_extension=False, extension_scope=None,
       serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
+    _descriptor.FieldDescriptor(
+      name='start_after', full_name='org.lfedge.eve.config.AppInstanceConfig.start_after', index=17,
+      number=20, type=11, cpp_type=10, label=3,
+      has_default_value=False, default_value=[],
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
+    _descriptor.FieldDescriptor(
+      name='restart_policy', full_name='org.lfedge.eve.config.AppInstanceConfig.restart_policy', index=18,
+      number=21, type=11, cpp_type=10, label=1,
+      has_default_value=False, default_value=None,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
+    _descriptor.FieldDescriptor(
+      name='secure_boot_keys', full_name='org.lfedge.eve.config.AppInstanceConfig.secure_boot_keys', index=19,
+      number=22, type=11, cpp_type=10, label=1,
+      has_default_value=False, default_value=None,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
+    _descriptor.FieldDescriptor(
+      name='pod_containers', full_name='org.lfedge.eve.config.AppInstanceConfig.pod_containers', index=20,
+      number=23, type=11, cpp_type=10, label=3,
+      has_default_value=False, default_value=[],
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
+    _descriptor.FieldDescriptor(
+      name='health_check', full_name='org.lfedge.eve.config.AppInstanceConfig.health_check', index=21,
+      number=24, type=11, cpp_type=10, label=1,
+      has_default_value=False, default_value=None,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
+    _descriptor.FieldDescriptor(
+      name='console_sessions', full_name='org.lfedge.eve.config.AppInstanceConfig.console_sessions', index=22,
+      number=25, type=11, cpp_type=10, label=3,
+      has_default_value=False, default_value=[],
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
   ],
   extensions=[
   ],
@@ -250,8 +880,8 @@ _APPINSTANCECONFIG = _descriptor.Descriptor(
   extension_ranges=[],
   oneofs=[
   ],
-  serialized_start=215,
-  serialized_end=953,
+  serialized_start=1375,
+  serialized_end=2480,
 )
 
 
@@ -284,6 +914,13 @@ _VOLUMEREF = _descriptor.Descriptor(
       message_type=None, enum_type=None, containing_type=None,
       is_extension=False, extension_scope=None,
       serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
+    _descriptor.FieldDescriptor(
+      name='shared_owner', full_name='org.lfedge.eve.config.VolumeRef.shared_owner', index=3,
+      number=4, type=8, cpp_type=7, label=1,
+      has_default_value=False, default_value=False,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
   ],
   extensions=[
   ],
@@ -296,10 +933,19 @@ _VOLUMEREF = _descriptor.Descriptor(
   extension_ranges=[],
   oneofs=[
   ],
-  serialized_start=955,
-  serialized_end=1024,
+  serialized_start=2482,
+  serialized_end=2573,
 )
 
+_APPDEPENDENCY.fields_by_name['readiness'].enum_type = _APPREADINESS
+_RESTARTPOLICY.fields_by_name['mode'].enum_type = _RESTARTMODE
+_PODCONTAINER_ENVENTRY.containing_type = _PODCONTAINER
+_PODCONTAINER.fields_by_name['env'].message_type = _PODCONTAINER_ENVENTRY
+_PODCONTAINER.fields_by_name['restart_mode'].enum_type = _RESTARTMODE
+_PODCONTAINER.fields_by_name['mounts'].message_type = _PODMOUNT
+_HEALTHCHECK.fields_by_name['type'].enum_type = _HEALTHCHECKTYPE
+_CONSOLESESSION.fields_by_name['type'].enum_type = _CONSOLETYPE
+_CONSOLESESSION.fields_by_name['not_after'].message_type = google_dot_protobuf_dot_timestamp__pb2._TIMESTAMP
 _APPINSTANCECONFIG.fields_by_name['uuidandversion'].message_type = config_dot_devcommon__pb2._UUIDANDVERSION
 _APPINSTANCECONFIG.fields_by_name['fixedresources'].message_type = config_dot_vm__pb2._VMCONFIG
 _APPINSTANCECONFIG.fields_by_name['drives'].message_type = config_dot_storage__pb2._DRIVE
@@ -310,10 +956,26 @@ _APPINSTANCECONFIG.fields_by_name['purge'].message_type = _INSTANCEOPSCMD
 _APPINSTANCECONFIG.fields_by_name['cipherData'].message_type = config_dot_acipherinfo__pb2._CIPHERBLOCK
 _APPINSTANCECONFIG.fields_by_name['volumeRefList'].message_type = _VOLUMEREF
 _APPINSTANCECONFIG.fields_by_name['metaDataType'].enum_type = _METADATATYPE
+_APPINSTANCECONFIG.fields_by_name['start_after'].message_type = _APPDEPENDENCY
+_APPINSTANCECONFIG.fields_by_name['restart_policy'].message_type = _RESTARTPOLICY
+_APPINSTANCECONFIG.fields_by_name['secure_boot_keys'].message_type = config_dot_acipherinfo__pb2._CIPHERBLOCK
+_APPINSTANCECONFIG.fields_by_name['pod_containers'].message_type = _PODCONTAINER
+_APPINSTANCECONFIG.fields_by_name['health_check'].message_type = _HEALTHCHECK
+_APPINSTANCECONFIG.fields_by_name['console_sessions'].message_type = _CONSOLESESSION
 DESCRIPTOR.message_types_by_name['InstanceOpsCmd'] = _INSTANCEOPSCMD
+DESCRIPTOR.message_types_by_name['AppDependency'] = _APPDEPENDENCY
+DESCRIPTOR.message_types_by_name['RestartPolicy'] = _RESTARTPOLICY
+DESCRIPTOR.message_types_by_name['PodMount'] = _PODMOUNT
+DESCRIPTOR.message_types_by_name['PodContainer'] = _PODCONTAINER
+DESCRIPTOR.message_types_by_name['HealthCheck'] = _HEALTHCHECK
+DESCRIPTOR.message_types_by_name['ConsoleSession'] = _CONSOLESESSION
 DESCRIPTOR.message_types_by_name['AppInstanceConfig'] = _APPINSTANCECONFIG
 DESCRIPTOR.message_types_by_name['VolumeRef'] = _VOLUMEREF
 DESCRIPTOR.enum_types_by_name['MetaDataType'] = _METADATATYPE
+DESCRIPTOR.enum_types_by_name['AppReadiness'] = _APPREADINESS
+DESCRIPTOR.enum_types_by_name['RestartMode'] = _RESTARTMODE
+DESCRIPTOR.enum_types_by_name['HealthCheckType'] = _HEALTHCHECKTYPE
+DESCRIPTOR.enum_types_by_name['ConsoleType'] = _CONSOLETYPE
 _sym_db.RegisterFileDescriptor(DESCRIPTOR)
 
 InstanceOpsCmd = _reflection.GeneratedProtocolMessageType('InstanceOpsCmd', (_message.Message,), {
@@ -323,6 +985,56 @@ InstanceOpsCmd = _reflection.GeneratedProtocolMessageType('InstanceOpsCmd', (_me
   })
 _sym_db.RegisterMessage(InstanceOpsCmd)
 
+AppDependency = _reflection.GeneratedProtocolMessageType('AppDependency', (_message.Message,), {
+  'DESCRIPTOR' : _APPDEPENDENCY,
+  '__module__' : 'config.appconfig_pb2'
+  # @@protoc_insertion_point(class_scope:org.lfedge.eve.config.AppDependency)
+  })
+_sym_db.RegisterMessage(AppDependency)
+
+RestartPolicy = _reflection.GeneratedProtocolMessageType('RestartPolicy', (_message.Message,), {
+  'DESCRIPTOR' : _RESTARTPOLICY,
+  '__module__' : 'config.appconfig_pb2'
+  # @@protoc_insertion_point(class_scope:org.lfedge.eve.config.RestartPolicy)
+  })
+_sym_db.RegisterMessage(RestartPolicy)
+
+PodMount = _reflection.GeneratedProtocolMessageType('PodMount', (_message.Message,), {
+  'DESCRIPTOR' : _PODMOUNT,
+  '__module__' : 'config.appconfig_pb2'
+  # @@protoc_insertion_point(class_scope:org.lfedge.eve.config.PodMount)
+  })
+_sym_db.RegisterMessage(PodMount)
+
+PodContainer = _reflection.GeneratedProtocolMessageType('PodContainer', (_message.Message,), {
+
+  'EnvEntry' : _reflection.GeneratedProtocolMessageType('EnvEntry', (_message.Message,), {
+    'DESCRIPTOR' : _PODCONTAINER_ENVENTRY,
+    '__module__' : 'config.appconfig_pb2'
+    # @@protoc_insertion_point(class_scope:org.lfedge.eve.config.PodContainer.EnvEntry)
+    })
+  ,
+  'DESCRIPTOR' : _PODCONTAINER,
+  '__module__' : 'config.appconfig_pb2'
+  # @@protoc_insertion_point(class_scope:org.lfedge.eve.config.PodContainer)
+  })
+_sym_db.RegisterMessage(PodContainer)
+_sym_db.RegisterMessage(PodContainer.EnvEntry)
+
+HealthCheck = _reflection.GeneratedProtocolMessageType('HealthCheck', (_message.Message,), {
+  'DESCRIPTOR' : _HEALTHCHECK,
+  '__module__' : 'config.appconfig_pb2'
+  # @@protoc_insertion_point(class_scope:org.lfedge.eve.config.HealthCheck)
+  })
+_sym_db.RegisterMessage(HealthCheck)
+
+ConsoleSession = _reflection.GeneratedProtocolMessageType('ConsoleSession', (_message.Message,), {
+  'DESCRIPTOR' : _CONSOLESESSION,
+  '__module__' : 'config.appconfig_pb2'
+  # @@protoc_insertion_point(class_scope:org.lfedge.eve.config.ConsoleSession)
+  })
+_sym_db.RegisterMessage(ConsoleSession)
+
 AppInstanceConfig = _reflection.GeneratedProtocolMessageType('AppInstanceConfig', (_message.Message,), {
   'DESCRIPTOR' : _APPINSTANCECONFIG,
   '__module__' : 'config.appconfig_pb2'
@@ -339,4 +1051,5 @@ _sym_db.RegisterMessage(VolumeRef)
 
 
 DESCRIPTOR._options = None
+_PODCONTAINER_ENVENTRY._options = None
 # @@protoc_insertion_point(module_scope)
@@ -13,6 +13,7 @@ _sym_db = _symbol_database.Default()
 
 from config import devcommon_pb2 as config_dot_devcommon__pb2
 from config import storage_pb2 as config_dot_storage__pb2
+from config import schedule_pb2 as config_dot_schedule__pb2
 
 
 DESCRIPTOR = _descriptor.FileDescriptor(
@@ -21,9 +22,9 @@ DESCRIPTOR = _descriptor.FileDescriptor(
   syntax='proto3',
   serialized_options=b'\n\025org.lfedge.eve.configZ$github.com/lf-edge/eve/api/go/config',
   create_key=_descriptor._internal_create_key,
-  serialized_pb=b'\n\x19\x63onfig/baseosconfig.proto\x12\x15org.lfedge.eve.config\x1a\x16\x63onfig/devcommon.proto\x1a\x14\x63onfig/storage.proto\"\x0b\n\tOSKeyTags\"\x0e\n\x0cOSVerDetails\"\xb6\x01\n\x0c\x42\x61seOSConfig\x12=\n\x0euuidandversion\x18\x01 \x01(\x0b\x32%.org.lfedge.eve.config.UUIDandVersion\x12,\n\x06\x64rives\x18\x03 \x03(\x0b\x32\x1c.org.lfedge.eve.config.Drive\x12\x10\n\x08\x61\x63tivate\x18\x04 \x01(\x08\x12\x15\n\rbaseOSVersion\x18\n \x01(\t\x12\x10\n\x08volumeID\x18\x0c \x01(\t\"^\n\x06\x42\x61seOS\x12\x19\n\x11\x63ontent_tree_uuid\x18\x01 \x01(\t\x12\x39\n\x0cretry_update\x18\x02 \x01(\x0b\x32#.org.lfedge.eve.config.DeviceOpsCmdB=\n\x15org.lfedge.eve.configZ$github.com/lf-edge/eve/api/go/configb\x06proto3'
+  serialized_pb=b'\n\x19\x63onfig/baseosconfig.proto\x12\x15org.lfedge.eve.config\x1a\x16\x63onfig/devcommon.proto\x1a\x14\x63onfig/storage.proto\x1a\x15\x63onfig/schedule.proto\"\x0b\n\tOSKeyTags\"\x0e\n\x0cOSVerDetails\"\xa3\x02\n\x0c\x42\x61seOSConfig\x12=\n\x0euuidandversion\x18\x01 \x01(\x0b\x32%.org.lfedge.eve.config.UUIDandVersion\x12,\n\x06\x64rives\x18\x03 \x03(\x0b\x32\x1c.org.lfedge.eve.config.Drive\x12\x10\n\x08\x61\x63tivate\x18\x04 \x01(\x08\x12\x15\n\rbaseOSVersion\x18\n \x01(\t\x12\x10\n\x08volumeID\x18\x0c \x01(\t\x12\x38\n\x08schedule\x18\r \x01(\x0b\x32&.org.lfedge.eve.config.ContentSchedule\x12\x31\n\x05\x64\x65lta\x18\x0e \x01(\x0b\x32\".org.lfedge.eve.config.BaseOSDelta\"~\n\x0b\x42\x61seOSDelta\x12+\n\x05\x64rive\x18\x01 \x01(\x0b\x32\x1c.org.lfedge.eve.config.Drive\x12\x13\n\x0b\x62\x61se_sha256\x18\x02 \x01(\t\x12\x17\n\x0f\x62\x61se_size_bytes\x18\x03 \x01(\x04\x12\x14\n\x0cimage_sha256\x18\x04 \x01(\t\"^\n\x06\x42\x61seOS\x12\x19\n\x11\x63ontent_tree_uuid\x18\x01 \x01(\t\x12\x39\n\x0cretry_update\x18\x02 \x01(\x0b\x32#.org.lfedge.eve.config.DeviceOpsCmdB=\n\x15org.lfedge.eve.configZ$github.com/lf-edge/eve/api/go/configb\x06proto3'
   ,
-  dependencies=[config_dot_devcommon__pb2.DESCRIPTOR,config_dot_storage__pb2.DESCRIPTOR,])
+  dependencies=[config_dot_devcommon__pb2.DESCRIPTOR,config_dot_storage__pb2.DESCRIPTOR,config_dot_schedule__pb2.DESCRIPTOR,])
 
 
 
@@ -48,8 +49,8 @@ _OSKEYTAGS = _descriptor.Descriptor(
   extension_ranges=[],
   oneofs=[
   ],
-  serialized_start=98,
-  serialized_end=109,
+  serialized_start=121,
+  serialized_end=132,
 )
 
 
@@ -73,8 +74,8 @@ _OSVERDETAILS = _descriptor.Descriptor(
   extension_ranges=[],
   oneofs=[
   ],
-  serialized_start=111,
-  serialized_end=125,
+  serialized_start=134,
+  serialized_end=148,
 )
 
 
@@ -121,6 +122,20 @@ _BASEOSCONFIG = _descriptor.Descriptor(
       message_type=None, enum_type=None, containing_type=None,
       is_extension=False, extension_scope=None,
       serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
+    _descriptor.FieldDescriptor(
+      name='schedule', full_name='org.lfedge.eve.config.BaseOSConfig.schedule', index=5,
+      number=13, type=11, cpp_type=10, label=1,
+      has_default_value=False, default_value=None,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
+    _descriptor.FieldDescriptor(
+      name='delta', full_name='org.lfedge.eve.config.BaseOSConfig.delta', index=6,
+      number=14, type=11, cpp_type=10, label=1,
+      has_default_value=False, default_value=None,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
   ],
   extensions=[
   ],
@@ -133,8 +148,61 @@ _BASEOSCONFIG = _descriptor.Descriptor(
   extension_ranges=[],
   oneofs=[
   ],
-  serialized_start=128,
-  serialized_end=310,
+  serialized_start=151,
+  serialized_end=442,
+)
+
+
+_BASEOSDELTA = _descriptor.Descriptor(
+  name='BaseOSDelta',
+  full_name='org.lfedge.eve.config.BaseOSDelta',
+  filename=None,
+  file=DESCRIPTOR,
+  containing_type=None,
+  create_key=_descriptor._internal_create_key,
+  fields=[
+    _descriptor.FieldDescriptor(
+      name='drive', full_name='org.lfedge.eve.config.BaseOSDelta.drive', index=0,
+      number=1, type=11, cpp_type=10, label=1,
+      has_default_value=False, default_value=None,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
+    _descriptor.FieldDescriptor(
+      name='base_sha256', full_name='org.lfedge.eve.config.BaseOSDelta.base_sha256', index=1,
+      number=2, type=9, cpp_type=9, label=1,
+      has_default_value=False, default_value=b"".decode('utf-8'),
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
+    _descriptor.FieldDescriptor(
+      name='base_size_bytes', full_name='org.lfedge.eve.config.BaseOSDelta.base_size_bytes', index=2,
+      number=3, type=4, cpp_type=4, label=1,
+      has_default_value=False, default_value=0,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
+    _descriptor.FieldDescriptor(
+      name='image_sha256', full_name='org.lfedge.eve.config.BaseOSDelta.image_sha256', index=3,
+      number=4, type=9, cpp_type=9, label=1,
+      has_default_value=False, default_value=b"".decode('utf-8'),
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
+  ],
+  extensions=[
+  ],
+  nested_types=[],
+  enum_types=[
+  ],
+  serialized_options=None,
+  is_extendable=False,
+  syntax='proto3',
+  extension_ranges=[],
+  oneofs=[
+  ],
+  serialized_start=444,
+  serialized_end=570,
 )
 
 
@@ -172,16 +240,20 @@ _BASEOS = _descriptor.Descriptor(
   extension_ranges=[],
   oneofs=[
   ],
-  serialized_start=312,
-  serialized_end=406,
+  serialized_start=572,
+  serialized_end=666,
 )
 
 _BASEOSCONFIG.fields_by_name['uuidandversion'].message_type = config_dot_devcommon__pb2._UUIDANDVERSION
 _BASEOSCONFIG.fields_by_name['drives'].message_type = config_dot_storage__pb2._DRIVE
+_BASEOSCONFIG.fields_by_name['schedule'].message_type = config_dot_schedule__pb2._CONTENTSCHEDULE
+_BASEOSCONFIG.fields_by_name['delta'].message_type = _BASEOSDELTA
+_BASEOSDELTA.fields_by_name['drive'].message_type = config_dot_storage__pb2._DRIVE
 _BASEOS.fields_by_name['retry_update'].message_type = config_dot_devcommon__pb2._DEVICEOPSCMD
 DESCRIPTOR.message_types_by_name['OSKeyTags'] = _OSKEYTAGS
 DESCRIPTOR.message_types_by_name['OSVerDetails'] = _OSVERDETAILS
 DESCRIPTOR.message_types_by_name['BaseOSConfig'] = _BASEOSCONFIG
+DESCRIPTOR.message_types_by_name['BaseOSDelta'] = _BASEOSDELTA
 DESCRIPTOR.message_types_by_name['BaseOS'] = _BASEOS
 _sym_db.RegisterFileDescriptor(DESCRIPTOR)
 
@@ -206,6 +278,13 @@ BaseOSConfig = _reflection.GeneratedProtocolMessageType('BaseOSConfig', (_messag
   })
 _sym_db.RegisterMessage(BaseOSConfig)
 
+BaseOSDelta = _reflection.GeneratedProtocolMessageType('BaseOSDelta', (_message.Message,), {
+  'DESCRIPTOR' : _BASEOSDELTA,
+  '__module__' : 'config.baseosconfig_pb2'
+  # @@protoc_insertion_point(class_scope:org.lfedge.eve.config.BaseOSDelta)
+  })
+_sym_db.RegisterMessage(BaseOSDelta)
+
 BaseOS = _reflection.GeneratedProtocolMessageType('BaseOS', (_message.Message,), {
   'DESCRIPTOR' : _BASEOS,
   '__module__' : 'config.baseosconfig_pb2'
@@ -0,0 +1,92 @@
+# -*- coding: utf-8 -*-
+# Generated by the protocol buffer compiler.  DO NOT EDIT!
+# source: config/localoperations.proto
+"""Generated protocol buffer code."""
+from google.protobuf import descriptor as _descriptor
+from google.protobuf import message as _message
+from google.protobuf import reflection as _reflection
+from google.protobuf import symbol_database as _symbol_database
+# @@protoc_insertion_point(imports)
+
+_sym_db = _symbol_database.Default()
+
+
+
+
+DESCRIPTOR = _descriptor.FileDescriptor(
+  name='config/localoperations.proto',
+  package='org.lfedge.eve.config',
+  syntax='proto3',
+  serialized_options=b'\n\025org.lfedge.eve.configZ$github.com/lf-edge/eve/api/go/config',
+  create_key=_descriptor._internal_create_key,
+  serialized_pb=b'\n\x1c\x63onfig/localoperations.proto\x12\x15org.lfedge.eve.config\"q\n\x15LocalOperationsPolicy\x12\x14\n\x0c\x61pp_activate\x18\x01 \x01(\x08\x12\x17\n\x0fglobal_settings\x18\x02 \x03(\t\x12\x11\n\tport_maps\x18\x03 \x01(\x08\x12\x16\n\x0e\x62\x61se_os_update\x18\x04 \x01(\x08\x42=\n\x15org.lfedge.eve.configZ$github.com/lf-edge/eve/api/go/configb\x06proto3'
+)
+
+
+
+
+_LOCALOPERATIONSPOLICY = _descriptor.Descriptor(
+  name='LocalOperationsPolicy',
+  full_name='org.lfedge.eve.config.LocalOperationsPolicy',
+  filename=None,
+  file=DESCRIPTOR,
+  containing_type=None,
+  create_key=_descriptor._internal_create_key,
+  fields=[
+    _descriptor.FieldDescriptor(
+      name='app_activate', full_name='org.lfedge.eve.config.LocalOperationsPolicy.app_activate', index=0,
+      number=1, type=8, cpp_type=7, label=1,
+      has_default_value=False, default_value=False,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
+    _descriptor.FieldDescriptor(
+      name='global_settings', full_name='org.lfedge.eve.config.LocalOperationsPolicy.global_settings', index=1,
+      number=2, type=9, cpp_type=9, label=3,
+      has_default_value=False, default_value=[],
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
+    _descriptor.FieldDescriptor(
+      name='port_maps', full_name='org.lfedge.eve.config.LocalOperationsPolicy.port_maps', index=2,
+      number=3, type=8, cpp_type=7, label=1,
+      has_default_value=False, default_value=False,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
+    _descriptor.FieldDescriptor(
+      name='base_os_update', full_name='org.lfedge.eve.config.LocalOperationsPolicy.base_os_update', index=3,
+      number=4, type=8, cpp_type=7, label=1,
+      has_default_value=False, default_value=False,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
+  ],
+  extensions=[
+  ],
+  nested_types=[],
+  enum_types=[
+  ],
+  serialized_options=None,
+  is_extendable=False,
+  syntax='proto3',
+  extension_ranges=[],
+  oneofs=[
+  ],
+  serialized_start=55,
+  serialized_end=168,
+)
+
+DESCRIPTOR.message_types_by_name['LocalOperationsPolicy'] = _LOCALOPERATIONSPOLICY
+_sym_db.RegisterFileDescriptor(DESCRIPTOR)
+
+LocalOperationsPolicy = _reflection.GeneratedProtocolMessageType('LocalOperationsPolicy', (_message.Message,), {
+  'DESCRIPTOR' : _LOCALOPERATIONSPOLICY,
+  '__module__' : 'config.localoperations_pb2'
+  # @@protoc_insertion_point(class_scope:org.lfedge.eve.config.LocalOperationsPolicy)
+  })
+_sym_db.RegisterMessage(LocalOperationsPolicy)
+
+
+DESCRIPTOR._options = None
+# @@protoc_insertion_point(module_scope)
@@ -2,6 +2,7 @@
 # Generated by the protocol buffer compiler.  DO NOT EDIT!
 # source: config/netconfig.proto
 """Generated protocol buffer code."""
+from google.protobuf.internal import enum_type_wrapper
 from google.protobuf import descriptor as _descriptor
 from google.protobuf import message as _message
 from google.protobuf import reflection as _reflection
@@ -22,10 +23,56 @@ DESCRIPTOR = _descriptor.FileDescriptor(
   syntax='proto3',
   serialized_options=b'\n\025org.lfedge.eve.configZ$github.com/lf-edge/eve/api/go/config',
   create_key=_descriptor._internal_create_key,
-  serialized_pb=b'\n\x16\x63onfig/netconfig.proto\x12\x15org.lfedge.eve.config\x1a\x18\x63onfig/acipherinfo.proto\x1a\x0f\x63onfig/fw.proto\x1a\x13\x63onfig/netcmn.proto\"\x9f\x02\n\rNetworkConfig\x12\n\n\x02id\x18\x01 \x01(\t\x12\x30\n\x04type\x18\x05 \x01(\x0e\x32\".org.lfedge.eve.config.NetworkType\x12)\n\x02ip\x18\x06 \x01(\x0b\x32\x1d.org.lfedge.eve.config.ipspec\x12\x36\n\x03\x64ns\x18\x07 \x03(\x0b\x32).org.lfedge.eve.config.ZnetStaticDNSEntry\x12\x34\n\x08\x65ntProxy\x18\x08 \x01(\x0b\x32\".org.lfedge.eve.config.ProxyConfig\x12\x37\n\x08wireless\x18\n \x01(\x0b\x32%.org.lfedge.eve.config.WirelessConfig\"\xf9\x01\n\x0eNetworkAdapter\x12\x0c\n\x04name\x18\x01 \x01(\t\x12\x11\n\tnetworkId\x18\x03 \x01(\t\x12\x0c\n\x04\x61\x64\x64r\x18\x04 \x01(\t\x12\x10\n\x08hostname\x18\x05 \x01(\t\x12\x11\n\tcryptoEid\x18\n \x01(\t\x12\x15\n\rlispsignature\x18\x06 \x01(\t\x12\x0f\n\x07pemcert\x18\x07 \x01(\x0c\x12\x15\n\rpemprivatekey\x18\x08 \x01(\x0c\x12\x12\n\nmacAddress\x18\t \x01(\t\x12(\n\x04\x61\x63ls\x18( \x03(\x0b\x32\x1a.org.lfedge.eve.config.ACE\x12\x16\n\x0e\x61\x63\x63\x65ss_vlan_id\x18) \x01(\r\"\xb3\x01\n\x0eWirelessConfig\x12\x31\n\x04type\x18\x01 \x01(\x0e\x32#.org.lfedge.eve.config.WirelessType\x12:\n\x0b\x63\x65llularCfg\x18\x05 \x03(\x0b\x32%.org.lfedge.eve.config.CellularConfig\x12\x32\n\x07wifiCfg\x18\n \x03(\x0b\x32!.org.lfedge.eve.config.WifiConfig\"y\n\x0e\x43\x65llularConfig\x12\x0b\n\x03\x41PN\x18\x01 \x01(\t\x12?\n\x05probe\x18\x02 \x01(\x0b\x32\x30.org.lfedge.eve.config.CellularConnectivityProbe\x12\x19\n\x11location_tracking\x18\x03 \x01(\x08\"C\n\x19\x43\x65llularConnectivityProbe\x12\x0f\n\x07\x64isable\x18\x01 \x01(\x08\x12\x15\n\rprobe_address\x18\x02 \x01(\t\"\xb7\x02\n\nWifiConfig\x12\x10\n\x08wifiSSID\x18\x01 \x01(\t\x12\x37\n\tkeyScheme\x18\x02 \x01(\x0e\x32$.org.lfedge.eve.config.WiFiKeyScheme\x12\x10\n\x08identity\x18\x05 \x01(\t\x12\x10\n\x08password\x18\n \x01(\t\x12=\n\x06\x63rypto\x18\x14 \x01(\x0b\x32-.org.lfedge.eve.config.WifiConfig.cryptoblock\x12\x10\n\x08priority\x18\x19 \x01(\x05\x12\x36\n\ncipherData\x18\x1e \x01(\x0b\x32\".org.lfedge.eve.config.CipherBlock\x1a\x31\n\x0b\x63ryptoblock\x12\x10\n\x08identity\x18\x0b \x01(\t\x12\x10\n\x08password\x18\x0c \x01(\tB=\n\x15org.lfedge.eve.configZ$github.com/lf-edge/eve/api/go/configb\x06proto3'
+  serialized_pb=b'\n\x16\x63onfig/netconfig.proto\x12\x15org.lfedge.eve.config\x1a\x18\x63onfig/acipherinfo.proto\x1a\x0f\x63onfig/fw.proto\x1a\x13\x63onfig/netcmn.proto\"\x9f\x02\n\rNetworkConfig\x12\n\n\x02id\x18\x01 \x01(\t\x12\x30\n\x04type\x18\x05 \x01(\x0e\x32\".org.lfedge.eve.config.NetworkType\x12)\n\x02ip\x18\x06 \x01(\x0b\x32\x1d.org.lfedge.eve.config.ipspec\x12\x36\n\x03\x64ns\x18\x07 \x03(\x0b\x32).org.lfedge.eve.config.ZnetStaticDNSEntry\x12\x34\n\x08\x65ntProxy\x18\x08 \x01(\x0b\x32\".org.lfedge.eve.config.ProxyConfig\x12\x37\n\x08wireless\x18\n \x01(\x0b\x32%.org.lfedge.eve.config.WirelessConfig\"\xf9\x01\n\x0eNetworkAdapter\x12\x0c\n\x04name\x18\x01 \x01(\t\x12\x11\n\tnetworkId\x18\x03 \x01(\t\x12\x0c\n\x04\x61\x64\x64r\x18\x04 \x01(\t\x12\x10\n\x08hostname\x18\x05 \x01(\t\x12\x11\n\tcryptoEid\x18\n \x01(\t\x12\x15\n\rlispsignature\x18\x06 \x01(\t\x12\x0f\n\x07pemcert\x18\x07 \x01(\x0c\x12\x15\n\rpemprivatekey\x18\x08 \x01(\x0c\x12\x12\n\nmacAddress\x18\t \x01(\t\x12(\n\x04\x61\x63ls\x18( \x03(\x0b\x32\x1a.org.lfedge.eve.config.ACE\x12\x16\n\x0e\x61\x63\x63\x65ss_vlan_id\x18) \x01(\r\"\xb3\x01\n\x0eWirelessConfig\x12\x31\n\x04type\x18\x01 \x01(\x0e\x32#.org.lfedge.eve.config.WirelessType\x12:\n\x0b\x63\x65llularCfg\x18\x05 \x03(\x0b\x32%.org.lfedge.eve.config.CellularConfig\x12\x32\n\x07wifiCfg\x18\n \x03(\x0b\x32!.org.lfedge.eve.config.WifiConfig\"\xf5\x01\n\x0e\x43\x65llularConfig\x12\x0b\n\x03\x41PN\x18\x01 \x01(\t\x12?\n\x05probe\x18\x02 \x01(\x0b\x32\x30.org.lfedge.eve.config.CellularConnectivityProbe\x12\x19\n\x11location_tracking\x18\x03 \x01(\x08\x12?\n\x0csim_profiles\x18\x04 \x03(\x0b\x32).org.lfedge.eve.config.CellularSimProfile\x12\x39\n\x08\x66\x61ilover\x18\x05 \x01(\x0b\x32\'.org.lfedge.eve.config.CellularFailover\"\xfd\x01\n\x11\x43\x65llularDataQuota\x12\x13\n\x0blimit_bytes\x18\x01 \x01(\x04\x12\x19\n\x11\x62illing_cycle_day\x18\x02 \x01(\r\x12\x1e\n\x16soft_threshold_percent\x18\x03 \x01(\r\x12K\n\x16soft_threshold_allowed\x18\x04 \x03(\x0e\x32+.org.lfedge.eve.config.CellularTrafficClass\x12K\n\x16hard_threshold_allowed\x18\x05 \x03(\x0e\x32+.org.lfedge.eve.config.CellularTrafficClass\"l\n\x12\x43\x65llularSimProfile\x12\x10\n\x08sim_slot\x18\x01 \x01(\r\x12\x0b\n\x03\x61pn\x18\x02 \x01(\t\x12\x37\n\x05quota\x18\x03 \x01(\x0b\x32(.org.lfedge.eve.config.CellularDataQuota\"v\n\x10\x43\x65llularFailover\x12\x0e\n\x06\x65nable\x18\x01 \x01(\x08\x12\x1d\n\x15probe_failure_timeout\x18\x02 \x01(\r\x12\x1a\n\x12on_quota_exhausted\x18\x03 \x01(\x08\x12\x17\n\x0frevert_interval\x18\x04 \x01(\r\"C\n\x19\x43\x65llularConnectivityProbe\x12\x0f\n\x07\x64isable\x18\x01 \x01(\x08\x12\x15\n\rprobe_address\x18\x02 \x01(\t\"\xb7\x02\n\nWifiConfig\x12\x10\n\x08wifiSSID\x18\x01 \x01(\t\x12\x37\n\tkeyScheme\x18\x02 \x01(\x0e\x32$.org.lfedge.eve.config.WiFiKeyScheme\x12\x10\n\x08identity\x18\x05 \x01(\t\x12\x10\n\x08password\x18\n \x01(\t\x12=\n\x06\x63rypto\x18\x14 \x01(\x0b\x32-.org.lfedge.eve.config.WifiConfig.cryptoblock\x12\x10\n\x08priority\x18\x19 \x01(\x05\x12\x36\n\ncipherData\x18\x1e \x01(\x0b\x32\".org.lfedge.eve.config.CipherBlock\x1a\x31\n\x0b\x63ryptoblock\x12\x10\n\x08identity\x18\x0b \x01(\t\x12\x10\n\x08password\x18\x0c \x01(\t*\xca\x01\n\x14\x43\x65llularTrafficClass\x12&\n\"CELLULAR_TRAFFIC_CLASS_UNSPECIFIED\x10\x00\x12\x1f\n\x1b\x43\x45LLULAR_TRAFFIC_CLASS_LOGS\x10\x01\x12\"\n\x1e\x43\x45LLULAR_TRAFFIC_CLASS_METRICS\x10\x02\x12$\n CELLULAR_TRAFFIC_CLASS_DOWNLOADS\x10\x03\x12\x1f\n\x1b\x43\x45LLULAR_TRAFFIC_CLASS_APPS\x10\x04\x42=\n\x15org.lfedge.eve.configZ$github.com/lf-edge/eve/api/go/configb\x06proto3'
   ,
   dependencies=[config_dot_acipherinfo__pb2.DESCRIPTOR,config_dot_fw__pb2.DESCRIPTOR,config_dot_netcmn__pb2.DESCRIPTOR,])
 
+_CELLULARTRAFFICCLASS = _descriptor.EnumDescriptor(
+  name='CellularTrafficClass',
+  full_name='org.lfedge.eve.config.CellularTrafficClass',
+  filename=None,
+  file=DESCRIPTOR,
+  create_key=_descriptor._internal_create_key,
+  values=[
+    _descriptor.EnumValueDescriptor(
+      name='CELLULAR_TRAFFIC_CLASS_UNSPECIFIED', index=0, number=0,
+      serialized_options=None,
+      type=None,
+      create_key=_descriptor._internal_create_key),
+    _descriptor.EnumValueDescriptor(
+      name='CELLULAR_TRAFFIC_CLASS_LOGS', index=1, number=1,
+      serialized_options=None,
+      type=None,
+      create_key=_descriptor._internal_create_key),
+    _descriptor.EnumValueDescriptor(
+      name='CELLULAR_TRAFFIC_CLASS_METRICS', index=2, number=2,
+      serialized_options=None,
+      type=None,
+      create_key=_descriptor._internal_create_key),
+    _descriptor.EnumValueDescriptor(
+      name='CELLULAR_TRAFFIC_CLASS_DOWNLOADS', index=3, number=3,
+      serialized_options=None,
+      type=None,
+      create_key=_descriptor._internal_create_key),
+    _descriptor.EnumValueDescriptor(
+      name='CELLULAR_TRAFFIC_CLASS_APPS', index=4, number=4,
+      serialized_options=None,
+      type=None,
+      create_key=_descriptor._internal_create_key),
+  ],
+  containing_type=None,
+  serialized_options=None,
+  serialized_start=1955,
+  serialized_end=2157,
+)
+_sym_db.RegisterEnumDescriptor(_CELLULARTRAFFICCLASS)
+
+CellularTrafficClass = enum_type_wrapper.EnumTypeWrapper(_CELLULARTRAFFICCLASS)
+CELLULAR_TRAFFIC_CLASS_UNSPECIFIED = 0
+CELLULAR_TRAFFIC_CLASS_LOGS = 1
+CELLULAR_TRAFFIC_CLASS_METRICS = 2
+CELLULAR_TRAFFIC_CLASS_DOWNLOADS = 3
+CELLULAR_TRAFFIC_CLASS_APPS = 4
 
 
 
@@ -273,6 +320,179 @@ _CELLULARCONFIG = _descriptor.Descriptor(
       message_type=None, enum_type=None, containing_type=None,
       is_extension=False, extension_scope=None,
       serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
+    _descriptor.FieldDescriptor(
+      name='sim_profiles', full_name='org.lfedge.eve.config.CellularConfig.sim_profiles', index=3,
+      number=4, type=11, cpp_type=10, label=3,
+      has_default_value=False, default_value=[],
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
+    _descriptor.FieldDescriptor(
+      name='failover', full_name='org.lfedge.eve.config.CellularConfig.failover', index=4,
+      number=5, type=11, cpp_type=10, label=1,
+      has_default_value=False, default_value=None,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
+  ],
+  extensions=[
+  ],
+  nested_types=[],
+  enum_types=[
+  ],
+  serialized_options=None,
+  is_extendable=False,
+  syntax='proto3',
+  extension_ranges=[],
+  oneofs=[
+  ],
+  serialized_start=838,
+  serialized_end=1083,
+)
+
+
+_CELLULARDATAQUOTA = _descriptor.Descriptor(
+  name='CellularDataQuota',
+  full_name='org.lfedge.eve.config.CellularDataQuota',
+  filename=None,
+  file=DESCRIPTOR,
+  containing_type=None,
+  create_key=_descriptor._internal_create_key,
+  fields=[
+    _descriptor.FieldDescriptor(
+      name='limit_bytes', full_name='org.lfedge.eve.config.CellularDataQuota.limit_bytes', index=0,
+      number=1, type=4, cpp_type=4, label=1,
+      has_default_value=False, default_value=0,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
+    _descriptor.FieldDescriptor(
+      name='billing_cycle_day', full_name='org.lfedge.eve.config.CellularDataQuota.billing_cycle_day', index=1,
+      number=2, type=13, cpp_type=3, label=1,
+      has_default_value=False, default_value=0,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
+    _descriptor.FieldDescriptor(
+      name='soft_threshold_percent', full_name='org.lfedge.eve.config.CellularDataQuota.soft_threshold_percent', index=2,
+      number=3, type=13, cpp_type=3, label=1,
+      has_default_value=False, default_value=0,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
+    _descriptor.FieldDescriptor(
+      name='soft_threshold_allowed', full_name='org.lfedge.eve.config.CellularDataQuota.soft_threshold_allowed', index=3,
+      number=4, type=14, cpp_type=8, label=3,
+      has_default_value=False, default_value=[],
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
+    _descriptor.FieldDescriptor(
+      name='hard_threshold_allowed', full_name='org.lfedge.eve.config.CellularDataQuota.hard_threshold_allowed', index=4,
+      number=5, type=14, cpp_type=8, label=3,
+      has_default_value=False, default_value=[],
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
+  ],
+  extensions=[
+  ],
+  nested_types=[],
+  enum_types=[
+  ],
+  serialized_options=None,
+  is_extendable=False,
+  syntax='proto3',
+  extension_ranges=[],
+  oneofs=[
+  ],
+  serialized_start=1086,
+  serialized_end=1339,
+)
+
+
+_CELLULARSIMPROFILE = _descriptor.Descriptor(
+  name='CellularSimProfile',
+  full_name='org.lfedge.eve.config.CellularSimProfile',
+  filename=None,
+  file=DESCRIPTOR,
+  containing_type=None,
+  create_key=_descriptor._internal_create_key,
+  fields=[
+    _descriptor.FieldDescriptor(
+      name='sim_slot', full_name='org.lfedge.eve.config.CellularSimProfile.sim_slot', index=0,
+      number=1, type=13, cpp_type=3, label=1,
+      has_default_value=False, default_value=0,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
+    _descriptor.FieldDescriptor(
+      name='apn', full_name='org.lfedge.eve.config.CellularSimProfile.apn', index=1,
+      number=2, type=9, cpp_type=9, label=1,
+      has_default_value=False, default_value=b"".decode('utf-8'),
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
+    _descriptor.FieldDescriptor(
+      name='quota', full_name='org.lfedge.eve.config.CellularSimProfile.quota', index=2,
+      number=3, type=11, cpp_type=10, label=1,
+      has_default_value=False, default_value=None,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
+  ],
+  extensions=[
+  ],
+  nested_types=[],
+  enum_types=[
+  ],
+  serialized_options=None,
+  is_extendable=False,
+  syntax='proto3',
+  extension_ranges=[],
+  oneofs=[
+  ],
+  serialized_start=1341,
+  serialized_end=1449,
+)
+
+
+_CELLULARFAILOVER = _descriptor.Descriptor(
+  name='CellularFailover',
+  full_name='org.lfedge.eve.config.CellularFailover',
+  filename=None,
+  file=DESCRIPTOR,
+  containing_type=None,
+  create_key=_descriptor._internal_create_key,
+  fields=[
+    _descriptor.FieldDescriptor(
+      name='enable', full_name='org.lfedge.eve.config.CellularFailover.enable', index=0,
+      number=1, type=8, cpp_type=7, label=1,
+      has_default_value=False, default_value=False,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
+    _descriptor.FieldDescriptor(
+      name='probe_failure_timeout', full_name='org.lfedge.eve.config.CellularFailover.probe_failure_timeout', index=1,
+      number=2, type=13, cpp_type=3, label=1,
+      has_default_value=False, default_value=0,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
+    _descriptor.FieldDescriptor(
+      name='on_quota_exhausted', full_name='org.lfedge.eve.config.CellularFailover.on_quota_exhausted', index=2,
+      number=3, type=8, cpp_type=7, label=1,
+      has_default_value=False, default_value=False,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
+    _descriptor.FieldDescriptor(
+      name='revert_interval', full_name='org.lfedge.eve.config.CellularFailover.revert_interval', index=3,
+      number=4, type=13, cpp_type=3, label=1,
+      has_default_value=False, default_value=0,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
   ],
   extensions=[
   ],
@@ -285,8 +505,8 @@ _CELLULARCONFIG = _descriptor.Descriptor(
   extension_ranges=[],
   oneofs=[
   ],
-  serialized_start=837,
-  serialized_end=958,
+  serialized_start=1451,
+  serialized_end=1569,
 )
 
 
@@ -324,8 +544,8 @@ _CELLULARCONNECTIVITYPROBE = _descriptor.Descriptor(
   extension_ranges=[],
   oneofs=[
   ],
-  serialized_start=960,
-  serialized_end=1027,
+  serialized_start=1571,
+  serialized_end=1638,
 )
 
 
@@ -363,8 +583,8 @@ _WIFICONFIG_CRYPTOBLOCK = _descriptor.Descriptor(
   extension_ranges=[],
   oneofs=[
   ],
-  serialized_start=1292,
-  serialized_end=1341,
+  serialized_start=1903,
+  serialized_end=1952,
 )
 
 _WIFICONFIG = _descriptor.Descriptor(
@@ -436,8 +656,8 @@ _WIFICONFIG = _descriptor.Descriptor(
   extension_ranges=[],
   oneofs=[
   ],
-  serialized_start=1030,
-  serialized_end=1341,
+  serialized_start=1641,
+  serialized_end=1952,
 )
 
 _NETWORKCONFIG.fields_by_name['type'].enum_type = config_dot_netcmn__pb2._NETWORKTYPE
@@ -450,6 +670,11 @@ _WIRELESSCONFIG.fields_by_name['type'].enum_type = config_dot_netcmn__pb2._WIREL
 _WIRELESSCONFIG.fields_by_name['cellularCfg'].message_type = _CELLULARCONFIG
 _WIRELESSCONFIG.fields_by_name['wifiCfg'].message_type = _WIFICONFIG
 _CELLULARCONFIG.fields_by_name['probe'].message_type = _CELLULARCONNECTIVITYPROBE
+_CELLULARCONFIG.fields_by_name['sim_profiles'].message_type = _CELLULARSIMPROFILE
+_CELLULARCONFIG.fields_by_name['failover'].message_type = _CELLULARFAILOVER
+_CELLULARDATAQUOTA.fields_by_name['soft_threshold_allowed'].enum_type = _CELLULARTRAFFICCLASS
+_CELLULARDATAQUOTA.fields_by_name['hard_threshold_allowed'].enum_type = _CELLULARTRAFFICCLASS
+_CELLULARSIMPROFILE.fields_by_name['quota'].message_type = _CELLULARDATAQUOTA
 _WIFICONFIG_CRYPTOBLOCK.containing_type = _WIFICONFIG
 _WIFICONFIG.fields_by_name['keyScheme'].enum_type = config_dot_netcmn__pb2._WIFIKEYSCHEME
 _WIFICONFIG.fields_by_name['crypto'].message_type = _WIFICONFIG_CRYPTOBLOCK
@@ -458,8 +683,12 @@ DESCRIPTOR.message_types_by_name['NetworkConfig'] = _NETWORKCONFIG
 DESCRIPTOR.message_types_by_name['NetworkAdapter'] = _NETWORKADAPTER
 DESCRIPTOR.message_types_by_name['WirelessConfig'] = _WIRELESSCONFIG
 DESCRIPTOR.message_types_by_name['CellularConfig'] = _CELLULARCONFIG
+DESCRIPTOR.message_types_by_name['CellularDataQuota'] = _CELLULARDATAQUOTA
+DESCRIPTOR.message_types_by_name['CellularSimProfile'] = _CELLULARSIMPROFILE
+DESCRIPTOR.message_types_by_name['CellularFailover'] = _CELLULARFAILOVER
 DESCRIPTOR.message_types_by_name['CellularConnectivityProbe'] = _CELLULARCONNECTIVITYPROBE
 DESCRIPTOR.message_types_by_name['WifiConfig'] = _WIFICONFIG
+DESCRIPTOR.enum_types_by_name['CellularTrafficClass'] = _CELLULARTRAFFICCLASS
 _sym_db.RegisterFileDescriptor(DESCRIPTOR)
 
 NetworkConfig = _reflection.GeneratedProtocolMessageType('NetworkConfig', (_message.Message,), {
@@ -490,6 +719,27 @@ CellularConfig = _reflection.GeneratedProtocolMessageType('CellularConfig', (_me
   })
 _sym_db.RegisterMessage(CellularConfig)
 
+CellularDataQuota = _reflection.GeneratedProtocolMessageType('CellularDataQuota', (_message.Message,), {
+  'DESCRIPTOR' : _CELLULARDATAQUOTA,
+  '__module__' : 'config.netconfig_pb2'
+  # @@protoc_insertion_point(class_scope:org.lfedge.eve.config.CellularDataQuota)
+  })
+_sym_db.RegisterMessage(CellularDataQuota)
+
+CellularSimProfile = _reflection.GeneratedProtocolMessageType('CellularSimProfile', (_message.Message,), {
+  'DESCRIPTOR' : _CELLULARSIMPROFILE,
+  '__module__' : 'config.netconfig_pb2'
+  # @@protoc_insertion_point(class_scope:org.lfedge.eve.config.CellularSimProfile)
+  })
+_sym_db.RegisterMessage(CellularSimProfile)
+
+CellularFailover = _reflection.GeneratedProtocolMessageType('CellularFailover', (_message.Message,), {
+  'DESCRIPTOR' : _CELLULARFAILOVER,
+  '__module__' : 'config.netconfig_pb2'
+  # @@protoc_insertion_point(class_scope:org.lfedge.eve.config.CellularFailover)
+  })
+_sym_db.RegisterMessage(CellularFailover)
+
 CellularConnectivityProbe = _reflection.GeneratedProtocolMessageType('CellularConnectivityProbe', (_message.Message,), {
   'DESCRIPTOR' : _CELLULARCONNECTIVITYPROBE,
   '__module__' : 'config.netconfig_pb2'
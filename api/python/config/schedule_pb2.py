@@ -0,0 +1,233 @@
+# -*- coding: utf-8 -*-
+# Generated by the protocol buffer compiler.  DO NOT EDIT!
+# source: config/schedule.proto
+"""Generated protocol buffer code."""
+from google.protobuf import descriptor as _descriptor
+from google.protobuf import message as _message
+from google.protobuf import reflection as _reflection
+from google.protobuf import symbol_database as _symbol_database
+# @@protoc_insertion_point(imports)
+
+_sym_db = _symbol_database.Default()
+
+
+from google.protobuf import timestamp_pb2 as google_dot_protobuf_dot_timestamp__pb2
+
+
+DESCRIPTOR = _descriptor.FileDescriptor(
+  name='config/schedule.proto',
+  package='org.lfedge.eve.config',
+  syntax='proto3',
+  serialized_options=b'\n\025org.lfedge.eve.configZ$github.com/lf-edge/eve/api/go/config',
+  create_key=_descriptor._internal_create_key,
+  serialized_pb=b'\n\x15\x63onfig/schedule.proto\x12\x15org.lfedge.eve.config\x1a\x1fgoogle/protobuf/timestamp.proto\"H\n\nTimeWindow\x12\x14\n\x0cstart_minute\x18\x01 \x01(\r\x12\x12\n\nend_minute\x18\x02 \x01(\r\x12\x10\n\x08weekdays\x18\x03 \x01(\r\"Z\n\x0e\x44ownloadWindow\x12\x31\n\x06window\x18\x01 \x01(\x0b\x32!.org.lfedge.eve.config.TimeWindow\x12\x15\n\rmax_port_cost\x18\x02 \x01(\r\"\x91\x01\n\x0e\x44\x65viceSchedule\x12?\n\x10\x64ownload_windows\x18\x01 \x03(\x0b\x32%.org.lfedge.eve.config.DownloadWindow\x12>\n\x13maintenance_windows\x18\x02 \x03(\x0b\x32!.org.lfedge.eve.config.TimeWindow\"v\n\x0f\x43ontentSchedule\x12/\n\x0bprefetch_by\x18\x01 \x01(\x0b\x32\x1a.google.protobuf.Timestamp\x12\x32\n\x0e\x61\x63tivate_after\x18\x02 \x01(\x0b\x32\x1a.google.protobuf.TimestampB=\n\x15org.lfedge.eve.configZ$github.com/lf-edge/eve/api/go/configb\x06proto3'
+  ,
+  dependencies=[google_dot_protobuf_dot_timestamp__pb2.DESCRIPTOR,])
+
+
+
+
+_TIMEWINDOW = _descriptor.Descriptor(
+  name='TimeWindow',
+  full_name='org.lfedge.eve.config.TimeWindow',
+  filename=None,
+  file=DESCRIPTOR,
+  containing_type=None,
+  create_key=_descriptor._internal_create_key,
+  fields=[
+    _descriptor.FieldDescriptor(
+      name='start_minute', full_name='org.lfedge.eve.config.TimeWindow.start_minute', index=0,
+      number=1, type=13, cpp_type=3, label=1,
+      has_default_value=False, default_value=0,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
+    _descriptor.FieldDescriptor(
+      name='end_minute', full_name='org.lfedge.eve.config.TimeWindow.end_minute', index=1,
+      number=2, type=13, cpp_type=3, label=1,
+      has_default_value=False, default_value=0,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
+    _descriptor.FieldDescriptor(
+      name='weekdays', full_name='org.lfedge.eve.config.TimeWindow.weekdays', index=2,
+      number=3, type=13, cpp_type=3, label=1,
+      has_default_value=False, default_value=0,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
+  ],
+  extensions=[
+  ],
+  nested_types=[],
+  enum_types=[
+  ],
+  serialized_options=None,
+  is_extendable=False,
+  syntax='proto3',
+  extension_ranges=[],
+  oneofs=[
+  ],
+  serialized_start=81,
+  serialized_end=153,
+)
+
+
+_DOWNLOADWINDOW = _descriptor.Descriptor(
+  name='DownloadWindow',
+  full_name='org.lfedge.eve.config.DownloadWindow',
+  filename=None,
+  file=DESCRIPTOR,
+  containing_type=None,
+  create_key=_descriptor._internal_create_key,
+  fields=[
+    _descriptor.FieldDescriptor(
+      name='window', full_name='org.lfedge.eve.config.DownloadWindow.window', index=0,
+      number=1, type=11, cpp_type=10, label=1,
+      has_default_value=False, default_value=None,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
+    _descriptor.FieldDescriptor(
+      name='max_port_cost', full_name='org.lfedge.eve.config.DownloadWindow.max_port_cost', index=1,
+      number=2, type=13, cpp_type=3, label=1,
+      has_default_value=False, default_value=0,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
+  ],
+  extensions=[
+  ],
+  nested_types=[],
+  enum_types=[
+  ],
+  serialized_options=None,
+  is_extendable=False,
+  syntax='proto3',
+  extension_ranges=[],
+  oneofs=[
+  ],
+  serialized_start=155,
+  serialized_end=245,
+)
+
+
+_DEVICESCHEDULE = _descriptor.Descriptor(
+  name='DeviceSchedule',
+  full_name='org.lfedge.eve.config.DeviceSchedule',
+  filename=None,
+  file=DESCRIPTOR,
+  containing_type=None,
+  create_key=_descriptor._internal_create_key,
+  fields=[
+    _descriptor.FieldDescriptor(
+      name='download_windows', full_name='org.lfedge.eve.config.DeviceSchedule.download_windows', index=0,
+      number=1, type=11, cpp_type=10, label=3,
+      has_default_value=False, default_value=[],
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
+    _descriptor.FieldDescriptor(
+      name='maintenance_windows', full_name='org.lfedge.eve.config.DeviceSchedule.maintenance_windows', index=1,
+      number=2, type=11, cpp_type=10, label=3,
+      has_default_value=False, default_value=[],
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
+  ],
+  extensions=[
+  ],
+  nested_types=[],
+  enum_types=[
+  ],
+  serialized_options=None,
+  is_extendable=False,
+  syntax='proto3',
+  extension_ranges=[],
+  oneofs=[
+  ],
+  serialized_start=248,
+  serialized_end=393,
+)
+
+
+_CONTENTSCHEDULE = _descriptor.Descriptor(
+  name='ContentSchedule',
+  full_name='org.lfedge.eve.config.ContentSchedule',
+  filename=None,
+  file=DESCRIPTOR,
+  containing_type=None,
+  create_key=_descriptor._internal_create_key,
+  fields=[
+    _descriptor.FieldDescriptor(
+      name='prefetch_by', full_name='org.lfedge.eve.config.ContentSchedule.prefetch_by', index=0,
+      number=1, type=11, cpp_type=10, label=1,
+      has_default_value=False, default_value=None,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
+    _descriptor.FieldDescriptor(
+      name='activate_after', full_name='org.lfedge.eve.config.ContentSchedule.activate_after', index=1,
+      number=2, type=11, cpp_type=10, label=1,
+      has_default_value=False, default_value=None,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
+  ],
+  extensions=[
+  ],
+  nested_types=[],
+  enum_types=[
+  ],
+  serialized_options=None,
+  is_extendable=False,
+  syntax='proto3',
+  extension_ranges=[],
+  oneofs=[
+  ],
+  serialized_start=395,
+  serialized_end=513,
+)
+
+_DOWNLOADWINDOW.fields_by_name['window'].message_type = _TIMEWINDOW
+_DEVICESCHEDULE.fields_by_name['download_windows'].message_type = _DOWNLOADWINDOW
+_DEVICESCHEDULE.fields_by_name['maintenance_windows'].message_type = _TIMEWINDOW
+_CONTENTSCHEDULE.fields_by_name['prefetch_by'].message_type = google_dot_protobuf_dot_timestamp__pb2._TIMESTAMP
+_CONTENTSCHEDULE.fields_by_name['activate_after'].message_type = google_dot_protobuf_dot_timestamp__pb2._TIMESTAMP
+DESCRIPTOR.message_types_by_name['TimeWindow'] = _TIMEWINDOW
+DESCRIPTOR.message_types_by_name['DownloadWindow'] = _DOWNLOADWINDOW
+DESCRIPTOR.message_types_by_name['DeviceSchedule'] = _DEVICESCHEDULE
+DESCRIPTOR.message_types_by_name['ContentSchedule'] = _CONTENTSCHEDULE
+_sym_db.RegisterFileDescriptor(DESCRIPTOR)
+
+TimeWindow = _reflection.GeneratedProtocolMessageType('TimeWindow', (_message.Message,), {
+  'DESCRIPTOR' : _TIMEWINDOW,
+  '__module__' : 'config.schedule_pb2'
+  # @@protoc_insertion_point(class_scope:org.lfedge.eve.config.TimeWindow)
+  })
+_sym_db.RegisterMessage(TimeWindow)
+
+DownloadWindow = _reflection.GeneratedProtocolMessageType('DownloadWindow', (_message.Message,), {
+  'DESCRIPTOR' : _DOWNLOADWINDOW,
+  '__module__' : 'config.schedule_pb2'
+  # @@protoc_insertion_point(class_scope:org.lfedge.eve.config.DownloadWindow)
+  })
+_sym_db.RegisterMessage(DownloadWindow)
+
+DeviceSchedule = _reflection.GeneratedProtocolMessageType('DeviceSchedule', (_message.Message,), {
+  'DESCRIPTOR' : _DEVICESCHEDULE,
+  '__module__' : 'config.schedule_pb2'
+  # @@protoc_insertion_point(class_scope:org.lfedge.eve.config.DeviceSchedule)
+  })
+_sym_db.RegisterMessage(DeviceSchedule)
+
+ContentSchedule = _reflection.GeneratedProtocolMessageType('ContentSchedule', (_message.Message,), {
+  'DESCRIPTOR' : _CONTENTSCHEDULE,
+  '__module__' : 'config.schedule_pb2'
+  # @@protoc_insertion_point(class_scope:org.lfedge.eve.config.ContentSchedule)
+  })
+_sym_db.RegisterMessage(ContentSchedule)
+
+
+DESCRIPTOR._options = None
+# @@protoc_insertion_point(module_scope)
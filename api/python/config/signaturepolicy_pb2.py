@@ -0,0 +1,202 @@
+# -*- coding: utf-8 -*-
+# Generated by the protocol buffer compiler.  DO NOT EDIT!
+# source: config/signaturepolicy.proto
+"""Generated protocol buffer code."""
+from google.protobuf import descriptor as _descriptor
+from google.protobuf import message as _message
+from google.protobuf import reflection as _reflection
+from google.protobuf import symbol_database as _symbol_database
+# @@protoc_insertion_point(imports)
+
+_sym_db = _symbol_database.Default()
+
+
+
+
+DESCRIPTOR = _descriptor.FileDescriptor(
+  name='config/signaturepolicy.proto',
+  package='org.lfedge.eve.config',
+  syntax='proto3',
+  serialized_options=b'\n\025org.lfedge.eve.configZ$github.com/lf-edge/eve/api/go/config',
+  create_key=_descriptor._internal_create_key,
+  serialized_pb=b'\n\x1c\x63onfig/signaturepolicy.proto\x12\x15org.lfedge.eve.config\"\x93\x01\n\x0e\x43osignVerifier\x12\x16\n\x0epublic_key_pem\x18\x01 \x01(\t\x12\x11\n\troots_pem\x18\x02 \x01(\t\x12\x1c\n\x14rekor_public_key_pem\x18\x03 \x01(\t\x12\x10\n\x08identity\x18\x04 \x01(\t\x12\x0e\n\x06issuer\x18\x05 \x01(\t\x12\x16\n\x0epredicate_type\x18\x06 \x01(\t\"A\n\x10NotationVerifier\x12\x11\n\troots_pem\x18\x01 \x01(\t\x12\x1a\n\x12trusted_identities\x18\x02 \x03(\t\"\x83\x01\n\x0fSignaturePolicy\x12\x35\n\x06\x63osign\x18\x01 \x03(\x0b\x32%.org.lfedge.eve.config.CosignVerifier\x12\x39\n\x08notation\x18\x02 \x03(\x0b\x32\'.org.lfedge.eve.config.NotationVerifierB=\n\x15org.lfedge.eve.configZ$github.com/lf-edge/eve/api/go/configb\x06proto3'
+)
+
+
+
+
+_COSIGNVERIFIER = _descriptor.Descriptor(
+  name='CosignVerifier',
+  full_name='org.lfedge.eve.config.CosignVerifier',
+  filename=None,
+  file=DESCRIPTOR,
+  containing_type=None,
+  create_key=_descriptor._internal_create_key,
+  fields=[
+    _descriptor.FieldDescriptor(
+      name='public_key_pem', full_name='org.lfedge.eve.config.CosignVerifier.public_key_pem', index=0,
+      number=1, type=9, cpp_type=9, label=1,
+      has_default_value=False, default_value=b"".decode('utf-8'),
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
+    _descriptor.FieldDescriptor(
+      name='roots_pem', full_name='org.lfedge.eve.config.CosignVerifier.roots_pem', index=1,
+      number=2, type=9, cpp_type=9, label=1,
+      has_default_value=False, default_value=b"".decode('utf-8'),
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
+    _descriptor.FieldDescriptor(
+      name='rekor_public_key_pem', full_name='org.lfedge.eve.config.CosignVerifier.rekor_public_key_pem', index=2,
+      number=3, type=9, cpp_type=9, label=1,
+      has_default_value=False, default_value=b"".decode('utf-8'),
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
+    _descriptor.FieldDescriptor(
+      name='identity', full_name='org.lfedge.eve.config.CosignVerifier.identity', index=3,
+      number=4, type=9, cpp_type=9, label=1,
+      has_default_value=False, default_value=b"".decode('utf-8'),
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
+    _descriptor.FieldDescriptor(
+      name='issuer', full_name='org.lfedge.eve.config.CosignVerifier.issuer', index=4,
+      number=5, type=9, cpp_type=9, label=1,
+      has_default_value=False, default_value=b"".decode('utf-8'),
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
+    _descriptor.FieldDescriptor(
+      name='predicate_type', full_name='org.lfedge.eve.config.CosignVerifier.predicate_type', index=5,
+      number=6, type=9, cpp_type=9, label=1,
+      has_default_value=False, default_value=b"".decode('utf-8'),
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
+  ],
+  extensions=[
+  ],
+  nested_types=[],
+  enum_types=[
+  ],
+  serialized_options=None,
+  is_extendable=False,
+  syntax='proto3',
+  extension_ranges=[],
+  oneofs=[
+  ],
+  serialized_start=56,
+  serialized_end=203,
+)
+
+
+_NOTATIONVERIFIER = _descriptor.Descriptor(
+  name='NotationVerifier',
+  full_name='org.lfedge.eve.config.NotationVerifier',
+  filename=None,
+  file=DESCRIPTOR,
+  containing_type=None,
+  create_key=_descriptor._internal_create_key,
+  fields=[
+    _descriptor.FieldDescriptor(
+      name='roots_pem', full_name='org.lfedge.eve.config.NotationVerifier.roots_pem', index=0,
+      number=1, type=9, cpp_type=9, label=1,
+      has_default_value=False, default_value=b"".decode('utf-8'),
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
+    _descriptor.FieldDescriptor(
+      name='trusted_identities', full_name='org.lfedge.eve.config.NotationVerifier.trusted_identities', index=1,
+      number=2, type=9, cpp_type=9, label=3,
+      has_default_value=False, default_value=[],
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
+  ],
+  extensions=[
+  ],
+  nested_types=[],
+  enum_types=[
+  ],
+  serialized_options=None,
+  is_extendable=False,
+  syntax='proto3',
+  extension_ranges=[],
+  oneofs=[
+  ],
+  serialized_start=205,
+  serialized_end=270,
+)
+
+
+_SIGNATUREPOLICY = _descriptor.Descriptor(
+  name='SignaturePolicy',
+  full_name='org.lfedge.eve.config.SignaturePolicy',
+  filename=None,
+  file=DESCRIPTOR,
+  containing_type=None,
+  create_key=_descriptor._internal_create_key,
+  fields=[
+    _descriptor.FieldDescriptor(
+      name='cosign', full_name='org.lfedge.eve.config.SignaturePolicy.cosign', index=0,
+      number=1, type=11, cpp_type=10, label=3,
+      has_default_value=False, default_value=[],
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
+    _descriptor.FieldDescriptor(
+      name='notation', full_name='org.lfedge.eve.config.SignaturePolicy.notation', index=1,
+      number=2, type=11, cpp_type=10, label=3,
+      has_default_value=False, default_value=[],
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
+  ],
+  extensions=[
+  ],
+  nested_types=[],
+  enum_types=[
+  ],
+  serialized_options=None,
+  is_extendable=False,
+  syntax='proto3',
+  extension_ranges=[],
+  oneofs=[
+  ],
+  serialized_start=273,
+  serialized_end=404,
+)
+
+_SIGNATUREPOLICY.fields_by_name['cosign'].message_type = _COSIGNVERIFIER
+_SIGNATUREPOLICY.fields_by_name['notation'].message_type = _NOTATIONVERIFIER
+DESCRIPTOR.message_types_by_name['CosignVerifier'] = _COSIGNVERIFIER
+DESCRIPTOR.message_types_by_name['NotationVerifier'] = _NOTATIONVERIFIER
+DESCRIPTOR.message_types_by_name['SignaturePolicy'] = _SIGNATUREPOLICY
+_sym_db.RegisterFileDescriptor(DESCRIPTOR)
+
+CosignVerifier = _reflection.GeneratedProtocolMessageType('CosignVerifier', (_message.Message,), {
+  'DESCRIPTOR' : _COSIGNVERIFIER,
+  '__module__' : 'config.signaturepolicy_pb2'
+  # @@protoc_insertion_point(class_scope:org.lfedge.eve.config.CosignVerifier)
+  })
+_sym_db.RegisterMessage(CosignVerifier)
+
+NotationVerifier = _reflection.GeneratedProtocolMessageType('NotationVerifier', (_message.Message,), {
+  'DESCRIPTOR' : _NOTATIONVERIFIER,
+  '__module__' : 'config.signaturepolicy_pb2'
+  # @@protoc_insertion_point(class_scope:org.lfedge.eve.config.NotationVerifier)
+  })
+_sym_db.RegisterMessage(NotationVerifier)
+
+SignaturePolicy = _reflection.GeneratedProtocolMessageType('SignaturePolicy', (_message.Message,), {
+  'DESCRIPTOR' : _SIGNATUREPOLICY,
+  '__module__' : 'config.signaturepolicy_pb2'
+  # @@protoc_insertion_point(class_scope:org.lfedge.eve.config.SignaturePolicy)
+  })
+_sym_db.RegisterMessage(SignaturePolicy)
+
+
+DESCRIPTOR._options = None
+# @@protoc_insertion_point(module_scope)
@@ -15,6 +15,8 @@ _sym_db = _symbol_database.Default()
 from config import devcommon_pb2 as config_dot_devcommon__pb2
 from config import acipherinfo_pb2 as config_dot_acipherinfo__pb2
 from evecommon import evecommon_pb2 as evecommon_dot_evecommon__pb2
+from config import schedule_pb2 as config_dot_schedule__pb2
+from config import signaturepolicy_pb2 as config_dot_signaturepolicy__pb2
 
 
 DESCRIPTOR = _descriptor.FileDescriptor(
@@ -23,9 +25,9 @@ DESCRIPTOR = _descriptor.FileDescriptor(
   syntax='proto3',
   serialized_options=b'\n\025org.lfedge.eve.configZ$github.com/lf-edge/eve/api/go/config',
   create_key=_descriptor._internal_create_key,
-  serialized_pb=b'\n\x14\x63onfig/storage.proto\x12\x15org.lfedge.eve.config\x1a\x16\x63onfig/devcommon.proto\x1a\x18\x63onfig/acipherinfo.proto\x1a\x19\x65vecommon/evecommon.proto\"P\n\rSignatureInfo\x12\x15\n\rintercertsurl\x18\x01 \x01(\t\x12\x15\n\rsignercerturl\x18\x02 \x01(\t\x12\x11\n\tsignature\x18\x03 \x01(\x0c\"\xe5\x01\n\x0f\x44\x61tastoreConfig\x12\n\n\x02id\x18\x64 \x01(\t\x12,\n\x05\x64Type\x18\x01 \x01(\x0e\x32\x1d.org.lfedge.eve.config.DsType\x12\x0c\n\x04\x66qdn\x18\x02 \x01(\t\x12\x0e\n\x06\x61piKey\x18\x03 \x01(\t\x12\x10\n\x08password\x18\x04 \x01(\t\x12\r\n\x05\x64path\x18\x05 \x01(\t\x12\x0e\n\x06region\x18\x06 \x01(\t\x12\x36\n\ncipherData\x18\x07 \x01(\x0b\x32\".org.lfedge.eve.config.CipherBlock\x12\x11\n\tdsCertPEM\x18\x08 \x03(\x0c\"\xec\x01\n\x05Image\x12=\n\x0euuidandversion\x18\x01 \x01(\x0b\x32%.org.lfedge.eve.config.UUIDandVersion\x12\x0c\n\x04name\x18\x02 \x01(\t\x12\x0e\n\x06sha256\x18\x03 \x01(\t\x12.\n\x07iformat\x18\x04 \x01(\x0e\x32\x1d.org.lfedge.eve.config.Format\x12\x35\n\x07siginfo\x18\x05 \x01(\x0b\x32$.org.lfedge.eve.config.SignatureInfo\x12\x0c\n\x04\x64sId\x18\x06 \x01(\t\x12\x11\n\tsizeBytes\x18\x08 \x01(\x03\"\xd0\x01\n\x05\x44rive\x12+\n\x05image\x18\x01 \x01(\x0b\x32\x1c.org.lfedge.eve.config.Image\x12\x10\n\x08readonly\x18\x05 \x01(\x08\x12\x10\n\x08preserve\x18\x06 \x01(\x08\x12\x31\n\x07\x64rvtype\x18\x08 \x01(\x0e\x32 .org.lfedge.eve.config.DriveType\x12-\n\x06target\x18\t \x01(\x0e\x32\x1d.org.lfedge.eve.config.Target\x12\x14\n\x0cmaxsizebytes\x18\n \x01(\x03\"\xf2\x01\n\x0b\x43ontentTree\x12\x0c\n\x04uuid\x18\x01 \x01(\t\x12\x0c\n\x04\x64sId\x18\x02 \x01(\t\x12\x0b\n\x03URL\x18\x03 \x01(\t\x12.\n\x07iformat\x18\x04 \x01(\x0e\x32\x1d.org.lfedge.eve.config.Format\x12\x0e\n\x06sha256\x18\x05 \x01(\t\x12\x14\n\x0cmaxSizeBytes\x18\x06 \x01(\x04\x12\x35\n\x07siginfo\x18\x07 \x01(\x0b\x32$.org.lfedge.eve.config.SignatureInfo\x12\x13\n\x0b\x64isplayName\x18\x08 \x01(\t\x12\x18\n\x10generation_count\x18\t \x01(\x03\"r\n\x13VolumeContentOrigin\x12<\n\x04type\x18\x01 \x01(\x0e\x32..org.lfedge.eve.config.VolumeContentOriginType\x12\x1d\n\x15\x64ownloadContentTreeID\x18\x02 \x01(\t\"\xfd\x01\n\x06Volume\x12\x0c\n\x04uuid\x18\x01 \x01(\t\x12:\n\x06origin\x18\x02 \x01(\x0b\x32*.org.lfedge.eve.config.VolumeContentOrigin\x12?\n\tprotocols\x18\x03 \x03(\x0e\x32,.org.lfedge.eve.config.VolumeAccessProtocols\x12\x17\n\x0fgenerationCount\x18\x04 \x01(\x03\x12\x14\n\x0cmaxsizebytes\x18\x05 \x01(\x03\x12\x10\n\x08readonly\x18\x06 \x01(\x08\x12\x13\n\x0b\x64isplayName\x18\x07 \x01(\t\x12\x12\n\nclear_text\x18\x08 \x01(\x08\"\xb8\x01\n\nDiskConfig\x12\x34\n\x04\x64isk\x18\x01 \x01(\x0b\x32&.org.lfedge.eve.common.DiskDescription\x12\x38\n\x08old_disk\x18\x02 \x01(\x0b\x32&.org.lfedge.eve.common.DiskDescription\x12:\n\x0b\x64isk_config\x18\x03 \x01(\x0e\x32%.org.lfedge.eve.config.DiskConfigType\"\xb0\x01\n\x0b\x44isksConfig\x12\x30\n\x05\x64isks\x18\x01 \x03(\x0b\x32!.org.lfedge.eve.config.DiskConfig\x12\x39\n\narray_type\x18\x02 \x01(\x0e\x32%.org.lfedge.eve.config.DisksArrayType\x12\x34\n\x08\x63hildren\x18\x03 \x03(\x0b\x32\".org.lfedge.eve.config.DisksConfig*\x85\x01\n\x06\x44sType\x12\r\n\tDsUnknown\x10\x00\x12\n\n\x06\x44sHttp\x10\x01\x12\x0b\n\x07\x44sHttps\x10\x02\x12\x08\n\x04\x44sS3\x10\x03\x12\n\n\x06\x44sSFTP\x10\x04\x12\x17\n\x13\x44sContainerRegistry\x10\x05\x12\x0f\n\x0b\x44sAzureBlob\x10\x06\x12\x13\n\x0f\x44sGoogleStorage\x10\x07*k\n\x06\x46ormat\x12\x0e\n\nFmtUnknown\x10\x00\x12\x07\n\x03RAW\x10\x01\x12\x08\n\x04QCOW\x10\x02\x12\t\n\x05QCOW2\x10\x03\x12\x07\n\x03VHD\x10\x04\x12\x08\n\x04VMDK\x10\x05\x12\x07\n\x03OVA\x10\x06\x12\x08\n\x04VHDX\x10\x07\x12\r\n\tCONTAINER\x10\x08*G\n\x06Target\x12\x0e\n\nTgtUnknown\x10\x00\x12\x08\n\x04\x44isk\x10\x01\x12\n\n\x06Kernel\x10\x02\x12\n\n\x06Initrd\x10\x03\x12\x0b\n\x07RamDisk\x10\x04*I\n\tDriveType\x12\x10\n\x0cUnclassified\x10\x00\x12\t\n\x05\x43\x44ROM\x10\x01\x12\x07\n\x03HDD\x10\x02\x12\x07\n\x03NET\x10\x03\x12\r\n\tHDD_EMPTY\x10\x04*1\n\x15VolumeAccessProtocols\x12\x0c\n\x08VAP_NONE\x10\x00\x12\n\n\x06VAP_9P\x10\x01*N\n\x17VolumeContentOriginType\x12\x10\n\x0cVCOT_UNKNOWN\x10\x00\x12\x0e\n\nVCOT_BLANK\x10\x01\x12\x11\n\rVCOT_DOWNLOAD\x10\x02*\xec\x01\n\x0e\x44iskConfigType\x12 \n\x1c\x44ISK_CONFIG_TYPE_UNSPECIFIED\x10\x00\x12\x1a\n\x16\x44ISK_CONFIG_TYPE_EVEOS\x10\x01\x12\x1c\n\x18\x44ISK_CONFIG_TYPE_PERSIST\x10\x02\x12\x1f\n\x1b\x44ISK_CONFIG_TYPE_ZFS_ONLINE\x10\x03\x12 \n\x1c\x44ISK_CONFIG_TYPE_ZFS_OFFLINE\x10\x04\x12\x1e\n\x1a\x44ISK_CONFIG_TYPE_APPDIRECT\x10\x05\x12\x1b\n\x17\x44ISK_CONFIG_TYPE_UNUSED\x10\x06*\xa2\x01\n\x0e\x44isksArrayType\x12 \n\x1c\x44ISKS_ARRAY_TYPE_UNSPECIFIED\x10\x00\x12\x1a\n\x16\x44ISKS_ARRAY_TYPE_RAID0\x10\x01\x12\x1a\n\x16\x44ISKS_ARRAY_TYPE_RAID1\x10\x02\x12\x1a\n\x16\x44ISKS_ARRAY_TYPE_RAID5\x10\x03\x12\x1a\n\x16\x44ISKS_ARRAY_TYPE_RAID6\x10\x04\x42=\n\x15org.lfedge.eve.configZ$github.com/lf-edge/eve/api/go/configb\x06proto3'
+  serialized_pb=b'\n\x14\x63onfig/storage.proto\x12\x15org.lfedge.eve.config\x1a\x16\x63onfig/devcommon.proto\x1a\x18\x63onfig/acipherinfo.proto\x1a\x19\x65vecommon/evecommon.proto\x1a\x15\x63onfig/schedule.proto\x1a\x1c\x63onfig/signaturepolicy.proto\"P\n\rSignatureInfo\x12\x15\n\rintercertsurl\x18\x01 \x01(\t\x12\x15\n\rsignercerturl\x18\x02 \x01(\t\x12\x11\n\tsignature\x18\x03 \x01(\x0c\"\xa7\x02\n\x0f\x44\x61tastoreConfig\x12\n\n\x02id\x18\x64 \x01(\t\x12,\n\x05\x64Type\x18\x01 \x01(\x0e\x32\x1d.org.lfedge.eve.config.DsType\x12\x0c\n\x04\x66qdn\x18\x02 \x01(\t\x12\x0e\n\x06\x61piKey\x18\x03 \x01(\t\x12\x10\n\x08password\x18\x04 \x01(\t\x12\r\n\x05\x64path\x18\x05 \x01(\t\x12\x0e\n\x06region\x18\x06 \x01(\t\x12\x36\n\ncipherData\x18\x07 \x01(\x0b\x32\".org.lfedge.eve.config.CipherBlock\x12\x11\n\tdsCertPEM\x18\x08 \x03(\x0c\x12@\n\x10signature_policy\x18\t \x01(\x0b\x32&.org.lfedge.eve.config.SignaturePolicy\"\xec\x01\n\x05Image\x12=\n\x0euuidandversion\x18\x01 \x01(\x0b\x32%.org.lfedge.eve.config.UUIDandVersion\x12\x0c\n\x04name\x18\x02 \x01(\t\x12\x0e\n\x06sha256\x18\x03 \x01(\t\x12.\n\x07iformat\x18\x04 \x01(\x0e\x32\x1d.org.lfedge.eve.config.Format\x12\x35\n\x07siginfo\x18\x05 \x01(\x0b\x32$.org.lfedge.eve.config.SignatureInfo\x12\x0c\n\x04\x64sId\x18\x06 \x01(\t\x12\x11\n\tsizeBytes\x18\x08 \x01(\x03\"\xd0\x01\n\x05\x44rive\x12+\n\x05image\x18\x01 \x01(\x0b\x32\x1c.org.lfedge.eve.config.Image\x12\x10\n\x08readonly\x18\x05 \x01(\x08\x12\x10\n\x08preserve\x18\x06 \x01(\x08\x12\x31\n\x07\x64rvtype\x18\x08 \x01(\x0e\x32 .org.lfedge.eve.config.DriveType\x12-\n\x06target\x18\t \x01(\x0e\x32\x1d.org.lfedge.eve.config.Target\x12\x14\n\x0cmaxsizebytes\x18\n \x01(\x03\"\xee\x02\n\x0b\x43ontentTree\x12\x0c\n\x04uuid\x18\x01 \x01(\t\x12\x0c\n\x04\x64sId\x18\x02 \x01(\t\x12\x0b\n\x03URL\x18\x03 \x01(\t\x12.\n\x07iformat\x18\x04 \x01(\x0e\x32\x1d.org.lfedge.eve.config.Format\x12\x0e\n\x06sha256\x18\x05 \x01(\t\x12\x14\n\x0cmaxSizeBytes\x18\x06 \x01(\x04\x12\x35\n\x07siginfo\x18\x07 \x01(\x0b\x32$.org.lfedge.eve.config.SignatureInfo\x12\x13\n\x0b\x64isplayName\x18\x08 \x01(\t\x12\x18\n\x10generation_count\x18\t \x01(\x03\x12\x38\n\x08schedule\x18\n \x01(\x0b\x32&.org.lfedge.eve.config.ContentSchedule\x12@\n\x10signature_policy\x18\x0b \x01(\x0b\x32&.org.lfedge.eve.config.SignaturePolicy\"E\n\x0fVolumeBackupRef\x12\x14\n\x0c\x64\x61tastore_id\x18\x01 \x01(\t\x12\x0c\n\x04name\x18\x02 \x01(\t\x12\x0e\n\x06sha256\x18\x03 \x01(\t\"\xaa\x01\n\x13VolumeContentOrigin\x12<\n\x04type\x18\x01 \x01(\x0e\x32..org.lfedge.eve.config.VolumeContentOriginType\x12\x1d\n\x15\x64ownloadContentTreeID\x18\x02 \x01(\t\x12\x36\n\x06\x62\x61\x63kup\x18\x03 \x01(\x0b\x32&.org.lfedge.eve.config.VolumeBackupRef\"{\n\x12VolumeBackupPolicy\x12\x14\n\x0c\x64\x61tastore_id\x18\x01 \x01(\t\x12\x10\n\x08interval\x18\x02 \x01(\r\x12\x0f\n\x07\x63ounter\x18\x03 \x01(\r\x12\x13\n\x0bincremental\x18\x04 \x01(\x08\x12\x17\n\x0fmax_incremental\x18\x05 \x01(\r\"p\n\x0eVolumeIOLimits\x12\x1a\n\x12read_bytes_per_sec\x18\x01 \x01(\x04\x12\x1b\n\x13write_bytes_per_sec\x18\x02 \x01(\x04\x12\x11\n\tread_iops\x18\x03 \x01(\x04\x12\x12\n\nwrite_iops\x18\x04 \x01(\x04\":\n\x0fVolumeKeyPolicy\x12\x0f\n\x07own_key\x18\x01 \x01(\x08\x12\x16\n\x0erotate_counter\x18\x02 \x01(\r\"n\n\x10VolumeFilesystem\x12\x31\n\x04type\x18\x01 \x01(\x0e\x32#.org.lfedge.eve.config.VolumeFsType\x12\r\n\x05label\x18\x02 \x01(\t\x12\x0b\n\x03uid\x18\x03 \x01(\r\x12\x0b\n\x03gid\x18\x04 \x01(\r\"\xda\x04\n\x06Volume\x12\x0c\n\x04uuid\x18\x01 \x01(\t\x12:\n\x06origin\x18\x02 \x01(\x0b\x32*.org.lfedge.eve.config.VolumeContentOrigin\x12?\n\tprotocols\x18\x03 \x03(\x0e\x32,.org.lfedge.eve.config.VolumeAccessProtocols\x12\x17\n\x0fgenerationCount\x18\x04 \x01(\x03\x12\x14\n\x0cmaxsizebytes\x18\x05 \x01(\x03\x12\x10\n\x08readonly\x18\x06 \x01(\x08\x12\x13\n\x0b\x64isplayName\x18\x07 \x01(\t\x12\x12\n\nclear_text\x18\x08 \x01(\x08\x12\x39\n\x06\x62\x61\x63kup\x18\t \x01(\x0b\x32).org.lfedge.eve.config.VolumeBackupPolicy\x12\x35\n\x07sharing\x18\n \x01(\x0e\x32$.org.lfedge.eve.config.VolumeSharing\x12\x38\n\tio_limits\x18\x0b \x01(\x0b\x32%.org.lfedge.eve.config.VolumeIOLimits\x12:\n\nkey_policy\x18\x0c \x01(\x0b\x32&.org.lfedge.eve.config.VolumeKeyPolicy\x12;\n\nfilesystem\x18\r \x01(\x0b\x32\'.org.lfedge.eve.config.VolumeFilesystem\x12\x36\n\nbackup_key\x18\x0e \x01(\x0b\x32\".org.lfedge.eve.config.CipherBlock\"\xb8\x01\n\nDiskConfig\x12\x34\n\x04\x64isk\x18\x01 \x01(\x0b\x32&.org.lfedge.eve.common.DiskDescription\x12\x38\n\x08old_disk\x18\x02 \x01(\x0b\x32&.org.lfedge.eve.common.DiskDescription\x12:\n\x0b\x64isk_config\x18\x03 \x01(\x0e\x32%.org.lfedge.eve.config.DiskConfigType\"\xb0\x01\n\x0b\x44isksConfig\x12\x30\n\x05\x64isks\x18\x01 \x03(\x0b\x32!.org.lfedge.eve.config.DiskConfig\x12\x39\n\narray_type\x18\x02 \x01(\x0e\x32%.org.lfedge.eve.config.DisksArrayType\x12\x34\n\x08\x63hildren\x18\x03 \x03(\x0b\x32\".org.lfedge.eve.config.DisksConfig*\x85\x01\n\x06\x44sType\x12\r\n\tDsUnknown\x10\x00\x12\n\n\x06\x44sHttp\x10\x01\x12\x0b\n\x07\x44sHttps\x10\x02\x12\x08\n\x04\x44sS3\x10\x03\x12\n\n\x06\x44sSFTP\x10\x04\x12\x17\n\x13\x44sContainerRegistry\x10\x05\x12\x0f\n\x0b\x44sAzureBlob\x10\x06\x12\x13\n\x0f\x44sGoogleStorage\x10\x07*k\n\x06\x46ormat\x12\x0e\n\nFmtUnknown\x10\x00\x12\x07\n\x03RAW\x10\x01\x12\x08\n\x04QCOW\x10\x02\x12\t\n\x05QCOW2\x10\x03\x12\x07\n\x03VHD\x10\x04\x12\x08\n\x04VMDK\x10\x05\x12\x07\n\x03OVA\x10\x06\x12\x08\n\x04VHDX\x10\x07\x12\r\n\tCONTAINER\x10\x08*G\n\x06Target\x12\x0e\n\nTgtUnknown\x10\x00\x12\x08\n\x04\x44isk\x10\x01\x12\n\n\x06Kernel\x10\x02\x12\n\n\x06Initrd\x10\x03\x12\x0b\n\x07RamDisk\x10\x04*I\n\tDriveType\x12\x10\n\x0cUnclassified\x10\x00\x12\t\n\x05\x43\x44ROM\x10\x01\x12\x07\n\x03HDD\x10\x02\x12\x07\n\x03NET\x10\x03\x12\r\n\tHDD_EMPTY\x10\x04*1\n\x15VolumeAccessProtocols\x12\x0c\n\x08VAP_NONE\x10\x00\x12\n\n\x06VAP_9P\x10\x01*_\n\x17VolumeContentOriginType\x12\x10\n\x0cVCOT_UNKNOWN\x10\x00\x12\x0e\n\nVCOT_BLANK\x10\x01\x12\x11\n\rVCOT_DOWNLOAD\x10\x02\x12\x0f\n\x0bVCOT_BACKUP\x10\x03*S\n\rVolumeSharing\x12\x1e\n\x1aVOLUME_SHARING_UNSPECIFIED\x10\x00\x12\"\n\x1eVOLUME_SHARING_READ_WRITE_MANY\x10\x01*x\n\x0cVolumeFsType\x12\x1e\n\x1aVOLUME_FS_TYPE_UNSPECIFIED\x10\x00\x12\x17\n\x13VOLUME_FS_TYPE_EXT4\x10\x01\x12\x16\n\x12VOLUME_FS_TYPE_XFS\x10\x02\x12\x17\n\x13VOLUME_FS_TYPE_VFAT\x10\x03*\x8c\x02\n\x0e\x44iskConfigType\x12 \n\x1c\x44ISK_CONFIG_TYPE_UNSPECIFIED\x10\x00\x12\x1a\n\x16\x44ISK_CONFIG_TYPE_EVEOS\x10\x01\x12\x1c\n\x18\x44ISK_CONFIG_TYPE_PERSIST\x10\x02\x12\x1f\n\x1b\x44ISK_CONFIG_TYPE_ZFS_ONLINE\x10\x03\x12 \n\x1c\x44ISK_CONFIG_TYPE_ZFS_OFFLINE\x10\x04\x12\x1e\n\x1a\x44ISK_CONFIG_TYPE_APPDIRECT\x10\x05\x12\x1b\n\x17\x44ISK_CONFIG_TYPE_UNUSED\x10\x06\x12\x1e\n\x1a\x44ISK_CONFIG_TYPE_ZFS_SPARE\x10\x07*\xa2\x01\n\x0e\x44isksArrayType\x12 \n\x1c\x44ISKS_ARRAY_TYPE_UNSPECIFIED\x10\x00\x12\x1a\n\x16\x44ISKS_ARRAY_TYPE_RAID0\x10\x01\x12\x1a\n\x16\x44ISKS_ARRAY_TYPE_RAID1\x10\x02\x12\x1a\n\x16\x44ISKS_ARRAY_TYPE_RAID5\x10\x03\x12\x1a\n\x16\x44ISKS_ARRAY_TYPE_RAID6\x10\x04\x42=\n\x15org.lfedge.eve.configZ$github.com/lf-edge/eve/api/go/configb\x06proto3'
   ,
-  dependencies=[config_dot_devcommon__pb2.DESCRIPTOR,config_dot_acipherinfo__pb2.DESCRIPTOR,evecommon_dot_evecommon__pb2.DESCRIPTOR,])
+  dependencies=[config_dot_devcommon__pb2.DESCRIPTOR,config_dot_acipherinfo__pb2.DESCRIPTOR,evecommon_dot_evecommon__pb2.DESCRIPTOR,config_dot_schedule__pb2.DESCRIPTOR,config_dot_signaturepolicy__pb2.DESCRIPTOR,])
 
 _DSTYPE = _descriptor.EnumDescriptor(
   name='DsType',
@@ -77,8 +79,8 @@ _DSTYPE = _descriptor.EnumDescriptor(
   ],
   containing_type=None,
   serialized_options=None,
-  serialized_start=1872,
-  serialized_end=2005,
+  serialized_start=3003,
+  serialized_end=3136,
 )
 _sym_db.RegisterEnumDescriptor(_DSTYPE)
 
@@ -138,8 +140,8 @@ _FORMAT = _descriptor.EnumDescriptor(
   ],
   containing_type=None,
   serialized_options=None,
-  serialized_start=2007,
-  serialized_end=2114,
+  serialized_start=3138,
+  serialized_end=3245,
 )
 _sym_db.RegisterEnumDescriptor(_FORMAT)
 
@@ -179,8 +181,8 @@ _TARGET = _descriptor.EnumDescriptor(
   ],
   containing_type=None,
   serialized_options=None,
-  serialized_start=2116,
-  serialized_end=2187,
+  serialized_start=3247,
+  serialized_end=3318,
 )
 _sym_db.RegisterEnumDescriptor(_TARGET)
 
@@ -220,8 +222,8 @@ _DRIVETYPE = _descriptor.EnumDescriptor(
   ],
   containing_type=None,
   serialized_options=None,
-  serialized_start=2189,
-  serialized_end=2262,
+  serialized_start=3320,
+  serialized_end=3393,
 )
 _sym_db.RegisterEnumDescriptor(_DRIVETYPE)
 
@@ -246,8 +248,8 @@ _VOLUMEACCESSPROTOCOLS = _descriptor.EnumDescriptor(
   ],
   containing_type=None,
   serialized_options=None,
-  serialized_start=2264,
-  serialized_end=2313,
+  serialized_start=3395,
+  serialized_end=3444,
 )
 _sym_db.RegisterEnumDescriptor(_VOLUMEACCESSPROTOCOLS)
 
@@ -274,15 +276,82 @@ _VOLUMECONTENTORIGINTYPE = _descriptor.EnumDescriptor(
       serialized_options=None,
       type=None,
       create_key=_descriptor._internal_create_key),
+    _descriptor.EnumValueDescriptor(
+      name='VCOT_BACKUP', index=3, number=3,
+      serialized_options=None,
+      type=None,
+      create_key=_descriptor._internal_create_key),
   ],
   containing_type=None,
   serialized_options=None,
-  serialized_start=2315,
-  serialized_end=2393,
+  serialized_start=3446,
+  serialized_end=3541,
 )
 _sym_db.RegisterEnumDescriptor(_VOLUMECONTENTORIGINTYPE)
 
 VolumeContentOriginType = enum_type_wrapper.EnumTypeWrapper(_VOLUMECONTENTORIGINTYPE)
+_VOLUMESHARING = _descriptor.EnumDescriptor(
+  name='VolumeSharing',
+  full_name='org.lfedge.eve.config.VolumeSharing',
+  filename=None,
+  file=DESCRIPTOR,
+  create_key=_descriptor._internal_create_key,
+  values=[
+    _descriptor.EnumValueDescriptor(
+      name='VOLUME_SHARING_UNSPECIFIED', index=0, number=0,
+      serialized_options=None,
+      type=None,
+      create_key=_descriptor._internal_create_key),
+    _descriptor.EnumValueDescriptor(
+      name='VOLUME_SHARING_READ_WRITE_MANY', index=1, number=1,
+      serialized_options=None,
+      type=None,
+      create_key=_descriptor._internal_create_key),
+  ],
+  containing_type=None,
+  serialized_options=None,
+  serialized_start=3543,
+  serialized_end=3626,
+)
+_sym_db.RegisterEnumDescriptor(_VOLUMESHARING)
+
+VolumeSharing = enum_type_wrapper.EnumTypeWrapper(_VOLUMESHARING)
+_VOLUMEFSTYPE = _descriptor.EnumDescriptor(
+  name='VolumeFsType',
+  full_name='org.lfedge.eve.config.VolumeFsType',
+  filename=None,
+  file=DESCRIPTOR,
+  create_key=_descriptor._internal_create_key,
+  values=[
+    _descriptor.EnumValueDescriptor(
+      name='VOLUME_FS_TYPE_UNSPECIFIED', index=0, number=0,
+      serialized_options=None,
+      type=None,
+      create_key=_descriptor._internal_create_key),
+    _descriptor.EnumValueDescriptor(
+      name='VOLUME_FS_TYPE_EXT4', index=1, number=1,
+      serialized_options=None,
+      type=None,
+      create_key=_descriptor._internal_create_key),
+    _descriptor.EnumValueDescriptor(
+      name='VOLUME_FS_TYPE_XFS', index=2, number=2,
+      serialized_options=None,
+      type=None,
+      create_key=_descriptor._internal_create_key),
+    _descriptor.EnumValueDescriptor(
+      name='VOLUME_FS_TYPE_VFAT', index=3, number=3,
+      serialized_options=None,
+      type=None,
+      create_key=_descriptor._internal_create_key),
+  ],
+  containing_type=None,
+  serialized_options=None,
+  serialized_start=3628,
+  serialized_end=3748,
+)
+_sym_db.RegisterEnumDescriptor(_VOLUMEFSTYPE)
+
+VolumeFsType = enum_type_wrapper.EnumTypeWrapper(_VOLUMEFSTYPE)
 _DISKCONFIGTYPE = _descriptor.EnumDescriptor(
   name='DiskConfigType',
   full_name='org.lfedge.eve.config.DiskConfigType',
@@ -325,11 +394,16 @@ _DISKCONFIGTYPE = _descriptor.EnumDescriptor(
       serialized_options=None,
       type=None,
       create_key=_descriptor._internal_create_key),
+    _descriptor.EnumValueDescriptor(
+      name='DISK_CONFIG_TYPE_ZFS_SPARE', index=7, number=7,
+      serialized_options=None,
+      type=None,
+      create_key=_descriptor._internal_create_key),
   ],
   containing_type=None,
   serialized_options=None,
-  serialized_start=2396,
-  serialized_end=2632,
+  serialized_start=3751,
+  serialized_end=4019,
 )
 _sym_db.RegisterEnumDescriptor(_DISKCONFIGTYPE)
 
@@ -369,8 +443,8 @@ _DISKSARRAYTYPE = _descriptor.EnumDescriptor(
   ],
   containing_type=None,
   serialized_options=None,
-  serialized_start=2635,
-  serialized_end=2797,
+  serialized_start=4022,
+  serialized_end=4184,
 )
 _sym_db.RegisterEnumDescriptor(_DISKSARRAYTYPE)
 
@@ -407,6 +481,13 @@ VAP_9P = 1
 VCOT_UNKNOWN = 0
 VCOT_BLANK = 1
 VCOT_DOWNLOAD = 2
+VCOT_BACKUP = 3
+VOLUME_SHARING_UNSPECIFIED = 0
+VOLUME_SHARING_READ_WRITE_MANY = 1
+VOLUME_FS_TYPE_UNSPECIFIED = 0
+VOLUME_FS_TYPE_EXT4 = 1
+VOLUME_FS_TYPE_XFS = 2
+VOLUME_FS_TYPE_VFAT = 3
 DISK_CONFIG_TYPE_UNSPECIFIED = 0
 DISK_CONFIG_TYPE_EVEOS = 1
 DISK_CONFIG_TYPE_PERSIST = 2
@@ -414,6 +495,7 @@ DISK_CONFIG_TYPE_ZFS_ONLINE = 3
 DISK_CONFIG_TYPE_ZFS_OFFLINE = 4
 DISK_CONFIG_TYPE_APPDIRECT = 5
 DISK_CONFIG_TYPE_UNUSED = 6
+DISK_CONFIG_TYPE_ZFS_SPARE = 7
 DISKS_ARRAY_TYPE_UNSPECIFIED = 0
 DISKS_ARRAY_TYPE_RAID0 = 1
 DISKS_ARRAY_TYPE_RAID1 = 2
@@ -463,8 +545,8 @@ _SIGNATUREINFO = _descriptor.Descriptor(
   extension_ranges=[],
   oneofs=[
   ],
-  serialized_start=124,
-  serialized_end=204,
+  serialized_start=177,
+  serialized_end=257,
 )
 
 
@@ -539,6 +621,13 @@ _DATASTORECONFIG = _descriptor.Descriptor(
       message_type=None, enum_type=None, containing_type=None,
       is_extension=False, extension_scope=None,
       serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
+    _descriptor.FieldDescriptor(
+      name='signature_policy', full_name='org.lfedge.eve.config.DatastoreConfig.signature_policy', index=9,
+      number=9, type=11, cpp_type=10, label=1,
+      has_default_value=False, default_value=None,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
   ],
   extensions=[
   ],
@@ -551,8 +640,8 @@ _DATASTORECONFIG = _descriptor.Descriptor(
   extension_ranges=[],
   oneofs=[
   ],
-  serialized_start=207,
-  serialized_end=436,
+  serialized_start=260,
+  serialized_end=555,
 )
 
 
@@ -625,8 +714,8 @@ _IMAGE = _descriptor.Descriptor(
   extension_ranges=[],
   oneofs=[
   ],
-  serialized_start=439,
-  serialized_end=675,
+  serialized_start=558,
+  serialized_end=794,
 )
 
 
@@ -692,8 +781,8 @@ _DRIVE = _descriptor.Descriptor(
   extension_ranges=[],
   oneofs=[
   ],
-  serialized_start=678,
-  serialized_end=886,
+  serialized_start=797,
+  serialized_end=1005,
 )
 
 
@@ -768,6 +857,66 @@ _CONTENTTREE = _descriptor.Descriptor(
       message_type=None, enum_type=None, containing_type=None,
       is_extension=False, extension_scope=None,
       serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
+    _descriptor.FieldDescriptor(
+      name='schedule', full_name='org.lfedge.eve.config.ContentTree.schedule', index=9,
+      number=10, type=11, cpp_type=10, label=1,
+      has_default_value=False, default_value=None,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
+    _descriptor.FieldDescriptor(
+      name='signature_policy', full_name='org.lfedge.eve.config.ContentTree.signature_policy', index=10,
+      number=11, type=11, cpp_type=10, label=1,
+      has_default_value=False, default_value=None,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
+  ],
+  extensions=[
+  ],
+  nested_types=[],
+  enum_types=[
+  ],
+  serialized_options=None,
+  is_extendable=False,
+  syntax='proto3',
+  extension_ranges=[],
+  oneofs=[
+  ],
+  serialized_start=1008,
+  serialized_end=1374,
+)
+
+
+_VOLUMEBACKUPREF = _descriptor.Descriptor(
+  name='VolumeBackupRef',
+  full_name='org.lfedge.eve.config.VolumeBackupRef',
+  filename=None,
+  file=DESCRIPTOR,
+  containing_type=None,
+  create_key=_descriptor._internal_create_key,
+  fields=[
+    _descriptor.FieldDescriptor(
+      name='datastore_id', full_name='org.lfedge.eve.config.VolumeBackupRef.datastore_id', index=0,
+      number=1, type=9, cpp_type=9, label=1,
+      has_default_value=False, default_value=b"".decode('utf-8'),
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
+    _descriptor.FieldDescriptor(
+      name='name', full_name='org.lfedge.eve.config.VolumeBackupRef.name', index=1,
+      number=2, type=9, cpp_type=9, label=1,
+      has_default_value=False, default_value=b"".decode('utf-8'),
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
+    _descriptor.FieldDescriptor(
+      name='sha256', full_name='org.lfedge.eve.config.VolumeBackupRef.sha256', index=2,
+      number=3, type=9, cpp_type=9, label=1,
+      has_default_value=False, default_value=b"".decode('utf-8'),
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
   ],
   extensions=[
   ],
@@ -780,8 +929,8 @@ _CONTENTTREE = _descriptor.Descriptor(
   extension_ranges=[],
   oneofs=[
   ],
-  serialized_start=889,
-  serialized_end=1131,
+  serialized_start=1376,
+  serialized_end=1445,
 )
 
 
@@ -807,6 +956,73 @@ _VOLUMECONTENTORIGIN = _descriptor.Descriptor(
       message_type=None, enum_type=None, containing_type=None,
       is_extension=False, extension_scope=None,
       serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
+    _descriptor.FieldDescriptor(
+      name='backup', full_name='org.lfedge.eve.config.VolumeContentOrigin.backup', index=2,
+      number=3, type=11, cpp_type=10, label=1,
+      has_default_value=False, default_value=None,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
+  ],
+  extensions=[
+  ],
+  nested_types=[],
+  enum_types=[
+  ],
+  serialized_options=None,
+  is_extendable=False,
+  syntax='proto3',
+  extension_ranges=[],
+  oneofs=[
+  ],
+  serialized_start=1448,
+  serialized_end=1618,
+)
+
+
+_VOLUMEBACKUPPOLICY = _descriptor.Descriptor(
+  name='VolumeBackupPolicy',
+  full_name='org.lfedge.eve.config.VolumeBackupPolicy',
+  filename=None,
+  file=DESCRIPTOR,
+  containing_type=None,
+  create_key=_descriptor._internal_create_key,
+  fields=[
+    _descriptor.FieldDescriptor(
+      name='datastore_id', full_name='org.lfedge.eve.config.VolumeBackupPolicy.datastore_id', index=0,
+      number=1, type=9, cpp_type=9, label=1,
+      has_default_value=False, default_value=b"".decode('utf-8'),
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
+    _descriptor.FieldDescriptor(
+      name='interval', full_name='org.lfedge.eve.config.VolumeBackupPolicy.interval', index=1,
+      number=2, type=13, cpp_type=3, label=1,
+      has_default_value=False, default_value=0,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
+    _descriptor.FieldDescriptor(
+      name='counter', full_name='org.lfedge.eve.config.VolumeBackupPolicy.counter', index=2,
+      number=3, type=13, cpp_type=3, label=1,
+      has_default_value=False, default_value=0,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
+    _descriptor.FieldDescriptor(
+      name='incremental', full_name='org.lfedge.eve.config.VolumeBackupPolicy.incremental', index=3,
+      number=4, type=8, cpp_type=7, label=1,
+      has_default_value=False, default_value=False,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
+    _descriptor.FieldDescriptor(
+      name='max_incremental', full_name='org.lfedge.eve.config.VolumeBackupPolicy.max_incremental', index=4,
+      number=5, type=13, cpp_type=3, label=1,
+      has_default_value=False, default_value=0,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
   ],
   extensions=[
   ],
@@ -819,8 +1035,153 @@ _VOLUMECONTENTORIGIN = _descriptor.Descriptor(
   extension_ranges=[],
   oneofs=[
   ],
-  serialized_start=1133,
-  serialized_end=1247,
+  serialized_start=1620,
+  serialized_end=1743,
+)
+
+
+_VOLUMEIOLIMITS = _descriptor.Descriptor(
+  name='VolumeIOLimits',
+  full_name='org.lfedge.eve.config.VolumeIOLimits',
+  filename=None,
+  file=DESCRIPTOR,
+  containing_type=None,
+  create_key=_descriptor._internal_create_key,
+  fields=[
+    _descriptor.FieldDescriptor(
+      name='read_bytes_per_sec', full_name='org.lfedge.eve.config.VolumeIOLimits.read_bytes_per_sec', index=0,
+      number=1, type=4, cpp_type=4, label=1,
+      has_default_value=False, default_value=0,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
+    _descriptor.FieldDescriptor(
+      name='write_bytes_per_sec', full_name='org.lfedge.eve.config.VolumeIOLimits.write_bytes_per_sec', index=1,
+      number=2, type=4, cpp_type=4, label=1,
+      has_default_value=False, default_value=0,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
+    _descriptor.FieldDescriptor(
+      name='read_iops', full_name='org.lfedge.eve.config.VolumeIOLimits.read_iops', index=2,
+      number=3, type=4, cpp_type=4, label=1,
+      has_default_value=False, default_value=0,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
+    _descriptor.FieldDescriptor(
+      name='write_iops', full_name='org.lfedge.eve.config.VolumeIOLimits.write_iops', index=3,
+      number=4, type=4, cpp_type=4, label=1,
+      has_default_value=False, default_value=0,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
+  ],
+  extensions=[
+  ],
+  nested_types=[],
+  enum_types=[
+  ],
+  serialized_options=None,
+  is_extendable=False,
+  syntax='proto3',
+  extension_ranges=[],
+  oneofs=[
+  ],
+  serialized_start=1745,
+  serialized_end=1857,
+)
+
+
+_VOLUMEKEYPOLICY = _descriptor.Descriptor(
+  name='VolumeKeyPolicy',
+  full_name='org.lfedge.eve.config.VolumeKeyPolicy',
+  filename=None,
+  file=DESCRIPTOR,
+  containing_type=None,
+  create_key=_descriptor._internal_create_key,
+  fields=[
+    _descriptor.FieldDescriptor(
+      name='own_key', full_name='org.lfedge.eve.config.VolumeKeyPolicy.own_key', index=0,
+      number=1, type=8, cpp_type=7, label=1,
+      has_default_value=False, default_value=False,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
+    _descriptor.FieldDescriptor(
+      name='rotate_counter', full_name='org.lfedge.eve.config.VolumeKeyPolicy.rotate_counter', index=1,
+      number=2, type=13, cpp_type=3, label=1,
+      has_default_value=False, default_value=0,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
+  ],
+  extensions=[
+  ],
+  nested_types=[],
+  enum_types=[
+  ],
+  serialized_options=None,
+  is_extendable=False,
+  syntax='proto3',
+  extension_ranges=[],
+  oneofs=[
+  ],
+  serialized_start=1859,
+  serialized_end=1917,
+)
+
+
+_VOLUMEFILESYSTEM = _descriptor.Descriptor(
+  name='VolumeFilesystem',
+  full_name='org.lfedge.eve.config.VolumeFilesystem',
+  filename=None,
+  file=DESCRIPTOR,
+  containing_type=None,
+  create_key=_descriptor._internal_create_key,
+  fields=[
+    _descriptor.FieldDescriptor(
+      name='type', full_name='org.lfedge.eve.config.VolumeFilesystem.type', index=0,
+      number=1, type=14, cpp_type=8, label=1,
+      has_default_value=False, default_value=0,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
+    _descriptor.FieldDescriptor(
+      name='label', full_name='org.lfedge.eve.config.VolumeFilesystem.label', index=1,
+      number=2, type=9, cpp_type=9, label=1,
+      has_default_value=False, default_value=b"".decode('utf-8'),
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
+    _descriptor.FieldDescriptor(
+      name='uid', full_name='org.lfedge.eve.config.VolumeFilesystem.uid', index=2,
+      number=3, type=13, cpp_type=3, label=1,
+      has_default_value=False, default_value=0,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
+    _descriptor.FieldDescriptor(
+      name='gid', full_name='org.lfedge.eve.config.VolumeFilesystem.gid', index=3,
+      number=4, type=13, cpp_type=3, label=1,
+      has_default_value=False, default_value=0,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
+  ],
+  extensions=[
+  ],
+  nested_types=[],
+  enum_types=[
+  ],
+  serialized_options=None,
+  is_extendable=False,
+  syntax='proto3',
+  extension_ranges=[],
+  oneofs=[
+  ],
+  serialized_start=1919,
+  serialized_end=2029,
 )
 
 
@@ -888,6 +1249,48 @@ _VOLUME = _descriptor.Descriptor(
       message_type=None, enum_type=None, containing_type=None,
       is_extension=False, extension_scope=None,
       serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
+    _descriptor.FieldDescriptor(
+      name='backup', full_name='org.lfedge.eve.config.Volume.backup', index=8,
+      number=9, type=11, cpp_type=10, label=1,
+      has_default_value=False, default_value=None,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
+    _descriptor.FieldDescriptor(
+      name='sharing', full_name='org.lfedge.eve.config.Volume.sharing', index=9,
+      number=10, type=14, cpp_type=8, label=1,
+      has_default_value=False, default_value=0,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
+    _descriptor.FieldDescriptor(
+      name='io_limits', full_name='org.lfedge.eve.config.Volume.io_limits', index=10,
+      number=11, type=11, cpp_type=10, label=1,
+      has_default_value=False, default_value=None,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
+    _descriptor.FieldDescriptor(
+      name='key_policy', full_name='org.lfedge.eve.config.Volume.key_policy', index=11,
+      number=12, type=11, cpp_type=10, label=1,
+      has_default_value=False, default_value=None,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
+    _descriptor.FieldDescriptor(
+      name='filesystem', full_name='org.lfedge.eve.config.Volume.filesystem', index=12,
+      number=13, type=11, cpp_type=10, label=1,
+      has_default_value=False, default_value=None,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
+    _descriptor.FieldDescriptor(
+      name='backup_key', full_name='org.lfedge.eve.config.Volume.backup_key', index=13,
+      number=14, type=11, cpp_type=10, label=1,
+      has_default_value=False, default_value=None,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
   ],
   extensions=[
   ],
@@ -900,8 +1303,8 @@ _VOLUME = _descriptor.Descriptor(
   extension_ranges=[],
   oneofs=[
   ],
-  serialized_start=1250,
-  serialized_end=1503,
+  serialized_start=2032,
+  serialized_end=2634,
 )
 
 
@@ -946,8 +1349,8 @@ _DISKCONFIG = _descriptor.Descriptor(
   extension_ranges=[],
   oneofs=[
   ],
-  serialized_start=1506,
-  serialized_end=1690,
+  serialized_start=2637,
+  serialized_end=2821,
 )
 
 
@@ -992,12 +1395,13 @@ _DISKSCONFIG = _descriptor.Descriptor(
   extension_ranges=[],
   oneofs=[
   ],
-  serialized_start=1693,
-  serialized_end=1869,
+  serialized_start=2824,
+  serialized_end=3000,
 )
 
 _DATASTORECONFIG.fields_by_name['dType'].enum_type = _DSTYPE
 _DATASTORECONFIG.fields_by_name['cipherData'].message_type = config_dot_acipherinfo__pb2._CIPHERBLOCK
+_DATASTORECONFIG.fields_by_name['signature_policy'].message_type = config_dot_signaturepolicy__pb2._SIGNATUREPOLICY
 _IMAGE.fields_by_name['uuidandversion'].message_type = config_dot_devcommon__pb2._UUIDANDVERSION
 _IMAGE.fields_by_name['iformat'].enum_type = _FORMAT
 _IMAGE.fields_by_name['siginfo'].message_type = _SIGNATUREINFO
@@ -1006,9 +1410,19 @@ _DRIVE.fields_by_name['drvtype'].enum_type = _DRIVETYPE
 _DRIVE.fields_by_name['target'].enum_type = _TARGET
 _CONTENTTREE.fields_by_name['iformat'].enum_type = _FORMAT
 _CONTENTTREE.fields_by_name['siginfo'].message_type = _SIGNATUREINFO
+_CONTENTTREE.fields_by_name['schedule'].message_type = config_dot_schedule__pb2._CONTENTSCHEDULE
+_CONTENTTREE.fields_by_name['signature_policy'].message_type = config_dot_signaturepolicy__pb2._SIGNATUREPOLICY
 _VOLUMECONTENTORIGIN.fields_by_name['type'].enum_type = _VOLUMECONTENTORIGINTYPE
+_VOLUMECONTENTORIGIN.fields_by_name['backup'].message_type = _VOLUMEBACKUPREF
+_VOLUMEFILESYSTEM.fields_by_name['type'].enum_type = _VOLUMEFSTYPE
 _VOLUME.fields_by_name['origin'].message_type = _VOLUMECONTENTORIGIN
 _VOLUME.fields_by_name['protocols'].enum_type = _VOLUMEACCESSPROTOCOLS
+_VOLUME.fields_by_name['backup'].message_type = _VOLUMEBACKUPPOLICY
+_VOLUME.fields_by_name['sharing'].enum_type = _VOLUMESHARING
+_VOLUME.fields_by_name['io_limits'].message_type = _VOLUMEIOLIMITS
+_VOLUME.fields_by_name['key_policy'].message_type = _VOLUMEKEYPOLICY
+_VOLUME.fields_by_name['filesystem'].message_type = _VOLUMEFILESYSTEM
+_VOLUME.fields_by_name['backup_key'].message_type = config_dot_acipherinfo__pb2._CIPHERBLOCK
 _DISKCONFIG.fields_by_name['disk'].message_type = evecommon_dot_evecommon__pb2._DISKDESCRIPTION
 _DISKCONFIG.fields_by_name['old_disk'].message_type = evecommon_dot_evecommon__pb2._DISKDESCRIPTION
 _DISKCONFIG.fields_by_name['disk_config'].enum_type = _DISKCONFIGTYPE
@@ -1020,7 +1434,12 @@ DESCRIPTOR.message_types_by_name['DatastoreConfig'] = _DATASTORECONFIG
 DESCRIPTOR.message_types_by_name['Image'] = _IMAGE
 DESCRIPTOR.message_types_by_name['Drive'] = _DRIVE
 DESCRIPTOR.message_types_by_name['ContentTree'] = _CONTENTTREE
+DESCRIPTOR.message_types_by_name['VolumeBackupRef'] = _VOLUMEBACKUPREF
 DESCRIPTOR.message_types_by_name['VolumeContentOrigin'] = _VOLUMECONTENTORIGIN
+DESCRIPTOR.message_types_by_name['VolumeBackupPolicy'] = _VOLUMEBACKUPPOLICY
+DESCRIPTOR.message_types_by_name['VolumeIOLimits'] = _VOLUMEIOLIMITS
+DESCRIPTOR.message_types_by_name['VolumeKeyPolicy'] = _VOLUMEKEYPOLICY
+DESCRIPTOR.message_types_by_name['VolumeFilesystem'] = _VOLUMEFILESYSTEM
 DESCRIPTOR.message_types_by_name['Volume'] = _VOLUME
 DESCRIPTOR.message_types_by_name['DiskConfig'] = _DISKCONFIG
 DESCRIPTOR.message_types_by_name['DisksConfig'] = _DISKSCONFIG
@@ -1030,6 +1449,8 @@ DESCRIPTOR.enum_types_by_name['Target'] = _TARGET
 DESCRIPTOR.enum_types_by_name['DriveType'] = _DRIVETYPE
 DESCRIPTOR.enum_types_by_name['VolumeAccessProtocols'] = _VOLUMEACCESSPROTOCOLS
 DESCRIPTOR.enum_types_by_name['VolumeContentOriginType'] = _VOLUMECONTENTORIGINTYPE
+DESCRIPTOR.enum_types_by_name['VolumeSharing'] = _VOLUMESHARING
+DESCRIPTOR.enum_types_by_name['VolumeFsType'] = _VOLUMEFSTYPE
 DESCRIPTOR.enum_types_by_name['DiskConfigType'] = _DISKCONFIGTYPE
 DESCRIPTOR.enum_types_by_name['DisksArrayType'] = _DISKSARRAYTYPE
 _sym_db.RegisterFileDescriptor(DESCRIPTOR)
@@ -1069,6 +1490,13 @@ ContentTree = _reflection.GeneratedProtocolMessageType('ContentTree', (_message.
   })
 _sym_db.RegisterMessage(ContentTree)
 
+VolumeBackupRef = _reflection.GeneratedProtocolMessageType('VolumeBackupRef', (_message.Message,), {
+  'DESCRIPTOR' : _VOLUMEBACKUPREF,
+  '__module__' : 'config.storage_pb2'
+  # @@protoc_insertion_point(class_scope:org.lfedge.eve.config.VolumeBackupRef)
+  })
+_sym_db.RegisterMessage(VolumeBackupRef)
+
 VolumeContentOrigin = _reflection.GeneratedProtocolMessageType('VolumeContentOrigin', (_message.Message,), {
   'DESCRIPTOR' : _VOLUMECONTENTORIGIN,
   '__module__' : 'config.storage_pb2'
@@ -1076,6 +1504,34 @@ VolumeContentOrigin = _reflection.GeneratedProtocolMessageType('VolumeContentOri
   })
 _sym_db.RegisterMessage(VolumeContentOrigin)
 
+VolumeBackupPolicy = _reflection.GeneratedProtocolMessageType('VolumeBackupPolicy', (_message.Message,), {
+  'DESCRIPTOR' : _VOLUMEBACKUPPOLICY,
+  '__module__' : 'config.storage_pb2'
+  # @@protoc_insertion_point(class_scope:org.lfedge.eve.config.VolumeBackupPolicy)
+  })
+_sym_db.RegisterMessage(VolumeBackupPolicy)
+
+VolumeIOLimits = _reflection.GeneratedProtocolMessageType('VolumeIOLimits', (_message.Message,), {
+  'DESCRIPTOR' : _VOLUMEIOLIMITS,
+  '__module__' : 'config.storage_pb2'
+  # @@protoc_insertion_point(class_scope:org.lfedge.eve.config.VolumeIOLimits)
+  })
+_sym_db.RegisterMessage(VolumeIOLimits)
+
+VolumeKeyPolicy = _reflection.GeneratedProtocolMessageType('VolumeKeyPolicy', (_message.Message,), {
+  'DESCRIPTOR' : _VOLUMEKEYPOLICY,
+  '__module__' : 'config.storage_pb2'
+  # @@protoc_insertion_point(class_scope:org.lfedge.eve.config.VolumeKeyPolicy)
+  })
+_sym_db.RegisterMessage(VolumeKeyPolicy)
+
+VolumeFilesystem = _reflection.GeneratedProtocolMessageType('VolumeFilesystem', (_message.Message,), {
+  'DESCRIPTOR' : _VOLUMEFILESYSTEM,
+  '__module__' : 'config.storage_pb2'
+  # @@protoc_insertion_point(class_scope:org.lfedge.eve.config.VolumeFilesystem)
+  })
+_sym_db.RegisterMessage(VolumeFilesystem)
+
 Volume = _reflection.GeneratedProtocolMessageType('Volume', (_message.Message,), {
   'DESCRIPTOR' : _VOLUME,
   '__module__' : 'config.storage_pb2'
@@ -23,7 +23,7 @@ DESCRIPTOR = _descriptor.FileDescriptor(
   syntax='proto3',
   serialized_options=b'\n\023org.lfedge.eve.infoZ\"github.com/lf-edge/eve/api/go/info',
   create_key=_descriptor._internal_create_key,
-  serialized_pb=b'\n\x0finfo/info.proto\x12\x13org.lfedge.eve.info\x1a\x1fgoogle/protobuf/timestamp.proto\x1a\x1e\x65vecommon/devmodelcommon.proto\x1a\x19\x65vecommon/evecommon.proto\"\xdc\x01\n\x14\x64\x65precatedMetricItem\x12\x0b\n\x03key\x18\x01 \x01(\t\x12\x34\n\x04type\x18\x02 \x01(\x0e\x32&.org.lfedge.eve.info.DepMetricItemType\x12\x13\n\tboolValue\x18\x03 \x01(\x08H\x00\x12\x15\n\x0buint32Value\x18\x04 \x01(\rH\x00\x12\x15\n\x0buint64Value\x18\x05 \x01(\x04H\x00\x12\x14\n\nfloatValue\x18\x06 \x01(\x02H\x00\x12\x15\n\x0bstringValue\x18\x07 \x01(\tH\x00\x42\x11\n\x0fmetricItemValue\">\n\x15ZmetIPAssignmentEntry\x12\x12\n\nmacAddress\x18\x01 \x01(\t\x12\x11\n\tipAddress\x18\x02 \x03(\t\"A\n\x0bZmetVifInfo\x12\x0f\n\x07vifName\x18\x01 \x01(\t\x12\x12\n\nmacAddress\x18\x02 \x01(\t\x12\r\n\x05\x61ppID\x18\x03 \x01(\t\"\xa5\x02\n\tZioBundle\x12.\n\x04type\x18\x01 \x01(\x0e\x32 .org.lfedge.eve.common.PhyIoType\x12\x0c\n\x04name\x18\x02 \x01(\t\x12\x0f\n\x07members\x18\x03 \x03(\t\x12\x15\n\rusedByAppUUID\x18\x04 \x01(\t\x12\x14\n\x0cusedByBaseOS\x18\x05 \x01(\x08\x12\x37\n\rioAddressList\x18\x06 \x03(\x0b\x32 .org.lfedge.eve.info.IoAddresses\x12\x36\n\x05usage\x18\x07 \x01(\x0e\x32\'.org.lfedge.eve.common.PhyIoMemberUsage\x12+\n\x03\x65rr\x18\x08 \x01(\x0b\x32\x1e.org.lfedge.eve.info.ErrorInfo\"!\n\x0bIoAddresses\x12\x12\n\nmacAddress\x18\x01 \x01(\t\"\xc9\x01\n\x11ZInfoManufacturer\x12\x14\n\x0cmanufacturer\x18\x01 \x01(\t\x12\x13\n\x0bproductName\x18\x02 \x01(\t\x12\x0f\n\x07version\x18\x03 \x01(\t\x12\x14\n\x0cserialNumber\x18\x04 \x01(\t\x12\x0c\n\x04UUID\x18\x05 \x01(\t\x12\x12\n\ncompatible\x18\x06 \x01(\t\x12\x12\n\nbiosVendor\x18\x07 \x01(\t\x12\x13\n\x0b\x62iosVersion\x18\x08 \x01(\t\x12\x17\n\x0f\x62iosReleaseDate\x18\t \x01(\t\"\x8c\x03\n\x0cZInfoNetwork\x12\x0f\n\x07macAddr\x18\x03 \x01(\t\x12\x0f\n\x07\x64\x65vName\x18\x04 \x01(\t\x12\r\n\x05\x61lias\x18( \x01(\t\x12\x0f\n\x07IPAddrs\x18\x05 \x03(\t\x12\x16\n\x0e\x64\x65\x66\x61ultRouters\x18\x06 \x03(\t\x12*\n\x03\x64ns\x18\x07 \x01(\x0b\x32\x1d.org.lfedge.eve.info.ZInfoDNS\x12\x0f\n\x07ipv4_up\x18\x08 \x01(\x08\x12-\n\x08location\x18\t \x01(\x0b\x32\x1b.org.lfedge.eve.info.GeoLoc\x12\x0e\n\x06uplink\x18\n \x01(\x08\x12\x32\n\nnetworkErr\x18\x0b \x01(\x0b\x32\x1e.org.lfedge.eve.info.ErrorInfo\x12\x11\n\tlocalName\x18\x0c \x01(\t\x12/\n\x05proxy\x18\r \x01(\x0b\x32 .org.lfedge.eve.info.ProxyStatus\x12\x19\n\x11ip_addr_mis_match\x18\x0e \x01(\x08\x12\x13\n\x0bntp_servers\x18\x0f \x03(\t\"\x87\x01\n\x06GeoLoc\x12\x12\n\nUnderlayIP\x18\x01 \x01(\t\x12\x10\n\x08Hostname\x18\x02 \x01(\t\x12\x0c\n\x04\x43ity\x18\x03 \x01(\t\x12\x0e\n\x06Region\x18\x04 \x01(\t\x12\x0f\n\x07\x43ountry\x18\x05 \x01(\t\x12\x0b\n\x03Loc\x18\x06 \x01(\t\x12\x0b\n\x03Org\x18\x07 \x01(\t\x12\x0e\n\x06Postal\x18\x08 \x01(\t\"D\n\x08ZInfoDNS\x12\x12\n\nDNSservers\x18\x01 \x03(\t\x12\x11\n\tDNSdomain\x18\x02 \x01(\t\x12\x11\n\tDNSsearch\x18\x03 \x03(\t\"\xa5\x01\n\x07ZInfoSW\x12\x11\n\tswVersion\x18\x02 \x01(\t\x12\x0e\n\x06swHash\x18\x03 \x01(\t\x12,\n\x05state\x18\x04 \x01(\x0e\x32\x1d.org.lfedge.eve.info.ZSwState\x12\x0e\n\x06target\x18\x06 \x01(\t\x12\x0c\n\x04vdev\x18\x07 \x01(\t\x12\x18\n\x10\x64ownloadProgress\x18\x08 \x01(\r\x12\x11\n\timageName\x18\t \x01(\t\"\xce\x01\n\tErrorInfo\x12\x13\n\x0b\x64\x65scription\x18\x01 \x01(\t\x12-\n\ttimestamp\x18\x02 \x01(\x0b\x32\x1a.google.protobuf.Timestamp\x12/\n\x08severity\x18\x03 \x01(\x0e\x32\x1d.org.lfedge.eve.info.Severity\x12\x33\n\x08\x65ntities\x18\x04 \x03(\x0b\x32!.org.lfedge.eve.info.DeviceEntity\x12\x17\n\x0fretry_condition\x18\x05 \x01(\t\"N\n\x0c\x44\x65viceEntity\x12+\n\x06\x65ntity\x18\x01 \x01(\x0e\x32\x1b.org.lfedge.eve.info.Entity\x12\x11\n\tentity_id\x18\x02 \x01(\t\"\xb8\x01\n\tVaultInfo\x12\x0c\n\x04name\x18\x01 \x01(\t\x12\x38\n\x06status\x18\x02 \x01(\x0e\x32(.org.lfedge.eve.info.DataSecAtRestStatus\x12\x30\n\x08vaultErr\x18\x03 \x01(\x0b\x32\x1e.org.lfedge.eve.info.ErrorInfo\x12\x31\n\tpcrStatus\x18\x04 \x01(\x0e\x32\x1e.org.lfedge.eve.info.PCRStatus\"\x8a\x01\n\rDataSecAtRest\x12\x38\n\x06status\x18\x01 \x01(\x0e\x32(.org.lfedge.eve.info.DataSecAtRestStatus\x12\x0c\n\x04info\x18\x02 \x01(\t\x12\x31\n\tvaultList\x18\x03 \x03(\x0b\x32\x1e.org.lfedge.eve.info.VaultInfo\"<\n\x0cSecurityInfo\x12\x13\n\x0bsha_root_ca\x18\x01 \x01(\x0c\x12\x17\n\x0fsha_tls_root_ca\x18\x02 \x01(\x0c\"/\n\x0fZInfoConfigItem\x12\r\n\x05value\x18\x01 \x01(\t\x12\r\n\x05\x65rror\x18\x02 \x01(\t\"\x84\x03\n\x15ZInfoConfigItemStatus\x12P\n\x0b\x63onfigItems\x18\x01 \x03(\x0b\x32;.org.lfedge.eve.info.ZInfoConfigItemStatus.ConfigItemsEntry\x12^\n\x12unknownConfigItems\x18\x02 \x03(\x0b\x32\x42.org.lfedge.eve.info.ZInfoConfigItemStatus.UnknownConfigItemsEntry\x1aX\n\x10\x43onfigItemsEntry\x12\x0b\n\x03key\x18\x01 \x01(\t\x12\x33\n\x05value\x18\x02 \x01(\x0b\x32$.org.lfedge.eve.info.ZInfoConfigItem:\x02\x38\x01\x1a_\n\x17UnknownConfigItemsEntry\x12\x0b\n\x03key\x18\x01 \x01(\t\x12\x33\n\x05value\x18\x02 \x01(\x0b\x32$.org.lfedge.eve.info.ZInfoConfigItem:\x02\x38\x01\"B\n\x10ZInfoAppInstance\x12\x0c\n\x04uuid\x18\x01 \x01(\t\x12\x0c\n\x04name\x18\x02 \x01(\t\x12\x12\n\ndomainName\x18\x03 \x01(\t\"3\n\x10ZInfoDeviceTasks\x12\x0c\n\x04name\x18\x01 \x01(\t\x12\x11\n\tnamespace\x18\x02 \x01(\t\"\x86\x01\n\x0cZSimcardInfo\x12\x0c\n\x04name\x18\x01 \x01(\t\x12\x18\n\x10\x63\x65ll_module_name\x18\x02 \x01(\t\x12\x0c\n\x04imsi\x18\x03 \x01(\t\x12\r\n\x05iccid\x18\x04 \x01(\t\x12\x31\n\x05state\x18\x05 \x01(\x0e\x32\".org.lfedge.eve.info.ZSimcardState\"\xea\x01\n\x13ZCellularModuleInfo\x12\x0c\n\x04name\x18\x01 \x01(\t\x12\x0c\n\x04imei\x18\x02 \x01(\t\x12\x18\n\x10\x66irmware_version\x18\x03 \x01(\t\x12\r\n\x05model\x18\x04 \x01(\t\x12\x45\n\x0foperating_state\x18\x05 \x01(\x0e\x32,.org.lfedge.eve.info.ZCellularOperatingState\x12G\n\x10\x63ontrol_protocol\x18\x06 \x01(\x0e\x32-.org.lfedge.eve.info.ZCellularControlProtocol\"`\n\x11ZCellularProvider\x12\x0c\n\x04plmn\x18\x01 \x01(\t\x12\x13\n\x0b\x64\x65scription\x18\x02 \x01(\t\x12\x17\n\x0f\x63urrent_serving\x18\x03 \x01(\x08\x12\x0f\n\x07roaming\x18\x04 \x01(\x08\"\x81\x01\n\x10StorageDiskState\x12\x39\n\tdisk_name\x18\x01 \x01(\x0b\x32&.org.lfedge.eve.common.DiskDescription\x12\x32\n\x06status\x18\x02 \x01(\x0e\x32\".org.lfedge.eve.info.StorageStatus\"m\n\tSmartAttr\x12\n\n\x02id\x18\x01 \x01(\r\x12\r\n\x05value\x18\x02 \x01(\x04\x12\r\n\x05worst\x18\x03 \x01(\x04\x12\x0e\n\x06thresh\x18\x04 \x01(\x04\x12\x13\n\x0bwhen_failed\x18\x05 \x01(\t\x12\x11\n\traw_value\x18\x06 \x01(\x04\"\x8a\x03\n\x0bSmartMetric\x12=\n\x15reallocated_sector_ct\x18\x01 \x01(\x0b\x32\x1e.org.lfedge.eve.info.SmartAttr\x12\x36\n\x0epower_on_hours\x18\x02 \x01(\x0b\x32\x1e.org.lfedge.eve.info.SmartAttr\x12\x39\n\x11power_cycle_count\x18\x03 \x01(\x0b\x32\x1e.org.lfedge.eve.info.SmartAttr\x12?\n\x17reallocated_event_count\x18\x04 \x01(\x0b\x32\x1e.org.lfedge.eve.info.SmartAttr\x12>\n\x16\x63urrent_pending_sector\x18\x05 \x01(\x0b\x32\x1e.org.lfedge.eve.info.SmartAttr\x12\x13\n\x0bneed_update\x18\x06 \x01(\x08\x12\x33\n\x0btemperature\x18\x07 \x01(\x0b\x32\x1e.org.lfedge.eve.info.SmartAttr\"\xa7\x01\n\x0fStorageDiskInfo\x12\x11\n\tdisk_name\x18\x01 \x01(\t\x12\x34\n\nsmart_data\x18\x03 \x03(\x0b\x32 .org.lfedge.eve.info.SmartMetric\x12\x0b\n\x03wwn\x18\x04 \x01(\t\x12\x15\n\rserial_number\x18\x05 \x01(\t\x12\r\n\x05model\x18\x06 \x01(\t\x12\x18\n\x10\x63ollector_errors\x18\x07 \x01(\t\"\xd1\x01\n\x0fStorageChildren\x12:\n\x0c\x63urrent_raid\x18\x01 \x01(\x0e\x32$.org.lfedge.eve.info.StorageRaidType\x12\x34\n\x05\x64isks\x18\x02 \x03(\x0b\x32%.org.lfedge.eve.info.StorageDiskState\x12\x36\n\x08\x63hildren\x18\x03 \x03(\x0b\x32$.org.lfedge.eve.info.StorageChildren\x12\x14\n\x0c\x64isplay_name\x18\x04 \x01(\t\"\xb4\x03\n\x0bStorageInfo\x12\x11\n\tpool_name\x18\x01 \x01(\t\x12:\n\x0cstorage_type\x18\x02 \x01(\x0e\x32$.org.lfedge.eve.info.StorageTypeInfo\x12\x13\n\x0bzfs_version\x18\x03 \x01(\t\x12:\n\x0c\x63urrent_raid\x18\x04 \x01(\x0e\x32$.org.lfedge.eve.info.StorageRaidType\x12\x19\n\x11\x63ompression_ratio\x18\x05 \x01(\x01\x12\x12\n\nzpool_size\x18\x06 \x01(\x04\x12\x13\n\x0b\x63ount_zvols\x18\x07 \x01(\r\x12\x39\n\rstorage_state\x18\x08 \x01(\x0e\x32\".org.lfedge.eve.info.StorageStatus\x12\x34\n\x05\x64isks\x18\t \x03(\x0b\x32%.org.lfedge.eve.info.StorageDiskState\x12\x18\n\x10\x63ollector_errors\x18\n \x01(\t\x12\x36\n\x08\x63hildren\x18\x0b \x03(\x0b\x32$.org.lfedge.eve.info.StorageChildren\"D\n\rZInfoHardware\x12\x33\n\x05\x64isks\x18\x01 \x03(\x0b\x32$.org.lfedge.eve.info.StorageDiskInfo\"\xd2\x0f\n\x0bZInfoDevice\x12\x13\n\x0bmachineArch\x18\x04 \x01(\t\x12\x0f\n\x07\x63puArch\x18\x05 \x01(\t\x12\x10\n\x08platform\x18\x06 \x01(\t\x12\x0c\n\x04ncpu\x18\x07 \x01(\r\x12\x0e\n\x06memory\x18\x08 \x01(\x04\x12\x0f\n\x07storage\x18\t \x01(\x04\x12\x19\n\x11powerCycleCounter\x18\n \x01(\x03\x12\x35\n\x05minfo\x18\x0b \x01(\x0b\x32&.org.lfedge.eve.info.ZInfoManufacturer\x12\x32\n\x07network\x18\r \x03(\x0b\x32!.org.lfedge.eve.info.ZInfoNetwork\x12:\n\x12\x61ssignableAdapters\x18\x0f \x03(\x0b\x32\x1e.org.lfedge.eve.info.ZioBundle\x12*\n\x03\x64ns\x18\x10 \x01(\x0b\x32\x1d.org.lfedge.eve.info.ZInfoDNS\x12\x36\n\x0bstorageList\x18\x11 \x03(\x0b\x32!.org.lfedge.eve.info.ZInfoStorage\x12,\n\x08\x62ootTime\x18\x12 \x01(\x0b\x32\x1a.google.protobuf.Timestamp\x12/\n\x06swList\x18\x13 \x03(\x0b\x32\x1f.org.lfedge.eve.info.ZInfoDevSW\x12\x10\n\x08HostName\x18\x14 \x01(\t\x12>\n\x0bmetricItems\x18\x15 \x03(\x0b\x32).org.lfedge.eve.info.deprecatedMetricItem\x12\x18\n\x10lastRebootReason\x18\x16 \x01(\t\x12\x32\n\x0elastRebootTime\x18\x17 \x01(\x0b\x32\x1a.google.protobuf.Timestamp\x12=\n\rsystemAdapter\x18\x18 \x01(\x0b\x32&.org.lfedge.eve.info.SystemAdapterInfo\x12\x16\n\x0erestartCounter\x18\x19 \x01(\r\x12>\n\tHSMStatus\x18\x1a \x01(\x0e\x32+.org.lfedge.eve.info.HwSecurityModuleStatus\x12\x0f\n\x07HSMInfo\x18\x1b \x01(\t\x12\x17\n\x0flastRebootStack\x18\x1c \x01(\t\x12=\n\x11\x64\x61taSecAtRestInfo\x18\x1d \x01(\x0b\x32\".org.lfedge.eve.info.DataSecAtRest\x12\x33\n\x08sec_info\x18\x1e \x01(\x0b\x32!.org.lfedge.eve.info.SecurityInfo\x12\x44\n\x10\x63onfigItemStatus\x18\x1f \x01(\x0b\x32*.org.lfedge.eve.info.ZInfoConfigItemStatus\x12;\n\x0c\x61ppInstances\x18  \x03(\x0b\x32%.org.lfedge.eve.info.ZInfoAppInstance\x12\x1b\n\x13rebootConfigCounter\x18! \x01(\r\x12\x39\n\x10last_boot_reason\x18\" \x01(\x0e\x32\x1f.org.lfedge.eve.info.BootReason\x12=\n\x0b\x63\x65ll_radios\x18# \x03(\x0b\x32(.org.lfedge.eve.info.ZCellularModuleInfo\x12/\n\x04sims\x18$ \x03(\x0b\x32!.org.lfedge.eve.info.ZSimcardInfo\x12\x34\n\x05tasks\x18% \x03(\x0b\x32%.org.lfedge.eve.info.ZInfoDeviceTasks\x12\x18\n\x10maintenance_mode\x18& \x01(\x08\x12K\n\x17maintenance_mode_reason\x18\' \x01(\x0e\x32*.org.lfedge.eve.info.MaintenanceModeReason\x12!\n\x19hardware_watchdog_present\x18( \x01(\x08\x12\x19\n\x11reboot_inprogress\x18) \x01(\x08\x12\x37\n\x0c\x63\x61pabilities\x18* \x01(\x0b\x32!.org.lfedge.eve.info.Capabilities\x12\x1d\n\x15\x62\x61seos_update_counter\x18+ \x01(\r\x12\x30\n\x05state\x18, \x01(\x0e\x32!.org.lfedge.eve.info.ZDeviceState\x12\x15\n\rlocal_profile\x18- \x01(\t\x12L\n\x18maintenance_mode_reasons\x18. \x03(\x0e\x32*.org.lfedge.eve.info.MaintenanceModeReason\x12\x18\n\x0c\x64ormant_time\x18/ \x01(\tB\x02\x18\x01\x12\x36\n\x0cstorage_info\x18\x30 \x03(\x0b\x32 .org.lfedge.eve.info.StorageInfo\x12\x1f\n\x17shutdown_config_counter\x18\x31 \x01(\r\x12>\n\x10\x61ttestation_info\x18\x32 \x01(\x0b\x32$.org.lfedge.eve.info.AttestationInfo\x12:\n\x0e\x61pi_capability\x18\x33 \x01(\x0e\x32\".org.lfedge.eve.info.APICapability\"v\n\x0f\x41ttestationInfo\x12\x34\n\x05state\x18\x01 \x01(\x0e\x32%.org.lfedge.eve.info.AttestationState\x12-\n\x05\x65rror\x18\x02 \x01(\x0b\x32\x1e.org.lfedge.eve.info.ErrorInfo\"`\n\x11SystemAdapterInfo\x12\x14\n\x0c\x63urrentIndex\x18\x01 \x01(\r\x12\x35\n\x06status\x18\x02 \x03(\x0b\x32%.org.lfedge.eve.info.DevicePortStatus\"\x88\x02\n\x10\x44\x65vicePortStatus\x12\x0f\n\x07version\x18\x01 \x01(\r\x12\x0b\n\x03key\x18\x02 \x01(\t\x12\x30\n\x0ctimePriority\x18\x03 \x01(\x0b\x32\x1a.google.protobuf.Timestamp\x12.\n\nlastFailed\x18\x04 \x01(\x0b\x32\x1a.google.protobuf.Timestamp\x12\x31\n\rlastSucceeded\x18\x05 \x01(\x0b\x32\x1a.google.protobuf.Timestamp\x12.\n\x05ports\x18\x06 \x03(\x0b\x32\x1f.org.lfedge.eve.info.DevicePort\x12\x11\n\tlastError\x18\x07 \x01(\t\"\xfb\x04\n\nDevicePort\x12\x0e\n\x06ifname\x18\x01 \x01(\t\x12\x0c\n\x04name\x18\x02 \x01(\t\x12\x0e\n\x06isMgmt\x18\x03 \x01(\x08\x12\x0c\n\x04\x66ree\x18\x04 \x01(\x08\x12\x10\n\x08\x64hcpType\x18\x0b \x01(\r\x12\x0e\n\x06subnet\x18\x0c \x01(\t\x12\x0f\n\x07gateway\x18\r \x01(\t\x12\x12\n\ndomainname\x18\x0e \x01(\t\x12\x11\n\tntpServer\x18\x0f \x01(\t\x12\x12\n\ndnsServers\x18\x10 \x03(\t\x12\x14\n\x0c\x64hcpRangeLow\x18\x11 \x01(\t\x12\x15\n\rdhcpRangeHigh\x18\x12 \x01(\t\x12/\n\x05proxy\x18\x15 \x01(\x0b\x32 .org.lfedge.eve.info.ProxyStatus\x12\x0f\n\x07macAddr\x18\x16 \x01(\t\x12\x0f\n\x07IPAddrs\x18\x17 \x03(\t\x12\x16\n\x0e\x64\x65\x66\x61ultRouters\x18\x18 \x03(\t\x12*\n\x03\x64ns\x18\x19 \x01(\x0b\x32\x1d.org.lfedge.eve.info.ZInfoDNS\x12\n\n\x02up\x18\x1a \x01(\x08\x12-\n\x08location\x18\x1b \x01(\x0b\x32\x1b.org.lfedge.eve.info.GeoLoc\x12+\n\x03\x65rr\x18\x1d \x01(\x0b\x32\x1e.org.lfedge.eve.info.ErrorInfo\x12\x36\n\x05usage\x18\x1e \x01(\x0e\x32\'.org.lfedge.eve.common.PhyIoMemberUsage\x12\x13\n\x0bnetworkUUID\x18\x1f \x01(\t\x12\x0c\n\x04\x63ost\x18  \x01(\r\x12<\n\x0fwireless_status\x18! \x01(\x0b\x32#.org.lfedge.eve.info.WirelessStatus\"\xaa\x01\n\x0bProxyStatus\x12\x30\n\x07proxies\x18\x01 \x03(\x0b\x32\x1f.org.lfedge.eve.info.ProxyEntry\x12\x12\n\nexceptions\x18\x02 \x01(\t\x12\x0f\n\x07pacfile\x18\x03 \x01(\t\x12\x1a\n\x12networkProxyEnable\x18\x04 \x01(\x08\x12\x17\n\x0fnetworkProxyURL\x18\x05 \x01(\t\x12\x0f\n\x07wpadURL\x18\x06 \x01(\t\"8\n\nProxyEntry\x12\x0c\n\x04type\x18\x01 \x01(\r\x12\x0e\n\x06server\x18\x02 \x01(\t\x12\x0c\n\x04port\x18\x03 \x01(\r\"y\n\x0eWirelessStatus\x12/\n\x04type\x18\x01 \x01(\x0e\x32!.org.lfedge.eve.info.WirelessType\x12\x36\n\x08\x63\x65llular\x18\x02 \x01(\x0b\x32$.org.lfedge.eve.info.ZCellularStatus\"\xa3\x01\n\x0fZCellularStatus\x12\x17\n\x0f\x63\x65llular_module\x18\x01 \x01(\t\x12\x11\n\tsim_cards\x18\x02 \x03(\t\x12\x39\n\tproviders\x18\x03 \x03(\x0b\x32&.org.lfedge.eve.info.ZCellularProvider\x12\x14\n\x0c\x63onfig_error\x18\n \x01(\t\x12\x13\n\x0bprobe_error\x18\x0b \x01(\t\"\xac\x03\n\nZInfoDevSW\x12\x11\n\tactivated\x18\x02 \x01(\x08\x12\x16\n\x0epartitionLabel\x18\x03 \x01(\t\x12\x17\n\x0fpartitionDevice\x18\x04 \x01(\t\x12\x16\n\x0epartitionState\x18\x05 \x01(\t\x12-\n\x06status\x18\x06 \x01(\x0e\x32\x1d.org.lfedge.eve.info.ZSwState\x12\x14\n\x0cshortVersion\x18\x07 \x01(\t\x12\x13\n\x0blongVersion\x18\x08 \x01(\t\x12-\n\x05swErr\x18\t \x01(\x0b\x32\x1e.org.lfedge.eve.info.ErrorInfo\x12\x18\n\x10\x64ownloadProgress\x18\n \x01(\r\x12\x35\n\nuserStatus\x18\x0b \x01(\x0e\x32!.org.lfedge.eve.info.BaseOsStatus\x12\x14\n\x0csubStatusStr\x18\x0c \x01(\t\x12\x37\n\tsubStatus\x18\r \x01(\x0e\x32$.org.lfedge.eve.info.BaseOsSubStatus\x12\x19\n\x11subStatusProgress\x18\x0e \x01(\r\"Y\n\x0cZInfoStorage\x12\x0e\n\x06\x64\x65vice\x18\x01 \x01(\t\x12\x11\n\tmountPath\x18\x02 \x01(\t\x12\r\n\x05total\x18\x03 \x01(\x04\x12\x17\n\x0fstorageLocation\x18\x04 \x01(\x08\"\x93\x03\n\x08ZInfoApp\x12\r\n\x05\x41ppID\x18\x01 \x01(\t\x12\x12\n\nappVersion\x18\x02 \x01(\t\x12\x11\n\tsystemApp\x18\x06 \x01(\x08\x12\x0f\n\x07\x41ppName\x18\x07 \x01(\t\x12\x32\n\x0csoftwareList\x18\x08 \x03(\x0b\x32\x1c.org.lfedge.eve.info.ZInfoSW\x12,\n\x08\x62ootTime\x18\x0c \x01(\x0b\x32\x1a.google.protobuf.Timestamp\x12\x38\n\x10\x61ssignedAdapters\x18\r \x03(\x0b\x32\x1e.org.lfedge.eve.info.ZioBundle\x12.\n\x06\x61ppErr\x18\x0e \x03(\x0b\x32\x1e.org.lfedge.eve.info.ErrorInfo\x12,\n\x05state\x18\x0f \x01(\x0e\x32\x1d.org.lfedge.eve.info.ZSwState\x12\x32\n\x07network\x18\x10 \x03(\x0b\x32!.org.lfedge.eve.info.ZInfoNetwork\x12\x12\n\nvolumeRefs\x18\x11 \x03(\t\"D\n\x10ZInfoVpnLinkInfo\x12\r\n\x05spiId\x18\x01 \x01(\t\x12\x0e\n\x06subNet\x18\x02 \x01(\t\x12\x11\n\tdirection\x18\x03 \x01(\x08\"\xf9\x01\n\x0cZInfoVpnLink\x12\n\n\x02id\x18\x01 \x01(\t\x12\x0c\n\x04name\x18\x02 \x01(\t\x12\r\n\x05reqId\x18\x03 \x01(\t\x12\x10\n\x08instTime\x18\x04 \x01(\x04\x12\x0f\n\x07\x65spInfo\x18\x05 \x01(\t\x12\x31\n\x05state\x18\x06 \x01(\x0e\x32\".org.lfedge.eve.info.ZInfoVpnState\x12\x34\n\x05lInfo\x18\n \x01(\x0b\x32%.org.lfedge.eve.info.ZInfoVpnLinkInfo\x12\x34\n\x05rInfo\x18\x0b \x01(\x0b\x32%.org.lfedge.eve.info.ZInfoVpnLinkInfo\"<\n\x10ZInfoVpnEndPoint\x12\n\n\x02id\x18\x01 \x01(\t\x12\x0e\n\x06ipAddr\x18\x02 \x01(\t\x12\x0c\n\x04port\x18\x03 \x01(\r\"\xa9\x02\n\x0cZInfoVpnConn\x12\n\n\x02id\x18\x01 \x01(\t\x12\x0c\n\x04name\x18\x02 \x01(\t\x12\x0f\n\x07version\x18\x03 \x01(\t\x12\x0f\n\x07\x65stTime\x18\x04 \x01(\x04\x12\x0c\n\x04ikes\x18\x05 \x01(\t\x12\x31\n\x05state\x18\x06 \x01(\x0e\x32\".org.lfedge.eve.info.ZInfoVpnState\x12\x34\n\x05lInfo\x18\x07 \x01(\x0b\x32%.org.lfedge.eve.info.ZInfoVpnEndPoint\x12\x34\n\x05rInfo\x18\x08 \x01(\x0b\x32%.org.lfedge.eve.info.ZInfoVpnEndPoint\x12\x30\n\x05links\x18\n \x03(\x0b\x32!.org.lfedge.eve.info.ZInfoVpnLink\"z\n\x08ZInfoVpn\x12\x0e\n\x06upTime\x18\x01 \x01(\x04\x12\x13\n\x0bpolicyBased\x18\x02 \x01(\x08\x12\x18\n\x10listeningIpAddrs\x18\x03 \x03(\t\x12/\n\x04\x63onn\x18\n \x03(\x0b\x32!.org.lfedge.eve.info.ZInfoVpnConn\"\xd6\x05\n\x14ZInfoNetworkInstance\x12\x11\n\tnetworkID\x18\x02 \x01(\t\x12\x16\n\x0enetworkVersion\x18\x03 \x01(\t\x12\x10\n\x08instType\x18\x05 \x01(\r\x12\x13\n\x0b\x64isplayname\x18\x06 \x01(\t\x12\x11\n\tactivated\x18\x07 \x01(\x08\x12/\n\x0bupTimeStamp\x18\x08 \x01(\x0b\x32\x1a.google.protobuf.Timestamp\x12\x32\n\x0csoftwareList\x18\t \x01(\x0b\x32\x1c.org.lfedge.eve.info.ZInfoSW\x12\x19\n\x11\x43urrentUplinkIntf\x18\n \x01(\t\x12\x1a\n\x12\x43urrentUplinkAlias\x18\x0b \x01(\t\x12\x11\n\tbridgeNum\x18\x14 \x01(\r\x12\x12\n\nbridgeName\x18\x15 \x01(\t\x12\x14\n\x0c\x62ridgeIPAddr\x18\x16 \x01(\t\x12\x41\n\ripAssignments\x18\x17 \x03(\x0b\x32*.org.lfedge.eve.info.ZmetIPAssignmentEntry\x12\x14\n\x0c\x62ridgeIPSets\x18\x18 \x03(\t\x12.\n\x04vifs\x18\x19 \x03(\x0b\x32 .org.lfedge.eve.info.ZmetVifInfo\x12\x0f\n\x07ipv4Eid\x18\x1a \x01(\x08\x12\x38\n\x10\x61ssignedAdapters\x18\x1e \x03(\x0b\x32\x1e.org.lfedge.eve.info.ZioBundle\x12.\n\x05vinfo\x18\x1f \x01(\x0b\x32\x1d.org.lfedge.eve.info.ZInfoVpnH\x00\x12\x32\n\nnetworkErr\x18( \x03(\x0b\x32\x1e.org.lfedge.eve.info.ErrorInfo\x12\x39\n\x05state\x18) \x01(\x0e\x32*.org.lfedge.eve.info.ZNetworkInstanceStateB\r\n\x0bInfoContent\"\x89\x01\n\tUsageInfo\x12.\n\ncreateTime\x18\x01 \x01(\x0b\x32\x1a.google.protobuf.Timestamp\x12\x10\n\x08refCount\x18\x02 \x01(\r\x12:\n\x16lastRefcountChangeTime\x18\x03 \x01(\x0b\x32\x1a.google.protobuf.Timestamp\"=\n\x0fVolumeResources\x12\x14\n\x0cmaxSizeBytes\x18\x01 \x01(\x04\x12\x14\n\x0c\x63urSizeBytes\x18\x02 \x01(\x04\"\xaf\x02\n\x0bZInfoVolume\x12\x0c\n\x04uuid\x18\x01 \x01(\t\x12\x13\n\x0b\x64isplayName\x18\x02 \x01(\t\x12-\n\x05usage\x18\x03 \x01(\x0b\x32\x1e.org.lfedge.eve.info.UsageInfo\x12\x37\n\tresources\x18\x04 \x01(\x0b\x32$.org.lfedge.eve.info.VolumeResources\x12,\n\x05state\x18\x05 \x01(\x0e\x32\x1d.org.lfedge.eve.info.ZSwState\x12\x1a\n\x12progressPercentage\x18\x06 \x01(\r\x12\x31\n\tvolumeErr\x18\x07 \x01(\x0b\x32\x1e.org.lfedge.eve.info.ErrorInfo\x12\x18\n\x10generation_count\x18\x08 \x01(\x03\"(\n\x10\x43ontentResources\x12\x14\n\x0c\x63urSizeBytes\x18\x01 \x01(\x04\"\xd9\x02\n\x10ZInfoContentTree\x12\x0c\n\x04uuid\x18\x01 \x01(\t\x12\x13\n\x0b\x64isplayName\x18\x02 \x01(\t\x12\x0e\n\x06sha256\x18\x03 \x01(\t\x12\x38\n\tresources\x18\x04 \x01(\x0b\x32%.org.lfedge.eve.info.ContentResources\x12-\n\x05usage\x18\x05 \x01(\x0b\x32\x1e.org.lfedge.eve.info.UsageInfo\x12,\n\x05state\x18\x06 \x01(\x0e\x32\x1d.org.lfedge.eve.info.ZSwState\x12\x1a\n\x12progressPercentage\x18\x07 \x01(\r\x12+\n\x03\x65rr\x18\x08 \x01(\x0b\x32\x1e.org.lfedge.eve.info.ErrorInfo\x12\x18\n\x10\x63omponentShaList\x18\t \x03(\t\x12\x18\n\x10generation_count\x18\n \x01(\x03\"\xfb\x01\n\tZInfoBlob\x12\x0e\n\x06sha256\x18\x01 \x01(\t\x12\x38\n\tresources\x18\x02 \x01(\x0b\x32%.org.lfedge.eve.info.ContentResources\x12-\n\x05usage\x18\x03 \x01(\x0b\x32\x1e.org.lfedge.eve.info.UsageInfo\x12,\n\x05state\x18\x04 \x01(\x0e\x32\x1d.org.lfedge.eve.info.ZSwState\x12\x1a\n\x12progressPercentage\x18\x05 \x01(\r\x12+\n\x03\x65rr\x18\x06 \x01(\x0b\x32\x1e.org.lfedge.eve.info.ErrorInfo\"=\n\rZInfoBlobList\x12,\n\x04\x62lob\x18\x01 \x03(\x0b\x32\x1e.org.lfedge.eve.info.ZInfoBlob\"\xaa\x05\n\x08ZInfoMsg\x12.\n\x05ztype\x18\x01 \x01(\x0e\x32\x1f.org.lfedge.eve.info.ZInfoTypes\x12\r\n\x05\x64\x65vId\x18\x02 \x01(\t\x12\x31\n\x05\x64info\x18\x03 \x01(\x0b\x32 .org.lfedge.eve.info.ZInfoDeviceH\x00\x12.\n\x05\x61info\x18\x05 \x01(\x0b\x32\x1d.org.lfedge.eve.info.ZInfoAppH\x00\x12;\n\x06niinfo\x18\x0c \x01(\x0b\x32).org.lfedge.eve.info.ZInfoNetworkInstanceH\x00\x12\x31\n\x05vinfo\x18\r \x01(\x0b\x32 .org.lfedge.eve.info.ZInfoVolumeH\x00\x12\x36\n\x05\x63info\x18\x0e \x01(\x0b\x32%.org.lfedge.eve.info.ZInfoContentTreeH\x00\x12\x33\n\x05\x62info\x18\x0f \x01(\x0b\x32\".org.lfedge.eve.info.ZInfoBlobListH\x00\x12<\n\x07\x61mdinfo\x18\x10 \x01(\x0b\x32).org.lfedge.eve.info.ZInfoAppInstMetaDataH\x00\x12\x34\n\x06\x65vinfo\x18\x11 \x01(\x0b\x32\".org.lfedge.eve.info.ZInfoEdgeviewH\x00\x12\x34\n\x06hwinfo\x18\x12 \x01(\x0b\x32\".org.lfedge.eve.info.ZInfoHardwareH\x00\x12\x35\n\x07locinfo\x18\x13 \x01(\x0b\x32\".org.lfedge.eve.info.ZInfoLocationH\x00\x12/\n\x0b\x61tTimeStamp\x18\x06 \x01(\x0b\x32\x1a.google.protobuf.TimestampB\r\n\x0bInfoContent\"J\n\x0c\x43\x61pabilities\x12 \n\x18HWAssistedVirtualization\x18\x02 \x01(\x08\x12\x18\n\x10IOVirtualization\x18\x03 \x01(\x08\"j\n\x14ZInfoAppInstMetaData\x12\x0c\n\x04uuid\x18\x01 \x01(\t\x12\x36\n\x04type\x18\x02 \x01(\x0e\x32(.org.lfedge.eve.info.AppInstMetaDataType\x12\x0c\n\x04\x64\x61ta\x18\x03 \x01(\x0c\"\x98\x01\n\rZInfoEdgeview\x12/\n\x0b\x65xpire_time\x18\x01 \x01(\x0b\x32\x1a.google.protobuf.Timestamp\x12\x30\n\x0cstarted_time\x18\x02 \x01(\x0b\x32\x1a.google.protobuf.Timestamp\x12\x11\n\tcount_dev\x18\x03 \x01(\r\x12\x11\n\tcount_app\x18\x04 \x01(\r\"\xd5\x02\n\rZInfoLocation\x12\x10\n\x08latitude\x18\x01 \x01(\x01\x12\x11\n\tlongitude\x18\x02 \x01(\x01\x12\x10\n\x08\x61ltitude\x18\x03 \x01(\x01\x12\x31\n\rutc_timestamp\x18\x04 \x01(\x0b\x32\x1a.google.protobuf.Timestamp\x12\x43\n\x16horizontal_reliability\x18\x05 \x01(\x0e\x32#.org.lfedge.eve.info.LocReliability\x12\x41\n\x14vertical_reliability\x18\x06 \x01(\x0e\x32#.org.lfedge.eve.info.LocReliability\x12\x1e\n\x16horizontal_uncertainty\x18\x07 \x01(\x02\x12\x1c\n\x14vertical_uncertainty\x18\x08 \x01(\x02\x12\x14\n\x0clogicallabel\x18\t \x01(\t*u\n\x11\x44\x65pMetricItemType\x12\x16\n\x12\x44\x65pMetricItemOther\x10\x00\x12\x16\n\x12\x44\x65pMetricItemGauge\x10\x01\x12\x18\n\x14\x44\x65pMetricItemCounter\x10\x02\x12\x16\n\x12\x44\x65pMetricItemState\x10\x03*\xbf\x01\n\nZInfoTypes\x12\t\n\x05ZiNop\x10\x00\x12\x0c\n\x08ZiDevice\x10\x01\x12\t\n\x05ZiApp\x10\x03\x12\x15\n\x11ZiNetworkInstance\x10\x06\x12\x0c\n\x08ZiVolume\x10\x07\x12\x11\n\rZiContentTree\x10\x08\x12\x0e\n\nZiBlobList\x10\t\x12\x15\n\x11ZiAppInstMetaData\x10\n\x12\x0e\n\nZiHardware\x10\x0b\x12\x0e\n\nZiEdgeview\x10\x0c\x12\x0e\n\nZiLocation\x10\r*\xf4\x02\n\x08ZSwState\x12\x0b\n\x07INVALID\x10\x00\x12\x0b\n\x07INITIAL\x10\x01\x12\x14\n\x10\x44OWNLOAD_STARTED\x10\x02\x12\x0e\n\nDOWNLOADED\x10\x03\x12\r\n\tDELIVERED\x10\x04\x12\r\n\tINSTALLED\x10\x05\x12\x0b\n\x07\x42OOTING\x10\x06\x12\x0b\n\x07RUNNING\x10\x07\x12\x0b\n\x07HALTING\x10\x08\x12\n\n\x06HALTED\x10\t\x12\x0e\n\nRESTARTING\x10\n\x12\x0b\n\x07PURGING\x10\x0b\x12\x11\n\rRESOLVING_TAG\x10\x0c\x12\x10\n\x0cRESOLVED_TAG\x10\r\x12\x13\n\x0f\x43REATING_VOLUME\x10\x0e\x12\x12\n\x0e\x43REATED_VOLUME\x10\x0f\x12\r\n\tVERIFYING\x10\x10\x12\x0c\n\x08VERIFIED\x10\x11\x12\x0b\n\x07LOADING\x10\x12\x12\n\n\x06LOADED\x10\x13\x12\x18\n\x14\x41WAITNETWORKINSTANCE\x10\x14\x12\t\n\x05\x45RROR\x10\x15\x12\x11\n\rSTART_DELAYED\x10\x16*\x99\x02\n\x06\x45ntity\x12\x16\n\x12\x45NTITY_UNSPECIFIED\x10\x00\x12\x12\n\x0e\x45NTITY_BASE_OS\x10\x01\x12\x19\n\x15\x45NTITY_SYSTEM_ADAPTER\x10\x02\x12\x10\n\x0c\x45NTITY_VAULT\x10\x03\x12\x16\n\x12\x45NTITY_ATTESTATION\x10\x04\x12\x17\n\x13\x45NTITY_APP_INSTANCE\x10\x05\x12\x0f\n\x0b\x45NTITY_PORT\x10\x06\x12\x12\n\x0e\x45NTITY_NETWORK\x10\x07\x12\x1b\n\x17\x45NTITY_NETWORK_INSTANCE\x10\x08\x12\x17\n\x13\x45NTITY_CONTENT_TREE\x10\t\x12\x17\n\x13\x45NTITY_CONTENT_BLOB\x10\n\x12\x11\n\rENTITY_VOLUME\x10\x0b*c\n\x08Severity\x12\x18\n\x14SEVERITY_UNSPECIFIED\x10\x00\x12\x13\n\x0fSEVERITY_NOTICE\x10\x01\x12\x14\n\x10SEVERITY_WARNING\x10\x02\x12\x12\n\x0eSEVERITY_ERROR\x10\x03*N\n\x16HwSecurityModuleStatus\x12\x0b\n\x07UNKNOWN\x10\x00\x12\x0c\n\x08NOTFOUND\x10\x01\x12\x0c\n\x08\x44ISABLED\x10\x02\x12\x0b\n\x07\x45NABLED\x10\x03*\x88\x01\n\x13\x44\x61taSecAtRestStatus\x12\x1b\n\x17\x44\x41TASEC_AT_REST_UNKNOWN\x10\x00\x12\x1c\n\x18\x44\x41TASEC_AT_REST_DISABLED\x10\x01\x12\x1b\n\x17\x44\x41TASEC_AT_REST_ENABLED\x10\x02\x12\x19\n\x15\x44\x41TASEC_AT_REST_ERROR\x10\x04*?\n\tPCRStatus\x12\x0f\n\x0bPCR_UNKNOWN\x10\x00\x12\x0f\n\x0bPCR_ENABLED\x10\x01\x12\x10\n\x0cPCR_DISABLED\x10\x02*\xc5\x01\n\rZSimcardState\x12\x1b\n\x17Z_SIMCARD_STATE_INVALID\x10\x00\x12\x1c\n\x18Z_SIMCARD_STATE_ASSIGNED\x10\x01\x12\x1f\n\x1bZ_SIMCARD_STATE_PROVISIONED\x10\x02\x12\x1a\n\x16Z_SIMCARD_STATE_ACTIVE\x10\x03\x12\x1d\n\x19Z_SIMCARD_STATE_SUSPENDED\x10\x04\x12\x1d\n\x19Z_SIMCARD_STATE_CANCELLED\x10\x05*\xa0\x02\n\x17ZCellularOperatingState\x12*\n&Z_CELLULAR_OPERATING_STATE_UNSPECIFIED\x10\x00\x12&\n\"Z_CELLULAR_OPERATING_STATE_OFFLINE\x10\x01\x12(\n$Z_CELLULAR_OPERATING_STATE_RADIO_OFF\x10\x02\x12%\n!Z_CELLULAR_OPERATING_STATE_ONLINE\x10\x03\x12\x33\n/Z_CELLULAR_OPERATING_STATE_ONLINE_AND_CONNECTED\x10\x04\x12+\n\'Z_CELLULAR_OPERATING_STATE_UNRECOGNIZED\x10\x05*\x92\x01\n\x18ZCellularControlProtocol\x12+\n\'Z_CELLULAR_CONTROL_PROTOCOL_UNSPECIFIED\x10\x00\x12#\n\x1fZ_CELLULAR_CONTROL_PROTOCOL_QMI\x10\x01\x12$\n Z_CELLULAR_CONTROL_PROTOCOL_MBIM\x10\x02*\xb1\x02\n\x0cZDeviceState\x12\x1d\n\x19ZDEVICE_STATE_UNSPECIFIED\x10\x00\x12\x18\n\x14ZDEVICE_STATE_ONLINE\x10\x01\x12\x1b\n\x17ZDEVICE_STATE_REBOOTING\x10\x02\x12\"\n\x1eZDEVICE_STATE_MAINTENANCE_MODE\x10\x03\x12!\n\x1dZDEVICE_STATE_BASEOS_UPDATING\x10\x04\x12\x19\n\x15ZDEVICE_STATE_BOOTING\x10\x05\x12$\n ZDEVICE_STATE_PREPARING_POWEROFF\x10\x06\x12\x1e\n\x1aZDEVICE_STATE_POWERING_OFF\x10\x07\x12#\n\x1fZDEVICE_STATE_PREPARED_POWEROFF\x10\x08*\xf5\x01\n\rStorageStatus\x12\x1e\n\x1aSTORAGE_STATUS_UNSPECIFIED\x10\x00\x12\x19\n\x15STORAGE_STATUS_ONLINE\x10\x01\x12\x1b\n\x17STORAGE_STATUS_DEGRADED\x10\x02\x12\x1a\n\x16STORAGE_STATUS_FAULTED\x10\x03\x12\x1a\n\x16STORAGE_STATUS_OFFLINE\x10\x04\x12\x1a\n\x16STORAGE_STATUS_UNAVAIL\x10\x05\x12\x1a\n\x16STORAGE_STATUS_REMOVED\x10\x06\x12\x1c\n\x18STORAGE_STATUS_SUSPENDED\x10\x07*\xe3\x01\n\x0fStorageRaidType\x12!\n\x1dSTORAGE_RAID_TYPE_UNSPECIFIED\x10\x00\x12\x1b\n\x17STORAGE_RAID_TYPE_RAID0\x10\x01\x12\x1b\n\x17STORAGE_RAID_TYPE_RAID1\x10\x02\x12\x1b\n\x17STORAGE_RAID_TYPE_RAID5\x10\x03\x12\x1b\n\x17STORAGE_RAID_TYPE_RAID6\x10\x04\x12\x1b\n\x17STORAGE_RAID_TYPE_RAID7\x10\x05\x12\x1c\n\x18STORAGE_RAID_TYPE_NORAID\x10\x06*k\n\x0fStorageTypeInfo\x12!\n\x1dSTORAGE_TYPE_INFO_UNSPECIFIED\x10\x00\x12\x1a\n\x16STORAGE_TYPE_INFO_EXT4\x10\x01\x12\x19\n\x15STORAGE_TYPE_INFO_ZFS\x10\x02*\x98\x01\n\rAPICapability\x12\x1e\n\x1a\x41PI_CAPABILITY_UNSPECIFIED\x10\x00\x12\x1f\n\x1b\x41PI_CAPABILITY_RETRY_UPDATE\x10\x01\x12\x1b\n\x17\x41PI_CAPABILITY_SHUTDOWN\x10\x02\x12)\n%API_CAPABILITY_START_DELAY_IN_SECONDS\x10\x03*\xb9\x03\n\nBootReason\x12\x1b\n\x17\x42OOT_REASON_UNSPECIFIED\x10\x00\x12\x15\n\x11\x42OOT_REASON_FIRST\x10\x01\x12\x1a\n\x16\x42OOT_REASON_REBOOT_CMD\x10\x02\x12\x16\n\x12\x42OOT_REASON_UPDATE\x10\x03\x12\x18\n\x14\x42OOT_REASON_FALLBACK\x10\x04\x12\x1a\n\x16\x42OOT_REASON_DISCONNECT\x10\x05\x12\x15\n\x11\x42OOT_REASON_FATAL\x10\x06\x12\x13\n\x0f\x42OOT_REASON_OOM\x10\x07\x12\x1d\n\x19\x42OOT_REASON_WATCHDOG_HUNG\x10\x08\x12\x1c\n\x18\x42OOT_REASON_WATCHDOG_PID\x10\t\x12\x16\n\x12\x42OOT_REASON_KERNEL\x10\n\x12\x1a\n\x16\x42OOT_REASON_POWER_FAIL\x10\x0b\x12\x17\n\x13\x42OOT_REASON_UNKNOWN\x10\x0c\x12\x1c\n\x18\x42OOT_REASON_VAULT_FAILED\x10\r\x12\x1c\n\x18\x42OOT_REASON_POWEROFF_CMD\x10\x0e\x12\x1b\n\x16\x42OOT_REASON_PARSE_FAIL\x10\xff\x01*\xbe\x01\n\x15MaintenanceModeReason\x12 \n\x1cMAINTENANCE_MODE_REASON_NONE\x10\x00\x12*\n&MAINTENANCE_MODE_REASON_USER_REQUESTED\x10\x01\x12+\n\'MAINTENANCE_MODE_REASON_VAULT_LOCKED_UP\x10\x02\x12*\n&MAINTENANCE_MODE_REASON_LOW_DISK_SPACE\x10\x03*\xb5\x02\n\x10\x41ttestationState\x12!\n\x1d\x41TTESTATION_STATE_UNSPECIFIED\x10\x00\x12 \n\x1c\x41TTESTATION_STATE_NONCE_WAIT\x10\x01\x12$\n ATTESTATION_STATE_TPM_QUOTE_WAIT\x10\x02\x12%\n!ATTESTATION_STATE_TPM_ESCROW_WAIT\x10\x03\x12!\n\x1d\x41TTESTATION_STATE_ATTEST_WAIT\x10\x04\x12(\n$ATTESTATION_STATE_ATTEST_ESCROW_WAIT\x10\x05\x12\"\n\x1e\x41TTESTATION_STATE_RESTART_WAIT\x10\x06\x12\x1e\n\x1a\x41TTESTATION_STATE_COMPLETE\x10\x07*`\n\x13\x41ppInstMetaDataType\x12 \n\x1c\x41PP_INST_META_DATA_TYPE_NONE\x10\x00\x12\'\n#APP_INST_META_DATA_TYPE_KUBE_CONFIG\x10\x01*a\n\x0cWirelessType\x12\x1d\n\x19WIRELESS_TYPE_UNSPECIFIED\x10\x00\x12\x16\n\x12WIRELESS_TYPE_WIFI\x10\x01\x12\x1a\n\x16WIRELESS_TYPE_CELLULAR\x10\x02*q\n\x0c\x42\x61seOsStatus\x12\x08\n\x04NONE\x10\x00\x12\x0f\n\x0b\x44OWNLOADING\x10\x01\x12\x11\n\rDOWNLOAD_DONE\x10\x02\x12\x0c\n\x08UPDATING\x10\x03\x12\x0b\n\x07UPDATED\x10\x04\x12\x0c\n\x08\x46\x41LLBACK\x10\x05\x12\n\n\x06\x46\x41ILED\x10\x06*\xcb\x01\n\x0f\x42\x61seOsSubStatus\x12\x12\n\x0eNONE_SUBSTATUS\x10\x00\x12\x17\n\x13\x44OWNLOAD_INPROGRESS\x10\x01\x12\x15\n\x11VERIFY_INPROGRESS\x10\x02\x12\x17\n\x13UPDATE_INITIALIZING\x10\x03\x12\x14\n\x10UPDATE_REBOOTING\x10\x04\x12\x12\n\x0eUPDATE_TESTING\x10\x05\x12\x1c\n\x18UPDATE_NEED_TEST_CONFIRM\x10\x06\x12\x13\n\x0fUPDATE_DEFERRED\x10\x07*\x8f\x01\n\rZInfoVpnState\x12\x0f\n\x0bVPN_INVALID\x10\x00\x12\x0f\n\x0bVPN_INITIAL\x10\x01\x12\x12\n\x0eVPN_CONNECTING\x10\x02\x12\x13\n\x0fVPN_ESTABLISHED\x10\x03\x12\x11\n\rVPN_INSTALLED\x10\x04\x12\x0f\n\x0bVPN_REKEYED\x10\x05\x12\x0f\n\x0bVPN_DELETED\x10\n*\x85\x01\n\x15ZNetworkInstanceState\x12\x1e\n\x1aZNETINST_STATE_UNSPECIFIED\x10\x00\x12\x17\n\x13ZNETINST_STATE_INIT\x10\x01\x12\x19\n\x15ZNETINST_STATE_ONLINE\x10\x02\x12\x18\n\x14ZNETINST_STATE_ERROR\x10\x03*\x9e\x01\n\x0eLocReliability\x12\x1f\n\x1bLOC_RELIABILITY_UNSPECIFIED\x10\x00\x12\x1c\n\x18LOC_RELIABILITY_VERY_LOW\x10\x01\x12\x17\n\x13LOC_RELIABILITY_LOW\x10\x02\x12\x1a\n\x16LOC_RELIABILITY_MEDIUM\x10\x03\x12\x18\n\x14LOC_RELIABILITY_HIGH\x10\x04\x42\x39\n\x13org.lfedge.eve.infoZ\"github.com/lf-edge/eve/api/go/infob\x06proto3'
+  serialized_pb=b'\n\x0finfo/info.proto\x12\x13org.lfedge.eve.info\x1a\x1fgoogle/protobuf/timestamp.proto\x1a\x1e\x65vecommon/devmodelcommon.proto\x1a\x19\x65vecommon/evecommon.proto\"\xdc\x01\n\x14\x64\x65precatedMetricItem\x12\x0b\n\x03key\x18\x01 \x01(\t\x12\x34\n\x04type\x18\x02 \x01(\x0e\x32&.org.lfedge.eve.info.DepMetricItemType\x12\x13\n\tboolValue\x18\x03 \x01(\x08H\x00\x12\x15\n\x0buint32Value\x18\x04 \x01(\rH\x00\x12\x15\n\x0buint64Value\x18\x05 \x01(\x04H\x00\x12\x14\n\nfloatValue\x18\x06 \x01(\x02H\x00\x12\x15\n\x0bstringValue\x18\x07 \x01(\tH\x00\x42\x11\n\x0fmetricItemValue\">\n\x15ZmetIPAssignmentEntry\x12\x12\n\nmacAddress\x18\x01 \x01(\t\x12\x11\n\tipAddress\x18\x02 \x03(\t\"A\n\x0bZmetVifInfo\x12\x0f\n\x07vifName\x18\x01 \x01(\t\x12\x12\n\nmacAddress\x18\x02 \x01(\t\x12\r\n\x05\x61ppID\x18\x03 \x01(\t\"\xa5\x02\n\tZioBundle\x12.\n\x04type\x18\x01 \x01(\x0e\x32 .org.lfedge.eve.common.PhyIoType\x12\x0c\n\x04name\x18\x02 \x01(\t\x12\x0f\n\x07members\x18\x03 \x03(\t\x12\x15\n\rusedByAppUUID\x18\x04 \x01(\t\x12\x14\n\x0cusedByBaseOS\x18\x05 \x01(\x08\x12\x37\n\rioAddressList\x18\x06 \x03(\x0b\x32 .org.lfedge.eve.info.IoAddresses\x12\x36\n\x05usage\x18\x07 \x01(\x0e\x32\'.org.lfedge.eve.common.PhyIoMemberUsage\x12+\n\x03\x65rr\x18\x08 \x01(\x0b\x32\x1e.org.lfedge.eve.info.ErrorInfo\"!\n\x0bIoAddresses\x12\x12\n\nmacAddress\x18\x01 \x01(\t\"\xc9\x01\n\x11ZInfoManufacturer\x12\x14\n\x0cmanufacturer\x18\x01 \x01(\t\x12\x13\n\x0bproductName\x18\x02 \x01(\t\x12\x0f\n\x07version\x18\x03 \x01(\t\x12\x14\n\x0cserialNumber\x18\x04 \x01(\t\x12\x0c\n\x04UUID\x18\x05 \x01(\t\x12\x12\n\ncompatible\x18\x06 \x01(\t\x12\x12\n\nbiosVendor\x18\x07 \x01(\t\x12\x13\n\x0b\x62iosVersion\x18\x08 \x01(\t\x12\x17\n\x0f\x62iosReleaseDate\x18\t \x01(\t\"\x8c\x03\n\x0cZInfoNetwork\x12\x0f\n\x07macAddr\x18\x03 \x01(\t\x12\x0f\n\x07\x64\x65vName\x18\x04 \x01(\t\x12\r\n\x05\x61lias\x18( \x01(\t\x12\x0f\n\x07IPAddrs\x18\x05 \x03(\t\x12\x16\n\x0e\x64\x65\x66\x61ultRouters\x18\x06 \x03(\t\x12*\n\x03\x64ns\x18\x07 \x01(\x0b\x32\x1d.org.lfedge.eve.info.ZInfoDNS\x12\x0f\n\x07ipv4_up\x18\x08 \x01(\x08\x12-\n\x08location\x18\t \x01(\x0b\x32\x1b.org.lfedge.eve.info.GeoLoc\x12\x0e\n\x06uplink\x18\n \x01(\x08\x12\x32\n\nnetworkErr\x18\x0b \x01(\x0b\x32\x1e.org.lfedge.eve.info.ErrorInfo\x12\x11\n\tlocalName\x18\x0c \x01(\t\x12/\n\x05proxy\x18\r \x01(\x0b\x32 .org.lfedge.eve.info.ProxyStatus\x12\x19\n\x11ip_addr_mis_match\x18\x0e \x01(\x08\x12\x13\n\x0bntp_servers\x18\x0f \x03(\t\"\x87\x01\n\x06GeoLoc\x12\x12\n\nUnderlayIP\x18\x01 \x01(\t\x12\x10\n\x08Hostname\x18\x02 \x01(\t\x12\x0c\n\x04\x43ity\x18\x03 \x01(\t\x12\x0e\n\x06Region\x18\x04 \x01(\t\x12\x0f\n\x07\x43ountry\x18\x05 \x01(\t\x12\x0b\n\x03Loc\x18\x06 \x01(\t\x12\x0b\n\x03Org\x18\x07 \x01(\t\x12\x0e\n\x06Postal\x18\x08 \x01(\t\"D\n\x08ZInfoDNS\x12\x12\n\nDNSservers\x18\x01 \x03(\t\x12\x11\n\tDNSdomain\x18\x02 \x01(\t\x12\x11\n\tDNSsearch\x18\x03 \x03(\t\"\xa5\x01\n\x07ZInfoSW\x12\x11\n\tswVersion\x18\x02 \x01(\t\x12\x0e\n\x06swHash\x18\x03 \x01(\t\x12,\n\x05state\x18\x04 \x01(\x0e\x32\x1d.org.lfedge.eve.info.ZSwState\x12\x0e\n\x06target\x18\x06 \x01(\t\x12\x0c\n\x04vdev\x18\x07 \x01(\t\x12\x18\n\x10\x64ownloadProgress\x18\x08 \x01(\r\x12\x11\n\timageName\x18\t \x01(\t\"\xce\x01\n\tErrorInfo\x12\x13\n\x0b\x64\x65scription\x18\x01 \x01(\t\x12-\n\ttimestamp\x18\x02 \x01(\x0b\x32\x1a.google.protobuf.Timestamp\x12/\n\x08severity\x18\x03 \x01(\x0e\x32\x1d.org.lfedge.eve.info.Severity\x12\x33\n\x08\x65ntities\x18\x04 \x03(\x0b\x32!.org.lfedge.eve.info.DeviceEntity\x12\x17\n\x0fretry_condition\x18\x05 \x01(\t\"N\n\x0c\x44\x65viceEntity\x12+\n\x06\x65ntity\x18\x01 \x01(\x0e\x32\x1b.org.lfedge.eve.info.Entity\x12\x11\n\tentity_id\x18\x02 \x01(\t\"\xb8\x01\n\tVaultInfo\x12\x0c\n\x04name\x18\x01 \x01(\t\x12\x38\n\x06status\x18\x02 \x01(\x0e\x32(.org.lfedge.eve.info.DataSecAtRestStatus\x12\x30\n\x08vaultErr\x18\x03 \x01(\x0b\x32\x1e.org.lfedge.eve.info.ErrorInfo\x12\x31\n\tpcrStatus\x18\x04 \x01(\x0e\x32\x1e.org.lfedge.eve.info.PCRStatus\"\x8a\x01\n\rDataSecAtRest\x12\x38\n\x06status\x18\x01 \x01(\x0e\x32(.org.lfedge.eve.info.DataSecAtRestStatus\x12\x0c\n\x04info\x18\x02 \x01(\t\x12\x31\n\tvaultList\x18\x03 \x03(\x0b\x32\x1e.org.lfedge.eve.info.VaultInfo\"<\n\x0cSecurityInfo\x12\x13\n\x0bsha_root_ca\x18\x01 \x01(\x0c\x12\x17\n\x0fsha_tls_root_ca\x18\x02 \x01(\x0c\"/\n\x0fZInfoConfigItem\x12\r\n\x05value\x18\x01 \x01(\t\x12\r\n\x05\x65rror\x18\x02 \x01(\t\"\x84\x03\n\x15ZInfoConfigItemStatus\x12P\n\x0b\x63onfigItems\x18\x01 \x03(\x0b\x32;.org.lfedge.eve.info.ZInfoConfigItemStatus.ConfigItemsEntry\x12^\n\x12unknownConfigItems\x18\x02 \x03(\x0b\x32\x42.org.lfedge.eve.info.ZInfoConfigItemStatus.UnknownConfigItemsEntry\x1aX\n\x10\x43onfigItemsEntry\x12\x0b\n\x03key\x18\x01 \x01(\t\x12\x33\n\x05value\x18\x02 \x01(\x0b\x32$.org.lfedge.eve.info.ZInfoConfigItem:\x02\x38\x01\x1a_\n\x17UnknownConfigItemsEntry\x12\x0b\n\x03key\x18\x01 \x01(\t\x12\x33\n\x05value\x18\x02 \x01(\x0b\x32$.org.lfedge.eve.info.ZInfoConfigItem:\x02\x38\x01\"B\n\x10ZInfoAppInstance\x12\x0c\n\x04uuid\x18\x01 \x01(\t\x12\x0c\n\x04name\x18\x02 \x01(\t\x12\x12\n\ndomainName\x18\x03 \x01(\t\"3\n\x10ZInfoDeviceTasks\x12\x0c\n\x04name\x18\x01 \x01(\t\x12\x11\n\tnamespace\x18\x02 \x01(\t\"\x86\x01\n\x0cZSimcardInfo\x12\x0c\n\x04name\x18\x01 \x01(\t\x12\x18\n\x10\x63\x65ll_module_name\x18\x02 \x01(\t\x12\x0c\n\x04imsi\x18\x03 \x01(\t\x12\r\n\x05iccid\x18\x04 \x01(\t\x12\x31\n\x05state\x18\x05 \x01(\x0e\x32\".org.lfedge.eve.info.ZSimcardState\"\xea\x01\n\x13ZCellularModuleInfo\x12\x0c\n\x04name\x18\x01 \x01(\t\x12\x0c\n\x04imei\x18\x02 \x01(\t\x12\x18\n\x10\x66irmware_version\x18\x03 \x01(\t\x12\r\n\x05model\x18\x04 \x01(\t\x12\x45\n\x0foperating_state\x18\x05 \x01(\x0e\x32,.org.lfedge.eve.info.ZCellularOperatingState\x12G\n\x10\x63ontrol_protocol\x18\x06 \x01(\x0e\x32-.org.lfedge.eve.info.ZCellularControlProtocol\"`\n\x11ZCellularProvider\x12\x0c\n\x04plmn\x18\x01 \x01(\t\x12\x13\n\x0b\x64\x65scription\x18\x02 \x01(\t\x12\x17\n\x0f\x63urrent_serving\x18\x03 \x01(\x08\x12\x0f\n\x07roaming\x18\x04 \x01(\x08\"\xc0\x01\n\x10StorageDiskState\x12\x39\n\tdisk_name\x18\x01 \x01(\x0b\x32&.org.lfedge.eve.common.DiskDescription\x12\x32\n\x06status\x18\x02 \x01(\x0e\x32\".org.lfedge.eve.info.StorageStatus\x12\x13\n\x0bresilvering\x18\x03 \x01(\x08\x12\x19\n\x11resilver_progress\x18\x04 \x01(\r\x12\r\n\x05spare\x18\x05 \x01(\x08\"\xaf\x02\n\x11StorageScanStatus\x12\x32\n\x04type\x18\x01 \x01(\x0e\x32$.org.lfedge.eve.info.StorageScanType\x12\x34\n\x05state\x18\x02 \x01(\x0e\x32%.org.lfedge.eve.info.StorageScanState\x12.\n\nstart_time\x18\x03 \x01(\x0b\x32\x1a.google.protobuf.Timestamp\x12,\n\x08\x65nd_time\x18\x04 \x01(\x0b\x32\x1a.google.protobuf.Timestamp\x12\x18\n\x10to_examine_bytes\x18\x05 \x01(\x04\x12\x16\n\x0e\x65xamined_bytes\x18\x06 \x01(\x04\x12\x10\n\x08progress\x18\x07 \x01(\r\x12\x0e\n\x06\x65rrors\x18\x08 \x01(\x04\"m\n\tSmartAttr\x12\n\n\x02id\x18\x01 \x01(\r\x12\r\n\x05value\x18\x02 \x01(\x04\x12\r\n\x05worst\x18\x03 \x01(\x04\x12\x0e\n\x06thresh\x18\x04 \x01(\x04\x12\x13\n\x0bwhen_failed\x18\x05 \x01(\t\x12\x11\n\traw_value\x18\x06 \x01(\x04\"\x8a\x03\n\x0bSmartMetric\x12=\n\x15reallocated_sector_ct\x18\x01 \x01(\x0b\x32\x1e.org.lfedge.eve.info.SmartAttr\x12\x36\n\x0epower_on_hours\x18\x02 \x01(\x0b\x32\x1e.org.lfedge.eve.info.SmartAttr\x12\x39\n\x11power_cycle_count\x18\x03 \x01(\x0b\x32\x1e.org.lfedge.eve.info.SmartAttr\x12?\n\x17reallocated_event_count\x18\x04 \x01(\x0b\x32\x1e.org.lfedge.eve.info.SmartAttr\x12>\n\x16\x63urrent_pending_sector\x18\x05 \x01(\x0b\x32\x1e.org.lfedge.eve.info.SmartAttr\x12\x13\n\x0bneed_update\x18\x06 \x01(\x08\x12\x33\n\x0btemperature\x18\x07 \x01(\x0b\x32\x1e.org.lfedge.eve.info.SmartAttr\"\xa7\x01\n\x0fStorageDiskInfo\x12\x11\n\tdisk_name\x18\x01 \x01(\t\x12\x34\n\nsmart_data\x18\x03 \x03(\x0b\x32 .org.lfedge.eve.info.SmartMetric\x12\x0b\n\x03wwn\x18\x04 \x01(\t\x12\x15\n\rserial_number\x18\x05 \x01(\t\x12\r\n\x05model\x18\x06 \x01(\t\x12\x18\n\x10\x63ollector_errors\x18\x07 \x01(\t\"\xd1\x01\n\x0fStorageChildren\x12:\n\x0c\x63urrent_raid\x18\x01 \x01(\x0e\x32$.org.lfedge.eve.info.StorageRaidType\x12\x34\n\x05\x64isks\x18\x02 \x03(\x0b\x32%.org.lfedge.eve.info.StorageDiskState\x12\x36\n\x08\x63hildren\x18\x03 \x03(\x0b\x32$.org.lfedge.eve.info.StorageChildren\x12\x14\n\x0c\x64isplay_name\x18\x04 \x01(\t\"\xa1\x04\n\x0bStorageInfo\x12\x11\n\tpool_name\x18\x01 \x01(\t\x12:\n\x0cstorage_type\x18\x02 \x01(\x0e\x32$.org.lfedge.eve.info.StorageTypeInfo\x12\x13\n\x0bzfs_version\x18\x03 \x01(\t\x12:\n\x0c\x63urrent_raid\x18\x04 \x01(\x0e\x32$.org.lfedge.eve.info.StorageRaidType\x12\x19\n\x11\x63ompression_ratio\x18\x05 \x01(\x01\x12\x12\n\nzpool_size\x18\x06 \x01(\x04\x12\x13\n\x0b\x63ount_zvols\x18\x07 \x01(\r\x12\x39\n\rstorage_state\x18\x08 \x01(\x0e\x32\".org.lfedge.eve.info.StorageStatus\x12\x34\n\x05\x64isks\x18\t \x03(\x0b\x32%.org.lfedge.eve.info.StorageDiskState\x12\x18\n\x10\x63ollector_errors\x18\n \x01(\t\x12\x36\n\x08\x63hildren\x18\x0b \x03(\x0b\x32$.org.lfedge.eve.info.StorageChildren\x12\x34\n\x04scan\x18\x0c \x01(\x0b\x32&.org.lfedge.eve.info.StorageScanStatus\x12\x35\n\x06spares\x18\r \x03(\x0b\x32%.org.lfedge.eve.info.StorageDiskState\"D\n\rZInfoHardware\x12\x33\n\x05\x64isks\x18\x01 \x03(\x0b\x32$.org.lfedge.eve.info.StorageDiskInfo\"\x96\x10\n\x0bZInfoDevice\x12\x13\n\x0bmachineArch\x18\x04 \x01(\t\x12\x0f\n\x07\x63puArch\x18\x05 \x01(\t\x12\x10\n\x08platform\x18\x06 \x01(\t\x12\x0c\n\x04ncpu\x18\x07 \x01(\r\x12\x0e\n\x06memory\x18\x08 \x01(\x04\x12\x0f\n\x07storage\x18\t \x01(\x04\x12\x19\n\x11powerCycleCounter\x18\n \x01(\x03\x12\x35\n\x05minfo\x18\x0b \x01(\x0b\x32&.org.lfedge.eve.info.ZInfoManufacturer\x12\x32\n\x07network\x18\r \x03(\x0b\x32!.org.lfedge.eve.info.ZInfoNetwork\x12:\n\x12\x61ssignableAdapters\x18\x0f \x03(\x0b\x32\x1e.org.lfedge.eve.info.ZioBundle\x12*\n\x03\x64ns\x18\x10 \x01(\x0b\x32\x1d.org.lfedge.eve.info.ZInfoDNS\x12\x36\n\x0bstorageList\x18\x11 \x03(\x0b\x32!.org.lfedge.eve.info.ZInfoStorage\x12,\n\x08\x62ootTime\x18\x12 \x01(\x0b\x32\x1a.google.protobuf.Timestamp\x12/\n\x06swList\x18\x13 \x03(\x0b\x32\x1f.org.lfedge.eve.info.ZInfoDevSW\x12\x10\n\x08HostName\x18\x14 \x01(\t\x12>\n\x0bmetricItems\x18\x15 \x03(\x0b\x32).org.lfedge.eve.info.deprecatedMetricItem\x12\x18\n\x10lastRebootReason\x18\x16 \x01(\t\x12\x32\n\x0elastRebootTime\x18\x17 \x01(\x0b\x32\x1a.google.protobuf.Timestamp\x12=\n\rsystemAdapter\x18\x18 \x01(\x0b\x32&.org.lfedge.eve.info.SystemAdapterInfo\x12\x16\n\x0erestartCounter\x18\x19 \x01(\r\x12>\n\tHSMStatus\x18\x1a \x01(\x0e\x32+.org.lfedge.eve.info.HwSecurityModuleStatus\x12\x0f\n\x07HSMInfo\x18\x1b \x01(\t\x12\x17\n\x0flastRebootStack\x18\x1c \x01(\t\x12=\n\x11\x64\x61taSecAtRestInfo\x18\x1d \x01(\x0b\x32\".org.lfedge.eve.info.DataSecAtRest\x12\x33\n\x08sec_info\x18\x1e \x01(\x0b\x32!.org.lfedge.eve.info.SecurityInfo\x12\x44\n\x10\x63onfigItemStatus\x18\x1f \x01(\x0b\x32*.org.lfedge.eve.info.ZInfoConfigItemStatus\x12;\n\x0c\x61ppInstances\x18  \x03(\x0b\x32%.org.lfedge.eve.info.ZInfoAppInstance\x12\x1b\n\x13rebootConfigCounter\x18! \x01(\r\x12\x39\n\x10last_boot_reason\x18\" \x01(\x0e\x32\x1f.org.lfedge.eve.info.BootReason\x12=\n\x0b\x63\x65ll_radios\x18# \x03(\x0b\x32(.org.lfedge.eve.info.ZCellularModuleInfo\x12/\n\x04sims\x18$ \x03(\x0b\x32!.org.lfedge.eve.info.ZSimcardInfo\x12\x34\n\x05tasks\x18% \x03(\x0b\x32%.org.lfedge.eve.info.ZInfoDeviceTasks\x12\x18\n\x10maintenance_mode\x18& \x01(\x08\x12K\n\x17maintenance_mode_reason\x18\' \x01(\x0e\x32*.org.lfedge.eve.info.MaintenanceModeReason\x12!\n\x19hardware_watchdog_present\x18( \x01(\x08\x12\x19\n\x11reboot_inprogress\x18) \x01(\x08\x12\x37\n\x0c\x63\x61pabilities\x18* \x01(\x0b\x32!.org.lfedge.eve.info.Capabilities\x12\x1d\n\x15\x62\x61seos_update_counter\x18+ \x01(\r\x12\x30\n\x05state\x18, \x01(\x0e\x32!.org.lfedge.eve.info.ZDeviceState\x12\x15\n\rlocal_profile\x18- \x01(\t\x12L\n\x18maintenance_mode_reasons\x18. \x03(\x0e\x32*.org.lfedge.eve.info.MaintenanceModeReason\x12\x18\n\x0c\x64ormant_time\x18/ \x01(\tB\x02\x18\x01\x12\x36\n\x0cstorage_info\x18\x30 \x03(\x0b\x32 .org.lfedge.eve.info.StorageInfo\x12\x1f\n\x17shutdown_config_counter\x18\x31 \x01(\r\x12>\n\x10\x61ttestation_info\x18\x32 \x01(\x0b\x32$.org.lfedge.eve.info.AttestationInfo\x12:\n\x0e\x61pi_capability\x18\x33 \x01(\x0e\x32\".org.lfedge.eve.info.APICapability\x12\x42\n\x10local_operations\x18\x34 \x01(\x0b\x32(.org.lfedge.eve.info.LocalOperationsInfo\"\x95\x02\n\x14LocalOperationRecord\x12(\n\x04time\x18\x01 \x01(\x0b\x32\x1a.google.protobuf.Timestamp\x12\x14\n\x0coperation_id\x18\x02 \x01(\t\x12\x1b\n\x13operation_timestamp\x18\x03 \x01(\x04\x12\x35\n\x04type\x18\x04 \x01(\x0e\x32\'.org.lfedge.eve.info.LocalOperationType\x12\x0e\n\x06target\x18\x05 \x01(\t\x12\x0f\n\x07\x64\x65tails\x18\x06 \x01(\t\x12\x39\n\x06result\x18\x07 \x01(\x0e\x32).org.lfedge.eve.info.LocalOperationResult\x12\r\n\x05\x65rror\x18\x08 \x01(\t\"f\n\x13LocalOperationsInfo\x12\x13\n\x0b\x61pi_version\x18\x01 \x01(\r\x12:\n\x07records\x18\x02 \x03(\x0b\x32).org.lfedge.eve.info.LocalOperationRecord\"v\n\x0f\x41ttestationInfo\x12\x34\n\x05state\x18\x01 \x01(\x0e\x32%.org.lfedge.eve.info.AttestationState\x12-\n\x05\x65rror\x18\x02 \x01(\x0b\x32\x1e.org.lfedge.eve.info.ErrorInfo\"`\n\x11SystemAdapterInfo\x12\x14\n\x0c\x63urrentIndex\x18\x01 \x01(\r\x12\x35\n\x06status\x18\x02 \x03(\x0b\x32%.org.lfedge.eve.info.DevicePortStatus\"\x88\x02\n\x10\x44\x65vicePortStatus\x12\x0f\n\x07version\x18\x01 \x01(\r\x12\x0b\n\x03key\x18\x02 \x01(\t\x12\x30\n\x0ctimePriority\x18\x03 \x01(\x0b\x32\x1a.google.protobuf.Timestamp\x12.\n\nlastFailed\x18\x04 \x01(\x0b\x32\x1a.google.protobuf.Timestamp\x12\x31\n\rlastSucceeded\x18\x05 \x01(\x0b\x32\x1a.google.protobuf.Timestamp\x12.\n\x05ports\x18\x06 \x03(\x0b\x32\x1f.org.lfedge.eve.info.DevicePort\x12\x11\n\tlastError\x18\x07 \x01(\t\"\xfb\x04\n\nDevicePort\x12\x0e\n\x06ifname\x18\x01 \x01(\t\x12\x0c\n\x04name\x18\x02 \x01(\t\x12\x0e\n\x06isMgmt\x18\x03 \x01(\x08\x12\x0c\n\x04\x66ree\x18\x04 \x01(\x08\x12\x10\n\x08\x64hcpType\x18\x0b \x01(\r\x12\x0e\n\x06subnet\x18\x0c \x01(\t\x12\x0f\n\x07gateway\x18\r \x01(\t\x12\x12\n\ndomainname\x18\x0e \x01(\t\x12\x11\n\tntpServer\x18\x0f \x01(\t\x12\x12\n\ndnsServers\x18\x10 \x03(\t\x12\x14\n\x0c\x64hcpRangeLow\x18\x11 \x01(\t\x12\x15\n\rdhcpRangeHigh\x18\x12 \x01(\t\x12/\n\x05proxy\x18\x15 \x01(\x0b\x32 .org.lfedge.eve.info.ProxyStatus\x12\x0f\n\x07macAddr\x18\x16 \x01(\t\x12\x0f\n\x07IPAddrs\x18\x17 \x03(\t\x12\x16\n\x0e\x64\x65\x66\x61ultRouters\x18\x18 \x03(\t\x12*\n\x03\x64ns\x18\x19 \x01(\x0b\x32\x1d.org.lfedge.eve.info.ZInfoDNS\x12\n\n\x02up\x18\x1a \x01(\x08\x12-\n\x08location\x18\x1b \x01(\x0b\x32\x1b.org.lfedge.eve.info.GeoLoc\x12+\n\x03\x65rr\x18\x1d \x01(\x0b\x32\x1e.org.lfedge.eve.info.ErrorInfo\x12\x36\n\x05usage\x18\x1e \x01(\x0e\x32\'.org.lfedge.eve.common.PhyIoMemberUsage\x12\x13\n\x0bnetworkUUID\x18\x1f \x01(\t\x12\x0c\n\x04\x63ost\x18  \x01(\r\x12<\n\x0fwireless_status\x18! \x01(\x0b\x32#.org.lfedge.eve.info.WirelessStatus\"\xaa\x01\n\x0bProxyStatus\x12\x30\n\x07proxies\x18\x01 \x03(\x0b\x32\x1f.org.lfedge.eve.info.ProxyEntry\x12\x12\n\nexceptions\x18\x02 \x01(\t\x12\x0f\n\x07pacfile\x18\x03 \x01(\t\x12\x1a\n\x12networkProxyEnable\x18\x04 \x01(\x08\x12\x17\n\x0fnetworkProxyURL\x18\x05 \x01(\t\x12\x0f\n\x07wpadURL\x18\x06 \x01(\t\"8\n\nProxyEntry\x12\x0c\n\x04type\x18\x01 \x01(\r\x12\x0e\n\x06server\x18\x02 \x01(\t\x12\x0c\n\x04port\x18\x03 \x01(\r\"y\n\x0eWirelessStatus\x12/\n\x04type\x18\x01 \x01(\x0e\x32!.org.lfedge.eve.info.WirelessType\x12\x36\n\x08\x63\x65llular\x18\x02 \x01(\x0b\x32$.org.lfedge.eve.info.ZCellularStatus\"\xf9\x01\n\x0fZCellularStatus\x12\x17\n\x0f\x63\x65llular_module\x18\x01 \x01(\t\x12\x11\n\tsim_cards\x18\x02 \x03(\t\x12\x39\n\tproviders\x18\x03 \x03(\x0b\x32&.org.lfedge.eve.info.ZCellularProvider\x12\x14\n\x0c\x63onfig_error\x18\n \x01(\t\x12\x13\n\x0bprobe_error\x18\x0b \x01(\t\x12\x17\n\x0f\x61\x63tive_sim_slot\x18\x04 \x01(\r\x12;\n\ndata_usage\x18\x05 \x03(\x0b\x32\'.org.lfedge.eve.info.ZCellularDataUsage\"\xd2\x02\n\x12ZCellularDataUsage\x12\x10\n\x08sim_slot\x18\x01 \x01(\r\x12\r\n\x05iccid\x18\x02 \x01(\t\x12/\n\x0b\x63ycle_start\x18\x03 \x01(\x0b\x32\x1a.google.protobuf.Timestamp\x12-\n\tcycle_end\x18\x04 \x01(\x0b\x32\x1a.google.protobuf.Timestamp\x12\x10\n\x08rx_bytes\x18\x05 \x01(\x04\x12\x10\n\x08tx_bytes\x18\x06 \x01(\x04\x12\x13\n\x0blimit_bytes\x18\x07 \x01(\x04\x12=\n\x0bquota_state\x18\x08 \x01(\x0e\x32(.org.lfedge.eve.info.ZCellularQuotaState\x12\x43\n\x0f\x62locked_traffic\x18\t \x03(\x0e\x32*.org.lfedge.eve.info.ZCellularTrafficClass\"\xac\x03\n\nZInfoDevSW\x12\x11\n\tactivated\x18\x02 \x01(\x08\x12\x16\n\x0epartitionLabel\x18\x03 \x01(\t\x12\x17\n\x0fpartitionDevice\x18\x04 \x01(\t\x12\x16\n\x0epartitionState\x18\x05 \x01(\t\x12-\n\x06status\x18\x06 \x01(\x0e\x32\x1d.org.lfedge.eve.info.ZSwState\x12\x14\n\x0cshortVersion\x18\x07 \x01(\t\x12\x13\n\x0blongVersion\x18\x08 \x01(\t\x12-\n\x05swErr\x18\t \x01(\x0b\x32\x1e.org.lfedge.eve.info.ErrorInfo\x12\x18\n\x10\x64ownloadProgress\x18\n \x01(\r\x12\x35\n\nuserStatus\x18\x0b \x01(\x0e\x32!.org.lfedge.eve.info.BaseOsStatus\x12\x14\n\x0csubStatusStr\x18\x0c \x01(\t\x12\x37\n\tsubStatus\x18\r \x01(\x0e\x32$.org.lfedge.eve.info.BaseOsSubStatus\x12\x19\n\x11subStatusProgress\x18\x0e \x01(\r\"Y\n\x0cZInfoStorage\x12\x0e\n\x06\x64\x65vice\x18\x01 \x01(\t\x12\x11\n\tmountPath\x18\x02 \x01(\t\x12\r\n\x05total\x18\x03 \x01(\x04\x12\x17\n\x0fstorageLocation\x18\x04 \x01(\x08\"\xdf\x05\n\x08ZInfoApp\x12\r\n\x05\x41ppID\x18\x01 \x01(\t\x12\x12\n\nappVersion\x18\x02 \x01(\t\x12\x11\n\tsystemApp\x18\x06 \x01(\x08\x12\x0f\n\x07\x41ppName\x18\x07 \x01(\t\x12\x32\n\x0csoftwareList\x18\x08 \x03(\x0b\x32\x1c.org.lfedge.eve.info.ZInfoSW\x12,\n\x08\x62ootTime\x18\x0c \x01(\x0b\x32\x1a.google.protobuf.Timestamp\x12\x38\n\x10\x61ssignedAdapters\x18\r \x03(\x0b\x32\x1e.org.lfedge.eve.info.ZioBundle\x12.\n\x06\x61ppErr\x18\x0e \x03(\x0b\x32\x1e.org.lfedge.eve.info.ErrorInfo\x12,\n\x05state\x18\x0f \x01(\x0e\x32\x1d.org.lfedge.eve.info.ZSwState\x12\x32\n\x07network\x18\x10 \x03(\x0b\x32!.org.lfedge.eve.info.ZInfoNetwork\x12\x12\n\nvolumeRefs\x18\x11 \x03(\t\x12=\n\x19\x61\x63tivation_deferred_until\x18\x12 \x01(\x0b\x32\x1a.google.protobuf.Timestamp\x12\x15\n\rrestart_count\x18\x13 \x01(\r\x12\x18\n\x10last_exit_reason\x18\x14 \x01(\t\x12\x32\n\x0elast_exit_time\x18\x15 \x01(\x0b\x32\x1a.google.protobuf.Timestamp\x12>\n\x0epod_containers\x18\x16 \x03(\x0b\x32&.org.lfedge.eve.info.ZInfoPodContainer\x12\x33\n\x06health\x18\x17 \x01(\x0b\x32#.org.lfedge.eve.info.ZInfoAppHealth\x12\x31\n\x05guest\x18\x18 \x01(\x0b\x32\".org.lfedge.eve.info.ZInfoAppGuest\"\xba\x01\n\x0eZInfoAppHealth\x12/\n\x05state\x18\x01 \x01(\x0e\x32 .org.lfedge.eve.info.HealthState\x12\x16\n\x0e\x66\x61iling_streak\x18\x02 \x01(\r\x12\x13\n\x0blast_output\x18\x03 \x01(\t\x12\x33\n\x0flast_check_time\x18\x04 \x01(\x0b\x32\x1a.google.protobuf.Timestamp\x12\x15\n\rrestart_count\x18\x05 \x01(\r\"J\n\x13ZInfoGuestInterface\x12\x0c\n\x04name\x18\x01 \x01(\t\x12\x13\n\x0bmac_address\x18\x02 \x01(\t\x12\x10\n\x08ip_addrs\x18\x03 \x03(\t\"a\n\x14ZInfoGuestFilesystem\x12\x12\n\nmountpoint\x18\x01 \x01(\t\x12\x0c\n\x04type\x18\x02 \x01(\t\x12\x13\n\x0btotal_bytes\x18\x03 \x01(\x04\x12\x12\n\nused_bytes\x18\x04 \x01(\x04\"\x8d\x02\n\rZInfoAppGuest\x12\x0f\n\x07os_name\x18\x01 \x01(\t\x12\x12\n\nos_version\x18\x02 \x01(\t\x12\x16\n\x0ekernel_release\x18\x03 \x01(\t\x12\x10\n\x08hostname\x18\x04 \x01(\t\x12<\n\ninterfaces\x18\x05 \x03(\x0b\x32(.org.lfedge.eve.info.ZInfoGuestInterface\x12>\n\x0b\x66ilesystems\x18\x06 \x03(\x0b\x32).org.lfedge.eve.info.ZInfoGuestFilesystem\x12/\n\x0bupdate_time\x18\x07 \x01(\x0b\x32\x1a.google.protobuf.Timestamp\"\xc7\x01\n\x11ZInfoPodContainer\x12\x0c\n\x04name\x18\x01 \x01(\t\x12,\n\x05state\x18\x02 \x01(\x0e\x32\x1d.org.lfedge.eve.info.ZSwState\x12\x11\n\texit_code\x18\x03 \x01(\x05\x12\x15\n\rrestart_count\x18\x04 \x01(\r\x12\x18\n\x10last_exit_reason\x18\x05 \x01(\t\x12\x32\n\x0elast_exit_time\x18\x06 \x01(\x0b\x32\x1a.google.protobuf.Timestamp\"D\n\x10ZInfoVpnLinkInfo\x12\r\n\x05spiId\x18\x01 \x01(\t\x12\x0e\n\x06subNet\x18\x02 \x01(\t\x12\x11\n\tdirection\x18\x03 \x01(\x08\"\xf9\x01\n\x0cZInfoVpnLink\x12\n\n\x02id\x18\x01 \x01(\t\x12\x0c\n\x04name\x18\x02 \x01(\t\x12\r\n\x05reqId\x18\x03 \x01(\t\x12\x10\n\x08instTime\x18\x04 \x01(\x04\x12\x0f\n\x07\x65spInfo\x18\x05 \x01(\t\x12\x31\n\x05state\x18\x06 \x01(\x0e\x32\".org.lfedge.eve.info.ZInfoVpnState\x12\x34\n\x05lInfo\x18\n \x01(\x0b\x32%.org.lfedge.eve.info.ZInfoVpnLinkInfo\x12\x34\n\x05rInfo\x18\x0b \x01(\x0b\x32%.org.lfedge.eve.info.ZInfoVpnLinkInfo\"<\n\x10ZInfoVpnEndPoint\x12\n\n\x02id\x18\x01 \x01(\t\x12\x0e\n\x06ipAddr\x18\x02 \x01(\t\x12\x0c\n\x04port\x18\x03 \x01(\r\"\xa9\x02\n\x0cZInfoVpnConn\x12\n\n\x02id\x18\x01 \x01(\t\x12\x0c\n\x04name\x18\x02 \x01(\t\x12\x0f\n\x07version\x18\x03 \x01(\t\x12\x0f\n\x07\x65stTime\x18\x04 \x01(\x04\x12\x0c\n\x04ikes\x18\x05 \x01(\t\x12\x31\n\x05state\x18\x06 \x01(\x0e\x32\".org.lfedge.eve.info.ZInfoVpnState\x12\x34\n\x05lInfo\x18\x07 \x01(\x0b\x32%.org.lfedge.eve.info.ZInfoVpnEndPoint\x12\x34\n\x05rInfo\x18\x08 \x01(\x0b\x32%.org.lfedge.eve.info.ZInfoVpnEndPoint\x12\x30\n\x05links\x18\n \x03(\x0b\x32!.org.lfedge.eve.info.ZInfoVpnLink\"z\n\x08ZInfoVpn\x12\x0e\n\x06upTime\x18\x01 \x01(\x04\x12\x13\n\x0bpolicyBased\x18\x02 \x01(\x08\x12\x18\n\x10listeningIpAddrs\x18\x03 \x03(\t\x12/\n\x04\x63onn\x18\n \x03(\x0b\x32!.org.lfedge.eve.info.ZInfoVpnConn\"\xd6\x05\n\x14ZInfoNetworkInstance\x12\x11\n\tnetworkID\x18\x02 \x01(\t\x12\x16\n\x0enetworkVersion\x18\x03 \x01(\t\x12\x10\n\x08instType\x18\x05 \x01(\r\x12\x13\n\x0b\x64isplayname\x18\x06 \x01(\t\x12\x11\n\tactivated\x18\x07 \x01(\x08\x12/\n\x0bupTimeStamp\x18\x08 \x01(\x0b\x32\x1a.google.protobuf.Timestamp\x12\x32\n\x0csoftwareList\x18\t \x01(\x0b\x32\x1c.org.lfedge.eve.info.ZInfoSW\x12\x19\n\x11\x43urrentUplinkIntf\x18\n \x01(\t\x12\x1a\n\x12\x43urrentUplinkAlias\x18\x0b \x01(\t\x12\x11\n\tbridgeNum\x18\x14 \x01(\r\x12\x12\n\nbridgeName\x18\x15 \x01(\t\x12\x14\n\x0c\x62ridgeIPAddr\x18\x16 \x01(\t\x12\x41\n\ripAssignments\x18\x17 \x03(\x0b\x32*.org.lfedge.eve.info.ZmetIPAssignmentEntry\x12\x14\n\x0c\x62ridgeIPSets\x18\x18 \x03(\t\x12.\n\x04vifs\x18\x19 \x03(\x0b\x32 .org.lfedge.eve.info.ZmetVifInfo\x12\x0f\n\x07ipv4Eid\x18\x1a \x01(\x08\x12\x38\n\x10\x61ssignedAdapters\x18\x1e \x03(\x0b\x32\x1e.org.lfedge.eve.info.ZioBundle\x12.\n\x05vinfo\x18\x1f \x01(\x0b\x32\x1d.org.lfedge.eve.info.ZInfoVpnH\x00\x12\x32\n\nnetworkErr\x18( \x03(\x0b\x32\x1e.org.lfedge.eve.info.ErrorInfo\x12\x39\n\x05state\x18) \x01(\x0e\x32*.org.lfedge.eve.info.ZNetworkInstanceStateB\r\n\x0bInfoContent\"\x89\x01\n\tUsageInfo\x12.\n\ncreateTime\x18\x01 \x01(\x0b\x32\x1a.google.protobuf.Timestamp\x12\x10\n\x08refCount\x18\x02 \x01(\r\x12:\n\x16lastRefcountChangeTime\x18\x03 \x01(\x0b\x32\x1a.google.protobuf.Timestamp\"=\n\x0fVolumeResources\x12\x14\n\x0cmaxSizeBytes\x18\x01 \x01(\x04\x12\x14\n\x0c\x63urSizeBytes\x18\x02 \x01(\x04\"\x97\x03\n\x0bZInfoVolume\x12\x0c\n\x04uuid\x18\x01 \x01(\t\x12\x13\n\x0b\x64isplayName\x18\x02 \x01(\t\x12-\n\x05usage\x18\x03 \x01(\x0b\x32\x1e.org.lfedge.eve.info.UsageInfo\x12\x37\n\tresources\x18\x04 \x01(\x0b\x32$.org.lfedge.eve.info.VolumeResources\x12,\n\x05state\x18\x05 \x01(\x0e\x32\x1d.org.lfedge.eve.info.ZSwState\x12\x1a\n\x12progressPercentage\x18\x06 \x01(\r\x12\x31\n\tvolumeErr\x18\x07 \x01(\x0b\x32\x1e.org.lfedge.eve.info.ErrorInfo\x12\x18\n\x10generation_count\x18\x08 \x01(\x03\x12\x35\n\x06\x62\x61\x63kup\x18\t \x01(\x0b\x32%.org.lfedge.eve.info.VolumeBackupInfo\x12/\n\x03key\x18\n \x01(\x0b\x32\".org.lfedge.eve.info.VolumeKeyInfo\"\x85\x01\n\rVolumeKeyInfo\x12\x12\n\ngeneration\x18\x01 \x01(\r\x12\x31\n\rlast_rotation\x18\x02 \x01(\x0b\x32\x1a.google.protobuf.Timestamp\x12-\n\x05\x65rror\x18\x03 \x01(\x0b\x32\x1e.org.lfedge.eve.info.ErrorInfo\"\xc3\x02\n\x10VolumeBackupInfo\x12\x35\n\x05state\x18\x01 \x01(\x0e\x32&.org.lfedge.eve.info.VolumeBackupState\x12\x11\n\tlast_name\x18\x02 \x01(\t\x12\x13\n\x0blast_sha256\x18\x03 \x01(\t\x12-\n\tlast_time\x18\x04 \x01(\x0b\x32\x1a.google.protobuf.Timestamp\x12\x18\n\x10last_incremental\x18\x05 \x01(\x08\x12\x17\n\x0flast_size_bytes\x18\x06 \x01(\x04\x12-\n\tnext_time\x18\x07 \x01(\x0b\x32\x1a.google.protobuf.Timestamp\x12\x10\n\x08progress\x18\x08 \x01(\r\x12-\n\x05\x65rror\x18\t \x01(\x0b\x32\x1e.org.lfedge.eve.info.ErrorInfo\"(\n\x10\x43ontentResources\x12\x14\n\x0c\x63urSizeBytes\x18\x01 \x01(\x04\"\xbf\x03\n\x10ZInfoContentTree\x12\x0c\n\x04uuid\x18\x01 \x01(\t\x12\x13\n\x0b\x64isplayName\x18\x02 \x01(\t\x12\x0e\n\x06sha256\x18\x03 \x01(\t\x12\x38\n\tresources\x18\x04 \x01(\x0b\x32%.org.lfedge.eve.info.ContentResources\x12-\n\x05usage\x18\x05 \x01(\x0b\x32\x1e.org.lfedge.eve.info.UsageInfo\x12,\n\x05state\x18\x06 \x01(\x0e\x32\x1d.org.lfedge.eve.info.ZSwState\x12\x1a\n\x12progressPercentage\x18\x07 \x01(\r\x12+\n\x03\x65rr\x18\x08 \x01(\x0b\x32\x1e.org.lfedge.eve.info.ErrorInfo\x12\x18\n\x10\x63omponentShaList\x18\t \x03(\t\x12\x18\n\x10generation_count\x18\n \x01(\x03\x12\'\n\x03\x65ta\x18\x0b \x01(\x0b\x32\x1a.google.protobuf.Timestamp\x12;\n\x17\x64ownload_deferred_until\x18\x0c \x01(\x0b\x32\x1a.google.protobuf.Timestamp\"\xfb\x01\n\tZInfoBlob\x12\x0e\n\x06sha256\x18\x01 \x01(\t\x12\x38\n\tresources\x18\x02 \x01(\x0b\x32%.org.lfedge.eve.info.ContentResources\x12-\n\x05usage\x18\x03 \x01(\x0b\x32\x1e.org.lfedge.eve.info.UsageInfo\x12,\n\x05state\x18\x04 \x01(\x0e\x32\x1d.org.lfedge.eve.info.ZSwState\x12\x1a\n\x12progressPercentage\x18\x05 \x01(\r\x12+\n\x03\x65rr\x18\x06 \x01(\x0b\x32\x1e.org.lfedge.eve.info.ErrorInfo\"=\n\rZInfoBlobList\x12,\n\x04\x62lob\x18\x01 \x03(\x0b\x32\x1e.org.lfedge.eve.info.ZInfoBlob\"\xaa\x05\n\x08ZInfoMsg\x12.\n\x05ztype\x18\x01 \x01(\x0e\x32\x1f.org.lfedge.eve.info.ZInfoTypes\x12\r\n\x05\x64\x65vId\x18\x02 \x01(\t\x12\x31\n\x05\x64info\x18\x03 \x01(\x0b\x32 .org.lfedge.eve.info.ZInfoDeviceH\x00\x12.\n\x05\x61info\x18\x05 \x01(\x0b\x32\x1d.org.lfedge.eve.info.ZInfoAppH\x00\x12;\n\x06niinfo\x18\x0c \x01(\x0b\x32).org.lfedge.eve.info.ZInfoNetworkInstanceH\x00\x12\x31\n\x05vinfo\x18\r \x01(\x0b\x32 .org.lfedge.eve.info.ZInfoVolumeH\x00\x12\x36\n\x05\x63info\x18\x0e \x01(\x0b\x32%.org.lfedge.eve.info.ZInfoContentTreeH\x00\x12\x33\n\x05\x62info\x18\x0f \x01(\x0b\x32\".org.lfedge.eve.info.ZInfoBlobListH\x00\x12<\n\x07\x61mdinfo\x18\x10 \x01(\x0b\x32).org.lfedge.eve.info.ZInfoAppInstMetaDataH\x00\x12\x34\n\x06\x65vinfo\x18\x11 \x01(\x0b\x32\".org.lfedge.eve.info.ZInfoEdgeviewH\x00\x12\x34\n\x06hwinfo\x18\x12 \x01(\x0b\x32\".org.lfedge.eve.info.ZInfoHardwareH\x00\x12\x35\n\x07locinfo\x18\x13 \x01(\x0b\x32\".org.lfedge.eve.info.ZInfoLocationH\x00\x12/\n\x0b\x61tTimeStamp\x18\x06 \x01(\x0b\x32\x1a.google.protobuf.TimestampB\r\n\x0bInfoContent\"J\n\x0c\x43\x61pabilities\x12 \n\x18HWAssistedVirtualization\x18\x02 \x01(\x08\x12\x18\n\x10IOVirtualization\x18\x03 \x01(\x08\"j\n\x14ZInfoAppInstMetaData\x12\x0c\n\x04uuid\x18\x01 \x01(\t\x12\x36\n\x04type\x18\x02 \x01(\x0e\x32(.org.lfedge.eve.info.AppInstMetaDataType\x12\x0c\n\x04\x64\x61ta\x18\x03 \x01(\x0c\"\x98\x01\n\rZInfoEdgeview\x12/\n\x0b\x65xpire_time\x18\x01 \x01(\x0b\x32\x1a.google.protobuf.Timestamp\x12\x30\n\x0cstarted_time\x18\x02 \x01(\x0b\x32\x1a.google.protobuf.Timestamp\x12\x11\n\tcount_dev\x18\x03 \x01(\r\x12\x11\n\tcount_app\x18\x04 \x01(\r\"\xd5\x02\n\rZInfoLocation\x12\x10\n\x08latitude\x18\x01 \x01(\x01\x12\x11\n\tlongitude\x18\x02 \x01(\x01\x12\x10\n\x08\x61ltitude\x18\x03 \x01(\x01\x12\x31\n\rutc_timestamp\x18\x04 \x01(\x0b\x32\x1a.google.protobuf.Timestamp\x12\x43\n\x16horizontal_reliability\x18\x05 \x01(\x0e\x32#.org.lfedge.eve.info.LocReliability\x12\x41\n\x14vertical_reliability\x18\x06 \x01(\x0e\x32#.org.lfedge.eve.info.LocReliability\x12\x1e\n\x16horizontal_uncertainty\x18\x07 \x01(\x02\x12\x1c\n\x14vertical_uncertainty\x18\x08 \x01(\x02\x12\x14\n\x0clogicallabel\x18\t \x01(\t*u\n\x11\x44\x65pMetricItemType\x12\x16\n\x12\x44\x65pMetricItemOther\x10\x00\x12\x16\n\x12\x44\x65pMetricItemGauge\x10\x01\x12\x18\n\x14\x44\x65pMetricItemCounter\x10\x02\x12\x16\n\x12\x44\x65pMetricItemState\x10\x03*\xbf\x01\n\nZInfoTypes\x12\t\n\x05ZiNop\x10\x00\x12\x0c\n\x08ZiDevice\x10\x01\x12\t\n\x05ZiApp\x10\x03\x12\x15\n\x11ZiNetworkInstance\x10\x06\x12\x0c\n\x08ZiVolume\x10\x07\x12\x11\n\rZiContentTree\x10\x08\x12\x0e\n\nZiBlobList\x10\t\x12\x15\n\x11ZiAppInstMetaData\x10\n\x12\x0e\n\nZiHardware\x10\x0b\x12\x0e\n\nZiEdgeview\x10\x0c\x12\x0e\n\nZiLocation\x10\r*\x84\x03\n\x08ZSwState\x12\x0b\n\x07INVALID\x10\x00\x12\x0b\n\x07INITIAL\x10\x01\x12\x14\n\x10\x44OWNLOAD_STARTED\x10\x02\x12\x0e\n\nDOWNLOADED\x10\x03\x12\r\n\tDELIVERED\x10\x04\x12\r\n\tINSTALLED\x10\x05\x12\x0b\n\x07\x42OOTING\x10\x06\x12\x0b\n\x07RUNNING\x10\x07\x12\x0b\n\x07HALTING\x10\x08\x12\n\n\x06HALTED\x10\t\x12\x0e\n\nRESTARTING\x10\n\x12\x0b\n\x07PURGING\x10\x0b\x12\x11\n\rRESOLVING_TAG\x10\x0c\x12\x10\n\x0cRESOLVED_TAG\x10\r\x12\x13\n\x0f\x43REATING_VOLUME\x10\x0e\x12\x12\n\x0e\x43REATED_VOLUME\x10\x0f\x12\r\n\tVERIFYING\x10\x10\x12\x0c\n\x08VERIFIED\x10\x11\x12\x0b\n\x07LOADING\x10\x12\x12\n\n\x06LOADED\x10\x13\x12\x18\n\x14\x41WAITNETWORKINSTANCE\x10\x14\x12\t\n\x05\x45RROR\x10\x15\x12\x11\n\rSTART_DELAYED\x10\x16\x12\x0e\n\nCRASH_LOOP\x10\x17*\x99\x02\n\x06\x45ntity\x12\x16\n\x12\x45NTITY_UNSPECIFIED\x10\x00\x12\x12\n\x0e\x45NTITY_BASE_OS\x10\x01\x12\x19\n\x15\x45NTITY_SYSTEM_ADAPTER\x10\x02\x12\x10\n\x0c\x45NTITY_VAULT\x10\x03\x12\x16\n\x12\x45NTITY_ATTESTATION\x10\x04\x12\x17\n\x13\x45NTITY_APP_INSTANCE\x10\x05\x12\x0f\n\x0b\x45NTITY_PORT\x10\x06\x12\x12\n\x0e\x45NTITY_NETWORK\x10\x07\x12\x1b\n\x17\x45NTITY_NETWORK_INSTANCE\x10\x08\x12\x17\n\x13\x45NTITY_CONTENT_TREE\x10\t\x12\x17\n\x13\x45NTITY_CONTENT_BLOB\x10\n\x12\x11\n\rENTITY_VOLUME\x10\x0b*c\n\x08Severity\x12\x18\n\x14SEVERITY_UNSPECIFIED\x10\x00\x12\x13\n\x0fSEVERITY_NOTICE\x10\x01\x12\x14\n\x10SEVERITY_WARNING\x10\x02\x12\x12\n\x0eSEVERITY_ERROR\x10\x03*N\n\x16HwSecurityModuleStatus\x12\x0b\n\x07UNKNOWN\x10\x00\x12\x0c\n\x08NOTFOUND\x10\x01\x12\x0c\n\x08\x44ISABLED\x10\x02\x12\x0b\n\x07\x45NABLED\x10\x03*\x88\x01\n\x13\x44\x61taSecAtRestStatus\x12\x1b\n\x17\x44\x41TASEC_AT_REST_UNKNOWN\x10\x00\x12\x1c\n\x18\x44\x41TASEC_AT_REST_DISABLED\x10\x01\x12\x1b\n\x17\x44\x41TASEC_AT_REST_ENABLED\x10\x02\x12\x19\n\x15\x44\x41TASEC_AT_REST_ERROR\x10\x04*?\n\tPCRStatus\x12\x0f\n\x0bPCR_UNKNOWN\x10\x00\x12\x0f\n\x0bPCR_ENABLED\x10\x01\x12\x10\n\x0cPCR_DISABLED\x10\x02*\xc5\x01\n\rZSimcardState\x12\x1b\n\x17Z_SIMCARD_STATE_INVALID\x10\x00\x12\x1c\n\x18Z_SIMCARD_STATE_ASSIGNED\x10\x01\x12\x1f\n\x1bZ_SIMCARD_STATE_PROVISIONED\x10\x02\x12\x1a\n\x16Z_SIMCARD_STATE_ACTIVE\x10\x03\x12\x1d\n\x19Z_SIMCARD_STATE_SUSPENDED\x10\x04\x12\x1d\n\x19Z_SIMCARD_STATE_CANCELLED\x10\x05*\xa0\x02\n\x17ZCellularOperatingState\x12*\n&Z_CELLULAR_OPERATING_STATE_UNSPECIFIED\x10\x00\x12&\n\"Z_CELLULAR_OPERATING_STATE_OFFLINE\x10\x01\x12(\n$Z_CELLULAR_OPERATING_STATE_RADIO_OFF\x10\x02\x12%\n!Z_CELLULAR_OPERATING_STATE_ONLINE\x10\x03\x12\x33\n/Z_CELLULAR_OPERATING_STATE_ONLINE_AND_CONNECTED\x10\x04\x12+\n\'Z_CELLULAR_OPERATING_STATE_UNRECOGNIZED\x10\x05*\x92\x01\n\x18ZCellularControlProtocol\x12+\n\'Z_CELLULAR_CONTROL_PROTOCOL_UNSPECIFIED\x10\x00\x12#\n\x1fZ_CELLULAR_CONTROL_PROTOCOL_QMI\x10\x01\x12$\n Z_CELLULAR_CONTROL_PROTOCOL_MBIM\x10\x02*\xb1\x02\n\x0cZDeviceState\x12\x1d\n\x19ZDEVICE_STATE_UNSPECIFIED\x10\x00\x12\x18\n\x14ZDEVICE_STATE_ONLINE\x10\x01\x12\x1b\n\x17ZDEVICE_STATE_REBOOTING\x10\x02\x12\"\n\x1eZDEVICE_STATE_MAINTENANCE_MODE\x10\x03\x12!\n\x1dZDEVICE_STATE_BASEOS_UPDATING\x10\x04\x12\x19\n\x15ZDEVICE_STATE_BOOTING\x10\x05\x12$\n ZDEVICE_STATE_PREPARING_POWEROFF\x10\x06\x12\x1e\n\x1aZDEVICE_STATE_POWERING_OFF\x10\x07\x12#\n\x1fZDEVICE_STATE_PREPARED_POWEROFF\x10\x08*\xf5\x01\n\rStorageStatus\x12\x1e\n\x1aSTORAGE_STATUS_UNSPECIFIED\x10\x00\x12\x19\n\x15STORAGE_STATUS_ONLINE\x10\x01\x12\x1b\n\x17STORAGE_STATUS_DEGRADED\x10\x02\x12\x1a\n\x16STORAGE_STATUS_FAULTED\x10\x03\x12\x1a\n\x16STORAGE_STATUS_OFFLINE\x10\x04\x12\x1a\n\x16STORAGE_STATUS_UNAVAIL\x10\x05\x12\x1a\n\x16STORAGE_STATUS_REMOVED\x10\x06\x12\x1c\n\x18STORAGE_STATUS_SUSPENDED\x10\x07*q\n\x0fStorageScanType\x12!\n\x1dSTORAGE_SCAN_TYPE_UNSPECIFIED\x10\x00\x12\x1b\n\x17STORAGE_SCAN_TYPE_SCRUB\x10\x01\x12\x1e\n\x1aSTORAGE_SCAN_TYPE_RESILVER\x10\x02*\x99\x01\n\x10StorageScanState\x12\"\n\x1eSTORAGE_SCAN_STATE_UNSPECIFIED\x10\x00\x12\x1f\n\x1bSTORAGE_SCAN_STATE_SCANNING\x10\x01\x12\x1f\n\x1bSTORAGE_SCAN_STATE_FINISHED\x10\x02\x12\x1f\n\x1bSTORAGE_SCAN_STATE_CANCELED\x10\x03*\xe3\x01\n\x0fStorageRaidType\x12!\n\x1dSTORAGE_RAID_TYPE_UNSPECIFIED\x10\x00\x12\x1b\n\x17STORAGE_RAID_TYPE_RAID0\x10\x01\x12\x1b\n\x17STORAGE_RAID_TYPE_RAID1\x10\x02\x12\x1b\n\x17STORAGE_RAID_TYPE_RAID5\x10\x03\x12\x1b\n\x17STORAGE_RAID_TYPE_RAID6\x10\x04\x12\x1b\n\x17STORAGE_RAID_TYPE_RAID7\x10\x05\x12\x1c\n\x18STORAGE_RAID_TYPE_NORAID\x10\x06*k\n\x0fStorageTypeInfo\x12!\n\x1dSTORAGE_TYPE_INFO_UNSPECIFIED\x10\x00\x12\x1a\n\x16STORAGE_TYPE_INFO_EXT4\x10\x01\x12\x19\n\x15STORAGE_TYPE_INFO_ZFS\x10\x02*\xd6\x01\n\x12LocalOperationType\x12$\n LOCAL_OPERATION_TYPE_UNSPECIFIED\x10\x00\x12%\n!LOCAL_OPERATION_TYPE_APP_ACTIVATE\x10\x01\x12\'\n#LOCAL_OPERATION_TYPE_GLOBAL_SETTING\x10\x02\x12!\n\x1dLOCAL_OPERATION_TYPE_PORT_MAP\x10\x03\x12\'\n#LOCAL_OPERATION_TYPE_BASE_OS_UPDATE\x10\x04*\xf3\x01\n\x14LocalOperationResult\x12&\n\"LOCAL_OPERATION_RESULT_UNSPECIFIED\x10\x00\x12\"\n\x1eLOCAL_OPERATION_RESULT_APPLIED\x10\x01\x12#\n\x1fLOCAL_OPERATION_RESULT_REJECTED\x10\x02\x12!\n\x1dLOCAL_OPERATION_RESULT_FAILED\x10\x03\x12#\n\x1fLOCAL_OPERATION_RESULT_REVERTED\x10\x04\x12\"\n\x1eLOCAL_OPERATION_RESULT_REVOKED\x10\x05*\x98\x01\n\rAPICapability\x12\x1e\n\x1a\x41PI_CAPABILITY_UNSPECIFIED\x10\x00\x12\x1f\n\x1b\x41PI_CAPABILITY_RETRY_UPDATE\x10\x01\x12\x1b\n\x17\x41PI_CAPABILITY_SHUTDOWN\x10\x02\x12)\n%API_CAPABILITY_START_DELAY_IN_SECONDS\x10\x03*\xb9\x03\n\nBootReason\x12\x1b\n\x17\x42OOT_REASON_UNSPECIFIED\x10\x00\x12\x15\n\x11\x42OOT_REASON_FIRST\x10\x01\x12\x1a\n\x16\x42OOT_REASON_REBOOT_CMD\x10\x02\x12\x16\n\x12\x42OOT_REASON_UPDATE\x10\x03\x12\x18\n\x14\x42OOT_REASON_FALLBACK\x10\x04\x12\x1a\n\x16\x42OOT_REASON_DISCONNECT\x10\x05\x12\x15\n\x11\x42OOT_REASON_FATAL\x10\x06\x12\x13\n\x0f\x42OOT_REASON_OOM\x10\x07\x12\x1d\n\x19\x42OOT_REASON_WATCHDOG_HUNG\x10\x08\x12\x1c\n\x18\x42OOT_REASON_WATCHDOG_PID\x10\t\x12\x16\n\x12\x42OOT_REASON_KERNEL\x10\n\x12\x1a\n\x16\x42OOT_REASON_POWER_FAIL\x10\x0b\x12\x17\n\x13\x42OOT_REASON_UNKNOWN\x10\x0c\x12\x1c\n\x18\x42OOT_REASON_VAULT_FAILED\x10\r\x12\x1c\n\x18\x42OOT_REASON_POWEROFF_CMD\x10\x0e\x12\x1b\n\x16\x42OOT_REASON_PARSE_FAIL\x10\xff\x01*\xbe\x01\n\x15MaintenanceModeReason\x12 \n\x1cMAINTENANCE_MODE_REASON_NONE\x10\x00\x12*\n&MAINTENANCE_MODE_REASON_USER_REQUESTED\x10\x01\x12+\n\'MAINTENANCE_MODE_REASON_VAULT_LOCKED_UP\x10\x02\x12*\n&MAINTENANCE_MODE_REASON_LOW_DISK_SPACE\x10\x03*\xb5\x02\n\x10\x41ttestationState\x12!\n\x1d\x41TTESTATION_STATE_UNSPECIFIED\x10\x00\x12 \n\x1c\x41TTESTATION_STATE_NONCE_WAIT\x10\x01\x12$\n ATTESTATION_STATE_TPM_QUOTE_WAIT\x10\x02\x12%\n!ATTESTATION_STATE_TPM_ESCROW_WAIT\x10\x03\x12!\n\x1d\x41TTESTATION_STATE_ATTEST_WAIT\x10\x04\x12(\n$ATTESTATION_STATE_ATTEST_ESCROW_WAIT\x10\x05\x12\"\n\x1e\x41TTESTATION_STATE_RESTART_WAIT\x10\x06\x12\x1e\n\x1a\x41TTESTATION_STATE_COMPLETE\x10\x07*`\n\x13\x41ppInstMetaDataType\x12 \n\x1c\x41PP_INST_META_DATA_TYPE_NONE\x10\x00\x12\'\n#APP_INST_META_DATA_TYPE_KUBE_CONFIG\x10\x01*a\n\x0cWirelessType\x12\x1d\n\x19WIRELESS_TYPE_UNSPECIFIED\x10\x00\x12\x16\n\x12WIRELESS_TYPE_WIFI\x10\x01\x12\x1a\n\x16WIRELESS_TYPE_CELLULAR\x10\x02*\xd5\x01\n\x15ZCellularTrafficClass\x12(\n$Z_CELLULAR_TRAFFIC_CLASS_UNSPECIFIED\x10\x00\x12!\n\x1dZ_CELLULAR_TRAFFIC_CLASS_LOGS\x10\x01\x12$\n Z_CELLULAR_TRAFFIC_CLASS_METRICS\x10\x02\x12&\n\"Z_CELLULAR_TRAFFIC_CLASS_DOWNLOADS\x10\x03\x12!\n\x1dZ_CELLULAR_TRAFFIC_CLASS_APPS\x10\x04*\xaa\x01\n\x13ZCellularQuotaState\x12&\n\"Z_CELLULAR_QUOTA_STATE_UNSPECIFIED\x10\x00\x12\x1d\n\x19Z_CELLULAR_QUOTA_STATE_OK\x10\x01\x12%\n!Z_CELLULAR_QUOTA_STATE_SOFT_LIMIT\x10\x02\x12%\n!Z_CELLULAR_QUOTA_STATE_HARD_LIMIT\x10\x03*q\n\x0c\x42\x61seOsStatus\x12\x08\n\x04NONE\x10\x00\x12\x0f\n\x0b\x44OWNLOADING\x10\x01\x12\x11\n\rDOWNLOAD_DONE\x10\x02\x12\x0c\n\x08UPDATING\x10\x03\x12\x0b\n\x07UPDATED\x10\x04\x12\x0c\n\x08\x46\x41LLBACK\x10\x05\x12\n\n\x06\x46\x41ILED\x10\x06*\xf2\x01\n\x0f\x42\x61seOsSubStatus\x12\x12\n\x0eNONE_SUBSTATUS\x10\x00\x12\x17\n\x13\x44OWNLOAD_INPROGRESS\x10\x01\x12\x15\n\x11VERIFY_INPROGRESS\x10\x02\x12\x17\n\x13UPDATE_INITIALIZING\x10\x03\x12\x14\n\x10UPDATE_REBOOTING\x10\x04\x12\x12\n\x0eUPDATE_TESTING\x10\x05\x12\x1c\n\x18UPDATE_NEED_TEST_CONFIRM\x10\x06\x12\x13\n\x0fUPDATE_DEFERRED\x10\x07\x12%\n!UPDATE_WAITING_MAINTENANCE_WINDOW\x10\x08*|\n\x0bHealthState\x12\x1c\n\x18HEALTH_STATE_UNSPECIFIED\x10\x00\x12\x19\n\x15HEALTH_STATE_STARTING\x10\x01\x12\x18\n\x14HEALTH_STATE_HEALTHY\x10\x02\x12\x1a\n\x16HEALTH_STATE_UNHEALTHY\x10\x03*\x8f\x01\n\rZInfoVpnState\x12\x0f\n\x0bVPN_INVALID\x10\x00\x12\x0f\n\x0bVPN_INITIAL\x10\x01\x12\x12\n\x0eVPN_CONNECTING\x10\x02\x12\x13\n\x0fVPN_ESTABLISHED\x10\x03\x12\x11\n\rVPN_INSTALLED\x10\x04\x12\x0f\n\x0bVPN_REKEYED\x10\x05\x12\x0f\n\x0bVPN_DELETED\x10\n*\x85\x01\n\x15ZNetworkInstanceState\x12\x1e\n\x1aZNETINST_STATE_UNSPECIFIED\x10\x00\x12\x17\n\x13ZNETINST_STATE_INIT\x10\x01\x12\x19\n\x15ZNETINST_STATE_ONLINE\x10\x02\x12\x18\n\x14ZNETINST_STATE_ERROR\x10\x03*\xbf\x01\n\x11VolumeBackupState\x12#\n\x1fVOLUME_BACKUP_STATE_UNSPECIFIED\x10\x00\x12\x1c\n\x18VOLUME_BACKUP_STATE_IDLE\x10\x01\x12$\n VOLUME_BACKUP_STATE_SNAPSHOTTING\x10\x02\x12!\n\x1dVOLUME_BACKUP_STATE_UPLOADING\x10\x03\x12\x1e\n\x1aVOLUME_BACKUP_STATE_FAILED\x10\x04*\x9e\x01\n\x0eLocReliability\x12\x1f\n\x1bLOC_RELIABILITY_UNSPECIFIED\x10\x00\x12\x1c\n\x18LOC_RELIABILITY_VERY_LOW\x10\x01\x12\x17\n\x13LOC_RELIABILITY_LOW\x10\x02\x12\x1a\n\x16LOC_RELIABILITY_MEDIUM\x10\x03\x12\x18\n\x14LOC_RELIABILITY_HIGH\x10\x04\x42\x39\n\x13org.lfedge.eve.infoZ\"github.com/lf-edge/eve/api/go/infob\x06proto3'
   ,
   dependencies=[google_dot_protobuf_dot_timestamp__pb2.DESCRIPTOR,evecommon_dot_devmodelcommon__pb2.DESCRIPTOR,evecommon_dot_evecommon__pb2.DESCRIPTOR,])
 
@@ -57,8 +57,8 @@ _DEPMETRICITEMTYPE = _descriptor.EnumDescriptor(
   ],
   containing_type=None,
   serialized_options=None,
-  serialized_start=13736,
-  serialized_end=13853,
+  serialized_start=16931,
+  serialized_end=17048,
 )
 _sym_db.RegisterEnumDescriptor(_DEPMETRICITEMTYPE)
 
@@ -128,8 +128,8 @@ _ZINFOTYPES = _descriptor.EnumDescriptor(
   ],
   containing_type=None,
   serialized_options=None,
-  serialized_start=13856,
-  serialized_end=14047,
+  serialized_start=17051,
+  serialized_end=17242,
 )
 _sym_db.RegisterEnumDescriptor(_ZINFOTYPES)
 
@@ -256,11 +256,16 @@ _ZSWSTATE = _descriptor.EnumDescriptor(
       serialized_options=None,
       type=None,
       create_key=_descriptor._internal_create_key),
+    _descriptor.EnumValueDescriptor(
+      name='CRASH_LOOP', index=23, number=23,
+      serialized_options=None,
+      type=None,
+      create_key=_descriptor._internal_create_key),
   ],
   containing_type=None,
   serialized_options=None,
-  serialized_start=14050,
-  serialized_end=14422,
+  serialized_start=17245,
+  serialized_end=17633,
 )
 _sym_db.RegisterEnumDescriptor(_ZSWSTATE)
 
@@ -335,8 +340,8 @@ _ENTITY = _descriptor.EnumDescriptor(
   ],
   containing_type=None,
   serialized_options=None,
-  serialized_start=14425,
-  serialized_end=14706,
+  serialized_start=17636,
+  serialized_end=17917,
 )
 _sym_db.RegisterEnumDescriptor(_ENTITY)
 
@@ -371,8 +376,8 @@ _SEVERITY = _descriptor.EnumDescriptor(
   ],
   containing_type=None,
   serialized_options=None,
-  serialized_start=14708,
-  serialized_end=14807,
+  serialized_start=17919,
+  serialized_end=18018,
 )
 _sym_db.RegisterEnumDescriptor(_SEVERITY)
 
@@ -407,8 +412,8 @@ _HWSECURITYMODULESTATUS = _descriptor.EnumDescriptor(
   ],
   containing_type=None,
   serialized_options=None,
-  serialized_start=14809,
-  serialized_end=14887,
+  serialized_start=18020,
+  serialized_end=18098,
 )
 _sym_db.RegisterEnumDescriptor(_HWSECURITYMODULESTATUS)
 
@@ -443,8 +448,8 @@ _DATASECATRESTSTATUS = _descriptor.EnumDescriptor(
   ],
   containing_type=None,
   serialized_options=None,
-  serialized_start=14890,
-  serialized_end=15026,
+  serialized_start=18101,
+  serialized_end=18237,
 )
 _sym_db.RegisterEnumDescriptor(_DATASECATRESTSTATUS)
 
@@ -474,8 +479,8 @@ _PCRSTATUS = _descriptor.EnumDescriptor(
   ],
   containing_type=None,
   serialized_options=None,
-  serialized_start=15028,
-  serialized_end=15091,
+  serialized_start=18239,
+  serialized_end=18302,
 )
 _sym_db.RegisterEnumDescriptor(_PCRSTATUS)
 
@@ -520,8 +525,8 @@ _ZSIMCARDSTATE = _descriptor.EnumDescriptor(
   ],
   containing_type=None,
   serialized_options=None,
-  serialized_start=15094,
-  serialized_end=15291,
+  serialized_start=18305,
+  serialized_end=18502,
 )
 _sym_db.RegisterEnumDescriptor(_ZSIMCARDSTATE)
 
@@ -566,8 +571,8 @@ _ZCELLULAROPERATINGSTATE = _descriptor.EnumDescriptor(
   ],
   containing_type=None,
   serialized_options=None,
-  serialized_start=15294,
-  serialized_end=15582,
+  serialized_start=18505,
+  serialized_end=18793,
 )
 _sym_db.RegisterEnumDescriptor(_ZCELLULAROPERATINGSTATE)
 
@@ -597,8 +602,8 @@ _ZCELLULARCONTROLPROTOCOL = _descriptor.EnumDescriptor(
   ],
   containing_type=None,
   serialized_options=None,
-  serialized_start=15585,
-  serialized_end=15731,
+  serialized_start=18796,
+  serialized_end=18942,
 )
 _sym_db.RegisterEnumDescriptor(_ZCELLULARCONTROLPROTOCOL)
 
@@ -658,8 +663,8 @@ _ZDEVICESTATE = _descriptor.EnumDescriptor(
   ],
   containing_type=None,
   serialized_options=None,
-  serialized_start=15734,
-  serialized_end=16039,
+  serialized_start=18945,
+  serialized_end=19250,
 )
 _sym_db.RegisterEnumDescriptor(_ZDEVICESTATE)
 
@@ -714,12 +719,79 @@ _STORAGESTATUS = _descriptor.EnumDescriptor(
   ],
   containing_type=None,
   serialized_options=None,
-  serialized_start=16042,
-  serialized_end=16287,
+  serialized_start=19253,
+  serialized_end=19498,
 )
 _sym_db.RegisterEnumDescriptor(_STORAGESTATUS)
 
 StorageStatus = enum_type_wrapper.EnumTypeWrapper(_STORAGESTATUS)
+_STORAGESCANTYPE = _descriptor.EnumDescriptor(
+  name='StorageScanType',
+  full_name='org.lfedge.eve.info.StorageScanType',
+  filename=None,
+  file=DESCRIPTOR,
+  create_key=_descriptor._internal_create_key,
+  values=[
+    _descriptor.EnumValueDescriptor(
+      name='STORAGE_SCAN_TYPE_UNSPECIFIED', index=0, number=0,
+      serialized_options=None,
+      type=None,
+      create_key=_descriptor._internal_create_key),
+    _descriptor.EnumValueDescriptor(
+      name='STORAGE_SCAN_TYPE_SCRUB', index=1, number=1,
+      serialized_options=None,
+      type=None,
+      create_key=_descriptor._internal_create_key),
+    _descriptor.EnumValueDescriptor(
+      name='STORAGE_SCAN_TYPE_RESILVER', index=2, number=2,
+      serialized_options=None,
+      type=None,
+      create_key=_descriptor._internal_create_key),
+  ],
+  containing_type=None,
+  serialized_options=None,
+  serialized_start=19500,
+  serialized_end=19613,
+)
+_sym_db.RegisterEnumDescriptor(_STORAGESCANTYPE)
+
+StorageScanType = enum_type_wrapper.EnumTypeWrapper(_STORAGESCANTYPE)
+_STORAGESCANSTATE = _descriptor.EnumDescriptor(
+  name='StorageScanState',
+  full_name='org.lfedge.eve.info.StorageScanState',
+  filename=None,
+  file=DESCRIPTOR,
+  create_key=_descriptor._internal_create_key,
+  values=[
+    _descriptor.EnumValueDescriptor(
+      name='STORAGE_SCAN_STATE_UNSPECIFIED', index=0, number=0,
+      serialized_options=None,
+      type=None,
+      create_key=_descriptor._internal_create_key),
+    _descriptor.EnumValueDescriptor(
+      name='STORAGE_SCAN_STATE_SCANNING', index=1, number=1,
+      serialized_options=None,
+      type=None,
+      create_key=_descriptor._internal_create_key),
+    _descriptor.EnumValueDescriptor(
+      name='STORAGE_SCAN_STATE_FINISHED', index=2, number=2,
+      serialized_options=None,
+      type=None,
+      create_key=_descriptor._internal_create_key),
+    _descriptor.EnumValueDescriptor(
+      name='STORAGE_SCAN_STATE_CANCELED', index=3, number=3,
+      serialized_options=None,
+      type=None,
+      create_key=_descriptor._internal_create_key),
+  ],
+  containing_type=None,
+  serialized_options=None,
+  serialized_start=19616,
+  serialized_end=19769,
+)
+_sym_db.RegisterEnumDescriptor(_STORAGESCANSTATE)
+
+StorageScanState = enum_type_wrapper.EnumTypeWrapper(_STORAGESCANSTATE)
 _STORAGERAIDTYPE = _descriptor.EnumDescriptor(
   name='StorageRaidType',
   full_name='org.lfedge.eve.info.StorageRaidType',
@@ -765,8 +837,8 @@ _STORAGERAIDTYPE = _descriptor.EnumDescriptor(
   ],
   containing_type=None,
   serialized_options=None,
-  serialized_start=16290,
-  serialized_end=16517,
+  serialized_start=19772,
+  serialized_end=19999,
 )
 _sym_db.RegisterEnumDescriptor(_STORAGERAIDTYPE)
 
@@ -796,12 +868,99 @@ _STORAGETYPEINFO = _descriptor.EnumDescriptor(
   ],
   containing_type=None,
   serialized_options=None,
-  serialized_start=16519,
-  serialized_end=16626,
+  serialized_start=20001,
+  serialized_end=20108,
 )
 _sym_db.RegisterEnumDescriptor(_STORAGETYPEINFO)
 
 StorageTypeInfo = enum_type_wrapper.EnumTypeWrapper(_STORAGETYPEINFO)
+_LOCALOPERATIONTYPE = _descriptor.EnumDescriptor(
+  name='LocalOperationType',
+  full_name='org.lfedge.eve.info.LocalOperationType',
+  filename=None,
+  file=DESCRIPTOR,
+  create_key=_descriptor._internal_create_key,
+  values=[
+    _descriptor.EnumValueDescriptor(
+      name='LOCAL_OPERATION_TYPE_UNSPECIFIED', index=0, number=0,
+      serialized_options=None,
+      type=None,
+      create_key=_descriptor._internal_create_key),
+    _descriptor.EnumValueDescriptor(
+      name='LOCAL_OPERATION_TYPE_APP_ACTIVATE', index=1, number=1,
+      serialized_options=None,
+      type=None,
+      create_key=_descriptor._internal_create_key),
+    _descriptor.EnumValueDescriptor(
+      name='LOCAL_OPERATION_TYPE_GLOBAL_SETTING', index=2, number=2,
+      serialized_options=None,
+      type=None,
+      create_key=_descriptor._internal_create_key),
+    _descriptor.EnumValueDescriptor(
+      name='LOCAL_OPERATION_TYPE_PORT_MAP', index=3, number=3,
+      serialized_options=None,
+      type=None,
+      create_key=_descriptor._internal_create_key),
+    _descriptor.EnumValueDescriptor(
+      name='LOCAL_OPERATION_TYPE_BASE_OS_UPDATE', index=4, number=4,
+      serialized_options=None,
+      type=None,
+      create_key=_descriptor._internal_create_key),
+  ],
+  containing_type=None,
+  serialized_options=None,
+  serialized_start=20111,
+  serialized_end=20325,
+)
+_sym_db.RegisterEnumDescriptor(_LOCALOPERATIONTYPE)
+
+LocalOperationType = enum_type_wrapper.EnumTypeWrapper(_LOCALOPERATIONTYPE)
+_LOCALOPERATIONRESULT = _descriptor.EnumDescriptor(
+  name='LocalOperationResult',
+  full_name='org.lfedge.eve.info.LocalOperationResult',
+  filename=None,
+  file=DESCRIPTOR,
+  create_key=_descriptor._internal_create_key,
+  values=[
+    _descriptor.EnumValueDescriptor(
+      name='LOCAL_OPERATION_RESULT_UNSPECIFIED', index=0, number=0,
+      serialized_options=None,
+      type=None,
+      create_key=_descriptor._internal_create_key),
+    _descriptor.EnumValueDescriptor(
+      name='LOCAL_OPERATION_RESULT_APPLIED', index=1, number=1,
+      serialized_options=None,
+      type=None,
+      create_key=_descriptor._internal_create_key),
+    _descriptor.EnumValueDescriptor(
+      name='LOCAL_OPERATION_RESULT_REJECTED', index=2, number=2,
+      serialized_options=None,
+      type=None,
+      create_key=_descriptor._internal_create_key),
+    _descriptor.EnumValueDescriptor(
+      name='LOCAL_OPERATION_RESULT_FAILED', index=3, number=3,
+      serialized_options=None,
+      type=None,
+      create_key=_descriptor._internal_create_key),
+    _descriptor.EnumValueDescriptor(
+      name='LOCAL_OPERATION_RESULT_REVERTED', index=4, number=4,
+      serialized_options=None,
+      type=None,
+      create_key=_descriptor._internal_create_key),
+    _descriptor.EnumValueDescriptor(
+      name='LOCAL_OPERATION_RESULT_REVOKED', index=5, number=5,
+      serialized_options=None,
+      type=None,
+      create_key=_descriptor._internal_create_key),
+  ],
+  containing_type=None,
+  serialized_options=None,
+  serialized_start=20328,
+  serialized_end=20571,
+)
+_sym_db.RegisterEnumDescriptor(_LOCALOPERATIONRESULT)
+
+LocalOperationResult = enum_type_wrapper.EnumTypeWrapper(_LOCALOPERATIONRESULT)
 _APICAPABILITY = _descriptor.EnumDescriptor(
   name='APICapability',
   full_name='org.lfedge.eve.info.APICapability',
@@ -832,8 +991,8 @@ _APICAPABILITY = _descriptor.EnumDescriptor(
   ],
   containing_type=None,
   serialized_options=None,
-  serialized_start=16629,
-  serialized_end=16781,
+  serialized_start=20574,
+  serialized_end=20726,
 )
 _sym_db.RegisterEnumDescriptor(_APICAPABILITY)
 
@@ -928,8 +1087,8 @@ _BOOTREASON = _descriptor.EnumDescriptor(
   ],
   containing_type=None,
   serialized_options=None,
-  serialized_start=16784,
-  serialized_end=17225,
+  serialized_start=20729,
+  serialized_end=21170,
 )
 _sym_db.RegisterEnumDescriptor(_BOOTREASON)
 
@@ -964,8 +1123,8 @@ _MAINTENANCEMODEREASON = _descriptor.EnumDescriptor(
   ],
   containing_type=None,
   serialized_options=None,
-  serialized_start=17228,
-  serialized_end=17418,
+  serialized_start=21173,
+  serialized_end=21363,
 )
 _sym_db.RegisterEnumDescriptor(_MAINTENANCEMODEREASON)
 
@@ -1020,8 +1179,8 @@ _ATTESTATIONSTATE = _descriptor.EnumDescriptor(
   ],
   containing_type=None,
   serialized_options=None,
-  serialized_start=17421,
-  serialized_end=17730,
+  serialized_start=21366,
+  serialized_end=21675,
 )
 _sym_db.RegisterEnumDescriptor(_ATTESTATIONSTATE)
 
@@ -1046,8 +1205,8 @@ _APPINSTMETADATATYPE = _descriptor.EnumDescriptor(
   ],
   containing_type=None,
   serialized_options=None,
-  serialized_start=17732,
-  serialized_end=17828,
+  serialized_start=21677,
+  serialized_end=21773,
 )
 _sym_db.RegisterEnumDescriptor(_APPINSTMETADATATYPE)
 
@@ -1077,12 +1236,89 @@ _WIRELESSTYPE = _descriptor.EnumDescriptor(
   ],
   containing_type=None,
   serialized_options=None,
-  serialized_start=17830,
-  serialized_end=17927,
+  serialized_start=21775,
+  serialized_end=21872,
 )
 _sym_db.RegisterEnumDescriptor(_WIRELESSTYPE)
 
 WirelessType = enum_type_wrapper.EnumTypeWrapper(_WIRELESSTYPE)
+_ZCELLULARTRAFFICCLASS = _descriptor.EnumDescriptor(
+  name='ZCellularTrafficClass',
+  full_name='org.lfedge.eve.info.ZCellularTrafficClass',
+  filename=None,
+  file=DESCRIPTOR,
+  create_key=_descriptor._internal_create_key,
+  values=[
+    _descriptor.EnumValueDescriptor(
+      name='Z_CELLULAR_TRAFFIC_CLASS_UNSPECIFIED', index=0, number=0,
+      serialized_options=None,
+      type=None,
+      create_key=_descriptor._internal_create_key),
+    _descriptor.EnumValueDescriptor(
+      name='Z_CELLULAR_TRAFFIC_CLASS_LOGS', index=1, number=1,
+      serialized_options=None,
+      type=None,
+      create_key=_descriptor._internal_create_key),
+    _descriptor.EnumValueDescriptor(
+      name='Z_CELLULAR_TRAFFIC_CLASS_METRICS', index=2, number=2,
+      serialized_options=None,
+      type=None,
+      create_key=_descriptor._internal_create_key),
+    _descriptor.EnumValueDescriptor(
+      name='Z_CELLULAR_TRAFFIC_CLASS_DOWNLOADS', index=3, number=3,
+      serialized_options=None,
+      type=None,
+      create_key=_descriptor._internal_create_key),
+    _descriptor.EnumValueDescriptor(
+      name='Z_CELLULAR_TRAFFIC_CLASS_APPS', index=4, number=4,
+      serialized_options=None,
+      type=None,
+      create_key=_descriptor._internal_create_key),
+  ],
+  containing_type=None,
+  serialized_options=None,
+  serialized_start=21875,
+  serialized_end=22088,
+)
+_sym_db.RegisterEnumDescriptor(_ZCELLULARTRAFFICCLASS)
+
+ZCellularTrafficClass = enum_type_wrapper.EnumTypeWrapper(_ZCELLULARTRAFFICCLASS)
+_ZCELLULARQUOTASTATE = _descriptor.EnumDescriptor(
+  name='ZCellularQuotaState',
+  full_name='org.lfedge.eve.info.ZCellularQuotaState',
+  filename=None,
+  file=DESCRIPTOR,
+  create_key=_descriptor._internal_create_key,
+  values=[
+    _descriptor.EnumValueDescriptor(
+      name='Z_CELLULAR_QUOTA_STATE_UNSPECIFIED', index=0, number=0,
+      serialized_options=None,
+      type=None,
+      create_key=_descriptor._internal_create_key),
+    _descriptor.EnumValueDescriptor(
+      name='Z_CELLULAR_QUOTA_STATE_OK', index=1, number=1,
+      serialized_options=None,
+      type=None,
+      create_key=_descriptor._internal_create_key),
+    _descriptor.EnumValueDescriptor(
+      name='Z_CELLULAR_QUOTA_STATE_SOFT_LIMIT', index=2, number=2,
+      serialized_options=None,
+      type=None,
+      create_key=_descriptor._internal_create_key),
+    _descriptor.EnumValueDescriptor(
+      name='Z_CELLULAR_QUOTA_STATE_HARD_LIMIT', index=3, number=3,
+      serialized_options=None,
+      type=None,
+      create_key=_descriptor._internal_create_key),
+  ],
+  containing_type=None,
+  serialized_options=None,
+  serialized_start=22091,
+  serialized_end=22261,
+)
+_sym_db.RegisterEnumDescriptor(_ZCELLULARQUOTASTATE)
+
+ZCellularQuotaState = enum_type_wrapper.EnumTypeWrapper(_ZCELLULARQUOTASTATE)
 _BASEOSSTATUS = _descriptor.EnumDescriptor(
   name='BaseOsStatus',
   full_name='org.lfedge.eve.info.BaseOsStatus',
@@ -1128,8 +1364,8 @@ _BASEOSSTATUS = _descriptor.EnumDescriptor(
   ],
   containing_type=None,
   serialized_options=None,
-  serialized_start=17929,
-  serialized_end=18042,
+  serialized_start=22263,
+  serialized_end=22376,
 )
 _sym_db.RegisterEnumDescriptor(_BASEOSSTATUS)
 
@@ -1181,15 +1417,56 @@ _BASEOSSUBSTATUS = _descriptor.EnumDescriptor(
       serialized_options=None,
       type=None,
       create_key=_descriptor._internal_create_key),
+    _descriptor.EnumValueDescriptor(
+      name='UPDATE_WAITING_MAINTENANCE_WINDOW', index=8, number=8,
+      serialized_options=None,
+      type=None,
+      create_key=_descriptor._internal_create_key),
   ],
   containing_type=None,
   serialized_options=None,
-  serialized_start=18045,
-  serialized_end=18248,
+  serialized_start=22379,
+  serialized_end=22621,
 )
 _sym_db.RegisterEnumDescriptor(_BASEOSSUBSTATUS)
 
 BaseOsSubStatus = enum_type_wrapper.EnumTypeWrapper(_BASEOSSUBSTATUS)
+_HEALTHSTATE = _descriptor.EnumDescriptor(
+  name='HealthState',
+  full_name='org.lfedge.eve.info.HealthState',
+  filename=None,
+  file=DESCRIPTOR,
+  create_key=_descriptor._internal_create_key,
+  values=[
+    _descriptor.EnumValueDescriptor(
+      name='HEALTH_STATE_UNSPECIFIED', index=0, number=0,
+      serialized_options=None,
+      type=None,
+      create_key=_descriptor._internal_create_key),
+    _descriptor.EnumValueDescriptor(
+      name='HEALTH_STATE_STARTING', index=1, number=1,
+      serialized_options=None,
+      type=None,
+      create_key=_descriptor._internal_create_key),
+    _descriptor.EnumValueDescriptor(
+      name='HEALTH_STATE_HEALTHY', index=2, number=2,
+      serialized_options=None,
+      type=None,
+      create_key=_descriptor._internal_create_key),
+    _descriptor.EnumValueDescriptor(
+      name='HEALTH_STATE_UNHEALTHY', index=3, number=3,
+      serialized_options=None,
+      type=None,
+      create_key=_descriptor._internal_create_key),
+  ],
+  containing_type=None,
+  serialized_options=None,
+  serialized_start=22623,
+  serialized_end=22747,
+)
+_sym_db.RegisterEnumDescriptor(_HEALTHSTATE)
+
+HealthState = enum_type_wrapper.EnumTypeWrapper(_HEALTHSTATE)
 _ZINFOVPNSTATE = _descriptor.EnumDescriptor(
   name='ZInfoVpnState',
   full_name='org.lfedge.eve.info.ZInfoVpnState',
@@ -1235,8 +1512,8 @@ _ZINFOVPNSTATE = _descriptor.EnumDescriptor(
   ],
   containing_type=None,
   serialized_options=None,
-  serialized_start=18251,
-  serialized_end=18394,
+  serialized_start=22750,
+  serialized_end=22893,
 )
 _sym_db.RegisterEnumDescriptor(_ZINFOVPNSTATE)
 
@@ -1271,12 +1548,53 @@ _ZNETWORKINSTANCESTATE = _descriptor.EnumDescriptor(
   ],
   containing_type=None,
   serialized_options=None,
-  serialized_start=18397,
-  serialized_end=18530,
+  serialized_start=22896,
+  serialized_end=23029,
 )
 _sym_db.RegisterEnumDescriptor(_ZNETWORKINSTANCESTATE)
 
 ZNetworkInstanceState = enum_type_wrapper.EnumTypeWrapper(_ZNETWORKINSTANCESTATE)
+_VOLUMEBACKUPSTATE = _descriptor.EnumDescriptor(
+  name='VolumeBackupState',
+  full_name='org.lfedge.eve.info.VolumeBackupState',
+  filename=None,
+  file=DESCRIPTOR,
+  create_key=_descriptor._internal_create_key,
+  values=[
+    _descriptor.EnumValueDescriptor(
+      name='VOLUME_BACKUP_STATE_UNSPECIFIED', index=0, number=0,
+      serialized_options=None,
+      type=None,
+      create_key=_descriptor._internal_create_key),
+    _descriptor.EnumValueDescriptor(
+      name='VOLUME_BACKUP_STATE_IDLE', index=1, number=1,
+      serialized_options=None,
+      type=None,
+      create_key=_descriptor._internal_create_key),
+    _descriptor.EnumValueDescriptor(
+      name='VOLUME_BACKUP_STATE_SNAPSHOTTING', index=2, number=2,
+      serialized_options=None,
+      type=None,
+      create_key=_descriptor._internal_create_key),
+    _descriptor.EnumValueDescriptor(
+      name='VOLUME_BACKUP_STATE_UPLOADING', index=3, number=3,
+      serialized_options=None,
+      type=None,
+      create_key=_descriptor._internal_create_key),
+    _descriptor.EnumValueDescriptor(
+      name='VOLUME_BACKUP_STATE_FAILED', index=4, number=4,
+      serialized_options=None,
+      type=None,
+      create_key=_descriptor._internal_create_key),
+  ],
+  containing_type=None,
+  serialized_options=None,
+  serialized_start=23032,
+  serialized_end=23223,
+)
+_sym_db.RegisterEnumDescriptor(_VOLUMEBACKUPSTATE)
+
+VolumeBackupState = enum_type_wrapper.EnumTypeWrapper(_VOLUMEBACKUPSTATE)
 _LOCRELIABILITY = _descriptor.EnumDescriptor(
   name='LocReliability',
   full_name='org.lfedge.eve.info.LocReliability',
@@ -1312,8 +1630,8 @@ _LOCRELIABILITY = _descriptor.EnumDescriptor(
   ],
   containing_type=None,
   serialized_options=None,
-  serialized_start=18533,
-  serialized_end=18691,
+  serialized_start=23226,
+  serialized_end=23384,
 )
 _sym_db.RegisterEnumDescriptor(_LOCRELIABILITY)
 
@@ -1356,6 +1674,7 @@ LOADED = 19
 AWAITNETWORKINSTANCE = 20
 ERROR = 21
 START_DELAYED = 22
+CRASH_LOOP = 23
 ENTITY_UNSPECIFIED = 0
 ENTITY_BASE_OS = 1
 ENTITY_SYSTEM_ADAPTER = 2
@@ -1415,6 +1734,13 @@ STORAGE_STATUS_OFFLINE = 4
 STORAGE_STATUS_UNAVAIL = 5
 STORAGE_STATUS_REMOVED = 6
 STORAGE_STATUS_SUSPENDED = 7
+STORAGE_SCAN_TYPE_UNSPECIFIED = 0
+STORAGE_SCAN_TYPE_SCRUB = 1
+STORAGE_SCAN_TYPE_RESILVER = 2
+STORAGE_SCAN_STATE_UNSPECIFIED = 0
+STORAGE_SCAN_STATE_SCANNING = 1
+STORAGE_SCAN_STATE_FINISHED = 2
+STORAGE_SCAN_STATE_CANCELED = 3
 STORAGE_RAID_TYPE_UNSPECIFIED = 0
 STORAGE_RAID_TYPE_RAID0 = 1
 STORAGE_RAID_TYPE_RAID1 = 2
@@ -1425,6 +1751,17 @@ STORAGE_RAID_TYPE_NORAID = 6
 STORAGE_TYPE_INFO_UNSPECIFIED = 0
 STORAGE_TYPE_INFO_EXT4 = 1
 STORAGE_TYPE_INFO_ZFS = 2
+LOCAL_OPERATION_TYPE_UNSPECIFIED = 0
+LOCAL_OPERATION_TYPE_APP_ACTIVATE = 1
+LOCAL_OPERATION_TYPE_GLOBAL_SETTING = 2
+LOCAL_OPERATION_TYPE_PORT_MAP = 3
+LOCAL_OPERATION_TYPE_BASE_OS_UPDATE = 4
+LOCAL_OPERATION_RESULT_UNSPECIFIED = 0
+LOCAL_OPERATION_RESULT_APPLIED = 1
+LOCAL_OPERATION_RESULT_REJECTED = 2
+LOCAL_OPERATION_RESULT_FAILED = 3
+LOCAL_OPERATION_RESULT_REVERTED = 4
+LOCAL_OPERATION_RESULT_REVOKED = 5
 API_CAPABILITY_UNSPECIFIED = 0
 API_CAPABILITY_RETRY_UPDATE = 1
 API_CAPABILITY_SHUTDOWN = 2
@@ -1462,6 +1799,15 @@ APP_INST_META_DATA_TYPE_KUBE_CONFIG = 1
 WIRELESS_TYPE_UNSPECIFIED = 0
 WIRELESS_TYPE_WIFI = 1
 WIRELESS_TYPE_CELLULAR = 2
+Z_CELLULAR_TRAFFIC_CLASS_UNSPECIFIED = 0
+Z_CELLULAR_TRAFFIC_CLASS_LOGS = 1
+Z_CELLULAR_TRAFFIC_CLASS_METRICS = 2
+Z_CELLULAR_TRAFFIC_CLASS_DOWNLOADS = 3
+Z_CELLULAR_TRAFFIC_CLASS_APPS = 4
+Z_CELLULAR_QUOTA_STATE_UNSPECIFIED = 0
+Z_CELLULAR_QUOTA_STATE_OK = 1
+Z_CELLULAR_QUOTA_STATE_SOFT_LIMIT = 2
+Z_CELLULAR_QUOTA_STATE_HARD_LIMIT = 3
 NONE = 0
 DOWNLOADING = 1
 DOWNLOAD_DONE = 2
@@ -1477,6 +1823,11 @@ UPDATE_REBOOTING = 4
 UPDATE_TESTING = 5
 UPDATE_NEED_TEST_CONFIRM = 6
 UPDATE_DEFERRED = 7
+UPDATE_WAITING_MAINTENANCE_WINDOW = 8
+HEALTH_STATE_UNSPECIFIED = 0
+HEALTH_STATE_STARTING = 1
+HEALTH_STATE_HEALTHY = 2
+HEALTH_STATE_UNHEALTHY = 3
 VPN_INVALID = 0
 VPN_INITIAL = 1
 VPN_CONNECTING = 2
@@ -1488,6 +1839,11 @@ ZNETINST_STATE_UNSPECIFIED = 0
 ZNETINST_STATE_INIT = 1
 ZNETINST_STATE_ONLINE = 2
 ZNETINST_STATE_ERROR = 3
+VOLUME_BACKUP_STATE_UNSPECIFIED = 0
+VOLUME_BACKUP_STATE_IDLE = 1
+VOLUME_BACKUP_STATE_SNAPSHOTTING = 2
+VOLUME_BACKUP_STATE_UPLOADING = 3
+VOLUME_BACKUP_STATE_FAILED = 4
 LOC_RELIABILITY_UNSPECIFIED = 0
 LOC_RELIABILITY_VERY_LOW = 1
 LOC_RELIABILITY_LOW = 2
@@ -2863,6 +3219,27 @@ _STORAGEDISKSTATE = _descriptor.Descriptor(
       message_type=None, enum_type=None, containing_type=None,
       is_extension=False, extension_scope=None,
       serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
+    _descriptor.FieldDescriptor(
+      name='resilvering', full_name='org.lfedge.eve.info.StorageDiskState.resilvering', index=2,
+      number=3, type=8, cpp_type=7, label=1,
+      has_default_value=False, default_value=False,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
+    _descriptor.FieldDescriptor(
+      name='resilver_progress', full_name='org.lfedge.eve.info.StorageDiskState.resilver_progress', index=3,
+      number=4, type=13, cpp_type=3, label=1,
+      has_default_value=False, default_value=0,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
+    _descriptor.FieldDescriptor(
+      name='spare', full_name='org.lfedge.eve.info.StorageDiskState.spare', index=4,
+      number=5, type=8, cpp_type=7, label=1,
+      has_default_value=False, default_value=False,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
   ],
   extensions=[
   ],
@@ -2876,36 +3253,117 @@ _STORAGEDISKSTATE = _descriptor.Descriptor(
   oneofs=[
   ],
   serialized_start=3509,
-  serialized_end=3638,
+  serialized_end=3701,
 )
 
 
-_SMARTATTR = _descriptor.Descriptor(
-  name='SmartAttr',
-  full_name='org.lfedge.eve.info.SmartAttr',
+_STORAGESCANSTATUS = _descriptor.Descriptor(
+  name='StorageScanStatus',
+  full_name='org.lfedge.eve.info.StorageScanStatus',
   filename=None,
   file=DESCRIPTOR,
   containing_type=None,
   create_key=_descriptor._internal_create_key,
   fields=[
     _descriptor.FieldDescriptor(
-      name='id', full_name='org.lfedge.eve.info.SmartAttr.id', index=0,
-      number=1, type=13, cpp_type=3, label=1,
+      name='type', full_name='org.lfedge.eve.info.StorageScanStatus.type', index=0,
+      number=1, type=14, cpp_type=8, label=1,
       has_default_value=False, default_value=0,
       message_type=None, enum_type=None, containing_type=None,
       is_extension=False, extension_scope=None,
       serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
     _descriptor.FieldDescriptor(
-      name='value', full_name='org.lfedge.eve.info.SmartAttr.value', index=1,
-      number=2, type=4, cpp_type=4, label=1,
+      name='state', full_name='org.lfedge.eve.info.StorageScanStatus.state', index=1,
+      number=2, type=14, cpp_type=8, label=1,
       has_default_value=False, default_value=0,
       message_type=None, enum_type=None, containing_type=None,
       is_extension=False, extension_scope=None,
       serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
     _descriptor.FieldDescriptor(
-      name='worst', full_name='org.lfedge.eve.info.SmartAttr.worst', index=2,
-      number=3, type=4, cpp_type=4, label=1,
-      has_default_value=False, default_value=0,
+      name='start_time', full_name='org.lfedge.eve.info.StorageScanStatus.start_time', index=2,
+      number=3, type=11, cpp_type=10, label=1,
+      has_default_value=False, default_value=None,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
+    _descriptor.FieldDescriptor(
+      name='end_time', full_name='org.lfedge.eve.info.StorageScanStatus.end_time', index=3,
+      number=4, type=11, cpp_type=10, label=1,
+      has_default_value=False, default_value=None,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
+    _descriptor.FieldDescriptor(
+      name='to_examine_bytes', full_name='org.lfedge.eve.info.StorageScanStatus.to_examine_bytes', index=4,
+      number=5, type=4, cpp_type=4, label=1,
+      has_default_value=False, default_value=0,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
+    _descriptor.FieldDescriptor(
+      name='examined_bytes', full_name='org.lfedge.eve.info.StorageScanStatus.examined_bytes', index=5,
+      number=6, type=4, cpp_type=4, label=1,
+      has_default_value=False, default_value=0,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
+    _descriptor.FieldDescriptor(
+      name='progress', full_name='org.lfedge.eve.info.StorageScanStatus.progress', index=6,
+      number=7, type=13, cpp_type=3, label=1,
+      has_default_value=False, default_value=0,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
+    _descriptor.FieldDescriptor(
+      name='errors', full_name='org.lfedge.eve.info.StorageScanStatus.errors', index=7,
+      number=8, type=4, cpp_type=4, label=1,
+      has_default_value=False, default_value=0,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
+  ],
+  extensions=[
+  ],
+  nested_types=[],
+  enum_types=[
+  ],
+  serialized_options=None,
+  is_extendable=False,
+  syntax='proto3',
+  extension_ranges=[],
+  oneofs=[
+  ],
+  serialized_start=3704,
+  serialized_end=4007,
+)
+
+
+_SMARTATTR = _descriptor.Descriptor(
+  name='SmartAttr',
+  full_name='org.lfedge.eve.info.SmartAttr',
+  filename=None,
+  file=DESCRIPTOR,
+  containing_type=None,
+  create_key=_descriptor._internal_create_key,
+  fields=[
+    _descriptor.FieldDescriptor(
+      name='id', full_name='org.lfedge.eve.info.SmartAttr.id', index=0,
+      number=1, type=13, cpp_type=3, label=1,
+      has_default_value=False, default_value=0,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
+    _descriptor.FieldDescriptor(
+      name='value', full_name='org.lfedge.eve.info.SmartAttr.value', index=1,
+      number=2, type=4, cpp_type=4, label=1,
+      has_default_value=False, default_value=0,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
+    _descriptor.FieldDescriptor(
+      name='worst', full_name='org.lfedge.eve.info.SmartAttr.worst', index=2,
+      number=3, type=4, cpp_type=4, label=1,
+      has_default_value=False, default_value=0,
       message_type=None, enum_type=None, containing_type=None,
       is_extension=False, extension_scope=None,
       serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
@@ -2942,8 +3400,8 @@ _SMARTATTR = _descriptor.Descriptor(
   extension_ranges=[],
   oneofs=[
   ],
-  serialized_start=3640,
-  serialized_end=3749,
+  serialized_start=4009,
+  serialized_end=4118,
 )
 
 
@@ -3016,8 +3474,8 @@ _SMARTMETRIC = _descriptor.Descriptor(
   extension_ranges=[],
   oneofs=[
   ],
-  serialized_start=3752,
-  serialized_end=4146,
+  serialized_start=4121,
+  serialized_end=4515,
 )
 
 
@@ -3083,8 +3541,8 @@ _STORAGEDISKINFO = _descriptor.Descriptor(
   extension_ranges=[],
   oneofs=[
   ],
-  serialized_start=4149,
-  serialized_end=4316,
+  serialized_start=4518,
+  serialized_end=4685,
 )
 
 
@@ -3136,8 +3594,8 @@ _STORAGECHILDREN = _descriptor.Descriptor(
   extension_ranges=[],
   oneofs=[
   ],
-  serialized_start=4319,
-  serialized_end=4528,
+  serialized_start=4688,
+  serialized_end=4897,
 )
 
 
@@ -3226,6 +3684,20 @@ _STORAGEINFO = _descriptor.Descriptor(
       message_type=None, enum_type=None, containing_type=None,
       is_extension=False, extension_scope=None,
       serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
+    _descriptor.FieldDescriptor(
+      name='scan', full_name='org.lfedge.eve.info.StorageInfo.scan', index=11,
+      number=12, type=11, cpp_type=10, label=1,
+      has_default_value=False, default_value=None,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
+    _descriptor.FieldDescriptor(
+      name='spares', full_name='org.lfedge.eve.info.StorageInfo.spares', index=12,
+      number=13, type=11, cpp_type=10, label=3,
+      has_default_value=False, default_value=[],
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
   ],
   extensions=[
   ],
@@ -3238,8 +3710,8 @@ _STORAGEINFO = _descriptor.Descriptor(
   extension_ranges=[],
   oneofs=[
   ],
-  serialized_start=4531,
-  serialized_end=4967,
+  serialized_start=4900,
+  serialized_end=5445,
 )
 
 
@@ -3270,8 +3742,8 @@ _ZINFOHARDWARE = _descriptor.Descriptor(
   extension_ranges=[],
   oneofs=[
   ],
-  serialized_start=4969,
-  serialized_end=5037,
+  serialized_start=5447,
+  serialized_end=5515,
 )
 
 
@@ -3605,6 +4077,133 @@ _ZINFODEVICE = _descriptor.Descriptor(
       message_type=None, enum_type=None, containing_type=None,
       is_extension=False, extension_scope=None,
       serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
+    _descriptor.FieldDescriptor(
+      name='local_operations', full_name='org.lfedge.eve.info.ZInfoDevice.local_operations', index=46,
+      number=52, type=11, cpp_type=10, label=1,
+      has_default_value=False, default_value=None,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
+  ],
+  extensions=[
+  ],
+  nested_types=[],
+  enum_types=[
+  ],
+  serialized_options=None,
+  is_extendable=False,
+  syntax='proto3',
+  extension_ranges=[],
+  oneofs=[
+  ],
+  serialized_start=5518,
+  serialized_end=7588,
+)
+
+
+_LOCALOPERATIONRECORD = _descriptor.Descriptor(
+  name='LocalOperationRecord',
+  full_name='org.lfedge.eve.info.LocalOperationRecord',
+  filename=None,
+  file=DESCRIPTOR,
+  containing_type=None,
+  create_key=_descriptor._internal_create_key,
+  fields=[
+    _descriptor.FieldDescriptor(
+      name='time', full_name='org.lfedge.eve.info.LocalOperationRecord.time', index=0,
+      number=1, type=11, cpp_type=10, label=1,
+      has_default_value=False, default_value=None,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
+    _descriptor.FieldDescriptor(
+      name='operation_id', full_name='org.lfedge.eve.info.LocalOperationRecord.operation_id', index=1,
+      number=2, type=9, cpp_type=9, label=1,
+      has_default_value=False, default_value=b"".decode('utf-8'),
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
+    _descriptor.FieldDescriptor(
+      name='operation_timestamp', full_name='org.lfedge.eve.info.LocalOperationRecord.operation_timestamp', index=2,
+      number=3, type=4, cpp_type=4, label=1,
+      has_default_value=False, default_value=0,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
+    _descriptor.FieldDescriptor(
+      name='type', full_name='org.lfedge.eve.info.LocalOperationRecord.type', index=3,
+      number=4, type=14, cpp_type=8, label=1,
+      has_default_value=False, default_value=0,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
+    _descriptor.FieldDescriptor(
+      name='target', full_name='org.lfedge.eve.info.LocalOperationRecord.target', index=4,
+      number=5, type=9, cpp_type=9, label=1,
+      has_default_value=False, default_value=b"".decode('utf-8'),
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
+    _descriptor.FieldDescriptor(
+      name='details', full_name='org.lfedge.eve.info.LocalOperationRecord.details', index=5,
+      number=6, type=9, cpp_type=9, label=1,
+      has_default_value=False, default_value=b"".decode('utf-8'),
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
+    _descriptor.FieldDescriptor(
+      name='result', full_name='org.lfedge.eve.info.LocalOperationRecord.result', index=6,
+      number=7, type=14, cpp_type=8, label=1,
+      has_default_value=False, default_value=0,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
+    _descriptor.FieldDescriptor(
+      name='error', full_name='org.lfedge.eve.info.LocalOperationRecord.error', index=7,
+      number=8, type=9, cpp_type=9, label=1,
+      has_default_value=False, default_value=b"".decode('utf-8'),
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
+  ],
+  extensions=[
+  ],
+  nested_types=[],
+  enum_types=[
+  ],
+  serialized_options=None,
+  is_extendable=False,
+  syntax='proto3',
+  extension_ranges=[],
+  oneofs=[
+  ],
+  serialized_start=7591,
+  serialized_end=7868,
+)
+
+
+_LOCALOPERATIONSINFO = _descriptor.Descriptor(
+  name='LocalOperationsInfo',
+  full_name='org.lfedge.eve.info.LocalOperationsInfo',
+  filename=None,
+  file=DESCRIPTOR,
+  containing_type=None,
+  create_key=_descriptor._internal_create_key,
+  fields=[
+    _descriptor.FieldDescriptor(
+      name='api_version', full_name='org.lfedge.eve.info.LocalOperationsInfo.api_version', index=0,
+      number=1, type=13, cpp_type=3, label=1,
+      has_default_value=False, default_value=0,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
+    _descriptor.FieldDescriptor(
+      name='records', full_name='org.lfedge.eve.info.LocalOperationsInfo.records', index=1,
+      number=2, type=11, cpp_type=10, label=3,
+      has_default_value=False, default_value=[],
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
   ],
   extensions=[
   ],
@@ -3617,8 +4216,8 @@ _ZINFODEVICE = _descriptor.Descriptor(
   extension_ranges=[],
   oneofs=[
   ],
-  serialized_start=5040,
-  serialized_end=7042,
+  serialized_start=7870,
+  serialized_end=7972,
 )
 
 
@@ -3656,8 +4255,8 @@ _ATTESTATIONINFO = _descriptor.Descriptor(
   extension_ranges=[],
   oneofs=[
   ],
-  serialized_start=7044,
-  serialized_end=7162,
+  serialized_start=7974,
+  serialized_end=8092,
 )
 
 
@@ -3695,8 +4294,8 @@ _SYSTEMADAPTERINFO = _descriptor.Descriptor(
   extension_ranges=[],
   oneofs=[
   ],
-  serialized_start=7164,
-  serialized_end=7260,
+  serialized_start=8094,
+  serialized_end=8190,
 )
 
 
@@ -3769,8 +4368,8 @@ _DEVICEPORTSTATUS = _descriptor.Descriptor(
   extension_ranges=[],
   oneofs=[
   ],
-  serialized_start=7263,
-  serialized_end=7527,
+  serialized_start=8193,
+  serialized_end=8457,
 )
 
 
@@ -3962,8 +4561,8 @@ _DEVICEPORT = _descriptor.Descriptor(
   extension_ranges=[],
   oneofs=[
   ],
-  serialized_start=7530,
-  serialized_end=8165,
+  serialized_start=8460,
+  serialized_end=9095,
 )
 
 
@@ -4029,8 +4628,8 @@ _PROXYSTATUS = _descriptor.Descriptor(
   extension_ranges=[],
   oneofs=[
   ],
-  serialized_start=8168,
-  serialized_end=8338,
+  serialized_start=9098,
+  serialized_end=9268,
 )
 
 
@@ -4075,8 +4674,8 @@ _PROXYENTRY = _descriptor.Descriptor(
   extension_ranges=[],
   oneofs=[
   ],
-  serialized_start=8340,
-  serialized_end=8396,
+  serialized_start=9270,
+  serialized_end=9326,
 )
 
 
@@ -4114,8 +4713,8 @@ _WIRELESSSTATUS = _descriptor.Descriptor(
   extension_ranges=[],
   oneofs=[
   ],
-  serialized_start=8398,
-  serialized_end=8519,
+  serialized_start=9328,
+  serialized_end=9449,
 )
 
 
@@ -4162,6 +4761,20 @@ _ZCELLULARSTATUS = _descriptor.Descriptor(
       message_type=None, enum_type=None, containing_type=None,
       is_extension=False, extension_scope=None,
       serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
+    _descriptor.FieldDescriptor(
+      name='active_sim_slot', full_name='org.lfedge.eve.info.ZCellularStatus.active_sim_slot', index=5,
+      number=4, type=13, cpp_type=3, label=1,
+      has_default_value=False, default_value=0,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
+    _descriptor.FieldDescriptor(
+      name='data_usage', full_name='org.lfedge.eve.info.ZCellularStatus.data_usage', index=6,
+      number=5, type=11, cpp_type=10, label=3,
+      has_default_value=False, default_value=[],
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
   ],
   extensions=[
   ],
@@ -4174,98 +4787,186 @@ _ZCELLULARSTATUS = _descriptor.Descriptor(
   extension_ranges=[],
   oneofs=[
   ],
-  serialized_start=8522,
-  serialized_end=8685,
+  serialized_start=9452,
+  serialized_end=9701,
 )
 
 
-_ZINFODEVSW = _descriptor.Descriptor(
-  name='ZInfoDevSW',
-  full_name='org.lfedge.eve.info.ZInfoDevSW',
+_ZCELLULARDATAUSAGE = _descriptor.Descriptor(
+  name='ZCellularDataUsage',
+  full_name='org.lfedge.eve.info.ZCellularDataUsage',
   filename=None,
   file=DESCRIPTOR,
   containing_type=None,
   create_key=_descriptor._internal_create_key,
   fields=[
     _descriptor.FieldDescriptor(
-      name='activated', full_name='org.lfedge.eve.info.ZInfoDevSW.activated', index=0,
-      number=2, type=8, cpp_type=7, label=1,
-      has_default_value=False, default_value=False,
+      name='sim_slot', full_name='org.lfedge.eve.info.ZCellularDataUsage.sim_slot', index=0,
+      number=1, type=13, cpp_type=3, label=1,
+      has_default_value=False, default_value=0,
       message_type=None, enum_type=None, containing_type=None,
       is_extension=False, extension_scope=None,
       serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
     _descriptor.FieldDescriptor(
-      name='partitionLabel', full_name='org.lfedge.eve.info.ZInfoDevSW.partitionLabel', index=1,
-      number=3, type=9, cpp_type=9, label=1,
+      name='iccid', full_name='org.lfedge.eve.info.ZCellularDataUsage.iccid', index=1,
+      number=2, type=9, cpp_type=9, label=1,
       has_default_value=False, default_value=b"".decode('utf-8'),
       message_type=None, enum_type=None, containing_type=None,
       is_extension=False, extension_scope=None,
       serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
     _descriptor.FieldDescriptor(
-      name='partitionDevice', full_name='org.lfedge.eve.info.ZInfoDevSW.partitionDevice', index=2,
-      number=4, type=9, cpp_type=9, label=1,
-      has_default_value=False, default_value=b"".decode('utf-8'),
+      name='cycle_start', full_name='org.lfedge.eve.info.ZCellularDataUsage.cycle_start', index=2,
+      number=3, type=11, cpp_type=10, label=1,
+      has_default_value=False, default_value=None,
       message_type=None, enum_type=None, containing_type=None,
       is_extension=False, extension_scope=None,
       serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
     _descriptor.FieldDescriptor(
-      name='partitionState', full_name='org.lfedge.eve.info.ZInfoDevSW.partitionState', index=3,
-      number=5, type=9, cpp_type=9, label=1,
-      has_default_value=False, default_value=b"".decode('utf-8'),
+      name='cycle_end', full_name='org.lfedge.eve.info.ZCellularDataUsage.cycle_end', index=3,
+      number=4, type=11, cpp_type=10, label=1,
+      has_default_value=False, default_value=None,
       message_type=None, enum_type=None, containing_type=None,
       is_extension=False, extension_scope=None,
       serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
     _descriptor.FieldDescriptor(
-      name='status', full_name='org.lfedge.eve.info.ZInfoDevSW.status', index=4,
-      number=6, type=14, cpp_type=8, label=1,
+      name='rx_bytes', full_name='org.lfedge.eve.info.ZCellularDataUsage.rx_bytes', index=4,
+      number=5, type=4, cpp_type=4, label=1,
       has_default_value=False, default_value=0,
       message_type=None, enum_type=None, containing_type=None,
       is_extension=False, extension_scope=None,
       serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
     _descriptor.FieldDescriptor(
-      name='shortVersion', full_name='org.lfedge.eve.info.ZInfoDevSW.shortVersion', index=5,
-      number=7, type=9, cpp_type=9, label=1,
-      has_default_value=False, default_value=b"".decode('utf-8'),
-      message_type=None, enum_type=None, containing_type=None,
-      is_extension=False, extension_scope=None,
-      serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
-    _descriptor.FieldDescriptor(
-      name='longVersion', full_name='org.lfedge.eve.info.ZInfoDevSW.longVersion', index=6,
-      number=8, type=9, cpp_type=9, label=1,
-      has_default_value=False, default_value=b"".decode('utf-8'),
-      message_type=None, enum_type=None, containing_type=None,
-      is_extension=False, extension_scope=None,
-      serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
-    _descriptor.FieldDescriptor(
-      name='swErr', full_name='org.lfedge.eve.info.ZInfoDevSW.swErr', index=7,
-      number=9, type=11, cpp_type=10, label=1,
-      has_default_value=False, default_value=None,
+      name='tx_bytes', full_name='org.lfedge.eve.info.ZCellularDataUsage.tx_bytes', index=5,
+      number=6, type=4, cpp_type=4, label=1,
+      has_default_value=False, default_value=0,
       message_type=None, enum_type=None, containing_type=None,
       is_extension=False, extension_scope=None,
       serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
     _descriptor.FieldDescriptor(
-      name='downloadProgress', full_name='org.lfedge.eve.info.ZInfoDevSW.downloadProgress', index=8,
-      number=10, type=13, cpp_type=3, label=1,
+      name='limit_bytes', full_name='org.lfedge.eve.info.ZCellularDataUsage.limit_bytes', index=6,
+      number=7, type=4, cpp_type=4, label=1,
       has_default_value=False, default_value=0,
       message_type=None, enum_type=None, containing_type=None,
       is_extension=False, extension_scope=None,
       serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
     _descriptor.FieldDescriptor(
-      name='userStatus', full_name='org.lfedge.eve.info.ZInfoDevSW.userStatus', index=9,
-      number=11, type=14, cpp_type=8, label=1,
+      name='quota_state', full_name='org.lfedge.eve.info.ZCellularDataUsage.quota_state', index=7,
+      number=8, type=14, cpp_type=8, label=1,
       has_default_value=False, default_value=0,
       message_type=None, enum_type=None, containing_type=None,
       is_extension=False, extension_scope=None,
       serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
     _descriptor.FieldDescriptor(
-      name='subStatusStr', full_name='org.lfedge.eve.info.ZInfoDevSW.subStatusStr', index=10,
-      number=12, type=9, cpp_type=9, label=1,
-      has_default_value=False, default_value=b"".decode('utf-8'),
+      name='blocked_traffic', full_name='org.lfedge.eve.info.ZCellularDataUsage.blocked_traffic', index=8,
+      number=9, type=14, cpp_type=8, label=3,
+      has_default_value=False, default_value=[],
       message_type=None, enum_type=None, containing_type=None,
       is_extension=False, extension_scope=None,
       serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
-    _descriptor.FieldDescriptor(
-      name='subStatus', full_name='org.lfedge.eve.info.ZInfoDevSW.subStatus', index=11,
+  ],
+  extensions=[
+  ],
+  nested_types=[],
+  enum_types=[
+  ],
+  serialized_options=None,
+  is_extendable=False,
+  syntax='proto3',
+  extension_ranges=[],
+  oneofs=[
+  ],
+  serialized_start=9704,
+  serialized_end=10042,
+)
+
+
+_ZINFODEVSW = _descriptor.Descriptor(
+  name='ZInfoDevSW',
+  full_name='org.lfedge.eve.info.ZInfoDevSW',
+  filename=None,
+  file=DESCRIPTOR,
+  containing_type=None,
+  create_key=_descriptor._internal_create_key,
+  fields=[
+    _descriptor.FieldDescriptor(
+      name='activated', full_name='org.lfedge.eve.info.ZInfoDevSW.activated', index=0,
+      number=2, type=8, cpp_type=7, label=1,
+      has_default_value=False, default_value=False,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
+    _descriptor.FieldDescriptor(
+      name='partitionLabel', full_name='org.lfedge.eve.info.ZInfoDevSW.partitionLabel', index=1,
+      number=3, type=9, cpp_type=9, label=1,
+      has_default_value=False, default_value=b"".decode('utf-8'),
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
+    _descriptor.FieldDescriptor(
+      name='partitionDevice', full_name='org.lfedge.eve.info.ZInfoDevSW.partitionDevice', index=2,
+      number=4, type=9, cpp_type=9, label=1,
+      has_default_value=False, default_value=b"".decode('utf-8'),
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
+    _descriptor.FieldDescriptor(
+      name='partitionState', full_name='org.lfedge.eve.info.ZInfoDevSW.partitionState', index=3,
+      number=5, type=9, cpp_type=9, label=1,
+      has_default_value=False, default_value=b"".decode('utf-8'),
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
+    _descriptor.FieldDescriptor(
+      name='status', full_name='org.lfedge.eve.info.ZInfoDevSW.status', index=4,
+      number=6, type=14, cpp_type=8, label=1,
+      has_default_value=False, default_value=0,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
+    _descriptor.FieldDescriptor(
+      name='shortVersion', full_name='org.lfedge.eve.info.ZInfoDevSW.shortVersion', index=5,
+      number=7, type=9, cpp_type=9, label=1,
+      has_default_value=False, default_value=b"".decode('utf-8'),
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
+    _descriptor.FieldDescriptor(
+      name='longVersion', full_name='org.lfedge.eve.info.ZInfoDevSW.longVersion', index=6,
+      number=8, type=9, cpp_type=9, label=1,
+      has_default_value=False, default_value=b"".decode('utf-8'),
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
+    _descriptor.FieldDescriptor(
+      name='swErr', full_name='org.lfedge.eve.info.ZInfoDevSW.swErr', index=7,
+      number=9, type=11, cpp_type=10, label=1,
+      has_default_value=False, default_value=None,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
+    _descriptor.FieldDescriptor(
+      name='downloadProgress', full_name='org.lfedge.eve.info.ZInfoDevSW.downloadProgress', index=8,
+      number=10, type=13, cpp_type=3, label=1,
+      has_default_value=False, default_value=0,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
+    _descriptor.FieldDescriptor(
+      name='userStatus', full_name='org.lfedge.eve.info.ZInfoDevSW.userStatus', index=9,
+      number=11, type=14, cpp_type=8, label=1,
+      has_default_value=False, default_value=0,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
+    _descriptor.FieldDescriptor(
+      name='subStatusStr', full_name='org.lfedge.eve.info.ZInfoDevSW.subStatusStr', index=10,
+      number=12, type=9, cpp_type=9, label=1,
+      has_default_value=False, default_value=b"".decode('utf-8'),
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
+    _descriptor.FieldDescriptor(
+      name='subStatus', full_name='org.lfedge.eve.info.ZInfoDevSW.subStatus', index=11,
       number=13, type=14, cpp_type=8, label=1,
       has_default_value=False, default_value=0,
       message_type=None, enum_type=None, containing_type=None,
@@ -4290,44 +4991,354 @@ _ZINFODEVSW = _descriptor.Descriptor(
   extension_ranges=[],
   oneofs=[
   ],
-  serialized_start=8688,
-  serialized_end=9116,
+  serialized_start=10045,
+  serialized_end=10473,
+)
+
+
+_ZINFOSTORAGE = _descriptor.Descriptor(
+  name='ZInfoStorage',
+  full_name='org.lfedge.eve.info.ZInfoStorage',
+  filename=None,
+  file=DESCRIPTOR,
+  containing_type=None,
+  create_key=_descriptor._internal_create_key,
+  fields=[
+    _descriptor.FieldDescriptor(
+      name='device', full_name='org.lfedge.eve.info.ZInfoStorage.device', index=0,
+      number=1, type=9, cpp_type=9, label=1,
+      has_default_value=False, default_value=b"".decode('utf-8'),
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
+    _descriptor.FieldDescriptor(
+      name='mountPath', full_name='org.lfedge.eve.info.ZInfoStorage.mountPath', index=1,
+      number=2, type=9, cpp_type=9, label=1,
+      has_default_value=False, default_value=b"".decode('utf-8'),
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
+    _descriptor.FieldDescriptor(
+      name='total', full_name='org.lfedge.eve.info.ZInfoStorage.total', index=2,
+      number=3, type=4, cpp_type=4, label=1,
+      has_default_value=False, default_value=0,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
+    _descriptor.FieldDescriptor(
+      name='storageLocation', full_name='org.lfedge.eve.info.ZInfoStorage.storageLocation', index=3,
+      number=4, type=8, cpp_type=7, label=1,
+      has_default_value=False, default_value=False,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
+  ],
+  extensions=[
+  ],
+  nested_types=[],
+  enum_types=[
+  ],
+  serialized_options=None,
+  is_extendable=False,
+  syntax='proto3',
+  extension_ranges=[],
+  oneofs=[
+  ],
+  serialized_start=10475,
+  serialized_end=10564,
+)
+
+
+_ZINFOAPP = _descriptor.Descriptor(
+  name='ZInfoApp',
+  full_name='org.lfedge.eve.info.ZInfoApp',
+  filename=None,
+  file=DESCRIPTOR,
+  containing_type=None,
+  create_key=_descriptor._internal_create_key,
+  fields=[
+    _descriptor.FieldDescriptor(
+      name='AppID', full_name='org.lfedge.eve.info.ZInfoApp.AppID', index=0,
+      number=1, type=9, cpp_type=9, label=1,
+      has_default_value=False, default_value=b"".decode('utf-8'),
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
+    _descriptor.FieldDescriptor(
+      name='appVersion', full_name='org.lfedge.eve.info.ZInfoApp.appVersion', index=1,
+      number=2, type=9, cpp_type=9, label=1,
+      has_default_value=False, default_value=b"".decode('utf-8'),
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
+    _descriptor.FieldDescriptor(
+      name='systemApp', full_name='org.lfedge.eve.info.ZInfoApp.systemApp', index=2,
+      number=6, type=8, cpp_type=7, label=1,
+      has_default_value=False, default_value=False,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
+    _descriptor.FieldDescriptor(
+      name='AppName', full_name='org.lfedge.eve.info.ZInfoApp.AppName', index=3,
+      number=7, type=9, cpp_type=9, label=1,
+      has_default_value=False, default_value=b"".decode('utf-8'),
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
+    _descriptor.FieldDescriptor(
+      name='softwareList', full_name='org.lfedge.eve.info.ZInfoApp.softwareList', index=4,
+      number=8, type=11, cpp_type=10, label=3,
+      has_default_value=False, default_value=[],
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
+    _descriptor.FieldDescriptor(
+      name='bootTime', full_name='org.lfedge.eve.info.ZInfoApp.bootTime', index=5,
+      number=12, type=11, cpp_type=10, label=1,
+      has_default_value=False, default_value=None,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
+    _descriptor.FieldDescriptor(
+      name='assignedAdapters', full_name='org.lfedge.eve.info.ZInfoApp.assignedAdapters', index=6,
+      number=13, type=11, cpp_type=10, label=3,
+      has_default_value=False, default_value=[],
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
+    _descriptor.FieldDescriptor(
+      name='appErr', full_name='org.lfedge.eve.info.ZInfoApp.appErr', index=7,
+      number=14, type=11, cpp_type=10, label=3,
+      has_default_value=False, default_value=[],
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
+    _descriptor.FieldDescriptor(
+      name='state', full_name='org.lfedge.eve.info.ZInfoApp.state', index=8,
+      number=15, type=14, cpp_type=8, label=1,
+      has_default_value=False, default_value=0,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
+    _descriptor.FieldDescriptor(
+      name='network', full_name='org.lfedge.eve.info.ZInfoApp.network', index=9,
+      number=16, type=11, cpp_type=10, label=3,
+      has_default_value=False, default_value=[],
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
+    _descriptor.FieldDescriptor(
+      name='volumeRefs', full_name='org.lfedge.eve.info.ZInfoApp.volumeRefs', index=10,
+      number=17, type=9, cpp_type=9, label=3,
+      has_default_value=False, default_value=[],
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
+    _descriptor.FieldDescriptor(
+      name='activation_deferred_until', full_name='org.lfedge.eve.info.ZInfoApp.activation_deferred_until', index=11,
+      number=18, type=11, cpp_type=10, label=1,
+      has_default_value=False, default_value=None,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
+    _descriptor.FieldDescriptor(
+      name='restart_count', full_name='org.lfedge.eve.info.ZInfoApp.restart_count', index=12,
+      number=19, type=13, cpp_type=3, label=1,
+      has_default_value=False, default_value=0,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
+    _descriptor.FieldDescriptor(
+      name='last_exit_reason', full_name='org.lfedge.eve.info.ZInfoApp.last_exit_reason', index=13,
+      number=20, type=9, cpp_type=9, label=1,
+      has_default_value=False, default_value=b"".decode('utf-8'),
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
+    _descriptor.FieldDescriptor(
+      name='last_exit_time', full_name='org.lfedge.eve.info.ZInfoApp.last_exit_time', index=14,
+      number=21, type=11, cpp_type=10, label=1,
+      has_default_value=False, default_value=None,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
+    _descriptor.FieldDescriptor(
+      name='pod_containers', full_name='org.lfedge.eve.info.ZInfoApp.pod_containers', index=15,
+      number=22, type=11, cpp_type=10, label=3,
+      has_default_value=False, default_value=[],
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
+    _descriptor.FieldDescriptor(
+      name='health', full_name='org.lfedge.eve.info.ZInfoApp.health', index=16,
+      number=23, type=11, cpp_type=10, label=1,
+      has_default_value=False, default_value=None,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
+    _descriptor.FieldDescriptor(
+      name='guest', full_name='org.lfedge.eve.info.ZInfoApp.guest', index=17,
+      number=24, type=11, cpp_type=10, label=1,
+      has_default_value=False, default_value=None,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
+  ],
+  extensions=[
+  ],
+  nested_types=[],
+  enum_types=[
+  ],
+  serialized_options=None,
+  is_extendable=False,
+  syntax='proto3',
+  extension_ranges=[],
+  oneofs=[
+  ],
+  serialized_start=10567,
+  serialized_end=11302,
+)
+
+
+_ZINFOAPPHEALTH = _descriptor.Descriptor(
+  name='ZInfoAppHealth',
+  full_name='org.lfedge.eve.info.ZInfoAppHealth',
+  filename=None,
+  file=DESCRIPTOR,
+  containing_type=None,
+  create_key=_descriptor._internal_create_key,
+  fields=[
+    _descriptor.FieldDescriptor(
+      name='state', full_name='org.lfedge.eve.info.ZInfoAppHealth.state', index=0,
+      number=1, type=14, cpp_type=8, label=1,
+      has_default_value=False, default_value=0,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
+    _descriptor.FieldDescriptor(
+      name='failing_streak', full_name='org.lfedge.eve.info.ZInfoAppHealth.failing_streak', index=1,
+      number=2, type=13, cpp_type=3, label=1,
+      has_default_value=False, default_value=0,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
+    _descriptor.FieldDescriptor(
+      name='last_output', full_name='org.lfedge.eve.info.ZInfoAppHealth.last_output', index=2,
+      number=3, type=9, cpp_type=9, label=1,
+      has_default_value=False, default_value=b"".decode('utf-8'),
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
+    _descriptor.FieldDescriptor(
+      name='last_check_time', full_name='org.lfedge.eve.info.ZInfoAppHealth.last_check_time', index=3,
+      number=4, type=11, cpp_type=10, label=1,
+      has_default_value=False, default_value=None,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
+    _descriptor.FieldDescriptor(
+      name='restart_count', full_name='org.lfedge.eve.info.ZInfoAppHealth.restart_count', index=4,
+      number=5, type=13, cpp_type=3, label=1,
+      has_default_value=False, default_value=0,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
+  ],
+  extensions=[
+  ],
+  nested_types=[],
+  enum_types=[
+  ],
+  serialized_options=None,
+  is_extendable=False,
+  syntax='proto3',
+  extension_ranges=[],
+  oneofs=[
+  ],
+  serialized_start=11305,
+  serialized_end=11491,
+)
+
+
+_ZINFOGUESTINTERFACE = _descriptor.Descriptor(
+  name='ZInfoGuestInterface',
+  full_name='org.lfedge.eve.info.ZInfoGuestInterface',
+  filename=None,
+  file=DESCRIPTOR,
+  containing_type=None,
+  create_key=_descriptor._internal_create_key,
+  fields=[
+    _descriptor.FieldDescriptor(
+      name='name', full_name='org.lfedge.eve.info.ZInfoGuestInterface.name', index=0,
+      number=1, type=9, cpp_type=9, label=1,
+      has_default_value=False, default_value=b"".decode('utf-8'),
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
+    _descriptor.FieldDescriptor(
+      name='mac_address', full_name='org.lfedge.eve.info.ZInfoGuestInterface.mac_address', index=1,
+      number=2, type=9, cpp_type=9, label=1,
+      has_default_value=False, default_value=b"".decode('utf-8'),
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
+    _descriptor.FieldDescriptor(
+      name='ip_addrs', full_name='org.lfedge.eve.info.ZInfoGuestInterface.ip_addrs', index=2,
+      number=3, type=9, cpp_type=9, label=3,
+      has_default_value=False, default_value=[],
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
+  ],
+  extensions=[
+  ],
+  nested_types=[],
+  enum_types=[
+  ],
+  serialized_options=None,
+  is_extendable=False,
+  syntax='proto3',
+  extension_ranges=[],
+  oneofs=[
+  ],
+  serialized_start=11493,
+  serialized_end=11567,
 )
 
 
-_ZINFOSTORAGE = _descriptor.Descriptor(
-  name='ZInfoStorage',
-  full_name='org.lfedge.eve.info.ZInfoStorage',
+_ZINFOGUESTFILESYSTEM = _descriptor.Descriptor(
+  name='ZInfoGuestFilesystem',
+  full_name='org.lfedge.eve.info.ZInfoGuestFilesystem',
   filename=None,
   file=DESCRIPTOR,
   containing_type=None,
   create_key=_descriptor._internal_create_key,
   fields=[
     _descriptor.FieldDescriptor(
-      name='device', full_name='org.lfedge.eve.info.ZInfoStorage.device', index=0,
+      name='mountpoint', full_name='org.lfedge.eve.info.ZInfoGuestFilesystem.mountpoint', index=0,
       number=1, type=9, cpp_type=9, label=1,
       has_default_value=False, default_value=b"".decode('utf-8'),
       message_type=None, enum_type=None, containing_type=None,
       is_extension=False, extension_scope=None,
       serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
     _descriptor.FieldDescriptor(
-      name='mountPath', full_name='org.lfedge.eve.info.ZInfoStorage.mountPath', index=1,
+      name='type', full_name='org.lfedge.eve.info.ZInfoGuestFilesystem.type', index=1,
       number=2, type=9, cpp_type=9, label=1,
       has_default_value=False, default_value=b"".decode('utf-8'),
       message_type=None, enum_type=None, containing_type=None,
       is_extension=False, extension_scope=None,
       serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
     _descriptor.FieldDescriptor(
-      name='total', full_name='org.lfedge.eve.info.ZInfoStorage.total', index=2,
+      name='total_bytes', full_name='org.lfedge.eve.info.ZInfoGuestFilesystem.total_bytes', index=2,
       number=3, type=4, cpp_type=4, label=1,
       has_default_value=False, default_value=0,
       message_type=None, enum_type=None, containing_type=None,
       is_extension=False, extension_scope=None,
       serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
     _descriptor.FieldDescriptor(
-      name='storageLocation', full_name='org.lfedge.eve.info.ZInfoStorage.storageLocation', index=3,
-      number=4, type=8, cpp_type=7, label=1,
-      has_default_value=False, default_value=False,
+      name='used_bytes', full_name='org.lfedge.eve.info.ZInfoGuestFilesystem.used_bytes', index=3,
+      number=4, type=4, cpp_type=4, label=1,
+      has_default_value=False, default_value=0,
       message_type=None, enum_type=None, containing_type=None,
       is_extension=False, extension_scope=None,
       serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
@@ -4343,93 +5354,132 @@ _ZINFOSTORAGE = _descriptor.Descriptor(
   extension_ranges=[],
   oneofs=[
   ],
-  serialized_start=9118,
-  serialized_end=9207,
+  serialized_start=11569,
+  serialized_end=11666,
 )
 
 
-_ZINFOAPP = _descriptor.Descriptor(
-  name='ZInfoApp',
-  full_name='org.lfedge.eve.info.ZInfoApp',
+_ZINFOAPPGUEST = _descriptor.Descriptor(
+  name='ZInfoAppGuest',
+  full_name='org.lfedge.eve.info.ZInfoAppGuest',
   filename=None,
   file=DESCRIPTOR,
   containing_type=None,
   create_key=_descriptor._internal_create_key,
   fields=[
     _descriptor.FieldDescriptor(
-      name='AppID', full_name='org.lfedge.eve.info.ZInfoApp.AppID', index=0,
+      name='os_name', full_name='org.lfedge.eve.info.ZInfoAppGuest.os_name', index=0,
       number=1, type=9, cpp_type=9, label=1,
       has_default_value=False, default_value=b"".decode('utf-8'),
       message_type=None, enum_type=None, containing_type=None,
       is_extension=False, extension_scope=None,
       serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
     _descriptor.FieldDescriptor(
-      name='appVersion', full_name='org.lfedge.eve.info.ZInfoApp.appVersion', index=1,
+      name='os_version', full_name='org.lfedge.eve.info.ZInfoAppGuest.os_version', index=1,
       number=2, type=9, cpp_type=9, label=1,
       has_default_value=False, default_value=b"".decode('utf-8'),
       message_type=None, enum_type=None, containing_type=None,
       is_extension=False, extension_scope=None,
       serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
     _descriptor.FieldDescriptor(
-      name='systemApp', full_name='org.lfedge.eve.info.ZInfoApp.systemApp', index=2,
-      number=6, type=8, cpp_type=7, label=1,
-      has_default_value=False, default_value=False,
+      name='kernel_release', full_name='org.lfedge.eve.info.ZInfoAppGuest.kernel_release', index=2,
+      number=3, type=9, cpp_type=9, label=1,
+      has_default_value=False, default_value=b"".decode('utf-8'),
       message_type=None, enum_type=None, containing_type=None,
       is_extension=False, extension_scope=None,
       serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
     _descriptor.FieldDescriptor(
-      name='AppName', full_name='org.lfedge.eve.info.ZInfoApp.AppName', index=3,
-      number=7, type=9, cpp_type=9, label=1,
+      name='hostname', full_name='org.lfedge.eve.info.ZInfoAppGuest.hostname', index=3,
+      number=4, type=9, cpp_type=9, label=1,
       has_default_value=False, default_value=b"".decode('utf-8'),
       message_type=None, enum_type=None, containing_type=None,
       is_extension=False, extension_scope=None,
       serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
     _descriptor.FieldDescriptor(
-      name='softwareList', full_name='org.lfedge.eve.info.ZInfoApp.softwareList', index=4,
-      number=8, type=11, cpp_type=10, label=3,
+      name='interfaces', full_name='org.lfedge.eve.info.ZInfoAppGuest.interfaces', index=4,
+      number=5, type=11, cpp_type=10, label=3,
       has_default_value=False, default_value=[],
       message_type=None, enum_type=None, containing_type=None,
       is_extension=False, extension_scope=None,
       serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
     _descriptor.FieldDescriptor(
-      name='bootTime', full_name='org.lfedge.eve.info.ZInfoApp.bootTime', index=5,
-      number=12, type=11, cpp_type=10, label=1,
+      name='filesystems', full_name='org.lfedge.eve.info.ZInfoAppGuest.filesystems', index=5,
+      number=6, type=11, cpp_type=10, label=3,
+      has_default_value=False, default_value=[],
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
+    _descriptor.FieldDescriptor(
+      name='update_time', full_name='org.lfedge.eve.info.ZInfoAppGuest.update_time', index=6,
+      number=7, type=11, cpp_type=10, label=1,
       has_default_value=False, default_value=None,
       message_type=None, enum_type=None, containing_type=None,
       is_extension=False, extension_scope=None,
       serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
+  ],
+  extensions=[
+  ],
+  nested_types=[],
+  enum_types=[
+  ],
+  serialized_options=None,
+  is_extendable=False,
+  syntax='proto3',
+  extension_ranges=[],
+  oneofs=[
+  ],
+  serialized_start=11669,
+  serialized_end=11938,
+)
+
+
+_ZINFOPODCONTAINER = _descriptor.Descriptor(
+  name='ZInfoPodContainer',
+  full_name='org.lfedge.eve.info.ZInfoPodContainer',
+  filename=None,
+  file=DESCRIPTOR,
+  containing_type=None,
+  create_key=_descriptor._internal_create_key,
+  fields=[
     _descriptor.FieldDescriptor(
-      name='assignedAdapters', full_name='org.lfedge.eve.info.ZInfoApp.assignedAdapters', index=6,
-      number=13, type=11, cpp_type=10, label=3,
-      has_default_value=False, default_value=[],
+      name='name', full_name='org.lfedge.eve.info.ZInfoPodContainer.name', index=0,
+      number=1, type=9, cpp_type=9, label=1,
+      has_default_value=False, default_value=b"".decode('utf-8'),
       message_type=None, enum_type=None, containing_type=None,
       is_extension=False, extension_scope=None,
       serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
     _descriptor.FieldDescriptor(
-      name='appErr', full_name='org.lfedge.eve.info.ZInfoApp.appErr', index=7,
-      number=14, type=11, cpp_type=10, label=3,
-      has_default_value=False, default_value=[],
+      name='state', full_name='org.lfedge.eve.info.ZInfoPodContainer.state', index=1,
+      number=2, type=14, cpp_type=8, label=1,
+      has_default_value=False, default_value=0,
       message_type=None, enum_type=None, containing_type=None,
       is_extension=False, extension_scope=None,
       serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
     _descriptor.FieldDescriptor(
-      name='state', full_name='org.lfedge.eve.info.ZInfoApp.state', index=8,
-      number=15, type=14, cpp_type=8, label=1,
+      name='exit_code', full_name='org.lfedge.eve.info.ZInfoPodContainer.exit_code', index=2,
+      number=3, type=5, cpp_type=1, label=1,
       has_default_value=False, default_value=0,
       message_type=None, enum_type=None, containing_type=None,
       is_extension=False, extension_scope=None,
       serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
     _descriptor.FieldDescriptor(
-      name='network', full_name='org.lfedge.eve.info.ZInfoApp.network', index=9,
-      number=16, type=11, cpp_type=10, label=3,
-      has_default_value=False, default_value=[],
+      name='restart_count', full_name='org.lfedge.eve.info.ZInfoPodContainer.restart_count', index=3,
+      number=4, type=13, cpp_type=3, label=1,
+      has_default_value=False, default_value=0,
       message_type=None, enum_type=None, containing_type=None,
       is_extension=False, extension_scope=None,
       serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
     _descriptor.FieldDescriptor(
-      name='volumeRefs', full_name='org.lfedge.eve.info.ZInfoApp.volumeRefs', index=10,
-      number=17, type=9, cpp_type=9, label=3,
-      has_default_value=False, default_value=[],
+      name='last_exit_reason', full_name='org.lfedge.eve.info.ZInfoPodContainer.last_exit_reason', index=4,
+      number=5, type=9, cpp_type=9, label=1,
+      has_default_value=False, default_value=b"".decode('utf-8'),
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
+    _descriptor.FieldDescriptor(
+      name='last_exit_time', full_name='org.lfedge.eve.info.ZInfoPodContainer.last_exit_time', index=5,
+      number=6, type=11, cpp_type=10, label=1,
+      has_default_value=False, default_value=None,
       message_type=None, enum_type=None, containing_type=None,
       is_extension=False, extension_scope=None,
       serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
@@ -4445,8 +5495,8 @@ _ZINFOAPP = _descriptor.Descriptor(
   extension_ranges=[],
   oneofs=[
   ],
-  serialized_start=9210,
-  serialized_end=9613,
+  serialized_start=11941,
+  serialized_end=12140,
 )
 
 
@@ -4491,8 +5541,8 @@ _ZINFOVPNLINKINFO = _descriptor.Descriptor(
   extension_ranges=[],
   oneofs=[
   ],
-  serialized_start=9615,
-  serialized_end=9683,
+  serialized_start=12142,
+  serialized_end=12210,
 )
 
 
@@ -4572,8 +5622,8 @@ _ZINFOVPNLINK = _descriptor.Descriptor(
   extension_ranges=[],
   oneofs=[
   ],
-  serialized_start=9686,
-  serialized_end=9935,
+  serialized_start=12213,
+  serialized_end=12462,
 )
 
 
@@ -4618,8 +5668,8 @@ _ZINFOVPNENDPOINT = _descriptor.Descriptor(
   extension_ranges=[],
   oneofs=[
   ],
-  serialized_start=9937,
-  serialized_end=9997,
+  serialized_start=12464,
+  serialized_end=12524,
 )
 
 
@@ -4706,8 +5756,8 @@ _ZINFOVPNCONN = _descriptor.Descriptor(
   extension_ranges=[],
   oneofs=[
   ],
-  serialized_start=10000,
-  serialized_end=10297,
+  serialized_start=12527,
+  serialized_end=12824,
 )
 
 
@@ -4759,8 +5809,8 @@ _ZINFOVPN = _descriptor.Descriptor(
   extension_ranges=[],
   oneofs=[
   ],
-  serialized_start=10299,
-  serialized_end=10421,
+  serialized_start=12826,
+  serialized_end=12948,
 )
 
 
@@ -4929,8 +5979,8 @@ _ZINFONETWORKINSTANCE = _descriptor.Descriptor(
       create_key=_descriptor._internal_create_key,
     fields=[]),
   ],
-  serialized_start=10424,
-  serialized_end=11150,
+  serialized_start=12951,
+  serialized_end=13677,
 )
 
 
@@ -4975,8 +6025,8 @@ _USAGEINFO = _descriptor.Descriptor(
   extension_ranges=[],
   oneofs=[
   ],
-  serialized_start=11153,
-  serialized_end=11290,
+  serialized_start=13680,
+  serialized_end=13817,
 )
 
 
@@ -5014,8 +6064,8 @@ _VOLUMERESOURCES = _descriptor.Descriptor(
   extension_ranges=[],
   oneofs=[
   ],
-  serialized_start=11292,
-  serialized_end=11353,
+  serialized_start=13819,
+  serialized_end=13880,
 )
 
 
@@ -5083,6 +6133,154 @@ _ZINFOVOLUME = _descriptor.Descriptor(
       message_type=None, enum_type=None, containing_type=None,
       is_extension=False, extension_scope=None,
       serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
+    _descriptor.FieldDescriptor(
+      name='backup', full_name='org.lfedge.eve.info.ZInfoVolume.backup', index=8,
+      number=9, type=11, cpp_type=10, label=1,
+      has_default_value=False, default_value=None,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
+    _descriptor.FieldDescriptor(
+      name='key', full_name='org.lfedge.eve.info.ZInfoVolume.key', index=9,
+      number=10, type=11, cpp_type=10, label=1,
+      has_default_value=False, default_value=None,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
+  ],
+  extensions=[
+  ],
+  nested_types=[],
+  enum_types=[
+  ],
+  serialized_options=None,
+  is_extendable=False,
+  syntax='proto3',
+  extension_ranges=[],
+  oneofs=[
+  ],
+  serialized_start=13883,
+  serialized_end=14290,
+)
+
+
+_VOLUMEKEYINFO = _descriptor.Descriptor(
+  name='VolumeKeyInfo',
+  full_name='org.lfedge.eve.info.VolumeKeyInfo',
+  filename=None,
+  file=DESCRIPTOR,
+  containing_type=None,
+  create_key=_descriptor._internal_create_key,
+  fields=[
+    _descriptor.FieldDescriptor(
+      name='generation', full_name='org.lfedge.eve.info.VolumeKeyInfo.generation', index=0,
+      number=1, type=13, cpp_type=3, label=1,
+      has_default_value=False, default_value=0,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
+    _descriptor.FieldDescriptor(
+      name='last_rotation', full_name='org.lfedge.eve.info.VolumeKeyInfo.last_rotation', index=1,
+      number=2, type=11, cpp_type=10, label=1,
+      has_default_value=False, default_value=None,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
+    _descriptor.FieldDescriptor(
+      name='error', full_name='org.lfedge.eve.info.VolumeKeyInfo.error', index=2,
+      number=3, type=11, cpp_type=10, label=1,
+      has_default_value=False, default_value=None,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
+  ],
+  extensions=[
+  ],
+  nested_types=[],
+  enum_types=[
+  ],
+  serialized_options=None,
+  is_extendable=False,
+  syntax='proto3',
+  extension_ranges=[],
+  oneofs=[
+  ],
+  serialized_start=14293,
+  serialized_end=14426,
+)
+
+
+_VOLUMEBACKUPINFO = _descriptor.Descriptor(
+  name='VolumeBackupInfo',
+  full_name='org.lfedge.eve.info.VolumeBackupInfo',
+  filename=None,
+  file=DESCRIPTOR,
+  containing_type=None,
+  create_key=_descriptor._internal_create_key,
+  fields=[
+    _descriptor.FieldDescriptor(
+      name='state', full_name='org.lfedge.eve.info.VolumeBackupInfo.state', index=0,
+      number=1, type=14, cpp_type=8, label=1,
+      has_default_value=False, default_value=0,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
+    _descriptor.FieldDescriptor(
+      name='last_name', full_name='org.lfedge.eve.info.VolumeBackupInfo.last_name', index=1,
+      number=2, type=9, cpp_type=9, label=1,
+      has_default_value=False, default_value=b"".decode('utf-8'),
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
+    _descriptor.FieldDescriptor(
+      name='last_sha256', full_name='org.lfedge.eve.info.VolumeBackupInfo.last_sha256', index=2,
+      number=3, type=9, cpp_type=9, label=1,
+      has_default_value=False, default_value=b"".decode('utf-8'),
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
+    _descriptor.FieldDescriptor(
+      name='last_time', full_name='org.lfedge.eve.info.VolumeBackupInfo.last_time', index=3,
+      number=4, type=11, cpp_type=10, label=1,
+      has_default_value=False, default_value=None,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
+    _descriptor.FieldDescriptor(
+      name='last_incremental', full_name='org.lfedge.eve.info.VolumeBackupInfo.last_incremental', index=4,
+      number=5, type=8, cpp_type=7, label=1,
+      has_default_value=False, default_value=False,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
+    _descriptor.FieldDescriptor(
+      name='last_size_bytes', full_name='org.lfedge.eve.info.VolumeBackupInfo.last_size_bytes', index=5,
+      number=6, type=4, cpp_type=4, label=1,
+      has_default_value=False, default_value=0,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
+    _descriptor.FieldDescriptor(
+      name='next_time', full_name='org.lfedge.eve.info.VolumeBackupInfo.next_time', index=6,
+      number=7, type=11, cpp_type=10, label=1,
+      has_default_value=False, default_value=None,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
+    _descriptor.FieldDescriptor(
+      name='progress', full_name='org.lfedge.eve.info.VolumeBackupInfo.progress', index=7,
+      number=8, type=13, cpp_type=3, label=1,
+      has_default_value=False, default_value=0,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
+    _descriptor.FieldDescriptor(
+      name='error', full_name='org.lfedge.eve.info.VolumeBackupInfo.error', index=8,
+      number=9, type=11, cpp_type=10, label=1,
+      has_default_value=False, default_value=None,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
   ],
   extensions=[
   ],
@@ -5095,8 +6293,8 @@ _ZINFOVOLUME = _descriptor.Descriptor(
   extension_ranges=[],
   oneofs=[
   ],
-  serialized_start=11356,
-  serialized_end=11659,
+  serialized_start=14429,
+  serialized_end=14752,
 )
 
 
@@ -5127,8 +6325,8 @@ _CONTENTRESOURCES = _descriptor.Descriptor(
   extension_ranges=[],
   oneofs=[
   ],
-  serialized_start=11661,
-  serialized_end=11701,
+  serialized_start=14754,
+  serialized_end=14794,
 )
 
 
@@ -5210,6 +6408,20 @@ _ZINFOCONTENTTREE = _descriptor.Descriptor(
       message_type=None, enum_type=None, containing_type=None,
       is_extension=False, extension_scope=None,
       serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
+    _descriptor.FieldDescriptor(
+      name='eta', full_name='org.lfedge.eve.info.ZInfoContentTree.eta', index=10,
+      number=11, type=11, cpp_type=10, label=1,
+      has_default_value=False, default_value=None,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
+    _descriptor.FieldDescriptor(
+      name='download_deferred_until', full_name='org.lfedge.eve.info.ZInfoContentTree.download_deferred_until', index=11,
+      number=12, type=11, cpp_type=10, label=1,
+      has_default_value=False, default_value=None,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
   ],
   extensions=[
   ],
@@ -5222,8 +6434,8 @@ _ZINFOCONTENTTREE = _descriptor.Descriptor(
   extension_ranges=[],
   oneofs=[
   ],
-  serialized_start=11704,
-  serialized_end=12049,
+  serialized_start=14797,
+  serialized_end=15244,
 )
 
 
@@ -5289,8 +6501,8 @@ _ZINFOBLOB = _descriptor.Descriptor(
   extension_ranges=[],
   oneofs=[
   ],
-  serialized_start=12052,
-  serialized_end=12303,
+  serialized_start=15247,
+  serialized_end=15498,
 )
 
 
@@ -5321,8 +6533,8 @@ _ZINFOBLOBLIST = _descriptor.Descriptor(
   extension_ranges=[],
   oneofs=[
   ],
-  serialized_start=12305,
-  serialized_end=12366,
+  serialized_start=15500,
+  serialized_end=15561,
 )
 
 
@@ -5442,8 +6654,8 @@ _ZINFOMSG = _descriptor.Descriptor(
       create_key=_descriptor._internal_create_key,
     fields=[]),
   ],
-  serialized_start=12369,
-  serialized_end=13051,
+  serialized_start=15564,
+  serialized_end=16246,
 )
 
 
@@ -5481,8 +6693,8 @@ _CAPABILITIES = _descriptor.Descriptor(
   extension_ranges=[],
   oneofs=[
   ],
-  serialized_start=13053,
-  serialized_end=13127,
+  serialized_start=16248,
+  serialized_end=16322,
 )
 
 
@@ -5527,8 +6739,8 @@ _ZINFOAPPINSTMETADATA = _descriptor.Descriptor(
   extension_ranges=[],
   oneofs=[
   ],
-  serialized_start=13129,
-  serialized_end=13235,
+  serialized_start=16324,
+  serialized_end=16430,
 )
 
 
@@ -5580,8 +6792,8 @@ _ZINFOEDGEVIEW = _descriptor.Descriptor(
   extension_ranges=[],
   oneofs=[
   ],
-  serialized_start=13238,
-  serialized_end=13390,
+  serialized_start=16433,
+  serialized_end=16585,
 )
 
 
@@ -5668,8 +6880,8 @@ _ZINFOLOCATION = _descriptor.Descriptor(
   extension_ranges=[],
   oneofs=[
   ],
-  serialized_start=13393,
-  serialized_end=13734,
+  serialized_start=16588,
+  serialized_end=16929,
 )
 
 _DEPRECATEDMETRICITEM.fields_by_name['type'].enum_type = _DEPMETRICITEMTYPE
@@ -5717,6 +6929,10 @@ _ZCELLULARMODULEINFO.fields_by_name['operating_state'].enum_type = _ZCELLULAROPE
 _ZCELLULARMODULEINFO.fields_by_name['control_protocol'].enum_type = _ZCELLULARCONTROLPROTOCOL
 _STORAGEDISKSTATE.fields_by_name['disk_name'].message_type = evecommon_dot_evecommon__pb2._DISKDESCRIPTION
 _STORAGEDISKSTATE.fields_by_name['status'].enum_type = _STORAGESTATUS
+_STORAGESCANSTATUS.fields_by_name['type'].enum_type = _STORAGESCANTYPE
+_STORAGESCANSTATUS.fields_by_name['state'].enum_type = _STORAGESCANSTATE
+_STORAGESCANSTATUS.fields_by_name['start_time'].message_type = google_dot_protobuf_dot_timestamp__pb2._TIMESTAMP
+_STORAGESCANSTATUS.fields_by_name['end_time'].message_type = google_dot_protobuf_dot_timestamp__pb2._TIMESTAMP
 _SMARTMETRIC.fields_by_name['reallocated_sector_ct'].message_type = _SMARTATTR
 _SMARTMETRIC.fields_by_name['power_on_hours'].message_type = _SMARTATTR
 _SMARTMETRIC.fields_by_name['power_cycle_count'].message_type = _SMARTATTR
@@ -5732,6 +6948,8 @@ _STORAGEINFO.fields_by_name['current_raid'].enum_type = _STORAGERAIDTYPE
 _STORAGEINFO.fields_by_name['storage_state'].enum_type = _STORAGESTATUS
 _STORAGEINFO.fields_by_name['disks'].message_type = _STORAGEDISKSTATE
 _STORAGEINFO.fields_by_name['children'].message_type = _STORAGECHILDREN
+_STORAGEINFO.fields_by_name['scan'].message_type = _STORAGESCANSTATUS
+_STORAGEINFO.fields_by_name['spares'].message_type = _STORAGEDISKSTATE
 _ZINFOHARDWARE.fields_by_name['disks'].message_type = _STORAGEDISKINFO
 _ZINFODEVICE.fields_by_name['minfo'].message_type = _ZINFOMANUFACTURER
 _ZINFODEVICE.fields_by_name['network'].message_type = _ZINFONETWORK
@@ -5759,6 +6977,11 @@ _ZINFODEVICE.fields_by_name['maintenance_mode_reasons'].enum_type = _MAINTENANCE
 _ZINFODEVICE.fields_by_name['storage_info'].message_type = _STORAGEINFO
 _ZINFODEVICE.fields_by_name['attestation_info'].message_type = _ATTESTATIONINFO
 _ZINFODEVICE.fields_by_name['api_capability'].enum_type = _APICAPABILITY
+_ZINFODEVICE.fields_by_name['local_operations'].message_type = _LOCALOPERATIONSINFO
+_LOCALOPERATIONRECORD.fields_by_name['time'].message_type = google_dot_protobuf_dot_timestamp__pb2._TIMESTAMP
+_LOCALOPERATIONRECORD.fields_by_name['type'].enum_type = _LOCALOPERATIONTYPE
+_LOCALOPERATIONRECORD.fields_by_name['result'].enum_type = _LOCALOPERATIONRESULT
+_LOCALOPERATIONSINFO.fields_by_name['records'].message_type = _LOCALOPERATIONRECORD
 _ATTESTATIONINFO.fields_by_name['state'].enum_type = _ATTESTATIONSTATE
 _ATTESTATIONINFO.fields_by_name['error'].message_type = _ERRORINFO
 _SYSTEMADAPTERINFO.fields_by_name['status'].message_type = _DEVICEPORTSTATUS
@@ -5776,6 +6999,11 @@ _PROXYSTATUS.fields_by_name['proxies'].message_type = _PROXYENTRY
 _WIRELESSSTATUS.fields_by_name['type'].enum_type = _WIRELESSTYPE
 _WIRELESSSTATUS.fields_by_name['cellular'].message_type = _ZCELLULARSTATUS
 _ZCELLULARSTATUS.fields_by_name['providers'].message_type = _ZCELLULARPROVIDER
+_ZCELLULARSTATUS.fields_by_name['data_usage'].message_type = _ZCELLULARDATAUSAGE
+_ZCELLULARDATAUSAGE.fields_by_name['cycle_start'].message_type = google_dot_protobuf_dot_timestamp__pb2._TIMESTAMP
+_ZCELLULARDATAUSAGE.fields_by_name['cycle_end'].message_type = google_dot_protobuf_dot_timestamp__pb2._TIMESTAMP
+_ZCELLULARDATAUSAGE.fields_by_name['quota_state'].enum_type = _ZCELLULARQUOTASTATE
+_ZCELLULARDATAUSAGE.fields_by_name['blocked_traffic'].enum_type = _ZCELLULARTRAFFICCLASS
 _ZINFODEVSW.fields_by_name['status'].enum_type = _ZSWSTATE
 _ZINFODEVSW.fields_by_name['swErr'].message_type = _ERRORINFO
 _ZINFODEVSW.fields_by_name['userStatus'].enum_type = _BASEOSSTATUS
@@ -5786,6 +7014,18 @@ _ZINFOAPP.fields_by_name['assignedAdapters'].message_type = _ZIOBUNDLE
 _ZINFOAPP.fields_by_name['appErr'].message_type = _ERRORINFO
 _ZINFOAPP.fields_by_name['state'].enum_type = _ZSWSTATE
 _ZINFOAPP.fields_by_name['network'].message_type = _ZINFONETWORK
+_ZINFOAPP.fields_by_name['activation_deferred_until'].message_type = google_dot_protobuf_dot_timestamp__pb2._TIMESTAMP
+_ZINFOAPP.fields_by_name['last_exit_time'].message_type = google_dot_protobuf_dot_timestamp__pb2._TIMESTAMP
+_ZINFOAPP.fields_by_name['pod_containers'].message_type = _ZINFOPODCONTAINER
+_ZINFOAPP.fields_by_name['health'].message_type = _ZINFOAPPHEALTH
+_ZINFOAPP.fields_by_name['guest'].message_type = _ZINFOAPPGUEST
+_ZINFOAPPHEALTH.fields_by_name['state'].enum_type = _HEALTHSTATE
+_ZINFOAPPHEALTH.fields_by_name['last_check_time'].message_type = google_dot_protobuf_dot_timestamp__pb2._TIMESTAMP
+_ZINFOAPPGUEST.fields_by_name['interfaces'].message_type = _ZINFOGUESTINTERFACE
+_ZINFOAPPGUEST.fields_by_name['filesystems'].message_type = _ZINFOGUESTFILESYSTEM
+_ZINFOAPPGUEST.fields_by_name['update_time'].message_type = google_dot_protobuf_dot_timestamp__pb2._TIMESTAMP
+_ZINFOPODCONTAINER.fields_by_name['state'].enum_type = _ZSWSTATE
+_ZINFOPODCONTAINER.fields_by_name['last_exit_time'].message_type = google_dot_protobuf_dot_timestamp__pb2._TIMESTAMP
 _ZINFOVPNLINK.fields_by_name['state'].enum_type = _ZINFOVPNSTATE
 _ZINFOVPNLINK.fields_by_name['lInfo'].message_type = _ZINFOVPNLINKINFO
 _ZINFOVPNLINK.fields_by_name['rInfo'].message_type = _ZINFOVPNLINKINFO
@@ -5811,10 +7051,20 @@ _ZINFOVOLUME.fields_by_name['usage'].message_type = _USAGEINFO
 _ZINFOVOLUME.fields_by_name['resources'].message_type = _VOLUMERESOURCES
 _ZINFOVOLUME.fields_by_name['state'].enum_type = _ZSWSTATE
 _ZINFOVOLUME.fields_by_name['volumeErr'].message_type = _ERRORINFO
+_ZINFOVOLUME.fields_by_name['backup'].message_type = _VOLUMEBACKUPINFO
+_ZINFOVOLUME.fields_by_name['key'].message_type = _VOLUMEKEYINFO
+_VOLUMEKEYINFO.fields_by_name['last_rotation'].message_type = google_dot_protobuf_dot_timestamp__pb2._TIMESTAMP
+_VOLUMEKEYINFO.fields_by_name['error'].message_type = _ERRORINFO
+_VOLUMEBACKUPINFO.fields_by_name['state'].enum_type = _VOLUMEBACKUPSTATE
+_VOLUMEBACKUPINFO.fields_by_name['last_time'].message_type = google_dot_protobuf_dot_timestamp__pb2._TIMESTAMP
+_VOLUMEBACKUPINFO.fields_by_name['next_time'].message_type = google_dot_protobuf_dot_timestamp__pb2._TIMESTAMP
+_VOLUMEBACKUPINFO.fields_by_name['error'].message_type = _ERRORINFO
 _ZINFOCONTENTTREE.fields_by_name['resources'].message_type = _CONTENTRESOURCES
 _ZINFOCONTENTTREE.fields_by_name['usage'].message_type = _USAGEINFO
 _ZINFOCONTENTTREE.fields_by_name['state'].enum_type = _ZSWSTATE
 _ZINFOCONTENTTREE.fields_by_name['err'].message_type = _ERRORINFO
+_ZINFOCONTENTTREE.fields_by_name['eta'].message_type = google_dot_protobuf_dot_timestamp__pb2._TIMESTAMP
+_ZINFOCONTENTTREE.fields_by_name['download_deferred_until'].message_type = google_dot_protobuf_dot_timestamp__pb2._TIMESTAMP
 _ZINFOBLOB.fields_by_name['resources'].message_type = _CONTENTRESOURCES
 _ZINFOBLOB.fields_by_name['usage'].message_type = _USAGEINFO
 _ZINFOBLOB.fields_by_name['state'].enum_type = _ZSWSTATE
@@ -5891,6 +7141,7 @@ DESCRIPTOR.message_types_by_name['ZSimcardInfo'] = _ZSIMCARDINFO
 DESCRIPTOR.message_types_by_name['ZCellularModuleInfo'] = _ZCELLULARMODULEINFO
 DESCRIPTOR.message_types_by_name['ZCellularProvider'] = _ZCELLULARPROVIDER
 DESCRIPTOR.message_types_by_name['StorageDiskState'] = _STORAGEDISKSTATE
+DESCRIPTOR.message_types_by_name['StorageScanStatus'] = _STORAGESCANSTATUS
 DESCRIPTOR.message_types_by_name['SmartAttr'] = _SMARTATTR
 DESCRIPTOR.message_types_by_name['SmartMetric'] = _SMARTMETRIC
 DESCRIPTOR.message_types_by_name['StorageDiskInfo'] = _STORAGEDISKINFO
@@ -5898,6 +7149,8 @@ DESCRIPTOR.message_types_by_name['StorageChildren'] = _STORAGECHILDREN
 DESCRIPTOR.message_types_by_name['StorageInfo'] = _STORAGEINFO
 DESCRIPTOR.message_types_by_name['ZInfoHardware'] = _ZINFOHARDWARE
 DESCRIPTOR.message_types_by_name['ZInfoDevice'] = _ZINFODEVICE
+DESCRIPTOR.message_types_by_name['LocalOperationRecord'] = _LOCALOPERATIONRECORD
+DESCRIPTOR.message_types_by_name['LocalOperationsInfo'] = _LOCALOPERATIONSINFO
 DESCRIPTOR.message_types_by_name['AttestationInfo'] = _ATTESTATIONINFO
 DESCRIPTOR.message_types_by_name['SystemAdapterInfo'] = _SYSTEMADAPTERINFO
 DESCRIPTOR.message_types_by_name['DevicePortStatus'] = _DEVICEPORTSTATUS
@@ -5906,9 +7159,15 @@ DESCRIPTOR.message_types_by_name['ProxyStatus'] = _PROXYSTATUS
 DESCRIPTOR.message_types_by_name['ProxyEntry'] = _PROXYENTRY
 DESCRIPTOR.message_types_by_name['WirelessStatus'] = _WIRELESSSTATUS
 DESCRIPTOR.message_types_by_name['ZCellularStatus'] = _ZCELLULARSTATUS
+DESCRIPTOR.message_types_by_name['ZCellularDataUsage'] = _ZCELLULARDATAUSAGE
 DESCRIPTOR.message_types_by_name['ZInfoDevSW'] = _ZINFODEVSW
 DESCRIPTOR.message_types_by_name['ZInfoStorage'] = _ZINFOSTORAGE
 DESCRIPTOR.message_types_by_name['ZInfoApp'] = _ZINFOAPP
+DESCRIPTOR.message_types_by_name['ZInfoAppHealth'] = _ZINFOAPPHEALTH
+DESCRIPTOR.message_types_by_name['ZInfoGuestInterface'] = _ZINFOGUESTINTERFACE
+DESCRIPTOR.message_types_by_name['ZInfoGuestFilesystem'] = _ZINFOGUESTFILESYSTEM
+DESCRIPTOR.message_types_by_name['ZInfoAppGuest'] = _ZINFOAPPGUEST
+DESCRIPTOR.message_types_by_name['ZInfoPodContainer'] = _ZINFOPODCONTAINER
 DESCRIPTOR.message_types_by_name['ZInfoVpnLinkInfo'] = _ZINFOVPNLINKINFO
 DESCRIPTOR.message_types_by_name['ZInfoVpnLink'] = _ZINFOVPNLINK
 DESCRIPTOR.message_types_by_name['ZInfoVpnEndPoint'] = _ZINFOVPNENDPOINT
@@ -5918,6 +7177,8 @@ DESCRIPTOR.message_types_by_name['ZInfoNetworkInstance'] = _ZINFONETWORKINSTANCE
 DESCRIPTOR.message_types_by_name['UsageInfo'] = _USAGEINFO
 DESCRIPTOR.message_types_by_name['VolumeResources'] = _VOLUMERESOURCES
 DESCRIPTOR.message_types_by_name['ZInfoVolume'] = _ZINFOVOLUME
+DESCRIPTOR.message_types_by_name['VolumeKeyInfo'] = _VOLUMEKEYINFO
+DESCRIPTOR.message_types_by_name['VolumeBackupInfo'] = _VOLUMEBACKUPINFO
 DESCRIPTOR.message_types_by_name['ContentResources'] = _CONTENTRESOURCES
 DESCRIPTOR.message_types_by_name['ZInfoContentTree'] = _ZINFOCONTENTTREE
 DESCRIPTOR.message_types_by_name['ZInfoBlob'] = _ZINFOBLOB
@@ -5940,18 +7201,26 @@ DESCRIPTOR.enum_types_by_name['ZCellularOperatingState'] = _ZCELLULAROPERATINGST
 DESCRIPTOR.enum_types_by_name['ZCellularControlProtocol'] = _ZCELLULARCONTROLPROTOCOL
 DESCRIPTOR.enum_types_by_name['ZDeviceState'] = _ZDEVICESTATE
 DESCRIPTOR.enum_types_by_name['StorageStatus'] = _STORAGESTATUS
+DESCRIPTOR.enum_types_by_name['StorageScanType'] = _STORAGESCANTYPE
+DESCRIPTOR.enum_types_by_name['StorageScanState'] = _STORAGESCANSTATE
 DESCRIPTOR.enum_types_by_name['StorageRaidType'] = _STORAGERAIDTYPE
 DESCRIPTOR.enum_types_by_name['StorageTypeInfo'] = _STORAGETYPEINFO
+DESCRIPTOR.enum_types_by_name['LocalOperationType'] = _LOCALOPERATIONTYPE
+DESCRIPTOR.enum_types_by_name['LocalOperationResult'] = _LOCALOPERATIONRESULT
 DESCRIPTOR.enum_types_by_name['APICapability'] = _APICAPABILITY
 DESCRIPTOR.enum_types_by_name['BootReason'] = _BOOTREASON
 DESCRIPTOR.enum_types_by_name['MaintenanceModeReason'] = _MAINTENANCEMODEREASON
 DESCRIPTOR.enum_types_by_name['AttestationState'] = _ATTESTATIONSTATE
 DESCRIPTOR.enum_types_by_name['AppInstMetaDataType'] = _APPINSTMETADATATYPE
 DESCRIPTOR.enum_types_by_name['WirelessType'] = _WIRELESSTYPE
+DESCRIPTOR.enum_types_by_name['ZCellularTrafficClass'] = _ZCELLULARTRAFFICCLASS
+DESCRIPTOR.enum_types_by_name['ZCellularQuotaState'] = _ZCELLULARQUOTASTATE
 DESCRIPTOR.enum_types_by_name['BaseOsStatus'] = _BASEOSSTATUS
 DESCRIPTOR.enum_types_by_name['BaseOsSubStatus'] = _BASEOSSUBSTATUS
+DESCRIPTOR.enum_types_by_name['HealthState'] = _HEALTHSTATE
 DESCRIPTOR.enum_types_by_name['ZInfoVpnState'] = _ZINFOVPNSTATE
 DESCRIPTOR.enum_types_by_name['ZNetworkInstanceState'] = _ZNETWORKINSTANCESTATE
+DESCRIPTOR.enum_types_by_name['VolumeBackupState'] = _VOLUMEBACKUPSTATE
 DESCRIPTOR.enum_types_by_name['LocReliability'] = _LOCRELIABILITY
 _sym_db.RegisterFileDescriptor(DESCRIPTOR)
 
@@ -6132,6 +7401,13 @@ StorageDiskState = _reflection.GeneratedProtocolMessageType('StorageDiskState',
   })
 _sym_db.RegisterMessage(StorageDiskState)
 
+StorageScanStatus = _reflection.GeneratedProtocolMessageType('StorageScanStatus', (_message.Message,), {
+  'DESCRIPTOR' : _STORAGESCANSTATUS,
+  '__module__' : 'info.info_pb2'
+  # @@protoc_insertion_point(class_scope:org.lfedge.eve.info.StorageScanStatus)
+  })
+_sym_db.RegisterMessage(StorageScanStatus)
+
 SmartAttr = _reflection.GeneratedProtocolMessageType('SmartAttr', (_message.Message,), {
   'DESCRIPTOR' : _SMARTATTR,
   '__module__' : 'info.info_pb2'
@@ -6181,6 +7457,20 @@ ZInfoDevice = _reflection.GeneratedProtocolMessageType('ZInfoDevice', (_message.
   })
 _sym_db.RegisterMessage(ZInfoDevice)
 
+LocalOperationRecord = _reflection.GeneratedProtocolMessageType('LocalOperationRecord', (_message.Message,), {
+  'DESCRIPTOR' : _LOCALOPERATIONRECORD,
+  '__module__' : 'info.info_pb2'
+  # @@protoc_insertion_point(class_scope:org.lfedge.eve.info.LocalOperationRecord)
+  })
+_sym_db.RegisterMessage(LocalOperationRecord)
+
+LocalOperationsInfo = _reflection.GeneratedProtocolMessageType('LocalOperationsInfo', (_message.Message,), {
+  'DESCRIPTOR' : _LOCALOPERATIONSINFO,
+  '__module__' : 'info.info_pb2'
+  # @@protoc_insertion_point(class_scope:org.lfedge.eve.info.LocalOperationsInfo)
+  })
+_sym_db.RegisterMessage(LocalOperationsInfo)
+
 AttestationInfo = _reflection.GeneratedProtocolMessageType('AttestationInfo', (_message.Message,), {
   'DESCRIPTOR' : _ATTESTATIONINFO,
   '__module__' : 'info.info_pb2'
@@ -6237,6 +7527,13 @@ ZCellularStatus = _reflection.GeneratedProtocolMessageType('ZCellularStatus', (_
   })
 _sym_db.RegisterMessage(ZCellularStatus)
 
+ZCellularDataUsage = _reflection.GeneratedProtocolMessageType('ZCellularDataUsage', (_message.Message,), {
+  'DESCRIPTOR' : _ZCELLULARDATAUSAGE,
+  '__module__' : 'info.info_pb2'
+  # @@protoc_insertion_point(class_scope:org.lfedge.eve.info.ZCellularDataUsage)
+  })
+_sym_db.RegisterMessage(ZCellularDataUsage)
+
 ZInfoDevSW = _reflection.GeneratedProtocolMessageType('ZInfoDevSW', (_message.Message,), {
   'DESCRIPTOR' : _ZINFODEVSW,
   '__module__' : 'info.info_pb2'
@@ -6258,6 +7555,41 @@ ZInfoApp = _reflection.GeneratedProtocolMessageType('ZInfoApp', (_message.Messag
   })
 _sym_db.RegisterMessage(ZInfoApp)
 
+ZInfoAppHealth = _reflection.GeneratedProtocolMessageType('ZInfoAppHealth', (_message.Message,), {
+  'DESCRIPTOR' : _ZINFOAPPHEALTH,
+  '__module__' : 'info.info_pb2'
+  # @@protoc_insertion_point(class_scope:org.lfedge.eve.info.ZInfoAppHealth)
+  })
+_sym_db.RegisterMessage(ZInfoAppHealth)
+
+ZInfoGuestInterface = _reflection.GeneratedProtocolMessageType('ZInfoGuestInterface', (_message.Message,), {
+  'DESCRIPTOR' : _ZINFOGUESTINTERFACE,
+  '__module__' : 'info.info_pb2'
+  # @@protoc_insertion_point(class_scope:org.lfedge.eve.info.ZInfoGuestInterface)
+  })
+_sym_db.RegisterMessage(ZInfoGuestInterface)
+
+ZInfoGuestFilesystem = _reflection.GeneratedProtocolMessageType('ZInfoGuestFilesystem', (_message.Message,), {
+  'DESCRIPTOR' : _ZINFOGUESTFILESYSTEM,
+  '__module__' : 'info.info_pb2'
+  # @@protoc_insertion_point(class_scope:org.lfedge.eve.info.ZInfoGuestFilesystem)
+  })
+_sym_db.RegisterMessage(ZInfoGuestFilesystem)
+
+ZInfoAppGuest = _reflection.GeneratedProtocolMessageType('ZInfoAppGuest', (_message.Message,), {
+  'DESCRIPTOR' : _ZINFOAPPGUEST,
+  '__module__' : 'info.info_pb2'
+  # @@protoc_insertion_point(class_scope:org.lfedge.eve.info.ZInfoAppGuest)
+  })
+_sym_db.RegisterMessage(ZInfoAppGuest)
+
+ZInfoPodContainer = _reflection.GeneratedProtocolMessageType('ZInfoPodContainer', (_message.Message,), {
+  'DESCRIPTOR' : _ZINFOPODCONTAINER,
+  '__module__' : 'info.info_pb2'
+  # @@protoc_insertion_point(class_scope:org.lfedge.eve.info.ZInfoPodContainer)
+  })
+_sym_db.RegisterMessage(ZInfoPodContainer)
+
 ZInfoVpnLinkInfo = _reflection.GeneratedProtocolMessageType('ZInfoVpnLinkInfo', (_message.Message,), {
   'DESCRIPTOR' : _ZINFOVPNLINKINFO,
   '__module__' : 'info.info_pb2'
@@ -6321,6 +7653,20 @@ ZInfoVolume = _reflection.GeneratedProtocolMessageType('ZInfoVolume', (_message.
   })
 _sym_db.RegisterMessage(ZInfoVolume)
 
+VolumeKeyInfo = _reflection.GeneratedProtocolMessageType('VolumeKeyInfo', (_message.Message,), {
+  'DESCRIPTOR' : _VOLUMEKEYINFO,
+  '__module__' : 'info.info_pb2'
+  # @@protoc_insertion_point(class_scope:org.lfedge.eve.info.VolumeKeyInfo)
+  })
+_sym_db.RegisterMessage(VolumeKeyInfo)
+
+VolumeBackupInfo = _reflection.GeneratedProtocolMessageType('VolumeBackupInfo', (_message.Message,), {
+  'DESCRIPTOR' : _VOLUMEBACKUPINFO,
+  '__module__' : 'info.info_pb2'
+  # @@protoc_insertion_point(class_scope:org.lfedge.eve.info.VolumeBackupInfo)
+  })
+_sym_db.RegisterMessage(VolumeBackupInfo)
+
 ContentResources = _reflection.GeneratedProtocolMessageType('ContentResources', (_message.Message,), {
   'DESCRIPTOR' : _CONTENTRESOURCES,
   '__module__' : 'info.info_pb2'
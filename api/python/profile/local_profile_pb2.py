@@ -11,6 +11,7 @@ from google.protobuf import symbol_database as _symbol_database
 _sym_db = _symbol_database.Default()
 
 
+from config import localoperations_pb2 as config_dot_localoperations__pb2
 from info import info_pb2 as info_dot_info__pb2
 from metrics import metrics_pb2 as metrics_dot_metrics__pb2
 from google.protobuf import timestamp_pb2 as google_dot_protobuf_dot_timestamp__pb2
@@ -22,9 +23,9 @@ DESCRIPTOR = _descriptor.FileDescriptor(
   syntax='proto3',
   serialized_options=b'\n\026org.lfedge.eve.profileZ%github.com/lf-edge/eve/api/go/profile',
   create_key=_descriptor._internal_create_key,
-  serialized_pb=b'\n\x1bprofile/local_profile.proto\x12\x16org.lfedge.eve.profile\x1a\x0finfo/info.proto\x1a\x15metrics/metrics.proto\x1a\x1fgoogle/protobuf/timestamp.proto\";\n\x0cLocalProfile\x12\x15\n\rlocal_profile\x18\x01 \x01(\t\x12\x14\n\x0cserver_token\x18\x02 \x01(\t\"\xbd\x01\n\x0bRadioStatus\x12\x15\n\rradio_silence\x18\x01 \x01(\x08\x12\x14\n\x0c\x63onfig_error\x18\x02 \x01(\t\x12?\n\x0f\x63\x65llular_status\x18\x03 \x03(\x0b\x32&.org.lfedge.eve.profile.CellularStatus\x12@\n\x10\x63\x65llular_metrics\x18\x04 \x03(\x0b\x32&.org.lfedge.eve.metrics.CellularMetric\"\xfc\x01\n\x0e\x43\x65llularStatus\x12\x14\n\x0clogicallabel\x18\x01 \x01(\t\x12\x38\n\x06module\x18\x02 \x01(\x0b\x32(.org.lfedge.eve.info.ZCellularModuleInfo\x12\x34\n\tsim_cards\x18\x03 \x03(\x0b\x32!.org.lfedge.eve.info.ZSimcardInfo\x12\x39\n\tproviders\x18\x04 \x03(\x0b\x32&.org.lfedge.eve.info.ZCellularProvider\x12\x14\n\x0c\x63onfig_error\x18\n \x01(\t\x12\x13\n\x0bprobe_error\x18\x0b \x01(\t\":\n\x0bRadioConfig\x12\x14\n\x0cserver_token\x18\x01 \x01(\t\x12\x15\n\rradio_silence\x18\x02 \x01(\x08\"K\n\x10LocalAppInfoList\x12\x37\n\tapps_info\x18\x01 \x03(\x0b\x32$.org.lfedge.eve.profile.LocalAppInfo\"\xb0\x01\n\x0cLocalAppInfo\x12\n\n\x02id\x18\x01 \x01(\t\x12\x0f\n\x07version\x18\x02 \x01(\t\x12\x0c\n\x04name\x18\x03 \x01(\t\x12+\n\x03\x65rr\x18\x04 \x01(\x0b\x32\x1e.org.lfedge.eve.info.ErrorInfo\x12,\n\x05state\x18\x05 \x01(\x0e\x32\x1d.org.lfedge.eve.info.ZSwState\x12\x1a\n\x12last_cmd_timestamp\x18\x06 \x01(\x04\"a\n\x0fLocalAppCmdList\x12\x14\n\x0cserver_token\x18\x01 \x01(\t\x12\x38\n\x0c\x61pp_commands\x18\x02 \x03(\x0b\x32\".org.lfedge.eve.profile.AppCommand\"\xc9\x01\n\nAppCommand\x12\n\n\x02id\x18\x01 \x01(\t\x12\x13\n\x0b\x64isplayname\x18\x02 \x01(\t\x12\x11\n\ttimestamp\x18\x03 \x01(\x04\x12;\n\x07\x63ommand\x18\x04 \x01(\x0e\x32*.org.lfedge.eve.profile.AppCommand.Command\"J\n\x07\x43ommand\x12\x17\n\x13\x43OMMAND_UNSPECIFIED\x10\x00\x12\x13\n\x0f\x43OMMAND_RESTART\x10\x01\x12\x11\n\rCOMMAND_PURGE\x10\x02\"\xa9\x02\n\x0cLocalDevInfo\x12\x13\n\x0b\x64\x65vice_uuid\x18\x01 \x01(\t\x12\x30\n\x05state\x18\x02 \x01(\x0e\x32!.org.lfedge.eve.info.ZDeviceState\x12L\n\x18maintenance_mode_reasons\x18\x03 \x03(\x0e\x32*.org.lfedge.eve.info.MaintenanceModeReason\x12-\n\tboot_time\x18\x04 \x01(\x0b\x32\x1a.google.protobuf.Timestamp\x12\x39\n\x10last_boot_reason\x18\x05 \x01(\x0e\x32\x1f.org.lfedge.eve.info.BootReason\x12\x1a\n\x12last_cmd_timestamp\x18\n \x01(\x04\"\xcd\x01\n\x0bLocalDevCmd\x12\x14\n\x0cserver_token\x18\x01 \x01(\t\x12\x11\n\ttimestamp\x18\x02 \x01(\x04\x12<\n\x07\x63ommand\x18\x03 \x01(\x0e\x32+.org.lfedge.eve.profile.LocalDevCmd.Command\"W\n\x07\x43ommand\x12\x17\n\x13\x43OMMAND_UNSPECIFIED\x10\x00\x12\x14\n\x10\x43OMMAND_SHUTDOWN\x10\x01\x12\x1d\n\x19\x43OMMAND_SHUTDOWN_POWEROFF\x10\x02\x42?\n\x16org.lfedge.eve.profileZ%github.com/lf-edge/eve/api/go/profileb\x06proto3'
+  serialized_pb=b'\n\x1bprofile/local_profile.proto\x12\x16org.lfedge.eve.profile\x1a\x1c\x63onfig/localoperations.proto\x1a\x0finfo/info.proto\x1a\x15metrics/metrics.proto\x1a\x1fgoogle/protobuf/timestamp.proto\";\n\x0cLocalProfile\x12\x15\n\rlocal_profile\x18\x01 \x01(\t\x12\x14\n\x0cserver_token\x18\x02 \x01(\t\"\xbd\x01\n\x0bRadioStatus\x12\x15\n\rradio_silence\x18\x01 \x01(\x08\x12\x14\n\x0c\x63onfig_error\x18\x02 \x01(\t\x12?\n\x0f\x63\x65llular_status\x18\x03 \x03(\x0b\x32&.org.lfedge.eve.profile.CellularStatus\x12@\n\x10\x63\x65llular_metrics\x18\x04 \x03(\x0b\x32&.org.lfedge.eve.metrics.CellularMetric\"\xfc\x01\n\x0e\x43\x65llularStatus\x12\x14\n\x0clogicallabel\x18\x01 \x01(\t\x12\x38\n\x06module\x18\x02 \x01(\x0b\x32(.org.lfedge.eve.info.ZCellularModuleInfo\x12\x34\n\tsim_cards\x18\x03 \x03(\x0b\x32!.org.lfedge.eve.info.ZSimcardInfo\x12\x39\n\tproviders\x18\x04 \x03(\x0b\x32&.org.lfedge.eve.info.ZCellularProvider\x12\x14\n\x0c\x63onfig_error\x18\n \x01(\t\x12\x13\n\x0bprobe_error\x18\x0b \x01(\t\":\n\x0bRadioConfig\x12\x14\n\x0cserver_token\x18\x01 \x01(\t\x12\x15\n\rradio_silence\x18\x02 \x01(\x08\"K\n\x10LocalAppInfoList\x12\x37\n\tapps_info\x18\x01 \x03(\x0b\x32$.org.lfedge.eve.profile.LocalAppInfo\"\xb0\x01\n\x0cLocalAppInfo\x12\n\n\x02id\x18\x01 \x01(\t\x12\x0f\n\x07version\x18\x02 \x01(\t\x12\x0c\n\x04name\x18\x03 \x01(\t\x12+\n\x03\x65rr\x18\x04 \x01(\x0b\x32\x1e.org.lfedge.eve.info.ErrorInfo\x12,\n\x05state\x18\x05 \x01(\x0e\x32\x1d.org.lfedge.eve.info.ZSwState\x12\x1a\n\x12last_cmd_timestamp\x18\x06 \x01(\x04\"a\n\x0fLocalAppCmdList\x12\x14\n\x0cserver_token\x18\x01 \x01(\t\x12\x38\n\x0c\x61pp_commands\x18\x02 \x03(\x0b\x32\".org.lfedge.eve.profile.AppCommand\"\xc9\x01\n\nAppCommand\x12\n\n\x02id\x18\x01 \x01(\t\x12\x13\n\x0b\x64isplayname\x18\x02 \x01(\t\x12\x11\n\ttimestamp\x18\x03 \x01(\x04\x12;\n\x07\x63ommand\x18\x04 \x01(\x0e\x32*.org.lfedge.eve.profile.AppCommand.Command\"J\n\x07\x43ommand\x12\x17\n\x13\x43OMMAND_UNSPECIFIED\x10\x00\x12\x13\n\x0f\x43OMMAND_RESTART\x10\x01\x12\x11\n\rCOMMAND_PURGE\x10\x02\"\xa9\x02\n\x0cLocalDevInfo\x12\x13\n\x0b\x64\x65vice_uuid\x18\x01 \x01(\t\x12\x30\n\x05state\x18\x02 \x01(\x0e\x32!.org.lfedge.eve.info.ZDeviceState\x12L\n\x18maintenance_mode_reasons\x18\x03 \x03(\x0e\x32*.org.lfedge.eve.info.MaintenanceModeReason\x12-\n\tboot_time\x18\x04 \x01(\x0b\x32\x1a.google.protobuf.Timestamp\x12\x39\n\x10last_boot_reason\x18\x05 \x01(\x0e\x32\x1f.org.lfedge.eve.info.BootReason\x12\x1a\n\x12last_cmd_timestamp\x18\n \x01(\x04\"\xcd\x01\n\x0bLocalDevCmd\x12\x14\n\x0cserver_token\x18\x01 \x01(\t\x12\x11\n\ttimestamp\x18\x02 \x01(\x04\x12<\n\x07\x63ommand\x18\x03 \x01(\x0e\x32+.org.lfedge.eve.profile.LocalDevCmd.Command\"W\n\x07\x43ommand\x12\x17\n\x13\x43OMMAND_UNSPECIFIED\x10\x00\x12\x14\n\x10\x43OMMAND_SHUTDOWN\x10\x01\x12\x1d\n\x19\x43OMMAND_SHUTDOWN_POWEROFF\x10\x02\"\xb5\x01\n\x0fLocalConfigInfo\x12\x13\n\x0b\x61pi_version\x18\x01 \x01(\r\x12\x13\n\x0b\x64\x65vice_uuid\x18\x02 \x01(\t\x12<\n\x06policy\x18\x03 \x01(\x0b\x32,.org.lfedge.eve.config.LocalOperationsPolicy\x12:\n\x07records\x18\x04 \x03(\x0b\x32).org.lfedge.eve.info.LocalOperationRecord\"{\n\x12LocalConfigRequest\x12\x14\n\x0cserver_token\x18\x01 \x01(\t\x12\x13\n\x0b\x61pi_version\x18\x02 \x01(\r\x12:\n\noperations\x18\x03 \x03(\x0b\x32&.org.lfedge.eve.profile.LocalOperation\"\xc3\x02\n\x0eLocalOperation\x12\n\n\x02id\x18\x01 \x01(\t\x12\x11\n\ttimestamp\x18\x02 \x01(\x04\x12@\n\x0c\x61pp_activate\x18\n \x01(\x0b\x32(.org.lfedge.eve.profile.LocalAppActivateH\x00\x12\x44\n\x0eglobal_setting\x18\x0b \x01(\x0b\x32*.org.lfedge.eve.profile.LocalGlobalSettingH\x00\x12\x38\n\x08port_map\x18\x0c \x01(\x0b\x32$.org.lfedge.eve.profile.LocalPortMapH\x00\x12\x43\n\x0e\x62\x61se_os_update\x18\r \x01(\x0b\x32).org.lfedge.eve.profile.LocalBaseOsUpdateH\x00\x42\x0b\n\toperation\"U\n\x10LocalAppActivate\x12\n\n\x02id\x18\x01 \x01(\t\x12\x13\n\x0b\x64isplayname\x18\x02 \x01(\t\x12\x10\n\x08\x61\x63tivate\x18\x03 \x01(\x08\x12\x0e\n\x06revert\x18\x04 \x01(\x08\"@\n\x12LocalGlobalSetting\x12\x0b\n\x03key\x18\x01 \x01(\t\x12\r\n\x05value\x18\x02 \x01(\t\x12\x0e\n\x06revert\x18\x03 \x01(\x08\"\x9f\x01\n\x0cLocalPortMap\x12\x0e\n\x06\x61pp_id\x18\x01 \x01(\t\x12\x17\n\x0f\x61pp_displayname\x18\x02 \x01(\t\x12\x1b\n\x13network_instance_id\x18\x03 \x01(\t\x12\x10\n\x08protocol\x18\x04 \x01(\t\x12\x10\n\x08\x61pp_port\x18\x05 \x01(\r\x12\x15\n\rexternal_port\x18\x06 \x01(\r\x12\x0e\n\x06revert\x18\x07 \x01(\x08\"I\n\x11LocalBaseOsUpdate\x12\x17\n\x0f\x62\x61se_os_version\x18\x01 \x01(\t\x12\x0b\n\x03url\x18\x02 \x01(\t\x12\x0e\n\x06sha256\x18\x03 \x01(\tB?\n\x16org.lfedge.eve.profileZ%github.com/lf-edge/eve/api/go/profileb\x06proto3'
   ,
-  dependencies=[info_dot_info__pb2.DESCRIPTOR,metrics_dot_metrics__pb2.DESCRIPTOR,google_dot_protobuf_dot_timestamp__pb2.DESCRIPTOR,])
+  dependencies=[config_dot_localoperations__pb2.DESCRIPTOR,info_dot_info__pb2.DESCRIPTOR,metrics_dot_metrics__pb2.DESCRIPTOR,google_dot_protobuf_dot_timestamp__pb2.DESCRIPTOR,])
 
 
 
@@ -53,8 +54,8 @@ _APPCOMMAND_COMMAND = _descriptor.EnumDescriptor(
   ],
   containing_type=None,
   serialized_options=None,
-  serialized_start=1179,
-  serialized_end=1253,
+  serialized_start=1209,
+  serialized_end=1283,
 )
 _sym_db.RegisterEnumDescriptor(_APPCOMMAND_COMMAND)
 
@@ -83,8 +84,8 @@ _LOCALDEVCMD_COMMAND = _descriptor.EnumDescriptor(
   ],
   containing_type=None,
   serialized_options=None,
-  serialized_start=1674,
-  serialized_end=1761,
+  serialized_start=1704,
+  serialized_end=1791,
 )
 _sym_db.RegisterEnumDescriptor(_LOCALDEVCMD_COMMAND)
 
@@ -123,8 +124,8 @@ _LOCALPROFILE = _descriptor.Descriptor(
   extension_ranges=[],
   oneofs=[
   ],
-  serialized_start=128,
-  serialized_end=187,
+  serialized_start=158,
+  serialized_end=217,
 )
 
 
@@ -176,8 +177,8 @@ _RADIOSTATUS = _descriptor.Descriptor(
   extension_ranges=[],
   oneofs=[
   ],
-  serialized_start=190,
-  serialized_end=379,
+  serialized_start=220,
+  serialized_end=409,
 )
 
 
@@ -243,8 +244,8 @@ _CELLULARSTATUS = _descriptor.Descriptor(
   extension_ranges=[],
   oneofs=[
   ],
-  serialized_start=382,
-  serialized_end=634,
+  serialized_start=412,
+  serialized_end=664,
 )
 
 
@@ -282,8 +283,8 @@ _RADIOCONFIG = _descriptor.Descriptor(
   extension_ranges=[],
   oneofs=[
   ],
-  serialized_start=636,
-  serialized_end=694,
+  serialized_start=666,
+  serialized_end=724,
 )
 
 
@@ -314,8 +315,8 @@ _LOCALAPPINFOLIST = _descriptor.Descriptor(
   extension_ranges=[],
   oneofs=[
   ],
-  serialized_start=696,
-  serialized_end=771,
+  serialized_start=726,
+  serialized_end=801,
 )
 
 
@@ -381,8 +382,8 @@ _LOCALAPPINFO = _descriptor.Descriptor(
   extension_ranges=[],
   oneofs=[
   ],
-  serialized_start=774,
-  serialized_end=950,
+  serialized_start=804,
+  serialized_end=980,
 )
 
 
@@ -420,8 +421,8 @@ _LOCALAPPCMDLIST = _descriptor.Descriptor(
   extension_ranges=[],
   oneofs=[
   ],
-  serialized_start=952,
-  serialized_end=1049,
+  serialized_start=982,
+  serialized_end=1079,
 )
 
 
@@ -474,8 +475,8 @@ _APPCOMMAND = _descriptor.Descriptor(
   extension_ranges=[],
   oneofs=[
   ],
-  serialized_start=1052,
-  serialized_end=1253,
+  serialized_start=1082,
+  serialized_end=1283,
 )
 
 
@@ -541,8 +542,8 @@ _LOCALDEVINFO = _descriptor.Descriptor(
   extension_ranges=[],
   oneofs=[
   ],
-  serialized_start=1256,
-  serialized_end=1553,
+  serialized_start=1286,
+  serialized_end=1583,
 )
 
 
@@ -588,8 +589,398 @@ _LOCALDEVCMD = _descriptor.Descriptor(
   extension_ranges=[],
   oneofs=[
   ],
-  serialized_start=1556,
-  serialized_end=1761,
+  serialized_start=1586,
+  serialized_end=1791,
+)
+
+
+_LOCALCONFIGINFO = _descriptor.Descriptor(
+  name='LocalConfigInfo',
+  full_name='org.lfedge.eve.profile.LocalConfigInfo',
+  filename=None,
+  file=DESCRIPTOR,
+  containing_type=None,
+  create_key=_descriptor._internal_create_key,
+  fields=[
+    _descriptor.FieldDescriptor(
+      name='api_version', full_name='org.lfedge.eve.profile.LocalConfigInfo.api_version', index=0,
+      number=1, type=13, cpp_type=3, label=1,
+      has_default_value=False, default_value=0,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
+    _descriptor.FieldDescriptor(
+      name='device_uuid', full_name='org.lfedge.eve.profile.LocalConfigInfo.device_uuid', index=1,
+      number=2, type=9, cpp_type=9, label=1,
+      has_default_value=False, default_value=b"".decode('utf-8'),
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
+    _descriptor.FieldDescriptor(
+      name='policy', full_name='org.lfedge.eve.profile.LocalConfigInfo.policy', index=2,
+      number=3, type=11, cpp_type=10, label=1,
+      has_default_value=False, default_value=None,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
+    _descriptor.FieldDescriptor(
+      name='records', full_name='org.lfedge.eve.profile.LocalConfigInfo.records', index=3,
+      number=4, type=11, cpp_type=10, label=3,
+      has_default_value=False, default_value=[],
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
+  ],
+  extensions=[
+  ],
+  nested_types=[],
+  enum_types=[
+  ],
+  serialized_options=None,
+  is_extendable=False,
+  syntax='proto3',
+  extension_ranges=[],
+  oneofs=[
+  ],
+  serialized_start=1794,
+  serialized_end=1975,
+)
+
+
+_LOCALCONFIGREQUEST = _descriptor.Descriptor(
+  name='LocalConfigRequest',
+  full_name='org.lfedge.eve.profile.LocalConfigRequest',
+  filename=None,
+  file=DESCRIPTOR,
+  containing_type=None,
+  create_key=_descriptor._internal_create_key,
+  fields=[
+    _descriptor.FieldDescriptor(
+      name='server_token', full_name='org.lfedge.eve.profile.LocalConfigRequest.server_token', index=0,
+      number=1, type=9, cpp_type=9, label=1,
+      has_default_value=False, default_value=b"".decode('utf-8'),
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
+    _descriptor.FieldDescriptor(
+      name='api_version', full_name='org.lfedge.eve.profile.LocalConfigRequest.api_version', index=1,
+      number=2, type=13, cpp_type=3, label=1,
+      has_default_value=False, default_value=0,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
+    _descriptor.FieldDescriptor(
+      name='operations', full_name='org.lfedge.eve.profile.LocalConfigRequest.operations', index=2,
+      number=3, type=11, cpp_type=10, label=3,
+      has_default_value=False, default_value=[],
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
+  ],
+  extensions=[
+  ],
+  nested_types=[],
+  enum_types=[
+  ],
+  serialized_options=None,
+  is_extendable=False,
+  syntax='proto3',
+  extension_ranges=[],
+  oneofs=[
+  ],
+  serialized_start=1977,
+  serialized_end=2100,
+)
+
+
+_LOCALOPERATION = _descriptor.Descriptor(
+  name='LocalOperation',
+  full_name='org.lfedge.eve.profile.LocalOperation',
+  filename=None,
+  file=DESCRIPTOR,
+  containing_type=None,
+  create_key=_descriptor._internal_create_key,
+  fields=[
+    _descriptor.FieldDescriptor(
+      name='id', full_name='org.lfedge.eve.profile.LocalOperation.id', index=0,
+      number=1, type=9, cpp_type=9, label=1,
+      has_default_value=False, default_value=b"".decode('utf-8'),
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
+    _descriptor.FieldDescriptor(
+      name='timestamp', full_name='org.lfedge.eve.profile.LocalOperation.timestamp', index=1,
+      number=2, type=4, cpp_type=4, label=1,
+      has_default_value=False, default_value=0,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
+    _descriptor.FieldDescriptor(
+      name='app_activate', full_name='org.lfedge.eve.profile.LocalOperation.app_activate', index=2,
+      number=10, type=11, cpp_type=10, label=1,
+      has_default_value=False, default_value=None,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
+    _descriptor.FieldDescriptor(
+      name='global_setting', full_name='org.lfedge.eve.profile.LocalOperation.global_setting', index=3,
+      number=11, type=11, cpp_type=10, label=1,
+      has_default_value=False, default_value=None,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
+    _descriptor.FieldDescriptor(
+      name='port_map', full_name='org.lfedge.eve.profile.LocalOperation.port_map', index=4,
+      number=12, type=11, cpp_type=10, label=1,
+      has_default_value=False, default_value=None,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
+    _descriptor.FieldDescriptor(
+      name='base_os_update', full_name='org.lfedge.eve.profile.LocalOperation.base_os_update', index=5,
+      number=13, type=11, cpp_type=10, label=1,
+      has_default_value=False, default_value=None,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
+  ],
+  extensions=[
+  ],
+  nested_types=[],
+  enum_types=[
+  ],
+  serialized_options=None,
+  is_extendable=False,
+  syntax='proto3',
+  extension_ranges=[],
+  oneofs=[
+    _descriptor.OneofDescriptor(
+      name='operation', full_name='org.lfedge.eve.profile.LocalOperation.operation',
+      index=0, containing_type=None,
+      create_key=_descriptor._internal_create_key,
+    fields=[]),
+  ],
+  serialized_start=2103,
+  serialized_end=2426,
+)
+
+
+_LOCALAPPACTIVATE = _descriptor.Descriptor(
+  name='LocalAppActivate',
+  full_name='org.lfedge.eve.profile.LocalAppActivate',
+  filename=None,
+  file=DESCRIPTOR,
+  containing_type=None,
+  create_key=_descriptor._internal_create_key,
+  fields=[
+    _descriptor.FieldDescriptor(
+      name='id', full_name='org.lfedge.eve.profile.LocalAppActivate.id', index=0,
+      number=1, type=9, cpp_type=9, label=1,
+      has_default_value=False, default_value=b"".decode('utf-8'),
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
+    _descriptor.FieldDescriptor(
+      name='displayname', full_name='org.lfedge.eve.profile.LocalAppActivate.displayname', index=1,
+      number=2, type=9, cpp_type=9, label=1,
+      has_default_value=False, default_value=b"".decode('utf-8'),
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
+    _descriptor.FieldDescriptor(
+      name='activate', full_name='org.lfedge.eve.profile.LocalAppActivate.activate', index=2,
+      number=3, type=8, cpp_type=7, label=1,
+      has_default_value=False, default_value=False,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
+    _descriptor.FieldDescriptor(
+      name='revert', full_name='org.lfedge.eve.profile.LocalAppActivate.revert', index=3,
+      number=4, type=8, cpp_type=7, label=1,
+      has_default_value=False, default_value=False,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
+  ],
+  extensions=[
+  ],
+  nested_types=[],
+  enum_types=[
+  ],
+  serialized_options=None,
+  is_extendable=False,
+  syntax='proto3',
+  extension_ranges=[],
+  oneofs=[
+  ],
+  serialized_start=2428,
+  serialized_end=2513,
+)
+
+
+_LOCALGLOBALSETTING = _descriptor.Descriptor(
+  name='LocalGlobalSetting',
+  full_name='org.lfedge.eve.profile.LocalGlobalSetting',
+  filename=None,
+  file=DESCRIPTOR,
+  containing_type=None,
+  create_key=_descriptor._internal_create_key,
+  fields=[
+    _descriptor.FieldDescriptor(
+      name='key', full_name='org.lfedge.eve.profile.LocalGlobalSetting.key', index=0,
+      number=1, type=9, cpp_type=9, label=1,
+      has_default_value=False, default_value=b"".decode('utf-8'),
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
+    _descriptor.FieldDescriptor(
+      name='value', full_name='org.lfedge.eve.profile.LocalGlobalSetting.value', index=1,
+      number=2, type=9, cpp_type=9, label=1,
+      has_default_value=False, default_value=b"".decode('utf-8'),
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
+    _descriptor.FieldDescriptor(
+      name='revert', full_name='org.lfedge.eve.profile.LocalGlobalSetting.revert', index=2,
+      number=3, type=8, cpp_type=7, label=1,
+      has_default_value=False, default_value=False,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
+  ],
+  extensions=[
+  ],
+  nested_types=[],
+  enum_types=[
+  ],
+  serialized_options=None,
+  is_extendable=False,
+  syntax='proto3',
+  extension_ranges=[],
+  oneofs=[
+  ],
+  serialized_start=2515,
+  serialized_end=2579,
+)
+
+
+_LOCALPORTMAP = _descriptor.Descriptor(
+  name='LocalPortMap',
+  full_name='org.lfedge.eve.profile.LocalPortMap',
+  filename=None,
+  file=DESCRIPTOR,
+  containing_type=None,
+  create_key=_descriptor._internal_create_key,
+  fields=[
+    _descriptor.FieldDescriptor(
+      name='app_id', full_name='org.lfedge.eve.profile.LocalPortMap.app_id', index=0,
+      number=1, type=9, cpp_type=9, label=1,
+      has_default_value=False, default_value=b"".decode('utf-8'),
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
+    _descriptor.FieldDescriptor(
+      name='app_displayname', full_name='org.lfedge.eve.profile.LocalPortMap.app_displayname', index=1,
+      number=2, type=9, cpp_type=9, label=1,
+      has_default_value=False, default_value=b"".decode('utf-8'),
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
+    _descriptor.FieldDescriptor(
+      name='network_instance_id', full_name='org.lfedge.eve.profile.LocalPortMap.network_instance_id', index=2,
+      number=3, type=9, cpp_type=9, label=1,
+      has_default_value=False, default_value=b"".decode('utf-8'),
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
+    _descriptor.FieldDescriptor(
+      name='protocol', full_name='org.lfedge.eve.profile.LocalPortMap.protocol', index=3,
+      number=4, type=9, cpp_type=9, label=1,
+      has_default_value=False, default_value=b"".decode('utf-8'),
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
+    _descriptor.FieldDescriptor(
+      name='app_port', full_name='org.lfedge.eve.profile.LocalPortMap.app_port', index=4,
+      number=5, type=13, cpp_type=3, label=1,
+      has_default_value=False, default_value=0,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
+    _descriptor.FieldDescriptor(
+      name='external_port', full_name='org.lfedge.eve.profile.LocalPortMap.external_port', index=5,
+      number=6, type=13, cpp_type=3, label=1,
+      has_default_value=False, default_value=0,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
+    _descriptor.FieldDescriptor(
+      name='revert', full_name='org.lfedge.eve.profile.LocalPortMap.revert', index=6,
+      number=7, type=8, cpp_type=7, label=1,
+      has_default_value=False, default_value=False,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
+  ],
+  extensions=[
+  ],
+  nested_types=[],
+  enum_types=[
+  ],
+  serialized_options=None,
+  is_extendable=False,
+  syntax='proto3',
+  extension_ranges=[],
+  oneofs=[
+  ],
+  serialized_start=2582,
+  serialized_end=2741,
+)
+
+
+_LOCALBASEOSUPDATE = _descriptor.Descriptor(
+  name='LocalBaseOsUpdate',
+  full_name='org.lfedge.eve.profile.LocalBaseOsUpdate',
+  filename=None,
+  file=DESCRIPTOR,
+  containing_type=None,
+  create_key=_descriptor._internal_create_key,
+  fields=[
+    _descriptor.FieldDescriptor(
+      name='base_os_version', full_name='org.lfedge.eve.profile.LocalBaseOsUpdate.base_os_version', index=0,
+      number=1, type=9, cpp_type=9, label=1,
+      has_default_value=False, default_value=b"".decode('utf-8'),
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
+    _descriptor.FieldDescriptor(
+      name='url', full_name='org.lfedge.eve.profile.LocalBaseOsUpdate.url', index=1,
+      number=2, type=9, cpp_type=9, label=1,
+      has_default_value=False, default_value=b"".decode('utf-8'),
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
+    _descriptor.FieldDescriptor(
+      name='sha256', full_name='org.lfedge.eve.profile.LocalBaseOsUpdate.sha256', index=2,
+      number=3, type=9, cpp_type=9, label=1,
+      has_default_value=False, default_value=b"".decode('utf-8'),
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
+  ],
+  extensions=[
+  ],
+  nested_types=[],
+  enum_types=[
+  ],
+  serialized_options=None,
+  is_extendable=False,
+  syntax='proto3',
+  extension_ranges=[],
+  oneofs=[
+  ],
+  serialized_start=2743,
+  serialized_end=2816,
 )
 
 _RADIOSTATUS.fields_by_name['cellular_status'].message_type = _CELLULARSTATUS
@@ -609,6 +1000,25 @@ _LOCALDEVINFO.fields_by_name['boot_time'].message_type = google_dot_protobuf_dot
 _LOCALDEVINFO.fields_by_name['last_boot_reason'].enum_type = info_dot_info__pb2._BOOTREASON
 _LOCALDEVCMD.fields_by_name['command'].enum_type = _LOCALDEVCMD_COMMAND
 _LOCALDEVCMD_COMMAND.containing_type = _LOCALDEVCMD
+_LOCALCONFIGINFO.fields_by_name['policy'].message_type = config_dot_localoperations__pb2._LOCALOPERATIONSPOLICY
+_LOCALCONFIGINFO.fields_by_name['records'].message_type = info_dot_info__pb2._LOCALOPERATIONRECORD
+_LOCALCONFIGREQUEST.fields_by_name['operations'].message_type = _LOCALOPERATION
+_LOCALOPERATION.fields_by_name['app_activate'].message_type = _LOCALAPPACTIVATE
+_LOCALOPERATION.fields_by_name['global_setting'].message_type = _LOCALGLOBALSETTING
+_LOCALOPERATION.fields_by_name['port_map'].message_type = _LOCALPORTMAP
+_LOCALOPERATION.fields_by_name['base_os_update'].message_type = _LOCALBASEOSUPDATE
+_LOCALOPERATION.oneofs_by_name['operation'].fields.append(
+  _LOCALOPERATION.fields_by_name['app_activate'])
+_LOCALOPERATION.fields_by_name['app_activate'].containing_oneof = _LOCALOPERATION.oneofs_by_name['operation']
+_LOCALOPERATION.oneofs_by_name['operation'].fields.append(
+  _LOCALOPERATION.fields_by_name['global_setting'])
+_LOCALOPERATION.fields_by_name['global_setting'].containing_oneof = _LOCALOPERATION.oneofs_by_name['operation']
+_LOCALOPERATION.oneofs_by_name['operation'].fields.append(
+  _LOCALOPERATION.fields_by_name['port_map'])
+_LOCALOPERATION.fields_by_name['port_map'].containing_oneof = _LOCALOPERATION.oneofs_by_name['operation']
+_LOCALOPERATION.oneofs_by_name['operation'].fields.append(
+  _LOCALOPERATION.fields_by_name['base_os_update'])
+_LOCALOPERATION.fields_by_name['base_os_update'].containing_oneof = _LOCALOPERATION.oneofs_by_name['operation']
 DESCRIPTOR.message_types_by_name['LocalProfile'] = _LOCALPROFILE
 DESCRIPTOR.message_types_by_name['RadioStatus'] = _RADIOSTATUS
 DESCRIPTOR.message_types_by_name['CellularStatus'] = _CELLULARSTATUS
@@ -619,6 +1029,13 @@ DESCRIPTOR.message_types_by_name['LocalAppCmdList'] = _LOCALAPPCMDLIST
 DESCRIPTOR.message_types_by_name['AppCommand'] = _APPCOMMAND
 DESCRIPTOR.message_types_by_name['LocalDevInfo'] = _LOCALDEVINFO
 DESCRIPTOR.message_types_by_name['LocalDevCmd'] = _LOCALDEVCMD
+DESCRIPTOR.message_types_by_name['LocalConfigInfo'] = _LOCALCONFIGINFO
+DESCRIPTOR.message_types_by_name['LocalConfigRequest'] = _LOCALCONFIGREQUEST
+DESCRIPTOR.message_types_by_name['LocalOperation'] = _LOCALOPERATION
+DESCRIPTOR.message_types_by_name['LocalAppActivate'] = _LOCALAPPACTIVATE
+DESCRIPTOR.message_types_by_name['LocalGlobalSetting'] = _LOCALGLOBALSETTING
+DESCRIPTOR.message_types_by_name['LocalPortMap'] = _LOCALPORTMAP
+DESCRIPTOR.message_types_by_name['LocalBaseOsUpdate'] = _LOCALBASEOSUPDATE
 _sym_db.RegisterFileDescriptor(DESCRIPTOR)
 
 LocalProfile = _reflection.GeneratedProtocolMessageType('LocalProfile', (_message.Message,), {
@@ -691,6 +1108,55 @@ LocalDevCmd = _reflection.GeneratedProtocolMessageType('LocalDevCmd', (_message.
   })
 _sym_db.RegisterMessage(LocalDevCmd)
 
+LocalConfigInfo = _reflection.GeneratedProtocolMessageType('LocalConfigInfo', (_message.Message,), {
+  'DESCRIPTOR' : _LOCALCONFIGINFO,
+  '__module__' : 'profile.local_profile_pb2'
+  # @@protoc_insertion_point(class_scope:org.lfedge.eve.profile.LocalConfigInfo)
+  })
+_sym_db.RegisterMessage(LocalConfigInfo)
+
+LocalConfigRequest = _reflection.GeneratedProtocolMessageType('LocalConfigRequest', (_message.Message,), {
+  'DESCRIPTOR' : _LOCALCONFIGREQUEST,
+  '__module__' : 'profile.local_profile_pb2'
+  # @@protoc_insertion_point(class_scope:org.lfedge.eve.profile.LocalConfigRequest)
+  })
+_sym_db.RegisterMessage(LocalConfigRequest)
+
+LocalOperation = _reflection.GeneratedProtocolMessageType('LocalOperation', (_message.Message,), {
+  'DESCRIPTOR' : _LOCALOPERATION,
+  '__module__' : 'profile.local_profile_pb2'
+  # @@protoc_insertion_point(class_scope:org.lfedge.eve.profile.LocalOperation)
+  })
+_sym_db.RegisterMessage(LocalOperation)
+
+LocalAppActivate = _reflection.GeneratedProtocolMessageType('LocalAppActivate', (_message.Message,), {
+  'DESCRIPTOR' : _LOCALAPPACTIVATE,
+  '__module__' : 'profile.local_profile_pb2'
+  # @@protoc_insertion_point(class_scope:org.lfedge.eve.profile.LocalAppActivate)
+  })
+_sym_db.RegisterMessage(LocalAppActivate)
+
+LocalGlobalSetting = _reflection.GeneratedProtocolMessageType('LocalGlobalSetting', (_message.Message,), {
+  'DESCRIPTOR' : _LOCALGLOBALSETTING,
+  '__module__' : 'profile.local_profile_pb2'
+  # @@protoc_insertion_point(class_scope:org.lfedge.eve.profile.LocalGlobalSetting)
+  })
+_sym_db.RegisterMessage(LocalGlobalSetting)
+
+LocalPortMap = _reflection.GeneratedProtocolMessageType('LocalPortMap', (_message.Message,), {
+  'DESCRIPTOR' : _LOCALPORTMAP,
+  '__module__' : 'profile.local_profile_pb2'
+  # @@protoc_insertion_point(class_scope:org.lfedge.eve.profile.LocalPortMap)
+  })
+_sym_db.RegisterMessage(LocalPortMap)
+
+LocalBaseOsUpdate = _reflection.GeneratedProtocolMessageType('LocalBaseOsUpdate', (_message.Message,), {
+  'DESCRIPTOR' : _LOCALBASEOSUPDATE,
+  '__module__' : 'profile.local_profile_pb2'
+  # @@protoc_insertion_point(class_scope:org.lfedge.eve.profile.LocalBaseOsUpdate)
+  })
+_sym_db.RegisterMessage(LocalBaseOsUpdate)
+
 
 DESCRIPTOR._options = None
 # @@protoc_insertion_point(module_scope)
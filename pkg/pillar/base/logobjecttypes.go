@@ -140,6 +140,8 @@ const (
 	WwanMetricsLogType LogObjectType = "wwan_metrics"
 	// WwanLocationInfoLogType:
 	WwanLocationInfoLogType LogObjectType = "wwan_location_info"
+	// WwanDataUsageLogType:
+	WwanDataUsageLogType LogObjectType = "wwan_data_usage"
 	// NetworkXObjectConfigLogType:
 	NetworkXObjectConfigLogType LogObjectType = "network_x_object"
 	// UUIDToNumLogType:
@@ -164,6 +166,18 @@ const (
 	EncryptedVaultKeyFromDeviceLogType LogObjectType = "encrypted_vault_key_from_device"
 	// EncryptedVaultKeyFromControllerLogType:
 	EncryptedVaultKeyFromControllerLogType LogObjectType = "encrypted_vault_key_from_controller"
+	// OfflineBlobImportConfigLogType:
+	OfflineBlobImportConfigLogType LogObjectType = "offline_blob_import_config"
+	// OfflineBlobImportStatusLogType:
+	OfflineBlobImportStatusLogType LogObjectType = "offline_blob_import_status"
+	// UploaderConfigLogType:
+	UploaderConfigLogType LogObjectType = "uploader_config"
+	// UploaderStatusLogType:
+	UploaderStatusLogType LogObjectType = "uploader_status"
+	// DiskBackupConfigLogType:
+	DiskBackupConfigLogType LogObjectType = "disk_backup_config"
+	// DiskBackupStatusLogType:
+	DiskBackupStatusLogType LogObjectType = "disk_backup_status"
 )
 
 // RelationObjectType :
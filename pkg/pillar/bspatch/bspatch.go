@@ -0,0 +1,118 @@
+// Copyright (c) 2022 Zededa, Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+// Package bspatch applies binary patches in the BSDIFF40 format of bsdiff.
+// The old content is read at random and the new content is written out
+// sequentially, hence neither has to fit in memory.
+package bspatch
+
+import (
+	"compress/bzip2"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+)
+
+const (
+	magic      = "BSDIFF40"
+	headerSize = 32
+	chunkSize  = 64 * 1024
+)
+
+// ErrCorrupt is returned when the patch is not a valid BSDIFF40 patch
+var ErrCorrupt = errors.New("corrupt patch")
+
+// Patch applies the patch of size patchSize to the old content of size
+// oldSize and writes the new content to w. Returns the size of the new
+// content.
+func Patch(old io.ReaderAt, oldSize int64, patch io.ReaderAt, patchSize int64,
+	w io.Writer) (int64, error) {
+
+	header := make([]byte, headerSize)
+	if _, err := patch.ReadAt(header, 0); err != nil {
+		return 0, fmt.Errorf("reading header failed: %v", err)
+	}
+	if string(header[:len(magic)]) != magic {
+		return 0, ErrCorrupt
+	}
+	ctrlLen := offtin(header[8:16])
+	diffLen := offtin(header[16:24])
+	newSize := offtin(header[24:32])
+	if ctrlLen < 0 || diffLen < 0 || newSize < 0 ||
+		headerSize+ctrlLen+diffLen > patchSize {
+		return 0, ErrCorrupt
+	}
+	ctrl := bzip2.NewReader(io.NewSectionReader(patch, headerSize, ctrlLen))
+	diff := bzip2.NewReader(io.NewSectionReader(patch,
+		headerSize+ctrlLen, diffLen))
+	extra := bzip2.NewReader(io.NewSectionReader(patch,
+		headerSize+ctrlLen+diffLen, patchSize-headerSize-ctrlLen-diffLen))
+
+	var oldPos, newPos int64
+	ctrlBuf := make([]byte, 24)
+	diffBuf := make([]byte, chunkSize)
+	oldBuf := make([]byte, chunkSize)
+	for newPos < newSize {
+		// Each control triple adds diff bytes to old bytes, copies
+		// extra bytes and seeks in the old content
+		if _, err := io.ReadFull(ctrl, ctrlBuf); err != nil {
+			return newPos, fmt.Errorf("reading control block failed: %v", err)
+		}
+		addLen := offtin(ctrlBuf[0:8])
+		copyLen := offtin(ctrlBuf[8:16])
+		seek := offtin(ctrlBuf[16:24])
+		if addLen < 0 || copyLen < 0 || newPos+addLen+copyLen > newSize {
+			return newPos, ErrCorrupt
+		}
+		for addLen > 0 {
+			n := addLen
+			if n > chunkSize {
+				n = chunkSize
+			}
+			if _, err := io.ReadFull(diff, diffBuf[:n]); err != nil {
+				return newPos, fmt.Errorf("reading diff block failed: %v", err)
+			}
+			// Bytes outside of the old content are taken as zero
+			lo, hi := oldPos, oldPos+n
+			if lo < 0 {
+				lo = 0
+			}
+			if hi > oldSize {
+				hi = oldSize
+			}
+			if lo < hi {
+				buf := oldBuf[:hi-lo]
+				if _, err := old.ReadAt(buf, lo); err != nil {
+					return newPos, fmt.Errorf("reading old content at %d failed: %v",
+						lo, err)
+				}
+				add := diffBuf[lo-oldPos : hi-oldPos]
+				for i := range add {
+					add[i] += buf[i]
+				}
+			}
+			if _, err := w.Write(diffBuf[:n]); err != nil {
+				return newPos, err
+			}
+			oldPos += n
+			newPos += n
+			addLen -= n
+		}
+		if _, err := io.CopyN(w, extra, copyLen); err != nil {
+			return newPos, fmt.Errorf("reading extra block failed: %v", err)
+		}
+		newPos += copyLen
+		oldPos += seek
+	}
+	return newPos, nil
+}
+
+// offtin decodes the sign and magnitude integers of bsdiff
+func offtin(b []byte) int64 {
+	y := int64(binary.LittleEndian.Uint64(b) &^ (1 << 63))
+	if b[7]&0x80 != 0 {
+		y = -y
+	}
+	return y
+}
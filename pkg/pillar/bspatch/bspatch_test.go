@@ -0,0 +1,72 @@
+// Copyright (c) 2022 Zededa, Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package bspatch
+
+import (
+	"bytes"
+	"io/ioutil"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPatch(t *testing.T) {
+	old, err := ioutil.ReadFile("testdata/old.bin")
+	assert.NoError(t, err)
+	expected, err := ioutil.ReadFile("testdata/new.bin")
+	assert.NoError(t, err)
+	patch, err := ioutil.ReadFile("testdata/patch.bin")
+	assert.NoError(t, err)
+
+	badMagic := append([]byte{}, patch...)
+	copy(badMagic, "BSDIFF39")
+	badSize := append([]byte{}, patch...)
+	badSize[31] = 0x80
+
+	testMatrix := map[string]struct {
+		old      []byte
+		patch    []byte
+		expected []byte
+		fail     bool
+	}{
+		"valid patch": {
+			old:      old,
+			patch:    patch,
+			expected: expected,
+		},
+		"bad magic": {
+			old:   old,
+			patch: badMagic,
+			fail:  true,
+		},
+		"negative size": {
+			old:   old,
+			patch: badSize,
+			fail:  true,
+		},
+		"truncated patch": {
+			old:   old,
+			patch: patch[:len(patch)/2],
+			fail:  true,
+		},
+		"short header": {
+			old:   old,
+			patch: patch[:headerSize-1],
+			fail:  true,
+		},
+	}
+	for testname, test := range testMatrix {
+		t.Logf("Running test case %s", testname)
+		var out bytes.Buffer
+		n, err := Patch(bytes.NewReader(test.old), int64(len(test.old)),
+			bytes.NewReader(test.patch), int64(len(test.patch)), &out)
+		if test.fail {
+			assert.Error(t, err, testname)
+			continue
+		}
+		assert.NoError(t, err, testname)
+		assert.Equal(t, int64(len(test.expected)), n, testname)
+		assert.Equal(t, test.expected, out.Bytes(), testname)
+	}
+}
@@ -822,10 +822,10 @@ func getBlobSize(c *containerdCAS, blobHash string) (int64, error) {
 }
 
 //getImageConfig returns imageConfig for a reference
-func getImageConfig(c *containerdCAS, reference string) (*ocispec.Image, error) {
+func getImageConfig(c *containerdCAS, reference string) (*containerd.ImageSpec, error) {
 	index := ocispec.Index{}
 	manifests := ocispec.Manifest{}
-	imageConfig := ocispec.Image{}
+	imageConfig := containerd.ImageSpec{}
 
 	//Step 1: Get the hash of parent blob
 	imageParentHash, err := c.GetImageHash(reference)
@@ -25,6 +25,11 @@ func getEncryptionBlock(
 	decBlock.WifiUserName = zconfigDecBlockPtr.WifiUserName
 	decBlock.WifiPassword = zconfigDecBlockPtr.WifiPassword
 	decBlock.ProtectedUserData = zconfigDecBlockPtr.ProtectedUserData
+	decBlock.UefiPK = zconfigDecBlockPtr.UefiPK
+	decBlock.UefiKEK = zconfigDecBlockPtr.UefiKEK
+	decBlock.UefiDb = zconfigDecBlockPtr.UefiDb
+	decBlock.UefiDbx = zconfigDecBlockPtr.UefiDbx
+	decBlock.BackupKey = zconfigDecBlockPtr.BackupKey
 	return decBlock
 }
 
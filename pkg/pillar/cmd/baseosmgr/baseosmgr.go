@@ -54,6 +54,7 @@ type baseOsMgrContext struct {
 	subContentTreeStatus pubsub.Subscription
 	subNodeAgentStatus   pubsub.Subscription
 	subZedAgentStatus    pubsub.Subscription
+	subDeviceSchedule    pubsub.Subscription
 	rebootReason         string    // From last reboot
 	rebootTime           time.Time // From last reboot
 	rebootImage          string    // Image from which the last reboot happened
@@ -146,12 +147,21 @@ func Run(ps *pubsub.PubSub, loggerArg *logrus.Logger, logArg *base.LogObject) in
 	}
 	log.Functionf("user containerd ready")
 
+	// Check once a minute whether deferred versions may be activated
+	activationTicker := time.NewTicker(time.Minute)
+
 	// start the forever loop for event handling
 	for {
 		select {
 		case change := <-ctx.subGlobalConfig.MsgChan():
 			ctx.subGlobalConfig.ProcessChange(change)
 
+		case change := <-ctx.subDeviceSchedule.MsgChan():
+			ctx.subDeviceSchedule.ProcessChange(change)
+
+		case <-activationTicker.C:
+			maybeActivateDeferred(&ctx)
+
 		case change := <-ctx.subBaseOsConfig.MsgChan():
 			ctx.subBaseOsConfig.ProcessChange(change)
 
@@ -204,13 +214,8 @@ func handleBaseOsConfigCreate(ctxArg interface{}, key string, configArg interfac
 		BaseOsVersion:  config.BaseOsVersion,
 	}
 
-	status.ContentTreeStatusList = make([]types.ContentTreeStatus,
-		len(config.ContentTreeConfigList))
-
-	for i, ctc := range config.ContentTreeConfigList {
-		cts := &status.ContentTreeStatusList[i]
-		cts.UpdateFromContentTreeConfig(ctc)
-	}
+	status.UsingDelta = config.Delta != nil
+	setContentTreeStatusList(&status, contentTreeConfigs(config, status))
 	// Check image count
 	err := validateBaseOsConfig(ctx, config)
 	if err != nil {
@@ -249,6 +254,9 @@ func handleBaseOsConfigModify(ctxArg interface{}, key string, configArg interfac
 
 	// update the version field, uuids being the same
 	status.UUIDandVersion = config.UUIDandVersion
+	if status.UsingDelta && config.Delta == nil {
+		fallbackToFullImage(ctx, config, status, "delta removed from config")
+	}
 	publishBaseOsStatus(ctx, status)
 	baseOsHandleStatusUpdate(ctx, &config, status)
 }
@@ -477,6 +485,24 @@ func initializeZedagentHandles(ps *pubsub.PubSub, ctx *baseOsMgrContext) {
 	}
 	ctx.subBaseOs = subBaseOs
 	subBaseOs.Activate()
+
+	// Look for the maintenance windows
+	subDeviceSchedule, err := ps.NewSubscription(
+		pubsub.SubscriptionOptions{
+			AgentName:   "zedagent",
+			MyAgentName: agentName,
+			TopicImpl:   types.DeviceSchedule{},
+			Persistent:  true,
+			Activate:    false,
+			Ctx:         ctx,
+			WarningTime: warningTime,
+			ErrorTime:   errorTime,
+		})
+	if err != nil {
+		log.Fatal(err)
+	}
+	ctx.subDeviceSchedule = subDeviceSchedule
+	subDeviceSchedule.Activate()
 }
 
 func initializeVolumemgrHandles(ps *pubsub.PubSub, ctx *baseOsMgrContext) {
@@ -0,0 +1,81 @@
+// Copyright (c) 2022 Zededa, Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package baseosmgr
+
+// Code for the delta images of base OS versions. The delta is downloaded
+// and applied instead of the image of the version until it fails, then
+// the image is downloaded.
+
+import (
+	"github.com/lf-edge/eve/pkg/pillar/types"
+	uuid "github.com/satori/go.uuid"
+)
+
+// contentTreeConfigs returns the content trees which are downloaded for
+// the base OS version
+func contentTreeConfigs(config types.BaseOsConfig,
+	status types.BaseOsStatus) []types.ContentTreeConfig {
+
+	if status.UsingDelta && config.Delta != nil {
+		return []types.ContentTreeConfig{config.Delta.ContentTreeConfig}
+	}
+	return config.ContentTreeConfigList
+}
+
+// installDelta returns the delta to install, or nil if the image is
+// installed
+func installDelta(config types.BaseOsConfig,
+	status types.BaseOsStatus) *types.BaseOsDelta {
+
+	if status.UsingDelta {
+		return config.Delta
+	}
+	return nil
+}
+
+// setContentTreeStatusList starts over the status of the content trees
+func setContentTreeStatusList(status *types.BaseOsStatus,
+	configList []types.ContentTreeConfig) {
+
+	status.ContentTreeStatusList = make([]types.ContentTreeStatus,
+		len(configList))
+	for i, ctc := range configList {
+		cts := &status.ContentTreeStatusList[i]
+		cts.UpdateFromContentTreeConfig(ctc)
+	}
+}
+
+// fallbackToFullImage gives up the delta and starts over with the
+// download of the image
+func fallbackToFullImage(ctx *baseOsMgrContext, config types.BaseOsConfig,
+	status *types.BaseOsStatus, reason string) {
+
+	log.Warnf("fallbackToFullImage(%s) for %s: %s",
+		config.BaseOsVersion, status.Key(), reason)
+	for _, cts := range status.ContentTreeStatusList {
+		MaybeRemoveContentTreeConfig(ctx, cts.Key())
+	}
+	status.UsingDelta = false
+	status.DeltaFailed = true
+	setContentTreeStatusList(status, config.ContentTreeConfigList)
+	status.ClearError()
+}
+
+// lookupBaseOsConfigByDelta returns the config whose delta is the
+// content tree
+func lookupBaseOsConfigByDelta(ctx *baseOsMgrContext, contentID string) *types.BaseOsConfig {
+	id, err := uuid.FromString(contentID)
+	if err != nil {
+		return nil
+	}
+	items := ctx.subBaseOsConfig.GetAll()
+	for _, c := range items {
+		config := c.(types.BaseOsConfig)
+		if config.Delta != nil &&
+			uuid.Equal(config.Delta.ContentTreeConfig.ContentID, id) {
+			return &config
+		}
+	}
+	return nil
+}
@@ -86,12 +86,15 @@ func baseOsHandleStatusUpdateImageSha(ctx *baseOsMgrContext, imageSha string) {
 func baseOsHandleStatusUpdateUUID(ctx *baseOsMgrContext, id string) {
 	log.Functionf("baseOsHandleStatusUpdateUUID for %s", id)
 	config := lookupBaseOsConfig(ctx, id)
+	if config == nil {
+		config = lookupBaseOsConfigByDelta(ctx, id)
+	}
 	if config == nil {
 		// assume that this ContentTreeStatus is not for baseOs
 		log.Functionf("baseOsHandleStatusUpdateUUID(%s) config not found", id)
 		return
 	}
-	status := lookupBaseOsStatus(ctx, id)
+	status := lookupBaseOsStatus(ctx, config.Key())
 	if status == nil {
 		log.Functionf("baseOsHandleStatusUpdateUUID(%s) status not found", id)
 		return
@@ -281,6 +284,18 @@ func doBaseOsStatusUpdate(ctx *baseOsMgrContext, uuidStr string,
 		return changed
 	}
 
+	// The new version is only activated when its schedule allows
+	next := nextActivation(ctx, config)
+	if !status.ActivationDeferredUntil.Equal(next) {
+		status.ActivationDeferredUntil = next
+		changed = true
+	}
+	if !next.IsZero() {
+		log.Functionf("doBaseOsStatusUpdate(%s) for %s, activation deferred until %v",
+			config.BaseOsVersion, uuidStr, next)
+		return changed
+	}
+
 	c, proceed = validateAndAssignPartition(ctx, config, status)
 	changed = changed || c
 	if !proceed {
@@ -354,7 +369,20 @@ func doBaseOsActivate(ctx *baseOsMgrContext, uuidStr string,
 
 	// install the image at proper partition; dd etc
 	changed, proceed, err = installDownloadedObjects(ctx, uuidStr, status.PartitionLabel,
-		&status.ContentTreeStatusList)
+		&status.ContentTreeStatusList, installDelta(config, *status))
+	if err != nil && status.UsingDelta {
+		// The partition holds part of a patched image
+		zboot.SetOtherPartitionStateUnused(log)
+		updateAndPublishZbootStatus(ctx,
+			status.PartitionLabel, false)
+		baseOsSetPartitionInfoInStatus(ctx, status,
+			status.PartitionLabel)
+		fallbackToFullImage(ctx, config, status, err.Error())
+		checkBaseOsVolumeStatus(ctx, status.UUIDandVersion.UUID,
+			config, status)
+		changed = true
+		return changed
+	}
 	if err != nil {
 		status.SetErrorNow(err.Error())
 		changed = true
@@ -398,7 +426,7 @@ func doBaseOsInstall(ctx *baseOsMgrContext, uuidStr string,
 	changed := false
 	proceed := false
 
-	for i, ctc := range config.ContentTreeConfigList {
+	for i, ctc := range contentTreeConfigs(config, *status) {
 		cts := &status.ContentTreeStatusList[i]
 		// check that the contenttreeconfig and contenttreestatus have matching content ID
 		// and matching Relative URL. However, we tolerate the mismatched URL if it is because
@@ -537,11 +565,16 @@ func checkBaseOsVolumeStatus(ctx *baseOsMgrContext, baseOsUUID uuid.UUID,
 	uuidStr := baseOsUUID.String()
 	log.Functionf("checkBaseOsVolumeStatus(%s) for %s",
 		config.BaseOsVersion, uuidStr)
-	ret := checkContentTreeStatus(ctx, baseOsUUID, config.ContentTreeConfigList,
-		status.ContentTreeStatusList)
+	ret := checkContentTreeStatus(ctx, baseOsUUID,
+		contentTreeConfigs(config, *status), status.ContentTreeStatusList)
 
 	status.State = ret.MinState
 
+	if ret.AllErrors != "" && status.UsingDelta {
+		fallbackToFullImage(ctx, config, status, ret.AllErrors)
+		_, done := checkBaseOsVolumeStatus(ctx, baseOsUUID, config, status)
+		return true, done
+	}
 	if ret.AllErrors != "" {
 		status.SetError(ret.AllErrors, ret.ErrorTime)
 		log.Errorf("checkBaseOsVolumeStatus(%s) for %s, volumemgr error at %v: %v",
@@ -107,7 +107,7 @@ func checkContentTreeStatus(ctx *baseOsMgrContext,
 
 // Note: can not do this in volumemgr since it is triggered by Activate=true
 func installDownloadedObjects(ctx *baseOsMgrContext, uuidStr, finalObjDir string,
-	status *[]types.ContentTreeStatus) (bool, bool, error) {
+	status *[]types.ContentTreeStatus, delta *types.BaseOsDelta) (bool, bool, error) {
 
 	var (
 		changed bool
@@ -121,7 +121,7 @@ func installDownloadedObjects(ctx *baseOsMgrContext, uuidStr, finalObjDir string
 
 		if ctsPtr.State == types.LOADED {
 			changed, proceed, err = installDownloadedObject(ctx, ctsPtr.ContentID,
-				finalObjDir, ctsPtr)
+				finalObjDir, ctsPtr, delta)
 			if err != nil {
 				log.Error(err)
 				return changed, proceed, err
@@ -138,7 +138,7 @@ func installDownloadedObjects(ctx *baseOsMgrContext, uuidStr, finalObjDir string
 // If the final installation directory is known, move the object there
 // returns an error, and if ready
 func installDownloadedObject(ctx *baseOsMgrContext, contentID uuid.UUID, finalObjDir string,
-	ctsPtr *types.ContentTreeStatus) (bool, bool, error) {
+	ctsPtr *types.ContentTreeStatus, delta *types.BaseOsDelta) (bool, bool, error) {
 
 	var (
 		refID   string
@@ -191,7 +191,7 @@ func installDownloadedObject(ctx *baseOsMgrContext, contentID uuid.UUID, finalOb
 	// Move to final installation point
 	// do this as a background task
 	// XXX called twice!
-	AddWorkInstall(ctx, contentID.String(), refID, finalObjDir)
+	AddWorkInstall(ctx, contentID.String(), refID, finalObjDir, delta)
 	log.Functionf("installDownloadedObject(%s) worker started", contentID)
 	return changed, proceed, nil
 }
@@ -0,0 +1,49 @@
+// Copyright (c) 2022 Zededa, Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package baseosmgr
+
+import (
+	"time"
+
+	"github.com/lf-edge/eve/pkg/pillar/types"
+)
+
+// lookupDeviceSchedule returns the schedule from the controller; it is
+// empty before there is one
+func lookupDeviceSchedule(ctx *baseOsMgrContext) types.DeviceSchedule {
+	s, _ := ctx.subDeviceSchedule.Get("global")
+	if s == nil {
+		return types.DeviceSchedule{}
+	}
+	return s.(types.DeviceSchedule)
+}
+
+// nextActivation returns zero if the base OS may be activated now,
+// otherwise when it may next
+func nextActivation(ctx *baseOsMgrContext, config types.BaseOsConfig) time.Time {
+	var schedule types.ContentSchedule
+	for _, ctc := range config.ContentTreeConfigList {
+		schedule = schedule.Merge(ctc.Schedule)
+	}
+	return lookupDeviceSchedule(ctx).NextActivation(schedule, time.Now())
+}
+
+// maybeActivateDeferred activates the base OS versions which waited for
+// their schedule once it allows
+func maybeActivateDeferred(ctx *baseOsMgrContext) {
+	items := ctx.pubBaseOsStatus.GetAll()
+	for _, st := range items {
+		status := st.(types.BaseOsStatus)
+		if status.ActivationDeferredUntil.IsZero() {
+			continue
+		}
+		config := lookupBaseOsConfig(ctx, status.Key())
+		if config == nil || !nextActivation(ctx, *config).IsZero() {
+			continue
+		}
+		log.Noticef("maybeActivateDeferred(%s) activating %s",
+			status.Key(), config.BaseOsVersion)
+		baseOsHandleStatusUpdate(ctx, config, &status)
+	}
+}
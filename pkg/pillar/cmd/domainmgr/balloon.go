@@ -0,0 +1,110 @@
+// Copyright (c) 2022 Zededa, Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package domainmgr
+
+// Code for the memory balloon of domains with a maximum memory above their
+// guaranteed memory. The memory between the two is reclaimed from guests
+// which do not use it while the host is under memory pressure, and given
+// back when the guest needs it.
+
+import (
+	"github.com/lf-edge/eve/pkg/pillar/hypervisor"
+	"github.com/lf-edge/eve/pkg/pillar/types"
+)
+
+const (
+	// The host is under pressure below this percent of free memory
+	balloonPressurePercent = 10
+	// A guest keeps this percent of its memory available
+	balloonHeadroomPercent = 20
+	// A guest needs memory below this percent of available memory, and
+	// gets this percent more
+	balloonDemandPercent = 10
+	balloonGrowPercent   = 25
+	// Smaller changes are not worth a balloon command
+	balloonMinStepMB = 16
+)
+
+// balloonMemory returns the balloon metric of a running domain with a
+// balloon after it applied the target of the policy
+func balloonMemory(ctx *domainContext, ballooner hypervisor.MemoryBallooner,
+	status *types.DomainStatus, hm types.HostMemory) *types.BalloonMetric {
+
+	metric, err := ballooner.GetBalloonStats(status.DomainName)
+	if err != nil {
+		log.Warnf("balloonMemory(%s): %v", status.DomainName, err)
+		return nil
+	}
+	metric.GuaranteedMB = uint32((status.Memory + 1023) / 1024)
+	metric.MaxMB = uint32((status.MaxMem + 1023) / 1024)
+	// The previous target, else the memory the guest has
+	metric.TargetMB = metric.ActualMB
+	st, _ := ctx.pubDomainMetric.Get(status.UUIDandVersion.UUID.String())
+	if st != nil {
+		previous := st.(types.DomainMetric)
+		if previous.Balloon != nil && previous.Balloon.TargetMB != 0 {
+			metric.TargetMB = previous.Balloon.TargetMB
+		}
+	}
+	target := balloonTarget(metric, hm)
+	if target == metric.TargetMB {
+		return &metric
+	}
+	log.Noticef("balloonMemory(%s): target %d MB from %d MB, actual %d MB, available %d MB, host free %d MB",
+		status.DomainName, target, metric.TargetMB, metric.ActualMB,
+		metric.AvailableMB, hm.FreeMemoryMB)
+	if err := ballooner.SetBalloonTarget(status.DomainName,
+		uint64(target)<<20); err != nil {
+		log.Errorf("balloonMemory(%s): %v", status.DomainName, err)
+		return &metric
+	}
+	metric.TargetMB = target
+	return &metric
+}
+
+// balloonTarget returns the memory for the guest. It is reclaimed from a
+// guest with more than its headroom available while the host is under
+// pressure, and given back to a guest which runs out of memory as long as
+// the host has free memory.
+func balloonTarget(metric types.BalloonMetric, hm types.HostMemory) uint32 {
+	target := metric.TargetMB
+	if metric.LastUpdate.IsZero() || metric.ActualMB == 0 {
+		// Nothing is known about the use of memory by the guest
+		return target
+	}
+	pressure := hm.FreeMemoryMB*100 < hm.TotalMemoryMB*balloonPressurePercent
+	headroom := metric.ActualMB * balloonHeadroomPercent / 100
+	switch {
+	case metric.AvailableMB*100 < metric.ActualMB*balloonDemandPercent:
+		grow := metric.ActualMB * balloonGrowPercent / 100
+		if grow < balloonMinStepMB {
+			grow = balloonMinStepMB
+		}
+		// Leave the host above the pressure
+		spare := int64(hm.FreeMemoryMB) -
+			int64(hm.TotalMemoryMB*balloonPressurePercent/100)
+		if spare < int64(grow) {
+			if spare <= 0 {
+				return target
+			}
+			grow = uint32(spare)
+		}
+		target = metric.ActualMB + grow
+	case pressure && metric.AvailableMB > headroom:
+		target = metric.ActualMB - (metric.AvailableMB - headroom)
+	default:
+		return target
+	}
+	if target < metric.GuaranteedMB {
+		target = metric.GuaranteedMB
+	}
+	if target > metric.MaxMB {
+		target = metric.MaxMB
+	}
+	diff := int64(target) - int64(metric.TargetMB)
+	if diff < balloonMinStepMB && diff > -balloonMinStepMB {
+		return metric.TargetMB
+	}
+	return target
+}
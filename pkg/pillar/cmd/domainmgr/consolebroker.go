@@ -0,0 +1,332 @@
+// Copyright (c) 2022 Zededa, Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package domainmgr
+
+// Code for the console broker. With app.console.brokered the remote
+// console tunnel relays to it instead of guacd. The controller starts a
+// session by sending a line with the id and the token of one of the console
+// sessions of the domains. The broker answers "OK" or "ERROR <reason>", and
+// then relays the VNC server or the serial console of the domain until the
+// session ends, recording its output if asked to.
+
+import (
+	"bufio"
+	"compress/gzip"
+	"crypto/sha256"
+	"crypto/subtle"
+	"encoding/binary"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/lf-edge/eve/pkg/pillar/hypervisor"
+	"github.com/lf-edge/eve/pkg/pillar/types"
+	uuid "github.com/satori/go.uuid"
+)
+
+// The controller has to send the first line of a session within that time
+const consoleHandshakeTimeout = 30 * time.Second
+
+// The first line of a session is "<session id> <token>"
+const consoleHandshakeMaxLen = 512
+
+// The VNC server or the serial console has to accept within that time
+const consoleDialTimeout = 10 * time.Second
+
+// A session can be used once at a time
+var activeConsoleSessions sync.Map
+
+// startConsoleBroker accepts the console sessions relayed by the tunnel
+func startConsoleBroker(ctx *domainContext) error {
+	listener, err := net.Listen("tcp", types.ConsoleBrokerAddr)
+	if err != nil {
+		return err
+	}
+	log.Noticef("console broker listening on %s", types.ConsoleBrokerAddr)
+	go func() {
+		for {
+			conn, err := listener.Accept()
+			if err != nil {
+				log.Errorf("console broker: %v", err)
+				time.Sleep(time.Second)
+				continue
+			}
+			go handleConsoleConn(ctx, conn)
+		}
+	}()
+	return nil
+}
+
+// handleConsoleConn checks the session a connection asks for and relays
+// the console of its domain
+func handleConsoleConn(ctx *domainContext, conn net.Conn) {
+	defer conn.Close()
+	conn.SetDeadline(time.Now().Add(consoleHandshakeTimeout))
+	reader := bufio.NewReaderSize(conn, consoleHandshakeMaxLen)
+	line, err := reader.ReadSlice('\n')
+	if err != nil {
+		log.Warnf("console session rejected: no handshake: %v", err)
+		return
+	}
+	var configs []types.DomainConfig
+	for _, c := range ctx.subDomainConfig.GetAll() {
+		configs = append(configs, c.(types.DomainConfig))
+	}
+	start := time.Now()
+	config, session, err := findConsoleSession(configs, string(line), start)
+	if err != nil {
+		log.Warnf("console session rejected: %v", err)
+		fmt.Fprintf(conn, "ERROR %s\n", err)
+		return
+	}
+	if _, busy := activeConsoleSessions.LoadOrStore(session.ID, true); busy {
+		log.Warnf("console session %s rejected: already in use", session.ID)
+		fmt.Fprintf(conn, "ERROR console session %s is already in use\n", session.ID)
+		return
+	}
+	defer activeConsoleSessions.Delete(session.ID)
+	target, err := dialConsole(ctx, config, session)
+	if err != nil {
+		log.Warnf("console session %s of %s rejected: %v", session.ID,
+			config.DisplayName, err)
+		fmt.Fprintf(conn, "ERROR %s\n", err)
+		return
+	}
+	defer target.Close()
+	var recording *consoleRecording
+	if session.Record {
+		recording, err = newConsoleRecording(types.NewlogUploadConsoleDir,
+			config.UUIDandVersion.UUID, session, start)
+		if err != nil {
+			log.Errorf("console session %s of %s not recorded: %v",
+				session.ID, config.DisplayName, err)
+		}
+	}
+	end := consoleSessionEnd(session, start)
+	log.Noticef("console session %s to the %s console of %s started until %s, recorded %t",
+		session.ID, session.Type, config.DisplayName, end.Format(time.RFC3339),
+		recording != nil)
+	fmt.Fprint(conn, "OK\n")
+	var output io.Writer = conn
+	if recording != nil {
+		output = io.MultiWriter(conn, recording)
+	}
+	in, out := relayConsole(conn, reader, target, output, end)
+	if recording != nil {
+		if err := recording.Close(); err != nil {
+			log.Errorf("console session %s of %s: %v", session.ID,
+				config.DisplayName, err)
+		}
+	}
+	log.Noticef("console session %s to the %s console of %s ended after %v; %d bytes in, %d bytes out",
+		session.ID, session.Type, config.DisplayName,
+		time.Since(start).Round(time.Second), in, out)
+}
+
+// findConsoleSession returns the domain and the console session the first
+// line of a session asks for, if its token matches and it did not end
+func findConsoleSession(configs []types.DomainConfig, line string,
+	now time.Time) (types.DomainConfig, types.ConsoleSession, error) {
+
+	fields := strings.Fields(line)
+	if len(fields) != 2 {
+		return types.DomainConfig{}, types.ConsoleSession{},
+			errors.New("malformed handshake")
+	}
+	id, err := uuid.FromString(fields[0])
+	if err != nil {
+		return types.DomainConfig{}, types.ConsoleSession{},
+			fmt.Errorf("invalid console session id %q", fields[0])
+	}
+	hash := sha256.Sum256([]byte(fields[1]))
+	for _, config := range configs {
+		for _, session := range config.ConsoleSessions {
+			if session.ID != id {
+				continue
+			}
+			if subtle.ConstantTimeCompare(hash[:], session.TokenSha256) != 1 {
+				return config, session, fmt.Errorf("wrong token for console session %s", id)
+			}
+			if !now.Before(session.NotAfter) {
+				return config, session, fmt.Errorf("console session %s ended at %s",
+					id, session.NotAfter.Format(time.RFC3339))
+			}
+			return config, session, nil
+		}
+	}
+	return types.DomainConfig{}, types.ConsoleSession{},
+		fmt.Errorf("unknown console session %s", id)
+}
+
+// consoleSessionEnd returns when a session which started at start ends
+func consoleSessionEnd(session types.ConsoleSession, start time.Time) time.Time {
+	end := session.NotAfter
+	if session.MaxDuration != 0 && start.Add(session.MaxDuration).Before(end) {
+		end = start.Add(session.MaxDuration)
+	}
+	return end
+}
+
+// dialConsole connects to the console of a running domain
+func dialConsole(ctx *domainContext, config types.DomainConfig,
+	session types.ConsoleSession) (net.Conn, error) {
+
+	status := lookupDomainStatus(ctx, config.Key())
+	if status == nil || status.State != types.RUNNING {
+		return nil, fmt.Errorf("%s is not running", config.DisplayName)
+	}
+	switch session.Type {
+	case types.ConsoleTypeVNC:
+		if !status.EnableVnc {
+			return nil, fmt.Errorf("%s does not enable VNC", config.DisplayName)
+		}
+		// The VNC ports only accept local connections from outside
+		address := fmt.Sprintf("127.0.0.1:%d", 5900+status.VncDisplay)
+		return net.DialTimeout("tcp", address, consoleDialTimeout)
+	case types.ConsoleTypeSerial:
+		consoler, ok := hyper.Task(status).(hypervisor.SerialConsoler)
+		if !ok {
+			return nil, fmt.Errorf("no serial console for %s with hypervisor %s",
+				config.DisplayName, hyper.Name())
+		}
+		return net.DialTimeout("unix", consoler.SerialConsoleSocket(status.DomainName),
+			consoleDialTimeout)
+	default:
+		return nil, fmt.Errorf("unknown console type %s", session.Type)
+	}
+}
+
+// relayConsole copies the input of a session from reader to target and
+// the output of target to output until either side closes or the session
+// ends. Returns the bytes of input and of output.
+func relayConsole(conn net.Conn, reader io.Reader, target net.Conn,
+	output io.Writer, end time.Time) (int64, int64) {
+
+	conn.SetDeadline(end)
+	target.SetDeadline(end)
+	var in int64
+	done := make(chan struct{})
+	go func() {
+		in, _ = io.Copy(target, reader)
+		// Have the copy of the output return too
+		target.SetDeadline(time.Now())
+		close(done)
+	}()
+	out, _ := io.Copy(output, target)
+	conn.SetDeadline(time.Now())
+	<-done
+	return in, out
+}
+
+// consoleRecording records the output of a console session into a gzip
+// file, which is only renamed for loguploader once complete. The output
+// of a VNC session is recorded in the FBS format of rfbproxy, and the
+// output of a serial console in the asciicast v2 format.
+type consoleRecording struct {
+	file      *os.File
+	gz        *gzip.Writer
+	name      string
+	vnc       bool
+	start     time.Time
+	size      int64
+	truncated bool
+}
+
+func newConsoleRecording(dir string, appUUID uuid.UUID,
+	session types.ConsoleSession, start time.Time) (*consoleRecording, error) {
+
+	if err := os.MkdirAll(dir, 0755); err != nil {
+		return nil, err
+	}
+	name := filepath.Join(dir, types.ConsoleRecordingName(appUUID, session.ID, start))
+	file, err := os.Create(filepath.Join(dir, "."+filepath.Base(name)))
+	if err != nil {
+		return nil, err
+	}
+	r := &consoleRecording{
+		file:  file,
+		gz:    gzip.NewWriter(file),
+		name:  name,
+		vnc:   session.Type == types.ConsoleTypeVNC,
+		start: start,
+	}
+	r.gz.ModTime = start
+	if r.vnc {
+		r.gz.Name = "vnc.fbs"
+		_, err = io.WriteString(r.gz, "FBS 001.000\n")
+	} else {
+		r.gz.Name = "serial.cast"
+		header, _ := json.Marshal(map[string]interface{}{
+			"version":   2,
+			"width":     80,
+			"height":    24,
+			"timestamp": start.Unix(),
+			"title":     session.ID.String(),
+		})
+		_, err = fmt.Fprintf(r.gz, "%s\n", header)
+	}
+	if err != nil {
+		file.Close()
+		os.Remove(file.Name())
+		return nil, err
+	}
+	return r, nil
+}
+
+// Write records a chunk of output. It never fails since the session goes
+// on without its recording.
+func (r *consoleRecording) Write(p []byte) (int, error) {
+	if r.truncated {
+		return len(p), nil
+	}
+	if r.size+int64(len(p)) > types.ConsoleRecordingMaxBytes {
+		log.Warnf("console recording %s reached %d bytes of output; not recording the rest",
+			r.name, types.ConsoleRecordingMaxBytes)
+		r.truncated = true
+		return len(p), nil
+	}
+	elapsed := time.Since(r.start)
+	var err error
+	if r.vnc {
+		// Length, data padded to 4 bytes, and timestamp in msec
+		chunk := make([]byte, 4+(len(p)+3)&^3+4)
+		binary.BigEndian.PutUint32(chunk, uint32(len(p)))
+		copy(chunk[4:], p)
+		binary.BigEndian.PutUint32(chunk[len(chunk)-4:],
+			uint32(elapsed/time.Millisecond))
+		_, err = r.gz.Write(chunk)
+	} else {
+		var event []byte
+		event, err = json.Marshal([]interface{}{elapsed.Seconds(), "o", string(p)})
+		if err == nil {
+			_, err = fmt.Fprintf(r.gz, "%s\n", event)
+		}
+	}
+	if err != nil {
+		log.Errorf("console recording %s failed: %v", r.name, err)
+		r.truncated = true
+	}
+	r.size += int64(len(p))
+	return len(p), nil
+}
+
+// Close completes the recording and makes it available for upload
+func (r *consoleRecording) Close() error {
+	err := r.gz.Close()
+	if cerr := r.file.Close(); err == nil {
+		err = cerr
+	}
+	if err != nil {
+		os.Remove(r.file.Name())
+		return fmt.Errorf("recording %s failed: %v", r.name, err)
+	}
+	return os.Rename(r.file.Name(), r.name)
+}
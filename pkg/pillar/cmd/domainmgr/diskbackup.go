@@ -0,0 +1,228 @@
+// Copyright (c) 2022 Zededa, Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package domainmgr
+
+// volumemgr asks for a copy of a disk image when it backs up a volume
+// while a running domain has the image locked, and for the snapshot of a
+// zvol, which is taken with the filesystems of the guest frozen.
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"time"
+
+	"github.com/lf-edge/eve/pkg/pillar/diskmetrics"
+	"github.com/lf-edge/eve/pkg/pillar/hypervisor"
+	"github.com/lf-edge/eve/pkg/pillar/types"
+	"github.com/lf-edge/eve/pkg/pillar/worker"
+	"github.com/lf-edge/eve/pkg/pillar/zfs"
+)
+
+const workDiskBackup = "diskbackup"
+
+// diskBackupWorkDescription is the copy or snapshot of a disk of a
+// running domain
+type diskBackupWorkDescription struct {
+	config         types.DiskBackupConfig
+	backuper       hypervisor.DiskBackuper
+	agent          hypervisor.GuestAgent // nil without one
+	domainName     string
+	diskStatusList []types.DiskStatus
+	index          int
+}
+
+func handleDiskBackupConfigCreate(ctxArg interface{}, key string,
+	configArg interface{}) {
+
+	ctx := ctxArg.(*domainContext)
+	config := configArg.(types.DiskBackupConfig)
+	log.Functionf("handleDiskBackupConfigCreate(%s) for %s", key, config.FileLocation)
+	status := types.DiskBackupStatus{
+		VolumeKey: config.VolumeKey,
+		Target:    config.Target,
+	}
+	domainStatus, index := lookupRunningDisk(ctx, config.FileLocation)
+	backuper, canBackup := hyper.(hypervisor.DiskBackuper)
+	switch {
+	case domainStatus == nil:
+		log.Noticef("handleDiskBackupConfigCreate(%s): %s not in use by a running domain",
+			key, config.FileLocation)
+		status.NotRunning = true
+	case !canBackup && !config.Snapshot:
+		status.SetErrorNow(fmt.Sprintf("hypervisor %s cannot copy disks of running domains",
+			hyper.Name()))
+	}
+	ctx.pubDiskBackupStatus.Publish(key, status)
+	if status.NotRunning || status.HasError() {
+		return
+	}
+	agent, _ := hyper.Task(domainStatus).(hypervisor.GuestAgent)
+	w := worker.Work{Kind: workDiskBackup, Key: key,
+		Description: diskBackupWorkDescription{
+			config:         config,
+			backuper:       backuper,
+			agent:          agent,
+			domainName:     domainStatus.DomainName,
+			diskStatusList: domainStatus.DiskStatusList,
+			index:          index,
+		}}
+	// Don't fail on errors to make idempotent (Submit returns an error if
+	// the work was already submitted)
+	done, err := ctx.worker.TrySubmit(w)
+	if err != nil {
+		log.Errorf("TrySubmit %s failed: %s", key, err)
+	} else if !done {
+		log.Fatalf("Failed to submit work due to queue length for %s", key)
+	}
+}
+
+func handleDiskBackupConfigModify(ctxArg interface{}, key string,
+	configArg interface{}, oldConfigArg interface{}) {
+
+	// The publisher deletes the config to ask for another copy
+	log.Warnf("handleDiskBackupConfigModify(%s) ignored", key)
+}
+
+func handleDiskBackupConfigDelete(ctxArg interface{}, key string,
+	configArg interface{}) {
+
+	ctx := ctxArg.(*domainContext)
+	log.Functionf("handleDiskBackupConfigDelete(%s)", key)
+	ctx.worker.Cancel(key)
+	if st, _ := ctx.pubDiskBackupStatus.Get(key); st != nil {
+		ctx.pubDiskBackupStatus.Unpublish(key)
+	}
+}
+
+// diskBackupWorker implementation of work.WorkFunction that copies or
+// snapshots a disk
+func diskBackupWorker(ctxPtr interface{}, w worker.Work) worker.WorkResult {
+	d := w.Description.(diskBackupWorkDescription)
+	var err error
+	if d.config.Snapshot {
+		err = snapshotDisk(d.agent, d.domainName, d.config.Target)
+	} else {
+		err = backupDisk(d.backuper, d.domainName, d.diskStatusList, d.index,
+			d.config.FileLocation, d.config.Target)
+	}
+	result := worker.WorkResult{
+		Key:         w.Key,
+		Description: d,
+	}
+	if err != nil {
+		result.Error = err
+		result.ErrorTime = time.Now()
+	}
+	return result
+}
+
+// processDiskBackupResult publishes the result of a copy unless
+// volumemgr deleted the config in the meantime
+func processDiskBackupResult(ctxPtr interface{}, res worker.WorkResult) error {
+	ctx := ctxPtr.(*domainContext)
+	d := res.Description.(diskBackupWorkDescription)
+	ctx.worker.Pop(res.Key)
+	config := d.config
+	if c, _ := ctx.subDiskBackupConfig.Get(res.Key); c == nil ||
+		c.(types.DiskBackupConfig).Target != config.Target {
+		log.Noticef("processDiskBackupResult(%s): config was deleted, discard %s",
+			res.Key, config.Target)
+		if res.Error == nil {
+			discardDiskBackup(config)
+		}
+		return nil
+	}
+	status := types.DiskBackupStatus{
+		VolumeKey: config.VolumeKey,
+		Target:    config.Target,
+	}
+	if res.Error != nil {
+		log.Errorf("processDiskBackupResult(%s): %v", res.Key, res.Error)
+		status.SetErrorNow(res.Error.Error())
+	} else {
+		log.Noticef("processDiskBackupResult(%s): copied %s to %s",
+			res.Key, config.FileLocation, config.Target)
+		status.Done = true
+	}
+	ctx.pubDiskBackupStatus.Publish(res.Key, status)
+	return nil
+}
+
+// lookupRunningDisk returns the running domain which has the disk image
+// and the index of the disk
+func lookupRunningDisk(ctx *domainContext, fileLocation string) (*types.DomainStatus, int) {
+	for _, st := range ctx.pubDomainStatus.GetAll() {
+		status := st.(types.DomainStatus)
+		if status.State != types.RUNNING {
+			continue
+		}
+		for i, ds := range status.DiskStatusList {
+			if ds.FileLocation == fileLocation {
+				return &status, i
+			}
+		}
+	}
+	return nil, 0
+}
+
+// discardDiskBackup removes a copy or snapshot nobody waits for any more
+func discardDiskBackup(config types.DiskBackupConfig) {
+	if !config.Snapshot {
+		os.Remove(config.Target)
+		return
+	}
+	if stdoutStderr, err := zfs.DestroyDataset(log, config.Target); err != nil {
+		log.Errorf("discardDiskBackup(%s): cannot destroy %s: %v, output=%s",
+			config.Key(), config.Target, err, stdoutStderr)
+	}
+}
+
+// snapshotDisk takes the zfs snapshot of the zvol of a disk while the guest
+// agent, if any, has the filesystems of the guest frozen, so that the
+// snapshot is consistent for them and not only after a crash
+func snapshotDisk(agent hypervisor.GuestAgent, domainName, snapshot string) error {
+	frozen := false
+	if agent != nil {
+		if count, err := agent.FreezeGuestFs(domainName); err != nil {
+			log.Warnf("snapshotDisk(%s): snapshot without freezing the filesystems of %s: %v",
+				snapshot, domainName, err)
+		} else {
+			log.Noticef("snapshotDisk(%s): froze %d filesystems of %s",
+				snapshot, count, domainName)
+			frozen = true
+		}
+	}
+	stdoutStderr, err := zfs.CreateSnapshot(log, snapshot)
+	if frozen {
+		if _, err := agent.ThawGuestFs(domainName); err != nil {
+			log.Errorf("snapshotDisk(%s): failed to thaw the filesystems of %s: %v",
+				snapshot, domainName, err)
+		}
+	}
+	if err != nil {
+		return fmt.Errorf("cannot create snapshot %s: %v, output=%s",
+			snapshot, err, stdoutStderr)
+	}
+	return nil
+}
+
+// backupDisk creates target with the size of the disk and lets the
+// hypervisor copy the disk into it
+func backupDisk(backuper hypervisor.DiskBackuper, domainName string,
+	diskStatusList []types.DiskStatus, index int, fileLocation, target string) error {
+
+	size, err := diskmetrics.GetDiskVirtualSize(log, fileLocation)
+	if err != nil {
+		return err
+	}
+	if err := diskmetrics.CreateImg(context.Background(), log, target, "qcow2", size); err != nil {
+		return fmt.Errorf("cannot create %s: %v", target, err)
+	}
+	if err := backuper.BackupDisk(domainName, diskStatusList, index, target); err != nil {
+		os.Remove(target)
+		return err
+	}
+	return nil
+}
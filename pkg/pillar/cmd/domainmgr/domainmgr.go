@@ -9,6 +9,7 @@
 package domainmgr
 
 import (
+	"context"
 	"encoding/base64"
 	"errors"
 	"flag"
@@ -16,6 +17,7 @@ import (
 	"io/ioutil"
 	"os"
 	"path"
+	"regexp"
 	"strconv"
 	"strings"
 	"sync"
@@ -29,6 +31,7 @@ import (
 	"github.com/lf-edge/eve/pkg/pillar/cipher"
 	"github.com/lf-edge/eve/pkg/pillar/containerd"
 	"github.com/lf-edge/eve/pkg/pillar/devicenetwork"
+	"github.com/lf-edge/eve/pkg/pillar/diskmetrics"
 	"github.com/lf-edge/eve/pkg/pillar/flextimer"
 	"github.com/lf-edge/eve/pkg/pillar/hypervisor"
 	"github.com/lf-edge/eve/pkg/pillar/pidfile"
@@ -36,6 +39,7 @@ import (
 	"github.com/lf-edge/eve/pkg/pillar/sema"
 	"github.com/lf-edge/eve/pkg/pillar/types"
 	"github.com/lf-edge/eve/pkg/pillar/utils"
+	"github.com/lf-edge/eve/pkg/pillar/worker"
 	uuid "github.com/satori/go.uuid"
 	"github.com/sirupsen/logrus"
 	"github.com/vishvananda/netlink"
@@ -53,11 +57,15 @@ const (
 	warningTime         = 40 * time.Second
 	containerRootfsPath = "rootfs/"
 	casClientType       = "containerd"
+
+	maxMountTagLen = 31 // Limit of 9p mount tags in older guests
 )
 
 // Really a constant
 var nilUUID = uuid.UUID{}
 
+var mountTagRegexp = regexp.MustCompile(`^[A-Za-z0-9_.-]+$`)
+
 // Set from Makefile
 var Version = "No version specified"
 
@@ -87,6 +95,9 @@ type domainContext struct {
 	pubHostMemory          pubsub.Publication
 	pubProcessMetric       pubsub.Publication
 	pubCipherBlockStatus   pubsub.Publication
+	subDiskBackupConfig    pubsub.Subscription
+	pubDiskBackupStatus    pubsub.Publication
+	worker                 worker.Worker // For the disk backups
 	cipherMetrics          *cipher.AgentMetrics
 	usbAccess              bool
 	vgaAccess              bool
@@ -105,6 +116,9 @@ type domainContext struct {
 
 	// From global config setting
 	processCloudInitMultiPart bool
+
+	// The cpusets cgroups before any CPUs were isolated, by directory
+	cpusets map[string]string
 }
 
 func (ctx *domainContext) publishAssignableAdapters() {
@@ -269,6 +283,21 @@ func Run(ps *pubsub.PubSub, loggerArg *logrus.Logger, logArg *base.LogObject) in
 	domainCtx.pubCipherBlockStatus = pubCipherBlockStatus
 	pubCipherBlockStatus.ClearRestarted()
 
+	pubDiskBackupStatus, err := ps.NewPublication(
+		pubsub.PublicationOptions{
+			AgentName: agentName,
+			TopicType: types.DiskBackupStatus{},
+		})
+	if err != nil {
+		log.Fatal(err)
+	}
+	domainCtx.pubDiskBackupStatus = pubDiskBackupStatus
+
+	// Create the background worker
+	domainCtx.worker = worker.NewPool(log, &domainCtx, 20, map[string]worker.Handler{
+		workDiskBackup: {Request: diskBackupWorker, Response: processDiskBackupResult},
+	})
+
 	cipherMetricsPub, err := ps.NewPublication(pubsub.PublicationOptions{
 		AgentName: agentName,
 		TopicType: types.CipherMetrics{},
@@ -594,6 +623,30 @@ func Run(ps *pubsub.PubSub, loggerArg *logrus.Logger, logArg *base.LogObject) in
 	domainCtx.subDomainConfig = subDomainConfig
 	subDomainConfig.Activate()
 
+	// Subscribe to DiskBackupConfig from volumemgr
+	subDiskBackupConfig, err := ps.NewSubscription(
+		pubsub.SubscriptionOptions{
+			AgentName:     "volumemgr",
+			MyAgentName:   agentName,
+			TopicImpl:     types.DiskBackupConfig{},
+			Activate:      false,
+			Ctx:           &domainCtx,
+			CreateHandler: handleDiskBackupConfigCreate,
+			ModifyHandler: handleDiskBackupConfigModify,
+			DeleteHandler: handleDiskBackupConfigDelete,
+			WarningTime:   warningTime,
+			ErrorTime:     errorTime,
+		})
+	if err != nil {
+		log.Fatal(err)
+	}
+	domainCtx.subDiskBackupConfig = subDiskBackupConfig
+	subDiskBackupConfig.Activate()
+
+	if err := startConsoleBroker(&domainCtx); err != nil {
+		log.Errorf("console broker failed: %v", err)
+	}
+
 	for {
 		select {
 		case change := <-subControllerCert.MsgChan():
@@ -611,6 +664,12 @@ func Run(ps *pubsub.PubSub, loggerArg *logrus.Logger, logArg *base.LogObject) in
 		case change := <-subDomainConfig.MsgChan():
 			subDomainConfig.ProcessChange(change)
 
+		case change := <-subDiskBackupConfig.MsgChan():
+			subDiskBackupConfig.ProcessChange(change)
+
+		case res := <-domainCtx.worker.MsgChan():
+			res.Process(&domainCtx, true)
+
 		case change := <-subDeviceNetworkStatus.MsgChan():
 			subDeviceNetworkStatus.ProcessChange(change)
 
@@ -807,6 +866,11 @@ func runHandler(ctx *domainContext, key string, c <-chan Notify) {
 	min := max * 0.3
 	ticker := flextimer.NewRangeTicker(time.Duration(min),
 		time.Duration(max))
+	healthTicker := time.NewTicker(healthPollInterval)
+	defer healthTicker.Stop()
+	guestTicker := time.NewTicker(guestPollInterval)
+	defer guestTicker.Stop()
+	guestPoll := newGuestAgentPoll()
 
 	closed := false
 	for !closed {
@@ -841,6 +905,16 @@ func runHandler(ctx *domainContext, key string, c <-chan Notify) {
 				verifyStatus(ctx, status)
 				maybeRetry(ctx, status)
 			}
+		case <-healthTicker.C:
+			status := lookupDomainStatus(ctx, key)
+			if status != nil {
+				maybeCheckHealth(ctx, status)
+			}
+		case <-guestTicker.C:
+			status := lookupDomainStatus(ctx, key)
+			if status != nil {
+				maybePollGuest(ctx, status, &guestPoll)
+			}
 		}
 	}
 	log.Functionf("runHandler(%s) DONE", key)
@@ -866,12 +940,18 @@ func verifyStatus(ctx *domainContext, status *types.DomainStatus) {
 			log.Warnln(errStr)
 			status.Activated = false
 			status.State = types.HALTED
-
-			// check if task is in the BROKEN state and kill it (later on we may do some
-			// level of recovery or at least gather some intel on why and how it crashed)
-			// NOTE: we don't do anything for repairing tasks in the UNKNOWN state, for those
-			// the only remedy is an explicit user action (delete, restart, etc.)
-			if domainStatus == types.BROKEN {
+			status.LastExitReason = err.Error()
+			status.LastExitTime = time.Now()
+
+			if applyRestartPolicy(config.RestartPolicy, status, err.Error(),
+				domainStatus == types.BROKEN, time.Now()) {
+				log.Functionf("verifyStatus(%s) applied the %s restart policy",
+					status.Key(), config.RestartPolicy.Mode)
+			} else if domainStatus == types.BROKEN {
+				// check if task is in the BROKEN state and kill it (later on we may do some
+				// level of recovery or at least gather some intel on why and how it crashed)
+				// NOTE: we don't do anything for repairing tasks in the UNKNOWN state, for those
+				// the only remedy is an explicit user action (delete, restart, etc.)
 				err := fmt.Errorf("one of the %s tasks has crashed (%v)", status.Key(), err)
 				log.Errorf(err.Error())
 				status.SetErrorNow("one of the application's tasks has crashed - please restart application instance")
@@ -898,6 +978,11 @@ func verifyStatus(ctx *domainContext, status *types.DomainStatus) {
 		status.DomainId = 0
 		publishDomainStatus(ctx, status)
 	} else {
+		if status.Activated && config != nil && len(config.PodContainers) != 0 {
+			if podManager, ok := hyper.Task(status).(hypervisor.PodManager); ok {
+				verifyPodContainers(ctx, podManager, *config, status)
+			}
+		}
 		if !status.Activated && domainStatus == types.RUNNING {
 			log.Warnf("verifyDomain(%s) domain came back alive; id  %d",
 				status.Key(), domainID)
@@ -958,18 +1043,32 @@ func maybeRetryBoot(ctx *domainContext, status *types.DomainStatus) {
 		log.Errorf("maybeRetryBoot(%s) Config not Activate - nothing to do",
 			status.Key())
 		status.BootFailed = false
+		status.NextRestartTime = time.Time{}
 		publishDomainStatus(ctx, status)
 		return
 	}
 
 	t := time.Now()
-	elapsed := t.Sub(status.ErrorTime)
-	timeLimit := time.Duration(ctx.domainBootRetryTime) * time.Second
-	if elapsed < timeLimit {
-		log.Functionf("maybeRetryBoot(%s) %d remaining",
-			status.Key(),
-			(timeLimit-elapsed)/time.Second)
-		return
+	if !status.NextRestartTime.IsZero() {
+		// Scheduled by the restart policy
+		if t.Before(status.NextRestartTime) {
+			log.Functionf("maybeRetryBoot(%s) %d remaining",
+				status.Key(),
+				status.NextRestartTime.Sub(t)/time.Second)
+			return
+		}
+		status.NextRestartTime = time.Time{}
+		status.RestartCount++
+		status.RestartTimes = append(status.RestartTimes, t)
+	} else {
+		elapsed := t.Sub(status.ErrorTime)
+		timeLimit := time.Duration(ctx.domainBootRetryTime) * time.Second
+		if elapsed < timeLimit {
+			log.Functionf("maybeRetryBoot(%s) %d remaining",
+				status.Key(),
+				(timeLimit-elapsed)/time.Second)
+			return
+		}
 	}
 	log.Noticef("maybeRetryBoot(%s) after %s at %v",
 		status.Key(), status.Error, status.ErrorTime)
@@ -980,6 +1079,15 @@ func maybeRetryBoot(ctx *domainContext, status *types.DomainStatus) {
 		status.ClearError()
 	}
 
+	// Do not boot with an NVRAM enrolled with revoked Secure Boot keys;
+	// BootFailed stays set to retry later
+	if err := prepareUefiNvram(ctx, *config); err != nil {
+		log.Errorf("maybeRetryBoot(%s): %s", status.Key(), err)
+		status.SetErrorNow(err.Error())
+		publishDomainStatus(ctx, status)
+		return
+	}
+
 	filename := xenCfgFilename(config.AppNum)
 	file, err := os.Create(filename)
 	if err != nil {
@@ -988,7 +1096,8 @@ func maybeRetryBoot(ctx *domainContext, status *types.DomainStatus) {
 	}
 	defer file.Close()
 
-	if err := hyper.Task(status).Setup(*status, *config, ctx.assignableAdapters, file); err != nil {
+	if err := hyper.Task(status).Setup(*status, isolatedConfig(*config, status),
+		ctx.assignableAdapters, file); err != nil {
 		//it is retry, so omit error
 		log.Errorf("Failed to create DomainStatus from %v: %s",
 			config, err)
@@ -1004,11 +1113,17 @@ func maybeRetryBoot(ctx *domainContext, status *types.DomainStatus) {
 			status.DomainName, err)
 		status.BootFailed = true
 		status.SetErrorNow(err.Error())
+		applyRestartPolicy(config.RestartPolicy, status, err.Error(),
+			true, time.Now())
 		publishDomainStatus(ctx, status)
 		return
 	}
 	status.BootFailed = false
 	doActivateTail(ctx, status, domainID)
+	if status.BootFailed {
+		applyRestartPolicy(config.RestartPolicy, status, status.Error,
+			true, time.Now())
+	}
 	publishDomainStatus(ctx, status)
 	log.Functionf("maybeRetryBoot(%s) DONE for %s",
 		status.Key(), status.DisplayName)
@@ -1239,6 +1354,19 @@ func doActivate(ctx *domainContext, config types.DomainConfig,
 		status.IoAdapterList = nil
 		return
 	}
+	// Retried like the adapters
+	if errDescription := reserveIsolatedCPUs(ctx, config, status); errDescription != nil {
+		log.Errorf("Failed to reserve CPUs for %s: %s",
+			config.Key(), errDescription.Error)
+		status.PendingAdd = false
+		status.SetErrorDescription(*errDescription)
+		status.AdaptersFailed = true
+		publishDomainStatus(ctx, status)
+		releaseAdapters(ctx, config.IoAdapterList, config.UUIDandVersion.UUID,
+			nil)
+		status.IoAdapterList = nil
+		return
+	}
 	status.AdaptersFailed = false
 	if status.HasError() {
 		log.Noticef("maybeRetryAdapters(%s) clearing existing error: %s",
@@ -1256,6 +1384,7 @@ func doActivate(ctx *domainContext, config types.DomainConfig,
 		status.PendingAdd = false
 		status.SetErrorNow(err.Error())
 		status.AdaptersFailed = true
+		releaseIsolatedCPUs(ctx, status)
 		publishDomainStatus(ctx, status)
 		releaseAdapters(ctx, config.IoAdapterList, config.UUIDandVersion.UUID,
 			nil)
@@ -1264,6 +1393,10 @@ func doActivate(ctx *domainContext, config types.DomainConfig,
 	}
 	// Finish preparing for container runtime.
 	for _, ds := range status.DiskStatusList {
+		if ds.Shared {
+			// directories which need no preparation
+			continue
+		}
 		switch ds.Format {
 		case zconfig.Format_FmtUnknown:
 			// do nothing
@@ -1278,7 +1411,7 @@ func doActivate(ctx *domainContext, config types.DomainConfig,
 			}
 		default:
 			// assume everything else to be disk formats
-			_, _, format, _, err := utils.GetVolumeSize(log, ds.FileLocation)
+			_, virtualSize, format, _, err := utils.GetVolumeSize(log, ds.FileLocation)
 			if err == nil && format != strings.ToLower(ds.Format.String()) {
 				err = fmt.Errorf("Disk format mismatch, format in config %v and output of qemu-img/zfs get %v\n"+
 					"Note: Format mismatch may be because of disk corruption also.",
@@ -1289,7 +1422,32 @@ func doActivate(ctx *domainContext, config types.DomainConfig,
 				status.SetErrorNow(err.Error())
 				return
 			}
+			if err := maybeGrowImage(ds, virtualSize); err != nil {
+				log.Errorf("doActivate(%s): %s", status.Key(), err)
+				status.SetErrorNow(err.Error())
+				return
+			}
+		}
+	}
+
+	// The images of the containers of the pod are not disks of the domain
+	status.PodImageList = nil
+	for _, pc := range config.PodContainers {
+		snapshotID := containerd.GetSnapshotID(pc.Image.FileLocation)
+		if err := ctx.casClient.MountSnapshot(snapshotID, getRoofFsPath(pc.Image.FileLocation)); err != nil {
+			err := fmt.Errorf("doActivate: Failed mount snapshot: %s for pod container %s of %s. Error %s",
+				snapshotID, pc.Name, config.UUIDandVersion.UUID, err)
+			log.Error(err.Error())
+			status.SetErrorNow(err.Error())
+			return
 		}
+		status.PodImageList = append(status.PodImageList, pc.Image.FileLocation)
+	}
+
+	if err := prepareUefiNvram(ctx, config); err != nil {
+		log.Errorf("doActivate(%s): %s", status.Key(), err)
+		status.SetErrorNow(err.Error())
+		return
 	}
 
 	filename := xenCfgFilename(config.AppNum)
@@ -1299,7 +1457,8 @@ func doActivate(ctx *domainContext, config types.DomainConfig,
 	}
 	defer file.Close()
 
-	if err := hyper.Task(status).Setup(*status, config, ctx.assignableAdapters, file); err != nil {
+	if err := hyper.Task(status).Setup(*status, isolatedConfig(config, status),
+		ctx.assignableAdapters, file); err != nil {
 		log.Errorf("Failed to create DomainStatus from %v: %s",
 			config, err)
 		status.SetErrorNow(err.Error())
@@ -1307,6 +1466,7 @@ func doActivate(ctx *domainContext, config types.DomainConfig,
 	}
 
 	status.TriedCount = 0
+	resetRestarts(status)
 	var domainID int
 	// Invoke domain create; try 3 times with a timeout
 	for {
@@ -1344,6 +1504,7 @@ func doActivateTail(ctx *domainContext, status *types.DomainStatus,
 		status.DomainId, status.BootTime.Format(time.RFC3339Nano),
 		status.Key())
 	status.State = types.BOOTING
+	status.PodContainerStatusList = nil
 	publishDomainStatus(ctx, status)
 
 	err := hyper.Task(status).Start(status.DomainName)
@@ -1365,6 +1526,7 @@ func doActivateTail(ctx *domainContext, status *types.DomainStatus,
 		status.State = types.BROKEN
 		return
 	}
+	isolateFromRealtime(ctx, status)
 	// The -emu interfaces were most likely created as result of the boot so we
 	// update VifUsed here.
 	status.VifList = checkIfEmu(status.VifList)
@@ -1397,6 +1559,10 @@ func doActivateTail(ctx *domainContext, status *types.DomainStatus,
 			status.DomainId, status.BootTime.Format(time.RFC3339Nano),
 			status.Key())
 	}
+	if config := lookupDomainConfig(ctx, status.Key()); config != nil {
+		startHealthCheck(*config, status, time.Now())
+	}
+	status.GuestInfo = types.GuestInfo{}
 	status.Activated = true
 	err = setupVlans(status.VifList)
 	if err != nil {
@@ -1648,11 +1814,15 @@ func doInactivate(ctx *domainContext, status *types.DomainStatus, impatient bool
 	} else {
 		status.Activated = false
 		status.State = types.HALTED
+		resetHealth(status)
+		status.GuestInfo = types.GuestInfo{}
 	}
 	unmountContainers(ctx, status.DiskStatusList)
+	unmountPodImages(ctx, status)
 	releaseAdapters(ctx, status.IoAdapterList, status.UUIDandVersion.UUID,
 		status)
 	status.IoAdapterList = nil
+	releaseIsolatedCPUs(ctx, status)
 	publishDomainStatus(ctx, status)
 
 	log.Functionf("doInactivate(%v) done for %s",
@@ -1732,6 +1902,8 @@ func configToStatus(ctx *domainContext, config types.DomainConfig,
 	status.DiskStatusList = make([]types.DiskStatus,
 		len(config.DiskConfigList))
 	need9P := false
+	isContainer := len(config.DiskConfigList) > 0 &&
+		config.DiskConfigList[0].Format == zconfig.Format_CONTAINER
 	for i, dc := range config.DiskConfigList {
 		ds := &status.DiskStatusList[i]
 		ds.VolumeKey = dc.VolumeKey
@@ -1741,9 +1913,18 @@ func configToStatus(ctx *domainContext, config types.DomainConfig,
 		ds.MountDir = dc.MountDir
 		ds.DisplayName = dc.DisplayName
 		ds.WWN = dc.WWN
+		ds.MaxVolSize = dc.MaxVolSize
+		ds.Shared = dc.Shared
+		ds.IOLimits = dc.IOLimits
 		// Generate Devtype for hypervisor package
 		// XXX can hypervisor look at something different?
-		if dc.Format == zconfig.Format_CONTAINER {
+		if dc.Shared {
+			// bind mounted into containers and exported to VMs
+			if !isContainer {
+				ds.Devtype = "9P"
+				ds.MountTag = sharedMountTag(dc)
+			}
+		} else if dc.Format == zconfig.Format_CONTAINER {
 			if i == 0 {
 				ds.MountDir = "/"
 				status.OCIConfigDir = ds.FileLocation
@@ -1799,6 +1980,17 @@ func configToStatus(ctx *domainContext, config types.DomainConfig,
 	return nil
 }
 
+// sharedMountTag returns the 9p mount tag of a shared volume: the
+// display name of the volume if it is a valid tag, otherwise the first
+// part of the volume UUID
+func sharedMountTag(dc types.DiskConfig) string {
+	if len(dc.DisplayName) > 0 && len(dc.DisplayName) <= maxMountTagLen &&
+		mountTagRegexp.MatchString(dc.DisplayName) {
+		return dc.DisplayName
+	}
+	return strings.SplitN(dc.VolumeKey, "-", 2)[0]
+}
+
 // Check for errors and reserve any assigned adapters.
 // Please note that reservation is done only by setting UsedByUUID to the application UUID.
 // The actual call to PCIReserve() is done later by doAssignIoAdaptersToDomain().
@@ -1984,6 +2176,11 @@ func handleModify(ctx *domainContext, key string,
 		return
 	}
 
+	if config.Activate && status.Activated {
+		maybeGrowDisks(*config, status)
+		maybeThrottleDisks(*config, status)
+	}
+
 	// XXX check if we have status.HasError() and delete and retry
 	// even if same version. XXX won't the above Activate/Activated checks
 	// result in redoing things? Could have failures during copy i.e.
@@ -2010,6 +2207,83 @@ func handleModify(ctx *domainContext, key string,
 		config.UUIDandVersion, config.DisplayName)
 }
 
+// maybeGrowImage grows the image file of a disk which volumemgr could not
+// grow while the domain was running. zvols are always grown by volumemgr.
+func maybeGrowImage(ds types.DiskStatus, virtualSize uint64) error {
+	if ds.ReadOnly || ds.MaxVolSize <= virtualSize ||
+		strings.HasPrefix(ds.FileLocation, types.ZVolDevicePrefix) {
+		return nil
+	}
+	// qcow2 requires the size to be a multiple of the sector size
+	size := (ds.MaxVolSize + 511) &^ 511
+	log.Noticef("maybeGrowImage: grow %s from %d to %d",
+		ds.FileLocation, virtualSize, size)
+	if err := diskmetrics.ResizeImg(context.Background(), log, ds.FileLocation, size); err != nil {
+		return fmt.Errorf("failed to grow %s to %d: %s",
+			ds.FileLocation, size, err)
+	}
+	return nil
+}
+
+// maybeGrowDisks lets the hypervisor of a running domain know about
+// disks which volumemgr has grown. If it cannot, the domain sees the new
+// size after a restart.
+func maybeGrowDisks(config types.DomainConfig, status *types.DomainStatus) {
+	resizer, canResize := hyper.(hypervisor.DiskResizer)
+	for i, dc := range config.DiskConfigList {
+		if i >= len(status.DiskStatusList) {
+			break
+		}
+		ds := &status.DiskStatusList[i]
+		if ds.VolumeKey != dc.VolumeKey || dc.MaxVolSize <= ds.MaxVolSize {
+			continue
+		}
+		if ds.Format != zconfig.Format_CONTAINER && !ds.Shared && ds.MaxVolSize != 0 {
+			if !canResize || status.State != types.RUNNING {
+				log.Noticef("maybeGrowDisks(%s): %s grows to %d after restart",
+					status.Key(), ds.DisplayName, dc.MaxVolSize)
+			} else if err := resizer.ResizeDisk(status.DomainName,
+				status.DiskStatusList, i, dc.MaxVolSize); err != nil {
+				log.Errorf("maybeGrowDisks(%s): %s", status.Key(), err)
+				// retry on the next modify
+				continue
+			} else {
+				log.Noticef("maybeGrowDisks(%s): %s grown to %d",
+					status.Key(), ds.DisplayName, dc.MaxVolSize)
+			}
+		}
+		ds.MaxVolSize = dc.MaxVolSize
+	}
+}
+
+// maybeThrottleDisks applies changed I/O limits to the disks of a running
+// domain. Other domains get the limits when they start.
+func maybeThrottleDisks(config types.DomainConfig, status *types.DomainStatus) {
+	throttler, canThrottle := hyper.Task(status).(hypervisor.DiskThrottler)
+	for i, dc := range config.DiskConfigList {
+		if i >= len(status.DiskStatusList) {
+			break
+		}
+		ds := &status.DiskStatusList[i]
+		if ds.VolumeKey != dc.VolumeKey || dc.IOLimits == ds.IOLimits {
+			continue
+		}
+		if !canThrottle || status.State != types.RUNNING {
+			log.Noticef("maybeThrottleDisks(%s): %s limits %+v apply after restart",
+				status.Key(), ds.DisplayName, dc.IOLimits)
+		} else if err := throttler.ThrottleDisk(status.DomainName,
+			status.DiskStatusList, i, dc.IOLimits); err != nil {
+			log.Errorf("maybeThrottleDisks(%s): %s", status.Key(), err)
+			// retry on the next modify
+			continue
+		} else {
+			log.Noticef("maybeThrottleDisks(%s): %s limited to %+v",
+				status.Key(), ds.DisplayName, dc.IOLimits)
+		}
+		ds.IOLimits = dc.IOLimits
+	}
+}
+
 func updateStatusFromConfig(status *types.DomainStatus, config types.DomainConfig) {
 	status.VirtualizationMode = config.VirtualizationModeOrDefault()
 	status.EnableVnc = config.EnableVnc
@@ -2089,6 +2363,8 @@ func handleDelete(ctx *domainContext, key string, status *types.DomainStatus) {
 	if status.Activated {
 		doInactivate(ctx, status, true)
 	}
+	// Also when the activation failed
+	releaseIsolatedCPUs(ctx, status)
 
 	// Check if the USB controller became available for dom0
 	updateUsbAccess(ctx)
@@ -2143,6 +2419,15 @@ func DomainShutdown(status types.DomainStatus, force bool) error {
 	var err error
 	log.Functionf("DomainShutdown force-%v %s %d", force, status.DomainName, status.DomainId)
 
+	// The guest agent, if any, shuts the guest down more reliably than ACPI
+	if agent, ok := hyper.Task(&status).(hypervisor.GuestAgent); ok && !force {
+		if err = agent.GuestShutdown(status.DomainName); err == nil {
+			log.Functionf("Stopping domain - %s by its guest agent", status.DomainName)
+			return nil
+		}
+		log.Noticef("DomainShutdown %s without guest agent: %v", status.DomainName, err)
+	}
+
 	// Stop the domain
 	log.Functionf("Stopping domain - %s", status.DomainName)
 	err = hyper.Task(&status).Stop(status.DomainName, force)
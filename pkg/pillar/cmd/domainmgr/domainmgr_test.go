@@ -9,7 +9,13 @@
 package domainmgr
 
 import (
+	"bytes"
+	"compress/gzip"
+	"crypto/sha256"
 	"encoding/base64"
+	"encoding/binary"
+	"encoding/json"
+	"errors"
 	"fmt"
 	"io/ioutil"
 	"os"
@@ -17,8 +23,11 @@ import (
 	"reflect"
 	"strings"
 	"testing"
+	"time"
 
 	"github.com/lf-edge/eve/pkg/pillar/base"
+	"github.com/lf-edge/eve/pkg/pillar/types"
+	uuid "github.com/satori/go.uuid"
 	"github.com/sirupsen/logrus"
 	"github.com/stretchr/testify/assert"
 )
@@ -456,3 +465,798 @@ func TestHandleMimeMultipart(t *testing.T) {
 	}
 	os.RemoveAll(dir)
 }
+
+func TestSharedMountTag(t *testing.T) {
+	volumeKey := "2a1a4d8e-6f3e-4b62-9c1d-0e5c2d7b9a11#0"
+	tests := map[string]string{
+		"data":                              "data",
+		"pipeline.in_1":                     "pipeline.in_1",
+		"":                                  "2a1a4d8e",
+		"my data":                           "2a1a4d8e",
+		"a-name-which-is-longer-than-31-ch": "2a1a4d8e",
+	}
+	for name, tag := range tests {
+		assert.Equal(t, tag, sharedMountTag(types.DiskConfig{
+			VolumeKey:   volumeKey,
+			DisplayName: name,
+		}), name)
+	}
+}
+
+func TestApplyRestartPolicy(t *testing.T) {
+	log = base.NewSourceLogObject(logrus.StandardLogger(), "domainmgr", 0)
+	now := time.Now()
+	policy := types.RestartPolicy{
+		Backoff:           10 * time.Second,
+		MaxBackoff:        time.Minute,
+		CrashLoopRestarts: 3,
+		CrashLoopWindow:   10 * time.Minute,
+	}
+	withMode := func(mode types.RestartMode, maxAttempts uint32) types.RestartPolicy {
+		p := policy
+		p.Mode = mode
+		p.MaxAttempts = maxAttempts
+		return p
+	}
+	type testEntry struct {
+		policy       types.RestartPolicy
+		failed       bool
+		restartCount uint32
+		restartTimes []time.Time
+		applied      bool
+		state        types.SwState
+		backoff      time.Duration // zero if not restarted
+	}
+	testMatrix := map[string]testEntry{
+		"default": {
+			policy:  withMode(types.RestartModeDefault, 0),
+			failed:  true,
+			applied: false,
+		},
+		"never": {
+			policy:  withMode(types.RestartModeNever, 0),
+			failed:  true,
+			applied: true,
+			state:   types.HALTED,
+		},
+		"on-failure after exit": {
+			policy:  withMode(types.RestartModeOnFailure, 0),
+			applied: true,
+			state:   types.HALTED,
+		},
+		"on-failure after failure": {
+			policy:  withMode(types.RestartModeOnFailure, 0),
+			failed:  true,
+			applied: true,
+			state:   types.HALTED,
+			backoff: 10 * time.Second,
+		},
+		"on-failure after max attempts": {
+			policy:       withMode(types.RestartModeOnFailure, 2),
+			failed:       true,
+			restartCount: 2,
+			applied:      true,
+			state:        types.HALTED,
+		},
+		"always with backoff": {
+			policy:       withMode(types.RestartModeAlways, 0),
+			restartTimes: []time.Time{now.Add(-time.Minute), now.Add(-time.Hour)},
+			applied:      true,
+			state:        types.HALTED,
+			backoff:      20 * time.Second,
+		},
+		"always with max backoff": {
+			policy: types.RestartPolicy{
+				Mode:              types.RestartModeAlways,
+				Backoff:           40 * time.Second,
+				MaxBackoff:        time.Minute,
+				CrashLoopRestarts: 3,
+				CrashLoopWindow:   10 * time.Minute,
+			},
+			restartTimes: []time.Time{now.Add(-time.Minute), now.Add(-time.Minute)},
+			applied:      true,
+			state:        types.HALTED,
+			backoff:      time.Minute,
+		},
+		"always in crash loop": {
+			policy: withMode(types.RestartModeAlways, 0),
+			restartTimes: []time.Time{now.Add(-3 * time.Minute),
+				now.Add(-2 * time.Minute), now.Add(-time.Minute)},
+			applied: true,
+			state:   types.CRASH_LOOP,
+		},
+	}
+	for testname, test := range testMatrix {
+		t.Logf("Running test case %s", testname)
+		status := types.DomainStatus{
+			State:        types.RUNNING,
+			RestartCount: test.restartCount,
+			RestartTimes: test.restartTimes,
+		}
+		applied := applyRestartPolicy(test.policy, &status, "exited",
+			test.failed, now)
+		assert.Equal(t, test.applied, applied, testname)
+		if !applied {
+			continue
+		}
+		assert.Equal(t, test.state, status.State, testname)
+		assert.Equal(t, test.backoff != 0, status.BootFailed, testname)
+		if test.backoff != 0 {
+			assert.Equal(t, now.Add(test.backoff), status.NextRestartTime, testname)
+			assert.Equal(t, types.ErrorSeverityWarning, status.ErrorSeverity, testname)
+		} else {
+			assert.True(t, status.NextRestartTime.IsZero(), testname)
+			assert.True(t, status.HasError(), testname)
+		}
+	}
+}
+
+func TestUpdatePodContainerStatus(t *testing.T) {
+	now := time.Now()
+	config := types.DomainConfig{
+		RestartPolicy: types.RestartPolicy{
+			Backoff:    10 * time.Second,
+			MaxBackoff: time.Minute,
+		},
+		PodContainers: []types.PodContainerConfig{
+			{Name: "init", Init: true},
+			{Name: "always"},
+			{Name: "on-failure", RestartMode: types.RestartModeOnFailure},
+			{Name: "never", RestartMode: types.RestartModeNever},
+		},
+	}
+	type testEntry struct {
+		previous    *types.PodContainerStatus
+		info        types.PodContainerInfo
+		restart     bool
+		state       types.SwState
+		nextRestart time.Duration // zero if no restart is pending
+		exitReason  string
+	}
+	testMatrix := map[string]testEntry{
+		"running sidecar": {
+			info:  types.PodContainerInfo{Name: "always", State: types.RUNNING},
+			state: types.RUNNING,
+		},
+		"init container exited": {
+			previous:   &types.PodContainerStatus{Name: "init", State: types.RUNNING},
+			info:       types.PodContainerInfo{Name: "init", Init: true, State: types.HALTED},
+			state:      types.HALTED,
+			exitReason: "exited with status 0",
+		},
+		"sidecar exited": {
+			previous:    &types.PodContainerStatus{Name: "always", State: types.RUNNING},
+			info:        types.PodContainerInfo{Name: "always", State: types.HALTED},
+			state:       types.HALTED,
+			nextRestart: 10 * time.Second,
+			exitReason:  "exited with status 0",
+		},
+		"sidecar exited after restarts": {
+			previous: &types.PodContainerStatus{Name: "always", State: types.BOOTING,
+				RestartCount: 1},
+			info:        types.PodContainerInfo{Name: "always", State: types.HALTED},
+			state:       types.HALTED,
+			nextRestart: 20 * time.Second,
+			exitReason:  "exited with status 0",
+		},
+		"sidecar due": {
+			previous: &types.PodContainerStatus{Name: "always", State: types.HALTED,
+				NextRestartTime: now},
+			info:    types.PodContainerInfo{Name: "always", State: types.HALTED},
+			restart: true,
+			state:   types.BOOTING,
+		},
+		"on-failure exited": {
+			previous:   &types.PodContainerStatus{Name: "on-failure", State: types.RUNNING},
+			info:       types.PodContainerInfo{Name: "on-failure", State: types.HALTED},
+			state:      types.HALTED,
+			exitReason: "exited with status 0",
+		},
+		"on-failure broken": {
+			previous: &types.PodContainerStatus{Name: "on-failure", State: types.RUNNING},
+			info: types.PodContainerInfo{Name: "on-failure", State: types.BROKEN,
+				ExitCode: 2},
+			state:       types.BROKEN,
+			nextRestart: 10 * time.Second,
+			exitReason:  "exited with status 2",
+		},
+		"never broken": {
+			previous: &types.PodContainerStatus{Name: "never", State: types.RUNNING},
+			info: types.PodContainerInfo{Name: "never", State: types.BROKEN,
+				ExitCode: 1},
+			state:      types.BROKEN,
+			exitReason: "exited with status 1",
+		},
+	}
+	for testname, test := range testMatrix {
+		t.Logf("Running test case %s", testname)
+		status := types.DomainStatus{}
+		if test.previous != nil {
+			status.PodContainerStatusList = []types.PodContainerStatus{*test.previous}
+		}
+		restart := updatePodContainerStatus(config, &status,
+			[]types.PodContainerInfo{test.info}, now)
+		assert.Equal(t, test.restart, len(restart) == 1, testname)
+		assert.Equal(t, 1, len(status.PodContainerStatusList), testname)
+		pcs := status.PodContainerStatusList[0]
+		assert.Equal(t, test.state, pcs.State, testname)
+		assert.Equal(t, test.exitReason, pcs.LastExitReason, testname)
+		if test.nextRestart != 0 {
+			assert.Equal(t, now.Add(test.nextRestart), pcs.NextRestartTime, testname)
+		} else {
+			assert.True(t, pcs.NextRestartTime.IsZero(), testname)
+		}
+	}
+}
+
+func TestApplyHealthResult(t *testing.T) {
+	now := time.Now()
+	check := types.HealthCheck{
+		Type:        types.HealthCheckExec,
+		Interval:    10 * time.Second,
+		StartPeriod: time.Minute,
+		Retries:     3,
+		Restart:     true,
+	}
+	failed := errors.New("execution failed with exit status 1")
+	type testEntry struct {
+		health        types.HealthStatus
+		bootTime      time.Time
+		err           error
+		output        string
+		restart       bool
+		state         types.HealthState
+		failingStreak uint32
+		lastOutput    string
+	}
+	testMatrix := map[string]testEntry{
+		"healthy after start": {
+			health:     types.HealthStatus{State: types.HealthStarting},
+			bootTime:   now.Add(-time.Second),
+			output:     "ok\n",
+			state:      types.HealthHealthy,
+			lastOutput: "ok",
+		},
+		"failure in start period": {
+			health:     types.HealthStatus{State: types.HealthStarting},
+			bootTime:   now.Add(-time.Second),
+			err:        failed,
+			state:      types.HealthStarting,
+			lastOutput: failed.Error(),
+		},
+		"failure after start period": {
+			health:        types.HealthStatus{State: types.HealthStarting},
+			bootTime:      now.Add(-2 * time.Minute),
+			err:           failed,
+			output:        "connection refused",
+			state:         types.HealthStarting,
+			failingStreak: 1,
+			lastOutput:    failed.Error() + ": connection refused",
+		},
+		"healthy again": {
+			health: types.HealthStatus{State: types.HealthHealthy,
+				FailingStreak: 2},
+			bootTime: now.Add(-time.Hour),
+			state:    types.HealthHealthy,
+		},
+		"unhealthy after retries": {
+			health: types.HealthStatus{State: types.HealthHealthy,
+				FailingStreak: 2},
+			bootTime:      now.Add(-time.Hour),
+			err:           failed,
+			restart:       true,
+			state:         types.HealthUnhealthy,
+			failingStreak: 3,
+			lastOutput:    failed.Error(),
+		},
+		"still unhealthy": {
+			health: types.HealthStatus{State: types.HealthUnhealthy,
+				FailingStreak: 3},
+			bootTime:      now.Add(-time.Hour),
+			err:           failed,
+			state:         types.HealthUnhealthy,
+			failingStreak: 4,
+			lastOutput:    failed.Error(),
+		},
+	}
+	for testname, test := range testMatrix {
+		t.Logf("Running test case %s", testname)
+		health := test.health
+		restart := applyHealthResult(check, &health, test.output, test.err,
+			test.bootTime, now)
+		assert.Equal(t, test.restart, restart, testname)
+		assert.Equal(t, test.state, health.State, testname)
+		assert.Equal(t, test.failingStreak, health.FailingStreak, testname)
+		assert.Equal(t, test.lastOutput, health.LastOutput, testname)
+		assert.Equal(t, now.Add(check.Interval), health.NextCheckTime, testname)
+	}
+}
+
+func TestEffectiveHealthCheck(t *testing.T) {
+	dir, err := ioutil.TempDir("", "health")
+	if err != nil {
+		t.Fatalf("failed to create tmpdir %v", err)
+	}
+	defer os.RemoveAll(dir)
+	imageConfig := `{"config": {"Cmd": ["/server"], "Healthcheck": {
+		"Test": ["CMD-SHELL", "curl -f http://localhost/ || exit 1"],
+		"Interval": 5000000000, "Retries": 5}}}`
+	if err := ioutil.WriteFile(filepath.Join(dir, "image-config.json"),
+		[]byte(imageConfig), 0644); err != nil {
+		t.Fatalf("failed to write image config %v", err)
+	}
+	type testEntry struct {
+		check        types.HealthCheck
+		ociConfigDir string
+		found        bool
+		expected     types.HealthCheck
+	}
+	testMatrix := map[string]testEntry{
+		"image": {
+			ociConfigDir: dir,
+			found:        true,
+			expected: types.HealthCheck{
+				Type:     types.HealthCheckExec,
+				Command:  []string{"/bin/sh", "-c", "curl -f http://localhost/ || exit 1"},
+				Interval: 5 * time.Second,
+				Timeout:  types.DefaultHealthCheckTimeout,
+				Retries:  5,
+			},
+		},
+		"image with overrides": {
+			check:        types.HealthCheck{Retries: 2, Restart: true},
+			ociConfigDir: dir,
+			found:        true,
+			expected: types.HealthCheck{
+				Type:     types.HealthCheckExec,
+				Command:  []string{"/bin/sh", "-c", "curl -f http://localhost/ || exit 1"},
+				Interval: 5 * time.Second,
+				Timeout:  types.DefaultHealthCheckTimeout,
+				Retries:  2,
+				Restart:  true,
+			},
+		},
+		"image without health check": {
+			ociConfigDir: filepath.Join(dir, "missing"),
+		},
+		"none": {
+			check:        types.HealthCheck{Type: types.HealthCheckNone},
+			ociConfigDir: dir,
+		},
+		"tcp": {
+			check: types.HealthCheck{Type: types.HealthCheckTCP, Port: 8080},
+			found: true,
+			expected: types.HealthCheck{
+				Type:     types.HealthCheckTCP,
+				Port:     8080,
+				Interval: types.DefaultHealthCheckInterval,
+				Timeout:  types.DefaultHealthCheckTimeout,
+				Retries:  types.DefaultHealthCheckRetries,
+			},
+		},
+	}
+	for testname, test := range testMatrix {
+		t.Logf("Running test case %s", testname)
+		config := types.DomainConfig{HealthCheck: test.check}
+		status := types.DomainStatus{OCIConfigDir: test.ociConfigDir}
+		check, found := effectiveHealthCheck(config, &status)
+		assert.Equal(t, test.found, found, testname)
+		if found {
+			assert.Equal(t, test.expected, check, testname)
+		}
+	}
+}
+
+func TestBalloonTarget(t *testing.T) {
+	now := time.Now()
+	balloon := func(target, actual, available uint32) types.BalloonMetric {
+		return types.BalloonMetric{
+			GuaranteedMB: 1024,
+			MaxMB:        4096,
+			ActualMB:     actual,
+			TargetMB:     target,
+			AvailableMB:  available,
+			LastUpdate:   now,
+		}
+	}
+	relaxed := types.HostMemory{TotalMemoryMB: 16384, FreeMemoryMB: 8192}
+	pressure := types.HostMemory{TotalMemoryMB: 16384, FreeMemoryMB: 1024}
+	type testEntry struct {
+		metric types.BalloonMetric
+		host   types.HostMemory
+		target uint32
+	}
+	testMatrix := map[string]testEntry{
+		"no statistics": {
+			metric: types.BalloonMetric{GuaranteedMB: 1024, MaxMB: 4096,
+				ActualMB: 4096, TargetMB: 4096},
+			host:   pressure,
+			target: 4096,
+		},
+		"idle guest without pressure": {
+			metric: balloon(4096, 4096, 3000),
+			host:   relaxed,
+			target: 4096,
+		},
+		"idle guest under pressure": {
+			// keeps 20% of 4096 available
+			metric: balloon(4096, 4096, 3000),
+			host:   pressure,
+			target: 4096 - (3000 - 819),
+		},
+		"idle guest down to guaranteed": {
+			metric: balloon(2048, 2048, 2000),
+			host:   pressure,
+			target: 1024,
+		},
+		"busy guest without pressure": {
+			// grows by 25%
+			metric: balloon(2048, 2048, 100),
+			host:   relaxed,
+			target: 2560,
+		},
+		"busy guest up to max": {
+			metric: balloon(4000, 4000, 100),
+			host:   relaxed,
+			target: 4096,
+		},
+		"busy guest under pressure": {
+			metric: balloon(2048, 2048, 100),
+			host:   pressure,
+			target: 2048,
+		},
+		"small change": {
+			metric: balloon(1030, 1030, 900),
+			host:   pressure,
+			target: 1030,
+		},
+	}
+	for testname, test := range testMatrix {
+		t.Logf("Running test case %s", testname)
+		assert.Equal(t, test.target, balloonTarget(test.metric, test.host), testname)
+	}
+}
+
+func TestPickIsolatedCPUs(t *testing.T) {
+	testMatrix := map[string]struct {
+		ncpus int
+		used  map[int]bool
+		count int
+		cpus  []int
+		free  int
+	}{
+		"from the last CPUs": {
+			ncpus: 8,
+			count: 2,
+			cpus:  []int{6, 7},
+			free:  7,
+		},
+		"around the used CPUs": {
+			ncpus: 8,
+			used:  map[int]bool{7: true, 5: true},
+			count: 3,
+			cpus:  []int{3, 4, 6},
+			free:  5,
+		},
+		"all but the first CPU": {
+			ncpus: 4,
+			count: 3,
+			cpus:  []int{1, 2, 3},
+			free:  3,
+		},
+		"not enough CPUs": {
+			ncpus: 4,
+			used:  map[int]bool{3: true},
+			count: 3,
+			free:  2,
+		},
+		"single CPU": {
+			ncpus: 1,
+			count: 1,
+			free:  0,
+		},
+	}
+	for testname, test := range testMatrix {
+		t.Logf("Running test case %s", testname)
+		cpus, free := pickIsolatedCPUs(test.ncpus, test.used, test.count)
+		assert.Equal(t, test.cpus, cpus, testname)
+		assert.Equal(t, test.free, free, testname)
+	}
+}
+
+// efiTestVarStore returns an empty variable store in a firmware volume
+func efiTestVarStore(size int) []byte {
+	vars := bytes.Repeat([]byte{0xff}, size)
+	fvHeaderLength := 72
+	copy(vars[efiFvSignatureOffset:], "_FVH")
+	binary.LittleEndian.PutUint16(vars[efiFvHeaderLengthOffset:], uint16(fvHeaderLength))
+	copy(vars[fvHeaderLength:], efiAuthVarStoreGUID)
+	binary.LittleEndian.PutUint32(vars[fvHeaderLength+16:], uint32(size-fvHeaderLength))
+	return vars
+}
+
+// getEfiVariable returns the data of the live values of a variable
+func getEfiVariable(vars []byte, name string, guid []byte) [][]byte {
+	var values [][]byte
+	offset, end, _ := efiVarStore(vars)
+	for offset+efiVarHeaderSize <= end &&
+		binary.LittleEndian.Uint16(vars[offset:]) == efiVarStartID {
+		nameSize := int(binary.LittleEndian.Uint32(vars[offset+36:]))
+		dataSize := int(binary.LittleEndian.Uint32(vars[offset+40:]))
+		nameStart := offset + efiVarHeaderSize
+		if vars[offset+2] == efiVarAdded &&
+			bytes.Equal(vars[offset+44:offset+60], guid) &&
+			bytes.Equal(vars[nameStart:nameStart+nameSize], efiName(name)) {
+			values = append(values, vars[nameStart+nameSize:nameStart+nameSize+dataSize])
+		}
+		offset = efiAlign(nameStart + nameSize + dataSize)
+	}
+	return values
+}
+
+func TestEnrollSecureBootKeys(t *testing.T) {
+	now := time.Date(2022, 3, 4, 5, 6, 7, 0, time.UTC)
+	keys := types.EncryptionBlock{
+		UefiPK:  []byte("platform key"),
+		UefiKEK: []byte("key exchange key"),
+		UefiDb:  []byte("allowed"),
+	}
+	testMatrix := map[string]struct {
+		size      int
+		keys      types.EncryptionBlock
+		enrolls   int
+		expectErr bool
+	}{
+		"enroll": {
+			size:    4096,
+			keys:    keys,
+			enrolls: 1,
+		},
+		"enroll again": {
+			size:    4096,
+			keys:    keys,
+			enrolls: 2,
+		},
+		"no platform key": {
+			size:      4096,
+			keys:      types.EncryptionBlock{UefiDb: []byte("allowed")},
+			enrolls:   1,
+			expectErr: true,
+		},
+		"no space": {
+			size:      256,
+			keys:      keys,
+			enrolls:   1,
+			expectErr: true,
+		},
+	}
+	for testname, test := range testMatrix {
+		t.Logf("Running test case %s", testname)
+		vars := efiTestVarStore(test.size)
+		var err error
+		for i := 0; i < test.enrolls; i++ {
+			err = enrollSecureBootKeys(vars, test.keys, now)
+		}
+		if test.expectErr {
+			assert.Error(t, err, testname)
+			continue
+		}
+		assert.NoError(t, err, testname)
+		assert.Equal(t, [][]byte{keys.UefiPK},
+			getEfiVariable(vars, "PK", efiGlobalVariableGUID), testname)
+		assert.Equal(t, [][]byte{keys.UefiKEK},
+			getEfiVariable(vars, "KEK", efiGlobalVariableGUID), testname)
+		assert.Equal(t, [][]byte{keys.UefiDb},
+			getEfiVariable(vars, "db", efiImageSecurityDbGUID), testname)
+		assert.Empty(t, getEfiVariable(vars, "dbx", efiImageSecurityDbGUID), testname)
+		assert.Equal(t, [][]byte{{1}},
+			getEfiVariable(vars, "SecureBootEnable", efiSecureBootEnableGUID), testname)
+	}
+}
+
+func TestEfiGUID(t *testing.T) {
+	assert.Equal(t, []byte{0x61, 0xdf, 0xe4, 0x8b, 0xca, 0x93, 0xd2, 0x11,
+		0xaa, 0x0d, 0x00, 0xe0, 0x98, 0x03, 0x2b, 0x8c},
+		efiGUID("8be4df61-93ca-11d2-aa0d-00e098032b8c"))
+}
+
+func TestUpdateGuestInfo(t *testing.T) {
+	then := time.Now().Add(-time.Hour)
+	now := time.Now()
+	reported := types.GuestInfo{
+		OSName:   "Ubuntu 20.04.4 LTS",
+		Hostname: "app1",
+	}
+	renamed := reported
+	renamed.Hostname = "app2"
+	type testEntry struct {
+		current    types.GuestInfo
+		info       types.GuestInfo
+		changed    bool
+		updateTime time.Time
+	}
+	testMatrix := map[string]testEntry{
+		"first report": {
+			info:       reported,
+			changed:    true,
+			updateTime: now,
+		},
+		"first empty report": {
+			info:       types.GuestInfo{},
+			changed:    true,
+			updateTime: now,
+		},
+		"same report": {
+			current:    types.GuestInfo{OSName: reported.OSName, Hostname: reported.Hostname, UpdateTime: then},
+			info:       reported,
+			changed:    false,
+			updateTime: then,
+		},
+		"changed report": {
+			current:    types.GuestInfo{OSName: reported.OSName, Hostname: reported.Hostname, UpdateTime: then},
+			info:       renamed,
+			changed:    true,
+			updateTime: now,
+		},
+	}
+	for testname, test := range testMatrix {
+		t.Logf("Running test case %s", testname)
+		current := test.current
+		changed := updateGuestInfo(&current, test.info, now)
+		assert.Equal(t, test.changed, changed, testname)
+		assert.Equal(t, test.updateTime, current.UpdateTime, testname)
+		assert.Equal(t, test.info.Hostname, current.Hostname, testname)
+	}
+}
+
+func TestFindConsoleSession(t *testing.T) {
+	now := time.Now()
+	tokenHash := sha256.Sum256([]byte("secret"))
+	session := types.ConsoleSession{
+		ID:          uuid.Must(uuid.NewV4()),
+		Type:        types.ConsoleTypeVNC,
+		TokenSha256: tokenHash[:],
+		NotAfter:    now.Add(time.Hour),
+	}
+	expired := session
+	expired.ID = uuid.Must(uuid.NewV4())
+	expired.NotAfter = now.Add(-time.Minute)
+	configs := []types.DomainConfig{
+		{DisplayName: "app1"},
+		{DisplayName: "app2", ConsoleSessions: []types.ConsoleSession{session, expired}},
+	}
+	type testEntry struct {
+		line    string
+		session uuid.UUID
+		fail    bool
+	}
+	testMatrix := map[string]testEntry{
+		"valid session": {
+			line:    fmt.Sprintf("%s secret\n", session.ID),
+			session: session.ID,
+		},
+		"wrong token": {
+			line: fmt.Sprintf("%s guess\n", session.ID),
+			fail: true,
+		},
+		"expired session": {
+			line: fmt.Sprintf("%s secret\n", expired.ID),
+			fail: true,
+		},
+		"unknown session": {
+			line: fmt.Sprintf("%s secret\n", uuid.Must(uuid.NewV4())),
+			fail: true,
+		},
+		"invalid session id": {
+			line: "app2 secret\n",
+			fail: true,
+		},
+		"malformed handshake": {
+			line: fmt.Sprintf("%s\n", session.ID),
+			fail: true,
+		},
+	}
+	for testname, test := range testMatrix {
+		t.Logf("Running test case %s", testname)
+		config, found, err := findConsoleSession(configs, test.line, now)
+		if test.fail {
+			assert.Error(t, err, testname)
+			continue
+		}
+		assert.NoError(t, err, testname)
+		assert.Equal(t, "app2", config.DisplayName, testname)
+		assert.Equal(t, test.session, found.ID, testname)
+	}
+}
+
+func TestConsoleSessionEnd(t *testing.T) {
+	start := time.Now()
+	notAfter := start.Add(time.Hour)
+	type testEntry struct {
+		maxDuration time.Duration
+		end         time.Time
+	}
+	testMatrix := map[string]testEntry{
+		"no max duration": {
+			end: notAfter,
+		},
+		"short max duration": {
+			maxDuration: 10 * time.Minute,
+			end:         start.Add(10 * time.Minute),
+		},
+		"long max duration": {
+			maxDuration: 2 * time.Hour,
+			end:         notAfter,
+		},
+	}
+	for testname, test := range testMatrix {
+		t.Logf("Running test case %s", testname)
+		session := types.ConsoleSession{NotAfter: notAfter, MaxDuration: test.maxDuration}
+		assert.Equal(t, test.end, consoleSessionEnd(session, start), testname)
+	}
+}
+
+func TestConsoleRecording(t *testing.T) {
+	log = base.NewSourceLogObject(logrus.StandardLogger(), "domainmgr", 0)
+	dir, err := ioutil.TempDir("", "console")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	appUUID := uuid.Must(uuid.NewV4())
+	for _, consoleType := range []types.ConsoleType{types.ConsoleTypeVNC, types.ConsoleTypeSerial} {
+		t.Logf("Running test case %s", consoleType)
+		session := types.ConsoleSession{ID: uuid.Must(uuid.NewV4()), Type: consoleType, Record: true}
+		start := time.Now()
+		recording, err := newConsoleRecording(dir, appUUID, session, start)
+		if err != nil {
+			t.Fatal(err)
+		}
+		recording.Write([]byte("hello"))
+		// Not available for upload until complete
+		_, err = os.Stat(filepath.Join(dir, types.ConsoleRecordingName(appUUID, session.ID, start)))
+		assert.True(t, os.IsNotExist(err), consoleType.String())
+		if err := recording.Close(); err != nil {
+			t.Fatal(err)
+		}
+		file, err := os.Open(filepath.Join(dir, types.ConsoleRecordingName(appUUID, session.ID, start)))
+		if err != nil {
+			t.Fatal(err)
+		}
+		gz, err := gzip.NewReader(file)
+		if err != nil {
+			t.Fatal(err)
+		}
+		content, err := ioutil.ReadAll(gz)
+		file.Close()
+		if err != nil {
+			t.Fatal(err)
+		}
+		if consoleType == types.ConsoleTypeVNC {
+			assert.Equal(t, "vnc.fbs", gz.Name)
+			header := "FBS 001.000\n"
+			if assert.Len(t, content, len(header)+4+8+4) {
+				assert.Equal(t, header, string(content[:len(header)]))
+				chunk := content[len(header):]
+				assert.Equal(t, uint32(5), binary.BigEndian.Uint32(chunk))
+				assert.Equal(t, "hello\x00\x00\x00", string(chunk[4:12]))
+			}
+		} else {
+			assert.Equal(t, "serial.cast", gz.Name)
+			lines := strings.Split(strings.TrimSpace(string(content)), "\n")
+			if assert.Len(t, lines, 2) {
+				var header struct {
+					Version int `json:"version"`
+				}
+				assert.NoError(t, json.Unmarshal([]byte(lines[0]), &header))
+				assert.Equal(t, 2, header.Version)
+				var event []interface{}
+				assert.NoError(t, json.Unmarshal([]byte(lines[1]), &event))
+				if assert.Len(t, event, 3) {
+					assert.Equal(t, "o", event[1])
+					assert.Equal(t, "hello", event[2])
+				}
+			}
+		}
+	}
+}
@@ -0,0 +1,105 @@
+// Copyright (c) 2022 Zededa, Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package domainmgr
+
+// Code for the guest agent in the guest of the domains. The handler of a
+// domain asks it what it knows about the guest, and has it resync the
+// clock of the guest once the host resumed from a suspend. DomainShutdown
+// asks it to power the guest off before the hypervisor does.
+
+import (
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/lf-edge/eve/pkg/pillar/hypervisor"
+	"github.com/lf-edge/eve/pkg/pillar/types"
+	"golang.org/x/sys/unix"
+)
+
+// The handler of a domain looks for a resume of the host that often
+const guestPollInterval = 10 * time.Second
+
+// What the guest agent reports is updated that often, and retried that
+// often while it does not answer since a guest without an agent makes us
+// wait for the timeout
+const (
+	guestInfoInterval  = 5 * time.Minute
+	guestRetryInterval = time.Minute
+)
+
+// guestAgentPoll is what the handler of a domain keeps between the polls
+// of the guest agent
+type guestAgentPoll struct {
+	suspended time.Duration
+	nextInfo  time.Time
+}
+
+func newGuestAgentPoll() guestAgentPoll {
+	return guestAgentPoll{suspended: hostSuspendedTime()}
+}
+
+// hostSuspendedTime returns how long the host was suspended since it
+// booted, which CLOCK_BOOTTIME counts while CLOCK_MONOTONIC does not
+func hostSuspendedTime() time.Duration {
+	var boot, mono unix.Timespec
+	if unix.ClockGettime(unix.CLOCK_BOOTTIME, &boot) != nil ||
+		unix.ClockGettime(unix.CLOCK_MONOTONIC, &mono) != nil {
+		return 0
+	}
+	return time.Duration(boot.Nano() - mono.Nano())
+}
+
+// maybePollGuest syncs the clock of the guest of a running domain once the
+// host resumed, and updates what the guest agent reports once it is due
+func maybePollGuest(ctx *domainContext, status *types.DomainStatus,
+	poll *guestAgentPoll) {
+
+	suspended := hostSuspendedTime()
+	resumed := suspended-poll.suspended > time.Second
+	poll.suspended = suspended
+	if !status.Activated || status.State != types.RUNNING {
+		return
+	}
+	agent, ok := hyper.Task(status).(hypervisor.GuestAgent)
+	if !ok {
+		return
+	}
+	if resumed {
+		log.Noticef("maybePollGuest(%s) syncing the clock of the guest since the host resumed",
+			status.Key())
+		if err := agent.SyncGuestTime(status.DomainName); err != nil {
+			log.Warnf("maybePollGuest(%s) failed to sync the clock: %v",
+				status.Key(), err)
+		}
+	}
+	now := time.Now()
+	if now.Before(poll.nextInfo) {
+		return
+	}
+	info, err := agent.GetGuestInfo(status.DomainName)
+	if err != nil {
+		log.Functionf("maybePollGuest(%s): %v", status.Key(), err)
+		poll.nextInfo = now.Add(guestRetryInterval)
+		return
+	}
+	poll.nextInfo = now.Add(guestInfoInterval)
+	if updateGuestInfo(&status.GuestInfo, info, now) {
+		publishDomainStatus(ctx, status)
+	}
+}
+
+// updateGuestInfo replaces the guest info of a domain if what the agent
+// reports changed, in which case it returns true. UpdateTime is when it
+// changed.
+func updateGuestInfo(current *types.GuestInfo, info types.GuestInfo,
+	now time.Time) bool {
+
+	info.UpdateTime = current.UpdateTime
+	if !current.UpdateTime.IsZero() && cmp.Equal(*current, info) {
+		return false
+	}
+	info.UpdateTime = now
+	*current = info
+	return true
+}
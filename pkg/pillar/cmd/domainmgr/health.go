@@ -0,0 +1,198 @@
+// Copyright (c) 2022 Zededa, Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package domainmgr
+
+// Code for the health checks of the main container of the domains. The
+// HEALTHCHECK of the image, or the one of the config, is run every
+// interval by the handler of the domain. Failures within the start period
+// do not count, and retries consecutive failures make the domain
+// unhealthy, which restarts it if the health check asks for it.
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/lf-edge/eve/pkg/pillar/containerd"
+	"github.com/lf-edge/eve/pkg/pillar/hypervisor"
+	"github.com/lf-edge/eve/pkg/pillar/types"
+)
+
+// The handler of a domain looks for a due health check that often
+const healthPollInterval = 5 * time.Second
+
+// Like Docker, only the end of the output of a check is kept
+const healthMaxOutput = 4096
+
+// effectiveHealthCheck returns the health check of a domain, which is the
+// one of its image unless the config has one, with the defaults for the
+// unset timings. Returns false if the domain has none.
+func effectiveHealthCheck(config types.DomainConfig,
+	status *types.DomainStatus) (types.HealthCheck, bool) {
+
+	check := config.HealthCheck
+	switch check.Type {
+	case types.HealthCheckNone:
+		return check, false
+	case types.HealthCheckImage:
+		if status.OCIConfigDir == "" {
+			return check, false
+		}
+		image, ok := containerd.GetImageHealthCheck(status.OCIConfigDir)
+		if !ok {
+			return check, false
+		}
+		// The timings of the config override those of the image
+		if check.Interval == 0 {
+			check.Interval = image.Interval
+		}
+		if check.Timeout == 0 {
+			check.Timeout = image.Timeout
+		}
+		if check.StartPeriod == 0 {
+			check.StartPeriod = image.StartPeriod
+		}
+		if check.Retries == 0 {
+			check.Retries = image.Retries
+		}
+		check.Type = image.Type
+		check.Command = image.Command
+	}
+	if check.Interval == 0 {
+		check.Interval = types.DefaultHealthCheckInterval
+	}
+	if check.Timeout == 0 {
+		check.Timeout = types.DefaultHealthCheckTimeout
+	}
+	if check.Retries == 0 {
+		check.Retries = types.DefaultHealthCheckRetries
+	}
+	return check, true
+}
+
+// startHealthCheck resets the health of a domain which just booted and
+// schedules its first check
+func startHealthCheck(config types.DomainConfig, status *types.DomainStatus,
+	now time.Time) {
+
+	resetHealth(status)
+	if _, ok := hyper.Task(status).(hypervisor.HealthChecker); !ok {
+		return
+	}
+	check, ok := effectiveHealthCheck(config, status)
+	if !ok {
+		return
+	}
+	log.Functionf("startHealthCheck(%s) %s check every %v",
+		status.Key(), check.Type, check.Interval)
+	status.Health.State = types.HealthStarting
+	status.Health.NextCheckTime = now.Add(check.Interval)
+}
+
+// resetHealth forgets the health of a domain which is not running, but
+// not its restarts
+func resetHealth(status *types.DomainStatus) {
+	status.Health = types.HealthStatus{RestartCount: status.Health.RestartCount}
+}
+
+// maybeCheckHealth runs the health check of a running domain once it is
+// due, and restarts the domain if it became unhealthy
+func maybeCheckHealth(ctx *domainContext, status *types.DomainStatus) {
+	if !status.Activated || status.Health.State == types.HealthUnknown ||
+		time.Now().Before(status.Health.NextCheckTime) {
+		return
+	}
+	config := lookupDomainConfig(ctx, status.Key())
+	if config == nil || !config.Activate {
+		return
+	}
+	checker, ok := hyper.Task(status).(hypervisor.HealthChecker)
+	if !ok {
+		return
+	}
+	check, ok := effectiveHealthCheck(*config, status)
+	if !ok {
+		resetHealth(status)
+		publishDomainStatus(ctx, status)
+		return
+	}
+	output, err := checker.CheckHealth(status.DomainName, check)
+	previous := status.Health.State
+	restart := applyHealthResult(check, &status.Health, output, err,
+		status.BootTime, time.Now())
+	if status.Health.State != previous {
+		log.Noticef("maybeCheckHealth(%s) %s: %s", status.Key(),
+			status.Health.State, status.Health.LastOutput)
+	}
+	if restart {
+		restartUnhealthy(ctx, status)
+	}
+	publishDomainStatus(ctx, status)
+}
+
+// applyHealthResult updates the health of a domain with the result of a
+// check. Returns true if the domain became unhealthy and is to be
+// restarted.
+func applyHealthResult(check types.HealthCheck, health *types.HealthStatus,
+	output string, err error, bootTime time.Time, now time.Time) bool {
+
+	output = strings.TrimSpace(output)
+	if err != nil {
+		if output == "" {
+			output = err.Error()
+		} else {
+			output = fmt.Sprintf("%s: %s", err, output)
+		}
+	}
+	if len(output) > healthMaxOutput {
+		output = output[len(output)-healthMaxOutput:]
+	}
+	health.LastOutput = output
+	health.LastCheckTime = now
+	health.NextCheckTime = now.Add(check.Interval)
+	if err == nil {
+		health.FailingStreak = 0
+		health.State = types.HealthHealthy
+		return false
+	}
+	if health.State == types.HealthStarting &&
+		now.Sub(bootTime) < check.StartPeriod {
+		return false
+	}
+	health.FailingStreak++
+	if health.FailingStreak < check.Retries ||
+		health.State == types.HealthUnhealthy {
+		return false
+	}
+	health.State = types.HealthUnhealthy
+	return check.Restart
+}
+
+// restartUnhealthy deletes the task of an unhealthy domain and has it
+// booted again by maybeRetryBoot
+func restartUnhealthy(ctx *domainContext, status *types.DomainStatus) {
+	reason := fmt.Sprintf("unhealthy after %d failed health checks: %s",
+		status.Health.FailingStreak, status.Health.LastOutput)
+	log.Warnf("restartUnhealthy(%s) %s", status.Key(), reason)
+	if err := hyper.Task(status).Delete(status.DomainName); err != nil {
+		log.Errorf("failed to delete domain: %s (%v)", status.DomainName, err)
+	}
+	if err := hyper.Task(status).Cleanup(status.DomainName); err != nil {
+		log.Errorf("failed to cleanup domain: %s (%v)", status.DomainName, err)
+	}
+	now := time.Now()
+	status.Activated = false
+	status.State = types.HALTED
+	status.DomainId = 0
+	status.LastExitReason = reason
+	status.LastExitTime = now
+	status.Health.RestartCount++
+	status.BootFailed = true
+	status.NextRestartTime = now
+	status.SetErrorDescription(types.ErrorDescription{
+		Error:               reason,
+		ErrorSeverity:       types.ErrorSeverityWarning,
+		ErrorRetryCondition: "will restart now",
+	})
+}
@@ -114,6 +114,18 @@ func getAndPublishMetrics(ctx *domainContext, hyper hypervisor.Hypervisor) {
 			}
 			// XXX remove - this does not include qemu overhead
 			// dm.AllocatedMB = uint32((status.Memory + 1023) / 1024)
+			if statter, ok := hyper.Task(status).(hypervisor.DiskIOStatter); ok &&
+				status.State == types.RUNNING {
+				diskIO, err := statter.GetDiskIOStats(domainName, status.DiskStatusList)
+				if err != nil {
+					log.Warnf("GetDiskIOStats(%s): %v", domainName, err)
+				}
+				dm.DiskIO = diskIO
+			}
+			if ballooner, ok := hyper.Task(status).(hypervisor.MemoryBallooner); ok &&
+				status.Ballooned() && status.State == types.RUNNING {
+				dm.Balloon = balloonMemory(ctx, ballooner, status, hm)
+			}
 		} else if dm.UUIDandVersion.UUID == nilUUID && hm.Ncpus != 0 {
 			// Scale Xen Dom0 based CPUs seen by hypervisor
 			dm.CPUTotalNs /= uint64(hm.Ncpus)
@@ -0,0 +1,102 @@
+// Copyright (c) 2022 Zededa, Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package domainmgr
+
+// Code for the init and sidecar containers of the pod of a domain. The
+// init containers run to completion before the main container hence they
+// are only reported, while the sidecars are restarted by their restart
+// mode with the backoff of the restart policy of the domain.
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/lf-edge/eve/pkg/pillar/hypervisor"
+	"github.com/lf-edge/eve/pkg/pillar/types"
+)
+
+// verifyPodContainers updates the state of the containers of the pod of a
+// running domain and restarts the sidecars which are due
+func verifyPodContainers(ctx *domainContext, podManager hypervisor.PodManager,
+	config types.DomainConfig, status *types.DomainStatus) {
+
+	infos, err := podManager.GetPodContainerInfo(status.DomainName)
+	if err != nil {
+		log.Warnf("verifyPodContainers(%s): %v", status.Key(), err)
+		return
+	}
+	previous := append([]types.PodContainerStatus{}, status.PodContainerStatusList...)
+	for _, name := range updatePodContainerStatus(config, status, infos, time.Now()) {
+		log.Noticef("verifyPodContainers(%s) restarting sidecar %s",
+			status.Key(), name)
+		if err := podManager.RestartPodContainer(status.DomainName, name); err != nil {
+			log.Errorf("verifyPodContainers(%s) restart of %s failed: %v",
+				status.Key(), name, err)
+		}
+	}
+	if !cmp.Equal(previous, status.PodContainerStatusList) {
+		publishDomainStatus(ctx, status)
+	}
+}
+
+// updatePodContainerStatus updates the status of the containers of the pod
+// from the hypervisor and returns the sidecars to restart now. A sidecar
+// which failed to restart is seen to exit again since it was BOOTING.
+func updatePodContainerStatus(config types.DomainConfig, status *types.DomainStatus,
+	infos []types.PodContainerInfo, now time.Time) []string {
+
+	previous := make(map[string]types.PodContainerStatus)
+	for _, pcs := range status.PodContainerStatusList {
+		previous[pcs.Name] = pcs
+	}
+	modes := make(map[string]types.RestartMode)
+	for _, pc := range config.PodContainers {
+		modes[pc.Name] = pc.RestartMode
+	}
+	var restart []string
+	var list []types.PodContainerStatus
+	for _, info := range infos {
+		pcs, found := previous[info.Name]
+		if !found {
+			pcs = types.PodContainerStatus{Name: info.Name}
+		}
+		exited := info.State == types.HALTED || info.State == types.BROKEN
+		wasExited := found &&
+			(pcs.State == types.HALTED || pcs.State == types.BROKEN)
+		if exited && !wasExited {
+			pcs.ExitCode = info.ExitCode
+			pcs.LastExitReason = fmt.Sprintf("exited with status %d", info.ExitCode)
+			pcs.LastExitTime = now
+			mode := modes[info.Name]
+			if !info.Init && (mode == types.RestartModeDefault ||
+				mode == types.RestartModeAlways ||
+				(mode == types.RestartModeOnFailure && info.ExitCode != 0)) {
+				pcs.NextRestartTime = now.Add(restartBackoff(config.RestartPolicy,
+					int(pcs.RestartCount)))
+			}
+		}
+		pcs.State = info.State
+		if !pcs.NextRestartTime.IsZero() && !now.Before(pcs.NextRestartTime) {
+			pcs.NextRestartTime = time.Time{}
+			pcs.RestartCount++
+			pcs.State = types.BOOTING
+			restart = append(restart, info.Name)
+		}
+		list = append(list, pcs)
+	}
+	status.PodContainerStatusList = list
+	return restart
+}
+
+// unmountPodImages unmounts the images of the containers of the pod once
+// the domain is inactive
+func unmountPodImages(ctx *domainContext, status *types.DomainStatus) {
+	for _, location := range status.PodImageList {
+		if err := ctx.casClient.UnmountContainerRootDir(location); err != nil {
+			log.Errorf("unmountPodImages: %s", err)
+		}
+	}
+	status.PodImageList = nil
+}
@@ -0,0 +1,285 @@
+// Copyright (c) 2022 Zededa, Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package domainmgr
+
+// Code for realtime domains. Each of their VCpus gets a CPU of its own,
+// which is isolated from EVE, from the other domains and from the
+// interrupts by means of the cpuset cgroups and the IRQ affinity. The
+// hypervisor pins the vcpus to those CPUs and schedules them as SCHED_FIFO.
+
+import (
+	"fmt"
+	"io/ioutil"
+	"path/filepath"
+	"strings"
+
+	"github.com/lf-edge/eve/pkg/pillar/types"
+	"github.com/lf-edge/eve/pkg/pillar/utils"
+)
+
+// The first CPUs are never isolated since EVE needs them
+const realtimeHostCPUs = 1
+
+// reserveIsolatedCPUs reserves the CPUs of a realtime domain and moves
+// everything else off them
+func reserveIsolatedCPUs(ctx *domainContext, config types.DomainConfig,
+	status *types.DomainStatus) *types.ErrorDescription {
+
+	if !config.Realtime || len(status.IsolatedCPUs) != 0 {
+		return nil
+	}
+	hm, err := hyper.GetHostCPUMem()
+	if err != nil {
+		return &types.ErrorDescription{
+			Error: fmt.Sprintf("cannot count the CPUs for realtime: %v", err),
+		}
+	}
+	used, others := isolatedCPUsInUse(ctx, status.Key())
+	cpus, free := pickIsolatedCPUs(int(hm.Ncpus), used, config.VCpus)
+	if cpus == nil {
+		description := types.ErrorDescription{
+			Error: fmt.Sprintf("realtime needs %d isolated CPUs but only %d of %d CPUs are free",
+				config.VCpus, free, hm.Ncpus),
+			ErrorSeverity: types.ErrorSeverityError,
+		}
+		if len(others) != 0 {
+			// Those might halt
+			description.ErrorSeverity = types.ErrorSeverityWarning
+			description.ErrorRetryCondition = "Will wait for CPUs to release from realtime apps"
+			for _, other := range others {
+				description.ErrorEntities = append(description.ErrorEntities,
+					&types.ErrorEntity{EntityID: other,
+						EntityType: types.ErrorEntityAppInstance})
+			}
+		}
+		return &description
+	}
+	log.Noticef("reserveIsolatedCPUs(%s) CPUs %s", status.Key(),
+		utils.FormatCPUList(cpus))
+	status.IsolatedCPUs = cpus
+	isolateCPUs(ctx, status, int(hm.Ncpus))
+	return nil
+}
+
+// releaseIsolatedCPUs gives the CPUs of a realtime domain back to the rest
+func releaseIsolatedCPUs(ctx *domainContext, status *types.DomainStatus) {
+	if len(status.IsolatedCPUs) == 0 {
+		return
+	}
+	log.Noticef("releaseIsolatedCPUs(%s) CPUs %s", status.Key(),
+		utils.FormatCPUList(status.IsolatedCPUs))
+	status.IsolatedCPUs = nil
+	hm, err := hyper.GetHostCPUMem()
+	if err != nil {
+		log.Errorf("releaseIsolatedCPUs(%s): %v", status.Key(), err)
+		return
+	}
+	isolateCPUs(ctx, status, int(hm.Ncpus))
+}
+
+// isolateFromRealtime moves a domain which started off the CPUs of the
+// realtime domains
+func isolateFromRealtime(ctx *domainContext, status *types.DomainStatus) {
+	if len(status.IsolatedCPUs) != 0 {
+		return
+	}
+	if used, _ := isolatedCPUsInUse(ctx, status.Key()); len(used) == 0 {
+		return
+	}
+	hm, err := hyper.GetHostCPUMem()
+	if err != nil {
+		log.Errorf("isolateFromRealtime(%s): %v", status.Key(), err)
+		return
+	}
+	isolateCPUs(ctx, status, int(hm.Ncpus))
+}
+
+// isolatedConfig returns the config with the CPUs of a realtime domain
+func isolatedConfig(config types.DomainConfig,
+	status *types.DomainStatus) types.DomainConfig {
+
+	if len(status.IsolatedCPUs) != 0 {
+		config.CPUs = utils.FormatCPUList(status.IsolatedCPUs)
+	}
+	return config
+}
+
+// isolatedCPUsInUse returns the CPUs of the realtime domains other than
+// key, and the UUIDs of those domains
+func isolatedCPUsInUse(ctx *domainContext, key string) (map[int]bool, []string) {
+	used := make(map[int]bool)
+	var others []string
+	for _, item := range ctx.pubDomainStatus.GetAll() {
+		status := item.(types.DomainStatus)
+		if status.Key() == key || len(status.IsolatedCPUs) == 0 {
+			continue
+		}
+		for _, cpu := range status.IsolatedCPUs {
+			used[cpu] = true
+		}
+		others = append(others, status.UUIDandVersion.UUID.String())
+	}
+	return used, others
+}
+
+// pickIsolatedCPUs returns count CPUs which are not used, starting from
+// the last one since EVE runs on the first ones, and the number of free
+// CPUs. Returns nil if there are not enough.
+func pickIsolatedCPUs(ncpus int, used map[int]bool, count int) ([]int, int) {
+	var free []int
+	for cpu := ncpus - 1; cpu >= realtimeHostCPUs; cpu-- {
+		if !used[cpu] {
+			free = append(free, cpu)
+		}
+	}
+	if count <= 0 || count > len(free) {
+		return nil, len(free)
+	}
+	cpus := free[:count]
+	// Sorted for the vcpus
+	for i, j := 0, len(cpus)-1; i < j; i, j = i+1, j-1 {
+		cpus[i], cpus[j] = cpus[j], cpus[i]
+	}
+	return cpus, len(free)
+}
+
+// isolateCPUs moves EVE, the domains which are not realtime and the
+// interrupts off the CPUs of the realtime domains, with status being
+// the domain which changed
+func isolateCPUs(ctx *domainContext, status *types.DomainStatus, ncpus int) {
+	isolated, _ := isolatedCPUsInUse(ctx, status.Key())
+	realtime := make(map[string]bool)
+	for _, item := range ctx.pubDomainStatus.GetAll() {
+		other := item.(types.DomainStatus)
+		if other.Key() != status.Key() && len(other.IsolatedCPUs) != 0 {
+			realtime[other.DomainName] = true
+		}
+	}
+	for _, cpu := range status.IsolatedCPUs {
+		isolated[cpu] = true
+	}
+	if len(status.IsolatedCPUs) != 0 {
+		realtime[status.DomainName] = true
+	}
+	if ctx.cpusets == nil {
+		ctx.cpusets = make(map[string]string)
+	}
+	restrictCPUSet(ctx, types.EveCPUSetDir, isolated)
+	if entries, err := ioutil.ReadDir(types.UserAppsCPUSetDir); err == nil {
+		for _, entry := range entries {
+			// The realtime domains have their cpuset from their spec
+			if entry.IsDir() && !realtime[entry.Name()] {
+				restrictCPUSet(ctx, filepath.Join(types.UserAppsCPUSetDir,
+					entry.Name()), isolated)
+			}
+		}
+	}
+	if len(isolated) == 0 {
+		// All restored
+		ctx.cpusets = nil
+	}
+
+	var housekeeping []int
+	for cpu := 0; cpu < ncpus; cpu++ {
+		if !isolated[cpu] {
+			housekeeping = append(housekeeping, cpu)
+		}
+	}
+	setIRQAffinity(utils.FormatCPUList(housekeeping))
+}
+
+// restrictCPUSet removes the isolated CPUs from the cpuset cgroup in dir
+// and its children, or restores them. The cpus of a child must be within
+// those of its parent hence the parent grows first and shrinks last.
+func restrictCPUSet(ctx *domainContext, dir string, isolated map[int]bool) {
+	filename := filepath.Join(dir, "cpuset.cpus")
+	b, err := ioutil.ReadFile(filename)
+	if err != nil {
+		log.Warnf("restrictCPUSet: %v", err)
+		return
+	}
+	current := strings.TrimSpace(string(b))
+	original, ok := ctx.cpusets[dir]
+	if !ok {
+		original = current
+		ctx.cpusets[dir] = original
+	}
+	originalCPUs, err := utils.ParseCPUList(original)
+	if err != nil {
+		log.Errorf("restrictCPUSet(%s): %v", dir, err)
+		return
+	}
+	var cpus []int
+	for _, cpu := range originalCPUs {
+		if !isolated[cpu] {
+			cpus = append(cpus, cpu)
+		}
+	}
+	if len(cpus) == 0 {
+		// Left with the CPUs which are never isolated
+		for cpu := 0; cpu < realtimeHostCPUs; cpu++ {
+			cpus = append(cpus, cpu)
+		}
+	}
+	wanted := make(map[int]bool)
+	for _, cpu := range cpus {
+		wanted[cpu] = true
+	}
+	currentCPUs, _ := utils.ParseCPUList(current)
+	shrink := false
+	for _, cpu := range currentCPUs {
+		if !wanted[cpu] {
+			shrink = true
+			break
+		}
+	}
+	write := func() {
+		list := utils.FormatCPUList(cpus)
+		if list == current {
+			return
+		}
+		if err := ioutil.WriteFile(filename, []byte(list), 0644); err != nil {
+			log.Errorf("restrictCPUSet(%s) to %s: %v", dir, list, err)
+		} else {
+			log.Functionf("restrictCPUSet(%s) from %s to %s", dir, current, list)
+		}
+	}
+	if !shrink {
+		write()
+	}
+	if entries, err := ioutil.ReadDir(dir); err == nil {
+		for _, entry := range entries {
+			if entry.IsDir() {
+				restrictCPUSet(ctx, filepath.Join(dir, entry.Name()), isolated)
+			}
+		}
+	}
+	if shrink {
+		write()
+	}
+}
+
+// setIRQAffinity sets the CPUs of all interrupts; some, like the per-CPU
+// ones, cannot be moved
+func setIRQAffinity(list string) {
+	entries, err := ioutil.ReadDir(types.IRQDir)
+	if err != nil {
+		log.Errorf("setIRQAffinity: %v", err)
+		return
+	}
+	moved := 0
+	for _, entry := range entries {
+		if !entry.IsDir() {
+			continue
+		}
+		filename := filepath.Join(types.IRQDir, entry.Name(), "smp_affinity_list")
+		if err := ioutil.WriteFile(filename, []byte(list), 0644); err != nil {
+			log.Functionf("setIRQAffinity(%s) to %s: %v",
+				entry.Name(), list, err)
+			continue
+		}
+		moved++
+	}
+	log.Noticef("setIRQAffinity moved %d interrupts to CPUs %s", moved, list)
+}
@@ -0,0 +1,112 @@
+// Copyright (c) 2022 Zededa, Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package domainmgr
+
+// Code for the restart policy of domains which halt or crash by
+// themselves. With the default policy they are retried after
+// DomainBootRetryTime as before.
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/lf-edge/eve/pkg/pillar/types"
+)
+
+// applyRestartPolicy decides on the restart of a domain which exited with
+// reason, or failed to boot, and schedules it. Returns false if the domain
+// has the default policy, which is left to the caller.
+func applyRestartPolicy(policy types.RestartPolicy, status *types.DomainStatus,
+	reason string, failed bool, now time.Time) bool {
+
+	if policy.Mode == types.RestartModeDefault {
+		return false
+	}
+	status.BootFailed = false
+	status.NextRestartTime = time.Time{}
+	status.State = types.HALTED
+
+	restart := policy.Mode == types.RestartModeAlways ||
+		(policy.Mode == types.RestartModeOnFailure && failed)
+	if !restart {
+		log.Noticef("applyRestartPolicy(%s) not restarted by the %s policy: %s",
+			status.Key(), policy.Mode, reason)
+		severity := types.ErrorSeverityNotice
+		if failed {
+			severity = types.ErrorSeverityError
+		}
+		status.SetErrorDescription(types.ErrorDescription{
+			Error: fmt.Sprintf("%s; not restarted by the %s restart policy",
+				reason, policy.Mode),
+			ErrorSeverity: severity,
+		})
+		return true
+	}
+	if policy.Mode == types.RestartModeOnFailure && policy.MaxAttempts != 0 &&
+		status.RestartCount >= policy.MaxAttempts {
+		log.Errorf("applyRestartPolicy(%s) gave up after %d restarts: %s",
+			status.Key(), status.RestartCount, reason)
+		status.SetErrorDescription(types.ErrorDescription{
+			Error: fmt.Sprintf("%s; not restarted after %d attempts",
+				reason, status.RestartCount),
+			ErrorSeverity: types.ErrorSeverityError,
+		})
+		return true
+	}
+
+	// Only the restarts within the window count for the backoff and
+	// the crash loop detection
+	var recent []time.Time
+	for _, t := range status.RestartTimes {
+		if now.Sub(t) < policy.CrashLoopWindow {
+			recent = append(recent, t)
+		}
+	}
+	status.RestartTimes = recent
+	if policy.CrashLoopRestarts != 0 &&
+		uint32(len(recent)) >= policy.CrashLoopRestarts {
+		log.Errorf("applyRestartPolicy(%s) crash loop after %d restarts within %v: %s",
+			status.Key(), len(recent), policy.CrashLoopWindow, reason)
+		status.State = types.CRASH_LOOP
+		status.SetErrorDescription(types.ErrorDescription{
+			Error: fmt.Sprintf("crash loop: %d restarts within %v, last exit: %s",
+				len(recent), policy.CrashLoopWindow, reason),
+			ErrorSeverity: types.ErrorSeverityError,
+		})
+		return true
+	}
+	backoff := restartBackoff(policy, len(recent))
+	log.Noticef("applyRestartPolicy(%s) restart in %v: %s",
+		status.Key(), backoff, reason)
+	status.BootFailed = true
+	status.NextRestartTime = now.Add(backoff)
+	status.SetErrorDescription(types.ErrorDescription{
+		Error:               reason,
+		ErrorSeverity:       types.ErrorSeverityWarning,
+		ErrorRetryCondition: fmt.Sprintf("will restart in %v", backoff),
+	})
+	return true
+}
+
+// restartBackoff returns the backoff doubled for each recent restart up
+// to the MaxBackoff of the policy
+func restartBackoff(policy types.RestartPolicy, recent int) time.Duration {
+	backoff := policy.Backoff
+	for i := 0; i < recent && backoff < policy.MaxBackoff; i++ {
+		backoff *= 2
+	}
+	if policy.MaxBackoff != 0 && backoff > policy.MaxBackoff {
+		backoff = policy.MaxBackoff
+	}
+	return backoff
+}
+
+// resetRestarts forgets the restarts when the domain is activated by its
+// config
+func resetRestarts(status *types.DomainStatus) {
+	status.RestartCount = 0
+	status.RestartTimes = nil
+	status.Health.RestartCount = 0
+	status.NextRestartTime = time.Time{}
+}
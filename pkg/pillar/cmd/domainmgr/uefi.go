@@ -0,0 +1,284 @@
+// Copyright (c) 2022 Zededa, Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package domainmgr
+
+// Code for the NVRAM of the domains which boot OVMF. Each of them gets a
+// copy of the variable store of the firmware in the vault, which is kept
+// across restarts. The Secure Boot keys of the controller are written into
+// that copy before the first boot hence OVMF finds them enrolled.
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/binary"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"runtime"
+	"time"
+	"unicode/utf16"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/lf-edge/eve/pkg/pillar/cipher"
+	"github.com/lf-edge/eve/pkg/pillar/hypervisor"
+	"github.com/lf-edge/eve/pkg/pillar/types"
+	fileutils "github.com/lf-edge/eve/pkg/pillar/utils/file"
+)
+
+// The firmware of the domains as installed by xen-tools
+const uefiFirmwareDir = "/containers/services/xen-tools/rootfs/usr/lib/xen/boot"
+
+// ArmVirtQemu needs both of its flash devices to be 64MiB
+const uefiArmFlashSize = 64 * 1024 * 1024
+
+// Layout of the variable store in the flash of OVMF, which is a firmware
+// volume holding a store of authenticated variables
+const (
+	efiFvSignatureOffset    = 40
+	efiFvHeaderLengthOffset = 48
+	efiVarStoreHeaderSize   = 28
+	efiVarHeaderSize        = 60
+	efiVarStartID           = 0x55AA
+	efiVarAdded             = 0x3f
+	efiVarInDeletedTransit  = 0xfe
+	efiVarDeleted           = 0xfd
+)
+
+// Attributes of the variables
+const (
+	efiVarNonVolatile       = 0x01
+	efiVarBootserviceAccess = 0x02
+	efiVarRuntimeAccess     = 0x04
+	efiVarTimeBasedAuth     = 0x20
+)
+
+var (
+	efiAuthVarStoreGUID     = efiGUID("aaf32c78-947b-439a-a180-2e144ec37792")
+	efiGlobalVariableGUID   = efiGUID("8be4df61-93ca-11d2-aa0d-00e098032b8c")
+	efiImageSecurityDbGUID  = efiGUID("d719b2cb-3d3a-4596-a3bc-dad00e67656f")
+	efiSecureBootEnableGUID = efiGUID("f0a30bc7-af08-4556-99c4-001009c93a44")
+)
+
+// uefiNvramProfile is what the NVRAM of a domain was made from, which is
+// kept next to it. A change recreates the NVRAM.
+type uefiNvramProfile struct {
+	SecureBoot bool
+	KeysHash   []byte
+}
+
+func uefiNvramProfileFile(nvram string) string {
+	return nvram + ".keys"
+}
+
+// prepareUefiNvram creates the NVRAM of a domain which boots OVMF from the
+// variable store of the firmware and enrolls the Secure Boot keys. An
+// existing NVRAM is kept unless Secure Boot or the keys changed.
+func prepareUefiNvram(ctx *domainContext, config types.DomainConfig) error {
+	if hyper.Name() != hypervisor.KVMHypervisorName || !config.HasUefiNvram() {
+		return nil
+	}
+	nvram := types.UefiNvramFile(config.UUIDandVersion.UUID)
+	profile := uefiNvramProfile{SecureBoot: config.SecureBoot}
+	if config.SecureBoot && config.SecureBootKeys.IsCipher {
+		hash := sha256.Sum256(config.SecureBootKeys.CipherData)
+		profile.KeysHash = hash[:]
+	}
+	if _, err := os.Stat(nvram); err == nil {
+		var current uefiNvramProfile
+		b, err := ioutil.ReadFile(uefiNvramProfileFile(nvram))
+		if err == nil {
+			err = json.Unmarshal(b, &current)
+		}
+		if err == nil && cmp.Equal(current, profile) {
+			return nil
+		}
+		log.Noticef("prepareUefiNvram(%s) recreating the NVRAM, was %+v",
+			config.Key(), current)
+	}
+
+	source := filepath.Join(uefiFirmwareDir, "ovmf_vars.bin")
+	if config.SecureBoot {
+		source = filepath.Join(uefiFirmwareDir, "ovmf_vars_sb.bin")
+	}
+	vars, err := ioutil.ReadFile(source)
+	if err != nil {
+		return fmt.Errorf("cannot read the UEFI variables: %v", err)
+	}
+	if runtime.GOARCH == "arm64" && len(vars) < uefiArmFlashSize {
+		vars = append(vars, make([]byte, uefiArmFlashSize-len(vars))...)
+	}
+	if profile.KeysHash != nil {
+		status, decBlock, err := cipher.GetCipherCredentials(&ctx.decryptCipherContext,
+			config.SecureBootKeys)
+		ctx.pubCipherBlockStatus.Publish(status.Key(), status)
+		if err != nil {
+			return fmt.Errorf("cannot decrypt the Secure Boot keys: %v", err)
+		}
+		if err := enrollSecureBootKeys(vars, decBlock, time.Now()); err != nil {
+			return fmt.Errorf("cannot enroll the Secure Boot keys: %v", err)
+		}
+		log.Noticef("prepareUefiNvram(%s) enrolled the Secure Boot keys",
+			config.Key())
+	}
+
+	if err := os.MkdirAll(types.UefiNvramDirname, 0700); err != nil {
+		return err
+	}
+	// The profile goes last hence a partial NVRAM is recreated
+	os.Remove(uefiNvramProfileFile(nvram))
+	if err := fileutils.WriteRename(nvram, vars); err != nil {
+		return fmt.Errorf("cannot write the UEFI variables: %v", err)
+	}
+	b, err := json.Marshal(profile)
+	if err != nil {
+		return err
+	}
+	return fileutils.WriteRename(uefiNvramProfileFile(nvram), b)
+}
+
+// enrollSecureBootKeys writes the keys into the variable store and enables
+// Secure Boot. OVMF leaves the setup mode once it finds PK.
+func enrollSecureBootKeys(vars []byte, keys types.EncryptionBlock,
+	now time.Time) error {
+
+	if len(keys.UefiPK) == 0 {
+		return errors.New("no platform key")
+	}
+	authenticated := uint32(efiVarNonVolatile | efiVarBootserviceAccess |
+		efiVarRuntimeAccess | efiVarTimeBasedAuth)
+	for _, v := range []struct {
+		name string
+		guid []byte
+		data []byte
+	}{
+		{"db", efiImageSecurityDbGUID, keys.UefiDb},
+		{"dbx", efiImageSecurityDbGUID, keys.UefiDbx},
+		{"KEK", efiGlobalVariableGUID, keys.UefiKEK},
+		{"PK", efiGlobalVariableGUID, keys.UefiPK},
+	} {
+		if len(v.data) == 0 {
+			continue
+		}
+		if err := setEfiVariable(vars, v.name, v.guid, authenticated,
+			v.data, now); err != nil {
+			return err
+		}
+	}
+	return setEfiVariable(vars, "SecureBootEnable", efiSecureBootEnableGUID,
+		efiVarNonVolatile|efiVarBootserviceAccess, []byte{1}, now)
+}
+
+// efiVarStore returns the offsets of the variables and of the end of the
+// variable store in the flash
+func efiVarStore(vars []byte) (int, int, error) {
+	if len(vars) < efiFvHeaderLengthOffset+2 ||
+		string(vars[efiFvSignatureOffset:efiFvSignatureOffset+4]) != "_FVH" {
+		return 0, 0, errors.New("no firmware volume")
+	}
+	store := int(binary.LittleEndian.Uint16(vars[efiFvHeaderLengthOffset:]))
+	if store+efiVarStoreHeaderSize > len(vars) ||
+		!bytes.Equal(vars[store:store+16], efiAuthVarStoreGUID) {
+		return 0, 0, errors.New("no store of authenticated variables")
+	}
+	end := store + int(binary.LittleEndian.Uint32(vars[store+16:]))
+	if end > len(vars) {
+		return 0, 0, fmt.Errorf("variable store ends at %d past %d", end, len(vars))
+	}
+	return efiAlign(store + efiVarStoreHeaderSize), end, nil
+}
+
+// setEfiVariable adds a variable at the end of the store and deletes its
+// previous value like the variable driver of the firmware does
+func setEfiVariable(vars []byte, name string, guid []byte, attributes uint32,
+	data []byte, now time.Time) error {
+
+	offset, end, err := efiVarStore(vars)
+	if err != nil {
+		return err
+	}
+	ucs2 := efiName(name)
+	for offset+efiVarHeaderSize <= end &&
+		binary.LittleEndian.Uint16(vars[offset:]) == efiVarStartID {
+		nameSize := int(binary.LittleEndian.Uint32(vars[offset+36:]))
+		dataSize := int(binary.LittleEndian.Uint32(vars[offset+40:]))
+		state := vars[offset+2]
+		nameStart := offset + efiVarHeaderSize
+		if nameStart+nameSize+dataSize > end {
+			return fmt.Errorf("variable at %d past the store", offset)
+		}
+		if (state == efiVarAdded || state == efiVarAdded&efiVarInDeletedTransit) &&
+			bytes.Equal(vars[offset+44:offset+60], guid) &&
+			bytes.Equal(vars[nameStart:nameStart+nameSize], ucs2) {
+			vars[offset+2] &= efiVarDeleted
+		}
+		offset = efiAlign(nameStart + nameSize + dataSize)
+	}
+	size := efiVarHeaderSize + len(ucs2) + len(data)
+	if offset+size > end {
+		return fmt.Errorf("no space for variable %s of %d bytes", name, len(data))
+	}
+	header := vars[offset : offset+efiVarHeaderSize]
+	for i := range header {
+		header[i] = 0
+	}
+	binary.LittleEndian.PutUint16(header[0:], efiVarStartID)
+	header[2] = efiVarAdded
+	binary.LittleEndian.PutUint32(header[4:], attributes)
+	if attributes&efiVarTimeBasedAuth != 0 {
+		putEfiTime(header[16:32], now)
+	}
+	binary.LittleEndian.PutUint32(header[36:], uint32(len(ucs2)))
+	binary.LittleEndian.PutUint32(header[40:], uint32(len(data)))
+	copy(header[44:60], guid)
+	copy(vars[offset+efiVarHeaderSize:], ucs2)
+	copy(vars[offset+efiVarHeaderSize+len(ucs2):], data)
+	return nil
+}
+
+// putEfiTime writes an EFI_TIME in UTC
+func putEfiTime(b []byte, t time.Time) {
+	t = t.UTC()
+	binary.LittleEndian.PutUint16(b[0:], uint16(t.Year()))
+	b[2] = byte(t.Month())
+	b[3] = byte(t.Day())
+	b[4] = byte(t.Hour())
+	b[5] = byte(t.Minute())
+	b[6] = byte(t.Second())
+}
+
+// efiName returns the name of a variable in UCS-2 with its terminator
+func efiName(name string) []byte {
+	var b []byte
+	for _, c := range append(utf16.Encode([]rune(name)), 0) {
+		b = append(b, byte(c), byte(c>>8))
+	}
+	return b
+}
+
+// efiGUID returns a GUID in the mixed-endian layout of UEFI
+func efiGUID(s string) []byte {
+	var d1 uint32
+	var d2, d3, d4 uint16
+	var d5 uint64
+	if _, err := fmt.Sscanf(s, "%08x-%04x-%04x-%04x-%012x",
+		&d1, &d2, &d3, &d4, &d5); err != nil {
+		panic(fmt.Sprintf("bad GUID %s: %v", s, err))
+	}
+	b := make([]byte, 16)
+	binary.LittleEndian.PutUint32(b[0:], d1)
+	binary.LittleEndian.PutUint16(b[4:], d2)
+	binary.LittleEndian.PutUint16(b[6:], d3)
+	binary.BigEndian.PutUint16(b[8:], d4)
+	for i := 0; i < 6; i++ {
+		b[10+i] = byte(d5 >> (8 * (5 - i)))
+	}
+	return b
+}
+
+func efiAlign(offset int) int {
+	return (offset + 3) &^ 3
+}
@@ -19,11 +19,14 @@ type downloaderContext struct {
 	pubDownloaderStatus      pubsub.Publication
 	subResolveConfig         pubsub.Subscription
 	pubResolveStatus         pubsub.Publication
+	subUploaderConfig        pubsub.Subscription
+	pubUploaderStatus        pubsub.Publication
 	pubCipherBlockStatus     pubsub.Publication
 	subDatastoreConfig       pubsub.Subscription
 	subNetworkInstanceStatus pubsub.Subscription
 	deviceNetworkStatus      types.DeviceNetworkStatus
 	subGlobalConfig          pubsub.Subscription
+	subDeviceSchedule        pubsub.Subscription
 	zedcloudMetrics          *zedcloud.AgentMetrics
 	cipherMetrics            *cipher.AgentMetrics
 	GCInitialized            bool
@@ -104,6 +107,23 @@ func (ctx *downloaderContext) registerHandlers(ps *pubsub.PubSub) error {
 	ctx.subGlobalConfig = subGlobalConfig
 	subGlobalConfig.Activate()
 
+	// Look for the download windows of prefetched objects
+	subDeviceSchedule, err := ps.NewSubscription(pubsub.SubscriptionOptions{
+		AgentName:   "zedagent",
+		MyAgentName: agentName,
+		TopicImpl:   types.DeviceSchedule{},
+		Activate:    false,
+		Ctx:         ctx,
+		WarningTime: warningTime,
+		ErrorTime:   errorTime,
+		Persistent:  true,
+	})
+	if err != nil {
+		return err
+	}
+	ctx.subDeviceSchedule = subDeviceSchedule
+	subDeviceSchedule.Activate()
+
 	subDeviceNetworkStatus, err := ps.NewSubscription(pubsub.SubscriptionOptions{
 		CreateHandler: handleDNSCreate,
 		ModifyHandler: handleDNSModify,
@@ -182,6 +202,15 @@ func (ctx *downloaderContext) registerHandlers(ps *pubsub.PubSub) error {
 	}
 	ctx.pubResolveStatus = pubResolveStatus
 
+	pubUploaderStatus, err := ps.NewPublication(pubsub.PublicationOptions{
+		AgentName: agentName,
+		TopicType: types.UploaderStatus{},
+	})
+	if err != nil {
+		return err
+	}
+	ctx.pubUploaderStatus = pubUploaderStatus
+
 	subDownloaderConfig, err := ps.NewSubscription(pubsub.SubscriptionOptions{
 		CreateHandler: handleDownloaderConfigCreate,
 		ModifyHandler: handleDownloaderConfigModify,
@@ -216,8 +245,26 @@ func (ctx *downloaderContext) registerHandlers(ps *pubsub.PubSub) error {
 	ctx.subResolveConfig = subResolveConfig
 	subResolveConfig.Activate()
 
+	subUploaderConfig, err := ps.NewSubscription(pubsub.SubscriptionOptions{
+		CreateHandler: handleUploaderConfigCreate,
+		ModifyHandler: handleUploaderConfigModify,
+		DeleteHandler: handleUploaderConfigDelete,
+		WarningTime:   warningTime,
+		ErrorTime:     errorTime,
+		AgentName:     "volumemgr",
+		MyAgentName:   agentName,
+		TopicImpl:     types.UploaderConfig{},
+		Ctx:           ctx,
+	})
+	if err != nil {
+		return err
+	}
+	ctx.subUploaderConfig = subUploaderConfig
+	subUploaderConfig.Activate()
+
 	pubDownloaderStatus.SignalRestarted()
 	pubResolveStatus.SignalRestarted()
+	pubUploaderStatus.SignalRestarted()
 
 	return nil
 }
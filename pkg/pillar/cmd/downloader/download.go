@@ -17,6 +17,10 @@ import (
 	"github.com/lf-edge/eve/pkg/pillar/zedcloud"
 )
 
+// errDownloadWindowClosed is returned when a prefetch stops at the end of
+// its download window
+var errDownloadWindowClosed = errors.New("download window closed")
+
 func loadDownloadedParts(locFilename string) types.DownloadedParts {
 	var downloadedParts types.DownloadedParts
 	fd, err := os.Open(locFilename + progressFileSuffix)
@@ -57,11 +61,13 @@ func saveDownloadedParts(locFilename string, downloadedParts types.DownloadedPar
 // determined it is, empty string if not available; and the error, if any.
 // Returns a cancel bool to tell the caller to not retry using other
 // interfaces or IP addresses.
+// A download which has a windowEnd stops then with errDownloadWindowClosed
+// and keeps the parts which are done.
 func download(ctx *downloaderContext, trType zedUpload.SyncTransportType,
 	status Status, syncOp zedUpload.SyncOpType, downloadURL string,
 	auth *zedUpload.AuthInput, dpath, region string, maxsize uint64, ifname string,
 	ipSrc net.IP, filename, locFilename string, certs [][]byte,
-	receiveChan chan<- CancelChannel) (string, bool, error) {
+	windowEnd time.Time, receiveChan chan<- CancelChannel) (string, bool, error) {
 
 	// create Endpoint
 	var dEndPoint zedUpload.DronaEndPoint
@@ -148,6 +154,23 @@ func download(ctx *downloaderContext, trType zedUpload.SyncTransportType,
 		req.Cancel()
 	}()
 
+	windowClosed := make(chan struct{})
+	if !windowEnd.IsZero() {
+		timer := time.AfterFunc(time.Until(windowEnd), func() {
+			close(windowClosed)
+			req.Cancel()
+		})
+		defer timer.Stop()
+	}
+	stoppedByWindow := func() bool {
+		select {
+		case <-windowClosed:
+			return true
+		default:
+			return false
+		}
+	}
+
 	req.Post()
 
 	lastProgress := time.Now()
@@ -196,6 +219,9 @@ func download(ctx *downloaderContext, trType zedUpload.SyncTransportType,
 			_, err = resp.GetUpStatus()
 		}
 		if resp.IsError() {
+			if stoppedByWindow() {
+				return "", cancel, errDownloadWindowClosed
+			}
 			return "", cancel, err
 		}
 		log.Functionf("Done for %v size %d",
@@ -204,6 +230,9 @@ func download(ctx *downloaderContext, trType zedUpload.SyncTransportType,
 	}
 	// if we got here, channel was closed
 	// range ends on a closed channel, which is the equivalent of "!ok"
+	if stoppedByWindow() {
+		return "", cancel, errDownloadWindowClosed
+	}
 	errStr := fmt.Sprintf("respChan EOF for <%s>, <%s>, <%s>",
 		dpath, region, filename)
 	log.Errorln(errStr)
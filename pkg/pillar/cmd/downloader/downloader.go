@@ -43,6 +43,7 @@ var (
 	Version        = "No version specified"           // Set from Makefile
 	dHandler       = makeDownloadHandler()
 	resHandler     = makeResolveHandler()
+	uHandler       = makeUploadHandler()
 	logger         *logrus.Logger
 	log            *base.LogObject
 )
@@ -177,6 +178,9 @@ func Run(ps *pubsub.PubSub, loggerArg *logrus.Logger, logArg *base.LogObject) in
 		case change := <-ctx.subDeviceNetworkStatus.MsgChan():
 			ctx.subDeviceNetworkStatus.ProcessChange(change)
 
+		case change := <-ctx.subDeviceSchedule.MsgChan():
+			ctx.subDeviceSchedule.ProcessChange(change)
+
 		case change := <-ctx.subNetworkInstanceStatus.MsgChan():
 			ctx.subNetworkInstanceStatus.ProcessChange(change)
 
@@ -186,6 +190,9 @@ func Run(ps *pubsub.PubSub, loggerArg *logrus.Logger, logArg *base.LogObject) in
 		case change := <-ctx.subResolveConfig.MsgChan():
 			ctx.subResolveConfig.ProcessChange(change)
 
+		case change := <-ctx.subUploaderConfig.MsgChan():
+			ctx.subUploaderConfig.ProcessChange(change)
+
 		case change := <-ctx.subDatastoreConfig.MsgChan():
 			ctx.subDatastoreConfig.ProcessChange(change)
 			log.Noticef("Processed DatastoreConfig")
@@ -246,6 +253,16 @@ func lookupDownloaderStatus(ctx *downloaderContext,
 	return &status
 }
 
+// lookupDeviceSchedule returns the schedule from the controller; it is
+// empty before there is one
+func lookupDeviceSchedule(ctx *downloaderContext) types.DeviceSchedule {
+	s, _ := ctx.subDeviceSchedule.Get("global")
+	if s == nil {
+		return types.DeviceSchedule{}
+	}
+	return s.(types.DeviceSchedule)
+}
+
 func lookupDownloaderConfig(ctx *downloaderContext, key string) *types.DownloaderConfig {
 
 	sub := ctx.subDownloaderConfig
@@ -310,6 +327,22 @@ func runHandler(ctx *downloaderContext, key string, updateChan <-chan Notify,
 func maybeRetryDownload(ctx *downloaderContext,
 	status *types.DownloaderStatus, receiveChan chan<- CancelChannel) {
 
+	// a prefetch which waits for a download window is
+	// resumed once the window opens
+	if !status.HasError() && !status.DeferredUntil.IsZero() {
+		if time.Now().Before(status.DeferredUntil) {
+			return
+		}
+		config := lookupDownloaderConfig(ctx, status.Key())
+		if config == nil || config.RefCount == 0 {
+			return
+		}
+		log.Functionf("maybeRetryDownload(%s) deferred until %v",
+			status.Key(), status.DeferredUntil)
+		status.DeferredUntil = time.Time{}
+		doDownload(ctx, *config, status, receiveChan)
+		return
+	}
 	// object is either in download progress or,
 	// successfully downloaded, nothing to do
 	if !status.HasError() {
@@ -470,9 +503,32 @@ func doDownload(ctx *downloaderContext, config types.DownloaderConfig, status *t
 	}
 	log.Tracef("Found datastore(%s) for %s", config.DatastoreID.String(), config.Name)
 
+	if prefetchDeferred(ctx, config, status) {
+		log.Noticef("doDownload(%s): prefetch deferred until %v",
+			config.Name, status.DeferredUntil)
+		return
+	}
+
 	handleSyncOp(ctx, status.Key(), config, status, dst, receiveChan)
 }
 
+// prefetchDeferred returns true if the prefetch of the object waits for a
+// download window; the status then says until when
+func prefetchDeferred(ctx *downloaderContext, config types.DownloaderConfig,
+	status *types.DownloaderStatus) bool {
+
+	schedule := lookupDeviceSchedule(ctx)
+	_, until, deferred := schedule.PrefetchWindow(config.PrefetchBy, time.Now())
+	if !deferred {
+		return false
+	}
+	status.DeferredUntil = until
+	status.Rate = 0
+	status.ClearPendingStatus()
+	publishDownloaderStatus(ctx, status)
+	return true
+}
+
 func handleDelete(ctx *downloaderContext, key string,
 	status *types.DownloaderStatus) {
 
@@ -163,7 +163,7 @@ func resolveTagsToHash(ctx *downloaderContext, rc types.ResolveConfig,
 		}
 	}
 	sha := maybeNameHasSha(rc.Name)
-	if sha != "" {
+	if sha != "" && !rc.FetchSignatures {
 		rs.ImageSha256 = sha
 		publishResolveStatus(ctx, rs)
 		return
@@ -201,7 +201,9 @@ func resolveTagsToHash(ctx *downloaderContext, rc types.ResolveConfig,
 	log.Functionf("Resolving config <%s> using %d downloadMaxPortCost",
 		rc.Name, downloadMaxPortCost)
 
-	addrCount := types.CountLocalAddrNoLinkLocalWithCost(ctx.deviceNetworkStatus,
+	// Skip ports with downloads blocked due to cellular data quota.
+	dns := ctx.deviceNetworkStatus.WithoutBlockedTraffic(types.TrafficClassDownloads)
+	addrCount := types.CountLocalAddrNoLinkLocalWithCost(dns,
 		downloadMaxPortCost)
 	log.Functionf("Have %d management port addresses for cost %d",
 		addrCount, downloadMaxPortCost)
@@ -257,30 +259,43 @@ func resolveTagsToHash(ctx *downloaderContext, rc types.ResolveConfig,
 
 	// Loop through all interfaces until a success
 	for addrIndex := 0; addrIndex < addrCount; addrIndex++ {
-		ipSrc, err := types.GetLocalAddrNoLinkLocalWithCost(ctx.deviceNetworkStatus,
+		ipSrc, err := types.GetLocalAddrNoLinkLocalWithCost(dns,
 			addrIndex, "", downloadMaxPortCost)
 		if err != nil {
 			log.Errorf("GetLocalAddr failed: %s", err)
 			errStr = errStr + "\n" + err.Error()
 			continue
 		}
-		ifname := types.GetMgmtPortFromAddr(ctx.deviceNetworkStatus, ipSrc)
+		ifname := types.GetMgmtPortFromAddr(dns, ipSrc)
 		log.Functionf("Using IP source %v if %s transport %v",
 			ipSrc, ifname, dsCtx.TransportMethod)
 
-		sha256, cancelled, err = objectMetadata(ctx, trType, syncOp, serverURL, auth,
-			dsCtx.Dpath, dsCtx.Region,
-			ifname, ipSrc, remoteName, receiveChan)
-		if err != nil {
-			if cancelled {
-				errStr = "tag resolution cancelled by user"
-				break
+		sha256 = sha
+		if sha256 == "" {
+			sha256, cancelled, err = objectMetadata(ctx, trType, syncOp, serverURL, auth,
+				dsCtx.Dpath, dsCtx.Region,
+				ifname, ipSrc, remoteName, receiveChan)
+			if err != nil {
+				if cancelled {
+					errStr = "tag resolution cancelled by user"
+					break
+				}
+				// to catch and skip the oci manifest error with the suffix of "no suitable address found"
+				if !strings.HasSuffix(err.Error(), logutils.NoSuitableAddrStr) {
+					errStr = errStr + "\n" + err.Error()
+				}
+				continue
 			}
-			// to catch and skip the oci manifest error with the suffix of "no suitable address found"
-			if !strings.HasSuffix(err.Error(), logutils.NoSuitableAddrStr) {
+		}
+		if rc.FetchSignatures {
+			signatures, err := fetchSignatures(ctx, serverURL, remoteName,
+				auth, ifname, ipSrc, "sha256:"+strings.ToLower(sha256))
+			if err != nil {
 				errStr = errStr + "\n" + err.Error()
+				continue
 			}
-			continue
+			rs.Signatures = signatures
+			rs.SignaturesFetched = true
 		}
 		rs.ClearError()
 		rs.ImageSha256 = sha256
@@ -0,0 +1,277 @@
+// Copyright (c) 2022 Zededa, Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package downloader
+
+// Code to fetch the cosign and Notation signatures of OCI images from the
+// registry. They are verified by volumemgr against the signature policy
+// of the content tree.
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net"
+	"net/http"
+	"net/url"
+	"time"
+
+	"github.com/google/go-containerregistry/pkg/authn"
+	"github.com/google/go-containerregistry/pkg/name"
+	v1 "github.com/google/go-containerregistry/pkg/v1"
+	"github.com/google/go-containerregistry/pkg/v1/remote"
+	"github.com/google/go-containerregistry/pkg/v1/remote/transport"
+	"github.com/lf-edge/eve/libs/zedUpload"
+	"github.com/lf-edge/eve/pkg/pillar/sigverify"
+	"github.com/lf-edge/eve/pkg/pillar/types"
+	"github.com/lf-edge/eve/pkg/pillar/zedcloud"
+)
+
+const (
+	// Limits on what is fetched for an image, which is not verified yet
+	maxSignatures    = 16
+	maxSignatureSize = 1024 * 1024
+
+	cosignSignatureAnnotation   = "dev.cosignproject.cosign/signature"
+	cosignCertificateAnnotation = "dev.sigstore.cosign/certificate"
+	cosignChainAnnotation       = "dev.sigstore.cosign/chain"
+	cosignBundleAnnotation      = "dev.sigstore.cosign/bundle"
+)
+
+// fetchSignatures returns the signatures of the image with the digest in
+// the repository
+func fetchSignatures(ctx *downloaderContext, serverURL, remoteName string,
+	auth *zedUpload.AuthInput, ifname string, ipSrc net.IP,
+	digest string) ([]types.ImageSignature, error) {
+
+	ref, err := name.ParseReference(serverURL + "/" + remoteName)
+	if err != nil {
+		return nil, fmt.Errorf("invalid OCI reference %s/%s: %v",
+			serverURL, remoteName, err)
+	}
+	repo := ref.Context()
+
+	proxyLookupURL := zedcloud.IntfLookupProxyCfg(log, &ctx.deviceNetworkStatus,
+		ifname, serverURL, zedUpload.SyncOCIRegistryTr)
+	proxyURL, err := zedcloud.LookupProxy(log, &ctx.deviceNetworkStatus,
+		ifname, proxyLookupURL)
+	if err != nil {
+		proxyURL = nil
+	}
+	opts := signatureOptions(auth, ipSrc, proxyURL)
+
+	log.Functionf("fetchSignatures(%s, %s) using %v", repo, digest, ipSrc)
+	var signatures []types.ImageSignature
+	for _, attestation := range []bool{false, true} {
+		tag := sigverify.CosignSignatureTag(digest)
+		kind := types.ImageSignatureCosign
+		if attestation {
+			tag = sigverify.CosignAttestationTag(digest)
+			kind = types.ImageSignatureCosignAttestation
+		}
+		raw, err := fetchManifest(repo.Tag(tag), opts)
+		if err != nil {
+			return nil, err
+		}
+		if raw == nil {
+			continue
+		}
+		sigs, err := cosignSignatures(repo, raw, kind, opts)
+		if err != nil {
+			return nil, err
+		}
+		signatures = append(signatures, sigs...)
+	}
+	raw, err := fetchManifest(repo.Tag(sigverify.ReferrersTag(digest)), opts)
+	if err != nil {
+		return nil, err
+	}
+	if raw != nil {
+		sigs, err := notationSignatures(repo, raw, opts)
+		if err != nil {
+			return nil, err
+		}
+		signatures = append(signatures, sigs...)
+	}
+	if len(signatures) > maxSignatures {
+		signatures = signatures[:maxSignatures]
+	}
+	log.Noticef("fetchSignatures(%s, %s) found %d signatures",
+		repo, digest, len(signatures))
+	return signatures, nil
+}
+
+// signatureOptions returns the options to talk to the registry from
+// ipSrc, with the credentials of the datastore
+func signatureOptions(auth *zedUpload.AuthInput, ipSrc net.IP,
+	proxyURL *url.URL) []remote.Option {
+
+	localTCPAddr := net.TCPAddr{IP: ipSrc}
+	localUDPAddr := net.UDPAddr{IP: ipSrc}
+	resolverDial := func(ctx context.Context, network, address string) (net.Conn, error) {
+		d := net.Dialer{LocalAddr: &localUDPAddr}
+		return d.DialContext(ctx, network, address)
+	}
+	r := net.Resolver{Dial: resolverDial, PreferGo: true, StrictErrors: false}
+	tr := &http.Transport{
+		DialContext: (&net.Dialer{
+			Resolver:  &r,
+			LocalAddr: &localTCPAddr,
+			Timeout:   30 * time.Second,
+			KeepAlive: 30 * time.Second,
+		}).DialContext,
+		TLSHandshakeTimeout: 10 * time.Second,
+	}
+	if proxyURL != nil {
+		tr.Proxy = http.ProxyURL(proxyURL)
+	}
+	// default to anonymous, unless we have auth credentials
+	authenticator := authn.Anonymous
+	if auth != nil && (auth.Uname != "" || auth.Password != "") {
+		authenticator = authn.FromConfig(authn.AuthConfig{
+			Username: auth.Uname,
+			Password: auth.Password,
+		})
+	}
+	return []remote.Option{
+		remote.WithAuth(authenticator),
+		remote.WithTransport(tr),
+	}
+}
+
+// fetchManifest returns the raw manifest of the reference, or nil if
+// there is none
+func fetchManifest(ref name.Reference, opts []remote.Option) ([]byte, error) {
+	desc, err := remote.Get(ref, opts...)
+	if err != nil {
+		var terr *transport.Error
+		if errors.As(err, &terr) && terr.StatusCode == http.StatusNotFound {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("fetching %s failed: %v", ref, err)
+	}
+	if desc.Size > maxSignatureSize {
+		return nil, fmt.Errorf("manifest of %s has %d bytes", ref, desc.Size)
+	}
+	return desc.Manifest, nil
+}
+
+// fetchBlob returns the content of a small blob of the repository
+func fetchBlob(repo name.Repository, desc v1.Descriptor,
+	opts []remote.Option) ([]byte, error) {
+
+	if desc.Size > maxSignatureSize {
+		return nil, fmt.Errorf("blob %s has %d bytes", desc.Digest, desc.Size)
+	}
+	layer, err := remote.Layer(repo.Digest(desc.Digest.String()), opts...)
+	if err != nil {
+		return nil, fmt.Errorf("fetching blob %s failed: %v", desc.Digest, err)
+	}
+	rc, err := layer.Compressed()
+	if err != nil {
+		return nil, fmt.Errorf("fetching blob %s failed: %v", desc.Digest, err)
+	}
+	defer rc.Close()
+	data, err := ioutil.ReadAll(io.LimitReader(rc, maxSignatureSize+1))
+	if err != nil {
+		return nil, fmt.Errorf("reading blob %s failed: %v", desc.Digest, err)
+	}
+	if len(data) > maxSignatureSize {
+		return nil, fmt.Errorf("blob %s is too large", desc.Digest)
+	}
+	return data, nil
+}
+
+// cosignSignatures returns the signatures in the layers of a cosign
+// signature image; the signature and the certificates are annotations
+func cosignSignatures(repo name.Repository, raw []byte,
+	kind types.ImageSignatureKind, opts []remote.Option) ([]types.ImageSignature, error) {
+
+	manifest, err := v1.ParseManifest(bytes.NewReader(raw))
+	if err != nil {
+		return nil, fmt.Errorf("parsing cosign manifest failed: %v", err)
+	}
+	var signatures []types.ImageSignature
+	for _, layer := range manifest.Layers {
+		if len(signatures) == maxSignatures {
+			break
+		}
+		sig := types.ImageSignature{
+			Kind:        kind,
+			MediaType:   string(layer.MediaType),
+			Signature:   layer.Annotations[cosignSignatureAnnotation],
+			Certificate: layer.Annotations[cosignCertificateAnnotation],
+			Chain:       layer.Annotations[cosignChainAnnotation],
+			Bundle:      layer.Annotations[cosignBundleAnnotation],
+		}
+		if kind == types.ImageSignatureCosign && sig.Signature == "" {
+			continue
+		}
+		payload, err := fetchBlob(repo, layer, opts)
+		if err != nil {
+			return nil, err
+		}
+		sig.Payload = payload
+		signatures = append(signatures, sig)
+	}
+	return signatures, nil
+}
+
+// referrersIndex is the index of the referrers of an image; unlike
+// v1.IndexManifest it has the artifact types
+type referrersIndex struct {
+	Manifests []struct {
+		Digest       string `json:"digest"`
+		ArtifactType string `json:"artifactType"`
+	} `json:"manifests"`
+}
+
+// notationSignatures returns the Notation signatures among the artifacts
+// in the index of the referrers of the image
+func notationSignatures(repo name.Repository, raw []byte,
+	opts []remote.Option) ([]types.ImageSignature, error) {
+
+	var index referrersIndex
+	if err := json.Unmarshal(raw, &index); err != nil {
+		return nil, fmt.Errorf("parsing referrers index failed: %v", err)
+	}
+	var signatures []types.ImageSignature
+	for _, desc := range index.Manifests {
+		if len(signatures) == maxSignatures {
+			break
+		}
+		if desc.ArtifactType != sigverify.NotationArtifactType {
+			continue
+		}
+		raw, err := fetchManifest(repo.Digest(desc.Digest), opts)
+		if err != nil {
+			return nil, err
+		}
+		if raw == nil {
+			continue
+		}
+		manifest, err := v1.ParseManifest(bytes.NewReader(raw))
+		if err != nil {
+			return nil, fmt.Errorf("parsing notation manifest failed: %v", err)
+		}
+		for _, layer := range manifest.Layers {
+			if string(layer.MediaType) != sigverify.NotationMediaType {
+				continue
+			}
+			envelope, err := fetchBlob(repo, layer, opts)
+			if err != nil {
+				return nil, err
+			}
+			signatures = append(signatures, types.ImageSignature{
+				Kind:      types.ImageSignatureNotation,
+				MediaType: string(layer.MediaType),
+				Payload:   envelope,
+			})
+		}
+	}
+	return signatures, nil
+}
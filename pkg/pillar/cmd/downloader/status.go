@@ -4,6 +4,8 @@
 package downloader
 
 import (
+	"time"
+
 	"github.com/lf-edge/eve/pkg/pillar/types"
 )
 
@@ -19,6 +21,9 @@ type Status interface {
 type PublishStatus struct {
 	ctx    *downloaderContext
 	status *types.DownloaderStatus
+	// where the download started, to report its rate
+	startTime time.Time
+	startSize int64
 }
 
 // Progress report progress as a percentage of completeness
@@ -28,6 +33,13 @@ func (d *PublishStatus) Progress(p uint, currentSize, totalSize int64) bool {
 		d.status.TotalSize == totalSize {
 		return false
 	}
+	if d.startTime.IsZero() {
+		d.startTime = time.Now()
+		d.startSize = currentSize
+	} else if elapsed := time.Since(d.startTime).Seconds(); elapsed > 0 &&
+		currentSize > d.startSize {
+		d.status.Rate = uint64(float64(currentSize-d.startSize) / elapsed)
+	}
 	d.status.Progress = p
 	d.status.CurrentSize = currentSize
 	d.status.TotalSize = totalSize
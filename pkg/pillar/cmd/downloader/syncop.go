@@ -4,6 +4,7 @@
 package downloader
 
 import (
+	"errors"
 	"fmt"
 	"net"
 	"net/url"
@@ -65,6 +66,7 @@ func handleSyncOp(ctx *downloaderContext, key string,
 	// derived, but it is good for the status to say where it *is*, as opposed to
 	// config, which says where it *should be*
 	status.Target = locFilename
+	status.DeferredUntil = time.Time{}
 	publishDownloaderStatus(ctx, status)
 
 	// make sure the directory exists - just a safety check
@@ -75,114 +77,30 @@ func handleSyncOp(ctx *downloaderContext, key string,
 		}
 	}
 
+	// a prefetch inside a download window uses the ports the window
+	// allows and stops when the window closes
 	downloadMaxPortCost := ctx.downloadMaxPortCost
+	schedule := lookupDeviceSchedule(ctx)
+	window, windowEnd, _ := schedule.PrefetchWindow(config.PrefetchBy, time.Now())
+	if window != nil {
+		downloadMaxPortCost = window.MaxPortCost
+	}
 	log.Functionf("Downloading <%s> to <%s> using %d downloadMaxPortCost",
 		config.Name, locFilename, downloadMaxPortCost)
 
-	dsPath := dsCtx.Dpath
-
-	switch dsCtx.TransportMethod {
-	case zconfig.DsType_DsContainerRegistry.String():
-		auth = &zedUpload.AuthInput{
-			AuthType: "apikey",
-			Uname:    dsCtx.APIKey,
-			Password: dsCtx.Password,
-		}
-		trType = zedUpload.SyncOCIRegistryTr
-		// get the name of the repository and the URL for the registry
-		serverURL, remoteName, err = ociRepositorySplit(dsCtx.DownloadURL)
-		if err != nil {
-			errStr = fmt.Sprintf("invalid OCI registry URL: %s", serverURL)
-		}
-	case zconfig.DsType_DsS3.String():
-		auth = &zedUpload.AuthInput{
-			AuthType: "s3",
-			Uname:    dsCtx.APIKey,
-			Password: dsCtx.Password,
-		}
-		trType = zedUpload.SyncAwsTr
-		serverURL = dsCtx.DownloadURL
-		// pass in the config.Name instead of 'filename' which
-		// does not contain the prefix of the relative path with '/'s
-		remoteName = config.Name
-
-		// we assume that the bucket name should not contain a / and we make the remoteName pick up anything after a /
-		// remove all leading '/' if we have them in dsPath, remove duplicates of '/' and split by '/'
-		splittedPath := strings.Split(path.Clean(strings.TrimLeft(dsPath, "/")), "/")
-		if len(splittedPath) > 1 {
-			// if we have '/' in datastore path, move all after first occurrence from bucket to remoteName
-			// valid bucket name should not contain '/'
-			dsPath = splittedPath[0]
-			splittedPath = append(splittedPath, remoteName)
-			remoteName = path.Join(splittedPath[1:]...)
-		}
-		metricsURL = fmt.Sprintf("S3:%s/%s", dsPath, remoteName)
-		cleanOnError = false
-
-	case zconfig.DsType_DsAzureBlob.String():
-		auth = &zedUpload.AuthInput{
-			AuthType: "password",
-			Uname:    dsCtx.APIKey,
-			Password: dsCtx.Password,
-		}
-		trType = zedUpload.SyncAzureTr
-		serverURL = strings.TrimSpace(dst.Fqdn)
-		// if fqdn is defined, ensure that we have scheme defined
-		// if not, assume it is https
-		if serverURL != "" {
-			u, err := url.Parse(serverURL)
-			if err != nil {
-				errStr = fmt.Sprintf("invalid fqdn(%s): %s", serverURL, err)
-			} else if u.Scheme == "" {
-				serverURL = fmt.Sprintf("https://%s", serverURL)
-			}
-		}
-		// pass in the config.Name instead of 'filename' which
-		// does not contain the prefix of the relative path with '/'s
-		remoteName = config.Name
-		cleanOnError = false
-
-	case zconfig.DsType_DsSFTP.String():
-		auth = &zedUpload.AuthInput{
-			AuthType: "sftp",
-			Uname:    dsCtx.APIKey,
-			Password: dsCtx.Password,
-		}
-		trType = zedUpload.SyncSftpTr
-		// pass in the config.Name instead of 'filename' which
-		// does not contain the prefix of the relative path with '/'s
-		remoteName = config.Name
-		serverURL = dst.Fqdn
-
-	case zconfig.DsType_DsHttp.String(), zconfig.DsType_DsHttps.String(), "":
-		auth = &zedUpload.AuthInput{
-			AuthType: "http",
-		}
-		trType = zedUpload.SyncHttpTr
-		// pass in the config.Name instead of 'filename' which
-		// does not contain the prefix of the relative path with '/'s
-		remoteName = config.Name
-		serverURL, err = getServerURL(dsCtx)
-		// failed to get server url
-		if err != nil {
-			errStr = errStr + "\n" + err.Error()
-		}
-
-	case zconfig.DsType_DsGoogleStorage.String():
-		auth = &zedUpload.AuthInput{
-			AuthType: "gs",
-			Uname:    dsCtx.APIKey,
-			Password: dsCtx.Password,
-		}
-		trType = zedUpload.SyncGSTr
-		serverURL = dsCtx.DownloadURL
-		// pass in the config.Name instead of 'filename' which
-		// does not contain the prefix of the relative path with '/'s
-		remoteName = config.Name
-	default:
-		errStr = "unsupported transport method " + dsCtx.TransportMethod
-
+	target, err := resolveSyncOpTarget(dsCtx, dst, config.Name)
+	if err != nil {
+		errStr = err.Error()
+	}
+	trType = target.trType
+	auth = target.auth
+	serverURL = target.serverURL
+	remoteName = target.remoteName
+	dsPath := target.dsPath
+	if target.metricsURL != "" {
+		metricsURL = target.metricsURL
 	}
+	cleanOnError = target.cleanOnError
 
 	// if there were any errors, do not bother continuing
 	// ideally in go we would have this as a check for error
@@ -194,12 +112,15 @@ func handleSyncOp(ctx *downloaderContext, key string,
 		return
 	}
 
+	// Skip ports with downloads blocked due to cellular data quota.
+	dns := ctx.deviceNetworkStatus.WithoutBlockedTraffic(types.TrafficClassDownloads)
+
 	// if the server URL ends with '.local', it is considered to be local data store
 	dsLocal := strings.HasSuffix(serverURL, ".local") || strings.HasSuffix(serverURL, ".local.")
 	if dsLocal {
 		addrCount = 1
 	} else {
-		addrCount = types.CountLocalAddrNoLinkLocalWithCost(ctx.deviceNetworkStatus,
+		addrCount = types.CountLocalAddrNoLinkLocalWithCost(dns,
 			downloadMaxPortCost)
 		if addrCount == 0 {
 			err := fmt.Errorf("No IP management port addresses with cost <= %d",
@@ -216,14 +137,14 @@ func handleSyncOp(ctx *downloaderContext, key string,
 		var ifname string
 		var ipSrc net.IP
 		if !dsLocal {
-			ipSrc, err = types.GetLocalAddrNoLinkLocalWithCost(ctx.deviceNetworkStatus,
+			ipSrc, err = types.GetLocalAddrNoLinkLocalWithCost(dns,
 				addrIndex, "", downloadMaxPortCost)
 			if err != nil {
 				log.Errorf("GetLocalAddr failed: %s", err)
 				errStr = errStr + "\n" + err.Error()
 				continue
 			}
-			ifname = types.GetMgmtPortFromAddr(ctx.deviceNetworkStatus, ipSrc)
+			ifname = types.GetMgmtPortFromAddr(dns, ipSrc)
 		} else {
 			serverURL, ifname, ipSrc, err = findDSmDNS(ctx, serverURL)
 			if err != nil {
@@ -244,8 +165,20 @@ func handleSyncOp(ctx *downloaderContext, key string,
 		contentType, cancelled, err = download(ctx, trType, st, syncOp, serverURL, auth,
 			dsPath, dsCtx.Region,
 			config.Size, ifname, ipSrc, remoteName, locFilename, dst.DsCertPEM,
-			receiveChan)
+			windowEnd, receiveChan)
 		if err != nil {
+			if errors.Is(err, errDownloadWindowClosed) {
+				// keep what is downloaded for the next window
+				log.Noticef("download %s stopped at the end of the download window",
+					config.Name)
+				status.Rate = 0
+				if !prefetchDeferred(ctx, config, status) {
+					// the deadline is near; resume at once
+					status.DeferredUntil = time.Now()
+					publishDownloaderStatus(ctx, status)
+				}
+				return
+			}
 			if cancelled {
 				log.Errorf("download %s cancelled", serverURL)
 				errStr = "download cancelled by user"
@@ -297,6 +230,131 @@ func handleSyncOp(ctx *downloaderContext, key string,
 		key, errStr, cancelled, cleanOnError)
 }
 
+// syncOpTarget says how to reach an object in a datastore
+type syncOpTarget struct {
+	trType       zedUpload.SyncTransportType
+	auth         *zedUpload.AuthInput
+	serverURL    string
+	remoteName   string
+	dsPath       string
+	metricsURL   string // Empty to use the DownloadURL
+	cleanOnError bool   // Remove partial downloads on error
+}
+
+// resolveSyncOpTarget picks the transport for the datastore and
+// the remote name of the object name in it
+func resolveSyncOpTarget(dsCtx *types.DatastoreContext,
+	dst *types.DatastoreConfig, name string) (syncOpTarget, error) {
+
+	var err error
+	target := syncOpTarget{
+		dsPath:       dsCtx.Dpath,
+		cleanOnError: true,
+	}
+	switch dsCtx.TransportMethod {
+	case zconfig.DsType_DsContainerRegistry.String():
+		target.auth = &zedUpload.AuthInput{
+			AuthType: "apikey",
+			Uname:    dsCtx.APIKey,
+			Password: dsCtx.Password,
+		}
+		target.trType = zedUpload.SyncOCIRegistryTr
+		// get the name of the repository and the URL for the registry
+		target.serverURL, target.remoteName, err = ociRepositorySplit(dsCtx.DownloadURL)
+		if err != nil {
+			return target, fmt.Errorf("invalid OCI registry URL: %s", dsCtx.DownloadURL)
+		}
+	case zconfig.DsType_DsS3.String():
+		target.auth = &zedUpload.AuthInput{
+			AuthType: "s3",
+			Uname:    dsCtx.APIKey,
+			Password: dsCtx.Password,
+		}
+		target.trType = zedUpload.SyncAwsTr
+		target.serverURL = dsCtx.DownloadURL
+		// pass in the name instead of 'filename' which
+		// does not contain the prefix of the relative path with '/'s
+		target.remoteName = name
+
+		// we assume that the bucket name should not contain a / and we make the remoteName pick up anything after a /
+		// remove all leading '/' if we have them in dsPath, remove duplicates of '/' and split by '/'
+		splittedPath := strings.Split(path.Clean(strings.TrimLeft(target.dsPath, "/")), "/")
+		if len(splittedPath) > 1 {
+			// if we have '/' in datastore path, move all after first occurrence from bucket to remoteName
+			// valid bucket name should not contain '/'
+			target.dsPath = splittedPath[0]
+			splittedPath = append(splittedPath, target.remoteName)
+			target.remoteName = path.Join(splittedPath[1:]...)
+		}
+		target.metricsURL = fmt.Sprintf("S3:%s/%s", target.dsPath, target.remoteName)
+		target.cleanOnError = false
+
+	case zconfig.DsType_DsAzureBlob.String():
+		target.auth = &zedUpload.AuthInput{
+			AuthType: "password",
+			Uname:    dsCtx.APIKey,
+			Password: dsCtx.Password,
+		}
+		target.trType = zedUpload.SyncAzureTr
+		target.serverURL = strings.TrimSpace(dst.Fqdn)
+		// if fqdn is defined, ensure that we have scheme defined
+		// if not, assume it is https
+		if target.serverURL != "" {
+			u, err := url.Parse(target.serverURL)
+			if err != nil {
+				return target, fmt.Errorf("invalid fqdn(%s): %s", target.serverURL, err)
+			} else if u.Scheme == "" {
+				target.serverURL = fmt.Sprintf("https://%s", target.serverURL)
+			}
+		}
+		// pass in the name instead of 'filename' which
+		// does not contain the prefix of the relative path with '/'s
+		target.remoteName = name
+		target.cleanOnError = false
+
+	case zconfig.DsType_DsSFTP.String():
+		target.auth = &zedUpload.AuthInput{
+			AuthType: "sftp",
+			Uname:    dsCtx.APIKey,
+			Password: dsCtx.Password,
+		}
+		target.trType = zedUpload.SyncSftpTr
+		// pass in the name instead of 'filename' which
+		// does not contain the prefix of the relative path with '/'s
+		target.remoteName = name
+		target.serverURL = dst.Fqdn
+
+	case zconfig.DsType_DsHttp.String(), zconfig.DsType_DsHttps.String(), "":
+		target.auth = &zedUpload.AuthInput{
+			AuthType: "http",
+		}
+		target.trType = zedUpload.SyncHttpTr
+		// pass in the name instead of 'filename' which
+		// does not contain the prefix of the relative path with '/'s
+		target.remoteName = name
+		target.serverURL, err = getServerURL(dsCtx)
+		// failed to get server url
+		if err != nil {
+			return target, err
+		}
+
+	case zconfig.DsType_DsGoogleStorage.String():
+		target.auth = &zedUpload.AuthInput{
+			AuthType: "gs",
+			Uname:    dsCtx.APIKey,
+			Password: dsCtx.Password,
+		}
+		target.trType = zedUpload.SyncGSTr
+		target.serverURL = dsCtx.DownloadURL
+		// pass in the name instead of 'filename' which
+		// does not contain the prefix of the relative path with '/'s
+		target.remoteName = name
+	default:
+		return target, fmt.Errorf("unsupported transport method %s", dsCtx.TransportMethod)
+	}
+	return target, nil
+}
+
 // DownloadURL format : http://<serverURL>/dpath/filename
 func getServerURL(dsCtx *types.DatastoreContext) (string, error) {
 	u, err := url.Parse(dsCtx.DownloadURL)
@@ -314,6 +372,7 @@ func handleSyncOpResponse(ctx *downloaderContext, config types.DownloaderConfig,
 	// have finished the download operation
 	// based on the result, perform some storage
 	// management also
+	status.Rate = 0
 
 	if errStr != "" {
 		if cleanOnError {
@@ -0,0 +1,283 @@
+// Copyright (c) 2022 Zededa, Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package downloader
+
+import (
+	"errors"
+	"fmt"
+	"net"
+	"os"
+	"strings"
+	"sync"
+	"time"
+
+	zconfig "github.com/lf-edge/eve/api/go/config"
+	"github.com/lf-edge/eve/libs/zedUpload"
+	"github.com/lf-edge/eve/pkg/pillar/types"
+	"github.com/lf-edge/eve/pkg/pillar/utils"
+	logutils "github.com/lf-edge/eve/pkg/pillar/utils/logging"
+)
+
+// uploadHandler runs one goroutine per UploaderConfig. Uploads are
+// not retried, hence the goroutine ends with the upload.
+type uploadHandler struct {
+	sync.Mutex
+	handlers map[string]*uploadChannels
+}
+
+// receiveChan is fed by download() with a cancel channel per attempt;
+// it is closed by the goroutine once the upload is over.
+// The status is unpublished by whoever is last of the goroutine
+// and the delete of the config.
+type uploadChannels struct {
+	receiveChan       chan CancelChannel
+	currentCancelChan chan<- Notify
+	deleted           bool
+	done              bool
+}
+
+func makeUploadHandler() *uploadHandler {
+	return &uploadHandler{
+		handlers: make(map[string]*uploadChannels),
+	}
+}
+
+func handleUploaderConfigCreate(ctxArg interface{}, key string,
+	configArg interface{}) {
+
+	uHandler.create(ctxArg, key, configArg)
+}
+
+func handleUploaderConfigModify(ctxArg interface{}, key string,
+	configArg interface{}, oldConfigArg interface{}) {
+
+	// The publisher deletes the config to upload something else
+	log.Warnf("handleUploaderConfigModify(%s) ignored", key)
+}
+
+func handleUploaderConfigDelete(ctxArg interface{}, key string,
+	configArg interface{}) {
+
+	uHandler.delete(ctxArg, key)
+}
+
+func (u *uploadHandler) create(ctxArg interface{}, key string,
+	configArg interface{}) {
+
+	log.Functionf("uploadHandler.create(%s)", key)
+	ctx := ctxArg.(*downloaderContext)
+	config := configArg.(types.UploaderConfig)
+	u.Lock()
+	defer u.Unlock()
+	if _, ok := u.handlers[key]; ok {
+		log.Errorf("uploadHandler.create(%s) upload still running", key)
+		return
+	}
+	h := &uploadChannels{
+		receiveChan: make(chan CancelChannel, 1),
+	}
+	u.handlers[key] = h
+
+	// Pick up the current cancel channel from the receiveChan and save
+	// it so it can be used if there is a cancel
+	go func() {
+		for ch := range h.receiveChan {
+			u.Lock()
+			if h.deleted {
+				close(ch)
+			} else {
+				h.currentCancelChan = ch
+			}
+			u.Unlock()
+		}
+		log.Noticef("uploadHandler(%s) receiveChan func done", key)
+	}()
+
+	status := types.UploaderStatus{
+		Name:        config.Name,
+		DatastoreID: config.DatastoreID,
+		Size:        config.Size,
+	}
+	ctx.pubUploaderStatus.Publish(key, status)
+	go runUpload(ctx, u, config, status, h)
+}
+
+func (u *uploadHandler) delete(ctxArg interface{}, key string) {
+	log.Functionf("uploadHandler.delete(%s)", key)
+	ctx := ctxArg.(*downloaderContext)
+	u.Lock()
+	defer u.Unlock()
+	h, ok := u.handlers[key]
+	if !ok {
+		log.Functionf("uploadHandler.delete: unknown %s", key)
+		return
+	}
+	h.deleted = true
+	if h.currentCancelChan != nil {
+		log.Noticef("uploadHandler.delete(%s) cancel", key)
+		// We only cancel one operation once
+		close(h.currentCancelChan)
+		h.currentCancelChan = nil
+	}
+	if h.done {
+		unpublishUploaderStatus(ctx, key)
+		delete(u.handlers, key)
+	}
+	log.Functionf("uploadHandler.delete(%s) done", key)
+}
+
+// runUpload is the goroutine of an UploaderConfig
+func runUpload(ctx *downloaderContext, u *uploadHandler,
+	config types.UploaderConfig, status types.UploaderStatus, h *uploadChannels) {
+
+	key := config.Key()
+	st := &uploadPublishStatus{ctx: ctx, status: &status}
+	err := doUpload(ctx, config, st, h.receiveChan)
+	close(h.receiveChan)
+	if err != nil {
+		log.Errorf("runUpload(%s) failed: %v", key, err)
+		status.SetErrorNow(err.Error())
+	} else {
+		log.Noticef("runUpload(%s) done", key)
+		status.Done = true
+		status.Progress = 100
+		status.ClearError()
+	}
+	u.Lock()
+	defer u.Unlock()
+	h.done = true
+	if h.deleted {
+		unpublishUploaderStatus(ctx, key)
+		delete(u.handlers, key)
+		return
+	}
+	ctx.pubUploaderStatus.Publish(key, status)
+}
+
+func doUpload(ctx *downloaderContext, config types.UploaderConfig,
+	st *uploadPublishStatus, receiveChan chan<- CancelChannel) error {
+
+	if _, err := os.Stat(config.Source); err != nil {
+		return err
+	}
+	dst, err := utils.LookupDatastoreConfig(ctx.subDatastoreConfig, config.DatastoreID)
+	if dst == nil {
+		return fmt.Errorf("datastore not available: %v", err)
+	}
+	if dst.DsType == zconfig.DsType_DsContainerRegistry.String() {
+		return fmt.Errorf("cannot upload %s to container registry %s",
+			config.Name, dst.Key())
+	}
+	dsCtx, err := constructDatastoreContext(ctx, config.Name, false, *dst)
+	if err != nil {
+		return err
+	}
+	target, err := resolveSyncOpTarget(dsCtx, dst, config.Name)
+	if err != nil {
+		return err
+	}
+	metricsURL := dsCtx.DownloadURL
+	if target.metricsURL != "" {
+		metricsURL = target.metricsURL
+	}
+	serverURL := target.serverURL
+	downloadMaxPortCost := ctx.downloadMaxPortCost
+
+	// Uploads count as downloads for the cellular data quota
+	dns := ctx.deviceNetworkStatus.WithoutBlockedTraffic(types.TrafficClassDownloads)
+
+	// if the server URL ends with '.local', it is considered to be local data store
+	dsLocal := strings.HasSuffix(serverURL, ".local") || strings.HasSuffix(serverURL, ".local.")
+	addrCount := 1
+	if !dsLocal {
+		addrCount = types.CountLocalAddrNoLinkLocalWithCost(dns,
+			downloadMaxPortCost)
+		if addrCount == 0 {
+			return fmt.Errorf("No IP management port addresses with cost <= %d",
+				downloadMaxPortCost)
+		}
+	}
+
+	var errStr string
+	// Loop through all interfaces until a success
+	for addrIndex := 0; addrIndex < addrCount; addrIndex++ {
+		var ifname string
+		var ipSrc net.IP
+		if !dsLocal {
+			ipSrc, err = types.GetLocalAddrNoLinkLocalWithCost(dns,
+				addrIndex, "", downloadMaxPortCost)
+			if err != nil {
+				log.Errorf("GetLocalAddr failed: %s", err)
+				errStr = errStr + "\n" + err.Error()
+				continue
+			}
+			ifname = types.GetMgmtPortFromAddr(dns, ipSrc)
+		} else {
+			serverURL, ifname, ipSrc, err = findDSmDNS(ctx, serverURL)
+			if err != nil {
+				return fmt.Errorf("find datastore mDNS failed: %s", err)
+			}
+		}
+		log.Functionf("Uploading %s to server URL %s IP source %v if %s transport %v",
+			config.Source, serverURL, ipSrc, ifname, dsCtx.TransportMethod)
+
+		uploadStartTime := time.Now()
+		_, cancelled, err := download(ctx, target.trType, st, zedUpload.SyncOpUpload,
+			serverURL, target.auth, target.dsPath, dsCtx.Region,
+			config.Size, ifname, ipSrc, target.remoteName, config.Source,
+			dst.DsCertPEM, time.Time{}, receiveChan)
+		// download keeps track of parts next to the local file
+		os.RemoveAll(config.Source + progressFileSuffix)
+		if err != nil {
+			if cancelled {
+				return errors.New("upload cancelled by user")
+			}
+			log.Errorf("Source IP %s failed: %s", ipSrc, err)
+			ctx.zedcloudMetrics.RecordFailure(log, ifname, metricsURL, 1024, 0, false)
+			dnError := strings.Trim(err.Error(), "\n")
+			if !strings.HasSuffix(dnError, logutils.NoSuitableAddrStr) {
+				errStr = errStr + "\n" + err.Error()
+			}
+			continue
+		}
+		uploadTime := int64(time.Since(uploadStartTime) / time.Millisecond)
+		ctx.zedcloudMetrics.RecordSuccess(log, ifname,
+			metricsURL, int64(config.Size), 0, uploadTime, false)
+		return nil
+	}
+	// we skip this error earlier but we must fill errStr
+	if errStr == "" {
+		errStr = logutils.NoSuitableAddrStr
+	}
+	return fmt.Errorf("All source IP addresses failed. All errors:%s", errStr)
+}
+
+// uploadPublishStatus publishes the progress of an upload
+type uploadPublishStatus struct {
+	ctx    *downloaderContext
+	status *types.UploaderStatus
+}
+
+// Progress report progress as a percentage of completeness
+// Returns true if there was a change to the recorded values
+func (u *uploadPublishStatus) Progress(p uint, currentSize, totalSize int64) bool {
+	if u.status.Progress == p && u.status.CurrentSize == currentSize {
+		return false
+	}
+	u.status.Progress = p
+	u.status.CurrentSize = currentSize
+	u.ctx.pubUploaderStatus.Publish(u.status.Key(), *u.status)
+	return true
+}
+
+func unpublishUploaderStatus(ctx *downloaderContext, key string) {
+	pub := ctx.pubUploaderStatus
+	log.Tracef("unpublishUploaderStatus(%s)", key)
+	st, _ := pub.Get(key)
+	if st == nil {
+		log.Errorf("unpublishUploaderStatus(%s) not found", key)
+		return
+	}
+	pub.Unpublish(key)
+}
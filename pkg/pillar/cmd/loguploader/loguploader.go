@@ -62,6 +62,7 @@ var (
 	contSentFailure     int64
 	dev4xxfile          resp4xxlogfile
 	app4xxfile          resp4xxlogfile
+	console4xxfile      resp4xxlogfile
 	appGzipMap          map[string]bool // current app gzip files counts with app-uuid as key
 )
 
@@ -387,10 +388,13 @@ func Run(ps *pubsub.PubSub, loggerArg *logrus.Logger, logArg *base.LogObject) in
 			numAppFile := doFetchSend(&loguploaderCtx, types.NewlogUploadAppDir, &iteration)
 			loguploaderCtx.metrics.AppMetrics.NumGzipFileInDir = uint32(numAppFile)
 
+			// Console recording upload
+			numConsoleFile := doFetchSendRecording(&loguploaderCtx, &iteration)
+
 			numLeftFiles = numDevFile + numAppFile
 			uploadTimer = time.NewTimer(time.Duration(loguploaderCtx.metrics.CurrUploadIntvSec) * time.Second)
-			log.Tracef("loguploader Run: time %v, timer fired, Dev/App files left in directories %d/%d",
-				time.Now(), numDevFile, numAppFile)
+			log.Tracef("loguploader Run: time %v, timer fired, Dev/App/Console files left in directories %d/%d/%d",
+				time.Now(), numDevFile, numAppFile, numConsoleFile)
 			if iteration > origIter {
 				metricsPub.Publish("global", loguploaderCtx.metrics)
 			}
@@ -669,6 +673,85 @@ func doFetchSend(ctx *loguploaderContext, zipDir string, iter *int) int {
 	return 0
 }
 
+// doFetchSendRecording uploads the oldest console recording, which is
+// removed once sent. Returns the number of recordings left.
+func doFetchSendRecording(ctx *loguploaderContext, iter *int) int {
+	files, err := ioutil.ReadDir(types.NewlogUploadConsoleDir)
+	if err != nil {
+		log.Tracef("doFetchSendRecording: can't read %s", types.NewlogUploadConsoleDir)
+		return 0
+	}
+	var gotFileName string
+	var appUUID, sessionID uuid.UUID
+	var fileTime time.Time
+	var numFiles int
+	for _, f := range files {
+		// Recordings in progress start with a dot
+		app, session, start, err := types.ParseConsoleRecordingName(f.Name())
+		if f.IsDir() || err != nil {
+			continue
+		}
+		numFiles++
+		if gotFileName == "" || start.Before(fileTime) {
+			gotFileName = f.Name()
+			appUUID, sessionID, fileTime = app, session, start
+		}
+	}
+	if gotFileName == "" {
+		return 0
+	}
+	recording := types.NewlogUploadConsoleDir + "/" + gotFileName
+	content, err := ioutil.ReadFile(recording)
+	if err != nil {
+		log.Errorf("doFetchSendRecording: %v", err)
+		return numFiles
+	}
+	var recordingURL string
+	if ctx.zedcloudCtx.V2API {
+		recordingURL = fmt.Sprintf("apps/instanceid/%s/consolerecordings/%s",
+			appUUID, sessionID)
+	} else {
+		// XXX temp support for adam controller
+		recordingURL = fmt.Sprintf("apps/instanceid/id/%s/consolerecordings/%s",
+			appUUID, sessionID)
+	}
+	url := zedcloud.URLPathString(ctx.serverNameAndPort, ctx.zedcloudCtx.V2API,
+		ctx.devUUID, recordingURL)
+	ctxWork, cancel := zedcloud.GetContextForAllIntfFunctions(ctx.zedcloudCtx)
+	defer cancel()
+	ctxWork = zedcloud.WithTrafficClass(ctxWork, types.TrafficClassLogs)
+	resp, _, _, err := zedcloud.SendOnAllIntf(ctxWork, ctx.zedcloudCtx, url,
+		int64(len(content)), bytes.NewBuffer(content), *iter, true)
+	*iter++
+	switch {
+	case resp != nil && resp.StatusCode == http.StatusOK:
+		log.Functionf("doFetchSendRecording: sent %s, %d bytes", gotFileName, len(content))
+		if err := os.Remove(recording); err != nil {
+			log.Errorf("doFetchSendRecording: %v", err)
+		}
+		return numFiles - 1
+	case resp != nil && isResp4xx(resp.StatusCode):
+		// Like the logs, keep it aside after too many attempts
+		if console4xxfile.logfileName != gotFileName {
+			console4xxfile.logfileName = gotFileName
+			console4xxfile.failureCnt = 0
+		}
+		console4xxfile.failureCnt++
+		if console4xxfile.failureCnt >= max4xxRetries {
+			console4xxfile.logfileName = ""
+			if err := os.MkdirAll(failSendDir, 0755); err != nil {
+				log.Errorf("doFetchSendRecording: %v", err)
+			} else if err := os.Rename(recording, failSendDir+"/"+gotFileName); err != nil {
+				log.Errorf("doFetchSendRecording: %v", err)
+			}
+		}
+		log.Errorf("doFetchSendRecording: %s got %s", gotFileName, resp.Status)
+	default:
+		log.Errorf("doFetchSendRecording: %s failed: %v", gotFileName, err)
+	}
+	return numFiles
+}
+
 func buildAppUUIDMap(fName string) {
 	var appUUID string
 	if strings.HasPrefix(fName, types.AppPrefix) && strings.HasSuffix(fName, ".gz") {
@@ -770,6 +853,7 @@ func sendToCloud(ctx *loguploaderContext, data []byte, iter int, fName string, f
 
 	ctxWork, cancel := zedcloud.GetContextForAllIntfFunctions(ctx.zedcloudCtx)
 	defer cancel()
+	ctxWork = zedcloud.WithTrafficClass(ctxWork, types.TrafficClassLogs)
 	// if resp statusOK, then sent success
 	// otherwise have to retry the same file later:
 	//  - if resp is nil, or it's 'StatusServiceUnavailable', mark as serviceUnavailabe
@@ -97,6 +97,7 @@ type nim struct {
 	pubWwanStatus            pubsub.Publication
 	pubWwanMetrics           pubsub.Publication
 	pubWwanLocationInfo      pubsub.Publication
+	pubWwanDataUsage         pubsub.Publication
 
 	// Metrics
 	zedcloudMetrics *zedcloud.AgentMetrics
@@ -182,6 +183,7 @@ func (n *nim) init() (err error) {
 		PubWwanStatus:            n.pubWwanStatus,
 		PubWwanMetrics:           n.pubWwanMetrics,
 		PubWwanLocationInfo:      n.pubWwanLocationInfo,
+		PubWwanDataUsage:         n.pubWwanDataUsage,
 		ZedcloudMetrics:          n.zedcloudMetrics,
 	}
 	return nil
@@ -474,6 +476,17 @@ func (n *nim) initPublications() (err error) {
 	if err != nil {
 		return err
 	}
+
+	// Data usage of SIM cards needs to survive reboots.
+	n.pubWwanDataUsage, err = n.PubSub.NewPublication(
+		pubsub.PublicationOptions{
+			AgentName:  agentName,
+			TopicType:  types.WwanDataUsage{},
+			Persistent: true,
+		})
+	if err != nil {
+		return err
+	}
 	return nil
 }
 
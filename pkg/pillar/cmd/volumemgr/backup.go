@@ -0,0 +1,714 @@
+// Copyright (c) 2022 Zededa, Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package volumemgr
+
+// Backups of volumes to datastores. A backup is taken by a worker into
+// VolumeBackupDirname/<volume key>, encrypted with the backup key of the
+// volume from the controller, and then uploaded by downloader object by
+// object. Any device which gets the same key can restore it. Files of the
+// manifest of the last successful backup are kept there to chain
+// incremental backups to it.
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"os"
+	"path"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/lf-edge/eve/pkg/pillar/cipher"
+	"github.com/lf-edge/eve/pkg/pillar/diskmetrics"
+	"github.com/lf-edge/eve/pkg/pillar/types"
+	"github.com/lf-edge/eve/pkg/pillar/volumebackup"
+	"github.com/lf-edge/eve/pkg/pillar/worker"
+	"github.com/lf-edge/eve/pkg/pillar/zfs"
+)
+
+const (
+	workBackup = "backup"
+	// how often we check if a backup is due
+	backupCheckInterval = time.Minute
+	// retry of scheduled backups which failed
+	backupRetryTime = 10 * time.Minute
+
+	backupLastFile    = "last.json"
+	backupPendingFile = "pending.json"
+	backupLiveCopy    = "live.qcow2"
+	backupCopy        = "copy.qcow2"
+)
+
+// savedBackup is a backup we took
+type savedBackup struct {
+	Counter        uint32 // VolumeBackupPolicy.Counter it was taken for
+	KeyID          string // Of the backup key, see backupKeyID
+	ManifestName   string
+	ManifestSha256 string
+	Manifest       volumebackup.Manifest
+	Objects        []types.VolumeBackupObject // Only while uploading
+}
+
+// volumeBackupWorkDescription is the work of taking a backup
+type volumeBackupWorkDescription struct {
+	status types.VolumeStatus
+	// Copy of the disk or snapshot of the zvol made by domainmgr, empty
+	// to read the volume or take the snapshot
+	liveCopy string
+	// Used for results
+	liveCopyNeeded bool
+	backup         savedBackup
+}
+
+func backupWorkKey(key string) string {
+	return workBackup + ":" + key
+}
+
+func backupDir(key string) string {
+	return filepath.Join(types.VolumeBackupDirname, key)
+}
+
+// backupKey decrypts the backup key of the volume from the controller
+func backupKey(ctx *volumemgrContext, status types.VolumeStatus) ([]byte, error) {
+	if !status.BackupKey.IsCipher {
+		if status.BackupKey.HasError() {
+			return nil, fmt.Errorf("invalid backup key: %s", status.BackupKey.Error)
+		}
+		return nil, errors.New("no backup key from the controller")
+	}
+	cipherStatus, decBlock, err := cipher.GetCipherCredentials(
+		&ctx.decryptCipherContext, status.BackupKey)
+	ctx.pubCipherBlockStatus.Publish(cipherStatus.Key(), cipherStatus)
+	if err != nil {
+		return nil, fmt.Errorf("cannot decrypt the backup key: %v", err)
+	}
+	if len(decBlock.BackupKey) != volumebackup.KeySize {
+		return nil, fmt.Errorf("backup key size %d, expected %d",
+			len(decBlock.BackupKey), volumebackup.KeySize)
+	}
+	return decBlock.BackupKey, nil
+}
+
+// backupKeyID identifies the backup key without revealing it, hence an
+// incremental backup is never chained to one taken with another key
+func backupKeyID(key []byte) string {
+	h := sha256.New()
+	h.Write([]byte("eve-volume-backup-key-id"))
+	h.Write(key)
+	return hex.EncodeToString(h.Sum(nil))
+}
+
+func unpublishBackupKeyStatus(ctx *volumemgrContext, status *types.VolumeStatus) {
+	key := status.BackupKey.Key()
+	if c, _ := ctx.pubCipherBlockStatus.Get(key); c == nil {
+		return
+	}
+	ctx.pubCipherBlockStatus.Unpublish(key)
+}
+
+func loadSavedBackup(filename string) (*savedBackup, error) {
+	b, err := ioutil.ReadFile(filename)
+	if err != nil {
+		return nil, err
+	}
+	var saved savedBackup
+	if err := json.Unmarshal(b, &saved); err != nil {
+		return nil, err
+	}
+	return &saved, nil
+}
+
+func writeSavedBackup(filename string, saved savedBackup) error {
+	b, err := json.Marshal(saved)
+	if err != nil {
+		return err
+	}
+	tmpFile := filename + ".tmp"
+	if err := ioutil.WriteFile(tmpFile, b, 0600); err != nil {
+		return err
+	}
+	return os.Rename(tmpFile, filename)
+}
+
+// AddWorkBackup adds a Work job to take a backup of a volume
+func AddWorkBackup(ctx *volumemgrContext, status *types.VolumeStatus, liveCopy string) {
+	d := volumeBackupWorkDescription{
+		status:   *status,
+		liveCopy: liveCopy,
+	}
+	w := worker.Work{Kind: workBackup, Key: backupWorkKey(status.Key()), Description: d}
+	// Don't fail on errors to make idempotent (Submit returns an error if
+	// the work was already submitted)
+	done, err := ctx.worker.TrySubmit(w)
+	if err != nil {
+		log.Errorf("TrySubmit %s failed: %s", w.Key, err)
+	} else if !done {
+		log.Fatalf("Failed to submit work due to queue length for %s",
+			w.Key)
+	}
+}
+
+// volumeBackupWorker implementation of work.WorkFunction that takes a backup
+func volumeBackupWorker(ctxPtr interface{}, w worker.Work) worker.WorkResult {
+	ctx := ctxPtr.(*volumemgrContext)
+	d := w.Description.(volumeBackupWorkDescription)
+	err := backupVolume(ctx, &d)
+	result := worker.WorkResult{
+		Key:         w.Key,
+		Description: d,
+	}
+	if err != nil {
+		result.Error = err
+		result.ErrorTime = time.Now()
+	}
+	return result
+}
+
+// backupVolume writes the encrypted part and manifest of a new backup
+// of the volume. Sets liveCopyNeeded if the disk is in use by a domain
+// and a copy from domainmgr is needed.
+func backupVolume(ctx *volumemgrContext, d *volumeBackupWorkDescription) error {
+	status := d.status
+	log.Functionf("backupVolume(%s) liveCopy %s", status.Key(), d.liveCopy)
+	key, err := backupKey(ctx, status)
+	if err != nil {
+		return err
+	}
+	keyID := backupKeyID(key)
+	dir := backupDir(status.Key())
+	if err := os.MkdirAll(dir, 0700); err != nil {
+		return err
+	}
+	var prev *volumebackup.Manifest
+	if saved, err := loadSavedBackup(filepath.Join(dir, backupLastFile)); err == nil &&
+		saved.KeyID == keyID {
+		prev = &saved.Manifest
+	}
+	now := time.Now()
+	baseName := volumebackup.BaseName(status.Key(), now)
+	part := volumebackup.Part{Name: baseName + volumebackup.PartSuffix}
+	partPath := filepath.Join(dir, path.Base(part.Name))
+	manifestName := baseName + volumebackup.ManifestSuffix
+	manifestPath := filepath.Join(dir, path.Base(manifestName))
+	var format, snapshot string
+	discard := func() {
+		os.Remove(partPath)
+		os.Remove(manifestPath)
+		if snapshot == "" {
+			return
+		}
+		if stdoutStderr, err := zfs.DestroyDataset(log, snapshot); err != nil {
+			log.Errorf("backupVolume(%s): cannot destroy snapshot: %v, output=%s",
+				status.Key(), err, stdoutStderr)
+		}
+	}
+	if ctx.persistType == types.PersistZFS {
+		format = volumebackup.FormatZFS
+		from := ""
+		if prev != nil && status.BackupPolicy.Incremental &&
+			prev.Format == format &&
+			prev.Incrementals() < status.BackupPolicy.IncrementalLimit() {
+			last := prev.Last().Snapshot
+			if snapshotExists(status.ZVolName(), last) {
+				from = status.ZVolName() + "@" + last
+			}
+		}
+		part.Incremental = from != ""
+		if d.liveCopy != "" {
+			snapshot = d.liveCopy
+			part.Snapshot = strings.TrimPrefix(snapshot, status.ZVolName()+"@")
+		} else {
+			part.Snapshot = volumebackup.SnapshotName(now)
+			snapshot = status.ZVolName() + "@" + part.Snapshot
+			if stdoutStderr, err := zfs.CreateSnapshot(log, snapshot); err != nil {
+				return fmt.Errorf("cannot create snapshot %s: %v, output=%s",
+					snapshot, err, stdoutStderr)
+			}
+		}
+		part.Sha256, part.Size, err = sendSnapshot(key, partPath, snapshot, from)
+		if err != nil {
+			discard()
+			return err
+		}
+	} else {
+		format = volumebackup.FormatQcow2
+		src := d.liveCopy
+		if src == "" {
+			src = filepath.Join(dir, backupCopy)
+			err := diskmetrics.ConvertImg(context.Background(), log,
+				status.FileLocation, src, volumebackup.FormatQcow2)
+			if err != nil {
+				os.Remove(src)
+				if strings.Contains(err.Error(), imageLockedErr) {
+					log.Noticef("backupVolume(%s): %s is in use, need a live copy",
+						status.Key(), status.FileLocation)
+					d.liveCopyNeeded = true
+					return nil
+				}
+				return err
+			}
+		}
+		defer os.Remove(src)
+		part.Sha256, part.Size, err = volumebackup.EncryptFile(key, partPath, src)
+		if err != nil {
+			discard()
+			return err
+		}
+	}
+	manifest, err := volumebackup.NewManifest(prev, status.Key(), format,
+		status.MaxVolSize, now, part)
+	if err != nil {
+		discard()
+		return err
+	}
+	manifestSha, manifestSize, err := volumebackup.WriteManifest(key, manifestPath, manifest)
+	if err != nil {
+		discard()
+		return err
+	}
+	d.backup = savedBackup{
+		Counter:        status.BackupPolicy.Counter,
+		KeyID:          keyID,
+		ManifestName:   manifestName,
+		ManifestSha256: manifestSha,
+		Manifest:       manifest,
+		Objects: []types.VolumeBackupObject{
+			{Name: part.Name, Path: partPath, Sha256: part.Sha256, Size: part.Size},
+			{Name: manifestName, Path: manifestPath, Sha256: manifestSha, Size: manifestSize},
+		},
+	}
+	// Cleaned up if we restart before the upload is done
+	if err := writeSavedBackup(filepath.Join(dir, backupPendingFile), d.backup); err != nil {
+		discard()
+		return err
+	}
+	log.Functionf("backupVolume(%s) done: %s", status.Key(), manifestName)
+	return nil
+}
+
+// sendSnapshot writes the zfs send stream of the snapshot encrypted to filename
+func sendSnapshot(key []byte, filename, snapshot, from string) (string, uint64, error) {
+	sendCtx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	pr, pw := io.Pipe()
+	sendDone := make(chan struct{})
+	go func() {
+		pw.CloseWithError(zfs.SendSnapshot(sendCtx, snapshot, from, pw))
+		close(sendDone)
+	}()
+	sha, size, err := volumebackup.EncryptToFile(key, filename, pr)
+	if err != nil {
+		// unblock and stop the sender
+		pr.CloseWithError(err)
+		cancel()
+		os.Remove(filename)
+	}
+	<-sendDone
+	return sha, size, err
+}
+
+func snapshotExists(dataset, snapshot string) bool {
+	if snapshot == "" {
+		return false
+	}
+	snapshots, err := zfs.GetSnapshots(log, dataset)
+	if err != nil {
+		log.Error(err)
+		return false
+	}
+	for _, s := range snapshots {
+		if s == dataset+"@"+snapshot {
+			return true
+		}
+	}
+	return false
+}
+
+// destroyBackupSnapshots destroys the snapshots of the zvol taken for
+// backups, but the one to keep
+func destroyBackupSnapshots(zVolName, keep string) {
+	snapshots, err := zfs.GetSnapshots(log, zVolName)
+	if err != nil {
+		log.Errorf("destroyBackupSnapshots(%s): %v", zVolName, err)
+		return
+	}
+	for _, snapshot := range snapshots {
+		name := strings.TrimPrefix(snapshot, zVolName+"@")
+		if name == keep || !volumebackup.IsSnapshotName(name) {
+			continue
+		}
+		if stdoutStderr, err := zfs.DestroyDataset(log, snapshot); err != nil {
+			log.Errorf("destroyBackupSnapshots: cannot destroy %s: %v, output=%s",
+				snapshot, err, stdoutStderr)
+		}
+	}
+}
+
+// processVolumeBackupResult handles the work result of a backup
+func processVolumeBackupResult(ctxPtr interface{}, res worker.WorkResult) error {
+	ctx := ctxPtr.(*volumemgrContext)
+	d := res.Description.(volumeBackupWorkDescription)
+	ctx.worker.Pop(res.Key)
+	status := lookupVolumeStatus(ctx, d.status.Key())
+	if status == nil {
+		log.Warnf("processVolumeBackupResult: no status for %s, discard the backup",
+			d.status.Key())
+		os.RemoveAll(backupDir(d.status.Key()))
+		return nil
+	}
+	if res.Error != nil {
+		backupFailed(ctx, status, res.Error.Error())
+		publishVolumeStatus(ctx, status)
+		return nil
+	}
+	if d.liveCopyNeeded {
+		status.Backup.LiveCopy = filepath.Join(backupDir(status.Key()), backupLiveCopy)
+		publishDiskBackupConfig(ctx, &types.DiskBackupConfig{
+			VolumeKey:    status.Key(),
+			FileLocation: status.FileLocation,
+			Target:       status.Backup.LiveCopy,
+		})
+		publishVolumeStatus(ctx, status)
+		return nil
+	}
+	log.Noticef("processVolumeBackupResult(%s): uploading %s",
+		status.Key(), d.backup.ManifestName)
+	status.Backup.State = types.VolumeBackupStateUploading
+	status.Backup.LiveCopy = ""
+	status.Backup.Progress = 0
+	status.Backup.Pending = d.backup.Objects
+	publishUploaderConfig(ctx, status)
+	publishVolumeStatus(ctx, status)
+	return nil
+}
+
+// checkVolumeBackups starts the backups which are due
+func checkVolumeBackups(ctx *volumemgrContext) {
+	for _, status := range getAllVolumeStatus(ctx) {
+		if maybeStartVolumeBackup(ctx, status) {
+			publishVolumeStatus(ctx, status)
+		}
+	}
+}
+
+// maybeStartVolumeBackup starts a backup of the volume if one was requested
+// by the controller or is due. Returns true if the status changed.
+func maybeStartVolumeBackup(ctx *volumemgrContext, status *types.VolumeStatus) bool {
+	changed := false
+	if status.Backup.State == types.VolumeBackupStateUnspecified {
+		loadVolumeBackupStatus(status)
+		changed = true
+	}
+	policy := status.BackupPolicy
+	if !policy.Enabled() || status.State != types.CREATED_VOLUME {
+		return changed
+	}
+	if status.Backup.State != types.VolumeBackupStateIdle &&
+		status.Backup.State != types.VolumeBackupStateFailed {
+		return changed
+	}
+	onDemand := policy.Counter != status.Backup.Counter
+	scheduled := policy.Interval != 0 && !time.Now().Before(status.Backup.NextTime)
+	if !onDemand && !scheduled {
+		return changed
+	}
+	status.Backup.Counter = policy.Counter
+	if status.IsContainer() {
+		backupFailed(ctx, status, "backups of container volumes are not supported")
+		return true
+	}
+	if status.Shared {
+		backupFailed(ctx, status, "backups of shared volumes are not supported")
+		return true
+	}
+	log.Noticef("maybeStartVolumeBackup(%s): on demand %t", status.Key(), onDemand)
+	status.Backup.State = types.VolumeBackupStateSnapshotting
+	status.Backup.Progress = 0
+	status.Backup.ClearError()
+	if ctx.persistType == types.PersistZFS {
+		// domainmgr has the guest agent of a running domain freeze the
+		// filesystems of the guest for the snapshot, or tells us that
+		// no domain is running with the zvol
+		status.Backup.LiveCopy = status.ZVolName() + "@" +
+			volumebackup.SnapshotName(time.Now())
+		publishDiskBackupConfig(ctx, &types.DiskBackupConfig{
+			VolumeKey:    status.Key(),
+			FileLocation: status.FileLocation,
+			Target:       status.Backup.LiveCopy,
+			Snapshot:     true,
+		})
+		return true
+	}
+	AddWorkBackup(ctx, status, "")
+	return true
+}
+
+// loadVolumeBackupStatus picks up the last backup from before a restart
+// and cleans up a backup which was not uploaded
+func loadVolumeBackupStatus(status *types.VolumeStatus) {
+	status.Backup.State = types.VolumeBackupStateIdle
+	dir := backupDir(status.Key())
+	if pending, err := loadSavedBackup(filepath.Join(dir, backupPendingFile)); err == nil {
+		log.Noticef("loadVolumeBackupStatus(%s): discard %s which was not uploaded",
+			status.Key(), pending.ManifestName)
+		cleanupPendingBackup(status.Key(), pending)
+	}
+	saved, err := loadSavedBackup(filepath.Join(dir, backupLastFile))
+	if err != nil {
+		if !os.IsNotExist(err) {
+			log.Errorf("loadVolumeBackupStatus(%s): %v", status.Key(), err)
+		}
+		return
+	}
+	setLastBackup(status, saved)
+}
+
+func setLastBackup(status *types.VolumeStatus, saved *savedBackup) {
+	last := saved.Manifest.Last()
+	status.Backup.Counter = saved.Counter
+	status.Backup.LastName = saved.ManifestName
+	status.Backup.LastSha256 = saved.ManifestSha256
+	status.Backup.LastTime = saved.Manifest.Time
+	status.Backup.LastIncremental = last.Incremental
+	status.Backup.LastSize = last.Size
+	status.Backup.NextTime = time.Time{}
+	if status.BackupPolicy.Interval != 0 {
+		status.Backup.NextTime = saved.Manifest.Time.Add(
+			time.Duration(status.BackupPolicy.Interval) * time.Second)
+	}
+}
+
+// cleanupPendingBackup removes the local files and the snapshot of
+// a backup which was not uploaded
+func cleanupPendingBackup(key string, pending *savedBackup) {
+	dir := backupDir(key)
+	for _, obj := range pending.Objects {
+		os.Remove(obj.Path)
+	}
+	os.Remove(filepath.Join(dir, backupPendingFile))
+	if snapshot := pending.Manifest.Last().Snapshot; snapshot != "" {
+		if zVolName, err := zVolNameByKey(key); err == nil {
+			dataset := zVolName + "@" + snapshot
+			if stdoutStderr, err := zfs.DestroyDataset(log, dataset); err != nil {
+				log.Errorf("cleanupPendingBackup: cannot destroy %s: %v, output=%s",
+					dataset, err, stdoutStderr)
+			}
+		}
+	}
+}
+
+// zVolNameByKey returns the zvol of the volume with the key if any
+func zVolNameByKey(key string) (string, error) {
+	for _, dataset := range []string{types.VolumeEncryptedZFSDataset, types.VolumeClearZFSDataset} {
+		zVolName := filepath.Join(dataset, key)
+		if _, err := zfs.GetDatasetOptions(log, zVolName); err == nil {
+			return zVolName, nil
+		}
+	}
+	return "", fmt.Errorf("no zvol for %s", key)
+}
+
+// backupFailed cleans up after a failed backup and schedules a retry
+func backupFailed(ctx *volumemgrContext, status *types.VolumeStatus, errStr string) {
+	log.Errorf("backup of %s failed: %s", status.Key(), errStr)
+	if pending, err := loadSavedBackup(filepath.Join(backupDir(status.Key()), backupPendingFile)); err == nil {
+		cleanupPendingBackup(status.Key(), pending)
+	}
+	os.Remove(filepath.Join(backupDir(status.Key()), backupLiveCopy))
+	unpublishUploaderConfig(ctx, status)
+	status.Backup.State = types.VolumeBackupStateFailed
+	status.Backup.LiveCopy = ""
+	status.Backup.Pending = nil
+	status.Backup.Progress = 0
+	status.Backup.NextTime = time.Time{}
+	if status.BackupPolicy.Interval != 0 {
+		status.Backup.NextTime = time.Now().Add(backupRetryTime)
+	}
+	status.Backup.SetErrorNow(errStr)
+}
+
+// backupUploaded finishes a backup once all its objects are uploaded
+func backupUploaded(status *types.VolumeStatus) error {
+	dir := backupDir(status.Key())
+	pendingFile := filepath.Join(dir, backupPendingFile)
+	saved, err := loadSavedBackup(pendingFile)
+	if err != nil {
+		return err
+	}
+	saved.Objects = nil
+	if err := writeSavedBackup(filepath.Join(dir, backupLastFile), *saved); err != nil {
+		return err
+	}
+	os.Remove(pendingFile)
+	if saved.Manifest.Format == volumebackup.FormatZFS {
+		// the next incremental backup is sent from the last snapshot
+		destroyBackupSnapshots(status.ZVolName(), saved.Manifest.Last().Snapshot)
+	}
+	log.Noticef("backupUploaded(%s): %s", status.Key(), saved.ManifestName)
+	setLastBackup(status, saved)
+	status.Backup.State = types.VolumeBackupStateIdle
+	status.Backup.Progress = 100
+	status.Backup.ClearError()
+	return nil
+}
+
+// cancelVolumeBackup stops backups of a volume which is deleted
+func cancelVolumeBackup(ctx *volumemgrContext, status *types.VolumeStatus) {
+	ctx.worker.Cancel(backupWorkKey(status.Key()))
+	unpublishUploaderConfig(ctx, status)
+	unpublishDiskBackupConfig(ctx, status.Key())
+	unpublishBackupKeyStatus(ctx, status)
+	status.Backup.Pending = nil
+	status.Backup.LiveCopy = ""
+	if err := os.RemoveAll(backupDir(status.Key())); err != nil {
+		log.Errorf("cancelVolumeBackup(%s): %v", status.Key(), err)
+	}
+}
+
+func publishUploaderConfig(ctx *volumemgrContext, status *types.VolumeStatus) {
+	if len(status.Backup.Pending) == 0 {
+		return
+	}
+	obj := status.Backup.Pending[0]
+	config := types.UploaderConfig{
+		Name:        obj.Name,
+		DatastoreID: status.BackupPolicy.DatastoreID,
+		Source:      obj.Path,
+		Size:        obj.Size,
+	}
+	log.Functionf("publishUploaderConfig(%s) for %s", config.Key(), status.Key())
+	ctx.pubUploaderConfig.Publish(config.Key(), config)
+}
+
+func unpublishUploaderConfig(ctx *volumemgrContext, status *types.VolumeStatus) {
+	if len(status.Backup.Pending) == 0 {
+		return
+	}
+	key := status.Backup.Pending[0].Name
+	if c, _ := ctx.pubUploaderConfig.Get(key); c == nil {
+		return
+	}
+	log.Functionf("unpublishUploaderConfig(%s) for %s", key, status.Key())
+	ctx.pubUploaderConfig.Unpublish(key)
+}
+
+func publishDiskBackupConfig(ctx *volumemgrContext, config *types.DiskBackupConfig) {
+	log.Functionf("publishDiskBackupConfig(%s)", config.Key())
+	ctx.pubDiskBackupConfig.Publish(config.Key(), *config)
+}
+
+func unpublishDiskBackupConfig(ctx *volumemgrContext, key string) {
+	if c, _ := ctx.pubDiskBackupConfig.Get(key); c == nil {
+		return
+	}
+	log.Functionf("unpublishDiskBackupConfig(%s)", key)
+	ctx.pubDiskBackupConfig.Unpublish(key)
+}
+
+func handleUploaderStatusCreate(ctxArg interface{}, key string,
+	statusArg interface{}) {
+	handleUploaderStatusImpl(ctxArg, key, statusArg)
+}
+
+func handleUploaderStatusModify(ctxArg interface{}, key string,
+	statusArg interface{}, oldStatusArg interface{}) {
+	handleUploaderStatusImpl(ctxArg, key, statusArg)
+}
+
+func handleUploaderStatusImpl(ctxArg interface{}, key string,
+	statusArg interface{}) {
+
+	ctx := ctxArg.(*volumemgrContext)
+	upload := statusArg.(types.UploaderStatus)
+	log.Functionf("handleUploaderStatusImpl(%s) progress %d done %t error %s",
+		key, upload.Progress, upload.Done, upload.Error)
+	status := lookupVolumeStatusByUpload(ctx, upload.Name)
+	if status == nil {
+		log.Functionf("handleUploaderStatusImpl(%s): no volume", key)
+		return
+	}
+	switch {
+	case upload.HasError():
+		backupFailed(ctx, status, fmt.Sprintf("upload of %s failed: %s",
+			upload.Name, upload.Error))
+	case upload.Done:
+		obj := status.Backup.Pending[0]
+		unpublishUploaderConfig(ctx, status)
+		os.Remove(obj.Path)
+		status.Backup.Pending = status.Backup.Pending[1:]
+		if len(status.Backup.Pending) != 0 {
+			status.Backup.Progress = 0
+			publishUploaderConfig(ctx, status)
+			break
+		}
+		if err := backupUploaded(status); err != nil {
+			backupFailed(ctx, status, err.Error())
+		}
+	default:
+		if status.Backup.Progress == upload.Progress {
+			return
+		}
+		status.Backup.Progress = upload.Progress
+	}
+	publishVolumeStatus(ctx, status)
+}
+
+func lookupVolumeStatusByUpload(ctx *volumemgrContext, name string) *types.VolumeStatus {
+	for _, status := range getAllVolumeStatus(ctx) {
+		if status.Backup.State == types.VolumeBackupStateUploading &&
+			len(status.Backup.Pending) != 0 &&
+			status.Backup.Pending[0].Name == name {
+			return status
+		}
+	}
+	return nil
+}
+
+func handleDiskBackupStatusCreate(ctxArg interface{}, key string,
+	statusArg interface{}) {
+	handleDiskBackupStatusImpl(ctxArg, key, statusArg)
+}
+
+func handleDiskBackupStatusModify(ctxArg interface{}, key string,
+	statusArg interface{}, oldStatusArg interface{}) {
+	handleDiskBackupStatusImpl(ctxArg, key, statusArg)
+}
+
+func handleDiskBackupStatusImpl(ctxArg interface{}, key string,
+	statusArg interface{}) {
+
+	ctx := ctxArg.(*volumemgrContext)
+	copyStatus := statusArg.(types.DiskBackupStatus)
+	log.Functionf("handleDiskBackupStatusImpl(%s) done %t not running %t error %s",
+		key, copyStatus.Done, copyStatus.NotRunning, copyStatus.Error)
+	if !copyStatus.Done && !copyStatus.NotRunning && !copyStatus.HasError() {
+		return
+	}
+	unpublishDiskBackupConfig(ctx, key)
+	status := lookupVolumeStatus(ctx, key)
+	if status == nil || status.Backup.LiveCopy == "" ||
+		status.Backup.LiveCopy != copyStatus.Target {
+		log.Functionf("handleDiskBackupStatusImpl(%s): not waiting for a copy", key)
+		return
+	}
+	switch {
+	case copyStatus.HasError():
+		backupFailed(ctx, status, fmt.Sprintf("copy of the disk failed: %s",
+			copyStatus.Error))
+	case copyStatus.Done:
+		AddWorkBackup(ctx, status, status.Backup.LiveCopy)
+	case copyStatus.NotRunning:
+		// no domain uses the disk, or it stopped in the meantime, read
+		// the volume again or take the snapshot ourselves
+		status.Backup.LiveCopy = ""
+		AddWorkBackup(ctx, status, "")
+	}
+	publishVolumeStatus(ctx, status)
+}
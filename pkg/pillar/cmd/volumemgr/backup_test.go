@@ -0,0 +1,142 @@
+// Copyright (c) 2022 Zededa, Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package volumemgr
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	zconfig "github.com/lf-edge/eve/api/go/config"
+	"github.com/lf-edge/eve/pkg/pillar/pubsub"
+	"github.com/lf-edge/eve/pkg/pillar/types"
+	"github.com/lf-edge/eve/pkg/pillar/volumebackup"
+	uuid "github.com/satori/go.uuid"
+	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSetLastBackup(t *testing.T) {
+	initStatusCtx(t)
+	taken := time.Date(2022, 6, 1, 12, 0, 0, 0, time.UTC)
+	saved := &savedBackup{
+		Counter:        3,
+		ManifestName:   "backup.manifest",
+		ManifestSha256: "abcd",
+		Manifest: volumebackup.Manifest{
+			Time: taken,
+			Parts: []volumebackup.Part{
+				{Name: "full", Size: 1000},
+				{Name: "incr", Size: 10, Incremental: true},
+			},
+		},
+	}
+	status := types.VolumeStatus{}
+	setLastBackup(&status, saved)
+	assert.Equal(t, uint32(3), status.Backup.Counter)
+	assert.Equal(t, "backup.manifest", status.Backup.LastName)
+	assert.Equal(t, taken, status.Backup.LastTime)
+	assert.True(t, status.Backup.LastIncremental)
+	assert.Equal(t, uint64(10), status.Backup.LastSize)
+	assert.True(t, status.Backup.NextTime.IsZero(), "on demand only")
+
+	status.BackupPolicy.Interval = 3600
+	setLastBackup(&status, saved)
+	assert.Equal(t, taken.Add(time.Hour), status.Backup.NextTime)
+}
+
+func TestMaybeStartVolumeBackup(t *testing.T) {
+	ctx := initStatusCtx(t)
+	ps := pubsub.New(&pubsub.EmptyDriver{}, logrus.StandardLogger(), log)
+	pubUploaderConfig, err := ps.NewPublication(pubsub.PublicationOptions{
+		AgentName: agentName,
+		TopicType: types.UploaderConfig{},
+	})
+	assert.Nil(t, err)
+	ctx.pubUploaderConfig = pubUploaderConfig
+
+	status := types.VolumeStatus{
+		VolumeID: uuid.FromStringOrNil("a5e5b8a3-1e52-4a7a-9d0b-6a1c3e5d7f21"),
+		State:    types.CREATED_VOLUME,
+		Backup: types.VolumeBackupStatus{
+			State: types.VolumeBackupStateIdle,
+		},
+	}
+	assert.False(t, maybeStartVolumeBackup(&ctx, &status), "backups not enabled")
+
+	status.BackupPolicy.DatastoreID = uuid.FromStringOrNil("7c1f6f0e-3b1d-4e0a-8c55-2f4b9d2e6a10")
+	status.BackupPolicy.Interval = 3600
+	status.Backup.NextTime = time.Now().Add(time.Hour)
+	assert.False(t, maybeStartVolumeBackup(&ctx, &status), "backup not due")
+
+	status.State = types.CREATING_VOLUME
+	status.BackupPolicy.Counter = 1
+	assert.False(t, maybeStartVolumeBackup(&ctx, &status), "volume not created")
+
+	status.State = types.CREATED_VOLUME
+	status.ContentFormat = zconfig.Format_CONTAINER
+	assert.True(t, maybeStartVolumeBackup(&ctx, &status))
+	assert.Equal(t, types.VolumeBackupStateFailed, status.Backup.State)
+	assert.Equal(t, uint32(1), status.Backup.Counter)
+	assert.True(t, status.Backup.HasError())
+	assert.False(t, status.Backup.NextTime.IsZero(), "retry of scheduled backups")
+
+	// the failed on demand backup is not retried before the next schedule
+	assert.False(t, maybeStartVolumeBackup(&ctx, &status))
+}
+
+func TestMaybeStartZVolBackup(t *testing.T) {
+	ctx := initStatusCtx(t)
+	ctx.persistType = types.PersistZFS
+	ps := pubsub.New(&pubsub.EmptyDriver{}, logrus.StandardLogger(), log)
+	pubDiskBackupConfig, err := ps.NewPublication(pubsub.PublicationOptions{
+		AgentName: agentName,
+		TopicType: types.DiskBackupConfig{},
+	})
+	assert.Nil(t, err)
+	ctx.pubDiskBackupConfig = pubDiskBackupConfig
+
+	status := types.VolumeStatus{
+		VolumeID:      uuid.FromStringOrNil("a5e5b8a3-1e52-4a7a-9d0b-6a1c3e5d7f21"),
+		State:         types.CREATED_VOLUME,
+		ContentFormat: zconfig.Format_RAW,
+		FileLocation:  "/dev/zvol/persist/vault/volumes/a5e5b8a3",
+		Backup: types.VolumeBackupStatus{
+			State: types.VolumeBackupStateIdle,
+		},
+	}
+	status.BackupPolicy.DatastoreID = uuid.FromStringOrNil("7c1f6f0e-3b1d-4e0a-8c55-2f4b9d2e6a10")
+	status.BackupPolicy.Counter = 1
+	assert.True(t, maybeStartVolumeBackup(&ctx, &status))
+	assert.Equal(t, types.VolumeBackupStateSnapshotting, status.Backup.State)
+
+	// domainmgr takes the snapshot in case a running domain uses the zvol
+	c, _ := pubDiskBackupConfig.Get(status.Key())
+	if assert.NotNil(t, c) {
+		config := c.(types.DiskBackupConfig)
+		assert.True(t, config.Snapshot)
+		assert.Equal(t, status.FileLocation, config.FileLocation)
+		assert.Equal(t, status.Backup.LiveCopy, config.Target)
+		assert.True(t, strings.HasPrefix(config.Target, status.ZVolName()+"@"))
+		assert.True(t, volumebackup.IsSnapshotName(
+			strings.TrimPrefix(config.Target, status.ZVolName()+"@")))
+	}
+}
+
+func TestBackupKey(t *testing.T) {
+	ctx := initStatusCtx(t)
+	status := types.VolumeStatus{}
+	_, err := backupKey(&ctx, status)
+	assert.Error(t, err, "no backup key")
+
+	status.BackupKey.SetErrorNow("block contains incomplete data")
+	_, err = backupKey(&ctx, status)
+	assert.Error(t, err, "invalid backup key")
+
+	key := make([]byte, volumebackup.KeySize)
+	otherKey := make([]byte, volumebackup.KeySize)
+	otherKey[0] = 1
+	assert.Equal(t, backupKeyID(key), backupKeyID(key))
+	assert.NotEqual(t, backupKeyID(key), backupKeyID(otherKey))
+}
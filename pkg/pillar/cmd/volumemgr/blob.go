@@ -70,6 +70,11 @@ func downloadBlob(ctx *volumemgrContext, blob *types.BlobStatus) bool {
 		blob.State = ds.State
 		changed = true
 	}
+	if !blob.DeferredUntil.Equal(ds.DeferredUntil) || blob.Rate != ds.Rate {
+		blob.DeferredUntil = ds.DeferredUntil
+		blob.Rate = ds.Rate
+		changed = true
+	}
 	if blob.TotalSize != ds.TotalSize ||
 		blob.CurrentSize != ds.CurrentSize ||
 		blob.Size != ds.Size {
@@ -116,6 +121,26 @@ func downloadBlob(ctx *volumemgrContext, blob *types.BlobStatus) bool {
 	return changed
 }
 
+// setBlobPrefetchBy sets the deadline of the prefetch of a blob of a
+// content tree. A blob which a content tree without a deadline shares is
+// downloaded at once. Returns true if the BlobStatus changed.
+func setBlobPrefetchBy(ctx *volumemgrContext, blob *types.BlobStatus, prefetchBy time.Time) bool {
+	if !blob.HasDownloaderRef {
+		if blob.PrefetchBy.Equal(prefetchBy) {
+			return false
+		}
+		blob.PrefetchBy = prefetchBy
+		return true
+	}
+	if blob.PrefetchBy.IsZero() || !prefetchBy.IsZero() {
+		return false
+	}
+	log.Functionf("setBlobPrefetchBy(%s): downloading at once", blob.Sha256)
+	blob.PrefetchBy = time.Time{}
+	updateDownloaderPrefetchBy(ctx, blob.Sha256, blob.PrefetchBy)
+	return true
+}
+
 //AddRefToBlobStatus adds the refObject as an reference to the blobs in the given blob list.
 func AddRefToBlobStatus(ctx *volumemgrContext, blobStatus ...*types.BlobStatus) {
 	for _, blob := range blobStatus {
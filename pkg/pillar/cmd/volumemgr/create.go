@@ -8,10 +8,12 @@ import (
 	"errors"
 	"fmt"
 	"os"
+	"path/filepath"
 	"strings"
 	"time"
 
 	"github.com/lf-edge/edge-containers/pkg/registry"
+	zconfig "github.com/lf-edge/eve/api/go/config"
 	"github.com/lf-edge/eve/pkg/pillar/cas"
 	"github.com/lf-edge/eve/pkg/pillar/diskmetrics"
 	"github.com/lf-edge/eve/pkg/pillar/tgt"
@@ -24,14 +26,48 @@ import (
 // new values for VolumeCreated, FileLocation, and error
 func createVolume(ctx *volumemgrContext, status types.VolumeStatus) (bool, string, error) {
 
+	if status.KeyPolicy.OwnKey {
+		if err := checkVolumeKeyPolicy(ctx, status); err != nil {
+			log.Error(err)
+			return false, "", err
+		}
+	}
+	if status.Filesystem.IsSet() {
+		if err := checkVolumeFilesystem(status); err != nil {
+			log.Error(err)
+			return false, "", err
+		}
+	}
+	if status.Shared {
+		return createSharedVolume(ctx, status)
+	}
 	if status.IsContainer() {
 		log.Functionf("createVolume(%s) from container %s", status.Key(), status.ReferenceName)
 		return createContainerVolume(ctx, status, status.ReferenceName)
 	}
+	if status.VolumeContentOriginType == zconfig.VolumeContentOriginType_VCOT_BACKUP {
+		return createVolumeFromBackup(ctx, status)
+	}
 	log.Functionf("createVolume(%s) from disk %s", status.Key(), status.ReferenceName)
 	return createVdiskVolume(ctx, status, status.ReferenceName)
 }
 
+// checkVolumeFilesystem returns an error if the volume cannot be formatted
+func checkVolumeFilesystem(status types.VolumeStatus) error {
+	switch {
+	case status.VolumeContentOriginType != zconfig.VolumeContentOriginType_VCOT_BLANK:
+		return fmt.Errorf("only blank volumes can be formatted, %s is not",
+			status.DisplayName)
+	case status.Shared:
+		return fmt.Errorf("shared volume %s is a directory and cannot be formatted",
+			status.DisplayName)
+	case status.ContentFormat != zconfig.Format_RAW:
+		return fmt.Errorf("volume %s of format %s cannot be formatted",
+			status.DisplayName, status.ContentFormat)
+	}
+	return nil
+}
+
 // createVdiskVolume does not update status but returns
 // new values for VolumeCreated, FileLocation, and error
 func createVdiskVolume(ctx *volumemgrContext, status types.VolumeStatus,
@@ -126,8 +162,24 @@ func createVdiskVolume(ctx *volumemgrContext, status types.VolumeStatus,
 				return created, zVolDevice, errors.New(errStr)
 			}
 		}
+		if status.Filesystem.IsSet() {
+			if err := diskmetrics.CreateFilesystem(createContext, log, zVolDevice, status.Filesystem); err != nil {
+				errStr := fmt.Sprintf("Error formatting zfs zvol %s: %v",
+					zVolDevice, err)
+				log.Error(errStr)
+				return created, zVolDevice, errors.New(errStr)
+			}
+		}
 		filelocation = zVolDevice
 	default:
+		if status.KeyPolicy.OwnKey {
+			if err := createKeyedDir(status); err != nil {
+				errStr := fmt.Sprintf("Error creating encrypted directory for %s: %v",
+					status.Key(), err)
+				log.Error(errStr)
+				return created, "", errors.New(errStr)
+			}
+		}
 		if ref != "" {
 			// use the edge-containers library to extract the data we need
 			puller := registry.Puller{
@@ -178,6 +230,14 @@ func createVdiskVolume(ctx *volumemgrContext, status types.VolumeStatus,
 				log.Error(err)
 				return created, filelocation, err
 			}
+			if status.Filesystem.IsSet() {
+				if err := diskmetrics.CreateFilesystem(createContext, log, filelocation, status.Filesystem); err != nil {
+					errStr := fmt.Sprintf("Error formatting volume %s: %v",
+						filelocation, err)
+					log.Error(errStr)
+					return created, filelocation, errors.New(errStr)
+				}
+			}
 			f, err := os.Open(filelocation)
 			if err != nil {
 				errStr := fmt.Sprintf("Error opening volume %s: %v",
@@ -260,6 +320,12 @@ func destroyVolume(ctx *volumemgrContext, status types.VolumeStatus) (bool, stri
 		return false, "", nil
 	}
 
+	if status.Shared {
+		return destroySharedVolume(ctx, status)
+	}
+	if status.KeyPolicy.OwnKey {
+		return destroyKeyedVolume(ctx, status)
+	}
 	if status.IsContainer() {
 		return destroyContainerVolume(ctx, status)
 	} else {
@@ -282,25 +348,9 @@ func destroyVdiskVolume(ctx *volumemgrContext, status types.VolumeStatus) (bool,
 		return created, "", errors.New(errStr)
 	}
 	if info.Mode()&os.ModeDevice != 0 {
-		if ctx.useVHost {
-			if err := tgt.VHostDeleteIBlock(status.WWN); err != nil {
-				errStr := fmt.Sprintf("Error deleting vhost for %s, error=%v",
-					status.Key(), err)
-				log.Error(errStr)
-			}
-			if err := tgt.TargetDeleteIBlock(status.Key()); err != nil {
-				errStr := fmt.Sprintf("Error deleting target for %s, error=%v",
-					status.Key(), err)
-				log.Error(errStr)
-			}
-		}
 		//Assume this is zfs device
-		zVolName := status.ZVolName()
-		if stdoutStderr, err := zfs.DestroyDataset(log, zVolName); err != nil {
-			errStr := fmt.Sprintf("Error destroying zfs zvol at %s, error=%v, output=%s",
-				zVolName, err, stdoutStderr)
-			log.Error(errStr)
-			return created, "", errors.New(errStr)
+		if err := destroyZVol(ctx, status); err != nil {
+			return created, "", err
 		}
 	} else {
 		if err := os.RemoveAll(filelocation); err != nil {
@@ -314,6 +364,32 @@ func destroyVdiskVolume(ctx *volumemgrContext, status types.VolumeStatus) (bool,
 	return created, filelocation, nil
 }
 
+// destroyZVol removes the vhost of the zvol of the volume and destroys it
+// along with its backup snapshots
+func destroyZVol(ctx *volumemgrContext, status types.VolumeStatus) error {
+	if ctx.useVHost {
+		if err := tgt.VHostDeleteIBlock(status.WWN); err != nil {
+			errStr := fmt.Sprintf("Error deleting vhost for %s, error=%v",
+				status.Key(), err)
+			log.Error(errStr)
+		}
+		if err := tgt.TargetDeleteIBlock(status.Key()); err != nil {
+			errStr := fmt.Sprintf("Error deleting target for %s, error=%v",
+				status.Key(), err)
+			log.Error(errStr)
+		}
+	}
+	zVolName := status.ZVolName()
+	destroyBackupSnapshots(zVolName, "")
+	if stdoutStderr, err := zfs.DestroyDataset(log, zVolName); err != nil {
+		errStr := fmt.Sprintf("Error destroying zfs zvol at %s, error=%v, output=%s",
+			zVolName, err, stdoutStderr)
+		log.Error(errStr)
+		return errors.New(errStr)
+	}
+	return nil
+}
+
 // destroyContainerVolume does not update status but returns
 // new values for VolumeCreated, FileLocation, and error
 func destroyContainerVolume(ctx *volumemgrContext, status types.VolumeStatus) (bool, string, error) {
@@ -330,6 +406,38 @@ func destroyContainerVolume(ctx *volumemgrContext, status types.VolumeStatus) (b
 	return created, filelocation, nil
 }
 
+// createSharedVolume does not update status but returns
+// new values for VolumeCreated, FileLocation, and error
+func createSharedVolume(ctx *volumemgrContext, status types.VolumeStatus) (bool, string, error) {
+
+	filelocation := status.PathName()
+	log.Functionf("createSharedVolume(%s) at %s", status.Key(), filelocation)
+	if err := os.MkdirAll(filelocation, 0755); err != nil {
+		return false, "", fmt.Errorf("cannot create shared volume %s: %v",
+			filelocation, err)
+	}
+	if err := utils.DirSync(filepath.Dir(filelocation)); err != nil {
+		log.Errorf("Failed to sync directory. Error %s", err)
+		return false, filelocation, err
+	}
+	log.Functionf("createSharedVolume(%s) DONE", status.Key())
+	return true, filelocation, nil
+}
+
+// destroySharedVolume does not update status but returns
+// new values for VolumeCreated, FileLocation, and error
+func destroySharedVolume(ctx *volumemgrContext, status types.VolumeStatus) (bool, string, error) {
+
+	created := status.SubState == types.VolumeSubStateCreated
+	filelocation := status.FileLocation
+	log.Functionf("Removing shared volume %s", filelocation)
+	if err := os.RemoveAll(filelocation); err != nil {
+		return created, filelocation, err
+	}
+	log.Functionf("destroySharedVolume(%s) DONE", status.Key())
+	return false, "", nil
+}
+
 // returns size and indicates do we need to resize disk to be at least maxsizebytes
 func checkResizeDisk(diskfile string, maxsizebytes uint64) (uint64, bool, error) {
 	vSize, err := diskmetrics.GetDiskVirtualSize(log, diskfile)
@@ -23,6 +23,7 @@ func initializeDirs() {
 	volumeDirs := []string{
 		types.VolumeEncryptedDirName,
 		types.VolumeClearDirName,
+		types.VolumeKeyedDirName,
 	}
 	for _, dirName := range volumeDirs {
 		if _, err := os.Stat(dirName); err != nil {
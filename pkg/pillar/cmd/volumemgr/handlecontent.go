@@ -35,6 +35,17 @@ func handleContentTreeModify(ctxArg interface{}, key string,
 	if status == nil {
 		log.Fatalf("Missing ContentTreeStatus for %s", config.Key())
 	}
+	changed := false
+	if !status.Schedule.Equal(config.Schedule) {
+		status.Schedule = config.Schedule
+		changed = true
+	}
+	if updateSignaturePolicy(status, config.SignaturePolicy) {
+		changed = true
+	}
+	if changed {
+		publishContentTreeStatus(ctx, status)
+	}
 	updateContentTree(ctx, status)
 	log.Functionf("handleContentTree(%s) Done", key)
 }
@@ -159,6 +170,8 @@ func createContentTreeStatus(ctx *volumemgrContext, config types.ContentTreeConf
 			MaxDownloadSize:   config.MaxDownloadSize,
 			GenerationCounter: config.GenerationCounter,
 			DisplayName:       config.DisplayName,
+			Schedule:          config.Schedule,
+			SignaturePolicy:   config.SignaturePolicy,
 			State:             types.INITIAL,
 			Blobs:             []string{},
 			// LastRefCountChangeTime: time.Now(),
@@ -8,6 +8,7 @@ import (
 	"encoding/hex"
 	"path"
 	"strconv"
+	"time"
 
 	"github.com/lf-edge/eve/pkg/pillar/types"
 )
@@ -62,12 +63,24 @@ func AddOrRefcountDownloaderConfig(ctx *volumemgrContext, blob types.BlobStatus)
 		Size:        size,
 		Target:      locFilename,
 		RefCount:    refCount,
+		PrefetchBy:  blob.PrefetchBy,
 	}
 	log.Functionf("AddOrRefcountDownloaderConfig: DownloaderConfig: %+v", n)
 	publishDownloaderConfig(ctx, &n)
 	log.Functionf("AddOrRefcountDownloaderConfig done for %s", blob.Sha256)
 }
 
+// updateDownloaderPrefetchBy changes the deadline of the prefetch of an
+// object which is being downloaded
+func updateDownloaderPrefetchBy(ctx *volumemgrContext, imageSha string, prefetchBy time.Time) {
+	m := lookupDownloaderConfig(ctx, imageSha)
+	if m == nil || m.PrefetchBy.Equal(prefetchBy) {
+		return
+	}
+	m.PrefetchBy = prefetchBy
+	publishDownloaderConfig(ctx, m)
+}
+
 // MaybeRemoveDownloaderConfig decrements Refcount of the given DownloaderConfig.
 // If the Refcount of a DownloaderConfig reaches zero, the following sequence of handshake is performed
 // before deleting DownloaderConfig:
@@ -170,6 +183,7 @@ func handleDownloaderStatusImpl(ctxArg interface{}, key string,
 
 	// Normal update case
 	updateStatusByBlob(ctx, status.ImageSha256)
+	updateVolumeStatusByBackupSha(ctx, status.ImageSha256)
 	log.Functionf("handleDownloaderStatusImpl done for %s", status.ImageSha256)
 }
 
@@ -207,6 +221,7 @@ func handleDownloaderStatusDelete(ctxArg interface{}, key string,
 	ctx := ctxArg.(*volumemgrContext)
 	status := statusArg.(types.DownloaderStatus)
 	updateStatusByBlob(ctx, status.ImageSha256)
+	updateVolumeStatusByBackupSha(ctx, status.ImageSha256)
 	// If we still publish a config with RefCount == 0 we delete it.
 	config := lookupDownloaderConfig(ctx, status.ImageSha256)
 	if config != nil && config.RefCount == 0 {
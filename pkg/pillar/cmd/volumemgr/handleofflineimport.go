@@ -0,0 +1,216 @@
+// Copyright (c) 2022 Zededa, Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+// Load blobs from an offline bundle, e.g., on a USB stick, into the CAS
+
+package volumemgr
+
+import (
+	"strings"
+	"time"
+
+	"github.com/lf-edge/eve/pkg/pillar/types"
+	"github.com/lf-edge/eve/pkg/pillar/worker"
+)
+
+const (
+	workOfflineImport = "offlineimport"
+
+	// offlineImportRefPrefix is the prefix of the image references we use
+	// to keep the imported blobs in the CAS until the content trees which
+	// use them are loaded. The references have no ContentTreeStatus hence
+	// gcImagesFromCAS removes them after the next boot.
+	offlineImportRefPrefix = "offline-import-"
+)
+
+// offlineImportWorkDescription offline blob import work we feed into the worker go routine
+type offlineImportWorkDescription struct {
+	config types.OfflineBlobImportConfig
+	// used for results
+	size int64
+}
+
+func offlineImportReference(sha256 string) string {
+	return offlineImportRefPrefix + sha256
+}
+
+func handleOfflineBlobImportCreate(ctxArg interface{}, key string,
+	configArg interface{}) {
+
+	ctx := ctxArg.(*volumemgrContext)
+	config := configArg.(types.OfflineBlobImportConfig)
+	log.Functionf("handleOfflineBlobImportCreate(%s) from %s", key, config.Path)
+	AddWorkOfflineImport(ctx, &config)
+}
+
+func handleOfflineBlobImportModify(ctxArg interface{}, key string,
+	configArg interface{}, oldConfigArg interface{}) {
+
+	ctx := ctxArg.(*volumemgrContext)
+	config := configArg.(types.OfflineBlobImportConfig)
+	log.Functionf("handleOfflineBlobImportModify(%s) from %s", key, config.Path)
+	status := lookupOfflineBlobImportStatus(ctx, key)
+	if status != nil && status.Loaded {
+		if status.BundleHash != config.BundleHash {
+			status.BundleHash = config.BundleHash
+			publishOfflineBlobImportStatus(ctx, status)
+		}
+		return
+	}
+	// Retry e.g. after the bundle was mounted again
+	AddWorkOfflineImport(ctx, &config)
+}
+
+func handleOfflineBlobImportDelete(ctxArg interface{}, key string,
+	configArg interface{}) {
+
+	ctx := ctxArg.(*volumemgrContext)
+	log.Functionf("handleOfflineBlobImportDelete(%s)", key)
+	ctx.worker.Cancel(offlineImportReference(key))
+	status := lookupOfflineBlobImportStatus(ctx, key)
+	if status != nil {
+		unpublishOfflineBlobImportStatus(ctx, status)
+	}
+}
+
+// AddWorkOfflineImport adds a Work job to load a blob from an offline bundle
+func AddWorkOfflineImport(ctx *volumemgrContext, config *types.OfflineBlobImportConfig) {
+	d := offlineImportWorkDescription{
+		config: *config,
+	}
+	key := offlineImportReference(config.Key())
+	w := worker.Work{Kind: workOfflineImport, Key: key, Description: d}
+	// Don't fail on errors to make idempotent (Submit returns an error if
+	// the work was already submitted)
+	done, err := ctx.worker.TrySubmit(w)
+	if err != nil {
+		log.Errorf("TrySubmit %s failed: %s", key, err)
+	} else if !done {
+		log.Fatalf("Failed to submit work due to queue length for %s", key)
+	}
+}
+
+// offlineImportWorker implementation of work.WorkFunction that loads a blob
+// into the CAS and references it so that it is not garbage collected
+func offlineImportWorker(ctxPtr interface{}, w worker.Work) worker.WorkResult {
+	ctx := ctxPtr.(*volumemgrContext)
+	d := w.Description.(offlineImportWorkDescription)
+	config := d.config
+
+	blob := types.BlobStatus{
+		Sha256:    strings.ToLower(config.Sha256),
+		Size:      config.Size,
+		MediaType: config.MediaType,
+		Path:      config.Path,
+	}
+	blobHash := checkAndCorrectBlobHash(blob.Sha256)
+	if ctx.casClient.CheckBlobExists(blobHash) {
+		log.Functionf("offlineImportWorker(%s): already in CAS", blob.Sha256)
+		blob.State = types.LOADED
+	}
+	_, err := ctx.casClient.IngestBlobsAndCreateImage(
+		offlineImportReference(blob.Sha256), blob, blob)
+	if err == nil {
+		info, err2 := ctx.casClient.GetBlobInfo(blobHash)
+		if err2 != nil {
+			err = err2
+		} else {
+			d.size = info.Size
+		}
+	}
+	result := worker.WorkResult{
+		Key:         w.Key,
+		Description: d,
+	}
+	if err != nil {
+		result.Error = err
+		result.ErrorTime = time.Now()
+	}
+	return result
+}
+
+// processOfflineImportWorkResult handle the work result of an offline blob import
+func processOfflineImportWorkResult(ctxPtr interface{}, res worker.WorkResult) error {
+	ctx := ctxPtr.(*volumemgrContext)
+	d := res.Description.(offlineImportWorkDescription)
+	// Nobody else waits for the result
+	ctx.worker.Pop(res.Key)
+	config := lookupOfflineBlobImportConfig(ctx, d.config.Key())
+	if config == nil {
+		log.Warnf("processOfflineImportWorkResult(%s): config is gone",
+			d.config.Key())
+		return nil
+	}
+	status := types.OfflineBlobImportStatus{
+		Sha256:     config.Sha256,
+		BundleHash: config.BundleHash,
+	}
+	if res.Error != nil {
+		log.Errorf("processOfflineImportWorkResult(%s): %v",
+			config.Sha256, res.Error)
+		status.SetError(res.Error.Error(), res.ErrorTime)
+		publishOfflineBlobImportStatus(ctx, &status)
+		return nil
+	}
+	sha := strings.ToLower(config.Sha256)
+	if blob := lookupBlobStatus(ctx, sha); blob == nil {
+		publishBlobStatus(ctx, &types.BlobStatus{
+			Sha256:                 sha,
+			Size:                   uint64(d.size),
+			State:                  types.LOADED,
+			MediaType:              config.MediaType,
+			TotalSize:              d.size,
+			CurrentSize:            d.size,
+			Progress:               100,
+			LastRefCountChangeTime: time.Now(),
+			CreateTime:             time.Now(),
+		})
+	} else if blob.State != types.LOADED {
+		log.Warnf("processOfflineImportWorkResult(%s): BlobStatus exists in state %s",
+			sha, blob.State)
+	}
+	status.Loaded = true
+	status.LoadTime = time.Now()
+	publishOfflineBlobImportStatus(ctx, &status)
+	return nil
+}
+
+func lookupOfflineBlobImportConfig(ctx *volumemgrContext,
+	key string) *types.OfflineBlobImportConfig {
+
+	c, _ := ctx.subOfflineBlobImportConfig.Get(key)
+	if c == nil {
+		return nil
+	}
+	config := c.(types.OfflineBlobImportConfig)
+	return &config
+}
+
+func lookupOfflineBlobImportStatus(ctx *volumemgrContext,
+	key string) *types.OfflineBlobImportStatus {
+
+	s, _ := ctx.pubOfflineBlobImportStatus.Get(key)
+	if s == nil {
+		return nil
+	}
+	status := s.(types.OfflineBlobImportStatus)
+	return &status
+}
+
+func publishOfflineBlobImportStatus(ctx *volumemgrContext,
+	status *types.OfflineBlobImportStatus) {
+
+	key := status.Key()
+	log.Tracef("publishOfflineBlobImportStatus(%s)", key)
+	pub := ctx.pubOfflineBlobImportStatus
+	pub.Publish(key, *status)
+}
+
+func unpublishOfflineBlobImportStatus(ctx *volumemgrContext,
+	status *types.OfflineBlobImportStatus) {
+
+	key := status.Key()
+	log.Tracef("unpublishOfflineBlobImportStatus(%s)", key)
+	pub := ctx.pubOfflineBlobImportStatus
+	pub.Unpublish(key)
+}
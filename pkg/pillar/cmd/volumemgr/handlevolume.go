@@ -8,6 +8,7 @@ import (
 	"os"
 	"time"
 
+	"github.com/google/go-cmp/cmp"
 	"github.com/lf-edge/eve/pkg/pillar/tgt"
 	"github.com/lf-edge/eve/pkg/pillar/types"
 	"github.com/lf-edge/eve/pkg/pillar/utils"
@@ -57,11 +58,40 @@ func handleVolumeModify(ctxArg interface{}, key string,
 				status.DisplayName, config.DisplayName, config.VolumeID)
 			status.DisplayName = config.DisplayName
 		}
-		if config.RefCount != status.RefCount {
-			log.Functionf("RefCount changed from %d to %d for %s",
-				status.RefCount, config.RefCount, config.DisplayName)
-			status.RefCount = config.RefCount
-			status.LastRefCountChangeTime = time.Now()
+		if grown, err := growVolume(ctx, status, config.MaxVolSize); err != nil {
+			log.Errorf("handleVolumeModify(%s) failed: %s", status.Key(), err)
+			status.SetErrorWithSource(err.Error(), types.VolumeStatus{}, time.Now())
+		} else if grown && status.IsErrorSource(types.VolumeStatus{}) {
+			status.ClearErrorWithSource()
+		}
+		if config.IOLimits != status.IOLimits {
+			log.Noticef("IOLimits changed from %+v to %+v for %s",
+				status.IOLimits, config.IOLimits, config.DisplayName)
+			status.IOLimits = config.IOLimits
+		}
+		if config.KeyPolicy.RotateCounter != status.KeyPolicy.RotateCounter {
+			log.Noticef("KeyPolicy.RotateCounter changed from %d to %d for %s",
+				status.KeyPolicy.RotateCounter, config.KeyPolicy.RotateCounter,
+				config.DisplayName)
+			status.KeyPolicy = config.KeyPolicy
+			maybeRotateVolumeKey(ctx, status)
+		}
+		if !cmp.Equal(config.BackupKey, status.BackupKey) {
+			log.Noticef("BackupKey changed for %s", config.DisplayName)
+			status.BackupKey = config.BackupKey
+		}
+		if config.BackupPolicy != status.BackupPolicy {
+			log.Functionf("BackupPolicy changed from %+v to %+v for %s",
+				status.BackupPolicy, config.BackupPolicy, config.DisplayName)
+			if config.BackupPolicy.Interval != status.BackupPolicy.Interval {
+				status.Backup.NextTime = time.Time{}
+				if config.BackupPolicy.Interval != 0 {
+					status.Backup.NextTime = status.Backup.LastTime.Add(
+						time.Duration(config.BackupPolicy.Interval) * time.Second)
+				}
+			}
+			status.BackupPolicy = config.BackupPolicy
+			maybeStartVolumeBackup(ctx, status)
 		}
 		updateVolumeStatusRefCount(ctx, status)
 		publishVolumeStatus(ctx, status)
@@ -111,6 +141,13 @@ func handleDeferredVolumeCreate(ctx *volumemgrContext, key string, config *types
 		LocalGenerationCounter:  config.LocalGenerationCounter,
 		Encrypted:               config.Encrypted,
 		DisplayName:             config.DisplayName,
+		BackupPolicy:            config.BackupPolicy,
+		BackupRef:               config.BackupRef,
+		BackupKey:               config.BackupKey,
+		Shared:                  config.Shared,
+		IOLimits:                config.IOLimits,
+		KeyPolicy:               config.KeyPolicy,
+		Filesystem:              config.Filesystem,
 		RefCount:                config.RefCount,
 		LastRefCountChangeTime:  time.Now(),
 		LastUse:                 time.Now(),
@@ -118,14 +155,26 @@ func handleDeferredVolumeCreate(ctx *volumemgrContext, key string, config *types
 	}
 	updateVolumeStatusRefCount(ctx, status)
 	status.ContentFormat = volumeFormat[status.Key()]
+	if status.Shared {
+		status.ContentFormat = blankVolumeFormat
+	}
+	// an existing volume uses the current key and a new one gets it
+	status.DataKey.RotateCounter = status.KeyPolicy.RotateCounter
 
 	created := false
 
 	persistFsType := ctx.persistType
 
-	if persistFsType == types.PersistZFS && !status.IsContainer() {
+	if persistFsType == types.PersistZFS && !status.IsContainer() && !status.Shared {
 		zvolName := status.ZVolName()
 		if _, err := zfs.GetDatasetOptions(log, zvolName); err == nil {
+			if status.KeyPolicy.OwnKey {
+				// the device of the zvol shows up once the key is loaded
+				if err := unlockVolume(ctx, status); err != nil {
+					log.Errorf("handleDeferredVolumeCreate(%s): %v", status.Key(), err)
+					status.DataKey.SetErrorNow(err.Error())
+				}
+			}
 			zVolDevice := zfs.GetZVolDeviceByDataset(zvolName)
 			if zVolDevice == "" {
 				errStr := fmt.Sprintf("cannot find device for zvol %s of %s", zvolName, status.Key())
@@ -158,6 +207,14 @@ func handleDeferredVolumeCreate(ctx *volumemgrContext, key string, config *types
 			}
 		}
 	} else {
+		if status.KeyPolicy.OwnKey {
+			if _, err := os.Stat(types.VolumeKeyedDir(status.Key())); err == nil {
+				if err := unlockVolume(ctx, status); err != nil {
+					log.Errorf("handleDeferredVolumeCreate(%s): %v", status.Key(), err)
+					status.DataKey.SetErrorNow(err.Error())
+				}
+			}
+		}
 		if _, err := os.Stat(status.PathName()); err == nil {
 			created = true
 			status.FileLocation = status.PathName()
@@ -190,30 +247,15 @@ func handleDeferredVolumeCreate(ctx *volumemgrContext, key string, config *types
 		return
 	}
 	publishVolumeStatus(ctx, status)
-	if !ctx.globalConfig.GlobalValueBool(types.IgnoreDiskCheckForApps) {
-		// Check disk usage
-		remaining, err := getRemainingDiskSpace(ctx)
-		if err != nil {
-			errStr := fmt.Sprintf("getRemainingDiskSpace failed: %s\n",
-				err)
-			status.SetError(errStr, time.Now())
-			publishVolumeStatus(ctx, status)
-			updateVolumeRefStatus(ctx, status)
-			if err := createOrUpdateAppDiskMetrics(ctx, status); err != nil {
-				log.Errorf("handleDeferredVolumeCreate(%s): exception while publishing diskmetric. %s", key, err.Error())
-			}
-			return
-		} else if remaining < status.MaxVolSize {
-			errStr := fmt.Sprintf("Remaining disk space %d volume needs %d\n",
-				remaining, status.MaxVolSize)
-			status.SetError(errStr, time.Now())
-			publishVolumeStatus(ctx, status)
-			updateVolumeRefStatus(ctx, status)
-			if err := createOrUpdateAppDiskMetrics(ctx, status); err != nil {
-				log.Errorf("handleDeferredVolumeCreate(%s): exception while publishing diskmetric. %s", key, err.Error())
-			}
-			return
+	// Check disk usage
+	if err := checkVolumeDiskSpace(ctx, status, status.MaxVolSize); err != nil {
+		status.SetError(err.Error(), time.Now())
+		publishVolumeStatus(ctx, status)
+		updateVolumeRefStatus(ctx, status)
+		if err := createOrUpdateAppDiskMetrics(ctx, status); err != nil {
+			log.Errorf("handleDeferredVolumeCreate(%s): exception while publishing diskmetric. %s", key, err.Error())
 		}
+		return
 	}
 	changed, _ := doUpdateVol(ctx, status)
 	if changed {
@@ -313,6 +355,8 @@ func maybeDeleteVolume(ctx *volumemgrContext, status *types.VolumeStatus) {
 		log.Functionf("maybeDeleteVolume for %v Done", status.Key())
 		return
 	}
+	cancelVolumeBackup(ctx, status)
+	releaseBackupDownloads(ctx, status)
 	if status.SubState == types.VolumeSubStateCreated {
 		// Asynch destruction; make sure we have a request for the work
 		AddWorkDestroy(ctx, status)
@@ -401,13 +445,35 @@ func quantifyChanges(config types.VolumeConfig,
 		needRegeneration = true
 		regenerationReason += str + "\n"
 	}
-	if config.MaxVolSize != status.MaxVolSize {
-		str := fmt.Sprintf("MaxVolSize changed from %d to %d for %s",
+	// growing the volume is handled online by growVolume
+	if config.MaxVolSize != 0 && config.MaxVolSize < status.MaxVolSize {
+		str := fmt.Sprintf("MaxVolSize shrunk from %d to %d for %s",
 			status.MaxVolSize, config.MaxVolSize, config.DisplayName)
 		log.Functionf(str)
 		needRegeneration = true
 		regenerationReason += str + "\n"
 	}
+	if config.KeyPolicy.OwnKey != status.KeyPolicy.OwnKey {
+		str := fmt.Sprintf("KeyPolicy.OwnKey changed from %v to %v for %s",
+			status.KeyPolicy.OwnKey, config.KeyPolicy.OwnKey, config.DisplayName)
+		log.Functionf(str)
+		needRegeneration = true
+		regenerationReason += str + "\n"
+	}
+	if config.Filesystem != status.Filesystem {
+		str := fmt.Sprintf("Filesystem changed from %+v to %+v for %s",
+			status.Filesystem, config.Filesystem, config.DisplayName)
+		log.Functionf(str)
+		needRegeneration = true
+		regenerationReason += str + "\n"
+	}
+	if config.Shared != status.Shared {
+		str := fmt.Sprintf("Shared changed from %v to %v for %s",
+			status.Shared, config.Shared, config.DisplayName)
+		log.Functionf(str)
+		needRegeneration = true
+		regenerationReason += str + "\n"
+	}
 	if config.ReadOnly != status.ReadOnly {
 		str := fmt.Sprintf("ReadOnly changed from %v to %v for %s",
 			status.ReadOnly, config.ReadOnly, config.DisplayName)
@@ -36,6 +36,8 @@ func handleVolumeRefCreate(ctxArg interface{}, key string,
 			MaxVolSize:             vs.MaxVolSize,
 			WWN:                    vs.WWN,
 			VerifyOnly:             config.VerifyOnly,
+			Shared:                 vs.Shared,
+			IOLimits:               vs.IOLimits,
 		}
 		if vs.HasError() {
 			description := vs.ErrorDescription
@@ -174,6 +176,8 @@ func updateVolumeRefStatus(ctx *volumemgrContext, vs *types.VolumeStatus) {
 				status.DisplayName = vs.DisplayName
 				status.MaxVolSize = vs.MaxVolSize
 				status.WWN = vs.WWN
+				status.Shared = vs.Shared
+				status.IOLimits = vs.IOLimits
 				if vs.HasError() {
 					description := vs.ErrorDescription
 					description.ErrorEntities = []*types.ErrorEntity{{
@@ -201,6 +205,8 @@ func updateVolumeRefStatus(ctx *volumemgrContext, vs *types.VolumeStatus) {
 				MaxVolSize:             vs.MaxVolSize,
 				WWN:                    vs.WWN,
 				VerifyOnly:             config.VerifyOnly,
+				Shared:                 vs.Shared,
+				IOLimits:               vs.IOLimits,
 			}
 			if vs.HasError() {
 				description := vs.ErrorDescription
@@ -18,6 +18,7 @@ import (
 	zconfig "github.com/lf-edge/eve/api/go/config"
 	"github.com/lf-edge/eve/pkg/pillar/tgt"
 	"github.com/lf-edge/eve/pkg/pillar/types"
+	"github.com/lf-edge/eve/pkg/pillar/vault"
 	"github.com/lf-edge/eve/pkg/pillar/zfs"
 )
 
@@ -108,6 +109,11 @@ func gcDatasets(ctx *volumemgrContext, dataset string) {
 				log.Warnf("gcDatasets: Error deleting target for %s, error=%v",
 					key, err)
 			}
+			destroyBackupSnapshots(location, "")
+			if err := os.RemoveAll(backupDir(key)); err != nil {
+				log.Warnf("gcDatasets: Error removing backups of %s, error=%v",
+					key, err)
+			}
 			output, err := zfs.DestroyDataset(log, location)
 			if err != nil {
 				log.Errorf("gcDatasets: DestroyDataset '%s' failed: %v output:%s",
@@ -170,6 +176,17 @@ func gcPendingCreateVolume(ctx *volumemgrContext) {
 					}
 				}
 			default:
+				if vcp.OwnKey {
+					dir := types.VolumeKeyedDir(vcp.Key())
+					if err := vault.DestroyVolumeDirPolicy(log, dir, vcp.Key()); err != nil {
+						log.Errorf("gcPendingCreateVolume: %s", err)
+					}
+					if err := os.RemoveAll(dir); err != nil {
+						log.Errorf("gcPendingCreateVolume: error deleting volume: %s", err)
+						continue
+					}
+					break
+				}
 				// check if file accessible
 				// assume that we should remove it as not created completely
 				if _, err := os.Stat(vcp.PathName()); err == nil {
@@ -180,6 +197,11 @@ func gcPendingCreateVolume(ctx *volumemgrContext) {
 				}
 			}
 		}
+		if vcp.OwnKey {
+			if err := vault.DestroyVolumeKey(vcp.Key()); err != nil {
+				log.Errorf("gcPendingCreateVolume: error destroying key: %s", err)
+			}
+		}
 		if err := ctx.pubVolumeCreatePending.Unpublish(vcp.Key()); err != nil {
 			log.Errorf("gcPendingCreateVolume: cannot unpublish %s: %s", vcp.Key(), err)
 		}
@@ -10,6 +10,7 @@ import (
 	"path/filepath"
 
 	"github.com/lf-edge/edge-containers/pkg/registry"
+	zconfig "github.com/lf-edge/eve/api/go/config"
 	"github.com/lf-edge/eve/pkg/pillar/cas"
 	"github.com/lf-edge/eve/pkg/pillar/types"
 	"github.com/lf-edge/eve/pkg/pillar/zfs"
@@ -75,7 +76,16 @@ func prepareZVol(ctx *volumemgrContext, status types.VolumeStatus) error {
 		}
 	}
 	zVolName := status.ZVolName()
-	if stdoutStderr, err := zfs.CreateVolumeDataset(log, zVolName, size, "zstd"); err != nil {
+	// do not reserve space in the pool for volumes if we allow overcommit
+	sparse := getVolumeOvercommitPercent(ctx) > 100
+	if status.KeyPolicy.OwnKey {
+		if err := createKeyedZVol(status, size, sparse); err != nil {
+			log.Error(err)
+			return err
+		}
+		return nil
+	}
+	if stdoutStderr, err := zfs.CreateVolumeDataset(log, zVolName, size, "zstd", sparse); err != nil {
 		errStr := fmt.Sprintf("Error creating zfs zvol at %s, error=%v, output=%s",
 			zVolName, err, stdoutStderr)
 		log.Error(errStr)
@@ -86,8 +96,17 @@ func prepareZVol(ctx *volumemgrContext, status types.VolumeStatus) error {
 
 func prepareVolume(ctx *volumemgrContext, status types.VolumeStatus) error {
 	log.Tracef("prepareVolume: %s", status.Key())
-	if ctx.persistType != types.PersistZFS || status.IsContainer() {
+	if status.KeyPolicy.OwnKey {
+		if err := checkVolumeKeyPolicy(ctx, status); err != nil {
+			log.Error(err)
+			return err
+		}
+	}
+	if ctx.persistType != types.PersistZFS || status.IsContainer() || status.Shared {
 		return nil
 	}
+	if status.VolumeContentOriginType == zconfig.VolumeContentOriginType_VCOT_BACKUP {
+		return prepareVolumeFromBackup(ctx, status)
+	}
 	return prepareZVol(ctx, status)
 }
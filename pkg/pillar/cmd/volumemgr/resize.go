@@ -0,0 +1,73 @@
+// Copyright (c) 2022 Zededa, Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package volumemgr
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/lf-edge/eve/pkg/pillar/diskmetrics"
+	"github.com/lf-edge/eve/pkg/pillar/types"
+	"github.com/lf-edge/eve/pkg/pillar/zfs"
+)
+
+// qemu-img reports this if the image is opened by a running domain
+const imageLockedErr = `"write" lock`
+
+// growVolume grows the volume to maxVolSize if it is larger than
+// MaxVolSize of the volume. Volumes which are not created yet only pick up
+// the new size, volumes being created are grown by doUpdateVol once they are.
+// Returns true if MaxVolSize of the volume changed.
+func growVolume(ctx *volumemgrContext, status *types.VolumeStatus, maxVolSize uint64) (bool, error) {
+	if maxVolSize <= status.MaxVolSize {
+		return false, nil
+	}
+	if status.ReadOnly {
+		return false, fmt.Errorf("cannot grow read-only volume %s from %d to %d",
+			status.DisplayName, status.MaxVolSize, maxVolSize)
+	}
+	if status.State < types.CREATING_VOLUME {
+		log.Noticef("growVolume(%s): MaxVolSize changed from %d to %d before creation",
+			status.Key(), status.MaxVolSize, maxVolSize)
+		status.MaxVolSize = maxVolSize
+		return true, nil
+	}
+	if status.State == types.CREATING_VOLUME {
+		log.Noticef("growVolume(%s): postponed until the volume is created",
+			status.Key())
+		return false, nil
+	}
+	if err := checkVolumeDiskSpace(ctx, status, maxVolSize-status.MaxVolSize); err != nil {
+		return false, fmt.Errorf("cannot grow volume %s from %d to %d: %s",
+			status.DisplayName, status.MaxVolSize, maxVolSize, err)
+	}
+	switch {
+	case status.IsContainer(), status.Shared:
+		// container and shared volumes are directories without a size
+		// limit, only the accounting changes
+	case ctx.persistType == types.PersistZFS:
+		if stdoutStderr, err := zfs.SetVolumeSize(log, status.ZVolName(), maxVolSize); err != nil {
+			return false, fmt.Errorf("cannot grow zvol %s to %d: %v, output=%s",
+				status.ZVolName(), maxVolSize, err, stdoutStderr)
+		}
+	default:
+		// qcow2 requires the size to be a multiple of the sector size
+		alignedSize := (maxVolSize + 511) &^ 511
+		if err := diskmetrics.ResizeImg(context.Background(), log, status.FileLocation, alignedSize); err != nil {
+			if !strings.Contains(err.Error(), imageLockedErr) {
+				return false, fmt.Errorf("cannot grow %s to %d: %s",
+					status.FileLocation, alignedSize, err)
+			}
+			// the running domain has the image open, so domainmgr
+			// grows it through the hypervisor once it sees the new size
+			log.Noticef("growVolume(%s): %s is in use, leave it to the hypervisor",
+				status.Key(), status.FileLocation)
+		}
+	}
+	log.Noticef("growVolume(%s): MaxVolSize grown from %d to %d",
+		status.Key(), status.MaxVolSize, maxVolSize)
+	status.MaxVolSize = maxVolSize
+	return true, nil
+}
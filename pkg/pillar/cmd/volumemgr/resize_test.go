@@ -0,0 +1,82 @@
+// Copyright (c) 2022 Zededa, Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package volumemgr
+
+import (
+	"testing"
+
+	"github.com/lf-edge/eve/pkg/pillar/types"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestQuantifyChangesMaxVolSize(t *testing.T) {
+	initStatusCtx(t)
+	status := types.VolumeStatus{
+		DisplayName: "disk",
+		MaxVolSize:  1024 * 1024,
+	}
+	config := types.VolumeConfig{
+		DisplayName: "disk",
+		MaxVolSize:  2 * 1024 * 1024,
+	}
+	needRegeneration, _ := quantifyChanges(config, status)
+	assert.False(t, needRegeneration, "growing must not need regeneration")
+
+	config.MaxVolSize = 0
+	needRegeneration, _ = quantifyChanges(config, status)
+	assert.False(t, needRegeneration, "zero MaxVolSize keeps the size of the image")
+
+	config.MaxVolSize = 512 * 1024
+	needRegeneration, reason := quantifyChanges(config, status)
+	assert.True(t, needRegeneration, "shrinking must need regeneration")
+	assert.Contains(t, reason, "MaxVolSize shrunk")
+}
+
+func TestProvisionedSize(t *testing.T) {
+	ctx := initStatusCtx(t)
+	ctx.globalConfig = types.DefaultConfigItemValueMap()
+	assert.Equal(t, uint64(1000), provisionedSize(&ctx, 1000))
+
+	ctx.globalConfig.SetGlobalValueInt(types.VolumeOvercommitPercent, 200)
+	assert.Equal(t, uint64(500), provisionedSize(&ctx, 1000))
+
+	// values below 100 would mean undercommit, treat them as no overcommit
+	ctx.globalConfig.SetGlobalValueInt(types.VolumeOvercommitPercent, 50)
+	assert.Equal(t, uint64(1000), provisionedSize(&ctx, 1000))
+}
+
+func TestGrowVolume(t *testing.T) {
+	ctx := initStatusCtx(t)
+	ctx.globalConfig = types.DefaultConfigItemValueMap()
+
+	status := types.VolumeStatus{
+		DisplayName: "disk",
+		MaxVolSize:  1024,
+		State:       types.DOWNLOADED,
+	}
+	grown, err := growVolume(&ctx, &status, 512)
+	assert.Nil(t, err)
+	assert.False(t, grown, "must not shrink")
+	assert.Equal(t, uint64(1024), status.MaxVolSize)
+
+	// not created yet, so creation picks the new size up
+	grown, err = growVolume(&ctx, &status, 2048)
+	assert.Nil(t, err)
+	assert.True(t, grown)
+	assert.Equal(t, uint64(2048), status.MaxVolSize)
+
+	// being created, so postponed until done
+	status.State = types.CREATING_VOLUME
+	grown, err = growVolume(&ctx, &status, 4096)
+	assert.Nil(t, err)
+	assert.False(t, grown)
+	assert.Equal(t, uint64(2048), status.MaxVolSize)
+
+	status.State = types.CREATED_VOLUME
+	status.ReadOnly = true
+	grown, err = growVolume(&ctx, &status, 4096)
+	assert.NotNil(t, err)
+	assert.False(t, grown)
+	assert.Equal(t, uint64(2048), status.MaxVolSize)
+}
@@ -0,0 +1,331 @@
+// Copyright (c) 2022 Zededa, Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package volumemgr
+
+// Volumes with VCOT_BACKUP are restored from a backup in a datastore.
+// The manifest and the parts are downloaded by downloader like blobs,
+// the decrypted manifest and the downloaded files are saved in
+// VolumeBackupDirname/restore for the workers which create the volume.
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+
+	zconfig "github.com/lf-edge/eve/api/go/config"
+	"github.com/lf-edge/eve/pkg/pillar/diskmetrics"
+	"github.com/lf-edge/eve/pkg/pillar/types"
+	"github.com/lf-edge/eve/pkg/pillar/volumebackup"
+	"github.com/lf-edge/eve/pkg/pillar/zfs"
+)
+
+// restoreState is what the workers need to restore a volume
+type restoreState struct {
+	Manifest volumebackup.Manifest
+	Files    []string // Downloaded parts in the order of Manifest.Parts
+}
+
+func restoreStateFile(key string) string {
+	return filepath.Join(types.VolumeBackupDirname, "restore", key+".json")
+}
+
+func loadRestoreState(key string) (*restoreState, error) {
+	b, err := ioutil.ReadFile(restoreStateFile(key))
+	if err != nil {
+		return nil, err
+	}
+	var restore restoreState
+	if err := json.Unmarshal(b, &restore); err != nil {
+		return nil, err
+	}
+	return &restore, nil
+}
+
+func saveRestoreState(key string, restore restoreState) error {
+	filename := restoreStateFile(key)
+	if err := os.MkdirAll(filepath.Dir(filename), 0700); err != nil {
+		return err
+	}
+	b, err := json.Marshal(restore)
+	if err != nil {
+		return err
+	}
+	return ioutil.WriteFile(filename, b, 0600)
+}
+
+// addBackupDownloaderConfig refcounts the download of an object of a backup
+func addBackupDownloaderConfig(ctx *volumemgrContext, status *types.VolumeStatus,
+	name, sha string, size uint64) {
+
+	sha = strings.ToLower(sha)
+	AddOrRefcountDownloaderConfig(ctx, types.BlobStatus{
+		DatastoreID: status.BackupRef.DatastoreID,
+		RelativeURL: name,
+		Sha256:      sha,
+		Size:        size,
+	})
+	status.BackupShas = append(status.BackupShas, sha)
+}
+
+// releaseBackupDownloads drops the downloads of the backup the volume
+// was restored from
+func releaseBackupDownloads(ctx *volumemgrContext, status *types.VolumeStatus) {
+	if len(status.BackupShas) == 0 {
+		return
+	}
+	for _, sha := range status.BackupShas {
+		MaybeRemoveDownloaderConfig(ctx, sha)
+	}
+	status.BackupShas = nil
+	if err := os.Remove(restoreStateFile(status.Key())); err != nil && !os.IsNotExist(err) {
+		log.Errorf("releaseBackupDownloads(%s): %v", status.Key(), err)
+	}
+}
+
+// downloadBackup downloads the manifest and then the parts of the backup
+// the volume is restored from. Returns changed, and done once all are
+// downloaded.
+func downloadBackup(ctx *volumemgrContext, status *types.VolumeStatus) (bool, bool, error) {
+	changed := false
+	ref := status.BackupRef
+	if len(status.BackupShas) == 0 {
+		if ref.Name == "" || ref.Sha256 == "" {
+			return changed, false, errors.New("no backup to restore from")
+		}
+		log.Functionf("downloadBackup(%s): manifest %s", status.Key(), ref.Name)
+		addBackupDownloaderConfig(ctx, status, ref.Name, ref.Sha256, 0)
+		status.State = types.DOWNLOADING
+		return true, false, nil
+	}
+	ds := lookupDownloaderStatus(ctx, status.BackupShas[0])
+	if ds == nil || ds.State != types.DOWNLOADED {
+		if ds != nil && ds.HasError() {
+			return changed, false, fmt.Errorf("download of backup %s failed: %s",
+				ref.Name, ds.Error)
+		}
+		return changed, false, nil
+	}
+	var restore *restoreState
+	if len(status.BackupShas) == 1 {
+		key, err := backupKey(ctx, *status)
+		if err != nil {
+			return changed, false, err
+		}
+		m, err := volumebackup.ReadManifest(key, ds.Target, ref.Sha256)
+		if err != nil {
+			return changed, false, fmt.Errorf("cannot read backup %s: %v",
+				ref.Name, err)
+		}
+		if m.Format == volumebackup.FormatZFS && ctx.persistType != types.PersistZFS {
+			return changed, false, fmt.Errorf("backup %s of a zfs volume cannot be restored without zfs",
+				ref.Name)
+		}
+		restore = &restoreState{Manifest: m}
+		if err := saveRestoreState(status.Key(), *restore); err != nil {
+			return changed, false, err
+		}
+		for _, part := range m.Parts {
+			addBackupDownloaderConfig(ctx, status, part.Name, part.Sha256, part.Size)
+		}
+		if m.MaxVolSize > status.MaxVolSize {
+			status.MaxVolSize = m.MaxVolSize
+		}
+		status.ContentFormat = zconfig.Format_RAW
+		if m.Format == volumebackup.FormatQcow2 && ctx.persistType != types.PersistZFS {
+			status.ContentFormat = zconfig.Format_QCOW2
+		}
+		changed = true
+	} else {
+		var err error
+		restore, err = loadRestoreState(status.Key())
+		if err != nil {
+			return changed, false, err
+		}
+	}
+	var currentSize, totalSize int64
+	var files []string
+	for i, sha := range status.BackupShas[1:] {
+		size := int64(restore.Manifest.Parts[i].Size)
+		ds := lookupDownloaderStatus(ctx, sha)
+		if ds == nil {
+			totalSize += size
+			continue
+		}
+		if ds.HasError() {
+			return changed, false, fmt.Errorf("download of %s failed: %s",
+				restore.Manifest.Parts[i].Name, ds.Error)
+		}
+		if ds.TotalSize > size {
+			size = ds.TotalSize
+		}
+		totalSize += size
+		if ds.State != types.DOWNLOADED {
+			currentSize += ds.CurrentSize
+			continue
+		}
+		currentSize += size
+		files = append(files, ds.Target)
+	}
+	if status.TotalSize != totalSize || status.CurrentSize != currentSize {
+		status.TotalSize = totalSize
+		status.CurrentSize = currentSize
+		if totalSize != 0 {
+			status.Progress = uint(100 * currentSize / totalSize)
+		}
+		changed = true
+	}
+	if len(files) != len(restore.Manifest.Parts) {
+		return changed, false, nil
+	}
+	restore.Files = files
+	if err := saveRestoreState(status.Key(), *restore); err != nil {
+		return changed, false, err
+	}
+	return changed, true, nil
+}
+
+// updateVolumeStatusByBackupSha updates the volumes which are restored
+// from a backup with the object
+func updateVolumeStatusByBackupSha(ctx *volumemgrContext, sha string) {
+	for _, status := range getAllVolumeStatus(ctx) {
+		for _, s := range status.BackupShas {
+			if s == sha {
+				updateVolumeStatus(ctx, status.VolumeID)
+				break
+			}
+		}
+	}
+}
+
+// decryptBackupParts writes the decrypted parts of the backup to w
+func decryptBackupParts(key []byte, restore *restoreState, w io.Writer) error {
+	for i, part := range restore.Manifest.Parts {
+		if err := volumebackup.DecryptFromFile(key, w, restore.Files[i], part.Sha256); err != nil {
+			return fmt.Errorf("cannot decrypt %s: %v", part.Name, err)
+		}
+	}
+	return nil
+}
+
+// prepareZVolFromBackup receives the zfs backup chain into the zvol
+func prepareZVolFromBackup(ctx *volumemgrContext, status types.VolumeStatus,
+	restore *restoreState) error {
+
+	key, err := backupKey(ctx, status)
+	if err != nil {
+		return err
+	}
+	zVolName := status.ZVolName()
+	for i, part := range restore.Manifest.Parts {
+		pr, pw := io.Pipe()
+		go func(i int) {
+			pw.CloseWithError(decryptBackupParts(key, &restoreState{
+				Manifest: volumebackup.Manifest{Parts: restore.Manifest.Parts[i : i+1]},
+				Files:    restore.Files[i : i+1],
+			}, pw))
+		}(i)
+		err := zfs.ReceiveStream(context.Background(), zVolName, "zstd", pr)
+		// unblock the decryption if receive failed
+		pr.CloseWithError(io.ErrClosedPipe)
+		if err != nil {
+			return fmt.Errorf("cannot receive %s into %s: %v", part.Name, zVolName, err)
+		}
+	}
+	destroyBackupSnapshots(zVolName, "")
+	if status.MaxVolSize > restore.Manifest.MaxVolSize {
+		if stdoutStderr, err := zfs.SetVolumeSize(log, zVolName, status.MaxVolSize); err != nil {
+			return fmt.Errorf("cannot grow zvol %s to %d: %v, output=%s",
+				zVolName, status.MaxVolSize, err, stdoutStderr)
+		}
+	}
+	return nil
+}
+
+// prepareVolumeFromBackup prepares the zvol for a volume restored from
+// a backup
+func prepareVolumeFromBackup(ctx *volumemgrContext, status types.VolumeStatus) error {
+	restore, err := loadRestoreState(status.Key())
+	if err != nil {
+		return err
+	}
+	if restore.Manifest.Format == volumebackup.FormatZFS {
+		return prepareZVolFromBackup(ctx, status, restore)
+	}
+	return prepareZVol(ctx, status)
+}
+
+// createVolumeFromBackup does not update status but returns
+// new values for VolumeCreated, FileLocation, and error
+func createVolumeFromBackup(ctx *volumemgrContext, status types.VolumeStatus) (bool, string, error) {
+	log.Functionf("createVolumeFromBackup(%s) from %s", status.Key(), status.BackupRef.Name)
+	restore, err := loadRestoreState(status.Key())
+	if err != nil {
+		return false, "", err
+	}
+	key, err := backupKey(ctx, status)
+	if err != nil {
+		return false, "", err
+	}
+	if ctx.persistType == types.PersistZFS {
+		zVolDevice := zfs.GetZVolDeviceByDataset(status.ZVolName())
+		if zVolDevice == "" {
+			return false, "", fmt.Errorf("Error finding zfs zvol %s", status.ZVolName())
+		}
+		if restore.Manifest.Format == volumebackup.FormatZFS {
+			// received by prepareZVolFromBackup
+			return true, zVolDevice, nil
+		}
+		tmpFile := restoreStateFile(status.Key()) + ".qcow2"
+		defer os.Remove(tmpFile)
+		if err := decryptBackupToFile(key, restore, tmpFile); err != nil {
+			return false, zVolDevice, err
+		}
+		if err := diskmetrics.RolloutImgToBlock(context.Background(), log, tmpFile, zVolDevice, "raw"); err != nil {
+			return false, zVolDevice, fmt.Errorf("Error converting %s to zfs zvol %s: %v",
+				tmpFile, zVolDevice, err)
+		}
+		return true, zVolDevice, nil
+	}
+	if restore.Manifest.Format != volumebackup.FormatQcow2 {
+		return false, "", fmt.Errorf("%s backups cannot be restored without zfs",
+			restore.Manifest.Format)
+	}
+	filelocation := status.PathName()
+	if _, err := os.Stat(filelocation); err == nil {
+		return false, "", fmt.Errorf("Can not create %s for %s: exists",
+			filelocation, status.Key())
+	}
+	if err := decryptBackupToFile(key, restore, filelocation); err != nil {
+		return false, "", err
+	}
+	if err := maybeResizeDisk(context.Background(), filelocation, status.MaxVolSize); err != nil {
+		return false, filelocation, err
+	}
+	log.Functionf("createVolumeFromBackup(%s) DONE", status.Key())
+	return true, filelocation, nil
+}
+
+func decryptBackupToFile(key []byte, restore *restoreState, filename string) error {
+	f, err := os.Create(filename)
+	if err != nil {
+		return err
+	}
+	err = decryptBackupParts(key, restore, f)
+	if err == nil {
+		err = f.Sync()
+	}
+	if cerr := f.Close(); err == nil {
+		err = cerr
+	}
+	if err != nil {
+		os.Remove(filename)
+	}
+	return err
+}
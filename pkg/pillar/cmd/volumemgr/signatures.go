@@ -0,0 +1,113 @@
+// Copyright (c) 2022 Zededa, Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package volumemgr
+
+// Code for the signature policy of content trees. The signatures of the
+// image are fetched by the downloader once the blobs are verified, and
+// the content tree is not loaded until they meet the policy.
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/lf-edge/eve/pkg/pillar/sigverify"
+	"github.com/lf-edge/eve/pkg/pillar/types"
+	"github.com/lf-edge/eve/pkg/pillar/utils"
+)
+
+// contentTreeSignaturePolicy returns the signature policy of the content
+// tree, else the one of its datastore
+func contentTreeSignaturePolicy(ctx *volumemgrContext,
+	status types.ContentTreeStatus) types.SignaturePolicy {
+
+	if status.SignaturePolicy.IsSet() {
+		return status.SignaturePolicy
+	}
+	datastore, err := utils.LookupDatastoreConfig(ctx.subDatastoreConfig,
+		status.DatastoreID)
+	if err != nil || datastore == nil {
+		return types.SignaturePolicy{}
+	}
+	return datastore.SignaturePolicy
+}
+
+// verifyContentTreeSignatures returns done once the signatures of the
+// image of the content tree meet its signature policy, or if there is no
+// policy. Until then it waits for the signatures from the downloader, or
+// sets the error of the failed verification.
+func verifyContentTreeSignatures(ctx *volumemgrContext,
+	status *types.ContentTreeStatus) (changed bool, done bool) {
+
+	if status.SignatureVerifiedBy != "" || !status.IsOCIRegistry() {
+		return false, true
+	}
+	policy := contentTreeSignaturePolicy(ctx, *status)
+	if !policy.IsSet() {
+		return false, true
+	}
+	rc := types.ResolveConfig{
+		DatastoreID:     status.DatastoreID,
+		Name:            status.RelativeURL,
+		Counter:         uint32(status.GenerationCounter),
+		FetchSignatures: true,
+	}
+	rs := lookupResolveStatus(ctx, rc.Key())
+	if rs == nil || (!rs.SignaturesFetched && !rs.HasError()) {
+		log.Functionf("verifyContentTreeSignatures(%s): waiting for signatures of %s",
+			status.Key(), status.RelativeURL)
+		if lookupResolveConfig(ctx, rc.Key()) == nil {
+			publishResolveConfig(ctx, &rc)
+		}
+		if !status.HasResolverRef {
+			status.HasResolverRef = true
+			changed = true
+		}
+		return changed, false
+	}
+	if rs.HasError() {
+		log.Errorf("verifyContentTreeSignatures(%s): fetching signatures failed: %s",
+			status.Key(), rs.Error)
+		status.SetErrorWithSourceAndDescription(rs.ErrorDescription, types.ResolveStatus{})
+		return true, false
+	}
+	verifiedBy, err := sigverify.Verify(policy, status.ContentSha256,
+		rs.Signatures, time.Now())
+	if err != nil {
+		errStr := fmt.Sprintf("signature verification of %s failed: %v",
+			status.RelativeURL, err)
+		log.Error(errStr)
+		if status.Error != errStr {
+			status.SetErrorWithSource(errStr, types.SignaturePolicy{}, time.Now())
+			changed = true
+		}
+		return changed, false
+	}
+	log.Noticef("verifyContentTreeSignatures(%s): %s verified by %s",
+		status.Key(), status.RelativeURL, verifiedBy)
+	status.SignatureVerifiedBy = verifiedBy
+	status.HasResolverRef = false
+	if status.IsErrorSource(types.SignaturePolicy{}) ||
+		status.IsErrorSource(types.ResolveStatus{}) {
+		status.ClearErrorWithSource()
+	}
+	deleteResolveConfig(ctx, rc.Key())
+	return true, true
+}
+
+// updateSignaturePolicy sets the signature policy from the config and
+// returns true if it changed. A content tree which failed the previous
+// policy is verified again.
+func updateSignaturePolicy(status *types.ContentTreeStatus,
+	policy types.SignaturePolicy) bool {
+
+	if cmp.Equal(status.SignaturePolicy, policy) {
+		return false
+	}
+	status.SignaturePolicy = policy
+	if status.State < types.VERIFIED {
+		status.SignatureVerifiedBy = ""
+	}
+	return true
+}
@@ -54,10 +54,14 @@ func getRemainingDiskSpace(ctxPtr *volumemgrContext) (uint64, error) {
 			log.Noticef("getRemainingDiskSpace: Volume %s has no app references, use CurrentSize",
 				iterVolumeStatus.Key())
 		} else {
-			// use MaxVolSize in other cases
+			// use MaxVolSize scaled by overcommit in other cases,
+			// but not less than what the volume already uses
 			log.Noticef("getRemainingDiskSpace: Use MaxVolSize for Volume %s",
 				iterVolumeStatus.Key())
-			sizeToUseInCalculation = iterVolumeStatus.MaxVolSize
+			provisioned := provisionedSize(ctxPtr, iterVolumeStatus.MaxVolSize)
+			if provisioned > sizeToUseInCalculation {
+				sizeToUseInCalculation = provisioned
+			}
 		}
 		totalDiskSize += sizeToUseInCalculation
 	}
@@ -98,3 +102,42 @@ func dom0DiskReservedSize(ctxPtr *volumemgrContext, deviceDiskSize uint64) uint6
 	}
 	return diskReservedForDom0
 }
+
+// getVolumeOvercommitPercent returns how much of the disk space available
+// for apps the sum of MaxVolSize of writable volumes may reach, in percent
+func getVolumeOvercommitPercent(ctxPtr *volumemgrContext) uint64 {
+	percent := ctxPtr.globalConfig.GlobalValueInt(types.VolumeOvercommitPercent)
+	if percent < 100 {
+		return 100
+	}
+	return uint64(percent)
+}
+
+// provisionedSize returns how many bytes of the disk space are accounted
+// for a volume with the given MaxVolSize
+func provisionedSize(ctxPtr *volumemgrContext, maxVolSize uint64) uint64 {
+	return maxVolSize * 100 / getVolumeOvercommitPercent(ctxPtr)
+}
+
+// checkVolumeDiskSpace returns an error if there is not enough disk space
+// left to provision size more bytes for the volume
+func checkVolumeDiskSpace(ctxPtr *volumemgrContext, status *types.VolumeStatus, size uint64) error {
+	if ctxPtr.globalConfig.GlobalValueBool(types.IgnoreDiskCheckForApps) {
+		return nil
+	}
+	remaining, err := getRemainingDiskSpace(ctxPtr)
+	if err != nil {
+		return fmt.Errorf("getRemainingDiskSpace failed: %s", err)
+	}
+	needed := provisionedSize(ctxPtr, size)
+	if remaining >= needed {
+		return nil
+	}
+	percent := getVolumeOvercommitPercent(ctxPtr)
+	if percent == 100 {
+		return fmt.Errorf("Remaining disk space %d volume %s needs %d",
+			remaining, status.DisplayName, size)
+	}
+	return fmt.Errorf("Remaining disk space %d volume %s of size %d needs %d with %d%% overcommit",
+		remaining, status.DisplayName, size, needed, percent)
+}
@@ -135,6 +135,8 @@ func doUpdateContentTree(ctx *volumemgrContext, status *types.ContentTreeStatus)
 			blobErrorEntities                         []*types.ErrorEntity
 			blobErrorSeverity                         types.ErrorSeverity
 			blobErrorTime                             time.Time
+			rate                                      uint64
+			deferredUntil                             time.Time
 		)
 		for _, blobSha := range status.Blobs {
 			// get the actual blobStatus
@@ -154,10 +156,16 @@ func doUpdateContentTree(ctx *volumemgrContext, status *types.ContentTreeStatus)
 				// any state less than downloaded, we ask for download, so that we have the refcount;
 				// downloadBlob() is smart enough to look for existing references
 				log.Tracef("doUpdateContentTree: blob sha %s download state %v less than DOWNLOADED", blob.Sha256, blob.State)
-				if downloadBlob(ctx, blob) {
+				prefetchChanged := setBlobPrefetchBy(ctx, blob, status.Schedule.PrefetchBy)
+				if downloadBlob(ctx, blob) || prefetchChanged {
 					publishBlobStatus(ctx, blob)
 					changed = true
 				}
+				rate += blob.Rate
+				if !blob.DeferredUntil.IsZero() &&
+					(deferredUntil.IsZero() || blob.DeferredUntil.Before(deferredUntil)) {
+					deferredUntil = blob.DeferredUntil
+				}
 			}
 			if blob.State == types.DOWNLOADED || blob.State == types.VERIFYING {
 				// downloaded: kick off verifier for this blob
@@ -230,6 +238,18 @@ func doUpdateContentTree(ctx *volumemgrContext, status *types.ContentTreeStatus)
 				currentSize, totalSize, status.Progress)
 		}
 
+		// the ETA follows from the rate of the blobs which download
+		var eta time.Time
+		if rate > 0 && totalSize > currentSize {
+			eta = time.Now().Add(time.Duration((totalSize-currentSize)/int64(rate)) *
+				time.Second).Truncate(time.Minute)
+		}
+		if !status.ETA.Equal(eta) || !status.DeferredUntil.Equal(deferredUntil) {
+			status.ETA = eta
+			status.DeferredUntil = deferredUntil
+			changed = true
+		}
+
 		rootBlob := lookupOrCreateBlobStatus(ctx, status.Blobs[0])
 		if rootBlob == nil {
 			log.Errorf("doUpdateContentTree(%s) name %s: could not find BlobStatus(%s)",
@@ -319,6 +339,12 @@ func doUpdateContentTree(ctx *volumemgrContext, status *types.ContentTreeStatus)
 			}
 		}
 
+		// the signatures of the image have to meet the signature policy
+		// before the tree is loaded and volumes are created from it
+		if sigChanged, done := verifyContentTreeSignatures(ctx, status); !done {
+			return changed || sigChanged, false
+		}
+
 		// if we made it this far, the entire tree has been verified
 		// we can mark the tree as verified, but still have to load it into the CAS store
 		log.Functionf("doUpdateContentTree(%s): all blobs verified %v, setting ContentTree state to VERIFIED", status.Key(), status.Blobs)
@@ -451,6 +477,17 @@ func doUpdateVol(ctx *volumemgrContext, status *types.VolumeStatus) (bool, bool)
 	}
 
 	changed := false
+	if status.Shared && status.VolumeContentOriginType != zconfig.VolumeContentOriginType_VCOT_BLANK {
+		if !status.HasError() {
+			errorStr := fmt.Sprintf("doUpdateVol (%s): only blank volumes can be shared, not %s",
+				status.Key(), status.VolumeContentOriginType)
+			log.Error(errorStr)
+			status.SetErrorWithSource(errorStr,
+				types.VolumeStatus{}, time.Now())
+			changed = true
+		}
+		return changed, false
+	}
 	switch status.VolumeContentOriginType {
 	case zconfig.VolumeContentOriginType_VCOT_BLANK:
 		if status.MaxVolSize == 0 {
@@ -563,6 +600,41 @@ func doUpdateVol(ctx *volumemgrContext, status *types.VolumeStatus) (bool, bool)
 			status.ClearErrorWithSource()
 			changed = true
 		}
+	case zconfig.VolumeContentOriginType_VCOT_BACKUP:
+		if status.State < types.CREATING_VOLUME &&
+			status.SubState == types.VolumeSubStateInitial {
+			downloadChanged, done, err := downloadBackup(ctx, status)
+			changed = changed || downloadChanged
+			if err != nil {
+				log.Errorf("doUpdateVol(%s) name %s: %v",
+					status.Key(), status.DisplayName, err)
+				status.SetErrorWithSource(err.Error(),
+					types.VolumeStatus{}, time.Now())
+				changed = true
+				return changed, false
+			}
+			if status.IsErrorSource(types.VolumeStatus{}) {
+				status.ClearErrorWithSource()
+				changed = true
+			}
+			if !done {
+				return changed, false
+			}
+			// we are done if verifyOnly set
+			if verifyOnly {
+				if status.State != types.LOADED {
+					status.State = types.LOADED
+					changed = true
+				}
+				return changed, false
+			}
+			status.State = types.CREATING_VOLUME
+			status.ReferenceName = ""
+			changed = true
+			// Asynch preparation; ensure we have requested it
+			AddWorkPrepare(ctx, status)
+			return changed, false
+		}
 	default:
 		// Unsupported volume content origin type
 		errStr := fmt.Sprintf("doUpdateVol(%s) name %s: Volume content origin type %v not supported",
@@ -595,7 +667,7 @@ func doUpdateVol(ctx *volumemgrContext, status *types.VolumeStatus) (bool, bool)
 		}
 	}
 	if status.State == types.CREATING_VOLUME && status.SubState == types.VolumeSubStatePreparing {
-		if ctx.useVHost && ctx.persistType == types.PersistZFS && !status.IsContainer() {
+		if ctx.useVHost && ctx.persistType == types.PersistZFS && !status.IsContainer() && !status.Shared {
 			zVolStatus := lookupZVolStatusByDataset(ctx, status.ZVolName())
 			if zVolStatus != nil {
 				wwn, err := createTargetVhost(zVolStatus.Device, status)
@@ -666,6 +738,7 @@ func doUpdateVol(ctx *volumemgrContext, status *types.VolumeStatus) (bool, bool)
 		changed = true
 		// Work is done
 		DeleteWorkCreate(ctx, status)
+		releaseBackupDownloads(ctx, status)
 		if status.MaxVolSize == 0 {
 			_, maxVolSize, _, _, err := utils.GetVolumeSize(log, status.FileLocation)
 			if err != nil {
@@ -679,6 +752,14 @@ func doUpdateVol(ctx *volumemgrContext, status *types.VolumeStatus) (bool, bool)
 				changed = true
 			}
 		}
+		// MaxVolSize may have been increased while we were creating
+		if vc := lookupVolumeConfig(ctx, status.Key()); vc != nil {
+			if _, err := growVolume(ctx, status, vc.MaxVolSize); err != nil {
+				log.Errorf("doUpdateVol(%s): %s", status.Key(), err)
+				status.SetErrorWithSource(err.Error(),
+					types.VolumeStatus{}, time.Now())
+			}
+		}
 		persistFsType := vault.ReadPersistType()
 		updateStatusByPersistType(status, persistFsType)
 	}
@@ -735,13 +816,17 @@ func updateStatusByDatastore(ctx *volumemgrContext, datastore types.DatastoreCon
 		status := st.(types.ContentTreeStatus)
 
 		// if it does not match the UUID, or it already has the type, ignore it
-		if status.DatastoreID != datastore.UUID || status.DatastoreType != "" {
+		// unless it failed the signature policy, which might have changed
+		if status.DatastoreID != datastore.UUID ||
+			(status.DatastoreType != "" && !status.IsErrorSource(types.SignaturePolicy{})) {
 			continue
 		}
-		// set the type
-		log.Functionf("Setting datastore type %s for datastore %s on ContentTreeStatus %s",
-			datastore.DsType, datastore.UUID, status.Key())
-		status.DatastoreType = datastore.DsType
+		if status.DatastoreType == "" {
+			// set the type
+			log.Functionf("Setting datastore type %s for datastore %s on ContentTreeStatus %s",
+				datastore.DsType, datastore.UUID, status.Key())
+			status.DatastoreType = datastore.DsType
+		}
 		if changed, _ := doUpdateContentTree(ctx, &status); changed {
 			log.Functionf("updateStatusByDatastore(%s) publishing ContentTreeStatus",
 				status.Key())
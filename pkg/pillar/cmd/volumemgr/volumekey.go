@@ -0,0 +1,276 @@
+// Copyright (c) 2022 Zededa, Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package volumemgr
+
+// Volumes with keys of their own are encrypted with a data key which vault
+// keeps wrapped by the vault key. zvols are datasets which are their own
+// encryption roots; on ext4 the image of the volume is kept in a directory
+// with an fscrypt policy of its own. Rotating the key re-wraps the key
+// which encrypts the data, and deleting the volume destroys the data key
+// before the storage.
+
+import (
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"time"
+
+	zconfig "github.com/lf-edge/eve/api/go/config"
+	"github.com/lf-edge/eve/pkg/pillar/types"
+	"github.com/lf-edge/eve/pkg/pillar/vault"
+	"github.com/lf-edge/eve/pkg/pillar/zfs"
+)
+
+// checkVolumeKeyPolicy returns an error if the volume cannot have a key of
+// its own
+func checkVolumeKeyPolicy(ctx *volumemgrContext, status types.VolumeStatus) error {
+	switch {
+	case !status.Encrypted:
+		return fmt.Errorf("volume %s is clear text and cannot have a key of its own",
+			status.DisplayName)
+	case status.Shared:
+		return fmt.Errorf("shared volume %s cannot have a key of its own",
+			status.DisplayName)
+	case status.IsContainer():
+		return fmt.Errorf("container volume %s cannot have a key of its own",
+			status.DisplayName)
+	case status.VolumeContentOriginType == zconfig.VolumeContentOriginType_VCOT_BACKUP:
+		return fmt.Errorf("volume %s restored from a backup cannot have a key of its own",
+			status.DisplayName)
+	case ctx.persistType != types.PersistZFS && ctx.persistType != types.PersistExt4:
+		return fmt.Errorf("volume %s cannot have a key of its own on %s",
+			status.DisplayName, ctx.persistType)
+	}
+	return nil
+}
+
+// createKeyedZVol creates the zvol with a new data key
+func createKeyedZVol(status types.VolumeStatus, size uint64, sparse bool) error {
+	key, err := vault.CreateVolumeKey(log, status.Key())
+	if err != nil {
+		return fmt.Errorf("cannot create the key of volume %s: %v", status.Key(), err)
+	}
+	zVolName := status.ZVolName()
+	if stdoutStderr, err := zfs.CreateEncryptedVolumeDataset(log, zVolName, size,
+		"zstd", sparse, key); err != nil {
+		return fmt.Errorf("Error creating zfs zvol at %s, error=%v, output=%s",
+			zVolName, err, stdoutStderr)
+	}
+	return nil
+}
+
+// createKeyedDir creates the directory of the volume on ext4 which is
+// encrypted with a new data key. A leftover of a failed attempt is removed.
+func createKeyedDir(status types.VolumeStatus) error {
+	dir := types.VolumeKeyedDir(status.Key())
+	if _, err := os.Stat(dir); err == nil {
+		log.Warnf("createKeyedDir(%s): removing leftover %s", status.Key(), dir)
+		if err := vault.DestroyVolumeDirPolicy(log, dir, status.Key()); err != nil {
+			log.Error(err)
+		}
+		if err := os.RemoveAll(dir); err != nil {
+			return err
+		}
+	}
+	key, err := vault.CreateVolumeKey(log, status.Key())
+	if err != nil {
+		return fmt.Errorf("cannot create the key of volume %s: %v", status.Key(), err)
+	}
+	if err := os.MkdirAll(dir, 0700); err != nil {
+		return err
+	}
+	return vault.EncryptVolumeDir(log, dir, status.Key(), key)
+}
+
+// unlockVolume loads the data key of the volume after a reboot. If the
+// device rebooted during a rotation the storage may use the next key
+// already.
+func unlockVolume(ctx *volumemgrContext, status *types.VolumeStatus) error {
+	unlock := func(key []byte) error {
+		if ctx.persistType == types.PersistZFS {
+			zVolName := status.ZVolName()
+			keyStatus, err := zfs.GetDatasetOption(log, zVolName, "keystatus")
+			if err == nil && keyStatus == "available" {
+				return nil
+			}
+			if stdoutStderr, err := zfs.LoadDatasetKey(zVolName, key); err != nil {
+				return fmt.Errorf("zfs load-key %s failed: %v: %s", zVolName, err, stdoutStderr)
+			}
+			return nil
+		}
+		return vault.UnlockVolumeDir(log, types.VolumeKeyedDir(status.Key()), status.Key(), key)
+	}
+	key, generation, err := vault.ReadVolumeKey(log, status.Key())
+	if err != nil {
+		return fmt.Errorf("cannot read the key of volume %s: %v", status.Key(), err)
+	}
+	err = unlock(key)
+	if err == nil {
+		status.DataKey.Generation = generation
+		return nil
+	}
+	nextKey, nextGeneration, nextErr := vault.ReadNextVolumeKey(log, status.Key())
+	if nextErr != nil {
+		return err
+	}
+	if nextErr := unlock(nextKey); nextErr != nil {
+		return err
+	}
+	log.Noticef("unlockVolume(%s): completing the rotation of the key", status.Key())
+	if err := vault.CommitVolumeKey(status.Key()); err != nil {
+		return err
+	}
+	status.DataKey.Generation = nextGeneration
+	return nil
+}
+
+// unlockKeyedDirs unlocks the directories of the volumes on ext4 at start
+// hence the formats of the volumes in them can be found
+func unlockKeyedDirs() {
+	dirs, err := ioutil.ReadDir(types.VolumeKeyedDirName)
+	if err != nil {
+		if !os.IsNotExist(err) {
+			log.Errorf("unlockKeyedDirs: %v", err)
+		}
+		return
+	}
+	for _, d := range dirs {
+		volumeKey := d.Name()
+		dir := types.VolumeKeyedDir(volumeKey)
+		key, _, err := vault.ReadVolumeKey(log, volumeKey)
+		if err == nil {
+			err = vault.UnlockVolumeDir(log, dir, volumeKey, key)
+		}
+		if err != nil {
+			// try the key of an unfinished rotation
+			if nextKey, _, nextErr := vault.ReadNextVolumeKey(log, volumeKey); nextErr == nil &&
+				vault.UnlockVolumeDir(log, dir, volumeKey, nextKey) == nil {
+				err = vault.CommitVolumeKey(volumeKey)
+			}
+		}
+		if err != nil {
+			log.Errorf("unlockKeyedDirs: cannot unlock %s: %v", dir, err)
+			continue
+		}
+		populateExistingVolumesFormat(dir)
+	}
+}
+
+// maybeRotateVolumeKey rotates the data key of a created volume if the
+// rotate counter changed. Returns true if the status changed.
+func maybeRotateVolumeKey(ctx *volumemgrContext, status *types.VolumeStatus) bool {
+	if !status.KeyPolicy.OwnKey ||
+		status.KeyPolicy.RotateCounter == status.DataKey.RotateCounter ||
+		status.State < types.CREATED_VOLUME {
+		return false
+	}
+	if err := rotateVolumeKey(ctx, *status); err != nil {
+		log.Errorf("maybeRotateVolumeKey(%s): %v", status.Key(), err)
+		status.DataKey.SetErrorNow(err.Error())
+	} else {
+		log.Noticef("maybeRotateVolumeKey(%s): rotated the key of %s",
+			status.Key(), status.DisplayName)
+		status.DataKey.Generation++
+		status.DataKey.LastRotation = time.Now()
+		status.DataKey.ClearError()
+	}
+	// a failed rotation is retried with the next counter
+	status.DataKey.RotateCounter = status.KeyPolicy.RotateCounter
+	return true
+}
+
+func rotateVolumeKey(ctx *volumemgrContext, status types.VolumeStatus) error {
+	key, _, err := vault.ReadVolumeKey(log, status.Key())
+	if err != nil {
+		return err
+	}
+	nextKey, err := vault.RotateVolumeKey(log, status.Key())
+	if err != nil {
+		return err
+	}
+	if ctx.persistType == types.PersistZFS {
+		var stdoutStderr string
+		stdoutStderr, err = zfs.ChangeDatasetKey(status.ZVolName(), nextKey)
+		if err != nil {
+			err = fmt.Errorf("zfs change-key %s failed: %v: %s",
+				status.ZVolName(), err, stdoutStderr)
+		}
+	} else {
+		err = vault.ChangeVolumeDirKey(log, status.Key(), key, nextKey)
+	}
+	if err != nil {
+		if discardErr := vault.DiscardNextVolumeKey(status.Key()); discardErr != nil {
+			log.Errorf("rotateVolumeKey(%s): %v", status.Key(), discardErr)
+		}
+		return err
+	}
+	return vault.CommitVolumeKey(status.Key())
+}
+
+// destroyKeyedVolume destroys the data key and then the storage of the
+// volume
+func destroyKeyedVolume(ctx *volumemgrContext, status types.VolumeStatus) (bool, string, error) {
+
+	created := status.SubState == types.VolumeSubStateCreated
+	if err := vault.DestroyVolumeKey(status.Key()); err != nil {
+		errStr := fmt.Sprintf("Error destroying the key of volume %s: %v",
+			status.Key(), err)
+		log.Error(errStr)
+		return created, status.FileLocation, errors.New(errStr)
+	}
+	log.Noticef("destroyKeyedVolume(%s): destroyed the key of %s",
+		status.Key(), status.DisplayName)
+	if ctx.persistType == types.PersistZFS {
+		if err := destroyZVol(ctx, status); err != nil {
+			return created, "", err
+		}
+	} else {
+		dir := types.VolumeKeyedDir(status.Key())
+		if err := vault.DestroyVolumeDirPolicy(log, dir, status.Key()); err != nil {
+			log.Error(err)
+		}
+		if err := os.RemoveAll(dir); err != nil {
+			log.Error(err)
+			return created, "", err
+		}
+	}
+	log.Functionf("destroyKeyedVolume(%s) DONE", status.Key())
+	return false, "", nil
+}
+
+// gcVolumeKeys destroys the keys and the directories of volumes which
+// are gone
+func gcVolumeKeys(ctx *volumemgrContext) {
+	volumeKeys, err := vault.ListVolumeKeys()
+	if err != nil {
+		log.Errorf("gcVolumeKeys: %v", err)
+	}
+	for _, volumeKey := range volumeKeys {
+		if lookupVolumeStatus(ctx, volumeKey) != nil {
+			continue
+		}
+		log.Noticef("gcVolumeKeys: Found unused key of volume %s. Destroying it.",
+			volumeKey)
+		if err := vault.DestroyVolumeKey(volumeKey); err != nil {
+			log.Errorf("gcVolumeKeys: %v", err)
+		}
+	}
+	dirs, err := ioutil.ReadDir(types.VolumeKeyedDirName)
+	if err != nil {
+		return
+	}
+	for _, d := range dirs {
+		volumeKey := d.Name()
+		if lookupVolumeStatus(ctx, volumeKey) != nil {
+			continue
+		}
+		dir := types.VolumeKeyedDir(volumeKey)
+		log.Functionf("gcVolumeKeys: Found unused volume %s. Deleting it.", dir)
+		if err := vault.DestroyVolumeDirPolicy(log, dir, volumeKey); err != nil {
+			log.Error(err)
+		}
+		deleteFile(dir)
+	}
+}
@@ -0,0 +1,59 @@
+// Copyright (c) 2022 Zededa, Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package volumemgr
+
+import (
+	"testing"
+
+	zconfig "github.com/lf-edge/eve/api/go/config"
+	"github.com/lf-edge/eve/pkg/pillar/types"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCheckVolumeKeyPolicy(t *testing.T) {
+	testMatrix := map[string]struct {
+		persistType types.PersistType
+		status      types.VolumeStatus
+		ok          bool
+	}{
+		"zfs": {
+			persistType: types.PersistZFS,
+			status:      types.VolumeStatus{Encrypted: true},
+			ok:          true,
+		},
+		"ext4": {
+			persistType: types.PersistExt4,
+			status:      types.VolumeStatus{Encrypted: true},
+			ok:          true,
+		},
+		"clear text": {
+			persistType: types.PersistZFS,
+			status:      types.VolumeStatus{},
+		},
+		"shared": {
+			persistType: types.PersistZFS,
+			status:      types.VolumeStatus{Encrypted: true, Shared: true},
+		},
+		"container": {
+			persistType: types.PersistExt4,
+			status: types.VolumeStatus{Encrypted: true,
+				ContentFormat: zconfig.Format_CONTAINER},
+		},
+		"backup": {
+			persistType: types.PersistZFS,
+			status: types.VolumeStatus{Encrypted: true,
+				VolumeContentOriginType: zconfig.VolumeContentOriginType_VCOT_BACKUP},
+		},
+		"unknown persist": {
+			persistType: types.PersistUnknown,
+			status:      types.VolumeStatus{Encrypted: true},
+		},
+	}
+	for testname, test := range testMatrix {
+		t.Logf("Running test case %s", testname)
+		ctx := &volumemgrContext{persistType: test.persistType}
+		err := checkVolumeKeyPolicy(ctx, test.status)
+		assert.Equal(t, test.ok, err == nil, testname)
+	}
+}
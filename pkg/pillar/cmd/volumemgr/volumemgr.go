@@ -16,6 +16,7 @@ import (
 	"github.com/lf-edge/eve/pkg/pillar/agentlog"
 	"github.com/lf-edge/eve/pkg/pillar/base"
 	"github.com/lf-edge/eve/pkg/pillar/cas"
+	"github.com/lf-edge/eve/pkg/pillar/cipher"
 	"github.com/lf-edge/eve/pkg/pillar/flextimer"
 	"github.com/lf-edge/eve/pkg/pillar/hypervisor"
 	"github.com/lf-edge/eve/pkg/pillar/pidfile"
@@ -72,12 +73,21 @@ type volumemgrContext struct {
 	subDatastoreConfig      pubsub.Subscription
 	subZVolStatus           pubsub.Subscription
 	pubVolumeCreatePending  pubsub.Publication
+	pubUploaderConfig       pubsub.Publication
+	subUploaderStatus       pubsub.Subscription
+	pubDiskBackupConfig     pubsub.Publication
+	subDiskBackupStatus     pubsub.Subscription
+	pubCipherBlockStatus    pubsub.Publication
 	diskMetricsTickerHandle interface{}
 	gc                      *time.Ticker
 	deferDelete             *time.Ticker
 
 	worker worker.Worker // For background work
 
+	// For the backup keys of the volumes
+	decryptCipherContext cipher.DecryptCipherContext
+	cipherMetrics        *cipher.AgentMetrics
+
 	verifierRestarted    bool // Wait for verifier to restart
 	contentTreeRestarted bool // Wait to receive all contentTree after restart
 	usingConfig          bool // From zedagent
@@ -92,6 +102,10 @@ type volumemgrContext struct {
 	// There is no shared data so its safe to be used by multiple goroutines
 	casClient cas.CAS
 
+	// Blobs to load from an offline bundle
+	subOfflineBlobImportConfig pubsub.Subscription
+	pubOfflineBlobImportStatus pubsub.Publication
+
 	volumeConfigCreateDeferredMap map[string]*types.VolumeConfig
 
 	persistType types.PersistType
@@ -131,6 +145,7 @@ func Run(ps *pubsub.PubSub, loggerArg *logrus.Logger, logArg *base.LogObject) in
 		deferContentDelete: 0,
 		globalConfig:       types.DefaultConfigItemValueMap(),
 		persistType:        vault.ReadPersistType(),
+		cipherMetrics:      cipher.NewAgentMetrics(agentName),
 	}
 
 	log.Functionf("Starting %s", agentName)
@@ -202,12 +217,18 @@ func Run(ps *pubsub.PubSub, loggerArg *logrus.Logger, logArg *base.LogObject) in
 	// volume's content format with the volume key
 	populateExistingVolumesFormat(volumeEncryptedDirName)
 	populateExistingVolumesFormat(volumeClearDirName)
+	if ctx.persistType != types.PersistZFS {
+		unlockKeyedDirs()
+	}
 
 	// Create the background worker
 	ctx.worker = worker.NewPool(log, &ctx, 20, map[string]worker.Handler{
 		workCreate:  {Request: volumeWorker, Response: processVolumeWorkResult},
 		workIngest:  {Request: casIngestWorker, Response: processCasIngestWorkResult},
 		workPrepare: {Request: volumePrepareWorker, Response: processVolumePrepareResult},
+		workOfflineImport: {Request: offlineImportWorker,
+			Response: processOfflineImportWorkResult},
+		workBackup: {Request: volumeBackupWorker, Response: processVolumeBackupResult},
 	})
 
 	// Set up our publications before the subscriptions so ctx is set
@@ -229,6 +250,42 @@ func Run(ps *pubsub.PubSub, loggerArg *logrus.Logger, logArg *base.LogObject) in
 	}
 	ctx.pubVerifyImageConfig = pubVerifyImageConfig
 
+	pubUploaderConfig, err := ps.NewPublication(pubsub.PublicationOptions{
+		AgentName: agentName,
+		TopicType: types.UploaderConfig{},
+	})
+	if err != nil {
+		log.Fatal(err)
+	}
+	ctx.pubUploaderConfig = pubUploaderConfig
+
+	pubDiskBackupConfig, err := ps.NewPublication(pubsub.PublicationOptions{
+		AgentName: agentName,
+		TopicType: types.DiskBackupConfig{},
+	})
+	if err != nil {
+		log.Fatal(err)
+	}
+	ctx.pubDiskBackupConfig = pubDiskBackupConfig
+
+	pubCipherBlockStatus, err := ps.NewPublication(pubsub.PublicationOptions{
+		AgentName: agentName,
+		TopicType: types.CipherBlockStatus{},
+	})
+	if err != nil {
+		log.Fatal(err)
+	}
+	ctx.pubCipherBlockStatus = pubCipherBlockStatus
+	pubCipherBlockStatus.ClearRestarted()
+
+	cipherMetricsPub, err := ps.NewPublication(pubsub.PublicationOptions{
+		AgentName: agentName,
+		TopicType: types.CipherMetrics{},
+	})
+	if err != nil {
+		log.Fatal(err)
+	}
+
 	pubResolveConfig, err := ps.NewPublication(pubsub.PublicationOptions{
 		AgentName: agentName,
 		TopicType: types.ResolveConfig{},
@@ -347,6 +404,42 @@ func Run(ps *pubsub.PubSub, loggerArg *logrus.Logger, logArg *base.LogObject) in
 	ctx.subDownloaderStatus = subDownloaderStatus
 	subDownloaderStatus.Activate()
 
+	// Look for UploaderStatus from downloader
+	subUploaderStatus, err := ps.NewSubscription(pubsub.SubscriptionOptions{
+		AgentName:     "downloader",
+		MyAgentName:   agentName,
+		TopicImpl:     types.UploaderStatus{},
+		Activate:      false,
+		Ctx:           &ctx,
+		CreateHandler: handleUploaderStatusCreate,
+		ModifyHandler: handleUploaderStatusModify,
+		WarningTime:   warningTime,
+		ErrorTime:     errorTime,
+	})
+	if err != nil {
+		log.Fatal(err)
+	}
+	ctx.subUploaderStatus = subUploaderStatus
+	subUploaderStatus.Activate()
+
+	// Look for DiskBackupStatus from domainmgr
+	subDiskBackupStatus, err := ps.NewSubscription(pubsub.SubscriptionOptions{
+		AgentName:     "domainmgr",
+		MyAgentName:   agentName,
+		TopicImpl:     types.DiskBackupStatus{},
+		Activate:      false,
+		Ctx:           &ctx,
+		CreateHandler: handleDiskBackupStatusCreate,
+		ModifyHandler: handleDiskBackupStatusModify,
+		WarningTime:   warningTime,
+		ErrorTime:     errorTime,
+	})
+	if err != nil {
+		log.Fatal(err)
+	}
+	ctx.subDiskBackupStatus = subDiskBackupStatus
+	subDiskBackupStatus.Activate()
+
 	// Look for VerifyImageStatus from verifier
 	subVerifyImageStatus, err := ps.NewSubscription(pubsub.SubscriptionOptions{
 		AgentName:      "verifier",
@@ -487,6 +580,86 @@ func Run(ps *pubsub.PubSub, loggerArg *logrus.Logger, logArg *base.LogObject) in
 	ctx.subZVolStatus = subZVolStatus
 	subZVolStatus.Activate()
 
+	pubOfflineBlobImportStatus, err := ps.NewPublication(pubsub.PublicationOptions{
+		AgentName: agentName,
+		TopicType: types.OfflineBlobImportStatus{},
+	})
+	if err != nil {
+		log.Fatal(err)
+	}
+	ctx.pubOfflineBlobImportStatus = pubOfflineBlobImportStatus
+
+	// Look for blobs to load from an offline bundle
+	subOfflineBlobImportConfig, err := ps.NewSubscription(pubsub.SubscriptionOptions{
+		CreateHandler: handleOfflineBlobImportCreate,
+		ModifyHandler: handleOfflineBlobImportModify,
+		DeleteHandler: handleOfflineBlobImportDelete,
+		WarningTime:   warningTime,
+		ErrorTime:     errorTime,
+		AgentName:     "zedagent",
+		TopicImpl:     types.OfflineBlobImportConfig{},
+		Ctx:           &ctx,
+	})
+	if err != nil {
+		log.Fatal(err)
+	}
+	ctx.subOfflineBlobImportConfig = subOfflineBlobImportConfig
+	subOfflineBlobImportConfig.Activate()
+
+	// Look for controller certs which will be used for decryption
+	subControllerCert, err := ps.NewSubscription(pubsub.SubscriptionOptions{
+		AgentName:   "zedagent",
+		MyAgentName: agentName,
+		TopicImpl:   types.ControllerCert{},
+		Activate:    false,
+		Ctx:         &ctx,
+		WarningTime: warningTime,
+		ErrorTime:   errorTime,
+		Persistent:  true,
+	})
+	if err != nil {
+		log.Fatal(err)
+	}
+	ctx.decryptCipherContext.Log = log
+	ctx.decryptCipherContext.AgentName = agentName
+	ctx.decryptCipherContext.AgentMetrics = ctx.cipherMetrics
+	ctx.decryptCipherContext.SubControllerCert = subControllerCert
+	subControllerCert.Activate()
+
+	// Look for edge node certs which will be used for decryption
+	subEdgeNodeCert, err := ps.NewSubscription(pubsub.SubscriptionOptions{
+		AgentName:   "tpmmgr",
+		MyAgentName: agentName,
+		TopicImpl:   types.EdgeNodeCert{},
+		Activate:    false,
+		Persistent:  true,
+		Ctx:         &ctx,
+		WarningTime: warningTime,
+		ErrorTime:   errorTime,
+	})
+	if err != nil {
+		log.Fatal(err)
+	}
+	ctx.decryptCipherContext.SubEdgeNodeCert = subEdgeNodeCert
+	subEdgeNodeCert.Activate()
+
+	// Look for cipher context which will be used for decryption
+	subCipherContext, err := ps.NewSubscription(pubsub.SubscriptionOptions{
+		AgentName:   "zedagent",
+		MyAgentName: agentName,
+		TopicImpl:   types.CipherContext{},
+		Activate:    false,
+		Ctx:         &ctx,
+		WarningTime: warningTime,
+		ErrorTime:   errorTime,
+		Persistent:  true,
+	})
+	if err != nil {
+		log.Fatal(err)
+	}
+	ctx.decryptCipherContext.SubCipherContext = subCipherContext
+	subCipherContext.Activate()
+
 	if ctx.casClient, err = cas.NewCAS(casClientType); err != nil {
 		err = fmt.Errorf("Run: exception while initializing CAS client: %s", err.Error())
 		log.Fatal(err)
@@ -561,6 +734,15 @@ func Run(ps *pubsub.PubSub, loggerArg *logrus.Logger, logArg *base.LogObject) in
 	go diskMetricsTimerTask(&ctx, diskMetricsTickerHandle)
 	ctx.diskMetricsTickerHandle = <-diskMetricsTickerHandle
 
+	backupTicker := time.NewTicker(backupCheckInterval)
+
+	// Publish the metrics of the decryption of the backup keys
+	interval := time.Duration(10 * time.Second)
+	max := float64(interval)
+	min := max * 0.3
+	publishTimer := flextimer.NewRangeTicker(time.Duration(min),
+		time.Duration(max))
+
 	for {
 		select {
 		case change := <-ctx.subGlobalConfig.MsgChan():
@@ -596,10 +778,43 @@ func Run(ps *pubsub.PubSub, loggerArg *logrus.Logger, logArg *base.LogObject) in
 		case change := <-ctx.subZVolStatus.MsgChan():
 			ctx.subZVolStatus.ProcessChange(change)
 
+		case change := <-ctx.subOfflineBlobImportConfig.MsgChan():
+			ctx.subOfflineBlobImportConfig.ProcessChange(change)
+
+		case change := <-subUploaderStatus.MsgChan():
+			subUploaderStatus.ProcessChange(change)
+
+		case change := <-subDiskBackupStatus.MsgChan():
+			subDiskBackupStatus.ProcessChange(change)
+
+		case change := <-subControllerCert.MsgChan():
+			subControllerCert.ProcessChange(change)
+
+		case change := <-subEdgeNodeCert.MsgChan():
+			subEdgeNodeCert.ProcessChange(change)
+
+		case change := <-subCipherContext.MsgChan():
+			subCipherContext.ProcessChange(change)
+
+		case <-publishTimer.C:
+			start := time.Now()
+			if err := ctx.cipherMetrics.Publish(log, cipherMetricsPub, "global"); err != nil {
+				log.Errorln(err)
+			}
+			ps.CheckMaxTimeTopic(agentName, "publishTimer", start,
+				warningTime, errorTime)
+
+		case <-backupTicker.C:
+			start := time.Now()
+			checkVolumeBackups(&ctx)
+			ps.CheckMaxTimeTopic(agentName, "backup", start,
+				warningTime, errorTime)
+
 		case <-ctx.gc.C:
 			start := time.Now()
 			gcObjects(&ctx, volumeEncryptedDirName)
 			gcObjects(&ctx, volumeClearDirName)
+			gcVolumeKeys(&ctx)
 			gcDatasets(&ctx, types.VolumeEncryptedZFSDataset)
 			gcDatasets(&ctx, types.VolumeClearZFSDataset)
 			if !ctx.initGced {
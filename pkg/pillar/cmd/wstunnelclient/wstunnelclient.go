@@ -49,6 +49,8 @@ type wstunnelclientContext struct {
 	wstunnelclient       *zedcloud.WSTunnelClient
 	dnsContext           *DNSContext
 	devUUID              uuid.UUID
+	// From the app.console.brokered global setting
+	consoleBrokered bool
 	// XXX add any output from scanAIConfigs()?
 }
 
@@ -259,6 +261,17 @@ func handleGlobalConfigImpl(ctxArg interface{}, key string,
 	debug, gcp = agentlog.HandleGlobalConfig(log, ctx.subGlobalConfig, agentName,
 		debugOverride, logger)
 	if gcp != nil {
+		brokered := gcp.GlobalValueBool(types.ConsoleBrokered)
+		if brokered != ctx.consoleBrokered {
+			log.Noticef("console brokered changed to %t", brokered)
+			ctx.consoleBrokered = brokered
+			// Reconnect to the new local relay
+			if ctx.wstunnelclient != nil {
+				ctx.wstunnelclient.Stop()
+				ctx.wstunnelclient = nil
+				scanAIConfigs(ctx)
+			}
+		}
 		ctx.GCInitialized = true
 	}
 	log.Functionf("handleGlobalConfigImpl done for %s\n", key)
@@ -372,9 +385,10 @@ func scanAIConfigs(ctx *wstunnelclientContext) {
 	items := sub.GetAll()
 	for _, c := range items {
 		config := c.(types.AppInstanceConfig)
-		log.Tracef("Remote console status for app-instance: %s: %t\n",
-			config.DisplayName, config.RemoteConsole)
-		isTunnelRequired = config.RemoteConsole || isTunnelRequired
+		log.Tracef("Remote console status for app-instance: %s: %t, %d console sessions\n",
+			config.DisplayName, config.RemoteConsole, len(config.ConsoleSessions))
+		isTunnelRequired = config.RemoteConsole ||
+			len(config.ConsoleSessions) != 0 || isTunnelRequired
 	}
 	log.Functionf("Tunnel check status after checking app-instance configs: %t\n",
 		isTunnelRequired)
@@ -397,7 +411,11 @@ func scanAIConfigs(ctx *wstunnelclientContext) {
 				ifname)
 			continue
 		}
-		wstunnelclient := zedcloud.InitializeTunnelClient(log, ctx.serverNameAndPort, "localhost:4822")
+		localRelay := types.GuacdAddr
+		if ctx.consoleBrokered {
+			localRelay = types.ConsoleBrokerAddr
+		}
+		wstunnelclient := zedcloud.InitializeTunnelClient(log, ctx.serverNameAndPort, localRelay)
 		destURL := wstunnelclient.Tunnel
 
 		addrCount, err := types.CountLocalAddrAnyNoLinkLocalIf(*deviceNetworkStatus,
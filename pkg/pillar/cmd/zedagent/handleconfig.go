@@ -17,6 +17,7 @@ import (
 
 	"github.com/golang/protobuf/proto"
 	zconfig "github.com/lf-edge/eve/api/go/config"
+	"github.com/lf-edge/eve/api/go/profile"
 	"github.com/lf-edge/eve/pkg/pillar/base"
 	"github.com/lf-edge/eve/pkg/pillar/flextimer"
 	"github.com/lf-edge/eve/pkg/pillar/hardware"
@@ -93,6 +94,7 @@ type getconfigContext struct {
 	subVolumeStatus           pubsub.Subscription
 	pubVolumeConfig           pubsub.Publication
 	pubDisksConfig            pubsub.Publication
+	pubDeviceSchedule         pubsub.Publication
 	NodeAgentStatus           *types.NodeAgentStatus
 	configProcessingSkipFlag  bool
 	lastReceivedConfig        time.Time
@@ -137,6 +139,17 @@ type getconfigContext struct {
 	currentMetricInterval uint32
 
 	configEdgeview *types.EdgeviewConfig // edge-view config save
+
+	// config changes requested by the local profile server
+	localOperations       localOperations
+	localConfigPOSTTicker flextimer.FlexTickerHandle
+	localConfigRequest    chan *profile.LocalConfigRequest
+	lastDevConfig         *zconfig.EdgeDevConfig // last parsed config
+
+	// offline config bundles from USB sticks
+	offlineConfig              chan []byte // verified ConfigResponse
+	pubOfflineBlobImportConfig pubsub.Publication
+	subOfflineBlobImportStatus pubsub.Subscription
 }
 
 // current devUUID from OnboardingStatus
@@ -237,6 +250,22 @@ func configTimerTask(handleChannel chan interface{},
 				warningTime, errorTime)
 			publishZedAgentStatus(getconfigCtx)
 
+		case req := <-getconfigCtx.localConfigRequest:
+			start := time.Now()
+			processLocalConfigRequest(getconfigCtx, req)
+			ctx.ps.CheckMaxTimeTopic(wdName, "processLocalConfigRequest", start,
+				warningTime, errorTime)
+
+		case contents := <-getconfigCtx.offlineConfig:
+			start := time.Now()
+			configProcessingSkipFlag := applyOfflineConfig(getconfigCtx, contents)
+			if configProcessingSkipFlag != getconfigCtx.configProcessingSkipFlag {
+				getconfigCtx.configProcessingSkipFlag = configProcessingSkipFlag
+				triggerPublishDevInfo(ctx)
+			}
+			ctx.ps.CheckMaxTimeTopic(wdName, "applyOfflineConfig", start,
+				warningTime, errorTime)
+
 		case <-tickerInfo.C:
 			start := time.Now()
 			triggerPublishDevInfo(ctx)
@@ -65,12 +65,38 @@ func parseContentInfoConfig(ctx *getconfigContext,
 		contentConfig.ContentSha256 = strings.ToLower(cfgContentTree.GetSha256())
 		contentConfig.MaxDownloadSize = cfgContentTree.GetMaxSizeBytes()
 		contentConfig.DisplayName = cfgContentTree.GetDisplayName()
+		contentConfig.Schedule = parseContentSchedule(cfgContentTree.GetSchedule())
+		contentConfig.SignaturePolicy = parseSignaturePolicy(
+			cfgContentTree.GetSignaturePolicy())
 		publishContentTreeConfig(ctx, *contentConfig)
 	}
 	ctx.pubContentTreeConfig.SignalRestarted()
 	log.Functionf("parsing content info config done\n")
 }
 
+// parseSignaturePolicy returns the policy for the signatures of OCI
+// images of a content tree or a datastore
+func parseSignaturePolicy(cfgPolicy *zconfig.SignaturePolicy) types.SignaturePolicy {
+	var policy types.SignaturePolicy
+	for _, v := range cfgPolicy.GetCosign() {
+		policy.Cosign = append(policy.Cosign, types.CosignVerifier{
+			PublicKeyPEM:      v.GetPublicKeyPem(),
+			RootsPEM:          v.GetRootsPem(),
+			RekorPublicKeyPEM: v.GetRekorPublicKeyPem(),
+			Identity:          v.GetIdentity(),
+			Issuer:            v.GetIssuer(),
+			PredicateType:     v.GetPredicateType(),
+		})
+	}
+	for _, v := range cfgPolicy.GetNotation() {
+		policy.Notation = append(policy.Notation, types.NotationVerifier{
+			RootsPEM:          v.GetRootsPem(),
+			TrustedIdentities: v.GetTrustedIdentities(),
+		})
+	}
+	return policy
+}
+
 func publishContentTreeConfig(ctx *getconfigContext,
 	config types.ContentTreeConfig) {
 	key := config.Key()
@@ -397,6 +397,8 @@ func publishMetrics(ctx *zedagentContext, iteration int) {
 			urlMet.RecvByteCount = um.RecvByteCount
 			urlMet.TotalTimeSpent = um.TotalTimeSpent
 			urlMet.SessResumeCount = um.SessionResume
+			urlMet.SentBytesSaved = um.SentBytesSaved
+			urlMet.RecvBytesSaved = um.RecvBytesSaved
 			metric.UrlMetrics = append(metric.UrlMetrics, urlMet)
 		}
 		ReportDeviceMetric.Zedcloud = append(ReportDeviceMetric.Zedcloud,
@@ -474,6 +476,7 @@ func publishMetrics(ctx *zedagentContext, iteration int) {
 		cipherMetricsDM,
 		cipherMetricsNim,
 		cipherMetricsZR,
+		cipherMetricsVM,
 	}
 	for _, cm := range cipherMetrics {
 		log.Functionf("Cipher metrics for %s: %+v", cm.AgentName, cm)
@@ -678,6 +681,20 @@ func publishMetrics(ctx *zedagentContext, iteration int) {
 			// New AppMemoryMetric fields
 			ReportAppMetric.AppMemory.AllocatedMB = dm.AllocatedMB
 			ReportAppMetric.AppMemory.UsedMB = dm.UsedMemory
+			if b := dm.Balloon; b != nil {
+				ReportAppMetric.AppMemory.Balloon = &metrics.AppBalloonMetric{
+					GuaranteedMB: b.GuaranteedMB,
+					MaxMB:        b.MaxMB,
+					ActualMB:     b.ActualMB,
+					TargetMB:     b.TargetMB,
+					FreeMB:       b.FreeMB,
+					AvailableMB:  b.AvailableMB,
+					DiskCachesMB: b.DiskCachesMB,
+					MajorFaults:  b.MajorFaults,
+					SwapInBytes:  b.SwapInBytes,
+					SwapOutBytes: b.SwapOutBytes,
+				}
+			}
 
 			// Deprecated MemoryMetric fields
 			ReportAppMetric.Memory.UsedMem = dm.UsedMemory
@@ -1150,6 +1167,46 @@ func PublishAppInfoToZedCloud(ctx *zedagentContext, uuid string,
 			ReportAppInfo.VolumeRefs = append(ReportAppInfo.VolumeRefs,
 				vr.VolumeID.String())
 		}
+		if !aiStatus.ActivationDeferredUntil.IsZero() {
+			ReportAppInfo.ActivationDeferredUntil, _ =
+				ptypes.TimestampProto(aiStatus.ActivationDeferredUntil)
+		}
+		ReportAppInfo.RestartCount = aiStatus.RestartCount
+		ReportAppInfo.LastExitReason = aiStatus.LastExitReason
+		if !aiStatus.LastExitTime.IsZero() {
+			ReportAppInfo.LastExitTime, _ =
+				ptypes.TimestampProto(aiStatus.LastExitTime)
+		}
+		for _, pcs := range aiStatus.PodContainerStatusList {
+			podContainer := &info.ZInfoPodContainer{
+				Name:           pcs.Name,
+				State:          pcs.State.ZSwState(),
+				ExitCode:       int32(pcs.ExitCode),
+				RestartCount:   pcs.RestartCount,
+				LastExitReason: pcs.LastExitReason,
+			}
+			if !pcs.LastExitTime.IsZero() {
+				podContainer.LastExitTime, _ =
+					ptypes.TimestampProto(pcs.LastExitTime)
+			}
+			ReportAppInfo.PodContainers = append(ReportAppInfo.PodContainers,
+				podContainer)
+		}
+		if aiStatus.Health.State != types.HealthUnknown {
+			ReportAppInfo.Health = &info.ZInfoAppHealth{
+				State:         info.HealthState(aiStatus.Health.State),
+				FailingStreak: aiStatus.Health.FailingStreak,
+				LastOutput:    aiStatus.Health.LastOutput,
+				RestartCount:  aiStatus.Health.RestartCount,
+			}
+			if !aiStatus.Health.LastCheckTime.IsZero() {
+				ReportAppInfo.Health.LastCheckTime, _ =
+					ptypes.TimestampProto(aiStatus.Health.LastCheckTime)
+			}
+		}
+		if !aiStatus.GuestInfo.UpdateTime.IsZero() {
+			ReportAppInfo.Guest = encodeGuestInfo(aiStatus.GuestInfo)
+		}
 	}
 
 	ReportInfo.InfoContent = new(info.ZInfoMsg_Ainfo)
@@ -1220,6 +1277,13 @@ func PublishContentInfoToZedCloud(ctx *zedagentContext, uuid string,
 		ReportContentInfo.ProgressPercentage = uint32(ctStatus.Progress)
 		ReportContentInfo.GenerationCount = ctStatus.GenerationCounter
 		ReportContentInfo.ComponentShaList = ctStatus.Blobs
+		if !ctStatus.ETA.IsZero() {
+			ReportContentInfo.Eta, _ = ptypes.TimestampProto(ctStatus.ETA)
+		}
+		if !ctStatus.DeferredUntil.IsZero() {
+			ReportContentInfo.DownloadDeferredUntil, _ =
+				ptypes.TimestampProto(ctStatus.DeferredUntil)
+		}
 	}
 
 	ReportInfo.InfoContent = new(info.ZInfoMsg_Cinfo)
@@ -1298,6 +1362,12 @@ func PublishVolumeToZedCloud(ctx *zedagentContext, uuid string,
 
 		ReportVolumeInfo.ProgressPercentage = uint32(volStatus.Progress)
 		ReportVolumeInfo.GenerationCount = volStatus.GenerationCounter
+		if volStatus.BackupPolicy.Enabled() {
+			ReportVolumeInfo.Backup = encodeVolumeBackupInfo(volStatus.Backup)
+		}
+		if volStatus.KeyPolicy.OwnKey {
+			ReportVolumeInfo.Key = encodeVolumeKeyInfo(volStatus.DataKey)
+		}
 	}
 
 	ReportInfo.InfoContent = new(info.ZInfoMsg_Vinfo)
@@ -1327,6 +1397,66 @@ func PublishVolumeToZedCloud(ctx *zedagentContext, uuid string,
 	zedcloud.HandleDeferred(zedcloudCtx, time.Now(), 0, true)
 }
 
+func encodeGuestInfo(guest types.GuestInfo) *info.ZInfoAppGuest {
+	guestInfo := &info.ZInfoAppGuest{
+		OsName:        guest.OSName,
+		OsVersion:     guest.OSVersion,
+		KernelRelease: guest.KernelRelease,
+		Hostname:      guest.Hostname,
+	}
+	for _, intf := range guest.Interfaces {
+		guestIntf := &info.ZInfoGuestInterface{
+			Name:       intf.Name,
+			MacAddress: intf.MacAddress,
+		}
+		for _, ip := range intf.IPAddrs {
+			guestIntf.IpAddrs = append(guestIntf.IpAddrs, ip.String())
+		}
+		guestInfo.Interfaces = append(guestInfo.Interfaces, guestIntf)
+	}
+	for _, fs := range guest.Filesystems {
+		guestInfo.Filesystems = append(guestInfo.Filesystems,
+			&info.ZInfoGuestFilesystem{
+				Mountpoint: fs.Mountpoint,
+				Type:       fs.Type,
+				TotalBytes: fs.TotalBytes,
+				UsedBytes:  fs.UsedBytes,
+			})
+	}
+	guestInfo.UpdateTime, _ = ptypes.TimestampProto(guest.UpdateTime)
+	return guestInfo
+}
+
+func encodeVolumeBackupInfo(backup types.VolumeBackupStatus) *info.VolumeBackupInfo {
+	backupInfo := &info.VolumeBackupInfo{
+		State:           backup.State.ZVolumeBackupState(),
+		LastName:        backup.LastName,
+		LastSha256:      backup.LastSha256,
+		LastIncremental: backup.LastIncremental,
+		LastSizeBytes:   backup.LastSize,
+		Progress:        uint32(backup.Progress),
+		Error:           encodeErrorInfo(backup.ErrorDescription),
+	}
+	if !backup.LastTime.IsZero() {
+		backupInfo.LastTime, _ = ptypes.TimestampProto(backup.LastTime)
+	}
+	if !backup.NextTime.IsZero() {
+		backupInfo.NextTime, _ = ptypes.TimestampProto(backup.NextTime)
+	}
+	return backupInfo
+}
+
+func encodeVolumeKeyInfo(key types.VolumeKeyStatus) *info.VolumeKeyInfo {
+	keyInfo := &info.VolumeKeyInfo{
+		Generation: key.Generation,
+		Error:      encodeErrorInfo(key.ErrorDescription),
+	}
+	if !key.LastRotation.IsZero() {
+		keyInfo.LastRotation, _ = ptypes.TimestampProto(key.LastRotation)
+	}
+	return keyInfo
+}
+
 // PublishBlobInfoToZedCloud is called per change, hence needs to try over all management ports
 // When blob Status is nil it means a delete and we send a message
 // containing only the UUID to inform zedcloud about the delete.
@@ -1506,11 +1636,16 @@ func SendMetricsProtobuf(ctx *getconfigContext, ReportMetrics *metrics.ZMetricMs
 	const bailOnHTTPErr = false
 	ctxWork, cancel := zedcloud.GetContextForAllIntfFunctions(zedcloudCtx)
 	defer cancel()
+	ctxWork = zedcloud.WithTrafficClass(ctxWork, types.TrafficClassMetrics)
 	_, _, rtf, err := zedcloud.SendOnAllIntf(ctxWork, zedcloudCtx, metricsUrl,
 		size, buf, iteration, bailOnHTTPErr)
 	if err != nil {
 		// Hopefully next timeout will be more successful
 		log.Errorf("SendMetricsProtobuf status %d failed: %s", rtf, err)
+		if ctx.zedagentCtx.globalConfig.GlobalValueBool(types.OfflineUSBBundle) {
+			// Keep for an export to a USB stick
+			queueOfflineMetrics(data)
+		}
 		return
 	} else {
 		maybeUpdateMetricsTimer(ctx, true)
@@ -1543,11 +1678,22 @@ func createVolumeInstanceMetrics(ctx *zedagentContext, reportMetrics *metrics.ZM
 	if volumelist == nil || len(volumelist) == 0 {
 		return
 	}
+	diskIO := getVolumeDiskIOMetrics(ctx)
 	for _, volume := range volumelist {
 		volumeStatus := volume.(types.VolumeStatus)
 		volumeMetric := new(metrics.ZMetricVolume)
 		volumeMetric.Uuid = volumeStatus.VolumeID.String()
 		volumeMetric.DisplayName = volumeStatus.DisplayName
+		if io, ok := diskIO[volumeStatus.Key()]; ok {
+			volumeMetric.ReadBytes = io.ReadBytes
+			volumeMetric.WriteBytes = io.WriteBytes
+			volumeMetric.ReadCount = io.ReadOps
+			volumeMetric.WriteCount = io.WriteOps
+		}
+		volumeMetric.ReadBytesPerSecLimit = volumeStatus.IOLimits.ReadBps
+		volumeMetric.WriteBytesPerSecLimit = volumeStatus.IOLimits.WriteBps
+		volumeMetric.ReadIopsLimit = volumeStatus.IOLimits.ReadIops
+		volumeMetric.WriteIopsLimit = volumeStatus.IOLimits.WriteIops
 		if volumeStatus.FileLocation == "" {
 			log.Functionf("FileLocation is empty for %s", volumeStatus.Key())
 		} else {
@@ -1558,6 +1704,24 @@ func createVolumeInstanceMetrics(ctx *zedagentContext, reportMetrics *metrics.ZM
 	log.Tracef("Volume instance metrics done: %v", reportMetrics.Vm)
 }
 
+// getVolumeDiskIOMetrics sums up the disk I/O of the app instances by volume
+func getVolumeDiskIOMetrics(ctx *zedagentContext) map[string]types.DiskIOMetric {
+	diskIO := make(map[string]types.DiskIOMetric)
+	for _, m := range ctx.getconfigCtx.subDomainMetric.GetAll() {
+		dm := m.(types.DomainMetric)
+		for _, io := range dm.DiskIO {
+			sum := diskIO[io.VolumeKey]
+			sum.VolumeKey = io.VolumeKey
+			sum.ReadBytes += io.ReadBytes
+			sum.WriteBytes += io.WriteBytes
+			sum.ReadOps += io.ReadOps
+			sum.WriteOps += io.WriteOps
+			diskIO[io.VolumeKey] = sum
+		}
+	}
+	return diskIO
+}
+
 func getVolumeResourcesMetrics(ctx *zedagentContext, name string, volumeMetric *metrics.ZMetricVolume) error {
 	appDiskMetric := lookupAppDiskMetric(ctx, name)
 	if appDiskMetric == nil {
@@ -628,6 +628,7 @@ func publishFlowMessage(flowMsg *flowlog.FlowMessage, iteration int) error {
 	const bailOnHTTPErr = false
 	ctxWork, cancel := zedcloud.GetContextForAllIntfFunctions(zedcloudCtx)
 	defer cancel()
+	ctxWork = zedcloud.WithTrafficClass(ctxWork, types.TrafficClassLogs)
 	_, _, rtf, err := zedcloud.SendOnAllIntf(ctxWork, zedcloudCtx, flowlogURL,
 		size, buf, iteration, bailOnHTTPErr)
 	if err != nil {
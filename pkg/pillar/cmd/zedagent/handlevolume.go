@@ -9,6 +9,7 @@ import (
 	"bytes"
 	"crypto/sha256"
 	"fmt"
+	"strings"
 
 	zconfig "github.com/lf-edge/eve/api/go/config"
 	"github.com/lf-edge/eve/pkg/pillar/types"
@@ -81,7 +82,21 @@ func parseVolumeConfig(ctx *getconfigContext,
 		if volumeOrigin != nil {
 			volumeConfig.VolumeContentOriginType = volumeOrigin.GetType()
 			volumeConfig.ContentID, _ = uuid.FromString(volumeOrigin.GetDownloadContentTreeID())
+			if backup := volumeOrigin.GetBackup(); backup != nil {
+				volumeConfig.BackupRef.DatastoreID, _ = uuid.FromString(backup.GetDatastoreId())
+				volumeConfig.BackupRef.Name = backup.GetName()
+				volumeConfig.BackupRef.Sha256 = strings.ToLower(backup.GetSha256())
+			}
+		}
+		if backup := cfgVolume.GetBackup(); backup != nil {
+			volumeConfig.BackupPolicy.DatastoreID, _ = uuid.FromString(backup.GetDatastoreId())
+			volumeConfig.BackupPolicy.Interval = backup.GetInterval()
+			volumeConfig.BackupPolicy.Counter = backup.GetCounter()
+			volumeConfig.BackupPolicy.Incremental = backup.GetIncremental()
+			volumeConfig.BackupPolicy.MaxIncremental = backup.GetMaxIncremental()
 		}
+		volumeConfig.BackupKey = parseCipherBlock(ctx, volumeConfig.VolumeID.String()+"-backup-key",
+			cfgVolume.GetBackupKey())
 		volumeConfig.MaxVolSize = uint64(cfgVolume.GetMaxsizebytes())
 		volumeConfig.GenerationCounter = cfgVolume.GetGenerationCount()
 		if cfgVolume.GetClearText() {
@@ -91,6 +106,27 @@ func parseVolumeConfig(ctx *getconfigContext,
 		}
 		volumeConfig.DisplayName = cfgVolume.GetDisplayName()
 		volumeConfig.ReadOnly = cfgVolume.GetReadonly()
+		volumeConfig.Shared = cfgVolume.GetSharing() == zconfig.VolumeSharing_VOLUME_SHARING_READ_WRITE_MANY
+		if limits := cfgVolume.GetIoLimits(); limits != nil {
+			volumeConfig.IOLimits = types.VolumeIOLimits{
+				ReadBps:   limits.GetReadBytesPerSec(),
+				WriteBps:  limits.GetWriteBytesPerSec(),
+				ReadIops:  limits.GetReadIops(),
+				WriteIops: limits.GetWriteIops(),
+			}
+		}
+		if keyPolicy := cfgVolume.GetKeyPolicy(); keyPolicy != nil {
+			volumeConfig.KeyPolicy.OwnKey = keyPolicy.GetOwnKey()
+			volumeConfig.KeyPolicy.RotateCounter = keyPolicy.GetRotateCounter()
+		}
+		if fs := cfgVolume.GetFilesystem(); fs != nil {
+			volumeConfig.Filesystem = types.VolumeFilesystem{
+				Type:  fs.GetType(),
+				Label: fs.GetLabel(),
+				UID:   fs.GetUid(),
+				GID:   fs.GetGid(),
+			}
+		}
 		volumeConfig.RefCount = 1
 		volumeConfig.HasNoAppReferences = checkVolumeHasNoAppReferences(ctx, cfgVolume, config)
 
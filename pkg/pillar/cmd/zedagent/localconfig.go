@@ -0,0 +1,874 @@
+// Copyright (c) 2022 Zededa, Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+// Local configuration changes requested by the Local profile server using
+// the api/v1/localconfig API. The changes are kept in LocalCommands and
+// applied on top of the config from the controller whenever it is parsed.
+// What may be changed is set by the LocalOperationsPolicy from the controller.
+
+package zedagent
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"path/filepath"
+	"regexp"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/golang/protobuf/ptypes"
+	zconfig "github.com/lf-edge/eve/api/go/config"
+	"github.com/lf-edge/eve/api/go/info"
+	"github.com/lf-edge/eve/api/go/profile"
+	"github.com/lf-edge/eve/pkg/pillar/flextimer"
+	"github.com/lf-edge/eve/pkg/pillar/types"
+	"github.com/lf-edge/eve/pkg/pillar/zedcloud"
+	uuid "github.com/satori/go.uuid"
+)
+
+const (
+	localConfigURLPath               = "/api/v1/localconfig"
+	localConfigAPIVersion            = 1
+	localConfigPOSTInterval          = time.Minute
+	localConfigPOSTThrottledInterval = time.Hour
+	savedLocalOperationsFile         = "localoperations"
+	// Number of audit trail entries we keep and report
+	maxLocalOperationRecords = 64
+)
+
+var (
+	throttledLocalConfig bool
+	sha256Regexp         = regexp.MustCompile("^[0-9a-fA-F]{64}$")
+)
+
+// localOperations holds the controller policy for, and the audit trail of,
+// the operations requested using the api/v1/localconfig API.
+// Lock order is localCommands before localOperations.
+type localOperations struct {
+	sync.Mutex
+	policy  types.LocalOperationsPolicy
+	records []types.LocalOperationRecord
+}
+
+// updateLocalConfigTicker sets ticker options to the initial value
+// if throttle set, will use localConfigPOSTThrottledInterval as interval
+func updateLocalConfigTicker(ctx *getconfigContext, throttle bool) {
+	interval := float64(localConfigPOSTInterval)
+	if throttle {
+		interval = float64(localConfigPOSTThrottledInterval)
+	}
+	max := 1.1 * interval
+	min := 0.8 * max
+	throttledLocalConfig = throttle
+	ctx.localConfigPOSTTicker.UpdateRangeTicker(time.Duration(min), time.Duration(max))
+}
+
+func initializeLocalConfig(ctx *getconfigContext) {
+	max := 1.1 * float64(localConfigPOSTInterval)
+	min := 0.8 * max
+	ctx.localConfigPOSTTicker = flextimer.NewRangeTicker(time.Duration(min), time.Duration(max))
+	// Requests are handled by the config task, and are submitted again
+	// by the local server if we drop them
+	ctx.localConfigRequest = make(chan *profile.LocalConfigRequest, 1)
+	loadLocalOperationRecords(ctx)
+}
+
+func triggerLocalConfigPOST(ctx *getconfigContext) {
+	log.Functionf("Triggering POST for %s to local server", localConfigURLPath)
+	if throttledLocalConfig {
+		log.Functionln("throttledLocalConfig flag set")
+		return
+	}
+	ctx.localConfigPOSTTicker.TickNow()
+}
+
+// Run a periodic POST request to send the local operations policy and
+// audit trail to the local server and optionally receive config changes
+// in the response.
+func localConfigPOSTTask(ctx *getconfigContext) {
+
+	log.Functionf("localConfigPOSTTask: waiting for localConfigPOSTTicker")
+	// wait for the first trigger
+	<-ctx.localConfigPOSTTicker.C
+	log.Functionln("localConfigPOSTTask: waiting for localConfigPOSTTicker done")
+	// trigger again to pass into the loop
+	triggerLocalConfigPOST(ctx)
+
+	wdName := agentName + "-localconfig"
+
+	// Run a periodic timer so we always update StillRunning
+	stillRunning := time.NewTicker(25 * time.Second)
+	ctx.zedagentCtx.ps.StillRunning(wdName, warningTime, errorTime)
+	ctx.zedagentCtx.ps.RegisterFileWatchdog(wdName)
+
+	for {
+		select {
+		case <-ctx.localConfigPOSTTicker.C:
+			start := time.Now()
+			req := postLocalConfigInfo(ctx)
+			if req != nil {
+				select {
+				case ctx.localConfigRequest <- req:
+				default:
+					log.Warnf("localConfigPOSTTask: previous request still pending")
+				}
+			} else {
+				ctx.localCommands.Lock()
+				if ctx.localCommands.HasLocalChanges() {
+					// Keep the local changes from becoming stale
+					touchLocalCommands()
+				}
+				ctx.localCommands.Unlock()
+			}
+			ctx.zedagentCtx.ps.CheckMaxTimeTopic(wdName, "localConfigPOSTTask", start,
+				warningTime, errorTime)
+		case <-stillRunning.C:
+		}
+		ctx.zedagentCtx.ps.StillRunning(wdName, warningTime, errorTime)
+	}
+}
+
+// Post the policy and audit trail to the local server and optionally
+// receive a set of operations to apply in the response.
+func postLocalConfigInfo(ctx *getconfigContext) *profile.LocalConfigRequest {
+	localProfileServer := ctx.localProfileServer
+	if localProfileServer == "" {
+		return nil
+	}
+	localServerURL, err := makeLocalServerBaseURL(localProfileServer)
+	if err != nil {
+		log.Errorf("postLocalConfigInfo: makeLocalServerBaseURL: %v", err)
+		return nil
+	}
+	if !ctx.localServerMap.upToDate {
+		err := updateLocalServerMap(ctx, localServerURL)
+		if err != nil {
+			log.Errorf("postLocalConfigInfo: updateLocalServerMap: %v", err)
+			return nil
+		}
+		// Make sure HasLocalServer is set correctly for the AppInstanceConfig
+		updateHasLocalServer(ctx)
+	}
+	srvMap := ctx.localServerMap.servers
+	if len(srvMap) == 0 {
+		log.Functionf("postLocalConfigInfo: cannot find any configured apps for localServerURL: %s",
+			localServerURL)
+		return nil
+	}
+
+	localInfo := prepareLocalConfigInfo(ctx)
+	var errList []string
+	for bridgeName, servers := range srvMap {
+		for _, srv := range servers {
+			fullURL := srv.localServerAddr + localConfigURLPath
+			req := &profile.LocalConfigRequest{}
+			resp, err := zedcloud.SendLocalProto(
+				zedcloudCtx, fullURL, bridgeName, srv.bridgeIP, localInfo, req)
+			if err != nil {
+				errList = append(errList, fmt.Sprintf("SendLocalProto: %v", err))
+				continue
+			}
+			switch resp.StatusCode {
+			case http.StatusNotFound:
+				// Throttle sending to be about once per hour.
+				updateLocalConfigTicker(ctx, true)
+				return nil
+			case http.StatusOK:
+				if len(req.Operations) != 0 {
+					if req.GetServerToken() != ctx.profileServerToken {
+						errList = append(errList,
+							fmt.Sprintf("invalid token submitted by local server (%s)", req.GetServerToken()))
+						continue
+					}
+					updateLocalConfigTicker(ctx, false)
+					return req
+				}
+				// No content in the response.
+				fallthrough
+			case http.StatusNoContent:
+				log.Functionf("Local server %s does not require config changes",
+					localServerURL)
+				updateLocalConfigTicker(ctx, false)
+				return nil
+			default:
+				errList = append(errList, fmt.Sprintf("postLocalConfigInfo: wrong response status code: %d",
+					resp.StatusCode))
+				continue
+			}
+		}
+	}
+	log.Errorf("postLocalConfigInfo: all attempts failed: %s", strings.Join(errList, ";"))
+	return nil
+}
+
+func prepareLocalConfigInfo(ctx *getconfigContext) *profile.LocalConfigInfo {
+	ctx.localOperations.Lock()
+	defer ctx.localOperations.Unlock()
+	policy := ctx.localOperations.policy
+	return &profile.LocalConfigInfo{
+		ApiVersion: localConfigAPIVersion,
+		DeviceUuid: devUUID.String(),
+		Policy: &zconfig.LocalOperationsPolicy{
+			AppActivate:    policy.AppActivate,
+			GlobalSettings: policy.GlobalSettings,
+			PortMaps:       policy.PortMaps,
+			BaseOsUpdate:   policy.BaseOsUpdate,
+		},
+		Records: encodeLocalOperationRecords(ctx.localOperations.records),
+	}
+}
+
+// getLocalOperationsInfo returns the audit trail for the device info
+func getLocalOperationsInfo(ctx *getconfigContext) *info.LocalOperationsInfo {
+	ctx.localOperations.Lock()
+	defer ctx.localOperations.Unlock()
+	if len(ctx.localOperations.records) == 0 {
+		return nil
+	}
+	return &info.LocalOperationsInfo{
+		ApiVersion: localConfigAPIVersion,
+		Records:    encodeLocalOperationRecords(ctx.localOperations.records),
+	}
+}
+
+func encodeLocalOperationRecords(records []types.LocalOperationRecord) []*info.LocalOperationRecord {
+	var result []*info.LocalOperationRecord
+	for _, record := range records {
+		ts, _ := ptypes.TimestampProto(record.Time)
+		result = append(result, &info.LocalOperationRecord{
+			Time:               ts,
+			OperationId:        record.OperationID,
+			OperationTimestamp: record.OperationTimestamp,
+			Type:               info.LocalOperationType(record.Type),
+			Target:             record.Target,
+			Details:            record.Details,
+			Result:             info.LocalOperationResult(record.Result),
+			Error:              record.Error,
+		})
+	}
+	return result
+}
+
+// addLocalOperationRecord adds an entry to the audit trail
+func addLocalOperationRecord(ctx *getconfigContext, record types.LocalOperationRecord) {
+	record.Time = time.Now()
+	log.Noticef("Local operation %s: type %d target %s: %s: result %d %s",
+		record.OperationID, record.Type, record.Target, record.Details,
+		record.Result, record.Error)
+	ctx.localOperations.Lock()
+	defer ctx.localOperations.Unlock()
+	records := append(ctx.localOperations.records, record)
+	if len(records) > maxLocalOperationRecords {
+		records = records[len(records)-maxLocalOperationRecords:]
+	}
+	ctx.localOperations.records = records
+	contents, err := json.Marshal(records)
+	if err != nil {
+		log.Fatalf("addLocalOperationRecord: Marshalling failed: %v", err)
+	}
+	saveConfig(savedLocalOperationsFile, contents)
+}
+
+func loadLocalOperationRecords(ctx *getconfigContext) {
+	// The audit trail never becomes stale
+	contents, _, err := readSavedConfig(0,
+		filepath.Join(checkpointDirname, savedLocalOperationsFile), true)
+	if err != nil {
+		log.Errorf("loadLocalOperationRecords failed: %v", err)
+		return
+	}
+	if contents == nil {
+		return
+	}
+	var records []types.LocalOperationRecord
+	if err := json.Unmarshal(contents, &records); err != nil {
+		log.Errorf("loadLocalOperationRecords: %v", err)
+		return
+	}
+	ctx.localOperations.Lock()
+	ctx.localOperations.records = records
+	ctx.localOperations.Unlock()
+}
+
+// parseLocalOperationsPolicy is called first by parseConfig. Local changes
+// which are no longer permitted are dropped before the config is parsed.
+// ctx.localCommands should be locked!
+func parseLocalOperationsPolicy(config *zconfig.EdgeDevConfig,
+	getconfigCtx *getconfigContext) {
+
+	getconfigCtx.lastDevConfig = config
+	var policy types.LocalOperationsPolicy
+	if cfgPolicy := config.GetLocalOperationsPolicy(); cfgPolicy != nil {
+		policy.AppActivate = cfgPolicy.AppActivate
+		policy.GlobalSettings = cfgPolicy.GlobalSettings
+		policy.PortMaps = cfgPolicy.PortMaps
+		policy.BaseOsUpdate = cfgPolicy.BaseOsUpdate
+	}
+	getconfigCtx.localOperations.Lock()
+	getconfigCtx.localOperations.policy = policy
+	getconfigCtx.localOperations.Unlock()
+
+	lc := getconfigCtx.localCommands
+	revoke := func(opType types.LocalOperationType, target string) {
+		addLocalOperationRecord(getconfigCtx, types.LocalOperationRecord{
+			Type:    opType,
+			Target:  target,
+			Details: "no longer permitted by the controller",
+			Result:  types.LocalOperationResultRevoked,
+		})
+	}
+	changed := false
+	if !policy.AppActivate {
+		for appUUID := range lc.AppActivate {
+			revoke(types.LocalOperationTypeAppActivate, appUUID)
+			appinstancePrevConfigHash = nil
+			changed = true
+		}
+		lc.AppActivate = nil
+	}
+	if !policy.PortMaps {
+		for appUUID := range lc.PortMaps {
+			revoke(types.LocalOperationTypePortMap, appUUID)
+			appinstancePrevConfigHash = nil
+			changed = true
+		}
+		lc.PortMaps = nil
+	}
+	for key := range lc.GlobalSettings {
+		if !policy.GlobalSettingPermitted(key) {
+			revoke(types.LocalOperationTypeGlobalSetting, key)
+			delete(lc.GlobalSettings, key)
+			itemsPrevConfigHash = nil
+			changed = true
+		}
+	}
+	if !policy.BaseOsUpdate && lc.BaseOsUpdate != nil {
+		revoke(types.LocalOperationTypeBaseOsUpdate, lc.BaseOsUpdate.BaseOsVersion)
+		dropLocalBaseOsUpdate(getconfigCtx)
+		changed = true
+	}
+	if changed {
+		persistLocalCommands(lc)
+	}
+}
+
+// processLocalConfigRequest applies the operations requested by the local
+// server. Runs in the config task to serialize with parseConfig.
+func processLocalConfigRequest(getconfigCtx *getconfigContext,
+	req *profile.LocalConfigRequest) {
+
+	getconfigCtx.localCommands.Lock()
+	defer getconfigCtx.localCommands.Unlock()
+	ctx := getconfigCtx.zedagentCtx
+	lc := getconfigCtx.localCommands
+
+	if req.GetApiVersion() > localConfigAPIVersion {
+		log.Warnf("processLocalConfigRequest: unsupported API version %d",
+			req.GetApiVersion())
+		return
+	}
+	config := getconfigCtx.lastDevConfig
+	if config == nil {
+		// Handled once the config from the controller is parsed
+		log.Warnf("processLocalConfigRequest: no config yet")
+		return
+	}
+	getconfigCtx.localOperations.Lock()
+	policy := getconfigCtx.localOperations.policy
+	getconfigCtx.localOperations.Unlock()
+
+	var appsChanged, itemsChanged, baseOsChanged, cmdChanges bool
+	seen := make(map[string]bool)
+	for _, op := range req.GetOperations() {
+		if op.GetId() == "" {
+			log.Warnf("processLocalConfigRequest: operation without id: %+v", op)
+			continue
+		}
+		seen[op.GetId()] = true
+		if ts, handled := lc.Operations[op.GetId()]; handled && ts == op.GetTimestamp() {
+			// already handled
+			continue
+		}
+		if lc.Operations == nil {
+			lc.Operations = make(map[string]uint64)
+		}
+		lc.Operations[op.GetId()] = op.GetTimestamp()
+		cmdChanges = true
+
+		var record types.LocalOperationRecord
+		var err error
+		switch {
+		case op.GetAppActivate() != nil:
+			record.Type = types.LocalOperationTypeAppActivate
+			record.Target = appReference(op.GetAppActivate().GetId(),
+				op.GetAppActivate().GetDisplayname())
+			if !policy.AppActivate {
+				break
+			}
+			err = applyLocalAppActivate(getconfigCtx, op.GetAppActivate(), &record)
+			appsChanged = appsChanged || err == nil
+		case op.GetGlobalSetting() != nil:
+			record.Type = types.LocalOperationTypeGlobalSetting
+			record.Target = op.GetGlobalSetting().GetKey()
+			if !policy.GlobalSettingPermitted(record.Target) {
+				break
+			}
+			err = applyLocalGlobalSetting(getconfigCtx, op.GetGlobalSetting(), &record)
+			itemsChanged = itemsChanged || err == nil
+		case op.GetPortMap() != nil:
+			record.Type = types.LocalOperationTypePortMap
+			record.Target = appReference(op.GetPortMap().GetAppId(),
+				op.GetPortMap().GetAppDisplayname())
+			if !policy.PortMaps {
+				break
+			}
+			err = applyLocalPortMap(getconfigCtx, op.GetPortMap(), &record)
+			appsChanged = appsChanged || err == nil
+		case op.GetBaseOsUpdate() != nil:
+			record.Type = types.LocalOperationTypeBaseOsUpdate
+			record.Target = op.GetBaseOsUpdate().GetBaseOsVersion()
+			if !policy.BaseOsUpdate {
+				break
+			}
+			err = applyLocalBaseOsUpdate(getconfigCtx, op.GetBaseOsUpdate(), &record)
+			baseOsChanged = baseOsChanged || err == nil
+		default:
+			err = fmt.Errorf("unsupported operation")
+		}
+		record.OperationID = op.GetId()
+		record.OperationTimestamp = op.GetTimestamp()
+		switch {
+		case err != nil:
+			record.Result = types.LocalOperationResultFailed
+			record.Error = err.Error()
+		case record.Result == types.LocalOperationResultUnspecified:
+			record.Result = types.LocalOperationResultRejected
+			record.Error = "not permitted by the controller"
+		}
+		addLocalOperationRecord(getconfigCtx, record)
+	}
+	// Forget operations which the local server no longer submits
+	for id := range lc.Operations {
+		if !seen[id] {
+			delete(lc.Operations, id)
+			cmdChanges = true
+		}
+	}
+	if cmdChanges {
+		persistLocalCommands(lc)
+	} else {
+		touchLocalCommands()
+	}
+
+	// Apply the changes on top of the last config from the controller
+	if itemsChanged {
+		itemsPrevConfigHash = nil
+		parseConfigItems(config, getconfigCtx)
+	}
+	if baseOsChanged {
+		baseOSConfigPrevConfigHash = nil
+		parseBaseOsConfig(getconfigCtx, config)
+	}
+	if appsChanged {
+		appinstancePrevConfigHash = nil
+		parseAppInstanceConfig(config, getconfigCtx)
+	}
+	if itemsChanged || baseOsChanged || appsChanged {
+		triggerPublishDevInfo(ctx)
+	}
+	triggerLocalConfigPOST(getconfigCtx)
+}
+
+// localConfigSuspended returns an error if config changes are not applied
+func localConfigSuspended(getconfigCtx *getconfigContext) error {
+	ctx := getconfigCtx.zedagentCtx
+	if getconfigCtx.configProcessingSkipFlag || ctx.deviceReboot || ctx.deviceShutdown {
+		return fmt.Errorf("config processing is suspended for reboot/shutdown")
+	}
+	if ctx.maintenanceMode {
+		return fmt.Errorf("device is in maintenance mode")
+	}
+	return nil
+}
+
+// ctx.localCommands should be locked!
+func applyLocalAppActivate(ctx *getconfigContext, op *profile.LocalAppActivate,
+	record *types.LocalOperationRecord) error {
+
+	appInst, err := findLocalOperationApp(ctx, op.GetId(), op.GetDisplayname())
+	if err != nil {
+		return err
+	}
+	appUUID := appInst.UUIDandVersion.UUID.String()
+	record.Target = appUUID
+	if err := localConfigSuspended(ctx); err != nil {
+		return err
+	}
+	lc := ctx.localCommands
+	if op.GetRevert() {
+		delete(lc.AppActivate, appUUID)
+		record.Details = "activate as set by the controller"
+		record.Result = types.LocalOperationResultReverted
+		return nil
+	}
+	if lc.AppActivate == nil {
+		lc.AppActivate = make(map[string]bool)
+	}
+	lc.AppActivate[appUUID] = op.GetActivate()
+	record.Details = fmt.Sprintf("activate %t", op.GetActivate())
+	record.Result = types.LocalOperationResultApplied
+	return nil
+}
+
+// ctx.localCommands should be locked!
+func applyLocalGlobalSetting(ctx *getconfigContext, op *profile.LocalGlobalSetting,
+	record *types.LocalOperationRecord) error {
+
+	if err := localConfigSuspended(ctx); err != nil {
+		return err
+	}
+	key := op.GetKey()
+	lc := ctx.localCommands
+	if op.GetRevert() {
+		delete(lc.GlobalSettings, key)
+		record.Details = "value as set by the controller"
+		record.Result = types.LocalOperationResultReverted
+		return nil
+	}
+	record.Details = fmt.Sprintf("value %q", op.GetValue())
+	zedagentCtx := ctx.zedagentCtx
+	_, err := zedagentCtx.specMap.ParseItem(types.DefaultConfigItemValueMap(),
+		&zedagentCtx.globalConfig, key, op.GetValue())
+	if err != nil {
+		return err
+	}
+	if lc.GlobalSettings == nil {
+		lc.GlobalSettings = make(map[string]string)
+	}
+	lc.GlobalSettings[key] = op.GetValue()
+	record.Result = types.LocalOperationResultApplied
+	return nil
+}
+
+// ctx.localCommands should be locked!
+func applyLocalPortMap(ctx *getconfigContext, op *profile.LocalPortMap,
+	record *types.LocalOperationRecord) error {
+
+	appInst, err := findLocalOperationApp(ctx, op.GetAppId(), op.GetAppDisplayname())
+	if err != nil {
+		return err
+	}
+	appUUID := appInst.UUIDandVersion.UUID.String()
+	record.Target = appUUID
+	portMap := types.LocalPortMap{
+		Protocol:     strings.ToLower(op.GetProtocol()),
+		AppPort:      int(op.GetAppPort()),
+		ExternalPort: int(op.GetExternalPort()),
+	}
+	record.Details = fmt.Sprintf("network instance %s %s port %d to %d",
+		op.GetNetworkInstanceId(), portMap.Protocol, portMap.ExternalPort,
+		portMap.AppPort)
+	portMap.NetworkInstance, err = uuid.FromString(op.GetNetworkInstanceId())
+	if err != nil {
+		return fmt.Errorf("invalid network instance: %v", err)
+	}
+	if portMap.Protocol != "tcp" && portMap.Protocol != "udp" {
+		return fmt.Errorf("unsupported protocol %q", op.GetProtocol())
+	}
+	if portMap.AppPort == 0 || portMap.AppPort > 65535 || portMap.ExternalPort > 65535 {
+		return fmt.Errorf("invalid port")
+	}
+	connected := false
+	for _, ul := range appInst.UnderlayNetworkList {
+		if ul.Network == portMap.NetworkInstance {
+			connected = true
+			break
+		}
+	}
+	if !connected {
+		return fmt.Errorf("app is not connected to network instance %s",
+			portMap.NetworkInstance)
+	}
+	if err := localConfigSuspended(ctx); err != nil {
+		return err
+	}
+	lc := ctx.localCommands
+	var portMaps []types.LocalPortMap
+	for _, pm := range lc.PortMaps[appUUID] {
+		if pm.NetworkInstance != portMap.NetworkInstance ||
+			pm.Protocol != portMap.Protocol || pm.AppPort != portMap.AppPort {
+			portMaps = append(portMaps, pm)
+		}
+	}
+	if op.GetRevert() {
+		record.Details = fmt.Sprintf("network instance %s %s port %d as set by the controller",
+			portMap.NetworkInstance, portMap.Protocol, portMap.AppPort)
+		record.Result = types.LocalOperationResultReverted
+	} else {
+		portMaps = append(portMaps, portMap)
+		record.Result = types.LocalOperationResultApplied
+	}
+	if len(portMaps) == 0 {
+		delete(lc.PortMaps, appUUID)
+		return nil
+	}
+	if lc.PortMaps == nil {
+		lc.PortMaps = make(map[string][]types.LocalPortMap)
+	}
+	lc.PortMaps[appUUID] = portMaps
+	return nil
+}
+
+// ctx.localCommands should be locked!
+func applyLocalBaseOsUpdate(ctx *getconfigContext, op *profile.LocalBaseOsUpdate,
+	record *types.LocalOperationRecord) error {
+
+	update := &types.LocalBaseOsUpdate{
+		BaseOsVersion: op.GetBaseOsVersion(),
+		URL:           op.GetUrl(),
+		Sha256:        strings.ToLower(op.GetSha256()),
+		ControllerConfigHash: hex.EncodeToString(
+			baseOsConfigHash(ctx.lastDevConfig.GetBase())),
+	}
+	record.Details = fmt.Sprintf("from %s sha256 %s", update.URL, update.Sha256)
+	if update.BaseOsVersion == "" {
+		return fmt.Errorf("missing base OS version")
+	}
+	if _, _, err := localBaseOsUpdateConfig(update); err != nil {
+		return err
+	}
+	if err := localConfigSuspended(ctx); err != nil {
+		return err
+	}
+	if ctx.updateInprogress {
+		return fmt.Errorf("base OS update in progress")
+	}
+	if ctx.localCommands.BaseOsUpdate != nil {
+		unpublishLocalBaseOsUpdate(ctx, ctx.localCommands.BaseOsUpdate)
+	}
+	ctx.localCommands.BaseOsUpdate = update
+	record.Result = types.LocalOperationResultApplied
+	return nil
+}
+
+// appReference returns the app UUID if set, otherwise the display name
+func appReference(id string, displayName string) string {
+	if id != "" {
+		return id
+	}
+	return displayName
+}
+
+func findLocalOperationApp(ctx *getconfigContext, id string,
+	displayName string) (*types.AppInstanceConfig, error) {
+
+	appUUID := nilUUID
+	if id != "" {
+		var err error
+		appUUID, err = uuid.FromString(id)
+		if err != nil {
+			return nil, fmt.Errorf("invalid app UUID: %v", err)
+		}
+	}
+	if appUUID == nilUUID && displayName == "" {
+		return nil, fmt.Errorf("missing both app UUID and display name")
+	}
+	appInst := findAppInstance(ctx, appUUID, displayName)
+	if appInst == nil {
+		return nil, fmt.Errorf("app instance not found")
+	}
+	return appInst, nil
+}
+
+// applyLocalPortMaps changes the port map ACEs of the app instance.
+// The first adapter connected to the network instance of the port map
+// is changed.
+func applyLocalPortMaps(appInstance *types.AppInstanceConfig,
+	portMaps []types.LocalPortMap) {
+
+	for _, pm := range portMaps {
+		for i := range appInstance.UnderlayNetworkList {
+			ul := &appInstance.UnderlayNetworkList[i]
+			if ul.Network != pm.NetworkInstance {
+				continue
+			}
+			ul.ACLs = portMapACLs(ul.ACLs, pm)
+			break
+		}
+	}
+}
+
+func portMapACLs(acls []types.ACE, pm types.LocalPortMap) []types.ACE {
+	var result []types.ACE
+	lport := strconv.Itoa(pm.ExternalPort)
+	found := false
+	maxRuleID := int32(0)
+	for _, ace := range acls {
+		if ace.RuleID > maxRuleID {
+			maxRuleID = ace.RuleID
+		}
+		if !isPortMapACE(ace, pm.Protocol, pm.AppPort) {
+			result = append(result, ace)
+			continue
+		}
+		if pm.ExternalPort == 0 || found {
+			// Removed, or a duplicate
+			continue
+		}
+		found = true
+		matches := make([]types.ACEMatch, 0, len(ace.Matches))
+		for _, match := range ace.Matches {
+			if match.Type != "lport" {
+				matches = append(matches, match)
+			}
+		}
+		ace.Matches = append(matches, types.ACEMatch{Type: "lport", Value: lport})
+		result = append(result, ace)
+	}
+	if !found && pm.ExternalPort != 0 {
+		result = append(result, types.ACE{
+			Name:   fmt.Sprintf("local-portmap-%s-%d", pm.Protocol, pm.AppPort),
+			RuleID: maxRuleID + 1,
+			Matches: []types.ACEMatch{
+				{Type: "protocol", Value: pm.Protocol},
+				{Type: "lport", Value: lport},
+			},
+			Actions: []types.ACEAction{
+				{PortMap: true, TargetPort: pm.AppPort},
+			},
+		})
+	}
+	return result
+}
+
+func isPortMapACE(ace types.ACE, protocol string, appPort int) bool {
+	portMap := false
+	for _, action := range ace.Actions {
+		if action.PortMap && action.TargetPort == appPort {
+			portMap = true
+		}
+	}
+	if !portMap {
+		return false
+	}
+	for _, match := range ace.Matches {
+		if match.Type == "protocol" && strings.ToLower(match.Value) == protocol {
+			return true
+		}
+	}
+	return false
+}
+
+// baseOsConfigHash returns the hash of the base OS config from the
+// controller as used by parseBaseOsConfig
+func baseOsConfigHash(cfgOsList []*zconfig.BaseOSConfig) []byte {
+	h := sha256.New()
+	for _, os := range cfgOsList {
+		computeConfigElementSha(h, os)
+	}
+	return h.Sum(nil)
+}
+
+// localBaseOsUpdateConfig returns the datastore and base OS config for
+// an update from an OCI registry on the local network
+func localBaseOsUpdateConfig(update *types.LocalBaseOsUpdate) (
+	types.DatastoreConfig, types.BaseOsConfig, error) {
+
+	const prefix = "docker://"
+	if !strings.HasPrefix(update.URL, prefix) {
+		return types.DatastoreConfig{}, types.BaseOsConfig{},
+			fmt.Errorf("URL %s is not an OCI registry reference", update.URL)
+	}
+	fields := strings.SplitN(strings.TrimPrefix(update.URL, prefix), "/", 2)
+	if len(fields) != 2 || fields[0] == "" || fields[1] == "" {
+		return types.DatastoreConfig{}, types.BaseOsConfig{},
+			fmt.Errorf("URL %s has no registry or repository", update.URL)
+	}
+	if !sha256Regexp.MatchString(update.Sha256) {
+		return types.DatastoreConfig{}, types.BaseOsConfig{},
+			fmt.Errorf("invalid sha256 %q", update.Sha256)
+	}
+	datastore := types.DatastoreConfig{
+		UUID:   uuid.NewV5(uuid.NamespaceURL, prefix+fields[0]),
+		DsType: zconfig.DsType_DsContainerRegistry.String(),
+		Fqdn:   prefix + fields[0],
+	}
+	baseOsUUID := uuid.NewV5(uuid.NamespaceURL, update.URL+"@"+update.Sha256)
+	baseOs := types.BaseOsConfig{
+		UUIDandVersion: types.UUIDandVersion{UUID: baseOsUUID},
+		BaseOsVersion:  update.BaseOsVersion,
+		Activate:       true,
+		ContentTreeConfigList: []types.ContentTreeConfig{{
+			ContentID:     uuid.NewV5(baseOsUUID, "content"),
+			DatastoreID:   datastore.UUID,
+			RelativeURL:   fields[1],
+			Format:        zconfig.Format_CONTAINER,
+			ContentSha256: strings.ToLower(update.Sha256),
+			DisplayName:   fields[1],
+		}},
+	}
+	return datastore, baseOs, nil
+}
+
+// isLocalDatastore returns true if the datastore is used by the local
+// base OS update.
+// ctx.localCommands should be locked!
+func isLocalDatastore(ctx *getconfigContext, key string) bool {
+	if ctx.localCommands.BaseOsUpdate == nil {
+		return false
+	}
+	datastore, _, err := localBaseOsUpdateConfig(ctx.localCommands.BaseOsUpdate)
+	return err == nil && datastore.Key() == key
+}
+
+// isLocalBaseOs returns true if the base OS config is for the local update.
+// ctx.localCommands should be locked!
+func isLocalBaseOs(ctx *getconfigContext, key string) bool {
+	if ctx.localCommands.BaseOsUpdate == nil {
+		return false
+	}
+	_, baseOs, err := localBaseOsUpdateConfig(ctx.localCommands.BaseOsUpdate)
+	return err == nil && baseOs.Key() == key
+}
+
+// publishLocalBaseOsUpdate is called by parseBaseOsConfig.
+// ctx.localCommands should be locked!
+func publishLocalBaseOsUpdate(ctx *getconfigContext) {
+	datastore, baseOs, err := localBaseOsUpdateConfig(ctx.localCommands.BaseOsUpdate)
+	if err != nil {
+		log.Errorf("publishLocalBaseOsUpdate: %v", err)
+		return
+	}
+	ctx.pubDatastoreConfig.Publish(datastore.Key(), datastore)
+	publishBaseOsConfig(ctx, &baseOs)
+}
+
+func unpublishLocalBaseOsUpdate(ctx *getconfigContext, update *types.LocalBaseOsUpdate) {
+	datastore, baseOs, err := localBaseOsUpdateConfig(update)
+	if err != nil {
+		return
+	}
+	if c, _ := ctx.pubBaseOsConfig.Get(baseOs.Key()); c != nil {
+		ctx.pubBaseOsConfig.Unpublish(baseOs.Key())
+	}
+	// The controller might use the same registry
+	if lookupDatastore(ctx.lastDevConfig.GetDatastores(), datastore.Key()) == nil {
+		if c, _ := ctx.pubDatastoreConfig.Get(datastore.Key()); c != nil {
+			ctx.pubDatastoreConfig.Unpublish(datastore.Key())
+		}
+	}
+}
+
+// dropLocalBaseOsUpdate removes the local base OS update, and makes the
+// next parseBaseOsConfig publish the base OS config from the controller.
+// ctx.localCommands should be locked!
+func dropLocalBaseOsUpdate(ctx *getconfigContext) {
+	unpublishLocalBaseOsUpdate(ctx, ctx.localCommands.BaseOsUpdate)
+	ctx.localCommands.BaseOsUpdate = nil
+	baseOSConfigPrevConfigHash = nil
+}
@@ -0,0 +1,110 @@
+// Copyright (c) 2022 Zededa, Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package zedagent
+
+import (
+	"testing"
+
+	zconfig "github.com/lf-edge/eve/api/go/config"
+	"github.com/lf-edge/eve/pkg/pillar/types"
+	. "github.com/onsi/gomega"
+	uuid "github.com/satori/go.uuid"
+)
+
+func TestApplyLocalPortMaps(t *testing.T) {
+	g := NewGomegaWithT(t)
+	ni1 := uuid.FromStringOrNil("2f5e3b1a-7c4d-4e8f-9a0b-1c2d3e4f5a6b")
+	ni2 := uuid.FromStringOrNil("8a9b0c1d-2e3f-4a5b-8c7d-8e9f0a1b2c3d")
+	controllerACLs := func() []types.ACE {
+		return []types.ACE{
+			{
+				RuleID:  1,
+				Matches: []types.ACEMatch{{Type: "host", Value: ""}},
+			},
+			{
+				RuleID: 2,
+				Matches: []types.ACEMatch{
+					{Type: "protocol", Value: "tcp"},
+					{Type: "lport", Value: "8080"},
+				},
+				Actions: []types.ACEAction{{PortMap: true, TargetPort: 80}},
+			},
+		}
+	}
+	app := types.AppInstanceConfig{
+		UnderlayNetworkList: []types.UnderlayNetworkConfig{
+			{Network: ni1, ACLs: controllerACLs()},
+			{Network: ni2, ACLs: controllerACLs()},
+		},
+	}
+	applyLocalPortMaps(&app, []types.LocalPortMap{
+		// change
+		{NetworkInstance: ni1, Protocol: "tcp", AppPort: 80, ExternalPort: 9090},
+		// add
+		{NetworkInstance: ni1, Protocol: "udp", AppPort: 53, ExternalPort: 5353},
+		// remove
+		{NetworkInstance: ni2, Protocol: "tcp", AppPort: 80},
+		// remove what does not exist
+		{NetworkInstance: ni2, Protocol: "udp", AppPort: 80},
+	})
+
+	acls := app.UnderlayNetworkList[0].ACLs
+	g.Expect(acls).To(HaveLen(3))
+	g.Expect(acls[0]).To(Equal(controllerACLs()[0]))
+	g.Expect(acls[1].RuleID).To(BeEquivalentTo(2))
+	g.Expect(acls[1].Matches).To(ConsistOf(
+		types.ACEMatch{Type: "protocol", Value: "tcp"},
+		types.ACEMatch{Type: "lport", Value: "9090"}))
+	g.Expect(acls[2].RuleID).To(BeEquivalentTo(3))
+	g.Expect(acls[2].Matches).To(ConsistOf(
+		types.ACEMatch{Type: "protocol", Value: "udp"},
+		types.ACEMatch{Type: "lport", Value: "5353"}))
+	g.Expect(acls[2].Actions).To(Equal(
+		[]types.ACEAction{{PortMap: true, TargetPort: 53}}))
+
+	g.Expect(app.UnderlayNetworkList[1].ACLs).To(Equal(controllerACLs()[:1]))
+}
+
+func TestLocalBaseOsUpdateConfig(t *testing.T) {
+	g := NewGomegaWithT(t)
+	sha := "D5D4F8E3F8B45A0F3D0B1F0E0C6B5A8E4D3C2B1A09F8E7D6C5B4A39281706F5E"
+	update := &types.LocalBaseOsUpdate{
+		BaseOsVersion: "8.6.0-kvm-amd64",
+		URL:           "docker://registry.local:5000/lfedge/eve:8.6.0-kvm-amd64",
+		Sha256:        sha,
+	}
+	datastore, baseOs, err := localBaseOsUpdateConfig(update)
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(datastore.DsType).To(Equal(zconfig.DsType_DsContainerRegistry.String()))
+	g.Expect(datastore.Fqdn).To(Equal("docker://registry.local:5000"))
+	g.Expect(baseOs.BaseOsVersion).To(Equal("8.6.0-kvm-amd64"))
+	g.Expect(baseOs.Activate).To(BeTrue())
+	g.Expect(baseOs.ContentTreeConfigList).To(HaveLen(1))
+	contentTree := baseOs.ContentTreeConfigList[0]
+	g.Expect(contentTree.DatastoreID).To(Equal(datastore.UUID))
+	g.Expect(contentTree.RelativeURL).To(Equal("lfedge/eve:8.6.0-kvm-amd64"))
+	g.Expect(contentTree.Format).To(Equal(zconfig.Format_CONTAINER))
+	g.Expect(contentTree.ContentSha256).To(Equal(
+		"d5d4f8e3f8b45a0f3d0b1f0e0c6b5a8e4d3c2b1a09f8e7d6c5b4a39281706f5e"))
+
+	// Stable UUIDs to find what we published after a restart
+	datastore2, baseOs2, err := localBaseOsUpdateConfig(update)
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(datastore2.UUID).To(Equal(datastore.UUID))
+	g.Expect(baseOs2.UUIDandVersion).To(Equal(baseOs.UUIDandVersion))
+
+	for _, url := range []string{
+		"https://registry.local/eve.img",
+		"docker://registry.local",
+		"docker:///lfedge/eve:latest",
+	} {
+		update.URL = url
+		_, _, err = localBaseOsUpdateConfig(update)
+		g.Expect(err).To(HaveOccurred(), url)
+	}
+	update.URL = "docker://registry.local/lfedge/eve:latest"
+	update.Sha256 = "1234"
+	_, _, err = localBaseOsUpdateConfig(update)
+	g.Expect(err).To(HaveOccurred())
+}
@@ -392,6 +392,12 @@ func addLocalAppConfig(ctx *getconfigContext, appInstance *types.AppInstanceConf
 		uuid = vr.VolumeID.String()
 		vr.LocalGenerationCounter = ctx.localCommands.VolumeGenCounters[uuid]
 	}
+	// Changes submitted via the api/v1/localconfig API.
+	uuid = appInstance.UUIDandVersion.UUID.String()
+	if activate, hasActivate := ctx.localCommands.AppActivate[uuid]; hasActivate {
+		appInstance.Activate = activate
+	}
+	applyLocalPortMaps(appInstance, ctx.localCommands.PortMaps[uuid])
 }
 
 // Delete all local config for this application.
@@ -399,6 +405,8 @@ func addLocalAppConfig(ctx *getconfigContext, appInstance *types.AppInstanceConf
 func delLocalAppConfig(ctx *getconfigContext, appUUID string) {
 	delete(ctx.localCommands.AppCommands, appUUID)
 	delete(ctx.localCommands.AppCounters, appUUID)
+	delete(ctx.localCommands.AppActivate, appUUID)
+	delete(ctx.localCommands.PortMaps, appUUID)
 	persistLocalCommands(ctx.localCommands)
 }
 
@@ -6,13 +6,16 @@ package zedagent
 import (
 	"bytes"
 	"crypto/sha256"
+	"encoding/hex"
 	"encoding/json"
 	"errors"
 	"fmt"
 	"hash"
 	"io/ioutil"
+	"math"
 	"net"
 	"os"
+	"regexp"
 	"sort"
 	"strings"
 	"time"
@@ -50,6 +53,9 @@ func parseConfig(config *zconfig.EdgeDevConfig, getconfigCtx *getconfigContext,
 	getconfigCtx.lastReceivedConfig = time.Now()
 	ctx := getconfigCtx.zedagentCtx
 
+	// Drop the local changes which are no longer permitted
+	parseLocalOperationsPolicy(config, getconfigCtx)
+
 	// XXX - DO NOT LOG entire config till secrets are in encrypted blobs
 	//log.Tracef("parseConfig: EdgeDevConfig: %v", *config)
 
@@ -113,6 +119,7 @@ func parseConfig(config *zconfig.EdgeDevConfig, getconfigCtx *getconfigContext,
 		// publish updated configuration.
 		forceSystemAdaptersParse := physioChanged || networksChanged || vlansChanged || bondsChanged
 		parseSystemAdapterConfig(config, getconfigCtx, forceSystemAdaptersParse)
+		parseDeviceSchedule(getconfigCtx, config)
 		parseBaseOS(getconfigCtx, config)
 		parseBaseOsConfig(getconfigCtx, config)
 		parseNetworkInstanceConfig(config, getconfigCtx)
@@ -246,11 +253,22 @@ func parseBaseOsConfig(getconfigCtx *getconfigContext,
 	config *zconfig.EdgeDevConfig) {
 
 	cfgOsList := config.GetBase()
-	h := sha256.New()
-	for _, os := range cfgOsList {
-		computeConfigElementSha(h, os)
+	configHash := baseOsConfigHash(cfgOsList)
+	// A local base OS update is dropped once the controller changes
+	// the base OS config
+	localUpdate := getconfigCtx.localCommands.BaseOsUpdate
+	if localUpdate != nil &&
+		localUpdate.ControllerConfigHash != hex.EncodeToString(configHash) {
+		addLocalOperationRecord(getconfigCtx, types.LocalOperationRecord{
+			Type:    types.LocalOperationTypeBaseOsUpdate,
+			Target:  localUpdate.BaseOsVersion,
+			Details: "base OS config changed by the controller",
+			Result:  types.LocalOperationResultRevoked,
+		})
+		dropLocalBaseOsUpdate(getconfigCtx)
+		persistLocalCommands(getconfigCtx.localCommands)
+		localUpdate = nil
 	}
-	configHash := h.Sum(nil)
 	same := bytes.Equal(configHash, baseOSConfigPrevConfigHash)
 	if same {
 		return
@@ -274,7 +292,7 @@ func parseBaseOsConfig(getconfigCtx *getconfigContext,
 			}
 		}
 		// baseOS instance not found, delete
-		if !found {
+		if !found && !isLocalBaseOs(getconfigCtx, uuidStr) {
 			log.Functionf("parseBaseOsConfig: deleting %s", uuidStr)
 			getconfigCtx.pubBaseOsConfig.Unpublish(uuidStr)
 		}
@@ -296,11 +314,38 @@ func parseBaseOsConfig(getconfigCtx *getconfigContext,
 		baseOs.ContentTreeConfigList = make([]types.ContentTreeConfig,
 			len(cfgOs.Drives))
 		parseContentTreeConfigList(baseOs.ContentTreeConfigList, cfgOs.Drives)
+		if cfgDelta := cfgOs.GetDelta(); cfgDelta.GetDrive() != nil {
+			deltaList := make([]types.ContentTreeConfig, 1)
+			parseContentTreeConfigList(deltaList,
+				[]*zconfig.Drive{cfgDelta.GetDrive()})
+			baseOs.Delta = &types.BaseOsDelta{
+				ContentTreeConfig: deltaList[0],
+				BaseSha256:        strings.ToLower(cfgDelta.GetBaseSha256()),
+				BaseSize:          cfgDelta.GetBaseSizeBytes(),
+				ImageSha256:       strings.ToLower(cfgDelta.GetImageSha256()),
+			}
+		}
+		if cfgOs.GetSchedule() != nil {
+			schedule := parseContentSchedule(cfgOs.GetSchedule())
+			for i := range baseOs.ContentTreeConfigList {
+				baseOs.ContentTreeConfigList[i].Schedule = schedule
+			}
+			if baseOs.Delta != nil {
+				baseOs.Delta.ContentTreeConfig.Schedule = schedule
+			}
+		}
+		if localUpdate != nil {
+			// The local update takes precedence
+			baseOs.Activate = false
+		}
 
 		log.Tracef("parseBaseOsConfig publishing %v",
 			baseOs)
 		publishBaseOsConfig(getconfigCtx, baseOs)
 	}
+	if localUpdate != nil {
+		publishLocalBaseOsUpdate(getconfigCtx)
+	}
 }
 
 var networkConfigPrevConfigHash []byte
@@ -540,9 +585,15 @@ func parseAppInstanceConfig(config *zconfig.EdgeDevConfig,
 	getconfigCtx *getconfigContext) {
 
 	Apps := config.GetApps()
+	// The activation of a new version follows the schedules of the
+	// content trees of its volumes
+	activations := getAppActivations(config)
 	h := sha256.New()
 	for _, a := range Apps {
 		computeConfigElementSha(h, a)
+		if activation, ok := activations[a.GetUuidandversion().GetUuid()]; ok {
+			computeConfigElementSha(h, activation)
+		}
 	}
 	configHash := h.Sum(nil)
 	same := bytes.Equal(configHash, appinstancePrevConfigHash)
@@ -573,6 +624,7 @@ func parseAppInstanceConfig(config *zconfig.EdgeDevConfig,
 		}
 	}
 
+	sharedOwners := getSharedVolumeOwners(Apps)
 	for _, cfgApp := range Apps {
 		// Note that we repeat this even if the app config didn't
 		// change but something else in the EdgeDeviceConfig did
@@ -596,12 +648,33 @@ func parseAppInstanceConfig(config *zconfig.EdgeDevConfig,
 		appInstance.FixedResources.VncDisplay = cfgApp.Fixedresources.VncDisplay
 		appInstance.FixedResources.VncPasswd = cfgApp.Fixedresources.VncPasswd
 		appInstance.FixedResources.DisableLogs = cfgApp.Fixedresources.DisableLogs
+		appInstance.FixedResources.Realtime = cfgApp.Fixedresources.Realtime
+		appInstance.FixedResources.Vtpm = types.VtpmModel(cfgApp.Fixedresources.Vtpm)
+		appInstance.FixedResources.VtpmResetOnPurge = cfgApp.Fixedresources.VtpmResetOnPurge
+		appInstance.FixedResources.SecureBoot = cfgApp.Fixedresources.SecureBoot
 		appInstance.MetaDataType = types.MetaDataType(cfgApp.MetaDataType)
 		appInstance.Delay = time.Duration(cfgApp.StartDelayInSeconds) * time.Second
+		appInstance.Activation = activations[cfgApp.Uuidandversion.Uuid]
+		parseStartAfter(&appInstance, cfgApp, Apps)
+		appInstance.RestartPolicy = parseRestartPolicy(cfgApp.GetRestartPolicy())
 
 		appInstance.VolumeRefConfigList = make([]types.VolumeRefConfig,
 			len(cfgApp.VolumeRefList))
 		parseVolumeRefList(appInstance.VolumeRefConfigList, cfgApp.GetVolumeRefList())
+		for i := range appInstance.VolumeRefConfigList {
+			vrc := &appInstance.VolumeRefConfigList[i]
+			owner := sharedOwners[vrc.VolumeID.String()]
+			if vrc.SharedOwner && owner != cfgApp.Uuidandversion.Uuid {
+				err := fmt.Errorf("volume %s is already owned by app instance %s",
+					vrc.VolumeID, owner)
+				log.Error(err)
+				appInstance.Errors = append(appInstance.Errors, err.Error())
+				vrc.SharedOwner = false
+			}
+		}
+		parsePodContainers(&appInstance, cfgApp.GetPodContainers())
+		parseHealthCheck(&appInstance, cfgApp.GetHealthCheck())
+		parseConsoleSessions(&appInstance, cfgApp.GetConsoleSessions())
 
 		// fill in the collect stats IP address of the App
 		appInstance.CollectStatsIPAddr = net.ParseIP(cfgApp.GetCollectStatsIPAddr())
@@ -638,6 +711,8 @@ func parseAppInstanceConfig(config *zconfig.EdgeDevConfig,
 		appInstance.RemoteConsole = cfgApp.GetRemoteConsole()
 		appInstance.CipherBlockStatus = parseCipherBlock(getconfigCtx, appInstance.Key(),
 			cfgApp.GetCipherData())
+		appInstance.SecureBootKeys = parseCipherBlock(getconfigCtx,
+			appInstance.Key()+"-secure-boot-keys", cfgApp.GetSecureBootKeys())
 		appInstance.ProfileList = cfgApp.ProfileList
 
 		// Add config submitted via local profile server.
@@ -648,6 +723,259 @@ func parseAppInstanceConfig(config *zconfig.EdgeDevConfig,
 	}
 }
 
+// getSharedVolumeOwners returns the first app instance which claims to be
+// the owner of a shared volume, indexed by volume UUID
+func getSharedVolumeOwners(apps []*zconfig.AppInstanceConfig) map[string]string {
+	owners := make(map[string]string)
+	for _, app := range apps {
+		for _, volumeRef := range app.GetVolumeRefList() {
+			if !volumeRef.GetSharedOwner() {
+				continue
+			}
+			if _, ok := owners[volumeRef.GetUuid()]; !ok {
+				owners[volumeRef.GetUuid()] = app.GetUuidandversion().GetUuid()
+			}
+		}
+	}
+	return owners
+}
+
+// parseStartAfter sets the app instances which the app instance starts
+// after. They have to be in the config, or else the app instance would
+// wait for them forever.
+func parseStartAfter(appInstance *types.AppInstanceConfig,
+	cfgApp *zconfig.AppInstanceConfig, apps []*zconfig.AppInstanceConfig) {
+
+	for _, dep := range cfgApp.GetStartAfter() {
+		appUUID, err := uuid.FromString(dep.GetAppUuid())
+		if err != nil {
+			err = fmt.Errorf("invalid app instance %q to start after: %v",
+				dep.GetAppUuid(), err)
+			log.Error(err)
+			appInstance.Errors = append(appInstance.Errors, err.Error())
+			continue
+		}
+		found := false
+		for _, app := range apps {
+			id, err := uuid.FromString(app.GetUuidandversion().GetUuid())
+			if err == nil && uuid.Equal(id, appUUID) {
+				found = true
+				break
+			}
+		}
+		if !found {
+			err = fmt.Errorf("no app instance %s to start after", appUUID)
+			log.Error(err)
+			appInstance.Errors = append(appInstance.Errors, err.Error())
+			continue
+		}
+		readiness := types.AppReadinessRunning
+		if dep.GetReadiness() == zconfig.AppReadiness_APP_READINESS_HEALTH_OK {
+			readiness = types.AppReadinessHealthOK
+		}
+		appInstance.StartAfter = append(appInstance.StartAfter,
+			types.AppDependency{AppUUID: appUUID, Readiness: readiness})
+	}
+}
+
+// parseRestartPolicy returns the restart policy with the defaults for
+// the unset fields
+func parseRestartPolicy(cfg *zconfig.RestartPolicy) types.RestartPolicy {
+	seconds := func(value, dflt uint32) time.Duration {
+		if value == 0 {
+			value = dflt
+		}
+		return time.Duration(value) * time.Second
+	}
+	policy := types.RestartPolicy{
+		Backoff:           seconds(cfg.GetBackoffSeconds(), 10),
+		MaxBackoff:        seconds(cfg.GetMaxBackoffSeconds(), 300),
+		CrashLoopRestarts: cfg.GetCrashLoopRestarts(),
+		CrashLoopWindow:   seconds(cfg.GetCrashLoopWindowSeconds(), 600),
+	}
+	if policy.CrashLoopRestarts == 0 {
+		policy.CrashLoopRestarts = 5
+	}
+	policy.Mode = parseRestartMode(cfg.GetMode())
+	if policy.Mode == types.RestartModeOnFailure {
+		policy.MaxAttempts = cfg.GetMaxAttempts()
+	}
+	return policy
+}
+
+func parseRestartMode(mode zconfig.RestartMode) types.RestartMode {
+	switch mode {
+	case zconfig.RestartMode_RESTART_MODE_NEVER:
+		return types.RestartModeNever
+	case zconfig.RestartMode_RESTART_MODE_ON_FAILURE:
+		return types.RestartModeOnFailure
+	case zconfig.RestartMode_RESTART_MODE_ALWAYS:
+		return types.RestartModeAlways
+	default:
+		return types.RestartModeDefault
+	}
+}
+
+// The names of the containers of a pod are DNS labels
+var podContainerNameRe = regexp.MustCompile(`^[a-z0-9]([-a-z0-9]{0,61}[a-z0-9])?$`)
+
+// parsePodContainers fills in the init and sidecar containers of an app
+// instance, whose volumes have to be among its volume refs
+func parsePodContainers(appInstance *types.AppInstanceConfig,
+	cfgs []*zconfig.PodContainer) {
+
+	volumeRef := func(volumeUUID string) (uuid.UUID, error) {
+		id, err := uuid.FromString(volumeUUID)
+		if err != nil {
+			return id, fmt.Errorf("invalid volume %q: %v", volumeUUID, err)
+		}
+		for _, vrc := range appInstance.VolumeRefConfigList {
+			if vrc.VolumeID == id {
+				return id, nil
+			}
+		}
+		return id, fmt.Errorf("volume %s is not a volume ref of the app instance", id)
+	}
+	names := make(map[string]bool)
+	for _, cfg := range cfgs {
+		pc := types.PodContainerConfig{
+			Name:         cfg.GetName(),
+			Init:         cfg.GetInit(),
+			Args:         cfg.GetArgs(),
+			EnvVariables: cfg.GetEnv(),
+			Memory:       int(cfg.GetMemory()),
+			VCpus:        int(cfg.GetVcpus()),
+			RestartMode:  parseRestartMode(cfg.GetRestartMode()),
+		}
+		var err error
+		if !podContainerNameRe.MatchString(pc.Name) {
+			err = fmt.Errorf("invalid pod container name %q", pc.Name)
+		} else if names[pc.Name] {
+			err = fmt.Errorf("duplicate pod container %s", pc.Name)
+		} else if pc.VolumeID, err = volumeRef(cfg.GetVolumeUuid()); err != nil {
+			err = fmt.Errorf("pod container %s: %v", pc.Name, err)
+		}
+		for _, mount := range cfg.GetMounts() {
+			if err != nil {
+				break
+			}
+			pm := types.PodMount{
+				MountDir: mount.GetMountDir(),
+				ReadOnly: mount.GetReadOnly(),
+			}
+			if pm.VolumeID, err = volumeRef(mount.GetVolumeUuid()); err != nil {
+				err = fmt.Errorf("pod container %s: %v", pc.Name, err)
+			} else if !strings.HasPrefix(pm.MountDir, "/") {
+				err = fmt.Errorf("pod container %s: mount dir %q should be absolute",
+					pc.Name, pm.MountDir)
+			}
+			pc.Mounts = append(pc.Mounts, pm)
+		}
+		if err != nil {
+			log.Error(err)
+			appInstance.Errors = append(appInstance.Errors, err.Error())
+			continue
+		}
+		names[pc.Name] = true
+		appInstance.PodContainers = append(appInstance.PodContainers, pc)
+	}
+}
+
+// parseHealthCheck fills in the health check of an app instance. The zero
+// timings are left to domainmgr which knows the HEALTHCHECK of the image.
+func parseHealthCheck(appInstance *types.AppInstanceConfig,
+	cfg *zconfig.HealthCheck) {
+
+	check := types.HealthCheck{
+		Command:     cfg.GetExecCommand(),
+		HTTPPath:    cfg.GetHttpPath(),
+		Interval:    time.Duration(cfg.GetIntervalSeconds()) * time.Second,
+		Timeout:     time.Duration(cfg.GetTimeoutSeconds()) * time.Second,
+		StartPeriod: time.Duration(cfg.GetStartPeriodSeconds()) * time.Second,
+		Retries:     cfg.GetRetries(),
+		Restart:     cfg.GetRestart(),
+	}
+	var err error
+	switch cfg.GetType() {
+	case zconfig.HealthCheckType_HEALTH_CHECK_TYPE_IMAGE:
+		check.Type = types.HealthCheckImage
+	case zconfig.HealthCheckType_HEALTH_CHECK_TYPE_NONE:
+		check.Type = types.HealthCheckNone
+	case zconfig.HealthCheckType_HEALTH_CHECK_TYPE_EXEC:
+		check.Type = types.HealthCheckExec
+		if len(check.Command) == 0 {
+			err = errors.New("exec health check without a command")
+		}
+	case zconfig.HealthCheckType_HEALTH_CHECK_TYPE_TCP,
+		zconfig.HealthCheckType_HEALTH_CHECK_TYPE_HTTP:
+		check.Type = types.HealthCheckTCP
+		if cfg.GetType() == zconfig.HealthCheckType_HEALTH_CHECK_TYPE_HTTP {
+			check.Type = types.HealthCheckHTTP
+			if check.HTTPPath == "" {
+				check.HTTPPath = "/"
+			} else if !strings.HasPrefix(check.HTTPPath, "/") {
+				err = fmt.Errorf("health check path %q should be absolute",
+					check.HTTPPath)
+			}
+		}
+		if cfg.GetPort() == 0 || cfg.GetPort() > 65535 {
+			err = fmt.Errorf("invalid health check port %d", cfg.GetPort())
+		}
+		check.Port = uint16(cfg.GetPort())
+	default:
+		err = fmt.Errorf("unknown health check type %d", cfg.GetType())
+	}
+	if err != nil {
+		log.Error(err)
+		appInstance.Errors = append(appInstance.Errors, err.Error())
+		return
+	}
+	appInstance.HealthCheck = check
+}
+
+// parseConsoleSessions fills in the console sessions of an app instance,
+// leaving out the invalid ones
+func parseConsoleSessions(appInstance *types.AppInstanceConfig,
+	cfgSessions []*zconfig.ConsoleSession) {
+
+	for _, cfg := range cfgSessions {
+		session := types.ConsoleSession{
+			TokenSha256: cfg.GetTokenSha256(),
+			NotAfter:    parseScheduleTime(cfg.GetNotAfter()),
+			MaxDuration: time.Duration(cfg.GetMaxDurationSeconds()) * time.Second,
+			Record:      cfg.GetRecord(),
+		}
+		var err error
+		session.ID, err = uuid.FromString(cfg.GetId())
+		switch {
+		case err != nil:
+			err = fmt.Errorf("invalid console session id %q: %v", cfg.GetId(), err)
+		case len(session.TokenSha256) != sha256.Size:
+			err = fmt.Errorf("console session %s without a SHA-256 of its token",
+				session.ID)
+		case session.NotAfter.IsZero():
+			err = fmt.Errorf("console session %s without an end", session.ID)
+		}
+		switch cfg.GetType() {
+		case zconfig.ConsoleType_CONSOLE_TYPE_VNC:
+			session.Type = types.ConsoleTypeVNC
+		case zconfig.ConsoleType_CONSOLE_TYPE_SERIAL:
+			session.Type = types.ConsoleTypeSerial
+		default:
+			if err == nil {
+				err = fmt.Errorf("console session %s of unknown type %d",
+					session.ID, cfg.GetType())
+			}
+		}
+		if err != nil {
+			log.Error(err)
+			appInstance.Errors = append(appInstance.Errors, err.Error())
+			continue
+		}
+		appInstance.ConsoleSessions = append(appInstance.ConsoleSessions, session)
+	}
+}
+
 var systemAdaptersPrevConfigHash []byte
 
 func parseSystemAdapterConfig(config *zconfig.EdgeDevConfig,
@@ -1539,7 +1867,7 @@ func publishDatastoreConfig(ctx *getconfigContext,
 	items := ctx.pubDatastoreConfig.GetAll()
 	for k := range items {
 		ds := lookupDatastore(cfgDatastores, k)
-		if ds != nil {
+		if ds != nil || isLocalDatastore(ctx, k) {
 			continue
 		}
 		log.Tracef("publishDatastoresConfig: unpublishing %s", k)
@@ -1561,6 +1889,7 @@ func publishDatastoreConfig(ctx *getconfigContext,
 		}
 
 		datastore.DsCertPEM = ds.GetDsCertPEM()
+		datastore.SignaturePolicy = parseSignaturePolicy(ds.GetSignaturePolicy())
 
 		datastore.CipherBlockStatus = parseCipherBlock(ctx, datastore.Key(),
 			ds.GetCipherData())
@@ -1603,6 +1932,7 @@ func parseVolumeRefList(volumeRefConfigList []types.VolumeRefConfig,
 		volume.GenerationCounter = volumeRef.GenerationCount
 		volume.RefCount = 1
 		volume.MountDir = volumeRef.GetMountDir()
+		volume.SharedOwner = volumeRef.GetSharedOwner()
 		volume.VerifyOnly = true
 		volumeRefConfigList[idx] = *volume
 		idx++
@@ -1822,6 +2152,17 @@ func parseNetworkWirelessConfig(ctx *getconfigContext, key string, netEnt *zconf
 			wcell.ProbeAddr = cellular.GetProbe().GetProbeAddress()
 			wcell.DisableProbe = cellular.GetProbe().GetDisable()
 			wcell.LocationTracking = cellular.GetLocationTracking()
+			wcell.SimProfiles = parseCellularSimProfiles(cellular.GetSimProfiles())
+			failover := cellular.GetFailover()
+			wcell.Failover = types.CellFailover{
+				Enable:              failover.GetEnable(),
+				ProbeFailureTimeout: failover.GetProbeFailureTimeout(),
+				OnQuotaExhausted:    failover.GetOnQuotaExhausted(),
+				RevertInterval:      failover.GetRevertInterval(),
+			}
+			if wcell.Failover.ProbeFailureTimeout == 0 {
+				wcell.Failover.ProbeFailureTimeout = types.DefaultCellProbeFailureTimeout
+			}
 			wconfig.Cellular = append(wconfig.Cellular, wcell)
 		}
 		log.Functionf("parseNetworkWirelessConfig: Wireless of network Cellular, %v", wconfig.Cellular)
@@ -1854,6 +2195,73 @@ func parseNetworkWirelessConfig(ctx *getconfigContext, key string, netEnt *zconf
 	return wconfig
 }
 
+func parseCellularSimProfiles(profiles []*zconfig.CellularSimProfile) []types.CellSimProfile {
+	var simProfiles []types.CellSimProfile
+	usedSlots := make(map[uint32]bool)
+	for _, profile := range profiles {
+		slot := profile.GetSimSlot()
+		if slot == 0 || slot > math.MaxUint8 {
+			log.Errorf("parseCellularSimProfiles: invalid SIM slot %d", slot)
+			continue
+		}
+		if usedSlots[slot] {
+			log.Errorf("parseCellularSimProfiles: duplicate profile for SIM slot %d",
+				slot)
+			continue
+		}
+		usedSlots[slot] = true
+		quota := profile.GetQuota()
+		cycleDay := quota.GetBillingCycleDay()
+		if cycleDay == 0 || cycleDay > 31 {
+			if cycleDay != 0 {
+				log.Errorf("parseCellularSimProfiles: invalid billing cycle day %d, "+
+					"using 1", cycleDay)
+			}
+			cycleDay = 1
+		}
+		softPercent := quota.GetSoftThresholdPercent()
+		if softPercent == 0 || softPercent > 100 {
+			if softPercent != 0 {
+				log.Errorf("parseCellularSimProfiles: invalid soft threshold %d%%, "+
+					"using %d%%", softPercent, types.DefaultCellSoftThresholdPercent)
+			}
+			softPercent = types.DefaultCellSoftThresholdPercent
+		}
+		simProfiles = append(simProfiles, types.CellSimProfile{
+			SimSlot: uint8(slot),
+			APN:     profile.GetApn(),
+			Quota: types.CellDataQuota{
+				LimitBytes:           quota.GetLimitBytes(),
+				BillingCycleDay:      uint8(cycleDay),
+				SoftThresholdPercent: uint8(softPercent),
+				SoftAllowed:          parseCellularTrafficClasses(quota.GetSoftThresholdAllowed()),
+				HardAllowed:          parseCellularTrafficClasses(quota.GetHardThresholdAllowed()),
+			},
+		})
+	}
+	return simProfiles
+}
+
+func parseCellularTrafficClasses(classes []zconfig.CellularTrafficClass) []types.TrafficClass {
+	var trafficClasses []types.TrafficClass
+	for _, class := range classes {
+		switch class {
+		case zconfig.CellularTrafficClass_CELLULAR_TRAFFIC_CLASS_LOGS:
+			trafficClasses = append(trafficClasses, types.TrafficClassLogs)
+		case zconfig.CellularTrafficClass_CELLULAR_TRAFFIC_CLASS_METRICS:
+			trafficClasses = append(trafficClasses, types.TrafficClassMetrics)
+		case zconfig.CellularTrafficClass_CELLULAR_TRAFFIC_CLASS_DOWNLOADS:
+			trafficClasses = append(trafficClasses, types.TrafficClassDownloads)
+		case zconfig.CellularTrafficClass_CELLULAR_TRAFFIC_CLASS_APPS:
+			trafficClasses = append(trafficClasses, types.TrafficClassApps)
+		default:
+			log.Errorf("parseCellularTrafficClasses: unsupported traffic class %v",
+				class)
+		}
+	}
+	return trafficClasses
+}
+
 func parseIpspecNetworkXObject(ipspec *zconfig.Ipspec, config *types.NetworkXObjectConfig) error {
 	config.Dhcp = types.DhcpType(ipspec.Dhcp)
 	config.DomainName = ipspec.GetDomain()
@@ -2266,6 +2674,14 @@ func parseConfigItems(config *zconfig.EdgeDevConfig, ctx *getconfigContext) {
 		log.Tracef("Processed ConfigItem: key: %s, Value: %s, itemValue: %+v",
 			item.Key, item.Value, itemValue)
 	}
+	// Settings overridden via the api/v1/localconfig API
+	for key, value := range ctx.localCommands.GlobalSettings {
+		_, err := ctx.zedagentCtx.specMap.ParseItem(newGlobalConfig,
+			gcPtr, key, value)
+		if err != nil {
+			log.Errorf("parseConfigItems: local setting %s: %v", key, err)
+		}
+	}
 	log.Tracef("Done with Parsing ConfigItems. globalStatus: %+v",
 		*newGlobalStatus)
 	ctx.zedagentCtx.globalStatus = *newGlobalStatus
@@ -1131,3 +1131,36 @@ func TestInvalidLowerLayerReferences(t *testing.T) {
 	g.Expect(dpc.HasError()).To(BeFalse())
 	g.Expect(dpc.Ports).To(HaveLen(2))
 }
+
+func TestParseStartAfter(t *testing.T) {
+	g := NewGomegaWithT(t)
+	initGetConfigCtx(g)
+
+	const (
+		app1UUID = "7d0a2c8e-0f5b-4b5c-9a3d-1f2e3c4d5e6f"
+		app2UUID = "1b2c3d4e-5f60-4718-9a0b-c1d2e3f40516"
+		unknown  = "9f8e7d6c-5b4a-4392-8170-6f5e4d3c2b1a"
+	)
+	apps := []*zconfig.AppInstanceConfig{
+		{Uuidandversion: &zconfig.UUIDandVersion{Uuid: app1UUID}},
+		{
+			Uuidandversion: &zconfig.UUIDandVersion{Uuid: app2UUID},
+			StartAfter: []*zconfig.AppDependency{
+				{
+					AppUuid:   app1UUID,
+					Readiness: zconfig.AppReadiness_APP_READINESS_HEALTH_OK,
+				},
+				{AppUuid: unknown},
+				{AppUuid: "not-a-uuid"},
+			},
+		},
+	}
+	var appInstance types.AppInstanceConfig
+	parseStartAfter(&appInstance, apps[1], apps)
+	g.Expect(appInstance.StartAfter).To(HaveLen(1))
+	g.Expect(appInstance.StartAfter[0].AppUUID.String()).To(Equal(app1UUID))
+	g.Expect(appInstance.StartAfter[0].Readiness).To(Equal(types.AppReadinessHealthOK))
+	g.Expect(appInstance.Errors).To(HaveLen(2))
+	g.Expect(appInstance.Errors[0]).To(ContainSubstring("no app instance " + unknown))
+	g.Expect(appInstance.Errors[1]).To(ContainSubstring("invalid app instance"))
+}
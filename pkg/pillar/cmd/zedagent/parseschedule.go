@@ -0,0 +1,121 @@
+// Copyright (c) 2022 Zededa, Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package zedagent
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"time"
+
+	"github.com/golang/protobuf/ptypes"
+	"github.com/golang/protobuf/ptypes/timestamp"
+	zconfig "github.com/lf-edge/eve/api/go/config"
+	"github.com/lf-edge/eve/pkg/pillar/types"
+)
+
+var deviceScheduleHash []byte
+
+// parseDeviceSchedule publishes the download and maintenance windows.
+// The schedule is persistent hence prefetching follows it before the
+// controller is reachable after a reboot.
+func parseDeviceSchedule(ctx *getconfigContext,
+	config *zconfig.EdgeDevConfig) {
+
+	cfgSchedule := config.GetSchedule()
+	h := sha256.New()
+	if cfgSchedule != nil {
+		computeConfigElementSha(h, cfgSchedule)
+	}
+	newHash := h.Sum(nil)
+	if bytes.Equal(newHash, deviceScheduleHash) {
+		return
+	}
+	log.Functionf("parseDeviceSchedule: Applying updated config "+
+		"Last Sha: % x, "+
+		"New  Sha: % x, "+
+		"schedule: %v",
+		deviceScheduleHash, newHash, cfgSchedule)
+	deviceScheduleHash = newHash
+
+	var schedule types.DeviceSchedule
+	for _, w := range cfgSchedule.GetDownloadWindows() {
+		maxPortCost := w.GetMaxPortCost()
+		if maxPortCost > 255 {
+			log.Warnf("parseDeviceSchedule: max port cost %d of a download window exceeds 255",
+				maxPortCost)
+			maxPortCost = 255
+		}
+		schedule.DownloadWindows = append(schedule.DownloadWindows,
+			types.DownloadWindow{
+				TimeWindow:  parseTimeWindow(w.GetWindow()),
+				MaxPortCost: uint8(maxPortCost),
+			})
+	}
+	for _, w := range cfgSchedule.GetMaintenanceWindows() {
+		schedule.MaintenanceWindows = append(schedule.MaintenanceWindows,
+			parseTimeWindow(w))
+	}
+	ctx.pubDeviceSchedule.Publish(schedule.Key(), schedule)
+}
+
+func parseTimeWindow(w *zconfig.TimeWindow) types.TimeWindow {
+	return types.TimeWindow{
+		StartMinute: w.GetStartMinute(),
+		EndMinute:   w.GetEndMinute(),
+		Weekdays:    w.GetWeekdays(),
+	}
+}
+
+// parseContentSchedule returns the schedule of the prefetch of a content
+// tree or of a base OS
+func parseContentSchedule(cfgSchedule *zconfig.ContentSchedule) types.ContentSchedule {
+	return types.ContentSchedule{
+		PrefetchBy:    parseScheduleTime(cfgSchedule.GetPrefetchBy()),
+		ActivateAfter: parseScheduleTime(cfgSchedule.GetActivateAfter()),
+	}
+}
+
+func parseScheduleTime(ts *timestamp.Timestamp) time.Time {
+	if ts == nil {
+		return time.Time{}
+	}
+	t, err := ptypes.Timestamp(ts)
+	if err != nil {
+		log.Errorf("parseScheduleTime: %v", err)
+		return time.Time{}
+	}
+	return t
+}
+
+// getAppActivations returns the schedules of the content trees of the
+// volumes of the app instances, indexed by app instance UUID. A new
+// version of an app instance is activated once all of them allow it.
+func getAppActivations(config *zconfig.EdgeDevConfig) map[string]types.ContentSchedule {
+	contentSchedules := make(map[string]types.ContentSchedule)
+	for _, contentTree := range config.GetContentInfo() {
+		if contentTree.GetSchedule() != nil {
+			contentSchedules[contentTree.GetUuid()] =
+				parseContentSchedule(contentTree.GetSchedule())
+		}
+	}
+	volumeContentTrees := make(map[string]string)
+	for _, volume := range config.GetVolumes() {
+		volumeContentTrees[volume.GetUuid()] =
+			volume.GetOrigin().GetDownloadContentTreeID()
+	}
+	activations := make(map[string]types.ContentSchedule)
+	for _, app := range config.GetApps() {
+		var activation types.ContentSchedule
+		for _, volumeRef := range app.GetVolumeRefList() {
+			contentTreeID := volumeContentTrees[volumeRef.GetUuid()]
+			if schedule, ok := contentSchedules[contentTreeID]; ok {
+				activation = activation.Merge(schedule)
+			}
+		}
+		if activation.IsSet() {
+			activations[app.GetUuidandversion().GetUuid()] = activation
+		}
+	}
+	return activations
+}
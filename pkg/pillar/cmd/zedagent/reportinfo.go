@@ -10,6 +10,7 @@ import (
 	"encoding/json"
 	"fmt"
 	"os"
+	"sort"
 	"strings"
 	"time"
 
@@ -161,6 +162,41 @@ func objectInfoTask(ctxPtr *zedagentContext, triggerInfo <-chan infoForObjectKey
 	}
 }
 
+func fillStorageDiskState(disk *types.StorageDiskState) *info.StorageDiskState {
+	diskInfo := new(info.StorageDiskState)
+	diskInfo.Status = info.StorageStatus(disk.Status)
+	if disk.DiskName != nil {
+		diskInfo.DiskName = new(evecommon.DiskDescription)
+		diskInfo.DiskName.Name = disk.DiskName.Name
+		diskInfo.DiskName.LogicalName = disk.DiskName.LogicalName
+		diskInfo.DiskName.Serial = disk.DiskName.Serial
+	}
+	diskInfo.Resilvering = disk.Resilvering
+	diskInfo.ResilverProgress = uint32(disk.ResilverProgress)
+	diskInfo.Spare = disk.Spare
+	return diskInfo
+}
+
+func fillStorageScanStatus(scan types.StorageScanStatus) *info.StorageScanStatus {
+	if scan.Type == types.StorageScanTypeUnspecified {
+		return nil
+	}
+	scanInfo := new(info.StorageScanStatus)
+	scanInfo.Type = info.StorageScanType(scan.Type)
+	scanInfo.State = info.StorageScanState(scan.State)
+	if !scan.StartTime.IsZero() {
+		scanInfo.StartTime, _ = ptypes.TimestampProto(scan.StartTime)
+	}
+	if !scan.EndTime.IsZero() {
+		scanInfo.EndTime, _ = ptypes.TimestampProto(scan.EndTime)
+	}
+	scanInfo.ToExamineBytes = scan.ToExamine
+	scanInfo.ExaminedBytes = scan.Examined
+	scanInfo.Progress = uint32(scan.Progress)
+	scanInfo.Errors = scan.Errors
+	return scanInfo
+}
+
 func fillStorageChildren(children []*types.StorageChildren) []*info.StorageChildren {
 	var infoChildren []*info.StorageChildren
 	for _, child := range children {
@@ -168,15 +204,7 @@ func fillStorageChildren(children []*types.StorageChildren) []*info.StorageChild
 		childInfo.CurrentRaid = info.StorageRaidType(child.CurrentRaid)
 		childInfo.DisplayName = child.DisplayName
 		for _, disk := range child.Disks {
-			diskInfo := new(info.StorageDiskState)
-			diskInfo.Status = info.StorageStatus(disk.Status)
-			if disk.DiskName != nil {
-				diskInfo.DiskName = new(evecommon.DiskDescription)
-				diskInfo.DiskName.Name = disk.DiskName.Name
-				diskInfo.DiskName.LogicalName = disk.DiskName.LogicalName
-				diskInfo.DiskName.Serial = disk.DiskName.Serial
-			}
-			childInfo.Disks = append(childInfo.Disks, diskInfo)
+			childInfo.Disks = append(childInfo.Disks, fillStorageDiskState(disk))
 		}
 		childInfo.Children = fillStorageChildren(child.Children)
 		infoChildren = append(infoChildren, childInfo)
@@ -290,6 +318,7 @@ func PublishDeviceInfoToZedCloud(ctx *zedagentContext) {
 	getSwInfo := func(partLabel string) *info.ZInfoDevSW {
 		swInfo := new(info.ZInfoDevSW)
 		tooEarly := false
+		var deferredUntil time.Time
 		if bos := getBaseOsStatus(partLabel); bos != nil {
 			// Get current state/version which is different than
 			// what is on disk
@@ -315,6 +344,7 @@ func PublishDeviceInfoToZedCloud(ctx *zedagentContext) {
 				swInfo.DownloadProgress = 0
 			}
 			tooEarly = bos.TooEarly
+			deferredUntil = bos.ActivationDeferredUntil
 		} else {
 			partStatus := getZbootPartitionStatus(ctx, partLabel)
 			swInfo.PartitionLabel = partLabel
@@ -333,7 +363,7 @@ func PublishDeviceInfoToZedCloud(ctx *zedagentContext) {
 				swInfo.DownloadProgress = 0
 			}
 		}
-		addUserSwInfo(ctx, swInfo, tooEarly)
+		addUserSwInfo(ctx, swInfo, tooEarly, deferredUntil)
 		return swInfo
 	}
 
@@ -363,7 +393,7 @@ func PublishDeviceInfoToZedCloud(ctx *zedagentContext) {
 				bos.ErrorTime, bos.Error, bos.BaseOsVersion)
 			swInfo.SwErr = encodeErrorInfo(bos.ErrorAndTime.ErrorDescription)
 		}
-		addUserSwInfo(ctx, swInfo, bos.TooEarly)
+		addUserSwInfo(ctx, swInfo, bos.TooEarly, bos.ActivationDeferredUntil)
 		ReportDeviceInfo.SwList = append(ReportDeviceInfo.SwList,
 			swInfo)
 	}
@@ -384,17 +414,13 @@ func PublishDeviceInfoToZedCloud(ctx *zedagentContext) {
 			storageInfo.CountZvols = zfsPoolStatus.CountZvols
 			storageInfo.CollectorErrors = zfsPoolStatus.CollectorErrors
 			for _, disk := range zfsPoolStatus.Disks {
-				diskInfo := new(info.StorageDiskState)
-				diskInfo.Status = info.StorageStatus(disk.Status)
-				if disk.DiskName != nil {
-					diskInfo.DiskName = new(evecommon.DiskDescription)
-					diskInfo.DiskName.Name = disk.DiskName.Name
-					diskInfo.DiskName.LogicalName = disk.DiskName.LogicalName
-					diskInfo.DiskName.Serial = disk.DiskName.Serial
-				}
-				storageInfo.Disks = append(storageInfo.Disks, diskInfo)
+				storageInfo.Disks = append(storageInfo.Disks, fillStorageDiskState(disk))
 			}
 			storageInfo.Children = fillStorageChildren(zfsPoolStatus.Children)
+			storageInfo.Scan = fillStorageScanStatus(zfsPoolStatus.Scan)
+			for _, spare := range zfsPoolStatus.Spares {
+				storageInfo.Spares = append(storageInfo.Spares, fillStorageDiskState(spare))
+			}
 			ReportDeviceInfo.StorageInfo = append(ReportDeviceInfo.StorageInfo, storageInfo)
 			log.Tracef("sending info for ZFS zpool %s", zfsPoolStatus.PoolName)
 		}
@@ -599,6 +625,9 @@ func PublishDeviceInfoToZedCloud(ctx *zedagentContext) {
 
 	ReportDeviceInfo.ApiCapability = info.APICapability_API_CAPABILITY_START_DELAY_IN_SECONDS
 
+	// Audit trail of the api/v1/localconfig API
+	ReportDeviceInfo.LocalOperations = getLocalOperationsInfo(ctx.getconfigCtx)
+
 	// Report if there is a local override of profile
 	if ctx.getconfigCtx.currentProfile != ctx.getconfigCtx.globalProfile {
 		ReportDeviceInfo.LocalProfile = ctx.getconfigCtx.currentProfile
@@ -700,7 +729,8 @@ func PublishAppInstMetaDataToZedCloud(ctx *zedagentContext, appInstID string, ap
 }
 
 // Convert the implementation details to the user-friendly userStatus and subStatus*
-func addUserSwInfo(ctx *zedagentContext, swInfo *info.ZInfoDevSW, tooEarly bool) {
+func addUserSwInfo(ctx *zedagentContext, swInfo *info.ZInfoDevSW, tooEarly bool,
+	deferredUntil time.Time) {
 	log.Functionf("Device swInfo: %s", swInfo.String())
 	switch swInfo.Status {
 	case info.ZSwState_INITIAL:
@@ -740,6 +770,14 @@ func addUserSwInfo(ctx *zedagentContext, swInfo *info.ZInfoDevSW, tooEarly bool)
 			swInfo.UserStatus = info.BaseOsStatus_UPDATING
 			swInfo.SubStatus = info.BaseOsSubStatus_UPDATE_DEFERRED
 			swInfo.SubStatusStr = "Waiting for current image to finish testing before updating again"
+		} else if !deferredUntil.IsZero() {
+			swInfo.UserStatus = info.BaseOsStatus_UPDATING
+			swInfo.SubStatus = info.BaseOsSubStatus_UPDATE_WAITING_MAINTENANCE_WINDOW
+			if left := time.Until(deferredUntil); left > 0 {
+				swInfo.SubStatusProgress = uint32(left / time.Second)
+			}
+			swInfo.SubStatusStr = fmt.Sprintf("Waiting %d more seconds to activate in a maintenance window",
+				swInfo.SubStatusProgress)
 		} else {
 			swInfo.UserStatus = info.BaseOsStatus_NONE
 		}
@@ -905,6 +943,71 @@ func encodeCellProviders(wwanProviders []types.WwanProvider) (providers []*info.
 	return providers
 }
 
+// encodeCellDataUsage returns data usage of SIM cards used with the cellular
+// modem in their current billing cycles.
+func encodeCellDataUsage(ctx *zedagentContext,
+	logicalLabel string) (dataUsage []*info.ZCellularDataUsage) {
+	now := time.Now()
+	for _, item := range ctx.subWwanDataUsage.GetAll() {
+		usage := item.(types.WwanDataUsage)
+		if usage.LogicalLabel != logicalLabel || now.After(usage.CycleEnd) {
+			// Not used since the last billing cycle ended.
+			continue
+		}
+		cycleStart, _ := ptypes.TimestampProto(usage.CycleStart)
+		cycleEnd, _ := ptypes.TimestampProto(usage.CycleEnd)
+		var blocked []info.ZCellularTrafficClass
+		for _, tc := range usage.BlockedTraffic {
+			blocked = append(blocked, encodeCellTrafficClass(tc))
+		}
+		dataUsage = append(dataUsage, &info.ZCellularDataUsage{
+			SimSlot:        uint32(usage.SimSlot),
+			Iccid:          usage.ICCID,
+			CycleStart:     cycleStart,
+			CycleEnd:       cycleEnd,
+			RxBytes:        usage.RxBytes,
+			TxBytes:        usage.TxBytes,
+			LimitBytes:     usage.LimitBytes,
+			QuotaState:     encodeCellQuotaState(usage.QuotaState),
+			BlockedTraffic: blocked,
+		})
+	}
+	// GetAll returns items in random order.
+	sort.Slice(dataUsage, func(i, j int) bool {
+		if dataUsage[i].SimSlot != dataUsage[j].SimSlot {
+			return dataUsage[i].SimSlot < dataUsage[j].SimSlot
+		}
+		return dataUsage[i].Iccid < dataUsage[j].Iccid
+	})
+	return dataUsage
+}
+
+func encodeCellTrafficClass(tc types.TrafficClass) info.ZCellularTrafficClass {
+	switch tc {
+	case types.TrafficClassLogs:
+		return info.ZCellularTrafficClass_Z_CELLULAR_TRAFFIC_CLASS_LOGS
+	case types.TrafficClassMetrics:
+		return info.ZCellularTrafficClass_Z_CELLULAR_TRAFFIC_CLASS_METRICS
+	case types.TrafficClassDownloads:
+		return info.ZCellularTrafficClass_Z_CELLULAR_TRAFFIC_CLASS_DOWNLOADS
+	case types.TrafficClassApps:
+		return info.ZCellularTrafficClass_Z_CELLULAR_TRAFFIC_CLASS_APPS
+	}
+	return info.ZCellularTrafficClass_Z_CELLULAR_TRAFFIC_CLASS_UNSPECIFIED
+}
+
+func encodeCellQuotaState(state types.WwanQuotaState) info.ZCellularQuotaState {
+	switch state {
+	case types.WwanQuotaStateOK:
+		return info.ZCellularQuotaState_Z_CELLULAR_QUOTA_STATE_OK
+	case types.WwanQuotaStateSoftLimit:
+		return info.ZCellularQuotaState_Z_CELLULAR_QUOTA_STATE_SOFT_LIMIT
+	case types.WwanQuotaStateHardLimit:
+		return info.ZCellularQuotaState_Z_CELLULAR_QUOTA_STATE_HARD_LIMIT
+	}
+	return info.ZCellularQuotaState_Z_CELLULAR_QUOTA_STATE_UNSPECIFIED
+}
+
 func encodeSystemAdapterInfo(ctx *zedagentContext) *info.SystemAdapterInfo {
 	dpcl := *ctx.DevicePortConfigList
 	sainfo := new(info.SystemAdapterInfo)
@@ -951,6 +1054,8 @@ func encodeSystemAdapterInfo(ctx *zedagentContext) *info.SystemAdapterInfo {
 						Providers:      encodeCellProviders(wwanStatus.Providers),
 						ConfigError:    wwanStatus.ConfigError,
 						ProbeError:     wwanStatus.ProbeError,
+						ActiveSimSlot:  uint32(wwanStatus.CurrentSimSlot),
+						DataUsage:      encodeCellDataUsage(ctx, p.Logicallabel),
 					},
 				}
 			}
@@ -0,0 +1,712 @@
+// Copyright (c) 2022 Zededa, Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+// Offline operation using USB sticks: apply a signed config bundle and
+// load the images it references into the CAS, then export info, metrics
+// and logs to the stick for a later upload to the controller.
+// See docs/OFFLINE-BUNDLE.md for the layout of the stick.
+
+package zedagent
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/golang/protobuf/proto"
+	v1types "github.com/google/go-containerregistry/pkg/v1/types"
+	"github.com/lf-edge/eve/api/go/batch"
+	zconfig "github.com/lf-edge/eve/api/go/config"
+	"github.com/lf-edge/eve/pkg/pillar/base"
+	"github.com/lf-edge/eve/pkg/pillar/types"
+	fileutils "github.com/lf-edge/eve/pkg/pillar/utils/file"
+	"github.com/lf-edge/eve/pkg/pillar/zedcloud"
+	"github.com/opencontainers/go-digest"
+	ocispec "github.com/opencontainers/image-spec/specs-go/v1"
+	uuid "github.com/satori/go.uuid"
+)
+
+const (
+	usbBundleDir        = "eve-bundle"
+	usbBundleCertsFile  = "controller-certs.pb"
+	usbBundleConfigFile = "config.pb"
+	usbBundleIndexFile  = "index.json"
+	usbExportDir        = "eve-export"
+
+	usbMountDir     = "/run/" + agentName + "/usb"
+	usbSysBlockDir  = "/sys/block"
+	usbPollInterval = 30 * time.Second
+	// How long we wait for volumemgr to load the blobs of a bundle
+	usbImportTimeout = 4 * time.Hour
+
+	// Each export file holds at most this much, and an export at most
+	// usbExportMaxLogBytes of logs; the rest goes to the next stick
+	usbExportMaxFileSize = 32 * 1024 * 1024
+	usbExportMaxLogBytes = 512 * 1024 * 1024
+	// Checkpoint file with the modification time of the newest log file
+	// which was exported
+	usbExportLogMarkFile = "lastusbexportlog"
+	// Checkpoint file with the sequence number of the last bundle which
+	// was applied
+	usbBundleSequenceFile = "lastusbbundlesequence"
+
+	// Metrics which could not be sent are kept in memory for an export,
+	// up to this size
+	offlineMetricsMaxSize = 4 * 1024 * 1024
+)
+
+// offlineMetrics holds the most recent metrics which could not be sent
+type offlineMetricsQueue struct {
+	sync.Mutex
+	msgs [][]byte
+	size int
+}
+
+var offlineMetrics offlineMetricsQueue
+
+// queueOfflineMetrics keeps metrics which could not be sent for an export,
+// dropping the oldest ones when over the limit
+func queueOfflineMetrics(data []byte) {
+	offlineMetrics.Lock()
+	defer offlineMetrics.Unlock()
+	offlineMetrics.msgs = append(offlineMetrics.msgs, data)
+	offlineMetrics.size += len(data)
+	for offlineMetrics.size > offlineMetricsMaxSize && len(offlineMetrics.msgs) > 1 {
+		offlineMetrics.size -= len(offlineMetrics.msgs[0])
+		offlineMetrics.msgs = offlineMetrics.msgs[1:]
+	}
+}
+
+// peekOfflineMetrics returns the queued metrics without removing them
+func peekOfflineMetrics() [][]byte {
+	offlineMetrics.Lock()
+	defer offlineMetrics.Unlock()
+	return append([][]byte(nil), offlineMetrics.msgs...)
+}
+
+// dropOfflineMetrics removes the exported metrics; more might have been
+// queued in the meantime, and the oldest might have been dropped.
+func dropOfflineMetrics(exported [][]byte) {
+	offlineMetrics.Lock()
+	defer offlineMetrics.Unlock()
+	done := make(map[*byte]bool)
+	for _, data := range exported {
+		if len(data) != 0 {
+			done[&data[0]] = true
+		}
+	}
+	var msgs [][]byte
+	size := 0
+	for _, data := range offlineMetrics.msgs {
+		if len(data) != 0 && done[&data[0]] {
+			continue
+		}
+		msgs = append(msgs, data)
+		size += len(data)
+	}
+	offlineMetrics.msgs = msgs
+	offlineMetrics.size = size
+}
+
+// usbBundleTask looks for USB sticks. Each stick is mounted, processed and
+// unmounted once after it was plugged in.
+func usbBundleTask(getconfigCtx *getconfigContext) {
+	ctx := getconfigCtx.zedagentCtx
+	wdName := agentName + "usb"
+
+	ticker := time.NewTicker(usbPollInterval)
+	stillRunning := time.NewTicker(25 * time.Second)
+	ctx.ps.StillRunning(wdName, warningTime, errorTime)
+	ctx.ps.RegisterFileWatchdog(wdName)
+
+	rootDisk := ""
+	if rootDev, err := getRootDevice(); err == nil {
+		rootDisk = filepath.Base(rootDev)
+	} else {
+		log.Warnf("usbBundleTask: %v", err)
+	}
+	// Devices which we processed and which are still present
+	processed := make(map[string]bool)
+	for {
+		select {
+		case <-ticker.C:
+			if !ctx.globalConfig.GlobalValueBool(types.OfflineUSBBundle) {
+				break
+			}
+			start := time.Now()
+			devices := findUSBDevices(usbSysBlockDir, rootDisk, mountedDevices())
+			present := make(map[string]bool)
+			for _, dev := range devices {
+				present[dev] = true
+				if processed[dev] {
+					continue
+				}
+				processed[dev] = true
+				processUSBDevice(getconfigCtx, wdName, dev)
+			}
+			for dev := range processed {
+				if !present[dev] {
+					log.Noticef("usbBundleTask: %s removed", dev)
+					delete(processed, dev)
+				}
+			}
+			ctx.ps.CheckMaxTimeTopic(wdName, "usbBundle", start,
+				warningTime, errorTime)
+		case <-stillRunning.C:
+		}
+		ctx.ps.StillRunning(wdName, warningTime, errorTime)
+	}
+}
+
+// getRootDevice is a variable to allow tests without a /dev/root
+var getRootDevice = func() (string, error) {
+	link, err := filepath.EvalSymlinks("/dev/root")
+	if err != nil {
+		return "", fmt.Errorf("cannot find root device: %s", err)
+	}
+	// Strip the partition
+	sysLink, err := filepath.EvalSymlinks(filepath.Join("/sys/class/block",
+		filepath.Base(link)))
+	if err != nil {
+		return "", fmt.Errorf("cannot find block device: %s", err)
+	}
+	if _, err := os.Stat(filepath.Join(sysLink, "partition")); err == nil {
+		return filepath.Dir(sysLink), nil
+	}
+	return sysLink, nil
+}
+
+// mountedDevices returns the names of the block devices which are mounted
+func mountedDevices() map[string]bool {
+	mounted := make(map[string]bool)
+	data, err := ioutil.ReadFile("/proc/mounts")
+	if err != nil {
+		log.Errorf("mountedDevices: %v", err)
+		return mounted
+	}
+	for _, line := range strings.Split(string(data), "\n") {
+		fields := strings.Fields(line)
+		if len(fields) < 2 || !strings.HasPrefix(fields[0], "/dev/") {
+			continue
+		}
+		mounted[filepath.Base(fields[0])] = true
+	}
+	return mounted
+}
+
+// findUSBDevices returns the partitions of the USB and other removable disks,
+// or the disk if it has no partitions. Disks with a partition which is
+// mounted are skipped since those are in use, e.g., EVE installed on a stick.
+func findUSBDevices(sysBlockDir string, rootDisk string,
+	mounted map[string]bool) []string {
+
+	var devices []string
+	disks, err := ioutil.ReadDir(sysBlockDir)
+	if err != nil {
+		log.Errorf("findUSBDevices: %v", err)
+		return nil
+	}
+	for _, disk := range disks {
+		name := disk.Name()
+		if name == rootDisk || strings.HasPrefix(name, "sr") {
+			continue
+		}
+		diskDir := filepath.Join(sysBlockDir, name)
+		removable, _ := ioutil.ReadFile(filepath.Join(diskDir, "removable"))
+		realPath, _ := filepath.EvalSymlinks(diskDir)
+		if strings.TrimSpace(string(removable)) != "1" &&
+			!strings.Contains(realPath, "/usb") {
+			continue
+		}
+		var parts []string
+		inUse := mounted[name]
+		entries, _ := ioutil.ReadDir(diskDir)
+		for _, entry := range entries {
+			_, err := os.Stat(filepath.Join(diskDir, entry.Name(), "partition"))
+			if err != nil {
+				continue
+			}
+			if mounted[entry.Name()] {
+				inUse = true
+			}
+			parts = append(parts, entry.Name())
+		}
+		if inUse {
+			continue
+		}
+		if len(parts) == 0 {
+			parts = []string{name}
+		}
+		devices = append(devices, parts...)
+	}
+	sort.Strings(devices)
+	return devices
+}
+
+// processUSBDevice applies the bundle and writes the export if the device
+// has a filesystem with either of them
+func processUSBDevice(getconfigCtx *getconfigContext, wdName string, dev string) {
+	mountPoint := filepath.Join(usbMountDir, dev)
+	if err := os.MkdirAll(mountPoint, 0700); err != nil {
+		log.Errorf("processUSBDevice(%s): %v", dev, err)
+		return
+	}
+	// Mount read-only unless we have something to write
+	out, err := base.Exec(log, "mount", "-o", "ro,nosuid,nodev,noexec",
+		"/dev/"+dev, mountPoint).CombinedOutput()
+	if err != nil {
+		log.Functionf("processUSBDevice(%s): no filesystem: %v %s", dev, err, out)
+		return
+	}
+	defer func() {
+		out, err := base.Exec(log, "umount", mountPoint).CombinedOutput()
+		if err != nil {
+			log.Errorf("processUSBDevice(%s): umount failed: %v %s", dev, err, out)
+		}
+	}()
+	bundleDir := filepath.Join(mountPoint, usbBundleDir)
+	exportDir := filepath.Join(mountPoint, usbExportDir)
+	_, err = os.Stat(bundleDir)
+	hasBundle := err == nil
+	_, err = os.Stat(exportDir)
+	hasExport := err == nil
+	if !hasBundle && !hasExport {
+		log.Noticef("processUSBDevice(%s): neither %s nor %s",
+			dev, usbBundleDir, usbExportDir)
+		return
+	}
+	log.Noticef("processUSBDevice(%s): bundle %t export %t", dev, hasBundle, hasExport)
+	if hasBundle {
+		if err := applyUSBBundle(getconfigCtx, wdName, bundleDir); err != nil {
+			log.Errorf("processUSBDevice(%s): bundle not applied: %v", dev, err)
+		}
+	}
+	if !hasExport {
+		return
+	}
+	out, err = base.Exec(log, "mount", "-o", "remount,rw,nosuid,nodev,noexec",
+		mountPoint).CombinedOutput()
+	if err != nil {
+		log.Errorf("processUSBDevice(%s): remount failed: %v %s", dev, err, out)
+		return
+	}
+	err = writeUSBExport(filepath.Join(exportDir, devUUID.String()))
+	if err != nil {
+		log.Errorf("processUSBDevice(%s): export failed: %v", dev, err)
+	}
+}
+
+// applyUSBBundle verifies the bundle, has volumemgr load its blobs into the
+// CAS, and then hands the config over to the config task
+func applyUSBBundle(getconfigCtx *getconfigContext, wdName string, bundleDir string) error {
+	certs, err := ioutil.ReadFile(filepath.Join(bundleDir, usbBundleCertsFile))
+	if err != nil {
+		return err
+	}
+	signingCert, err := zedcloud.VerifySigningCertChain(zedcloudCtx, certs)
+	if err != nil {
+		return fmt.Errorf("controller certificates: %v", err)
+	}
+	signed, err := ioutil.ReadFile(filepath.Join(bundleDir, usbBundleConfigFile))
+	if err != nil {
+		return err
+	}
+	contents, err := zedcloud.VerifyAuthContainer(zedcloudCtx, signed, signingCert)
+	if err != nil {
+		return err
+	}
+	configResponse := &zconfig.ConfigResponse{}
+	if err := proto.Unmarshal(contents, configResponse); err != nil {
+		return fmt.Errorf("config unmarshal failed: %v", err)
+	}
+	id, err := uuid.FromString(configResponse.GetConfig().GetId().GetUuid())
+	if err != nil || id != devUUID {
+		return fmt.Errorf("config is not for device %s", devUUID)
+	}
+	if err := checkUSBBundleSequence(configResponse, readUSBBundleSequence()); err != nil {
+		return err
+	}
+	hash := configResponse.GetConfigHash()
+	log.Noticef("applyUSBBundle: verified config with hash %s sequence %d",
+		hash, configResponse.GetOfflineBundleSequence())
+
+	blobs, err := readUSBBlobIndex(bundleDir, hash)
+	if err != nil {
+		return err
+	}
+	if err := importUSBBlobs(getconfigCtx, wdName, blobs); err != nil {
+		return err
+	}
+	getconfigCtx.offlineConfig <- contents
+	return nil
+}
+
+// readUSBBlobIndex returns the blobs of the OCI image layout in bundleDir.
+// We walk the index, manifests and manifest lists to learn the media types.
+// Blobs referenced by a manifest list but not included, e.g., for other
+// architectures, are skipped.
+func readUSBBlobIndex(bundleDir string, bundleHash string) ([]types.OfflineBlobImportConfig, error) {
+	var index ocispec.Index
+	if err := readUSBJSON(filepath.Join(bundleDir, usbBundleIndexFile), &index); err != nil {
+		return nil, err
+	}
+	type pendingDesc struct {
+		ocispec.Descriptor
+		required bool
+	}
+	var pending []pendingDesc
+	for _, desc := range index.Manifests {
+		pending = append(pending, pendingDesc{Descriptor: desc, required: true})
+	}
+	blobs := make(map[string]types.OfflineBlobImportConfig)
+	for len(pending) != 0 {
+		desc := pending[0]
+		pending = pending[1:]
+		if err := desc.Digest.Validate(); err != nil ||
+			desc.Digest.Algorithm() != digest.SHA256 {
+			return nil, fmt.Errorf("unsupported digest %q", desc.Digest)
+		}
+		sha := strings.ToLower(desc.Digest.Hex())
+		if _, ok := blobs[sha]; ok {
+			continue
+		}
+		path := filepath.Join(bundleDir, "blobs", string(digest.SHA256), sha)
+		if _, err := os.Stat(path); err != nil {
+			if desc.required {
+				return nil, fmt.Errorf("missing blob %s", desc.Digest)
+			}
+			log.Functionf("readUSBBlobIndex: skipping blob %s not in bundle",
+				desc.Digest)
+			continue
+		}
+		blob := types.BlobStatus{MediaType: desc.MediaType}
+		if blob.MediaType == "" {
+			blob.MediaType = string(v1types.OCILayer)
+		}
+		blobs[sha] = types.OfflineBlobImportConfig{
+			Sha256:     sha,
+			MediaType:  blob.MediaType,
+			Size:       uint64(desc.Size),
+			Path:       path,
+			BundleHash: bundleHash,
+		}
+		switch {
+		case blob.IsIndex():
+			var child ocispec.Index
+			if err := readUSBJSON(path, &child); err != nil {
+				return nil, err
+			}
+			for _, m := range child.Manifests {
+				pending = append(pending, pendingDesc{Descriptor: m})
+			}
+		case blob.IsManifest():
+			var manifest ocispec.Manifest
+			if err := readUSBJSON(path, &manifest); err != nil {
+				return nil, err
+			}
+			pending = append(pending,
+				pendingDesc{Descriptor: manifest.Config, required: true})
+			for _, l := range manifest.Layers {
+				pending = append(pending, pendingDesc{Descriptor: l, required: true})
+			}
+		}
+	}
+	var result []types.OfflineBlobImportConfig
+	for _, blob := range blobs {
+		result = append(result, blob)
+	}
+	sort.Slice(result, func(i, j int) bool {
+		return result[i].Sha256 < result[j].Sha256
+	})
+	return result, nil
+}
+
+func readUSBJSON(path string, v interface{}) error {
+	// Indexes and manifests are small; do not read a layer by mistake
+	const maxSize = 4 * 1024 * 1024
+	info, err := os.Stat(path)
+	if err != nil {
+		return err
+	}
+	if info.Size() > maxSize {
+		return fmt.Errorf("%s is too large", path)
+	}
+	data, err := ioutil.ReadFile(path)
+	if err != nil {
+		return err
+	}
+	if err := json.Unmarshal(data, v); err != nil {
+		return fmt.Errorf("%s: %v", path, err)
+	}
+	return nil
+}
+
+// importUSBBlobs asks volumemgr to load the blobs and waits for the result
+func importUSBBlobs(getconfigCtx *getconfigContext, wdName string,
+	blobs []types.OfflineBlobImportConfig) error {
+
+	ctx := getconfigCtx.zedagentCtx
+	pub := getconfigCtx.pubOfflineBlobImportConfig
+	for _, blob := range blobs {
+		pub.Publish(blob.Key(), blob)
+	}
+	defer func() {
+		for _, blob := range blobs {
+			pub.Unpublish(blob.Key())
+		}
+	}()
+	log.Noticef("importUSBBlobs: loading %d blobs", len(blobs))
+	deadline := time.Now().Add(usbImportTimeout)
+	for {
+		loaded := 0
+		var errs []string
+		for _, blob := range blobs {
+			st, _ := getconfigCtx.subOfflineBlobImportStatus.Get(blob.Key())
+			if st == nil {
+				continue
+			}
+			status := st.(types.OfflineBlobImportStatus)
+			if status.BundleHash != blob.BundleHash {
+				continue
+			}
+			if status.HasError() {
+				errs = append(errs, fmt.Sprintf("%s: %s", blob.Sha256, status.Error))
+			} else if status.Loaded {
+				loaded++
+			}
+		}
+		if len(errs) != 0 {
+			return fmt.Errorf("loading blobs failed: %s", strings.Join(errs, "; "))
+		}
+		if loaded == len(blobs) {
+			log.Noticef("importUSBBlobs: loaded %d blobs", loaded)
+			return nil
+		}
+		if time.Now().After(deadline) {
+			return fmt.Errorf("loaded only %d of %d blobs in %v",
+				loaded, len(blobs), usbImportTimeout)
+		}
+		time.Sleep(5 * time.Second)
+		ctx.ps.StillRunning(wdName, warningTime, errorTime)
+	}
+}
+
+// applyOfflineConfig is called by the config task with a config from a
+// verified bundle. Returns a configProcessingSkipFlag
+func applyOfflineConfig(getconfigCtx *getconfigContext, contents []byte) bool {
+	configResponse := &zconfig.ConfigResponse{}
+	if err := proto.Unmarshal(contents, configResponse); err != nil {
+		log.Errorf("applyOfflineConfig: unmarshalling failed: %v", err)
+		return getconfigCtx.configProcessingSkipFlag
+	}
+	// Another bundle might have been applied since this one was verified
+	if err := checkUSBBundleSequence(configResponse, readUSBBundleSequence()); err != nil {
+		log.Errorf("applyOfflineConfig: %v", err)
+		return getconfigCtx.configProcessingSkipFlag
+	}
+	saveConfig(usbBundleSequenceFile,
+		[]byte(strconv.FormatUint(configResponse.GetOfflineBundleSequence(), 10)))
+	hash := configResponse.GetConfigHash()
+	if hash != "" && hash == prevConfigHash {
+		log.Noticef("applyOfflineConfig: config %s is already in use", hash)
+		return getconfigCtx.configProcessingSkipFlag
+	}
+	log.Noticef("applyOfflineConfig: ConfigHash from %s to %s", prevConfigHash, hash)
+	prevConfigHash = hash
+	saveReceivedProtoMessage(contents)
+	// Do not replace it with the saved config if the controller is not
+	// reachable
+	getconfigCtx.readSavedConfig = true
+	if getconfigCtx.configGetStatus != types.ConfigGetSuccess {
+		getconfigCtx.configGetStatus = types.ConfigGetReadSaved
+	}
+	publishZedAgentStatus(getconfigCtx)
+	return inhaleDeviceConfig(configResponse.GetConfig(), getconfigCtx, false)
+}
+
+// writeUSBExport writes the info messages which are waiting to be sent,
+// the queued metrics and the logs which were not exported before into
+// signed files, each holding a batch request for the controller.
+func writeUSBExport(exportDir string) error {
+	items := zedcloud.GetDeferredBatchItems(zedcloudCtx)
+	metrics := peekOfflineMetrics()
+	for _, data := range metrics {
+		items = append(items, &batch.BatchItem{Path: "metrics", Body: data})
+	}
+	logItems, logMark, err := collectUSBExportLogs(readUSBExportLogMark())
+	if err != nil {
+		log.Errorf("writeUSBExport: logs: %v", err)
+	}
+	items = append(items, logItems...)
+	if len(items) == 0 {
+		log.Noticef("writeUSBExport: nothing to export")
+		return nil
+	}
+	if err := os.MkdirAll(exportDir, 0755); err != nil {
+		return err
+	}
+	prefix := "export-" + time.Now().UTC().Format("20060102T150405Z")
+	for i, group := range packBatchItems(items, usbExportMaxFileSize) {
+		payload, err := proto.Marshal(&batch.BatchRequest{Items: group})
+		if err != nil {
+			return err
+		}
+		signed, err := zedcloud.SignAuthContainer(zedcloudCtx, payload)
+		if err != nil {
+			return err
+		}
+		filename := filepath.Join(exportDir, fmt.Sprintf("%s-%03d.pb", prefix, i))
+		if err := fileutils.WriteRename(filename, signed); err != nil {
+			return err
+		}
+	}
+	dropOfflineMetrics(metrics)
+	if len(logItems) != 0 {
+		saveConfig(usbExportLogMarkFile, []byte(strconv.FormatInt(logMark, 10)))
+	}
+	log.Noticef("writeUSBExport: exported %d items, %d metrics and %d log files to %s",
+		len(items), len(metrics), len(logItems), exportDir)
+	return nil
+}
+
+// packBatchItems splits the items into groups of at most maxSize bytes.
+// An item larger than that is put in a group by itself.
+func packBatchItems(items []*batch.BatchItem, maxSize int) [][]*batch.BatchItem {
+	var groups [][]*batch.BatchItem
+	var group []*batch.BatchItem
+	size := 0
+	for _, item := range items {
+		itemSize := proto.Size(item)
+		if len(group) != 0 && size+itemSize > maxSize {
+			groups = append(groups, group)
+			group = nil
+			size = 0
+		}
+		group = append(group, item)
+		size += itemSize
+	}
+	if len(group) != 0 {
+		groups = append(groups, group)
+	}
+	return groups
+}
+
+// checkUSBBundleSequence rejects a bundle which is not newer than the last
+// one applied, the sequence number of which is last, since an older bundle
+// which is validly signed would roll the config back
+func checkUSBBundleSequence(configResponse *zconfig.ConfigResponse, last uint64) error {
+	sequence := configResponse.GetOfflineBundleSequence()
+	if sequence == 0 {
+		return fmt.Errorf("bundle has no sequence number")
+	}
+	if sequence <= last {
+		return fmt.Errorf("bundle sequence %d is not newer than %d", sequence, last)
+	}
+	return nil
+}
+
+func readUSBBundleSequence() uint64 {
+	data, err := ioutil.ReadFile(filepath.Join(checkpointDirname, usbBundleSequenceFile))
+	if err != nil {
+		return 0
+	}
+	sequence, err := strconv.ParseUint(strings.TrimSpace(string(data)), 10, 64)
+	if err != nil {
+		log.Warnf("readUSBBundleSequence: %v", err)
+		return 0
+	}
+	return sequence
+}
+
+func readUSBExportLogMark() int64 {
+	data, err := ioutil.ReadFile(filepath.Join(checkpointDirname, usbExportLogMarkFile))
+	if err != nil {
+		return 0
+	}
+	mark, err := strconv.ParseInt(strings.TrimSpace(string(data)), 10, 64)
+	if err != nil {
+		log.Warnf("readUSBExportLogMark: %v", err)
+		return 0
+	}
+	return mark
+}
+
+// collectUSBExportLogs returns the gzip log files waiting for upload which
+// were modified after mark, oldest first, and the new mark
+func collectUSBExportLogs(mark int64) ([]*batch.BatchItem, int64, error) {
+	type logFile struct {
+		path    string
+		urlPath string
+		modTime int64
+		size    int64
+	}
+	var files []logFile
+	for _, dir := range []string{types.NewlogUploadDevDir, types.NewlogUploadAppDir} {
+		entries, err := ioutil.ReadDir(dir)
+		if err != nil {
+			if os.IsNotExist(err) {
+				continue
+			}
+			return nil, mark, err
+		}
+		for _, entry := range entries {
+			modTime := entry.ModTime().UnixNano()
+			urlPath := newlogURLPath(entry.Name())
+			if urlPath == "" || modTime <= mark {
+				continue
+			}
+			files = append(files, logFile{
+				path:    filepath.Join(dir, entry.Name()),
+				urlPath: urlPath,
+				modTime: modTime,
+				size:    entry.Size(),
+			})
+		}
+	}
+	sort.Slice(files, func(i, j int) bool {
+		return files[i].modTime < files[j].modTime
+	})
+	var items []*batch.BatchItem
+	var total int64
+	for _, file := range files {
+		if total+file.size > usbExportMaxLogBytes {
+			break
+		}
+		data, err := ioutil.ReadFile(file.path)
+		if err != nil {
+			// Uploaded and removed in the meantime
+			log.Functionf("collectUSBExportLogs: %v", err)
+			continue
+		}
+		items = append(items, &batch.BatchItem{Path: file.urlPath, Body: data})
+		total += file.size
+		mark = file.modTime
+	}
+	return items, mark, nil
+}
+
+// newlogURLPath returns the path loguploader uses for the gzip log file
+func newlogURLPath(name string) string {
+	if !strings.HasSuffix(name, ".gz") {
+		return ""
+	}
+	if strings.HasPrefix(name, types.DevPrefix) {
+		return "newlogs"
+	}
+	if strings.HasPrefix(name, types.AppPrefix) {
+		fields := strings.Split(strings.TrimPrefix(name, types.AppPrefix),
+			types.AppSuffix)
+		if len(fields) != 2 {
+			return ""
+		}
+		return fmt.Sprintf("apps/instanceid/%s/newlogs", fields[0])
+	}
+	return ""
+}
@@ -0,0 +1,159 @@
+// Copyright (c) 2022 Zededa, Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package zedagent
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	v1types "github.com/google/go-containerregistry/pkg/v1/types"
+	"github.com/lf-edge/eve/api/go/batch"
+	zconfig "github.com/lf-edge/eve/api/go/config"
+	"github.com/lf-edge/eve/pkg/pillar/base"
+	. "github.com/onsi/gomega"
+	"github.com/opencontainers/go-digest"
+	ocispec "github.com/opencontainers/image-spec/specs-go/v1"
+	"github.com/sirupsen/logrus"
+)
+
+func TestPackBatchItems(t *testing.T) {
+	g := NewGomegaWithT(t)
+	item := func(size int) *batch.BatchItem {
+		return &batch.BatchItem{Path: "info", Body: make([]byte, size)}
+	}
+	items := []*batch.BatchItem{item(100), item(100), item(1000), item(10)}
+	groups := packBatchItems(items, 250)
+	g.Expect(groups).To(HaveLen(3))
+	g.Expect(groups[0]).To(Equal(items[0:2]))
+	// Too large for any group, but not dropped
+	g.Expect(groups[1]).To(Equal(items[2:3]))
+	g.Expect(groups[2]).To(Equal(items[3:4]))
+	g.Expect(packBatchItems(nil, 250)).To(BeEmpty())
+}
+
+func TestCheckUSBBundleSequence(t *testing.T) {
+	g := NewGomegaWithT(t)
+	bundle := func(sequence uint64) *zconfig.ConfigResponse {
+		return &zconfig.ConfigResponse{OfflineBundleSequence: sequence}
+	}
+	g.Expect(checkUSBBundleSequence(bundle(1), 0)).To(Succeed())
+	g.Expect(checkUSBBundleSequence(bundle(8), 7)).To(Succeed())
+	// Replayed or older bundles
+	g.Expect(checkUSBBundleSequence(bundle(7), 7)).NotTo(Succeed())
+	g.Expect(checkUSBBundleSequence(bundle(3), 7)).NotTo(Succeed())
+	// Bundles without a sequence number
+	g.Expect(checkUSBBundleSequence(bundle(0), 0)).NotTo(Succeed())
+}
+
+func TestFindUSBDevices(t *testing.T) {
+	g := NewGomegaWithT(t)
+	logger = logrus.StandardLogger()
+	log = base.NewSourceLogObject(logger, "zedagent", 1234)
+
+	sysBlock := t.TempDir()
+	addDisk := func(name string, removable string, parts ...string) {
+		dir := filepath.Join(sysBlock, name)
+		g.Expect(os.MkdirAll(dir, 0755)).To(Succeed())
+		g.Expect(ioutil.WriteFile(filepath.Join(dir, "removable"),
+			[]byte(removable+"\n"), 0644)).To(Succeed())
+		for i, part := range parts {
+			g.Expect(os.MkdirAll(filepath.Join(dir, part), 0755)).To(Succeed())
+			g.Expect(ioutil.WriteFile(filepath.Join(dir, part, "partition"),
+				[]byte{byte('1' + i)}, 0644)).To(Succeed())
+		}
+	}
+	addDisk("sda", "0", "sda1", "sda2")
+	addDisk("sdb", "1", "sdb1", "sdb2")
+	addDisk("sdc", "1")
+	addDisk("sdd", "1", "sdd1")
+	addDisk("sr0", "1")
+	addDisk("nvme0n1", "0", "nvme0n1p1")
+
+	mounted := map[string]bool{"sdd1": true}
+	g.Expect(findUSBDevices(sysBlock, "sda", mounted)).To(
+		Equal([]string{"sdb1", "sdb2", "sdc"}))
+	g.Expect(findUSBDevices(sysBlock, "sdb", nil)).To(
+		Equal([]string{"sdc", "sdd1"}))
+}
+
+func TestReadUSBBlobIndex(t *testing.T) {
+	g := NewGomegaWithT(t)
+	logger = logrus.StandardLogger()
+	log = base.NewSourceLogObject(logger, "zedagent", 1234)
+
+	bundleDir := t.TempDir()
+	blobDir := filepath.Join(bundleDir, "blobs", "sha256")
+	g.Expect(os.MkdirAll(blobDir, 0755)).To(Succeed())
+	addBlob := func(mediaType v1types.MediaType, data []byte) ocispec.Descriptor {
+		d := digest.FromBytes(data)
+		g.Expect(ioutil.WriteFile(filepath.Join(blobDir, d.Hex()), data,
+			0644)).To(Succeed())
+		return ocispec.Descriptor{
+			MediaType: string(mediaType),
+			Digest:    d,
+			Size:      int64(len(data)),
+		}
+	}
+	addJSONBlob := func(mediaType v1types.MediaType, v interface{}) ocispec.Descriptor {
+		data, err := json.Marshal(v)
+		g.Expect(err).NotTo(HaveOccurred())
+		return addBlob(mediaType, data)
+	}
+	writeIndex := func(descs ...ocispec.Descriptor) {
+		data, err := json.Marshal(ocispec.Index{Manifests: descs})
+		g.Expect(err).NotTo(HaveOccurred())
+		g.Expect(ioutil.WriteFile(filepath.Join(bundleDir, usbBundleIndexFile),
+			data, 0644)).To(Succeed())
+	}
+
+	config := addBlob(v1types.OCIConfigJSON, []byte(`{"architecture":"amd64"}`))
+	layer := addBlob(v1types.OCILayer, []byte("layer"))
+	manifest := addJSONBlob(v1types.OCIManifestSchema1, ocispec.Manifest{
+		Config: config,
+		Layers: []ocispec.Descriptor{layer},
+	})
+	otherArch := ocispec.Descriptor{
+		MediaType: string(v1types.OCIManifestSchema1),
+		Digest:    digest.FromString("not included"),
+	}
+	index := addJSONBlob(v1types.OCIImageIndex, ocispec.Index{
+		Manifests: []ocispec.Descriptor{manifest, otherArch},
+	})
+	writeIndex(index)
+
+	blobs, err := readUSBBlobIndex(bundleDir, "hash")
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(blobs).To(HaveLen(4))
+	mediaTypes := make(map[string]string)
+	for i, blob := range blobs {
+		if i > 0 {
+			g.Expect(blob.Sha256 > blobs[i-1].Sha256).To(BeTrue())
+		}
+		g.Expect(blob.BundleHash).To(Equal("hash"))
+		g.Expect(blob.Path).To(Equal(filepath.Join(blobDir, blob.Sha256)))
+		mediaTypes[blob.Sha256] = blob.MediaType
+	}
+	g.Expect(mediaTypes).To(Equal(map[string]string{
+		index.Digest.Hex():    string(v1types.OCIImageIndex),
+		manifest.Digest.Hex(): string(v1types.OCIManifestSchema1),
+		config.Digest.Hex():   string(v1types.OCIConfigJSON),
+		layer.Digest.Hex():    string(v1types.OCILayer),
+	}))
+
+	// A layer of an included manifest must be there
+	g.Expect(os.Remove(filepath.Join(blobDir, layer.Digest.Hex()))).To(Succeed())
+	_, err = readUSBBlobIndex(bundleDir, "hash")
+	g.Expect(err).To(HaveOccurred())
+
+	// Only sha256 digests are supported
+	writeIndex(ocispec.Descriptor{
+		MediaType: string(v1types.OCILayer),
+		Digest:    digest.Digest("md5:0123456789abcdef0123456789abcdef"),
+	})
+	_, err = readUSBBlobIndex(bundleDir, "hash")
+	g.Expect(err).To(HaveOccurred())
+}
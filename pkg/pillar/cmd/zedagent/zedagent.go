@@ -30,6 +30,7 @@ import (
 	"flag"
 	"fmt"
 	"os"
+	"strings"
 	"time"
 
 	"github.com/eriknordmark/ipinfo"
@@ -52,6 +53,8 @@ const (
 	agentName               = "zedagent"
 	restartCounterFile      = types.PersistStatusDir + "/restartcounter"
 	lastDevCmdTimestampFile = types.PersistStatusDir + "/lastdevcmdtimestamp"
+	// deferredJournalFile - info messages which are not sent yet
+	deferredJournalFile = types.PersistStatusDir + "/deferred-info.journal"
 	// checkpointDirname - location of config checkpoint
 	checkpointDirname = types.PersistDir + "/checkpoint"
 	// Time limits for event loop handlers
@@ -81,6 +84,7 @@ var cipherMetricsDL types.CipherMetrics
 var cipherMetricsDM types.CipherMetrics
 var cipherMetricsNim types.CipherMetrics
 var cipherMetricsZR types.CipherMetrics
+var cipherMetricsVM types.CipherMetrics
 var diagMetrics types.MetricsMap
 var nimMetrics types.MetricsMap
 var zrouterMetrics types.MetricsMap
@@ -131,6 +135,7 @@ type zedagentContext struct {
 	subCapabilities           pubsub.Subscription
 	subAppInstMetaData        pubsub.Subscription
 	subWwanMetrics            pubsub.Subscription
+	subWwanDataUsage          pubsub.Subscription
 	subLocationInfo           pubsub.Subscription
 	subDeviceNetworkStatus    pubsub.Subscription
 	subZFSPoolStatus          pubsub.Subscription
@@ -396,6 +401,17 @@ func Run(ps *pubsub.PubSub, loggerArg *logrus.Logger, logArg *base.LogObject) in
 		zedagentCtx.zedcloudMetrics)
 	// Timer for deferred sends of info messages
 	deferredChan := zedcloud.GetDeferredChan(zedcloudCtx, getDeferredSentHandlerFunction(&zedagentCtx), getDeferredPriorityFunctions()...)
+	maxSize, maxAge := getDeferredJournalLimits(zedagentCtx.globalConfig)
+	err = zedcloud.EnableDeferredJournal(zedcloudCtx, zedcloud.DeferredJournalOptions{
+		Path:           deferredJournalFile,
+		MaxSize:        maxSize,
+		MaxAge:         maxAge,
+		EncodeItemType: encodeDeferredItemType,
+		DecodeItemType: decodeDeferredItemType,
+	})
+	if err != nil {
+		log.Errorf("EnableDeferredJournal failed: %v", err)
+	}
 
 	subAssignableAdapters, err := ps.NewSubscription(pubsub.SubscriptionOptions{
 		AgentName:     "domainmgr",
@@ -567,6 +583,30 @@ func Run(ps *pubsub.PubSub, loggerArg *logrus.Logger, logArg *base.LogObject) in
 	pubDisksConfig.ClearRestarted()
 	getconfigCtx.pubDisksConfig = pubDisksConfig
 
+	// for the download and maintenance windows
+	pubDeviceSchedule, err := ps.NewPublication(
+		pubsub.PublicationOptions{
+			AgentName:  agentName,
+			TopicType:  types.DeviceSchedule{},
+			Persistent: true,
+		})
+	if err != nil {
+		log.Fatal(err)
+	}
+	getconfigCtx.pubDeviceSchedule = pubDeviceSchedule
+
+	// for blobs from offline bundles
+	pubOfflineBlobImportConfig, err := ps.NewPublication(
+		pubsub.PublicationOptions{
+			AgentName: agentName,
+			TopicType: types.OfflineBlobImportConfig{},
+		})
+	if err != nil {
+		log.Fatal(err)
+	}
+	pubOfflineBlobImportConfig.ClearRestarted()
+	getconfigCtx.pubOfflineBlobImportConfig = pubOfflineBlobImportConfig
+
 	// Look for global config such as log levels
 	subGlobalConfig, err := ps.NewSubscription(pubsub.SubscriptionOptions{
 		AgentName:     agentName,
@@ -1083,6 +1123,22 @@ func Run(ps *pubsub.PubSub, loggerArg *logrus.Logger, logArg *base.LogObject) in
 	zedagentCtx.subWwanMetrics = subWwanMetrics
 	subWwanMetrics.Activate()
 
+	subWwanDataUsage, err := ps.NewSubscription(pubsub.SubscriptionOptions{
+		AgentName:   "nim",
+		MyAgentName: agentName,
+		TopicImpl:   types.WwanDataUsage{},
+		Activate:    false,
+		Persistent:  true,
+		Ctx:         &zedagentCtx,
+		WarningTime: warningTime,
+		ErrorTime:   errorTime,
+	})
+	if err != nil {
+		log.Fatal(err)
+	}
+	zedagentCtx.subWwanDataUsage = subWwanDataUsage
+	subWwanDataUsage.Activate()
+
 	subLocationInfo, err := ps.NewSubscription(pubsub.SubscriptionOptions{
 		AgentName:   "nim",
 		MyAgentName: agentName,
@@ -1197,6 +1253,9 @@ func Run(ps *pubsub.PubSub, loggerArg *logrus.Logger, logArg *base.LogObject) in
 		case change := <-subWwanMetrics.MsgChan():
 			subWwanMetrics.ProcessChange(change)
 
+		case change := <-subWwanDataUsage.MsgChan():
+			subWwanDataUsage.ProcessChange(change)
+
 		case change := <-subLocationInfo.MsgChan():
 			subLocationInfo.ProcessChange(change)
 
@@ -1334,6 +1393,16 @@ func Run(ps *pubsub.PubSub, loggerArg *logrus.Logger, logArg *base.LogObject) in
 	if err != nil {
 		log.Fatal(err)
 	}
+	subCipherMetricsVM, err := ps.NewSubscription(pubsub.SubscriptionOptions{
+		AgentName:   "volumemgr",
+		MyAgentName: agentName,
+		TopicImpl:   types.CipherMetrics{},
+		Activate:    true,
+		Ctx:         &zedagentCtx,
+	})
+	if err != nil {
+		log.Fatal(err)
+	}
 
 	subZFSPoolStatus, err := ps.NewSubscription(pubsub.SubscriptionOptions{
 		AgentName:   "zfsmanager",
@@ -1350,6 +1419,21 @@ func Run(ps *pubsub.PubSub, loggerArg *logrus.Logger, logArg *base.LogObject) in
 	zedagentCtx.subZFSPoolStatus = subZFSPoolStatus
 	subZFSPoolStatus.Activate()
 
+	// Results of loading blobs from offline bundles
+	subOfflineBlobImportStatus, err := ps.NewSubscription(pubsub.SubscriptionOptions{
+		AgentName:   "volumemgr",
+		MyAgentName: agentName,
+		TopicImpl:   types.OfflineBlobImportStatus{},
+		Activate:    true,
+		Ctx:         &zedagentCtx,
+		WarningTime: warningTime,
+		ErrorTime:   errorTime,
+	})
+	if err != nil {
+		log.Fatal(err)
+	}
+	getconfigCtx.subOfflineBlobImportStatus = subOfflineBlobImportStatus
+
 	//Parse SMART data
 	go parseSMARTData()
 
@@ -1398,6 +1482,13 @@ func Run(ps *pubsub.PubSub, loggerArg *logrus.Logger, logArg *base.LogObject) in
 	initializeLocalDevInfo(&getconfigCtx)
 	go localDevInfoPOSTTask(&getconfigCtx)
 
+	// initialize localConfig
+	initializeLocalConfig(&getconfigCtx)
+	go localConfigPOSTTask(&getconfigCtx)
+
+	// configs from USB sticks are applied by the config fetch task
+	getconfigCtx.offlineConfig = make(chan []byte)
+
 	// start the config fetch tasks, when zboot status is ready
 	log.Functionf("Creating %s at %s", "configTimerTask", agentlog.GetMyStack())
 	go configTimerTask(handleChannel, &getconfigCtx)
@@ -1414,6 +1505,9 @@ func Run(ps *pubsub.PubSub, loggerArg *logrus.Logger, logArg *base.LogObject) in
 	// start task fetching radio config from local server
 	go radioPOSTTask(&getconfigCtx)
 
+	// start task looking for config bundles on USB sticks
+	go usbBundleTask(&getconfigCtx)
+
 	// start cipher module tasks
 	cipherModuleStart(&zedagentCtx)
 
@@ -1614,6 +1708,16 @@ func Run(ps *pubsub.PubSub, loggerArg *logrus.Logger, logArg *base.LogObject) in
 				cipherMetricsZR = m.(types.CipherMetrics)
 			}
 
+		case change := <-subCipherMetricsVM.MsgChan():
+			subCipherMetricsVM.ProcessChange(change)
+			m, err := subCipherMetricsVM.Get("global")
+			if err != nil {
+				log.Errorf("subCipherMetricsVM.Get failed: %s",
+					err)
+			} else {
+				cipherMetricsVM = m.(types.CipherMetrics)
+			}
+
 		case change := <-subNetworkInstanceStatus.MsgChan():
 			subNetworkInstanceStatus.ProcessChange(change)
 
@@ -1660,6 +1764,9 @@ func Run(ps *pubsub.PubSub, loggerArg *logrus.Logger, logArg *base.LogObject) in
 		case change := <-subWwanMetrics.MsgChan():
 			subWwanMetrics.ProcessChange(change)
 
+		case change := <-subWwanDataUsage.MsgChan():
+			subWwanDataUsage.ProcessChange(change)
+
 		case change := <-subLocationInfo.MsgChan():
 			subLocationInfo.ProcessChange(change)
 
@@ -1667,6 +1774,9 @@ func Run(ps *pubsub.PubSub, loggerArg *logrus.Logger, logArg *base.LogObject) in
 			subZFSPoolStatus.ProcessChange(change)
 			triggerPublishDevInfo(&zedagentCtx)
 
+		case change := <-getconfigCtx.subOfflineBlobImportStatus.MsgChan():
+			getconfigCtx.subOfflineBlobImportStatus.ProcessChange(change)
+
 		case <-hwInfoTiker.C:
 			triggerPublishHwInfo(&zedagentCtx)
 
@@ -2119,6 +2229,10 @@ func handleGlobalConfigImpl(ctxArg interface{}, key string,
 		ctx.GCInitialized = true
 		ctx.gcpMaintenanceMode = gcp.GlobalValueTriState(types.MaintenanceMode)
 		mergeMaintenanceMode(ctx)
+		if zedcloudCtx != nil {
+			maxSize, maxAge := getDeferredJournalLimits(ctx.globalConfig)
+			zedcloud.SetDeferredJournalLimits(zedcloudCtx, maxSize, maxAge)
+		}
 	}
 
 	// XXX for testing edge-view
@@ -2362,6 +2476,34 @@ func getDeferredPriorityFunctions() []zedcloud.TypePriorityCheckFunction {
 	return functions
 }
 
+// getDeferredJournalLimits returns the bounds for the info messages kept on disk
+func getDeferredJournalLimits(gc types.ConfigItemValueMap) (int64, time.Duration) {
+	maxSize := int64(gc.GlobalValueInt(types.DeferredJournalMaxKBytes)) * 1024
+	maxAge := time.Duration(gc.GlobalValueInt(types.DeferredJournalMaxAge)) * time.Second
+	return maxSize, maxAge
+}
+
+// Only info messages are kept on disk; attestation has to start over
+// after a reboot anyhow.
+const deferredInfoPrefix = "info:"
+
+func encodeDeferredItemType(itemType interface{}) (string, bool) {
+	if el, ok := itemType.(info.ZInfoTypes); ok {
+		return deferredInfoPrefix + el.String(), true
+	}
+	return "", false
+}
+
+func decodeDeferredItemType(itemType string) (interface{}, error) {
+	if strings.HasPrefix(itemType, deferredInfoPrefix) {
+		name := strings.TrimPrefix(itemType, deferredInfoPrefix)
+		if value, ok := info.ZInfoTypes_value[name]; ok {
+			return info.ZInfoTypes(value), nil
+		}
+	}
+	return nil, fmt.Errorf("unknown deferred item type %s", itemType)
+}
+
 // Track the DeviceUUID
 func handleOnboardStatusCreate(ctxArg interface{}, key string,
 	statusArg interface{}) {
@@ -0,0 +1,185 @@
+// Copyright (c) 2022 Zededa, Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package zedmanager
+
+// Code for the dependencies between app instances. An app instance starts
+// after the app instances in its StartAfter are ready, and halts before
+// them when they are deactivated together, e.g., when the device reboots.
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/lf-edge/eve/pkg/pillar/types"
+	uuid "github.com/satori/go.uuid"
+)
+
+// lookupAppConfigByUUID returns the config of the app instance
+func lookupAppConfigByUUID(ctx *zedmanagerContext,
+	appUUID uuid.UUID) *types.AppInstanceConfig {
+
+	return lookupAppInstanceConfig(ctx, appUUID.String())
+}
+
+// isAppReady returns true if the app instance is ready for the app
+// instances which start after it
+func isAppReady(status *types.AppInstanceStatus,
+	readiness types.AppReadiness) bool {
+
+	if status == nil || status.State != types.RUNNING {
+		return false
+	}
+	// App instances without health checks are healthy once they run
+	return readiness != types.AppReadinessHealthOK ||
+		status.Health.State == types.HealthUnknown ||
+		status.Health.State == types.HealthHealthy
+}
+
+// lookupDependencyNotReady returns the config of an app instance which
+// the app instance starts after and which is not ready yet
+func lookupDependencyNotReady(ctx *zedmanagerContext,
+	config types.AppInstanceConfig) *types.AppInstanceConfig {
+
+	for _, dep := range config.StartAfter {
+		depConfig := lookupAppConfigByUUID(ctx, dep.AppUUID)
+		if depConfig == nil {
+			// zedagent only accepts app instances in the config,
+			// hence its config is about to be published
+			log.Warnf("lookupDependencyNotReady(%s): no app instance %s yet",
+				config.Key(), dep.AppUUID)
+			return &types.AppInstanceConfig{
+				UUIDandVersion: types.UUIDandVersion{UUID: dep.AppUUID},
+				DisplayName:    dep.AppUUID.String(),
+			}
+		}
+		depStatus := lookupAppInstanceStatus(ctx, depConfig.Key())
+		if !isAppReady(depStatus, dep.Readiness) {
+			return depConfig
+		}
+	}
+	return nil
+}
+
+// lookupDependentNotHalted returns the config of an app instance which
+// starts after the app instance, is deactivated as well, and has not
+// halted yet
+func lookupDependentNotHalted(ctx *zedmanagerContext,
+	config types.AppInstanceConfig) *types.AppInstanceConfig {
+
+	for _, c := range ctx.subAppInstanceConfig.GetAll() {
+		dependent := c.(types.AppInstanceConfig)
+		if !dependsOn(dependent, config.UUIDandVersion.UUID) {
+			continue
+		}
+		status := lookupAppInstanceStatus(ctx, dependent.Key())
+		if status == nil || status.EffectiveActivate ||
+			!(status.Activated || status.ActivateInprogress) {
+			continue
+		}
+		// The app instances in a cycle halt at once
+		if dependencyCycle(ctx, dependent) != nil {
+			continue
+		}
+		return &dependent
+	}
+	return nil
+}
+
+func dependsOn(config types.AppInstanceConfig, appUUID uuid.UUID) bool {
+	for _, dep := range config.StartAfter {
+		if uuid.Equal(dep.AppUUID, appUUID) {
+			return true
+		}
+	}
+	return false
+}
+
+// dependencyCycle returns the names of the app instances in a cycle of
+// dependencies which goes through the app instance, or nil if there is
+// none
+func dependencyCycle(ctx *zedmanagerContext,
+	config types.AppInstanceConfig) []string {
+
+	deps := func(appUUID uuid.UUID) []uuid.UUID {
+		c := lookupAppConfigByUUID(ctx, appUUID)
+		if c == nil {
+			return nil
+		}
+		var uuids []uuid.UUID
+		for _, dep := range c.StartAfter {
+			uuids = append(uuids, dep.AppUUID)
+		}
+		return uuids
+	}
+	path := findCycle(config.UUIDandVersion.UUID, deps)
+	if path == nil {
+		return nil
+	}
+	var names []string
+	for _, appUUID := range path {
+		name := appUUID.String()
+		if c := lookupAppConfigByUUID(ctx, appUUID); c != nil {
+			name = c.DisplayName
+		}
+		names = append(names, name)
+	}
+	return names
+}
+
+// findCycle returns a path of dependencies from start back to start, or
+// nil if there is none
+func findCycle(start uuid.UUID, deps func(uuid.UUID) []uuid.UUID) []uuid.UUID {
+	visited := make(map[uuid.UUID]bool)
+	var path []uuid.UUID
+	var visit func(appUUID uuid.UUID) bool
+	visit = func(appUUID uuid.UUID) bool {
+		path = append(path, appUUID)
+		for _, dep := range deps(appUUID) {
+			if uuid.Equal(dep, start) {
+				return true
+			}
+			if visited[dep] {
+				continue
+			}
+			visited[dep] = true
+			if visit(dep) {
+				return true
+			}
+		}
+		path = path[:len(path)-1]
+		return false
+	}
+	visited[start] = true
+	if visit(start) {
+		return path
+	}
+	return nil
+}
+
+// checkDependencyCycle sets the error of an app instance in a cycle of
+// dependencies, or clears it once the cycle is gone. Returns true if the
+// app instance is in a cycle.
+func checkDependencyCycle(ctx *zedmanagerContext, config types.AppInstanceConfig,
+	status *types.AppInstanceStatus) (changed bool, inCycle bool) {
+
+	cycle := dependencyCycle(ctx, config)
+	if cycle == nil {
+		if status.IsErrorSource(types.AppDependency{}) {
+			log.Functionf("checkDependencyCycle(%s): clearing %s",
+				status.Key(), status.Error)
+			status.ClearErrorWithSource()
+			changed = true
+		}
+		return changed, false
+	}
+	errStr := fmt.Sprintf("cycle in the app instances to start after: %s",
+		strings.Join(append(cycle, cycle[0]), " -> "))
+	if status.Error != errStr {
+		log.Errorf("checkDependencyCycle(%s): %s", status.Key(), errStr)
+		status.SetErrorWithSource(errStr, types.AppDependency{}, time.Now())
+		changed = true
+	}
+	return changed, true
+}
@@ -0,0 +1,141 @@
+// Copyright (c) 2022 Zededa, Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package zedmanager
+
+import (
+	"testing"
+
+	"github.com/lf-edge/eve/pkg/pillar/base"
+	"github.com/lf-edge/eve/pkg/pillar/pubsub"
+	"github.com/lf-edge/eve/pkg/pillar/types"
+	uuid "github.com/satori/go.uuid"
+	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+)
+
+// testAppConfigs is a subscription to the app instance configs
+type testAppConfigs struct {
+	pubsub.Subscription
+	configs map[string]interface{}
+}
+
+func (sub testAppConfigs) Get(key string) (interface{}, error) {
+	return sub.configs[key], nil
+}
+
+func (sub testAppConfigs) GetAll() map[string]interface{} {
+	return sub.configs
+}
+
+func TestDependencyCycle(t *testing.T) {
+	logger = logrus.StandardLogger()
+	log = base.NewSourceLogObject(logger, agentName, 0)
+
+	var apps []types.AppInstanceConfig
+	for _, name := range []string{"app0", "app1", "app2", "app3"} {
+		apps = append(apps, types.AppInstanceConfig{
+			UUIDandVersion: types.UUIDandVersion{UUID: uuid.Must(uuid.NewV4())},
+			DisplayName:    name,
+		})
+	}
+	// startAfter returns the app instances where app instance i starts
+	// after the next one in deps
+	startAfter := func(deps ...int) []types.AppInstanceConfig {
+		configs := make([]types.AppInstanceConfig, len(apps))
+		copy(configs, apps)
+		for i := 0; i+1 < len(deps); i++ {
+			configs[deps[i]].StartAfter = append(configs[deps[i]].StartAfter,
+				types.AppDependency{AppUUID: apps[deps[i+1]].UUIDandVersion.UUID})
+		}
+		return configs
+	}
+
+	testMatrix := map[string]struct {
+		configs []types.AppInstanceConfig
+		cycle   []string
+	}{
+		"No dependencies": {
+			configs: startAfter(),
+		},
+		"Self": {
+			configs: startAfter(0, 0),
+			cycle:   []string{"app0"},
+		},
+		"Two app instances": {
+			configs: startAfter(0, 1, 0),
+			cycle:   []string{"app0", "app1"},
+		},
+		"Three app instances": {
+			configs: startAfter(0, 1, 2, 0),
+			cycle:   []string{"app0", "app1", "app2"},
+		},
+		"Chain": {
+			configs: startAfter(0, 1, 2, 3),
+		},
+		"Chain into a cycle": {
+			configs: startAfter(0, 1, 2, 3, 1),
+		},
+	}
+
+	for testname, test := range testMatrix {
+		t.Logf("Running test case %s", testname)
+		sub := testAppConfigs{configs: make(map[string]interface{})}
+		for _, config := range test.configs {
+			sub.configs[config.Key()] = config
+		}
+		ctx := &zedmanagerContext{subAppInstanceConfig: sub}
+		config := test.configs[0]
+		assert.Equal(t, test.cycle, dependencyCycle(ctx, config), testname)
+
+		status := &types.AppInstanceStatus{}
+		changed, inCycle := checkDependencyCycle(ctx, config, status)
+		assert.Equal(t, test.cycle != nil, changed, testname)
+		assert.Equal(t, test.cycle != nil, inCycle, testname)
+		assert.Equal(t, test.cycle != nil,
+			status.IsErrorSource(types.AppDependency{}), testname)
+
+		// The error is cleared once the cycle is gone
+		config.StartAfter = nil
+		sub.configs[config.Key()] = config
+		changed, inCycle = checkDependencyCycle(ctx, config, status)
+		assert.Equal(t, test.cycle != nil, changed, testname)
+		assert.False(t, inCycle, testname)
+		assert.False(t, status.HasError(), testname)
+	}
+}
+
+func TestFindCycle(t *testing.T) {
+	a, b, c := uuid.Must(uuid.NewV4()), uuid.Must(uuid.NewV4()), uuid.Must(uuid.NewV4())
+	testMatrix := map[string]struct {
+		deps  map[uuid.UUID][]uuid.UUID
+		cycle []uuid.UUID
+	}{
+		"Self": {
+			deps:  map[uuid.UUID][]uuid.UUID{a: {a}},
+			cycle: []uuid.UUID{a},
+		},
+		"Two": {
+			deps:  map[uuid.UUID][]uuid.UUID{a: {b}, b: {a}},
+			cycle: []uuid.UUID{a, b},
+		},
+		"Three": {
+			deps:  map[uuid.UUID][]uuid.UUID{a: {b}, b: {c}, c: {a}},
+			cycle: []uuid.UUID{a, b, c},
+		},
+		"Acyclic chain": {
+			deps: map[uuid.UUID][]uuid.UUID{a: {b}, b: {c}},
+		},
+		"Diamond": {
+			deps: map[uuid.UUID][]uuid.UUID{a: {b, c}, b: {c}},
+		},
+	}
+
+	for testname, test := range testMatrix {
+		t.Logf("Running test case %s", testname)
+		deps := func(appUUID uuid.UUID) []uuid.UUID {
+			return test.deps[appUUID]
+		}
+		assert.Equal(t, test.cycle, findCycle(a, deps), testname)
+	}
+}
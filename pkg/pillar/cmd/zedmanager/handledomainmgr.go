@@ -8,7 +8,9 @@ import (
 	"fmt"
 	"runtime"
 
+	zconfig "github.com/lf-edge/eve/api/go/config"
 	"github.com/lf-edge/eve/pkg/pillar/types"
+	uuid "github.com/satori/go.uuid"
 )
 
 // MaybeAddDomainConfig makes sure we have a DomainConfig
@@ -47,9 +49,19 @@ func MaybeAddDomainConfig(ctx *zedmanagerContext,
 		CipherBlockStatus: aiConfig.CipherBlockStatus,
 		GPUConfig:         "legacy",
 		MetaDataType:      aiConfig.MetaDataType,
+		RestartPolicy:     aiConfig.RestartPolicy,
+		SecureBootKeys:    aiConfig.SecureBootKeys,
+		HealthCheck:       aiConfig.HealthCheck,
+		ConsoleSessions:   aiConfig.ConsoleSessions,
 	}
 
 	dc.DiskConfigList = make([]types.DiskConfig, 0, len(aiStatus.VolumeRefStatusList))
+	// The images of the pod containers are not mounted in the main one
+	podImages := make(map[uuid.UUID]bool)
+	for _, pc := range aiConfig.PodContainers {
+		podImages[pc.VolumeID] = true
+	}
+	disks := make(map[uuid.UUID]types.DiskConfig)
 	for _, vrc := range aiConfig.VolumeRefConfigList {
 		vrs := getVolumeRefStatusFromAIStatus(&aiStatus, vrc)
 		if vrs == nil {
@@ -72,8 +84,48 @@ func MaybeAddDomainConfig(ctx *zedmanagerContext,
 		disk.MountDir = vrs.MountDir
 		disk.DisplayName = vrs.DisplayName
 		disk.WWN = vrs.WWN
+		disk.MaxVolSize = vrs.MaxVolSize
+		disk.Shared = vrs.Shared
+		disk.IOLimits = vrs.IOLimits
+		if vrs.Shared {
+			// VolumeRefStatus has the mount point of the first app
+			disk.MountDir = vrc.MountDir
+		}
+		disks[vrc.VolumeID] = disk
+		if podImages[vrc.VolumeID] {
+			continue
+		}
 		dc.DiskConfigList = append(dc.DiskConfigList, disk)
 	}
+	for _, pc := range aiConfig.PodContainers {
+		image, ok := disks[pc.VolumeID]
+		if !ok {
+			// Like a missing VolumeRefStatus
+			log.Errorf("Missing volume %s of pod container %s",
+				pc.VolumeID, pc.Name)
+			continue
+		}
+		if image.Format != zconfig.Format_CONTAINER {
+			errStr := fmt.Sprintf("Volume %s of pod container %s is not a container image",
+				image.DisplayName, pc.Name)
+			log.Error(errStr)
+			return nil, errors.New(errStr)
+		}
+		pc.Image = image
+		pc.Disks = nil
+		for _, mount := range pc.Mounts {
+			disk, ok := disks[mount.VolumeID]
+			if !ok {
+				log.Errorf("Missing volume %s of pod container %s",
+					mount.VolumeID, pc.Name)
+				continue
+			}
+			disk.MountDir = mount.MountDir
+			disk.ReadOnly = disk.ReadOnly || mount.ReadOnly
+			pc.Disks = append(pc.Disks, disk)
+		}
+		dc.PodContainers = append(dc.PodContainers, pc)
+	}
 	// let's fill some of the default values (arguably we may want controller
 	// to do this for us and give us complete config, but it is easier to
 	// fudge DomainConfig for now on our side)
@@ -102,12 +154,13 @@ func MaybeAddDomainConfig(ctx *zedmanagerContext,
 			if runtime.GOARCH == "amd64" {
 				dc.BootLoader = "/usr/lib/xen/boot/seabios.bin"
 			} else {
-				dc.BootLoader = "/usr/lib/xen/boot/ovmf.bin"
+				dc.BootLoader = types.OVMFBootLoader
 			}
 		}
 	}
-	if dc.BootLoader == "" && (dc.VirtualizationModeOrDefault() == types.FML || runtime.GOARCH == "arm64") {
-		dc.BootLoader = "/usr/lib/xen/boot/ovmf.bin"
+	if dc.BootLoader == "" && (dc.VirtualizationModeOrDefault() == types.FML ||
+		runtime.GOARCH == "arm64" || dc.SecureBoot) {
+		dc.BootLoader = types.OVMFBootLoader
 	}
 	if ns != nil {
 		ulNum := len(ns.UnderlayNetworkList)
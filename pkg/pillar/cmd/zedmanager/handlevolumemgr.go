@@ -221,3 +221,32 @@ func getVolumeRefConfigFromAIConfig(config *types.AppInstanceConfig,
 	log.Tracef("getVolumeRefConfigFromAIConfig(%v) Done", vrs.Key())
 	return nil
 }
+
+// lookupSharedVolumeOwnerNotRunning returns the config of an activated app
+// instance which owns a shared volume of the app instance and does not
+// run yet
+func lookupSharedVolumeOwnerNotRunning(ctx *zedmanagerContext,
+	config types.AppInstanceConfig, status *types.AppInstanceStatus) *types.AppInstanceConfig {
+
+	for _, vrs := range status.VolumeRefStatusList {
+		if !vrs.Shared {
+			continue
+		}
+		for _, c := range ctx.subAppInstanceConfig.GetAll() {
+			owner := c.(types.AppInstanceConfig)
+			if owner.UUIDandVersion.UUID == config.UUIDandVersion.UUID || !owner.Activate {
+				continue
+			}
+			for _, vrc := range owner.VolumeRefConfigList {
+				if !vrc.SharedOwner || vrc.Key() != vrs.Key() {
+					continue
+				}
+				ownerStatus := lookupAppInstanceStatus(ctx, owner.Key())
+				if ownerStatus == nil || ownerStatus.State != types.RUNNING {
+					return &owner
+				}
+			}
+		}
+	}
+	return nil
+}
@@ -28,7 +28,7 @@ func getRemainingMemory(ctxPtr *zedmanagerContext) (uint64, uint64, uint64, erro
 	itemsAppInstanceStatus := pubAppInstanceStatus.GetAll()
 	for _, st := range itemsAppInstanceStatus {
 		status := st.(types.AppInstanceStatus)
-		mem := uint64(status.FixedResources.Memory) << 10
+		mem := appMemory(status.FixedResources)
 		if status.Activated || status.ActivateInprogress {
 			usedMemorySize += mem
 			accountedApps = append(accountedApps, status.Key())
@@ -64,6 +64,16 @@ func getRemainingMemory(ctxPtr *zedmanagerContext) (uint64, uint64, uint64, erro
 	}
 }
 
+// appMemory returns how many bytes of memory an app instance may use.
+// A ballooned app instance boots with its maximum memory, and the balloon
+// never reclaims memory which the guest uses, hence the maximum counts.
+func appMemory(vm types.VmConfig) uint64 {
+	if vm.Ballooned() {
+		return uint64(vm.MaxMem) << 10
+	}
+	return uint64(vm.Memory) << 10
+}
+
 func sysTotalMemory(ctx *zedmanagerContext) (uint64, error) {
 	sub := ctx.subHostMemory
 	m, err := sub.Get("global")
@@ -0,0 +1,45 @@
+// Copyright (c) 2022 Zededa, Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package zedmanager
+
+import (
+	"testing"
+
+	"github.com/lf-edge/eve/pkg/pillar/types"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAppMemory(t *testing.T) {
+	testMatrix := map[string]struct {
+		vm     types.VmConfig
+		memory uint64
+	}{
+		"No maximum": {
+			vm:     types.VmConfig{Memory: 1024},
+			memory: 1024 << 10,
+		},
+		"Ballooned": {
+			vm:     types.VmConfig{Memory: 1024, MaxMem: 4096},
+			memory: 4096 << 10,
+		},
+		"Maximum below guaranteed": {
+			vm:     types.VmConfig{Memory: 1024, MaxMem: 512},
+			memory: 1024 << 10,
+		},
+		"Realtime": {
+			vm:     types.VmConfig{Memory: 1024, MaxMem: 4096, Realtime: true},
+			memory: 1024 << 10,
+		},
+		"Container": {
+			vm: types.VmConfig{Memory: 1024, MaxMem: 4096,
+				VirtualizationMode: types.NOHYPER},
+			memory: 1024 << 10,
+		},
+	}
+
+	for testname, test := range testMatrix {
+		t.Logf("Running test case %s", testname)
+		assert.Equal(t, test.memory, appMemory(test.vm), testname)
+	}
+}
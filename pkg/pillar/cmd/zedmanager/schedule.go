@@ -0,0 +1,35 @@
+// Copyright (c) 2022 Zededa, Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package zedmanager
+
+import (
+	"time"
+
+	"github.com/lf-edge/eve/pkg/pillar/types"
+)
+
+// lookupDeviceSchedule returns the schedule from the controller; it is
+// empty before there is one
+func lookupDeviceSchedule(ctx *zedmanagerContext) types.DeviceSchedule {
+	s, _ := ctx.subDeviceSchedule.Get("global")
+	if s == nil {
+		return types.DeviceSchedule{}
+	}
+	return s.(types.DeviceSchedule)
+}
+
+// nextActivation returns zero if the app instance may switch to the new
+// version of its content now, otherwise when it may next
+func nextActivation(ctx *zedmanagerContext, config types.AppInstanceConfig) time.Time {
+	return lookupDeviceSchedule(ctx).NextActivation(config.Activation, time.Now())
+}
+
+// firstActivationDeferred returns true if a new app instance waits for
+// the time after which its content may be activated. The maintenance
+// windows only apply to switching versions, hence an app instance which
+// is deployed starts at once afterwards and after a reboot.
+func firstActivationDeferred(config types.AppInstanceConfig, status types.AppInstanceStatus) bool {
+	return !status.Activated && status.PurgeInprogress == types.NotInprogress &&
+		time.Now().Before(config.Activation.ActivateAfter)
+}
@@ -5,6 +5,7 @@ package zedmanager
 
 import (
 	"fmt"
+	"os"
 	"time"
 
 	"github.com/google/go-cmp/cmp"
@@ -73,6 +74,8 @@ func removeAIStatus(ctx *zedmanagerContext, status *types.AppInstanceStatus) {
 
 	if uninstall {
 		log.Functionf("removeAIStatus(%s) remove done", uuidStr)
+		deleteVtpmState(status.UUIDandVersion.UUID)
+		deleteUefiNvram(status.UUIDandVersion.UUID)
 		// Write out what we modified to AppInstanceStatus aka delete
 		unpublishAppInstanceStatus(ctx, status)
 		return
@@ -83,6 +86,9 @@ func removeAIStatus(ctx *zedmanagerContext, status *types.AppInstanceStatus) {
 	publishAppInstanceStatus(ctx, status)
 	config := lookupAppInstanceConfig(ctx, uuidStr)
 	if config != nil {
+		if config.FixedResources.VtpmResetOnPurge {
+			deleteVtpmState(status.UUIDandVersion.UUID)
+		}
 		changed := purgeCmdDone(ctx, *config, status)
 		if changed {
 			publishAppInstanceStatus(ctx, status)
@@ -97,6 +103,34 @@ func removeAIStatus(ctx *zedmanagerContext, status *types.AppInstanceStatus) {
 	}
 }
 
+// deleteVtpmState destroys the state of the software TPM of an app
+// instance once its domain is gone
+func deleteVtpmState(appUUID uuid.UUID) {
+	dir := types.VtpmStateDir(appUUID)
+	if _, err := os.Stat(dir); err != nil {
+		return
+	}
+	log.Noticef("deleteVtpmState(%s)", appUUID)
+	if err := os.RemoveAll(dir); err != nil {
+		log.Errorf("deleteVtpmState(%s): %v", appUUID, err)
+	}
+}
+
+// deleteUefiNvram destroys the UEFI variables of an app instance once its
+// domain is gone, and what they were made from
+func deleteUefiNvram(appUUID uuid.UUID) {
+	nvram := types.UefiNvramFile(appUUID)
+	for _, filename := range []string{nvram + ".keys", nvram} {
+		if _, err := os.Stat(filename); err != nil {
+			continue
+		}
+		log.Noticef("deleteUefiNvram(%s) %s", appUUID, filename)
+		if err := os.Remove(filename); err != nil {
+			log.Errorf("deleteUefiNvram(%s): %v", appUUID, err)
+		}
+	}
+}
+
 // doUpdate will set checkFreedResources in context if some resources
 // might have been freed up.
 func doUpdate(ctx *zedmanagerContext,
@@ -118,6 +152,17 @@ func doUpdate(ctx *zedmanagerContext,
 	if status.PurgeInprogress == types.DownloadAndVerify {
 		log.Functionf("PurgeInprogress(%s) volumemgr done",
 			status.Key())
+		// The new version is switched to when its schedule allows
+		next := nextActivation(ctx, config)
+		if !status.ActivationDeferredUntil.Equal(next) {
+			status.ActivationDeferredUntil = next
+			changed = true
+		}
+		if !next.IsZero() {
+			log.Functionf("PurgeInprogress(%s) activation deferred until %v",
+				status.Key(), next)
+			return changed
+		}
 		status.PurgeInprogress = types.BringDown
 		changed = true
 		// Keep the old volumes in place
@@ -293,6 +338,8 @@ func doInstall(ctx *zedmanagerContext,
 			if c {
 				changed = true
 			}
+		} else if updateVolumeRefOnline(ctx, vrs) {
+			changed = true
 		}
 	}
 	// Determine minimum state and errors across all of VolumeRefStatus
@@ -394,6 +441,23 @@ func doInstallVolumeRef(ctx *zedmanagerContext, config types.AppInstanceConfig,
 	return changed
 }
 
+// updateVolumeRefOnline picks up the changes of the volumes which apply
+// to running app instances
+func updateVolumeRefOnline(ctx *zedmanagerContext, vrs *types.VolumeRefStatus) bool {
+	pubsubVrs := lookupVolumeRefStatus(ctx, vrs.Key())
+	if pubsubVrs == nil {
+		return false
+	}
+	if vrs.MaxVolSize == pubsubVrs.MaxVolSize && vrs.IOLimits == pubsubVrs.IOLimits {
+		return false
+	}
+	log.Functionf("updateVolumeRefOnline(%s): size %d limits %+v",
+		vrs.Key(), pubsubVrs.MaxVolSize, pubsubVrs.IOLimits)
+	vrs.MaxVolSize = pubsubVrs.MaxVolSize
+	vrs.IOLimits = pubsubVrs.IOLimits
+	return true
+}
+
 func doPrepare(ctx *zedmanagerContext,
 	config types.AppInstanceConfig, status *types.AppInstanceStatus) (bool, bool) {
 
@@ -473,7 +537,7 @@ func doActivate(ctx *zedmanagerContext, uuidStr string,
 			changed = true
 			return changed
 		}
-		need := uint64(config.FixedResources.Memory) << 10
+		need := appMemory(config.FixedResources)
 		if remaining < need {
 			var errStr string
 			var entities []*types.ErrorEntity
@@ -515,11 +579,11 @@ func doActivate(ctx *zedmanagerContext, uuidStr string,
 		}
 		if remaining < latent+need {
 			log.Warnf("Deploying %s memory %d kB remaining %d kB but latent memory use %d kB",
-				config.DisplayName, config.FixedResources.Memory,
+				config.DisplayName, need>>10,
 				remaining>>10, latent>>10)
 		} else {
 			log.Functionf("Deploying %s memory %d kB remaining %d kB latent %d kB",
-				config.DisplayName, config.FixedResources.Memory,
+				config.DisplayName, need>>10,
 				remaining>>10, latent>>10)
 		}
 	}
@@ -581,6 +645,61 @@ func doActivate(ctx *zedmanagerContext, uuidStr string,
 		// if the VM already active or in restarting/purging state - continue with the doActivate logic
 	}
 
+	// A new app instance is not started before its content may be activated
+	if firstActivationDeferred(config, *status) {
+		log.Functionf("doActivate(%s): activation deferred until %v",
+			uuidStr, config.Activation.ActivateAfter)
+		if status.State != types.START_DELAYED ||
+			!status.ActivationDeferredUntil.Equal(config.Activation.ActivateAfter) {
+			status.State = types.START_DELAYED
+			status.ActivationDeferredUntil = config.Activation.ActivateAfter
+			return true
+		}
+		return changed
+	}
+	if !status.ActivationDeferredUntil.IsZero() {
+		status.ActivationDeferredUntil = time.Time{}
+		changed = true
+	}
+
+	// The owners of shared volumes are started before the other app instances
+	if !status.Activated || status.RestartInprogress == types.BringUp || status.PurgeInprogress == types.BringUp {
+		if owner := lookupSharedVolumeOwnerNotRunning(ctx, config, status); owner != nil {
+			log.Functionf("doActivate(%s): waiting for %s to run first",
+				uuidStr, owner.DisplayName)
+			if status.State != types.START_DELAYED {
+				status.State = types.START_DELAYED
+				return true
+			}
+			return changed
+		}
+		// The app instances in StartAfter are started and ready first
+		if c, inCycle := checkDependencyCycle(ctx, config, status); inCycle {
+			if status.State != types.START_DELAYED {
+				status.State = types.START_DELAYED
+				changed = true
+			}
+			return changed || c
+		} else if c {
+			changed = true
+		}
+		if dep := lookupDependencyNotReady(ctx, config); dep != nil {
+			log.Functionf("doActivate(%s): waiting for %s to be ready first",
+				uuidStr, dep.DisplayName)
+			if status.State != types.START_DELAYED ||
+				status.WaitingForApp != dep.DisplayName {
+				status.State = types.START_DELAYED
+				status.WaitingForApp = dep.DisplayName
+				return true
+			}
+			return changed
+		}
+	}
+	if status.WaitingForApp != "" {
+		status.WaitingForApp = ""
+		changed = true
+	}
+
 	// Make sure we have a DomainConfig
 	// We modify it below and then publish it
 	dc, err := MaybeAddDomainConfig(ctx, config, *status, ns)
@@ -615,6 +734,26 @@ func doActivate(ctx *zedmanagerContext, uuidStr string,
 		status.BootTime = ds.BootTime
 		changed = true
 	}
+	if status.RestartCount != ds.RestartCount ||
+		status.LastExitReason != ds.LastExitReason ||
+		!status.LastExitTime.Equal(ds.LastExitTime) {
+		status.RestartCount = ds.RestartCount
+		status.LastExitReason = ds.LastExitReason
+		status.LastExitTime = ds.LastExitTime
+		changed = true
+	}
+	if !cmp.Equal(status.PodContainerStatusList, ds.PodContainerStatusList) {
+		status.PodContainerStatusList = ds.PodContainerStatusList
+		changed = true
+	}
+	if !cmp.Equal(status.Health, ds.Health) {
+		status.Health = ds.Health
+		changed = true
+	}
+	if !cmp.Equal(status.GuestInfo, ds.GuestInfo) {
+		status.GuestInfo = ds.GuestInfo
+		changed = true
+	}
 	c := updateVifUsed(status, *ds)
 	if c {
 		changed = true
@@ -985,6 +1124,21 @@ func doInactivateHalt(ctx *zedmanagerContext,
 	}
 	log.Tracef("Done with AppNetworkStatus for %s", uuidStr)
 
+	// The app instances which start after this one are halted first
+	if dependent := lookupDependentNotHalted(ctx, config); dependent != nil {
+		log.Functionf("doInactivateHalt(%s): waiting for %s to halt first",
+			uuidStr, dependent.DisplayName)
+		if status.WaitingForApp != dependent.DisplayName {
+			status.WaitingForApp = dependent.DisplayName
+			changed = true
+		}
+		return changed
+	}
+	if status.WaitingForApp != "" {
+		status.WaitingForApp = ""
+		changed = true
+	}
+
 	// Make sure we have a DomainConfig. Clears dc.Activate based
 	// on the AppInstanceConfig's Activate
 	dc, err := MaybeAddDomainConfig(ctx, config, *status, ns)
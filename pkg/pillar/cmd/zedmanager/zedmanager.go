@@ -49,6 +49,7 @@ type zedmanagerContext struct {
 	subGlobalConfig       pubsub.Subscription
 	subHostMemory         pubsub.Subscription
 	subZedAgentStatus     pubsub.Subscription
+	subDeviceSchedule     pubsub.Subscription
 	globalConfig          *types.ConfigItemValueMap
 	pubUuidToNum          pubsub.Publication
 	GCInitialized         bool
@@ -176,6 +177,23 @@ func Run(ps *pubsub.PubSub, loggerArg *logrus.Logger, logArg *base.LogObject) in
 	ctx.subGlobalConfig = subGlobalConfig
 	subGlobalConfig.Activate()
 
+	// Look for the maintenance windows from zedagent
+	subDeviceSchedule, err := ps.NewSubscription(pubsub.SubscriptionOptions{
+		AgentName:   "zedagent",
+		MyAgentName: agentName,
+		TopicImpl:   types.DeviceSchedule{},
+		Persistent:  true,
+		Activate:    false,
+		Ctx:         &ctx,
+		WarningTime: warningTime,
+		ErrorTime:   errorTime,
+	})
+	if err != nil {
+		log.Fatal(err)
+	}
+	ctx.subDeviceSchedule = subDeviceSchedule
+	subDeviceSchedule.Activate()
+
 	// Get AppInstanceConfig from zedagent
 	subAppInstanceConfig, err := ps.NewSubscription(pubsub.SubscriptionOptions{
 		AgentName:      "zedagent",
@@ -331,6 +349,9 @@ func Run(ps *pubsub.PubSub, loggerArg *logrus.Logger, logArg *base.LogObject) in
 		case change := <-subGlobalConfig.MsgChan():
 			subGlobalConfig.ProcessChange(change)
 
+		case change := <-subDeviceSchedule.MsgChan():
+			subDeviceSchedule.ProcessChange(change)
+
 		case change := <-subVolumeRefStatus.MsgChan():
 			subVolumeRefStatus.ProcessChange(change)
 
@@ -406,11 +427,32 @@ func checkDelayedStartApps(ctx *zedmanagerContext) {
 		config := c.(types.AppInstanceConfig)
 		status := lookupAppInstanceStatus(ctx, config.Key())
 		// Is the application in the delayed state and ready to be started?
-		if status != nil && status.State == types.START_DELAYED && status.StartTime.Before(time.Now()) {
+		if status != nil && status.State == types.START_DELAYED && status.StartTime.Before(time.Now()) &&
+			lookupSharedVolumeOwnerNotRunning(ctx, config, status) == nil &&
+			lookupDependencyNotReady(ctx, config) == nil &&
+			!firstActivationDeferred(config, *status) {
 			// Change the state immediately, so we do not enter here twice
 			status.State = types.INSTALLED
 			doUpdate(ctx, config, status)
 			publishAppInstanceStatus(ctx, status)
+		} else if status != nil && status.PurgeInprogress == types.DownloadAndVerify &&
+			!status.ActivationDeferredUntil.IsZero() &&
+			nextActivation(ctx, config).IsZero() {
+			// The new version may be activated now
+			doUpdate(ctx, config, status)
+			publishAppInstanceStatus(ctx, status)
+		} else if status != nil && status.State == types.START_DELAYED &&
+			status.IsErrorSource(types.AppDependency{}) {
+			// The cycle of dependencies may be gone
+			if changed, _ := checkDependencyCycle(ctx, config, status); changed {
+				publishAppInstanceStatus(ctx, status)
+			}
+		} else if status != nil && !status.EffectiveActivate &&
+			status.WaitingForApp != "" &&
+			lookupDependentNotHalted(ctx, config) == nil {
+			// The app instances which start after this one have halted
+			doUpdate(ctx, config, status)
+			publishAppInstanceStatus(ctx, status)
 		}
 	}
 }
@@ -648,6 +690,27 @@ func handleCreate(ctxArg interface{}, key string,
 		errStr := "Invalid Cpu count - 0\n"
 		allErrors += errStr
 	}
+	if config.FixedResources.Vtpm != types.VtpmNone &&
+		config.FixedResources.VirtualizationMode == types.NOHYPER {
+		errStr := "vTPM is not supported for containers\n"
+		allErrors += errStr
+	}
+	if config.FixedResources.SecureBoot &&
+		config.FixedResources.VirtualizationMode == types.NOHYPER {
+		errStr := "Secure Boot is not supported for containers\n"
+		allErrors += errStr
+	}
+	if len(config.PodContainers) != 0 &&
+		config.FixedResources.VirtualizationMode != types.NOHYPER {
+		errStr := "Pod containers are only supported for containers\n"
+		allErrors += errStr
+	}
+	if config.HealthCheck.Type != types.HealthCheckImage &&
+		config.HealthCheck.Type != types.HealthCheckNone &&
+		config.FixedResources.VirtualizationMode != types.NOHYPER {
+		errStr := "Health checks are only supported for containers\n"
+		allErrors += errStr
+	}
 
 	// if some error, return
 	if allErrors != "" {
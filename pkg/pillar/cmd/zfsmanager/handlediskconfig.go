@@ -10,6 +10,7 @@ import (
 	"time"
 
 	libzfs "github.com/bicomsystems/go-libzfs"
+	"github.com/lf-edge/eve/pkg/pillar/hardware"
 	"github.com/lf-edge/eve/pkg/pillar/types"
 	"github.com/lf-edge/eve/pkg/pillar/utils/disks"
 	"github.com/lf-edge/eve/pkg/pillar/vault"
@@ -23,6 +24,13 @@ func getVDevState(devTree libzfs.VDevTree, vDevName string) *libzfs.VDevState {
 	if vDevName == "" {
 		return nil
 	}
+	if devTree.Type == libzfs.VDevTypeFile {
+		// file-backed vdev
+		if devTree.Path == vDevName || devTree.Name == vDevName {
+			return &devTree.Stat.State
+		}
+		return nil
+	}
 	if devTree.Type != libzfs.VDevTypeDisk {
 		for _, d := range devTree.Devices {
 			retState := getVDevState(d, vDevName)
@@ -44,6 +52,85 @@ func getVDevState(devTree libzfs.VDevTree, vDevName string) *libzfs.VDevState {
 	return nil
 }
 
+// getSpareState returns state of hot spare vDev from zfs if found
+func getSpareState(devTree libzfs.VDevTree, vDevName string) *libzfs.VDevState {
+	for _, d := range devTree.Spares {
+		if retState := getVDevState(d, vDevName); retState != nil {
+			return retState
+		}
+	}
+	return nil
+}
+
+// findVDevBySerial returns name of the disk vDev in the tree with provided serial number
+// serialOf is used to obtain serial number of the disk
+func findVDevBySerial(devTree libzfs.VDevTree, serial string,
+	serialOf func(diskName string) (string, error)) string {
+	if devTree.Type == libzfs.VDevTypeDisk {
+		diskSerial, err := serialOf(devTree.Name)
+		if err == nil && diskSerial == serial {
+			return devTree.Name
+		}
+		return ""
+	}
+	for _, d := range devTree.Devices {
+		if name := findVDevBySerial(d, serial, serialOf); name != "" {
+			return name
+		}
+	}
+	for _, d := range devTree.Spares {
+		if name := findVDevBySerial(d, serial, serialOf); name != "" {
+			return name
+		}
+	}
+	return ""
+}
+
+// resolveDisksBySerial fills names of disks defined by serial numbers
+// disks to be replaced are looked up in the pool, the others in the system
+// if not found, the name from config is used
+func resolveDisksBySerial(disks types.EdgeNodeDisks, vdevTree libzfs.VDevTree) types.EdgeNodeDisks {
+	resolved := types.EdgeNodeDisks{ArrayType: disks.ArrayType}
+	for _, diskCfg := range disks.Disks {
+		if diskCfg.Disk.Serial != "" {
+			if name, err := hardware.GetDiskNameBySerial(diskCfg.Disk.Serial); err == nil {
+				diskCfg.Disk.Name = name
+			} else {
+				log.Warnf("cannot find disk with serial %s, using name %s: %s",
+					diskCfg.Disk.Serial, diskCfg.Disk.Name, err)
+			}
+		}
+		if diskCfg.OldDisk != nil && diskCfg.OldDisk.Serial != "" {
+			oldDisk := *diskCfg.OldDisk
+			if name := findVDevBySerial(vdevTree, oldDisk.Serial,
+				hardware.GetSerialNumberForDisk); name != "" {
+				oldDisk.Name = name
+			} else {
+				// failed disk may not respond, rely on the name
+				log.Functionf("cannot find disk with serial %s in pool, using name %s",
+					oldDisk.Serial, oldDisk.Name)
+			}
+			diskCfg.OldDisk = &oldDisk
+		}
+		resolved.Disks = append(resolved.Disks, diskCfg)
+	}
+	for _, el := range disks.Children {
+		resolved.Children = append(resolved.Children, resolveDisksBySerial(el, vdevTree))
+	}
+	return resolved
+}
+
+// inPoolLayout returns true if the disk should be part of data vdevs of the pool
+func inPoolLayout(diskCfg types.EdgeNodeDiskConfig) bool {
+	switch diskCfg.Config {
+	case types.EdgeNodeDiskConfigTypeZfsSpare,
+		types.EdgeNodeDiskConfigTypeUnused,
+		types.EdgeNodeDiskConfigTypeAppDirect:
+		return false
+	}
+	return true
+}
+
 func handleDisksConfigCreate(ctxArg interface{}, _ string, _ interface{}) {
 	handleDisksConfigImpl(ctxArg.(*zfsContext))
 }
@@ -79,6 +166,9 @@ func processDisksTask(ctx *zfsContext) {
 			if err := processDisks(ctx); err != nil {
 				log.Errorf("processDisks error: %s", err)
 			}
+			if err := processScrub(ctx); err != nil {
+				log.Errorf("processScrub error: %s", err)
+			}
 		case <-ctx.disksProcessingTrigger:
 			if err := processDisks(ctx); err != nil {
 				log.Errorf("processDisks error: %s", err)
@@ -103,6 +193,12 @@ func processDisks(ctx *zfsContext) error {
 	if err != nil {
 		return fmt.Errorf("cannot open pool: %s", err)
 	}
+	vdevTree, err := persistPool.VDevTree()
+	if err != nil {
+		persistPool.Close()
+		return fmt.Errorf("cannot get vdev tree: %s", err)
+	}
+	disksConfig = resolveDisksBySerial(disksConfig, vdevTree)
 	disksStateProcessing(disksConfig, persistPool)
 	persistPool.Close()
 	// re-read pool to grab changes after disksStateProcessing
@@ -124,9 +220,15 @@ func disksStateProcessing(disks types.EdgeNodeDisks, pool libzfs.Pool) {
 		return
 	}
 	for _, diskCfg := range disks.Disks {
+		if diskCfg.Config == types.EdgeNodeDiskConfigTypeZfsSpare {
+			spareStateProcessing(vdevTree, diskCfg)
+			continue
+		}
 		if diskCfg.OldDisk != nil {
 			oldDevState := getVDevState(vdevTree, diskCfg.OldDisk.Name)
-			if oldDevState != nil && diskCfg.Disk.Name != "" {
+			// skip if already replaced or replacing is in progress
+			if oldDevState != nil && diskCfg.Disk.Name != "" &&
+				getVDevState(vdevTree, diskCfg.Disk.Name) == nil {
 				// if we found device oldDevName, replace it
 				log.Functionf("replacing %s with %s, old stat %s", diskCfg.OldDisk.Name, diskCfg.Disk.Name, oldDevState.String())
 				if stdout, err := zfs.ReplaceVDev(log, vault.DefaultZpool, diskCfg.OldDisk.Name, diskCfg.Disk.Name); err != nil {
@@ -136,6 +238,14 @@ func disksStateProcessing(disks types.EdgeNodeDisks, pool libzfs.Pool) {
 			}
 		}
 		devState := getVDevState(vdevTree, diskCfg.Disk.Name)
+		if devState == nil && diskCfg.Config == types.EdgeNodeDiskConfigTypeUnused &&
+			getSpareState(vdevTree, diskCfg.Disk.Name) != nil {
+			// hot spare is not used anymore
+			if stdout, err := zfs.RemoveVDev(log, vault.DefaultZpool, diskCfg.Disk.Name); err != nil {
+				log.Errorf("cannot remove spare %s: %s %s", diskCfg.Disk.Name, stdout, err)
+			}
+			continue
+		}
 		// found in pool
 		if devState != nil {
 			log.Functionf("zpool config disk %s, op %d, stat %s", diskCfg.Disk.Name, diskCfg.Config, devState.String())
@@ -178,6 +288,23 @@ func disksStateProcessing(disks types.EdgeNodeDisks, pool libzfs.Pool) {
 	}
 }
 
+//spareStateProcessing adds disk as a hot spare of the pool if not added yet
+func spareStateProcessing(vdevTree libzfs.VDevTree, diskCfg types.EdgeNodeDiskConfig) {
+	if getSpareState(vdevTree, diskCfg.Disk.Name) != nil {
+		return
+	}
+	if getVDevState(vdevTree, diskCfg.Disk.Name) != nil {
+		// spare in use is part of the vdev it replaces,
+		// otherwise the disk needs to be removed first
+		log.Functionf("disk %s is already in pool, cannot add it as a spare",
+			diskCfg.Disk.Name)
+		return
+	}
+	if stdout, err := zfs.AddSpareVDev(log, vault.DefaultZpool, diskCfg.Disk.Name); err != nil {
+		log.Errorf("cannot add spare %s: %s %s", diskCfg.Disk.Name, stdout, err)
+	}
+}
+
 //disksLayoutProcessing iterate over disks and adjust pool layout accordingly to the config
 func disksLayoutProcessing(disks types.EdgeNodeDisks, pool libzfs.Pool) {
 	vdevTree, err := pool.VDevTree()
@@ -188,6 +315,10 @@ func disksLayoutProcessing(disks types.EdgeNodeDisks, pool libzfs.Pool) {
 	switch disks.ArrayType {
 	case types.EdgeNodeDiskArrayTypeRAID0:
 		disksLayoutRaid0Process(vdevTree, disks.Children)
+	case types.EdgeNodeDiskArrayTypeRAID1, types.EdgeNodeDiskArrayTypeRAID5,
+		types.EdgeNodeDiskArrayTypeRAID6:
+		// pool with the only one top-level vdev
+		disksLayoutRaid0Process(vdevTree, []types.EdgeNodeDisks{disks})
 	case types.EdgeNodeDiskArrayTypeUnspecified:
 	default:
 		// TBD: other array types
@@ -196,10 +327,22 @@ func disksLayoutProcessing(disks types.EdgeNodeDisks, pool libzfs.Pool) {
 }
 
 //disksLayoutRaid0Process ensure layout for batch of top-level vdevs in pool
-//we support raid0 of unspecified (single disk) and raid1 (mirror of disks) layout here
+//we support raid0 of unspecified (single disk), raid1 (mirror of disks)
+//and raid5/raid6 (raidz1/raidz2 of disks) layout here
 func disksLayoutRaid0Process(vdevTree libzfs.VDevTree, disks []types.EdgeNodeDisks) {
 	for _, el := range disks {
+		var layoutDisks []types.EdgeNodeDiskConfig
+		for _, dsk := range el.Disks {
+			if inPoolLayout(dsk) {
+				layoutDisks = append(layoutDisks, dsk)
+			}
+		}
+		el.Disks = layoutDisks
 		switch el.ArrayType {
+		case types.EdgeNodeDiskArrayTypeRAID5:
+			disksLayoutRaidzProcess(vdevTree, el.Disks, 1)
+		case types.EdgeNodeDiskArrayTypeRAID6:
+			disksLayoutRaidzProcess(vdevTree, el.Disks, 2)
 		case types.EdgeNodeDiskArrayTypeUnspecified, types.EdgeNodeDiskArrayTypeRAID1:
 			diskName := ""
 			// check if we have one of defined devices as part of vdev
@@ -241,3 +384,32 @@ func disksLayoutRaid0Process(vdevTree libzfs.VDevTree, disks []types.EdgeNodeDis
 		}
 	}
 }
+
+//disksLayoutRaidzProcess ensure raidz top-level vdev with provided parity built from disks
+//raidz vdev cannot be extended with new disks, so we only create it if none of disks is in pool,
+//failed disks should be replaced using OldDisk
+func disksLayoutRaidzProcess(vdevTree libzfs.VDevTree, disks []types.EdgeNodeDiskConfig, parity int) {
+	var inPool, missing []string
+	for _, dsk := range disks {
+		if getVDevState(vdevTree, dsk.Disk.Name) != nil {
+			inPool = append(inPool, dsk.Disk.Name)
+		} else {
+			missing = append(missing, dsk.Disk.Name)
+		}
+	}
+	if len(missing) == 0 {
+		return
+	}
+	if len(inPool) > 0 {
+		log.Warnf("cannot add %v to existing raidz%d vdev with %v", missing, parity, inPool)
+		return
+	}
+	if len(missing) <= parity {
+		log.Errorf("cannot create raidz%d vdev from %d disks: need at least %d",
+			parity, len(missing), parity+1)
+		return
+	}
+	if stdout, err := zfs.AddRaidzVDev(log, vault.DefaultZpool, parity, missing); err != nil {
+		log.Errorf("cannot add raidz%d of %v: %s %s", parity, missing, stdout, err)
+	}
+}
@@ -0,0 +1,82 @@
+// Copyright (c) 2022 Zededa, Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package zfsmanager
+
+import (
+	"fmt"
+	"testing"
+
+	libzfs "github.com/bicomsystems/go-libzfs"
+	"github.com/lf-edge/eve/pkg/pillar/types"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFileVDevs(t *testing.T) {
+	vdev := func(path string) libzfs.VDevTree {
+		return libzfs.VDevTree{Type: libzfs.VDevTypeFile, Name: path, Path: path}
+	}
+	tree := libzfs.VDevTree{
+		Type: libzfs.VDevTypeRoot,
+		Devices: []libzfs.VDevTree{
+			{
+				Type:    libzfs.VDevTypeRaidz,
+				Name:    "raidz2-0",
+				Devices: []libzfs.VDevTree{vdev("/tmp/d1"), vdev("/tmp/d2"), vdev("/tmp/d3")},
+			},
+		},
+		Spares: []libzfs.VDevTree{vdev("/tmp/spare1")},
+	}
+	assert.NotNil(t, getVDevState(tree, "/tmp/d2"))
+	assert.Nil(t, getVDevState(tree, "/tmp/d4"))
+	// spares are not part of data vdevs
+	assert.Nil(t, getVDevState(tree, "/tmp/spare1"))
+	assert.NotNil(t, getSpareState(tree, "/tmp/spare1"))
+	assert.Nil(t, getSpareState(tree, "/tmp/d1"))
+}
+
+func TestFindVDevBySerial(t *testing.T) {
+	disk := func(name string) libzfs.VDevTree {
+		return libzfs.VDevTree{Type: libzfs.VDevTypeDisk, Name: name}
+	}
+	serials := map[string]string{
+		"/dev/sda9": "S1",
+		"/dev/sdb":  "S2",
+		"/dev/sdd":  "S4",
+	}
+	serialOf := func(name string) (string, error) {
+		if serial, ok := serials[name]; ok {
+			return serial, nil
+		}
+		return "", fmt.Errorf("no serial for %s", name)
+	}
+	tree := libzfs.VDevTree{
+		Type: libzfs.VDevTypeRoot,
+		Devices: []libzfs.VDevTree{
+			{
+				Type:    libzfs.VDevTypeMirror,
+				Name:    "mirror-0",
+				Devices: []libzfs.VDevTree{disk("/dev/sda9"), disk("/dev/sdb"), disk("/dev/sdc")},
+			},
+		},
+		Spares: []libzfs.VDevTree{disk("/dev/sdd")},
+	}
+	assert.Equal(t, "/dev/sdb", findVDevBySerial(tree, "S2", serialOf))
+	assert.Equal(t, "/dev/sdd", findVDevBySerial(tree, "S4", serialOf))
+	// failed disk does not report serial
+	assert.Equal(t, "", findVDevBySerial(tree, "S3", serialOf))
+}
+
+func TestInPoolLayout(t *testing.T) {
+	for config, expected := range map[types.EdgeNodeDiskConfigType]bool{
+		types.EdgeNodeDiskConfigTypeUnspecified: true,
+		types.EdgeNodeDiskConfigTypeZfsOnline:   true,
+		types.EdgeNodeDiskConfigTypeZfsOffline:  true,
+		types.EdgeNodeDiskConfigTypeZfsSpare:    false,
+		types.EdgeNodeDiskConfigTypeUnused:      false,
+		types.EdgeNodeDiskConfigTypeAppDirect:   false,
+	} {
+		assert.Equal(t, expected, inPoolLayout(types.EdgeNodeDiskConfig{Config: config}),
+			"config %d", config)
+	}
+}
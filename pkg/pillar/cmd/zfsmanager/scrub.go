@@ -0,0 +1,71 @@
+// Copyright (c) 2022 Zededa, Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package zfsmanager
+
+import (
+	"fmt"
+	"time"
+
+	libzfs "github.com/bicomsystems/go-libzfs"
+	"github.com/lf-edge/eve/pkg/pillar/types"
+	"github.com/lf-edge/eve/pkg/pillar/vault"
+	"github.com/lf-edge/eve/pkg/pillar/zfs"
+)
+
+// scrubStartDelay is the time after start of zfsmanager when no scrub is started,
+// to not slow down the boot
+const scrubStartDelay = time.Hour
+
+// scrubDue returns true if the scrub of the pool should be started
+// we count the interval from the end of the last scrub or resilver as both read all the data
+func scrubDue(scan types.StorageScanStatus, interval time.Duration,
+	startedAt, now time.Time) bool {
+	if interval == 0 || scan.InProgress() {
+		return false
+	}
+	if now.Sub(startedAt) < scrubStartDelay {
+		return false
+	}
+	if scan.Type == types.StorageScanTypeUnspecified {
+		// never scanned
+		return true
+	}
+	return !scan.EndTime.IsZero() && now.Sub(scan.EndTime) >= interval
+}
+
+// getScrubInterval returns interval between scrubs from the global config
+func getScrubInterval(ctx *zfsContext) time.Duration {
+	gcp := types.DefaultConfigItemValueMap()
+	if item, err := ctx.subGlobalConfig.Get("global"); err == nil {
+		gc := item.(types.ConfigItemValueMap)
+		gcp = &gc
+	}
+	return time.Duration(gcp.GlobalValueInt(types.ZfsScrubInterval)) * time.Second
+}
+
+// processScrub starts scrub of the pool if it is due
+func processScrub(ctx *zfsContext) error {
+	if vault.ReadPersistType() != types.PersistZFS {
+		return nil
+	}
+	persistPool, err := libzfs.PoolOpen(vault.DefaultZpool)
+	if err != nil {
+		return fmt.Errorf("cannot open pool: %s", err)
+	}
+	defer persistPool.Close()
+	vdevTree, err := persistPool.VDevTree()
+	if err != nil {
+		return fmt.Errorf("cannot get vdev tree: %s", err)
+	}
+	scan := zfs.GetZfsScanStatus(vdevTree.ScanStat)
+	if !scrubDue(scan, getScrubInterval(ctx), ctx.startedAt, time.Now()) {
+		return nil
+	}
+	log.Noticef("starting scrub of %s, last scan ended at %s",
+		vault.DefaultZpool, scan.EndTime)
+	if stdout, err := zfs.ScrubPool(log, vault.DefaultZpool); err != nil {
+		return fmt.Errorf("cannot scrub %s: %s %s", vault.DefaultZpool, stdout, err)
+	}
+	return nil
+}
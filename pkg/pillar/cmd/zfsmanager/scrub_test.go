@@ -0,0 +1,46 @@
+// Copyright (c) 2022 Zededa, Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package zfsmanager
+
+import (
+	"testing"
+	"time"
+
+	"github.com/lf-edge/eve/pkg/pillar/types"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestScrubDue(t *testing.T) {
+	startedAt := time.Date(2022, time.May, 1, 10, 0, 0, 0, time.UTC)
+	interval := 30 * 24 * time.Hour
+	afterStart := startedAt.Add(2 * time.Hour)
+	lastScrub := types.StorageScanStatus{
+		Type:    types.StorageScanTypeScrub,
+		State:   types.StorageScanStateFinished,
+		EndTime: startedAt.Add(-interval),
+	}
+
+	// never scanned
+	assert.False(t, scrubDue(types.StorageScanStatus{}, interval, startedAt, startedAt))
+	assert.True(t, scrubDue(types.StorageScanStatus{}, interval, startedAt, afterStart))
+	// disabled
+	assert.False(t, scrubDue(types.StorageScanStatus{}, 0, startedAt, afterStart))
+
+	// not right after boot
+	assert.False(t, scrubDue(lastScrub, interval, startedAt, startedAt))
+	assert.True(t, scrubDue(lastScrub, interval, startedAt, afterStart))
+
+	// resilver reads all the data too
+	resilver := lastScrub
+	resilver.Type = types.StorageScanTypeResilver
+	resilver.EndTime = startedAt.Add(-time.Hour)
+	assert.False(t, scrubDue(resilver, interval, startedAt, afterStart))
+
+	// running
+	running := types.StorageScanStatus{
+		Type:  types.StorageScanTypeScrub,
+		State: types.StorageScanStateScanning,
+	}
+	assert.False(t, scrubDue(running, interval, startedAt, afterStart.Add(interval)))
+}
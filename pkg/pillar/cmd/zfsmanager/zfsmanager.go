@@ -10,6 +10,7 @@ import (
 	"time"
 
 	"github.com/fsnotify/fsnotify"
+	"github.com/lf-edge/eve/pkg/pillar/agentlog"
 	"github.com/lf-edge/eve/pkg/pillar/base"
 	"github.com/lf-edge/eve/pkg/pillar/pidfile"
 	"github.com/lf-edge/eve/pkg/pillar/pubsub"
@@ -47,7 +48,9 @@ type zfsContext struct {
 	zVolStatusPub          pubsub.Publication
 	storageStatusPub       pubsub.Publication
 	subDisksConfig         pubsub.Subscription
+	subGlobalConfig        pubsub.Subscription
 	disksProcessingTrigger chan interface{}
+	startedAt              time.Time
 }
 
 // Run - an zfs run
@@ -72,7 +75,31 @@ func Run(ps *pubsub.PubSub, loggerArg *logrus.Logger, logArg *base.LogObject) in
 	stillRunning := time.NewTicker(stillRunningInterval)
 	ps.StillRunning(agentName, warningTime, errorTime)
 
-	ctxPtr := zfsContext{ps: ps, disksProcessingTrigger: make(chan interface{}, 1)}
+	ctxPtr := zfsContext{
+		ps:                     ps,
+		disksProcessingTrigger: make(chan interface{}, 1),
+		startedAt:              time.Now(),
+	}
+
+	// Look for global config such as log levels
+	subGlobalConfig, err := ps.NewSubscription(pubsub.SubscriptionOptions{
+		AgentName:     "zedagent",
+		MyAgentName:   agentName,
+		TopicImpl:     types.ConfigItemValueMap{},
+		Persistent:    true,
+		Activate:      false,
+		Ctx:           &ctxPtr,
+		CreateHandler: handleGlobalConfigCreate,
+		ModifyHandler: handleGlobalConfigModify,
+		DeleteHandler: handleGlobalConfigDelete,
+		WarningTime:   warningTime,
+		ErrorTime:     errorTime,
+	})
+	if err != nil {
+		log.Fatal(err)
+	}
+	ctxPtr.subGlobalConfig = subGlobalConfig
+	subGlobalConfig.Activate()
 
 	if err := utils.WaitForVault(ps, log, agentName, warningTime, errorTime); err != nil {
 		log.Fatal(err)
@@ -139,12 +166,52 @@ func Run(ps *pubsub.PubSub, loggerArg *logrus.Logger, logArg *base.LogObject) in
 			processEvent(&ctxPtr, event)
 		case change := <-subDisksConfig.MsgChan():
 			subDisksConfig.ProcessChange(change)
+		case change := <-subGlobalConfig.MsgChan():
+			subGlobalConfig.ProcessChange(change)
 		case <-stillRunning.C:
 		}
 		ps.StillRunning(agentName, warningTime, errorTime)
 	}
 }
 
+func handleGlobalConfigCreate(ctxArg interface{}, key string,
+	statusArg interface{}) {
+	handleGlobalConfigImpl(ctxArg, key, statusArg)
+}
+
+func handleGlobalConfigModify(ctxArg interface{}, key string,
+	statusArg interface{}, oldStatusArg interface{}) {
+	handleGlobalConfigImpl(ctxArg, key, statusArg)
+}
+
+func handleGlobalConfigImpl(ctxArg interface{}, key string,
+	statusArg interface{}) {
+
+	ctx := ctxArg.(*zfsContext)
+	if key != "global" {
+		log.Functionf("handleGlobalConfigImpl: ignoring %s", key)
+		return
+	}
+	log.Functionf("handleGlobalConfigImpl for %s", key)
+	debug, _ = agentlog.HandleGlobalConfig(log, ctx.subGlobalConfig, agentName,
+		debugOverride, logger)
+	log.Functionf("handleGlobalConfigImpl done for %s", key)
+}
+
+func handleGlobalConfigDelete(ctxArg interface{}, key string,
+	statusArg interface{}) {
+
+	ctx := ctxArg.(*zfsContext)
+	if key != "global" {
+		log.Functionf("handleGlobalConfigDelete: ignoring %s", key)
+		return
+	}
+	log.Functionf("handleGlobalConfigDelete for %s", key)
+	debug, _ = agentlog.HandleGlobalConfig(log, ctx.subGlobalConfig, agentName,
+		debugOverride, logger)
+	log.Functionf("handleGlobalConfigDelete done for %s", key)
+}
+
 func processEvent(ctxPtr *zfsContext, event *zVolDeviceEvent) {
 	if event == nil {
 		return
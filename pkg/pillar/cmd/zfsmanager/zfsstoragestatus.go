@@ -86,6 +86,14 @@ func collectAndPublishStorageStatus(ctxPtr *zfsContext) {
 			if err != nil {
 				log.Errorf("error with get about RAID info from devTree: %v", err)
 			} else {
+				status.Scan = zfs.GetZfsScanStatus(vdevs.ScanStat)
+				for _, spare := range vdevs.Spares {
+					rDiskStatus, err := zfs.GetZfsDiskAndStatus(spare)
+					if err == nil {
+						rDiskStatus.Spare = true
+						status.Spares = append(status.Spares, rDiskStatus)
+					}
+				}
 				// it returns top-level raid type
 				currentRaid = zfs.GetZpoolRaidType(vdevs)
 				// handle the case when we have only one top-level vdev
@@ -93,23 +101,16 @@ func collectAndPublishStorageStatus(ctxPtr *zfsContext) {
 					for _, vdev := range vdevs.Devices {
 						// If this is a RAID or mirror, look at the disks it consists of
 						if vdev.Type == libzfs.VDevTypeMirror || vdev.Type == libzfs.VDevTypeRaidz {
-							for _, disk := range vdev.Devices {
-								rDiskStatus, err := zfs.GetZfsDiskAndStatus(disk)
-								// vdev.Devices might includes snapshots or caches,
-								// and those will result in errors which need to ignore.
-								if err == nil {
-									status.Disks = append(status.Disks, rDiskStatus)
-								}
-							}
+							// vdev.Devices might includes snapshots or caches,
+							// and those are ignored.
+							status.Disks = append(status.Disks,
+								zfs.GetZfsDisksAndStatus(vdev, status.Scan)...)
 							break // in that case we have only one RAID
 						}
 						// If there is no RAID or mirror, add a disk if it is a disk
-						rDiskStatus, err := zfs.GetZfsDiskAndStatus(vdev)
-						// vdev.Devices might includes snapshots or caches,
-						// and those will result in errors which need to ignore.
-						if err == nil {
-							status.Disks = append(status.Disks, rDiskStatus)
-						}
+						// (or disk being replaced)
+						status.Disks = append(status.Disks,
+							zfs.GetZfsDisksAndStatus(vdev, status.Scan)...)
 					}
 				} else {
 					// multiple top-level vdevs should be handled separately
@@ -137,25 +138,18 @@ func collectAndPublishStorageStatus(ctxPtr *zfsContext) {
 						if vdev.Type == libzfs.VDevTypeMirror || vdev.Type == libzfs.VDevTypeRaidz {
 							child.CurrentRaid = zfs.GetRaidTypeFromStr(vdev.Name)
 							updateCurrentRaid(child.CurrentRaid)
-							for _, disk := range vdev.Devices {
-								rDiskStatus, err := zfs.GetZfsDiskAndStatus(disk)
-								// vdev.Devices might includes snapshots or caches,
-								// and those will result in errors which need to ignore.
-								if err == nil {
-									child.Disks = append(child.Disks, rDiskStatus)
-								}
-							}
+							// vdev.Devices might includes snapshots or caches,
+							// and those are ignored.
+							child.Disks = zfs.GetZfsDisksAndStatus(vdev, status.Scan)
 							status.Children = append(status.Children, child)
 							continue
 						}
 						// If there is no RAID or mirror, add a disk if it is a disk
-						rDiskStatus, err := zfs.GetZfsDiskAndStatus(vdev)
-						// vdev.Devices might includes snapshots or caches,
-						// and those will result in errors which need to ignore.
-						if err == nil {
+						// (or disk being replaced)
+						if disks := zfs.GetZfsDisksAndStatus(vdev, status.Scan); len(disks) > 0 {
 							child.CurrentRaid = types.StorageRaidTypeNoRAID
 							updateCurrentRaid(child.CurrentRaid)
-							child.Disks = append(child.Disks, rDiskStatus)
+							child.Disks = disks
 							status.Children = append(status.Children, child)
 						}
 					}
@@ -0,0 +1,186 @@
+// Copyright (c) 2022 Zededa, Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package containerd
+
+// The kernel throttles the I/O of a cgroup per block device, hence the
+// limits of the volumes of a task are applied to the disks which back
+// the volumes. Volumes on the same disk share the smallest limits.
+
+import (
+	"context"
+	"fmt"
+
+	v1stat "github.com/containerd/cgroups/stats/v1"
+	"github.com/containerd/containerd"
+	"github.com/lf-edge/eve/pkg/pillar/types"
+	"github.com/opencontainers/runtime-spec/specs-go"
+	"github.com/sirupsen/logrus"
+)
+
+// lookupDiskBlockDevice is replaced by the tests
+var lookupDiskBlockDevice = diskBlockDevice
+
+type blockDevice struct {
+	major, minor int64
+}
+
+// minLimit returns the smaller of the non-zero limits
+func minLimit(a, b uint64) uint64 {
+	if a == 0 || (b != 0 && b < a) {
+		return b
+	}
+	return a
+}
+
+// BlockIOForDisks returns the OCI block I/O throttling of the block
+// devices of the disks. A zero rate removes the limit of a device
+// which allows to lift the limits of a running task.
+func BlockIOForDisks(disks []types.DiskStatus) *specs.LinuxBlockIO {
+	var devices []blockDevice
+	limits := make(map[blockDevice]types.VolumeIOLimits)
+	for _, disk := range disks {
+		if disk.FileLocation == "" {
+			continue
+		}
+		major, minor, err := lookupDiskBlockDevice(disk.FileLocation)
+		if err != nil {
+			if disk.IOLimits.IsSet() {
+				logrus.Warnf("BlockIOForDisks: cannot throttle %s: %v",
+					disk.DisplayName, err)
+			}
+			continue
+		}
+		dev := blockDevice{major: major, minor: minor}
+		l, ok := limits[dev]
+		if !ok {
+			devices = append(devices, dev)
+		}
+		l.ReadBps = minLimit(l.ReadBps, disk.IOLimits.ReadBps)
+		l.WriteBps = minLimit(l.WriteBps, disk.IOLimits.WriteBps)
+		l.ReadIops = minLimit(l.ReadIops, disk.IOLimits.ReadIops)
+		l.WriteIops = minLimit(l.WriteIops, disk.IOLimits.WriteIops)
+		limits[dev] = l
+	}
+	if len(devices) == 0 {
+		return nil
+	}
+	blockIO := &specs.LinuxBlockIO{}
+	throttle := func(list []specs.LinuxThrottleDevice, dev blockDevice, rate uint64) []specs.LinuxThrottleDevice {
+		d := specs.LinuxThrottleDevice{Rate: rate}
+		d.Major = dev.major
+		d.Minor = dev.minor
+		return append(list, d)
+	}
+	for _, dev := range devices {
+		l := limits[dev]
+		blockIO.ThrottleReadBpsDevice = throttle(blockIO.ThrottleReadBpsDevice, dev, l.ReadBps)
+		blockIO.ThrottleWriteBpsDevice = throttle(blockIO.ThrottleWriteBpsDevice, dev, l.WriteBps)
+		blockIO.ThrottleReadIOPSDevice = throttle(blockIO.ThrottleReadIOPSDevice, dev, l.ReadIops)
+		blockIO.ThrottleWriteIOPSDevice = throttle(blockIO.ThrottleWriteIOPSDevice, dev, l.WriteIops)
+	}
+	return blockIO
+}
+
+// DiskIOForDisks returns the I/O counters of the disks out of the
+// cgroup metrics. The counters of a block device are reported once
+// for the first disk on it.
+func DiskIOForDisks(disks []types.DiskStatus, blkio *v1stat.BlkIOStat) []types.DiskIOMetric {
+	if blkio == nil {
+		return nil
+	}
+	var metrics []types.DiskIOMetric
+	seen := make(map[blockDevice]bool)
+	for _, disk := range disks {
+		if disk.FileLocation == "" {
+			continue
+		}
+		major, minor, err := lookupDiskBlockDevice(disk.FileLocation)
+		if err != nil {
+			continue
+		}
+		dev := blockDevice{major: major, minor: minor}
+		if seen[dev] {
+			continue
+		}
+		seen[dev] = true
+		metric := types.DiskIOMetric{
+			VolumeKey: disk.VolumeKey,
+			IOLimits:  disk.IOLimits,
+		}
+		for _, e := range blkio.IoServiceBytesRecursive {
+			if int64(e.Major) != major || int64(e.Minor) != minor {
+				continue
+			}
+			switch e.Op {
+			case "Read":
+				metric.ReadBytes += e.Value
+			case "Write":
+				metric.WriteBytes += e.Value
+			}
+		}
+		for _, e := range blkio.IoServicedRecursive {
+			if int64(e.Major) != major || int64(e.Minor) != minor {
+				continue
+			}
+			switch e.Op {
+			case "Read":
+				metric.ReadOps += e.Value
+			case "Write":
+				metric.WriteOps += e.Value
+			}
+		}
+		metrics = append(metrics, metric)
+	}
+	return metrics
+}
+
+// UpdateBlockIO throttles the I/O of the task to the limits of the disks
+func (s *ociSpec) UpdateBlockIO(disks []types.DiskStatus) {
+	limited := false
+	for _, disk := range disks {
+		if disk.IOLimits.IsSet() {
+			limited = true
+		}
+	}
+	if !limited {
+		return
+	}
+	if s.Linux == nil {
+		s.Linux = &specs.Linux{}
+	}
+	if s.Linux.Resources == nil {
+		s.Linux.Resources = &specs.LinuxResources{}
+	}
+	s.Linux.Resources.BlockIO = BlockIOForDisks(disks)
+}
+
+// CtrUpdateBlockIO changes the block I/O throttling of a running task
+func (client *Client) CtrUpdateBlockIO(ctx context.Context, containerID string, blockIO *specs.LinuxBlockIO) error {
+	if err := client.verifyCtr(ctx, true); err != nil {
+		return fmt.Errorf("CtrUpdateBlockIO: exception while verifying ctrd client: %s", err.Error())
+	}
+	c, err := client.CtrLoadContainer(ctx, containerID)
+	if err != nil {
+		return err
+	}
+	spec, err := c.Spec(ctx)
+	if err != nil {
+		return err
+	}
+	if spec.Linux == nil {
+		return fmt.Errorf("CtrUpdateBlockIO: container %s is not a linux one", containerID)
+	}
+	if spec.Linux.Resources == nil {
+		spec.Linux.Resources = &specs.LinuxResources{}
+	}
+	if blockIO == nil {
+		blockIO = &specs.LinuxBlockIO{}
+	}
+	spec.Linux.Resources.BlockIO = blockIO
+	t, err := c.Task(ctx, nil)
+	if err != nil {
+		return err
+	}
+	return t.Update(ctx, containerd.WithResources(spec.Linux.Resources))
+}
@@ -66,6 +66,8 @@ const (
 	eveOCIMountPointsLabel = "org.lfedge.eve.blk_mounts"
 	// EVEOCIVNCPasswordLabel is OCI runtime spec label that tracks VNC password in OCI Image config
 	EVEOCIVNCPasswordLabel = "org.lfedge.eve.vnc_password"
+	// EVEOCIRealtimeCPUsLabel is OCI runtime spec label that tracks the isolated CPUs of a realtime domain
+	EVEOCIRealtimeCPUsLabel = "org.lfedge.eve.realtime_cpus"
 
 	//TBD: Have a better way to calculate this number.
 	//For now it is based on some trial-and-error experiments
@@ -73,6 +75,9 @@ const (
 
 	// default signal to kill tasks
 	defaultSignal = "SIGTERM"
+
+	// default time for an executable to exit
+	defaultExecTimeout = 30 * time.Second
 )
 
 var (
@@ -522,7 +527,7 @@ func (client *Client) CtrExec(ctx context.Context, domainName string, args []str
 	if err := client.verifyCtr(ctx, true); err != nil {
 		return "", "", fmt.Errorf("CtrExec: exception while verifying ctrd client: %s", err.Error())
 	}
-	return client.ctrExec(ctx, domainName, args)
+	return client.ctrExec(ctx, domainName, args, defaultExecTimeout)
 }
 
 // CtrSystemExec starts the executable in a running system (EVE's) container
@@ -530,7 +535,7 @@ func (client *Client) CtrSystemExec(ctx context.Context, domainName string, args
 	if err := client.verifyCtr(ctx, true); err != nil {
 		return "", "", fmt.Errorf("CtrSystemExec: exception while verifying ctrd client: %s", err.Error())
 	}
-	return client.ctrExec(ctx, domainName, args)
+	return client.ctrExec(ctx, domainName, args, defaultExecTimeout)
 }
 
 // CtrStopContainer stops (kills) the main task in the container
@@ -644,7 +649,7 @@ func (client *Client) CtrNewSystemServicesCtxWithLease() (context.Context, conte
 // Util methods
 
 // ctrExec starts the executable in a running container and attaches its logging to memlogd
-func (client *Client) ctrExec(ctx context.Context, domainName string, args []string) (string, string, error) {
+func (client *Client) ctrExec(ctx context.Context, domainName string, args []string, timeout time.Duration) (string, string, error) {
 	if err := client.verifyCtr(ctx, true); err != nil {
 		return "", "", fmt.Errorf("ctrExec: exception while verifying ctrd client: %s", err.Error())
 	}
@@ -678,7 +683,8 @@ func (client *Client) ctrExec(ctx context.Context, domainName string, args []str
 	if err != nil {
 		return "", "", err
 	}
-	defer process.Delete(ctx)
+	// a process which timed out is killed
+	defer process.Delete(ctx, containerd.WithProcessKill)
 
 	// prepare an exit code channel
 	statusC, err := process.Wait(ctx)
@@ -692,7 +698,8 @@ func (client *Client) ctrExec(ctx context.Context, domainName string, args []str
 	}
 
 	// block until the process exits or the timer fires
-	timer := time.NewTimer(30 * time.Second)
+	timer := time.NewTimer(timeout)
+	defer timer.Stop()
 	select {
 	case status := <-statusC:
 		if code, _, e := status.Result(); e == nil && code != 0 {
@@ -9,3 +9,8 @@ import "fmt"
 func bindNS(ns string, path string, pid int) error {
 	return fmt.Errorf("bindNS is not implemented on Mac OS X")
 }
+
+// diskBlockDevice returns the whole disk which backs fileLocation
+func diskBlockDevice(fileLocation string) (int64, int64, error) {
+	return 0, 0, fmt.Errorf("diskBlockDevice is not implemented on Mac OS X")
+}
@@ -6,6 +6,7 @@ package containerd
 import (
 	"fmt"
 	"golang.org/x/sys/unix"
+	"io/ioutil"
 	"os"
 	"path/filepath"
 )
@@ -32,3 +33,33 @@ func bindNS(ns string, path string, pid int) error {
 	}
 	return nil
 }
+
+// diskBlockDevice returns the whole disk which backs fileLocation
+func diskBlockDevice(fileLocation string) (int64, int64, error) {
+	var st unix.Stat_t
+	if err := unix.Stat(fileLocation, &st); err != nil {
+		return 0, 0, err
+	}
+	dev := uint64(st.Dev)
+	if st.Mode&unix.S_IFMT == unix.S_IFBLK {
+		dev = uint64(st.Rdev)
+	}
+	sysfs, err := filepath.EvalSymlinks(fmt.Sprintf("/sys/dev/block/%d:%d",
+		unix.Major(dev), unix.Minor(dev)))
+	if err != nil {
+		return 0, 0, fmt.Errorf("%s is not backed by a block device", fileLocation)
+	}
+	// the kernel throttles whole disks only
+	if _, err := os.Stat(filepath.Join(sysfs, "partition")); err == nil {
+		sysfs = filepath.Dir(sysfs)
+	}
+	devStr, err := ioutil.ReadFile(filepath.Join(sysfs, "dev"))
+	if err != nil {
+		return 0, 0, err
+	}
+	var major, minor int64
+	if _, err := fmt.Sscanf(string(devStr), "%d:%d", &major, &minor); err != nil {
+		return 0, 0, fmt.Errorf("cannot parse %s/dev: %v", sysfs, err)
+	}
+	return major, minor, nil
+}
@@ -0,0 +1,88 @@
+// Copyright (c) 2022 Zededa, Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package containerd
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"path/filepath"
+	"time"
+
+	"github.com/lf-edge/eve/pkg/pillar/types"
+	ocispec "github.com/opencontainers/image-spec/specs-go/v1"
+)
+
+// ImageSpec is the config of an image as saved next to its rootfs. It is
+// the OCI image config with the HEALTHCHECK which Docker keeps in it.
+type ImageSpec struct {
+	ocispec.Image
+	Config ImageSpecConfig `json:"config,omitempty"`
+}
+
+// ImageSpecConfig is the OCI image config with the HEALTHCHECK of Docker
+type ImageSpecConfig struct {
+	ocispec.ImageConfig
+	Healthcheck *ImageHealthcheck `json:"Healthcheck,omitempty"`
+}
+
+// ImageHealthcheck is the HEALTHCHECK of an image. Test is NONE, or CMD
+// with the command and its arguments, or CMD-SHELL with a shell command;
+// none inherits the one of the base image.
+type ImageHealthcheck struct {
+	Test        []string      `json:",omitempty"`
+	Interval    time.Duration `json:",omitempty"`
+	Timeout     time.Duration `json:",omitempty"`
+	StartPeriod time.Duration `json:",omitempty"`
+	Retries     int           `json:",omitempty"`
+}
+
+// GetImageHealthCheck returns the HEALTHCHECK of the image in an EVE
+// volume as an exec health check, or false if it has none
+func GetImageHealthCheck(volume string) (types.HealthCheck, bool) {
+	var check types.HealthCheck
+	data, err := ioutil.ReadFile(filepath.Join(volume, imageConfigFilename))
+	if err != nil {
+		return check, false
+	}
+	var image ImageSpec
+	if err := json.Unmarshal(data, &image); err != nil ||
+		image.Config.Healthcheck == nil {
+		return check, false
+	}
+	hc := image.Config.Healthcheck
+	if len(hc.Test) < 2 {
+		return check, false
+	}
+	switch hc.Test[0] {
+	case "CMD":
+		check.Command = hc.Test[1:]
+	case "CMD-SHELL":
+		check.Command = []string{"/bin/sh", "-c", hc.Test[1]}
+	default:
+		return check, false
+	}
+	check.Type = types.HealthCheckExec
+	check.Interval = hc.Interval
+	check.Timeout = hc.Timeout
+	check.StartPeriod = hc.StartPeriod
+	if hc.Retries > 0 {
+		check.Retries = uint32(hc.Retries)
+	}
+	return check, true
+}
+
+// CtrExecHealthCheck executes the command of a health check in a running
+// container. Returns its output, and an error if it failed or did not
+// exit within timeout.
+func (client *Client) CtrExecHealthCheck(ctx context.Context, containerID string,
+	args []string, timeout time.Duration) (string, error) {
+
+	if err := client.verifyCtr(ctx, true); err != nil {
+		return "", fmt.Errorf("CtrExecHealthCheck: exception while verifying ctrd client: %s", err.Error())
+	}
+	stdout, stderr, err := client.ctrExec(ctx, containerID, args, timeout)
+	return stdout + stderr, err
+}
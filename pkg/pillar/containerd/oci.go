@@ -60,7 +60,10 @@ type OCISpec interface {
 	UpdateFromDomain(*types.DomainConfig)
 	UpdateFromVolume(string) error
 	UpdateMounts([]types.DiskStatus) error
+	UpdateBlockIO([]types.DiskStatus)
 	UpdateEnvVar(map[string]string)
+	UpdatePod([]types.PodContainerConfig)
+	UpdateFromPodContainer(*types.DomainConfig, types.PodContainerConfig)
 }
 
 // NewOciSpec returns a default oci spec from the containerd point of view
@@ -295,11 +298,17 @@ func (s *ociSpec) UpdateFromDomain(dom *types.DomainConfig) {
 		s.Linux.Resources.Memory.Limit = &m
 		s.Linux.Resources.CPU.Period = &p
 		s.Linux.Resources.CPU.Quota = &q
+		if dom.CPUs != "" {
+			s.Linux.Resources.CPU.Cpus = dom.CPUs
+		}
 
 		s.Linux.CgroupsPath = fmt.Sprintf("/%s/%s", ctrdServicesNamespace, dom.GetTaskName())
 	}
 	s.Hostname = dom.UUIDandVersion.UUID.String()
 	s.Annotations[EVEOCIVNCPasswordLabel] = dom.VncPasswd
+	if dom.Realtime {
+		s.Annotations[EVEOCIRealtimeCPUsLabel] = dom.CPUs
+	}
 }
 
 // UpdateFromVolume updates values in the OCI spec based on the location
@@ -405,7 +414,7 @@ func (s *ociSpec) UpdateMounts(disks []types.DiskStatus) error {
 			dests = append(dests, "/dev/eve/volumes/by-name/"+disk.DisplayName)
 		}
 		if dst != "" {
-			if disk.Format != zconfig.Format_CONTAINER {
+			if disk.Format != zconfig.Format_CONTAINER && !disk.Shared {
 				// this is a bit of a hack: we assume that anything but
 				// the container image has to be a file and thus make it
 				// appear *under* destination directory as a file with ID
@@ -767,6 +767,71 @@ func TestUpdateMounts(t *testing.T) {
 	g.Expect(spec.UpdateMounts(tresAmigos)).To(HaveOccurred())
 }
 
+func TestUpdateMountsShared(t *testing.T) {
+	g := NewGomegaWithT(t)
+	spec := ociSpec{
+		name: "test",
+		Spec: specs.Spec{
+			Annotations: map[string]string{},
+		},
+	}
+
+	disks := []types.DiskStatus{
+		{MountDir: "/", Format: zconfig.Format_CONTAINER, FileLocation: "/foo/bar"},
+		{MountDir: "/data", Format: zconfig.Format_RAW, FileLocation: "/foo/vol.shared", Shared: true},
+	}
+	g.Expect(spec.UpdateMounts(disks)).ToNot(HaveOccurred())
+	g.Expect(spec.Mounts).To(ConsistOf([]specs.Mount{
+		{Destination: "/dev/eve/volumes/by-id/1", Type: "bind", Source: "/foo/vol.shared", Options: []string{"rbind", "rw"}},
+		{Destination: "/data", Type: "bind", Source: "/foo/vol.shared", Options: []string{"rbind", "rw"}},
+	}))
+	g.Expect(spec.Annotations).To(Equal(map[string]string{eveOCIMountPointsLabel: ""}))
+}
+
+func TestUpdateBlockIO(t *testing.T) {
+	g := NewGomegaWithT(t)
+	devices := map[string][2]int64{
+		"/persist/vol1": {8, 0},
+		"/persist/vol2": {8, 0},
+		"/nvme/vol3":    {259, 0},
+	}
+	lookupDiskBlockDevice = func(fileLocation string) (int64, int64, error) {
+		dev, ok := devices[fileLocation]
+		if !ok {
+			return 0, 0, fmt.Errorf("%s is not backed by a block device", fileLocation)
+		}
+		return dev[0], dev[1], nil
+	}
+	defer func() { lookupDiskBlockDevice = diskBlockDevice }()
+	throttle := func(major, minor int64, rate uint64) specs.LinuxThrottleDevice {
+		d := specs.LinuxThrottleDevice{Rate: rate}
+		d.Major = major
+		d.Minor = minor
+		return d
+	}
+
+	spec := ociSpec{name: "test"}
+	disks := []types.DiskStatus{
+		{FileLocation: "/persist/vol1"},
+		{FileLocation: "/persist/vol2"},
+		{FileLocation: "/zfs/vol4"},
+	}
+	spec.UpdateBlockIO(disks)
+	g.Expect(spec.Linux).To(BeNil())
+
+	disks[0].IOLimits = types.VolumeIOLimits{ReadBps: 2000, WriteIops: 100}
+	disks[1].IOLimits = types.VolumeIOLimits{ReadBps: 1000, WriteBps: 3000}
+	disks[2].IOLimits = types.VolumeIOLimits{ReadBps: 500}
+	disks = append(disks, types.DiskStatus{FileLocation: "/nvme/vol3"})
+	spec.UpdateBlockIO(disks)
+	g.Expect(spec.Linux.Resources.BlockIO).To(Equal(&specs.LinuxBlockIO{
+		ThrottleReadBpsDevice:   []specs.LinuxThrottleDevice{throttle(8, 0, 1000), throttle(259, 0, 0)},
+		ThrottleWriteBpsDevice:  []specs.LinuxThrottleDevice{throttle(8, 0, 3000), throttle(259, 0, 0)},
+		ThrottleReadIOPSDevice:  []specs.LinuxThrottleDevice{throttle(8, 0, 0), throttle(259, 0, 0)},
+		ThrottleWriteIOPSDevice: []specs.LinuxThrottleDevice{throttle(8, 0, 100), throttle(259, 0, 0)},
+	}))
+}
+
 func TestEnvs(t *testing.T) {
 	g := NewGomegaWithT(t)
 	spec := ociSpec{
@@ -788,6 +853,89 @@ func TestEnvs(t *testing.T) {
 	g.Expect(spec.Process.Args).To(Equal([]string{"echo", "hello"}))
 }
 
+func TestPod(t *testing.T) {
+	g := NewGomegaWithT(t)
+	spec := ociSpec{
+		name: "test",
+		Spec: specs.Spec{
+			Annotations: map[string]string{},
+			Process:     &specs.Process{Args: []string{"/start.sh"}},
+			Linux:       &specs.Linux{},
+		},
+	}
+	dom := &types.DomainConfig{
+		UUIDandVersion: types.UUIDandVersion{Version: "1"},
+		AppNum:         2,
+		VmConfig:       types.VmConfig{Memory: 1234, VCpus: 4},
+	}
+	pod := []types.PodContainerConfig{
+		{Name: "setup", Init: true},
+		{Name: "proxy", Memory: 100, VCpus: 1, Args: []string{"/proxy", "-v"}},
+		{Name: "migrate", Init: true},
+		{Name: "agent"},
+	}
+	spec.UpdatePod(pod)
+	inits, sidecars := PodContainerNames(spec.Annotations)
+	g.Expect(inits).To(Equal([]string{"setup", "migrate"}))
+	g.Expect(sidecars).To(Equal([]string{"proxy", "agent"}))
+
+	spec.UpdateFromPodContainer(dom, pod[1])
+	g.Expect(*spec.Linux.Resources.Memory.Limit).To(Equal(int64(100 * 1024)))
+	g.Expect(*spec.Linux.Resources.CPU.Quota).To(Equal(int64(100000)))
+	g.Expect(spec.Linux.CgroupsPath).To(Equal(fmt.Sprintf("/%s/%s/proxy",
+		ctrdServicesNamespace, dom.GetTaskName())))
+	g.Expect(spec.Process.Args).To(Equal([]string{"/proxy", "-v"}))
+
+	spec.UpdateFromPodContainer(dom, pod[3])
+	g.Expect(spec.Linux.Resources.Memory).To(BeNil())
+	g.Expect(spec.Linux.Resources.CPU).To(BeNil())
+
+	id := PodContainerID(dom.GetTaskName(), "proxy")
+	g.Expect(IsPodContainerID(id)).To(BeTrue())
+	g.Expect(IsPodContainerID(dom.GetTaskName())).To(BeFalse())
+	g.Expect(IsPodContainerID("c0ffee00-0000-0000-0000-000000000000.v_1.2")).To(BeFalse())
+}
+
+func TestGetImageHealthCheck(t *testing.T) {
+	g := NewGomegaWithT(t)
+	dir, err := ioutil.TempDir("", "healthcheck")
+	g.Expect(err).NotTo(HaveOccurred())
+	defer os.RemoveAll(dir)
+
+	image := ImageSpec{}
+	image.Config.Entrypoint = []string{"/server"}
+	for _, test := range []struct {
+		healthcheck *ImageHealthcheck
+		found       bool
+		command     []string
+	}{
+		{nil, false, nil},
+		{&ImageHealthcheck{Test: []string{"NONE"}}, false, nil},
+		{&ImageHealthcheck{Test: []string{"CMD", "/check", "-q"}, Retries: 2},
+			true, []string{"/check", "-q"}},
+		{&ImageHealthcheck{Test: []string{"CMD-SHELL", "pgrep server"}},
+			true, []string{"/bin/sh", "-c", "pgrep server"}},
+	} {
+		image.Config.Healthcheck = test.healthcheck
+		data, err := json.Marshal(image)
+		g.Expect(err).NotTo(HaveOccurred())
+		g.Expect(ioutil.WriteFile(filepath.Join(dir, imageConfigFilename), data, 0644)).To(Succeed())
+
+		// The OCI image config of the saved image is still there
+		saved, err := getSavedImageInfo(dir)
+		g.Expect(err).NotTo(HaveOccurred())
+		g.Expect(saved.Config.Entrypoint).To(Equal([]string{"/server"}))
+
+		check, found := GetImageHealthCheck(dir)
+		g.Expect(found).To(Equal(test.found))
+		if found {
+			g.Expect(check.Type).To(Equal(types.HealthCheckExec))
+			g.Expect(check.Command).To(Equal(test.command))
+			g.Expect(check.Retries).To(Equal(uint32(test.healthcheck.Retries)))
+		}
+	}
+}
+
 func TestAddLoader(t *testing.T) {
 	g := NewGomegaWithT(t)
 	specTemplate := ociSpec{
@@ -0,0 +1,184 @@
+// Copyright (c) 2022 Zededa, Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package containerd
+
+// The init and sidecar containers of a pod are containers of their own
+// next to the main container of a domain, which lists them in its
+// annotations. They join the network namespace of the main task, and their
+// cgroups are below its cgroup hence they share its limits.
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/containerd/containerd"
+	"github.com/lf-edge/eve/pkg/pillar/types"
+	"github.com/opencontainers/runtime-spec/specs-go"
+)
+
+const (
+	// EVEOCIPodInitLabel is OCI runtime spec label that tracks the init containers of a pod in their order
+	EVEOCIPodInitLabel = "org.lfedge.eve.pod.init"
+	// EVEOCIPodSidecarsLabel is OCI runtime spec label that tracks the sidecar containers of a pod
+	EVEOCIPodSidecarsLabel = "org.lfedge.eve.pod.sidecars"
+
+	// The names of the pod containers are DNS labels hence they have none
+	podContainerSeparator = "_"
+)
+
+// PodContainerID returns the ID of a container of the pod of a domain
+func PodContainerID(domainName, name string) string {
+	return domainName + podContainerSeparator + name
+}
+
+// IsPodContainerID returns true for the ID of a container of a pod, which
+// is not a domain of its own. The domain name ends with the app number.
+func IsPodContainerID(id string) bool {
+	return strings.Contains(id[strings.LastIndex(id, ".")+1:], podContainerSeparator)
+}
+
+// PodContainerNames returns the init containers in their order and the
+// sidecar containers listed in the annotations of the main container
+func PodContainerNames(annotations map[string]string) ([]string, []string) {
+	split := func(list string) []string {
+		if list == "" {
+			return nil
+		}
+		return strings.Split(list, ",")
+	}
+	return split(annotations[EVEOCIPodInitLabel]),
+		split(annotations[EVEOCIPodSidecarsLabel])
+}
+
+// UpdatePod lists the containers of the pod in the spec of the main container
+func (s *ociSpec) UpdatePod(pod []types.PodContainerConfig) {
+	var inits, sidecars []string
+	for _, pc := range pod {
+		if pc.Init {
+			inits = append(inits, pc.Name)
+		} else {
+			sidecars = append(sidecars, pc.Name)
+		}
+	}
+	if len(inits) != 0 {
+		s.Annotations[EVEOCIPodInitLabel] = strings.Join(inits, ",")
+	}
+	if len(sidecars) != 0 {
+		s.Annotations[EVEOCIPodSidecarsLabel] = strings.Join(sidecars, ",")
+	}
+}
+
+// UpdateFromPodContainer updates values in the OCI spec of a container of
+// the pod of a domain based on its PodContainerConfig. Its limits are
+// within those of the domain.
+func (s *ociSpec) UpdateFromPodContainer(dom *types.DomainConfig, pc types.PodContainerConfig) {
+	if s.Linux != nil {
+		if s.Linux.Resources == nil {
+			s.Linux.Resources = &specs.LinuxResources{}
+		}
+		s.Linux.Resources.Memory = nil
+		if pc.Memory != 0 {
+			m := int64(pc.Memory * 1024)
+			s.Linux.Resources.Memory = &specs.LinuxMemory{Limit: &m}
+		}
+		s.Linux.Resources.CPU = nil
+		if pc.VCpus != 0 {
+			p := uint64(100000)
+			q := int64(100000 * pc.VCpus)
+			s.Linux.Resources.CPU = &specs.LinuxCPU{Period: &p, Quota: &q}
+		}
+		s.Linux.CgroupsPath = fmt.Sprintf("/%s/%s/%s", ctrdServicesNamespace,
+			dom.GetTaskName(), pc.Name)
+	}
+	s.Hostname = dom.UUIDandVersion.UUID.String()
+	if len(pc.Args) != 0 {
+		s.Process.Args = pc.Args
+	}
+}
+
+// CtrJoinNetNamespace makes a container join the network namespace of the
+// process pid when its task is created
+func (client *Client) CtrJoinNetNamespace(ctx context.Context, containerID string, pid int) error {
+	if err := client.verifyCtr(ctx, true); err != nil {
+		return fmt.Errorf("CtrJoinNetNamespace: exception while verifying ctrd client: %s", err.Error())
+	}
+	ctr, err := client.CtrLoadContainer(ctx, containerID)
+	if err != nil {
+		return err
+	}
+	spec, err := ctr.Spec(ctx)
+	if err != nil {
+		return err
+	}
+	if spec.Linux == nil {
+		return fmt.Errorf("CtrJoinNetNamespace: container %s has no namespaces", containerID)
+	}
+	path := fmt.Sprintf("/proc/%d/ns/net", pid)
+	found := false
+	for i := range spec.Linux.Namespaces {
+		if spec.Linux.Namespaces[i].Type == specs.NetworkNamespace {
+			spec.Linux.Namespaces[i].Path = path
+			found = true
+		}
+	}
+	if !found {
+		spec.Linux.Namespaces = append(spec.Linux.Namespaces,
+			specs.LinuxNamespace{Type: specs.NetworkNamespace, Path: path})
+	}
+	return ctr.Update(ctx, containerd.UpdateContainerOpts(containerd.WithSpec(spec)))
+}
+
+// CtrRunTask starts the task of a container which was prepared by
+// CtrCreateTask and waits for it to exit. Returns its exit code.
+func (client *Client) CtrRunTask(ctx context.Context, containerID string) (int, error) {
+	if err := client.verifyCtr(ctx, true); err != nil {
+		return 0, fmt.Errorf("CtrRunTask: exception while verifying ctrd client: %s", err.Error())
+	}
+	ctr, err := client.CtrLoadContainer(ctx, containerID)
+	if err != nil {
+		return 0, err
+	}
+	task, err := ctr.Task(ctx, nil)
+	if err != nil {
+		return 0, err
+	}
+	exitCh, err := task.Wait(ctx)
+	if err != nil {
+		return 0, err
+	}
+	if err := task.Start(ctx); err != nil {
+		return 0, err
+	}
+	select {
+	case exit := <-exitCh:
+		code, _, err := exit.Result()
+		return int(code), err
+	case <-ctx.Done():
+		// ctx is over hence the kill needs one of its own
+		killCtx, done := client.CtrNewUserServicesCtx()
+		defer done()
+		if signal, err := containerd.ParseSignal(defaultSignal); err == nil {
+			_ = task.Kill(killCtx, signal, containerd.WithKillAll)
+		}
+		return 0, fmt.Errorf("CtrRunTask: container %s did not exit: %v", containerID, ctx.Err())
+	}
+}
+
+// CtrGetContainerAnnotations gets the annotations of the spec of a container,
+// which exist even if it has no task
+func (client *Client) CtrGetContainerAnnotations(ctx context.Context, containerID string) (map[string]string, error) {
+	if err := client.verifyCtr(ctx, true); err != nil {
+		return nil, fmt.Errorf("CtrGetContainerAnnotations: exception while verifying ctrd client: %s", err.Error())
+	}
+	ctr, err := client.CtrLoadContainer(ctx, containerID)
+	if err != nil {
+		return nil, err
+	}
+	spec, err := ctr.Spec(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return spec.Annotations, nil
+}
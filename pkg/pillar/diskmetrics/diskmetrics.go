@@ -86,3 +86,19 @@ func RolloutImgToBlock(ctx context.Context, log *base.LogObject, diskfile, outpu
 	}
 	return nil
 }
+
+//ConvertImg converts diskfile to a new outputFile with defined format.
+//Fails with a "write" lock error if the diskfile is in use.
+func ConvertImg(ctx context.Context, log *base.LogObject, diskfile, outputFile, outputFormat string) error {
+	if _, err := os.Stat(diskfile); err != nil {
+		return err
+	}
+	args := []string{"convert", "-t", "writeback", "-O", outputFormat, diskfile, outputFile}
+	output, err := base.Exec(log, "/usr/bin/qemu-img", args...).WithContext(ctx).CombinedOutputWithCustomTimeout(432000)
+	if err != nil {
+		errStr := fmt.Sprintf("qemu-img failed: %s, %s\n",
+			err, output)
+		return errors.New(errStr)
+	}
+	return nil
+}
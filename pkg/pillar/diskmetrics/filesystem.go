@@ -0,0 +1,108 @@
+// Copyright (c) 2022 Zededa, Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package diskmetrics
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"strconv"
+
+	zconfig "github.com/lf-edge/eve/api/go/config"
+	"github.com/lf-edge/eve/pkg/pillar/base"
+	"github.com/lf-edge/eve/pkg/pillar/types"
+)
+
+const (
+	mkfsExt4Path = "/sbin/mkfs.ext4"
+	mkfsXfsPath  = "/sbin/mkfs.xfs"
+	mkfsVfatPath = "/sbin/mkfs.vfat"
+
+	ext4LabelLen = 16
+	xfsLabelLen  = 12
+	vfatLabelLen = 11
+)
+
+// mkfsCommand returns the command which formats target with fs. The xfs
+// root directory is owned as asked by means of a protofile.
+func mkfsCommand(target string, fs types.VolumeFilesystem, protofile string) (string, []string, error) {
+	var command string
+	var args []string
+	labelLen := 0
+	switch fs.Type {
+	case zconfig.VolumeFsType_VOLUME_FS_TYPE_EXT4:
+		command = mkfsExt4Path
+		labelLen = ext4LabelLen
+		args = []string{"-F", "-q", "-E",
+			fmt.Sprintf("root_owner=%d:%d", fs.UID, fs.GID)}
+		if fs.Label != "" {
+			args = append(args, "-L", fs.Label)
+		}
+	case zconfig.VolumeFsType_VOLUME_FS_TYPE_XFS:
+		command = mkfsXfsPath
+		labelLen = xfsLabelLen
+		args = []string{"-f", "-q"}
+		if fs.Label != "" {
+			args = append(args, "-L", fs.Label)
+		}
+		if protofile != "" {
+			args = append(args, "-p", protofile)
+		}
+	case zconfig.VolumeFsType_VOLUME_FS_TYPE_VFAT:
+		command = mkfsVfatPath
+		labelLen = vfatLabelLen
+		if fs.UID != 0 || fs.GID != 0 {
+			return "", nil, errors.New("vfat has no owners")
+		}
+		if fs.Label != "" {
+			args = append(args, "-n", fs.Label)
+		}
+	default:
+		return "", nil, fmt.Errorf("unsupported filesystem %s", fs.Type)
+	}
+	if len(fs.Label) > labelLen {
+		return "", nil, fmt.Errorf("label %s is longer than %d characters",
+			fs.Label, labelLen)
+	}
+	return command, append(args, target), nil
+}
+
+// xfsProtofile returns the content of the mkfs.xfs protofile which only
+// sets the owner of the root directory
+func xfsProtofile(uid, gid uint32) string {
+	return "/dev/null\n0 0\nd--755 " + strconv.FormatUint(uint64(uid), 10) +
+		" " + strconv.FormatUint(uint64(gid), 10) + "\n$\n"
+}
+
+// CreateFilesystem formats the raw image file or block device target
+// with the filesystem fs
+func CreateFilesystem(ctx context.Context, log *base.LogObject, target string, fs types.VolumeFilesystem) error {
+	protofile := ""
+	if fs.Type == zconfig.VolumeFsType_VOLUME_FS_TYPE_XFS && (fs.UID != 0 || fs.GID != 0) {
+		f, err := ioutil.TempFile("", "xfsproto")
+		if err != nil {
+			return err
+		}
+		defer os.Remove(f.Name())
+		_, err = f.WriteString(xfsProtofile(fs.UID, fs.GID))
+		f.Close()
+		if err != nil {
+			return err
+		}
+		protofile = f.Name()
+	}
+	command, args, err := mkfsCommand(target, fs, protofile)
+	if err != nil {
+		return err
+	}
+	output, err := base.Exec(log, command, args...).WithContext(ctx).CombinedOutputWithCustomTimeout(3600)
+	if err != nil {
+		errStr := fmt.Sprintf("%s failed: %s, %s\n",
+			command, err, output)
+		return errors.New(errStr)
+	}
+	return nil
+}
@@ -0,0 +1,70 @@
+// Copyright (c) 2022 Zededa, Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package diskmetrics
+
+import (
+	"testing"
+
+	zconfig "github.com/lf-edge/eve/api/go/config"
+	"github.com/lf-edge/eve/pkg/pillar/types"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMkfsCommand(t *testing.T) {
+	testMatrix := map[string]struct {
+		fs        types.VolumeFilesystem
+		protofile string
+		command   string
+		args      []string
+		fails     bool
+	}{
+		"ext4": {
+			fs: types.VolumeFilesystem{Type: zconfig.VolumeFsType_VOLUME_FS_TYPE_EXT4,
+				Label: "data", UID: 1000, GID: 100},
+			command: mkfsExt4Path,
+			args:    []string{"-F", "-q", "-E", "root_owner=1000:100", "-L", "data", "/dev/zd0"},
+		},
+		"xfs": {
+			fs: types.VolumeFilesystem{Type: zconfig.VolumeFsType_VOLUME_FS_TYPE_XFS,
+				UID: 1000, GID: 100},
+			protofile: "/tmp/xfsproto",
+			command:   mkfsXfsPath,
+			args:      []string{"-f", "-q", "-p", "/tmp/xfsproto", "/dev/zd0"},
+		},
+		"xfs label too long": {
+			fs: types.VolumeFilesystem{Type: zconfig.VolumeFsType_VOLUME_FS_TYPE_XFS,
+				Label: "thirteenchars"},
+			fails: true,
+		},
+		"vfat": {
+			fs: types.VolumeFilesystem{Type: zconfig.VolumeFsType_VOLUME_FS_TYPE_VFAT,
+				Label: "BOOT"},
+			command: mkfsVfatPath,
+			args:    []string{"-n", "BOOT", "/dev/zd0"},
+		},
+		"vfat with owner": {
+			fs: types.VolumeFilesystem{Type: zconfig.VolumeFsType_VOLUME_FS_TYPE_VFAT,
+				UID: 1000},
+			fails: true,
+		},
+		"unspecified": {
+			fails: true,
+		},
+	}
+	for testname, test := range testMatrix {
+		t.Logf("Running test case %s", testname)
+		command, args, err := mkfsCommand("/dev/zd0", test.fs, test.protofile)
+		if test.fails {
+			assert.NotNil(t, err, testname)
+			continue
+		}
+		assert.Nil(t, err, testname)
+		assert.Equal(t, test.command, command, testname)
+		assert.Equal(t, test.args, args, testname)
+	}
+}
+
+func TestXfsProtofile(t *testing.T) {
+	assert.Equal(t, "/dev/null\n0 0\nd--755 1000 100\n$\n", xfsProtofile(1000, 100))
+}
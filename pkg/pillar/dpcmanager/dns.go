@@ -152,6 +152,8 @@ func (m *DpcManager) updateDNS() {
 					Cellular: wwanNetStatus,
 				}
 			}
+			m.deviceNetStatus.Ports[ix].BlockedTraffic =
+				m.cellBlockedTraffic(port.Logicallabel)
 		}
 	}
 
@@ -83,6 +83,7 @@ type DpcManager struct {
 	PubWwanStatus            pubsub.Publication
 	PubWwanMetrics           pubsub.Publication
 	PubWwanLocationInfo      pubsub.Publication
+	PubWwanDataUsage         pubsub.Publication // persistent
 
 	// Metrics
 	ZedcloudMetrics *zedcloud.AgentMetrics
@@ -105,6 +106,9 @@ type DpcManager struct {
 	deviceNetStatus types.DeviceNetworkStatus
 	wwanStatus      types.WwanStatus
 	wwanMetrics     types.WwanMetrics
+	wwanDataUsage   map[string]types.WwanDataUsage // key: SIM key
+	activeSimKeys   map[string]string              // logical label -> SIM key
+	cellFailover    map[string]*cellFailover       // key: logical label
 
 	// Channels
 	inputCommands chan inputCommand
@@ -224,6 +228,7 @@ func (m *DpcManager) Init(ctx context.Context) error {
 	// Ingest persisted list of DPCs. ingestDPCList will return false
 	// to indicate the file is missing in /persist
 	m.dpclPresentAtBoot = m.ingestDPCList()
+	m.loadWwanDataUsage()
 	return nil
 }
 
@@ -398,8 +403,10 @@ func (m *DpcManager) run(ctx context.Context) {
 				m.Log.Warnf("Undefined event received from WwanWatcher")
 			case WwanEventNewStatus:
 				m.reloadWwanStatus()
+				m.updateCellular(ctx, false)
 			case WwanEventNewMetrics:
 				m.reloadWwanMetrics()
+				m.updateCellular(ctx, true)
 			case WwanEventNewLocationInfo:
 				m.reloadWwanLocationInfo()
 			}
@@ -416,9 +423,11 @@ func (m *DpcManager) run(ctx context.Context) {
 
 func (m *DpcManager) reconcilerArgs() dpcreconciler.Args {
 	args := dpcreconciler.Args{
-		GCP: m.globalCfg,
-		AA:  m.adapters,
-		RS:  m.radioSilence,
+		GCP:              m.globalCfg,
+		AA:               m.adapters,
+		RS:               m.radioSilence,
+		SIMSlots:         m.selectedSimSlots(),
+		AppsBlockedPorts: m.appsBlockedPorts(),
 	}
 	if m.currentDPC() != nil {
 		args.DPC = *m.currentDPC()
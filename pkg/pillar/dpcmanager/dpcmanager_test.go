@@ -48,6 +48,7 @@ var (
 	pubWwwanStatus  pubsub.Publication
 	pubWwwanMetrics pubsub.Publication
 	pubWwanLocInfo  pubsub.Publication
+	pubWwanUsage    pubsub.Publication
 )
 
 func initTest(test *testing.T) *GomegaWithT {
@@ -110,6 +111,15 @@ func initTest(test *testing.T) *GomegaWithT {
 	if err != nil {
 		log.Fatal(err)
 	}
+	pubWwanUsage, err = ps.NewPublication(
+		pubsub.PublicationOptions{
+			AgentName:  "test",
+			Persistent: true,
+			TopicType:  types.WwanDataUsage{},
+		})
+	if err != nil {
+		log.Fatal(err)
+	}
 	networkMonitor = &netmonitor.MockNetworkMonitor{
 		Log:    logObj,
 		MainRT: syscall.RT_TABLE_MAIN,
@@ -140,6 +150,7 @@ func initTest(test *testing.T) *GomegaWithT {
 		PubWwanStatus:            pubWwwanStatus,
 		PubWwanMetrics:           pubWwwanMetrics,
 		PubWwanLocationInfo:      pubWwanLocInfo,
+		PubWwanDataUsage:         pubWwanUsage,
 		ZedcloudMetrics:          zedcloud.NewAgentMetrics(),
 	}
 	ctx := reconciler.MockRun(context.Background())
@@ -509,6 +520,7 @@ func mockWwan0Metrics() types.WwanMetrics {
 					TxBytes:   1256,
 					TxPackets: 12,
 				},
+				ConnectionID: "9c3f1a52-1f4e-4b43-8d1e-3f0a6c2b7e01",
 				SignalInfo: types.WwanSignalInfo{
 					RSSI: -67,
 					RSRQ: -11,
@@ -1301,6 +1313,129 @@ func TestWireless(test *testing.T) {
 	t.Expect(itemDescription(wwan)).To(ContainSubstring("RadioSilence:true"))
 }
 
+func TestCellularDataQuota(test *testing.T) {
+	t := initTest(test)
+	networkMonitor.AddOrUpdateInterface(mockWwan0())
+	dpcManager.UpdateGCP(globalConfig())
+	aa := makeAA(selectedIntfs{wwan0: true})
+	dpc := makeDPC("zedagent", time.Now(), selectedIntfs{wwan0: true})
+	dpc.Ports[0].WirelessCfg.Cellular[0].SimProfiles = []types.CellSimProfile{
+		{
+			SimSlot: 1,
+			Quota: types.CellDataQuota{
+				LimitBytes:           20000,
+				SoftThresholdPercent: 50,
+				SoftAllowed:          []types.TrafficClass{types.TrafficClassApps},
+			},
+		},
+		{
+			SimSlot: 2,
+			APN:     "backup-apn",
+		},
+	}
+	dpc.Ports[0].WirelessCfg.Cellular[0].Failover = types.CellFailover{
+		Enable:           true,
+		OnQuotaExhausted: true,
+	}
+	dpcManager.UpdateAA(aa)
+	dpcManager.AddDPC(dpc)
+	t.Eventually(dpcStateCb(0)).Should(Equal(types.DPCStateSuccess))
+	wwan := dg.Reference(generic.Wwan{})
+	t.Eventually(func() string {
+		return itemDescription(wwan)
+	}).Should(ContainSubstring("SimSlot:1"))
+
+	// Usage above the soft threshold.
+	expectedWwanConfig := types.WwanConfig{
+		Networks: []types.WwanNetworkConfig{
+			{
+				LogicalLabel:     "mock-wwan0",
+				PhysAddrs:        types.WwanPhysAddrs{Interface: "wwan0"},
+				Apns:             []string{"apn"},
+				LocationTracking: true,
+				SimSlot:          1,
+			},
+		},
+	}
+	_, wwanCfgHash, err := generic.MarshalWwanConfig(expectedWwanConfig)
+	t.Expect(err).To(BeNil())
+	wwan0Status := mockWwan0Status()
+	wwan0Status.ConfigChecksum = wwanCfgHash
+	wwan0Status.Networks[0].CurrentSimSlot = 1
+	wwan0Status.Networks[0].SimCards[0].SlotNumber = 1
+	wwanWatcher.UpdateStatus(wwan0Status)
+	t.Eventually(wwanOpModeCb(types.WwanOpModeConnected)).Should(BeTrue())
+	wwan0Metrics := mockWwan0Metrics()
+	wwanWatcher.UpdateMetrics(wwan0Metrics)
+	blockedTrafficCb := func() []types.TrafficClass {
+		return getDNS().Ports[0].BlockedTraffic
+	}
+	t.Eventually(blockedTrafficCb).Should(Equal([]types.TrafficClass{
+		types.TrafficClassLogs, types.TrafficClassMetrics, types.TrafficClassDownloads}))
+	const iccid = "89012703578345957137"
+	obj, err := pubWwanUsage.Get(iccid)
+	t.Expect(err).To(BeNil())
+	usage := obj.(types.WwanDataUsage)
+	t.Expect(usage.SimSlot).To(BeEquivalentTo(1))
+	t.Expect(usage.RxBytes).To(BeEquivalentTo(12345))
+	t.Expect(usage.TxBytes).To(BeEquivalentTo(1256))
+	t.Expect(usage.LimitBytes).To(BeEquivalentTo(20000))
+	t.Expect(usage.QuotaState).To(Equal(types.WwanQuotaStateSoftLimit))
+	t.Expect(usage.CycleStart.Day()).To(Equal(1))
+	t.Expect(usage.CycleStart.Before(time.Now())).To(BeTrue())
+	t.Expect(usage.CycleEnd.After(time.Now())).To(BeTrue())
+	forwardChain := dg.Reference(linux.IptablesChain{Table: "mangle", ChainName: "FORWARD-device"})
+	t.Expect(itemIsCreated(forwardChain)).To(BeFalse())
+
+	// Modem reconnected and its counters already passed the last values,
+	// quota gets exhausted.
+	wwan0Metrics.Networks[0].ConnectionID = "5b0e2d7c-8a61-4f0b-9e7d-2c4a1b6f3d02"
+	wwan0Metrics.Networks[0].PacketStats.RxBytes = 13000
+	wwan0Metrics.Networks[0].PacketStats.TxBytes = 2000
+	wwanWatcher.UpdateMetrics(wwan0Metrics)
+	t.Eventually(func() types.WwanQuotaState {
+		obj, _ := pubWwanUsage.Get(iccid)
+		return obj.(types.WwanDataUsage).QuotaState
+	}).Should(Equal(types.WwanQuotaStateHardLimit))
+	obj, _ = pubWwanUsage.Get(iccid)
+	usage = obj.(types.WwanDataUsage)
+	t.Expect(usage.RxBytes).To(BeEquivalentTo(25345))
+	t.Expect(usage.TxBytes).To(BeEquivalentTo(3256))
+	t.Expect(usage.BlockedTraffic).To(Equal(types.RestrictableTrafficClasses))
+	t.Expect(itemDescription(forwardChain)).To(ContainSubstring("-o wwan0 -j DROP"))
+
+	// Failover to the second SIM card, which has no quota.
+	t.Eventually(func() string {
+		return itemDescription(wwan)
+	}).Should(ContainSubstring("SimSlot:2"))
+	t.Expect(itemDescription(wwan)).To(ContainSubstring("Apns:[backup-apn]"))
+	expectedWwanConfig.Networks[0].SimSlot = 2
+	expectedWwanConfig.Networks[0].Apns = []string{"backup-apn"}
+	_, wwanCfgHash, err = generic.MarshalWwanConfig(expectedWwanConfig)
+	t.Expect(err).To(BeNil())
+	wwan0Status.ConfigChecksum = wwanCfgHash
+	wwan0Status.Networks[0].CurrentSimSlot = 2
+	wwan0Status.Networks[0].SimCards = []types.WwanSimCard{
+		{
+			ICCID:      "89012703578345957138",
+			IMSI:       "310180933695714",
+			SlotNumber: 2,
+		},
+	}
+	wwanWatcher.UpdateStatus(wwan0Status)
+	wwan0Metrics.Networks[0].ConnectionID = "e4a7c9b1-3d5f-4a2e-b8c6-7f1d0e9a5b03"
+	wwan0Metrics.Networks[0].PacketStats.RxBytes = 100
+	wwan0Metrics.Networks[0].PacketStats.TxBytes = 10
+	wwanWatcher.UpdateMetrics(wwan0Metrics)
+	t.Eventually(blockedTrafficCb).Should(BeEmpty())
+	t.Eventually(itemIsCreatedCb(forwardChain)).Should(BeFalse())
+	obj, err = pubWwanUsage.Get("89012703578345957138")
+	t.Expect(err).To(BeNil())
+	usage = obj.(types.WwanDataUsage)
+	t.Expect(usage.UsedBytes()).To(BeEquivalentTo(110))
+	t.Expect(usage.QuotaState).To(Equal(types.WwanQuotaStateOK))
+}
+
 func TestAddDPCDuringVerify(test *testing.T) {
 	t := initTest(test)
 
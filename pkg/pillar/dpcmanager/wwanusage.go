@@ -0,0 +1,338 @@
+// Copyright (c) 2022 Zededa, Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package dpcmanager
+
+import (
+	"context"
+	"reflect"
+	"time"
+
+	"github.com/lf-edge/eve/pkg/pillar/types"
+)
+
+// cellFailover : state of the failover between SIM profiles of a cellular modem.
+type cellFailover struct {
+	// Index of the selected profile in CellConfig.SimProfiles.
+	profileIndex      int
+	switchedAt        time.Time
+	probeFailingSince time.Time
+}
+
+// loadWwanDataUsage loads data usage persisted before the reboot.
+func (m *DpcManager) loadWwanDataUsage() {
+	m.wwanDataUsage = make(map[string]types.WwanDataUsage)
+	m.activeSimKeys = make(map[string]string)
+	m.cellFailover = make(map[string]*cellFailover)
+	if m.PubWwanDataUsage == nil {
+		return
+	}
+	for _, item := range m.PubWwanDataUsage.GetAll() {
+		usage := item.(types.WwanDataUsage)
+		m.wwanDataUsage[usage.Key()] = usage
+	}
+}
+
+// lookupCellConfig returns config of the cellular modem with the given logical label
+// from the current DPC.
+func (m *DpcManager) lookupCellConfig(logicalLabel string) (types.CellConfig, bool) {
+	dpc := m.currentDPC()
+	if dpc == nil || logicalLabel == "" {
+		return types.CellConfig{}, false
+	}
+	for _, port := range dpc.Ports {
+		if port.Logicallabel != logicalLabel ||
+			port.WirelessCfg.WType != types.WirelessTypeCellular ||
+			len(port.WirelessCfg.Cellular) == 0 {
+			continue
+		}
+		return port.WirelessCfg.Cellular[0], true
+	}
+	return types.CellConfig{}, false
+}
+
+// lookupSimProfile returns the profile configured for the given SIM slot.
+func lookupSimProfile(cellCfg types.CellConfig, simSlot uint8) (types.CellSimProfile, bool) {
+	for _, profile := range cellCfg.SimProfiles {
+		if profile.SimSlot == simSlot {
+			return profile, true
+		}
+	}
+	return types.CellSimProfile{}, false
+}
+
+// selectedSimSlot returns the SIM slot which the modem should use,
+// or zero if there are no SIM profiles configured.
+func (m *DpcManager) selectedSimSlot(logicalLabel string) uint8 {
+	cellCfg, found := m.lookupCellConfig(logicalLabel)
+	if !found || len(cellCfg.SimProfiles) == 0 {
+		return 0
+	}
+	var index int
+	if failover := m.cellFailover[logicalLabel]; failover != nil &&
+		failover.profileIndex < len(cellCfg.SimProfiles) {
+		index = failover.profileIndex
+	}
+	return cellCfg.SimProfiles[index].SimSlot
+}
+
+// selectedSimSlots returns SIM slots to apply for all cellular modems of the current DPC.
+func (m *DpcManager) selectedSimSlots() map[string]uint8 {
+	simSlots := make(map[string]uint8)
+	dpc := m.currentDPC()
+	if dpc == nil {
+		return simSlots
+	}
+	for _, port := range dpc.Ports {
+		if slot := m.selectedSimSlot(port.Logicallabel); slot != 0 {
+			simSlots[port.Logicallabel] = slot
+		}
+	}
+	return simSlots
+}
+
+// activeSimCard returns the slot and the ICCID of the SIM card currently used
+// by the modem.
+func (m *DpcManager) activeSimCard(logicalLabel string) (simSlot uint8, iccid string) {
+	status, found := m.wwanStatus.LookupNetworkStatus(logicalLabel)
+	if found {
+		simSlot = status.CurrentSimSlot
+	}
+	if simSlot == 0 {
+		simSlot = m.selectedSimSlot(logicalLabel)
+	}
+	if simSlot == 0 {
+		simSlot = 1
+	}
+	if !found {
+		return simSlot, ""
+	}
+	for _, simCard := range status.SimCards {
+		if simCard.SlotNumber == simSlot {
+			return simSlot, simCard.ICCID
+		}
+	}
+	// Modem reports only the active SIM card without the slot number.
+	if len(status.SimCards) == 1 && status.SimCards[0].SlotNumber == 0 {
+		iccid = status.SimCards[0].ICCID
+	}
+	return simSlot, iccid
+}
+
+// cellBlockedTraffic returns traffic classes which are not allowed to use
+// the cellular modem with the given logical label.
+func (m *DpcManager) cellBlockedTraffic(logicalLabel string) []types.TrafficClass {
+	simKey, found := m.activeSimKeys[logicalLabel]
+	if !found {
+		return nil
+	}
+	return m.wwanDataUsage[simKey].BlockedTraffic
+}
+
+// appsBlockedPorts returns interface names of ports with application traffic
+// blocked due to data quota.
+func (m *DpcManager) appsBlockedPorts() (ifNames []string) {
+	dpc := m.currentDPC()
+	if dpc == nil {
+		return nil
+	}
+	for _, port := range dpc.Ports {
+		if port.WirelessCfg.WType != types.WirelessTypeCellular {
+			continue
+		}
+		for _, tc := range m.cellBlockedTraffic(port.Logicallabel) {
+			if tc == types.TrafficClassApps {
+				ifNames = append(ifNames, port.IfName)
+				break
+			}
+		}
+	}
+	return ifNames
+}
+
+// updateWwanDataUsage accounts data transferred by each SIM card since the last
+// metrics update. Counters of the modem are reset when the modem reconnects
+// or the device reboots, in which case the whole counter value is accounted.
+// A reset is detected by the change of the connection ID, which the last
+// update of each SIM card keeps, or else by the counters going backwards.
+// Returns true if the set of blocked traffic classes changed for any modem.
+func (m *DpcManager) updateWwanDataUsage(now time.Time) (blockedChanged bool) {
+	for _, metrics := range m.wwanMetrics.Networks {
+		logicalLabel := metrics.LogicalLabel
+		cellCfg, found := m.lookupCellConfig(logicalLabel)
+		if !found {
+			continue
+		}
+		prevBlocked := m.cellBlockedTraffic(logicalLabel)
+		simSlot, iccid := m.activeSimCard(logicalLabel)
+		simKey := types.WwanSimKey(iccid, logicalLabel, simSlot)
+		m.activeSimKeys[logicalLabel] = simKey
+		profile, _ := lookupSimProfile(cellCfg, simSlot)
+		usage, exists := m.wwanDataUsage[simKey]
+		if !exists {
+			usage = types.WwanDataUsage{SimKey: simKey}
+		}
+		prevUsage := usage
+		usage.ICCID = iccid
+		usage.LogicalLabel = logicalLabel
+		usage.SimSlot = simSlot
+		cycleStart, cycleEnd := profile.Quota.BillingCycle(now)
+		if !usage.CycleStart.Equal(cycleStart) {
+			// New billing cycle (or the billing cycle day was changed).
+			usage.RxBytes = 0
+			usage.TxBytes = 0
+			usage.CycleStart = cycleStart
+		}
+		usage.CycleEnd = cycleEnd
+		stats := metrics.PacketStats
+		reset := metrics.ConnectionID != "" &&
+			metrics.ConnectionID != usage.LastModemConnectionID
+		usage.RxBytes += counterDelta(usage.LastModemRxBytes, stats.RxBytes, reset)
+		usage.TxBytes += counterDelta(usage.LastModemTxBytes, stats.TxBytes, reset)
+		usage.LastModemRxBytes = stats.RxBytes
+		usage.LastModemTxBytes = stats.TxBytes
+		if metrics.ConnectionID != "" {
+			usage.LastModemConnectionID = metrics.ConnectionID
+		}
+		usage.LimitBytes = profile.Quota.LimitBytes
+		usage.QuotaState = profile.Quota.State(usage.UsedBytes())
+		usage.BlockedTraffic = profile.Quota.BlockedTraffic(usage.QuotaState)
+		usage.LastUpdate = now
+		if exists && reflect.DeepEqual(prevUsage, usage) {
+			continue
+		}
+		m.wwanDataUsage[simKey] = usage
+		if m.PubWwanDataUsage != nil {
+			if err := m.PubWwanDataUsage.Publish(simKey, usage); err != nil {
+				m.Log.Errorf("Failed to publish wwan data usage: %v", err)
+			}
+		}
+		if !reflect.DeepEqual(prevBlocked, usage.BlockedTraffic) {
+			m.Log.Noticef("Traffic blocked for cellular port %s (SIM %s) changed "+
+				"from %v to %v", logicalLabel, simKey, prevBlocked, usage.BlockedTraffic)
+			blockedChanged = true
+		}
+	}
+	return blockedChanged
+}
+
+func counterDelta(last, current uint64, reset bool) uint64 {
+	if reset || current < last {
+		// Counter was reset.
+		return current
+	}
+	return current - last
+}
+
+// updateCellFailover decides which SIM profile each cellular modem should use.
+// Returns true if the selection changed for any modem.
+func (m *DpcManager) updateCellFailover(now time.Time) (changed bool) {
+	for _, network := range m.wwanStatus.Networks {
+		logicalLabel := network.LogicalLabel
+		cellCfg, found := m.lookupCellConfig(logicalLabel)
+		if !found {
+			continue
+		}
+		failover := m.cellFailover[logicalLabel]
+		if failover == nil {
+			failover = &cellFailover{}
+			m.cellFailover[logicalLabel] = failover
+		}
+		profiles := cellCfg.SimProfiles
+		if !cellCfg.Failover.Enable || len(profiles) < 2 {
+			if failover.profileIndex != 0 {
+				failover.profileIndex = 0
+				changed = true
+			}
+			continue
+		}
+		if failover.profileIndex >= len(profiles) {
+			failover.profileIndex = 0
+			changed = true
+		}
+		if network.ProbeError == "" {
+			failover.probeFailingSince = time.Time{}
+		} else if failover.probeFailingSince.IsZero() {
+			failover.probeFailingSince = now
+		}
+		var reason string
+		probeTimeout := time.Duration(cellCfg.Failover.ProbeFailureTimeout) * time.Second
+		if probeTimeout == 0 {
+			probeTimeout = types.DefaultCellProbeFailureTimeout * time.Second
+		}
+		if !failover.probeFailingSince.IsZero() &&
+			now.Sub(failover.probeFailingSince) >= probeTimeout {
+			reason = "connectivity probe is failing: " + network.ProbeError
+		}
+		exhausted := func(index int) bool {
+			if !cellCfg.Failover.OnQuotaExhausted {
+				return false
+			}
+			usage, found := m.lookupSlotUsage(logicalLabel, profiles[index].SimSlot)
+			return found && usage.QuotaState == types.WwanQuotaStateHardLimit
+		}
+		if reason == "" && exhausted(failover.profileIndex) {
+			reason = "data quota is exhausted"
+		}
+		nextIndex := failover.profileIndex
+		if reason != "" {
+			for i := 1; i < len(profiles); i++ {
+				index := (failover.profileIndex + i) % len(profiles)
+				if !exhausted(index) {
+					nextIndex = index
+					break
+				}
+			}
+		} else if failover.profileIndex != 0 && cellCfg.Failover.RevertInterval > 0 {
+			revertInterval := time.Duration(cellCfg.Failover.RevertInterval) * time.Second
+			if now.Sub(failover.switchedAt) >= revertInterval && !exhausted(0) {
+				reason = "reverting to the primary SIM profile"
+				nextIndex = 0
+			}
+		}
+		if nextIndex == failover.profileIndex {
+			continue
+		}
+		m.Log.Noticef("Switching cellular modem %s from SIM slot %d to SIM slot %d: %s",
+			logicalLabel, profiles[failover.profileIndex].SimSlot,
+			profiles[nextIndex].SimSlot, reason)
+		failover.profileIndex = nextIndex
+		failover.switchedAt = now
+		// Give the other SIM card the full timeout.
+		failover.probeFailingSince = time.Time{}
+		changed = true
+	}
+	return changed
+}
+
+// lookupSlotUsage returns the most recently updated data usage recorded
+// for the given SIM slot of the modem.
+func (m *DpcManager) lookupSlotUsage(logicalLabel string,
+	simSlot uint8) (usage types.WwanDataUsage, found bool) {
+	for _, item := range m.wwanDataUsage {
+		if item.LogicalLabel != logicalLabel || item.SimSlot != simSlot {
+			continue
+		}
+		if !found || item.LastUpdate.After(usage.LastUpdate) {
+			usage = item
+			found = true
+		}
+	}
+	return usage, found
+}
+
+// updateCellular re-evaluates data usage and SIM failover and applies changes.
+func (m *DpcManager) updateCellular(ctx context.Context, withMetrics bool) {
+	now := time.Now()
+	var blockedChanged bool
+	if withMetrics {
+		blockedChanged = m.updateWwanDataUsage(now)
+	}
+	failoverChanged := m.updateCellFailover(now)
+	if blockedChanged || failoverChanged {
+		m.reconcileStatus = m.DpcReconciler.Reconcile(ctx, m.reconcilerArgs())
+	}
+	if blockedChanged {
+		m.updateDNS()
+	}
+}
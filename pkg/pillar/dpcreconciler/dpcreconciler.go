@@ -30,6 +30,12 @@ type Args struct {
 	AA  types.AssignableAdapters
 	RS  types.RadioSilence
 	GCP types.ConfigItemValueMap
+	// SIMSlots : SIM slot selected for each cellular modem (key = logical label),
+	// see CellConfig.SimProfiles.
+	SIMSlots map[string]uint8
+	// AppsBlockedPorts : interface names of ports which are not allowed
+	// to forward application traffic (see CellDataQuota).
+	AppsBlockedPorts []string
 }
 
 // ReconcileStatus : state data related to config reconciliation.
@@ -343,6 +343,12 @@ func (r *LinuxDpcReconciler) Reconcile(ctx context.Context, args Args) Reconcile
 		if r.rsChanged(args.RS) {
 			r.addPendingReconcile(WirelessSG, "RS change", false)
 		}
+		if r.simSlotsChanged(args.SIMSlots) {
+			r.addPendingReconcile(WirelessSG, "SIM slot change", false)
+		}
+		if r.appsBlockedPortsChanged(args.AppsBlockedPorts) {
+			r.addPendingReconcile(ACLsSG, "change of ports blocked for apps", false)
+		}
 	}
 	if r.pendingReconcile.isPending {
 		reconcileSG = r.pendingReconcile.forSubGraph
@@ -381,9 +387,9 @@ func (r *LinuxDpcReconciler) Reconcile(ctx context.Context, args Args) Reconcile
 		case L3SG:
 			intSG = r.getIntendedL3Cfg(args.DPC)
 		case WirelessSG:
-			intSG = r.getIntendedWirelessCfg(args.DPC, args.AA, args.RS)
+			intSG = r.getIntendedWirelessCfg(args.DPC, args.AA, args.RS, args.SIMSlots)
 		case ACLsSG:
-			intSG = r.getIntendedACLs(args.DPC, args.GCP)
+			intSG = r.getIntendedACLs(args.DPC, args.GCP, args.AppsBlockedPorts)
 		default:
 			// Only these top-level subgraphs are used for selective-reconcile for now.
 			r.Log.Fatalf("Unexpected SG select for reconcile: %s", reconcileSG)
@@ -566,6 +572,30 @@ func (r *LinuxDpcReconciler) rsChanged(newRS types.RadioSilence) bool {
 	return r.prevArgs.RS.Imposed != newRS.Imposed
 }
 
+func (r *LinuxDpcReconciler) simSlotsChanged(newSlots map[string]uint8) bool {
+	if len(r.prevArgs.SIMSlots) != len(newSlots) {
+		return true
+	}
+	for label, slot := range newSlots {
+		if prevSlot, found := r.prevArgs.SIMSlots[label]; !found || prevSlot != slot {
+			return true
+		}
+	}
+	return false
+}
+
+func (r *LinuxDpcReconciler) appsBlockedPortsChanged(newPorts []string) bool {
+	if len(r.prevArgs.AppsBlockedPorts) != len(newPorts) {
+		return true
+	}
+	for i := range newPorts {
+		if r.prevArgs.AppsBlockedPorts[i] != newPorts[i] {
+			return true
+		}
+	}
+	return false
+}
+
 func (r *LinuxDpcReconciler) gcpChanged(newGCP types.ConfigItemValueMap) bool {
 	prevAuthKeys := r.prevArgs.GCP.GlobalValueString(types.SSHAuthorizedKeys)
 	newAuthKeys := newGCP.GlobalValueString(types.SSHAuthorizedKeys)
@@ -577,6 +607,11 @@ func (r *LinuxDpcReconciler) gcpChanged(newGCP types.ConfigItemValueMap) bool {
 	if prevAllowVNC != newAllowVNC {
 		return true
 	}
+	prevBrokered := r.prevArgs.GCP.GlobalValueBool(types.ConsoleBrokered)
+	newBrokered := newGCP.GlobalValueBool(types.ConsoleBrokered)
+	if prevBrokered != newBrokered {
+		return true
+	}
 	return false
 }
 
@@ -693,8 +728,10 @@ func (r *LinuxDpcReconciler) updateIntendedState(args Args) {
 	r.intendedState.PutSubGraph(r.getIntendedPhysicalIO(args.DPC))
 	r.intendedState.PutSubGraph(r.getIntendedLogicalIO(args.DPC))
 	r.intendedState.PutSubGraph(r.getIntendedL3Cfg(args.DPC))
-	r.intendedState.PutSubGraph(r.getIntendedWirelessCfg(args.DPC, args.AA, args.RS))
-	r.intendedState.PutSubGraph(r.getIntendedACLs(args.DPC, args.GCP))
+	r.intendedState.PutSubGraph(r.getIntendedWirelessCfg(args.DPC, args.AA, args.RS,
+		args.SIMSlots))
+	r.intendedState.PutSubGraph(r.getIntendedACLs(args.DPC, args.GCP,
+		args.AppsBlockedPorts))
 }
 
 func (r *LinuxDpcReconciler) getIntendedGlobalCfg(dpc types.DevicePortConfig) dg.Graph {
@@ -1061,7 +1098,8 @@ func (r *LinuxDpcReconciler) getIntendedArps(dpc types.DevicePortConfig) dg.Grap
 }
 
 func (r *LinuxDpcReconciler) getIntendedWirelessCfg(dpc types.DevicePortConfig,
-	aa types.AssignableAdapters, radioSilence types.RadioSilence) dg.Graph {
+	aa types.AssignableAdapters, radioSilence types.RadioSilence,
+	simSlots map[string]uint8) dg.Graph {
 	graphArgs := dg.InitArgs{
 		Name:        WirelessSG,
 		Description: "Configuration for wireless connectivity",
@@ -1071,7 +1109,7 @@ func (r *LinuxDpcReconciler) getIntendedWirelessCfg(dpc types.DevicePortConfig,
 	intendedWirelessCfg.PutItem(
 		r.getIntendedWlanConfig(dpc, rsImposed), nil)
 	intendedWirelessCfg.PutItem(
-		r.getIntendedWwanConfig(dpc, aa, rsImposed), nil)
+		r.getIntendedWwanConfig(dpc, aa, rsImposed, simSlots), nil)
 	return intendedWirelessCfg
 }
 
@@ -1161,7 +1199,7 @@ func (r *LinuxDpcReconciler) getWifiCredentials(wifi types.WifiConfig) (types.En
 }
 
 func (r *LinuxDpcReconciler) getIntendedWwanConfig(dpc types.DevicePortConfig,
-	aa types.AssignableAdapters, radioSilence bool) dg.Item {
+	aa types.AssignableAdapters, radioSilence bool, simSlots map[string]uint8) dg.Item {
 	config := types.WwanConfig{RadioSilence: radioSilence, Networks: []types.WwanNetworkConfig{}}
 
 	for _, port := range dpc.Ports {
@@ -1180,6 +1218,13 @@ func (r *LinuxDpcReconciler) getIntendedWwanConfig(dpc types.DevicePortConfig,
 		}
 		// XXX Limited to a single APN for now
 		cellCfg := port.WirelessCfg.Cellular[0]
+		apn := cellCfg.APN
+		simSlot := simSlots[port.Logicallabel]
+		for _, profile := range cellCfg.SimProfiles {
+			if profile.SimSlot == simSlot && profile.APN != "" {
+				apn = profile.APN
+			}
+		}
 		network := types.WwanNetworkConfig{
 			LogicalLabel: port.Logicallabel,
 			PhysAddrs: types.WwanPhysAddrs{
@@ -1187,20 +1232,21 @@ func (r *LinuxDpcReconciler) getIntendedWwanConfig(dpc types.DevicePortConfig,
 				USB:       ioBundle.UsbAddr,
 				PCI:       ioBundle.PciLong,
 			},
-			Apns: []string{cellCfg.APN},
+			Apns: []string{apn},
 			Probe: types.WwanProbe{
 				Disable: cellCfg.DisableProbe,
 				Address: cellCfg.ProbeAddr,
 			},
 			LocationTracking: cellCfg.LocationTracking,
+			SimSlot:          simSlot,
 		}
 		config.Networks = append(config.Networks, network)
 	}
 	return generic.Wwan{Config: config}
 }
 
-func (r *LinuxDpcReconciler) getIntendedACLs(
-	dpc types.DevicePortConfig, gcp types.ConfigItemValueMap) dg.Graph {
+func (r *LinuxDpcReconciler) getIntendedACLs(dpc types.DevicePortConfig,
+	gcp types.ConfigItemValueMap, appsBlockedPorts []string) dg.Graph {
 	graphArgs := dg.InitArgs{
 		Name:        ACLsSG,
 		Description: "Device-wide ACLs",
@@ -1258,7 +1304,9 @@ func (r *LinuxDpcReconciler) getIntendedACLs(
 	}
 	filterV4Rules = append(filterV4Rules, allowLocalVNCv4)
 	filterV6Rules = append(filterV6Rules, allowLocalVNCv6)
-	gcpAllowRemoteVNC := gcp.GlobalValueBool(types.AllowAppVnc)
+	// Brokered console sessions are the only way in from outside
+	gcpAllowRemoteVNC := gcp.GlobalValueBool(types.AllowAppVnc) &&
+		!gcp.GlobalValueBool(types.ConsoleBrokered)
 	if !gcpAllowRemoteVNC {
 		blockRemoteVNC := linux.IptablesRule{
 			Args: []string{"-p", "tcp", "--dport", "5900:5999",
@@ -1403,5 +1451,38 @@ func (r *LinuxDpcReconciler) getIntendedACLs(
 		Rules:      outputV6Rules,
 		PreCreated: true,
 	}, nil)
+
+	// Do not forward application traffic through ports with data quota
+	// running out (see CellDataQuota).
+	// The chain is pre-created, removing the item only flushes it.
+	if len(appsBlockedPorts) == 0 {
+		return intendedACLs
+	}
+	var forwardRules []linux.IptablesRule
+	for _, ifName := range appsBlockedPorts {
+		forwardRules = append(forwardRules,
+			linux.IptablesRule{
+				Args:        []string{"-o", ifName, "-j", "DROP"},
+				Description: "Block application traffic leaving via " + ifName,
+			},
+			linux.IptablesRule{
+				Args:        []string{"-i", ifName, "-j", "DROP"},
+				Description: "Block application traffic coming via " + ifName,
+			})
+	}
+	intendedACLs.PutItem(linux.IptablesChain{
+		ChainName:  "FORWARD" + iptables.DeviceChainSuffix,
+		Table:      "mangle",
+		ForIPv6:    false,
+		Rules:      forwardRules,
+		PreCreated: true,
+	}, nil)
+	intendedACLs.PutItem(linux.IptablesChain{
+		ChainName:  "FORWARD" + iptables.DeviceChainSuffix,
+		Table:      "mangle",
+		ForIPv6:    true,
+		Rules:      forwardRules,
+		PreCreated: true,
+	}, nil)
 	return intendedACLs
 }
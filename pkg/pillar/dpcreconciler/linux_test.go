@@ -573,6 +573,10 @@ func TestWireless(test *testing.T) {
 					Cellular: []types.CellConfig{
 						{
 							APN: "my-apn",
+							SimProfiles: []types.CellSimProfile{
+								{SimSlot: 1},
+								{SimSlot: 2, APN: "backup-apn"},
+							},
 						},
 					},
 				},
@@ -652,6 +656,25 @@ func TestWireless(test *testing.T) {
 	t.Expect(itemCountWithType(generic.DhcpcdTypename)).To(Equal(1))
 	t.Expect(itemCountWithType(generic.RouteTypename)).To(Equal(0))
 	t.Expect(itemCountWithType(generic.ArpTypename)).To(Equal(0))
+
+	// Switch to the backup SIM and block application traffic on wwan0.
+	simSlots := map[string]uint8{"mock-wwan0": 2}
+	ctx = reconciler.MockRun(context.Background())
+	status = dpcReconciler.Reconcile(ctx, dpcrec.Args{GCP: *gcp, DPC: dpc, AA: aa, RS: rs,
+		SIMSlots: simSlots, AppsBlockedPorts: []string{"wwan0"}})
+	t.Expect(status.Error).To(BeNil())
+	t.Expect(itemDescription(wwan)).To(ContainSubstring("Apns:[backup-apn]"))
+	t.Expect(itemDescription(wwan)).To(ContainSubstring("SimSlot:2"))
+	forwardChain := dg.Reference(linux.IptablesChain{Table: "mangle", ChainName: "FORWARD-device"})
+	t.Expect(itemDescription(forwardChain)).To(ContainSubstring("-o wwan0 -j DROP"))
+	t.Expect(itemDescription(forwardChain)).To(ContainSubstring("-i wwan0 -j DROP"))
+
+	// Unblock application traffic.
+	ctx = reconciler.MockRun(context.Background())
+	status = dpcReconciler.Reconcile(ctx, dpcrec.Args{GCP: *gcp, DPC: dpc, AA: aa, RS: rs,
+		SIMSlots: simSlots})
+	t.Expect(status.Error).To(BeNil())
+	t.Expect(itemIsCreated(forwardChain)).To(BeFalse())
 }
 
 func TestVlansAndBonds(test *testing.T) {
@@ -253,3 +253,24 @@ func GetSerialNumberForDisk(diskName string) (string, error) {
 
 	return diskSmartInfo.SerialNumber, nil
 }
+
+// GetDiskNameBySerial takes a serial number as input and returns name
+// of the disk (from dev directory, for example /dev/sda) with this serial number
+func GetDiskNameBySerial(serial string) (string, error) {
+	block, err := ghw.Block()
+	if err != nil {
+		return "", fmt.Errorf("error getting block storage info: %v", err)
+	}
+	for _, disk := range block.Disks {
+		diskName := fmt.Sprintf("/dev/%v", disk.Name)
+		// prefer the serial number we report in info
+		diskSerial, err := GetSerialNumberForDisk(diskName)
+		if err != nil {
+			diskSerial = disk.SerialNumber
+		}
+		if diskSerial == serial {
+			return diskName, nil
+		}
+	}
+	return "", fmt.Errorf("disk with serial number %s not found", serial)
+}
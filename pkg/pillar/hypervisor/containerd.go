@@ -75,6 +75,7 @@ func (ctx ctrdContext) setupSpec(status *types.DomainStatus, config *types.Domai
 	}
 	spec.UpdateFromDomain(config)
 	spec.UpdateMounts(status.DiskStatusList)
+	spec.UpdateBlockIO(status.DiskStatusList)
 	spec.UpdateVifList(status.VifList)
 	spec.UpdateEnvVar(status.EnvVariables)
 
@@ -85,6 +86,12 @@ func (ctx ctrdContext) Setup(status types.DomainStatus, config types.DomainConfi
 	if status.OCIConfigDir == "" {
 		return logError("failed to run domain %s: not based on an OCI image", status.DomainName)
 	}
+	if config.Vtpm != types.VtpmNone {
+		return logError("vTPM of domain %s is not supported for containers", status.DomainName)
+	}
+	if config.SecureBoot {
+		return logError("Secure Boot of domain %s is not supported for containers", status.DomainName)
+	}
 
 	spec, err := ctx.setupSpec(&status, &config, status.OCIConfigDir)
 	if err != nil {
@@ -108,11 +115,19 @@ func (ctx ctrdContext) Setup(status types.DomainStatus, config types.DomainConfi
 		Source:      vifsTaskResolv,
 		Destination: "/etc/resolv.conf",
 		Options:     []string{"rbind", "ro"}})
+	spec.UpdatePod(config.PodContainers)
 
 	if err := spec.CreateContainer(true); err != nil {
 		return logError("Failed to create container for task %s from %v: %v", status.DomainName, config, err)
 	}
 
+	for _, pc := range config.PodContainers {
+		if err := ctx.setupPodContainer(status, config, pc, vifsTaskResolv); err != nil {
+			return logError("Failed to create pod container %s for task %s: %v",
+				pc.Name, status.DomainName, err)
+		}
+	}
+
 	return nil
 }
 
@@ -125,16 +140,27 @@ func (ctx ctrdContext) Create(domainName string, cfgFilename string, config *typ
 	defer done()
 	_ = ctx.ctrdClient.CtrStopContainer(ctrdCtx, domainName, true)
 
-	return ctx.ctrdClient.CtrCreateTask(ctrdCtx, domainName)
+	pid, err := ctx.ctrdClient.CtrCreateTask(ctrdCtx, domainName)
+	if err != nil {
+		return pid, err
+	}
+	// the containers of the pod share the network of the main task
+	return pid, ctx.createPodTasks(ctrdCtx, domainName, pid)
 }
 
 func (ctx ctrdContext) Start(domainName string) error {
 	ctrdCtx, done := ctx.ctrdClient.CtrNewUserServicesCtx()
 	defer done()
+	if err := ctx.runInitContainers(ctrdCtx, domainName); err != nil {
+		return err
+	}
 	err := ctx.ctrdClient.CtrStartTask(ctrdCtx, domainName)
 	if err != nil {
 		return err
 	}
+	if err := ctx.startSidecars(ctrdCtx, domainName); err != nil {
+		return err
+	}
 
 	// now lets wait for task to reach a steady state or for >10sec to elapse
 	for i := 0; i < 10; i++ {
@@ -151,12 +177,14 @@ func (ctx ctrdContext) Start(domainName string) error {
 func (ctx ctrdContext) Stop(domainName string, force bool) error {
 	ctrdCtx, done := ctx.ctrdClient.CtrNewUserServicesCtx()
 	defer done()
+	ctx.stopPodContainers(ctrdCtx, domainName, force, false)
 	return ctx.ctrdClient.CtrStopContainer(ctrdCtx, domainName, force)
 }
 
 func (ctx ctrdContext) Delete(domainName string) error {
 	ctrdCtx, done := ctx.ctrdClient.CtrNewUserServicesCtx()
 	defer done()
+	ctx.stopPodContainers(ctrdCtx, domainName, true, true)
 	if err := ctx.ctrdClient.CtrDeleteContainer(ctrdCtx, domainName); err != nil {
 		return err
 	}
@@ -178,6 +206,15 @@ func (ctx ctrdContext) Annotations(domainName string) (map[string]string, error)
 	return ctx.ctrdClient.CtrGetAnnotations(ctrdCtx, domainName)
 }
 
+// ctrdTaskStates maps the status of a containerd task to the state of a domain
+var ctrdTaskStates = map[string]types.SwState{
+	"created": types.INSTALLED,
+	"running": types.RUNNING,
+	"pausing": types.PAUSING,
+	"paused":  types.PAUSED,
+	"stopped": types.HALTED,
+}
+
 func (ctx ctrdContext) Info(domainName string) (int, types.SwState, error) {
 	ctrdCtx, done := ctx.ctrdClient.CtrNewUserServicesCtx()
 	defer done()
@@ -190,14 +227,7 @@ func (ctx ctrdContext) Info(domainName string) (int, types.SwState, error) {
 		return 0, types.BROKEN, logError("task broke with exit status %d", exit)
 	}
 
-	stateMap := map[string]types.SwState{
-		"created": types.INSTALLED,
-		"running": types.RUNNING,
-		"pausing": types.PAUSING,
-		"paused":  types.PAUSED,
-		"stopped": types.HALTED,
-	}
-	if effectiveDomainState, matched := stateMap[status]; !matched {
+	if effectiveDomainState, matched := ctrdTaskStates[status]; !matched {
 		err := fmt.Errorf("task %s happens to be in an unexpected state %s",
 			domainName, status)
 		logrus.Error(err)
@@ -233,6 +263,36 @@ func (ctx ctrdContext) GetHostCPUMem() (types.HostMemory, error) {
 	return selfDomCPUMem()
 }
 
+// ThrottleDisk changes the block I/O throttling of a running task
+func (ctx ctrdContext) ThrottleDisk(domainName string, diskStatusList []types.DiskStatus, index int, limits types.VolumeIOLimits) error {
+	if index < 0 || index >= len(diskStatusList) {
+		return logError("no disk %d for domain %s", index, domainName)
+	}
+	disks := make([]types.DiskStatus, len(diskStatusList))
+	copy(disks, diskStatusList)
+	disks[index].IOLimits = limits
+	ctrdCtx, done := ctx.ctrdClient.CtrNewUserServicesCtx()
+	defer done()
+	if err := ctx.ctrdClient.CtrUpdateBlockIO(ctrdCtx, domainName,
+		containerd.BlockIOForDisks(disks)); err != nil {
+		return logError("throttling disk %s of domain %s failed: %v",
+			disks[index].DisplayName, domainName, err)
+	}
+	return nil
+}
+
+// GetDiskIOStats returns the I/O counters of the block devices of the disks
+// of a running task
+func (ctx ctrdContext) GetDiskIOStats(domainName string, diskStatusList []types.DiskStatus) ([]types.DiskIOMetric, error) {
+	ctrdCtx, done := ctx.ctrdClient.CtrNewUserServicesCtx()
+	defer done()
+	metric, err := ctx.ctrdClient.CtrGetContainerMetrics(ctrdCtx, domainName)
+	if err != nil {
+		return nil, err
+	}
+	return containerd.DiskIOForDisks(diskStatusList, metric.Blkio), nil
+}
+
 const nanoSecToSec uint64 = 1000000000
 
 func (ctx ctrdContext) GetDomsCPUMem() (map[string]types.DomainMetric, error) {
@@ -246,6 +306,10 @@ func (ctx ctrdContext) GetDomsCPUMem() (map[string]types.DomainMetric, error) {
 	}
 
 	for _, id := range ids {
+		// the containers of a pod are accounted in the cgroup of their domain
+		if containerd.IsPodContainerID(id) {
+			continue
+		}
 		var usedMem, maxUsedMem, availMem, totalMem uint32
 		var usedMemPerc float64
 		var cpuTotal uint64
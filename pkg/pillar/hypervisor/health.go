@@ -0,0 +1,99 @@
+// Copyright (c) 2022 Zededa, Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package hypervisor
+
+import (
+	"bufio"
+	"fmt"
+	"net"
+	"net/http"
+	"os"
+	"runtime"
+	"strconv"
+	"time"
+
+	"github.com/lf-edge/eve/pkg/pillar/types"
+	"golang.org/x/sys/unix"
+)
+
+// CheckHealth runs a health check of the main container of a domain. The
+// probes connect to localhost in the network namespace of the container.
+func (ctx ctrdContext) CheckHealth(domainName string, check types.HealthCheck) (string, error) {
+	ctrdCtx, done := ctx.ctrdClient.CtrNewUserServicesCtx()
+	defer done()
+	if check.Type == types.HealthCheckExec {
+		return ctx.ctrdClient.CtrExecHealthCheck(ctrdCtx, domainName,
+			check.Command, check.Timeout)
+	}
+	pid, _, status, err := ctx.ctrdClient.CtrContainerInfo(ctrdCtx, domainName)
+	if err != nil {
+		return "", err
+	}
+	if status != "running" {
+		return "", fmt.Errorf("task %s is %s", domainName, status)
+	}
+	var output string
+	err = inNetNamespace(pid, func() error {
+		var err error
+		output, err = probe(check)
+		return err
+	})
+	return output, err
+}
+
+// probe connects to the port of a TCP or HTTP health check. The socket is
+// created by the calling thread hence in its network namespace.
+func probe(check types.HealthCheck) (string, error) {
+	address := net.JoinHostPort("127.0.0.1", strconv.Itoa(int(check.Port)))
+	conn, err := net.DialTimeout("tcp4", address, check.Timeout)
+	if err != nil {
+		return "", err
+	}
+	defer conn.Close()
+	if check.Type != types.HealthCheckHTTP {
+		return fmt.Sprintf("connected to port %d", check.Port), nil
+	}
+	if err := conn.SetDeadline(time.Now().Add(check.Timeout)); err != nil {
+		return "", err
+	}
+	req, err := http.NewRequest(http.MethodGet, "http://"+address+check.HTTPPath, nil)
+	if err != nil {
+		return "", err
+	}
+	req.Close = true
+	if err := req.Write(conn); err != nil {
+		return "", err
+	}
+	resp, err := http.ReadResponse(bufio.NewReader(conn), req)
+	if err != nil {
+		return "", err
+	}
+	resp.Body.Close()
+	if resp.StatusCode < 200 || resp.StatusCode >= 400 {
+		return resp.Status, fmt.Errorf("GET %s returned %s", check.HTTPPath, resp.Status)
+	}
+	return resp.Status, nil
+}
+
+// inNetNamespace calls f on a thread in the network namespace of the
+// process pid. The thread is never switched back: it runs a goroutine of
+// its own which exits locked to it, hence the thread is terminated.
+func inNetNamespace(pid int, f func() error) error {
+	result := make(chan error, 1)
+	go func() {
+		runtime.LockOSThread()
+		target, err := os.Open(fmt.Sprintf("/proc/%d/ns/net", pid))
+		if err != nil {
+			result <- err
+			return
+		}
+		defer target.Close()
+		if err := unix.Setns(int(target.Fd()), unix.CLONE_NEWNET); err != nil {
+			result <- fmt.Errorf("cannot enter the network of %d: %v", pid, err)
+			return
+		}
+		result <- f()
+	}()
+	return <-result
+}
@@ -27,6 +27,73 @@ type Hypervisor interface {
 	GetCapabilities() (*types.Capabilities, error)
 }
 
+// DiskResizer is implemented by hypervisors which can grow a disk
+// of a running domain. index is the position of the disk in diskStatusList.
+type DiskResizer interface {
+	ResizeDisk(domainName string, diskStatusList []types.DiskStatus, index int, size uint64) error
+}
+
+// DiskBackuper is implemented by hypervisors which can copy a disk
+// of a running domain. index is the position of the disk in diskStatusList
+// and target an existing qcow2 image at least as large as the disk.
+type DiskBackuper interface {
+	BackupDisk(domainName string, diskStatusList []types.DiskStatus, index int, target string) error
+}
+
+// DiskThrottler is implemented by hypervisors which can change the I/O
+// limits of a disk of a running domain. index is the position of the disk
+// in diskStatusList. Zero limits mean unlimited.
+type DiskThrottler interface {
+	ThrottleDisk(domainName string, diskStatusList []types.DiskStatus, index int, limits types.VolumeIOLimits) error
+}
+
+// DiskIOStatter is implemented by hypervisors which report the I/O
+// counters of the disks of a running domain
+type DiskIOStatter interface {
+	GetDiskIOStats(domainName string, diskStatusList []types.DiskStatus) ([]types.DiskIOMetric, error)
+}
+
+// MemoryBallooner is implemented by hypervisors which can change the
+// memory of a running domain with a balloon device. The memory is in
+// bytes; the returned metric only has the actual memory of the guest and
+// its statistics.
+type MemoryBallooner interface {
+	SetBalloonTarget(domainName string, target uint64) error
+	GetBalloonStats(domainName string) (types.BalloonMetric, error)
+}
+
+// PodManager is implemented by hypervisors which run the init and sidecar
+// containers of a pod next to the main container of a domain
+type PodManager interface {
+	GetPodContainerInfo(domainName string) ([]types.PodContainerInfo, error)
+	RestartPodContainer(domainName string, name string) error
+}
+
+// HealthChecker is implemented by hypervisors which can run a health check
+// of a running domain. Returns the output of the check, and an error if it
+// failed.
+type HealthChecker interface {
+	CheckHealth(domainName string, check types.HealthCheck) (string, error)
+}
+
+// GuestAgent is implemented by hypervisors which talk to an agent in the
+// guest of a running domain. The calls fail if the guest has no agent.
+type GuestAgent interface {
+	GuestShutdown(domainName string) error
+	SyncGuestTime(domainName string) error
+	GetGuestInfo(domainName string) (types.GuestInfo, error)
+	// FreezeGuestFs and ThawGuestFs return how many filesystems of the
+	// guest they froze or thawed
+	FreezeGuestFs(domainName string) (int, error)
+	ThawGuestFs(domainName string) (int, error)
+}
+
+// SerialConsoler is implemented by hypervisors which serve the serial
+// console of a running domain on a UNIX socket
+type SerialConsoler interface {
+	SerialConsoleSocket(domainName string) string
+}
+
 type hypervisorDesc struct {
 	constructor func() Hypervisor
 	dom0handle  string
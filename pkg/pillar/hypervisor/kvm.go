@@ -7,8 +7,11 @@ import (
 	"fmt"
 	"io/ioutil"
 	"os"
+	"os/exec"
 	"runtime"
+	"strconv"
 	"strings"
+	"syscall"
 	"text/template"
 	"time"
 
@@ -16,6 +19,8 @@ import (
 	"github.com/lf-edge/eve/pkg/pillar/agentlog"
 	"github.com/lf-edge/eve/pkg/pillar/containerd"
 	"github.com/lf-edge/eve/pkg/pillar/types"
+	"github.com/lf-edge/eve/pkg/pillar/utils"
+	"github.com/lf-edge/eve/pkg/pillar/vault"
 	"github.com/sirupsen/logrus"
 )
 
@@ -28,6 +33,17 @@ const minQemuOverHead = int64(600 * 1024 * 1024)
 
 const minUringKernelTag = uint64((5 << 16) | (4 << 8) | (72 << 0))
 
+// The balloon device of ballooned domains and how often the guest reports
+// its statistics
+const (
+	kvmBalloonDevice        = "balloon0"
+	kvmBalloonStatsInterval = 10 * time.Second
+)
+
+// SCHED_FIFO priority of the vcpus of realtime domains, below the kernel
+// threads which run at 50
+const kvmRealtimePriority = 40
+
 // We build device model around PCIe topology according to best practices
 //    https://github.com/qemu/qemu/blob/master/docs/pcie.txt
 // and
@@ -71,6 +87,9 @@ const qemuConfTemplate = `# This file is automatically generated by domainmgr
 {{- if .BootLoader }}
   firmware = "{{.BootLoader}}"
 {{- end -}}
+{{- if and .SecureBoot (ne .Machine "virt") }}
+  smm = "on"
+{{- end -}}
 {{- if .Kernel }}
   kernel = "{{.Kernel}}"
 {{- end -}}
@@ -108,7 +127,7 @@ const qemuConfTemplate = `# This file is automatically generated by domainmgr
   caching-mode = "on"
 {{ end }}
 [realtime]
-  mlock = "off"
+  mlock = "{{if .Realtime}}on{{else}}off{{end}}"
 
 [chardev "charmonitor"]
   backend = "socket"
@@ -157,6 +176,17 @@ const qemuConfTemplate = `# This file is automatically generated by domainmgr
   chardev = "charserial0"
   name = "org.lfedge.eve.console.0"
 
+[chardev "charqga"]
+  backend = "socket"
+  path = "` + kvmStateDir + `{{.DisplayName}}/qga"
+  server = "on"
+  wait = "off"
+
+[device]
+  driver = "virtserialport"
+  chardev = "charqga"
+  name = "org.qemu.guest_agent.0"
+
 {{if .EnableVnc}}
 [vnc "default"]
   vnc = "0.0.0.0:{{if .VncDisplay}}{{.VncDisplay}}{{else}}0{{end}}"
@@ -239,11 +269,14 @@ const qemuDiskTemplate = `
   fsdriver = "local"
   security_model = "none"
   path = "{{.FileLocation}}"
+{{- if .ReadOnly}}
+  readonly = "on"
+{{- end}}
 
 [device "fs{{.DiskID}}"]
   driver = "virtio-9p-pci"
   fsdev = "fsdev{{.DiskID}}"
-  mount_tag = "share_dir"
+  mount_tag = "{{if .MountTag}}{{.MountTag}}{{else}}share_dir{{end}}"
   addr = "{{printf "0x%x" .PCIId}}"
 {{else}}
 [device "pci.{{.PCIId}}"]
@@ -259,6 +292,21 @@ const qemuDiskTemplate = `
   aio = "{{.AioType}}"
   cache = "writeback"
   if = "none"
+{{- if .IOLimits.IsSet}}
+  throttling.group = "drive-virtio-disk{{.DiskID}}"
+{{- end}}
+{{- if .IOLimits.ReadBps}}
+  throttling.bps-read = "{{.IOLimits.ReadBps}}"
+{{- end}}
+{{- if .IOLimits.WriteBps}}
+  throttling.bps-write = "{{.IOLimits.WriteBps}}"
+{{- end}}
+{{- if .IOLimits.ReadIops}}
+  throttling.iops-read = "{{.IOLimits.ReadIops}}"
+{{- end}}
+{{- if .IOLimits.WriteIops}}
+  throttling.iops-write = "{{.IOLimits.WriteIops}}"
+{{- end}}
 {{if .ReadOnly}}  readonly = "on"{{end}}
 {{- if eq .Devtype "legacy"}}
 [device "ahci.{{.PCIId}}"]
@@ -332,6 +380,55 @@ const qemuPciPassthruTemplate = `
   x-igd-opregion = "on"
 {{- end -}}
 `
+const qemuBalloonTemplate = `
+[device "pci.{{.PCIId}}"]
+  driver = "pcie-root-port"
+  port = "1{{.PCIId}}"
+  chassis = "{{.PCIId}}"
+  bus = "pcie.0"
+  addr = "{{printf "0x%x" .PCIId}}"
+
+[device "` + kvmBalloonDevice + `"]
+  driver = "virtio-balloon-pci"
+  deflate-on-oom = "on"
+  bus = "pci.{{.PCIId}}"
+  addr = "0x0"
+`
+
+const qemuOvmfTemplate = `
+[drive "drive-ovmf-code"]
+  if = "pflash"
+  format = "raw"
+  readonly = "on"
+  file = "{{.Code}}"
+
+[drive "drive-ovmf-vars"]
+  if = "pflash"
+  format = "raw"
+  file = "{{.Vars}}"
+{{- if and .SecureBoot (ne .Machine "virt") }}
+
+[global]
+  driver = "cfi.pflash01"
+  property = "secure"
+  value = "on"
+{{- end }}
+`
+
+const qemuVtpmTemplate = `
+[chardev "charvtpm"]
+  backend = "socket"
+  path = "` + kvmStateDir + `{{.DisplayName}}/swtpm.sock"
+
+[tpmdev "vtpm0"]
+  type = "emulator"
+  chardev = "charvtpm"
+
+[device "vtpm"]
+  driver = "{{.Driver}}"
+  tpmdev = "vtpm0"
+`
+
 const qemuSerialTemplate = `
 [chardev "charserial-usr{{.ID}}"]
   backend = "tty"
@@ -350,16 +447,21 @@ const qemuUsbHostTemplate = `
 `
 
 const kvmStateDir = "/run/hypervisor/kvm/"
+const swtpmExec = "/usr/bin/swtpm"
 const sysfsPciDevices = "/sys/bus/pci/devices/"
 const sysfsVfioPciBind = "/sys/bus/pci/drivers/vfio-pci/bind"
 const sysfsPciDriversProbe = "/sys/bus/pci/drivers_probe"
 const vfioDriverPath = "/sys/bus/pci/drivers/vfio-pci"
 
+// diskBackupTimeout bounds a blockdev-backup of a disk of a running domain
+const diskBackupTimeout = 6 * time.Hour
+
 // KVM domains map 1-1 to anchor device model UNIX processes (qemu or firecracker)
 // For every anchor process we maintain the following entry points in the
 // /run/hypervisor/kvm/DOMAIN_NAME:
 //    pid - contains PID of the anchor process
 //    qmp - UNIX domain socket that allows us to talk to anchor process
+//    qga - UNIX domain socket that allows us to talk to the guest agent in the domain, if any
 //   cons - symlink to /dev/pts/X that allows us to talk to the serial console of the domain
 // In addition to that, we also maintain DOMAIN_NAME -> PID mapping in kvmContext, so we don't
 // have to look things up in the filesystem all the time (this also allows us to filter domains
@@ -474,6 +576,12 @@ func (ctx kvmContext) Setup(status types.DomainStatus, config types.DomainConfig
 
 	os.MkdirAll(kvmStateDir+domainName, 0777)
 
+	if config.Vtpm != types.VtpmNone {
+		if err := startSwtpm(domainName, types.VtpmStateDir(domainUUID)); err != nil {
+			return logError("failed to start vTPM of domain %s: %v", domainName, err)
+		}
+	}
+
 	args := []string{ctx.dmExec}
 	args = append(args, dmArgs...)
 	args = append(args, "-name", domainName,
@@ -489,14 +597,20 @@ func (ctx kvmContext) Setup(status types.DomainStatus, config types.DomainConfig
 		return logError("failed to add kvm hypervisor loader to domain %s: %v", status.DomainName, err)
 	}
 
+	// A ballooned domain may use all of its maximum memory
+	memConfig := config
+	if config.Ballooned() {
+		memConfig.Memory = config.MaxMem
+	}
+
 	/* 2.5 % of total memory */
-	qemuOverHead := int64(config.Memory) * 1024 * 25 / 1000
+	qemuOverHead := int64(memConfig.Memory) * 1024 * 25 / 1000
 	if qemuOverHead < minQemuOverHead {
 		qemuOverHead = minQemuOverHead
 	}
 
 	logrus.Debugf("Qemu overhead for domain %s is %d bytes", status.DomainName, qemuOverHead)
-	spec.AdjustMemLimit(config, qemuOverHead)
+	spec.AdjustMemLimit(memConfig, qemuOverHead)
 	spec.Get().Process.Args = args
 	logrus.Infof("Hypervisor args: %v", args)
 
@@ -514,7 +628,17 @@ func (ctx kvmContext) CreateDomConfig(domainName string, config types.DomainConf
 		types.DomainConfig
 	}{ctx.devicemodel, config}
 	tmplCtx.Memory = (config.Memory + 1023) / 1024
+	if config.Ballooned() {
+		// The guest starts with all of its memory
+		tmplCtx.Memory = (config.MaxMem + 1023) / 1024
+	}
 	tmplCtx.DisplayName = domainName
+	if config.HasUefiNvram() {
+		// OVMF boots from flash with its variables in the NVRAM
+		tmplCtx.BootLoader = ""
+	} else if config.SecureBoot {
+		return logError("Secure Boot of domain %s needs UEFI", domainName)
+	}
 
 	// render global device model settings
 	t, _ := template.New("qemu").Parse(qemuConfTemplate)
@@ -522,6 +646,23 @@ func (ctx kvmContext) CreateDomConfig(domainName string, config types.DomainConf
 		return logError("can't write to config file %s (%v)", file.Name(), err)
 	}
 
+	// render the flash of OVMF
+	if config.HasUefiNvram() {
+		ovmfContext := struct {
+			Machine    string
+			Code, Vars string
+			SecureBoot bool
+		}{Machine: ctx.devicemodel, Code: "/usr/lib/xen/boot/ovmf_code.bin",
+			Vars: types.UefiNvramFile(config.UUIDandVersion.UUID), SecureBoot: config.SecureBoot}
+		if config.SecureBoot {
+			ovmfContext.Code = "/usr/lib/xen/boot/ovmf_code_sb.bin"
+		}
+		t, _ = template.New("qemuOvmf").Parse(qemuOvmfTemplate)
+		if err := t.Execute(file, ovmfContext); err != nil {
+			return logError("can't write OVMF to config file %s (%v)", file.Name(), err)
+		}
+	}
+
 	// render disk device model settings
 	diskContext := struct {
 		Machine                          string
@@ -572,6 +713,29 @@ func (ctx kvmContext) CreateDomConfig(domainName string, config types.DomainConf
 		netContext.NetID = netContext.NetID + 1
 	}
 
+	// render the memory balloon
+	if config.Ballooned() {
+		balloonContext := struct {
+			PCIId int
+		}{PCIId: netContext.PCIId}
+		t, _ = template.New("qemuBalloon").Parse(qemuBalloonTemplate)
+		if err := t.Execute(file, balloonContext); err != nil {
+			return logError("can't write balloon to config file %s (%v)", file.Name(), err)
+		}
+		netContext.PCIId = netContext.PCIId + 1
+	}
+
+	// render the software TPM
+	if config.Vtpm != types.VtpmNone {
+		vtpmContext := struct {
+			DisplayName, Driver string
+		}{DisplayName: domainName, Driver: kvmVtpmDriver(ctx.devicemodel, config.Vtpm)}
+		t, _ = template.New("qemuVtpm").Parse(qemuVtpmTemplate)
+		if err := t.Execute(file, vtpmContext); err != nil {
+			return logError("can't write vTPM to config file %s (%v)", file.Name(), err)
+		}
+	}
+
 	// Gather all PCI assignments into a single line
 	var pciAssignments []typeAndPCI
 	// Gather all USB assignments into a single line
@@ -745,6 +909,19 @@ func (ctx kvmContext) Start(domainName string) error {
 		}
 	}
 
+	if cpus, ok := annotations[containerd.EVEOCIRealtimeCPUsLabel]; ok && cpus != "" {
+		if err := pinRealtimeVCPUs(qmpFile, cpus); err != nil {
+			return logError("failed to pin the vcpus of domain %s to %s: %v", domainName, cpus, err)
+		}
+	}
+
+	// Only domains with a balloon device have a balloon
+	if _, err := getQemuBalloon(qmpFile); err == nil {
+		if err := execBalloonStatsPolling(qmpFile, kvmBalloonDevice, kvmBalloonStatsInterval); err != nil {
+			logrus.Warnf("failed to enable balloon statistics of domain %s: %v", domainName, err)
+		}
+	}
+
 	if err := execContinue(qmpFile); err != nil {
 		return logError("failed to start domain that is stopped %v", err)
 	}
@@ -768,6 +945,8 @@ func (ctx kvmContext) Delete(domainName string) (result error) {
 	if err := execQuit(getQmpExecutorSocket(domainName)); err != nil {
 		return logError("failed to execute quit command %v", err)
 	}
+	// swtpm also terminates when qemu is gone
+	stopSwtpm(domainName)
 	// we may want to wait a little bit here and actually kill qemu process if it gets wedged
 	if err := os.RemoveAll(kvmStateDir + domainName); err != nil {
 		return logError("failed to clean up domain state directory %s (%v)", domainName, err)
@@ -823,6 +1002,288 @@ func (ctx kvmContext) Cleanup(domainName string) error {
 	return nil
 }
 
+// ResizeDisk grows a disk of a running domain by means of block_resize.
+// Disks get their DiskID the same way as in CreateDomConfig.
+func (ctx kvmContext) ResizeDisk(domainName string, diskStatusList []types.DiskStatus, index int, size uint64) error {
+	if index < 0 || index >= len(diskStatusList) {
+		return logError("no disk %d for domain %s", index, domainName)
+	}
+	ds := diskStatusList[index]
+	switch {
+	case ds.Devtype == "" || ds.Devtype == "cdrom" || ds.Devtype == "9P":
+		return logError("disk %s of domain %s of type %q cannot be resized",
+			ds.DisplayName, domainName, ds.Devtype)
+	case ds.WWN != "":
+		return logError("disk %s of domain %s is attached over vhost-scsi and cannot be resized online",
+			ds.DisplayName, domainName)
+	}
+	// qcow2 requires the size to be a multiple of the sector size
+	size = (size + 511) &^ 511
+	device := kvmDiskDevice(diskStatusList, index)
+	if err := execBlockResize(getQmpExecutorSocket(domainName), device, size); err != nil {
+		return logError("block_resize of %s to %d for domain %s failed: %v",
+			device, size, domainName, err)
+	}
+	return nil
+}
+
+// BackupDisk copies a disk of a running domain by means of blockdev-backup
+func (ctx kvmContext) BackupDisk(domainName string, diskStatusList []types.DiskStatus, index int, target string) error {
+	if index < 0 || index >= len(diskStatusList) {
+		return logError("no disk %d for domain %s", index, domainName)
+	}
+	ds := diskStatusList[index]
+	switch {
+	case ds.Devtype == "" || ds.Devtype == "cdrom":
+		return logError("disk %s of domain %s of type %q cannot be backed up",
+			ds.DisplayName, domainName, ds.Devtype)
+	case ds.WWN != "":
+		return logError("disk %s of domain %s is attached over vhost-scsi and cannot be backed up online",
+			ds.DisplayName, domainName)
+	}
+	// The guest agent, if any, freezes the filesystems of the guest until
+	// the job started since the copy is consistent as of its start
+	qgaFile := getQgaSocket(domainName)
+	frozen := false
+	if count, err := execGuestFsFreeze(qgaFile); err != nil {
+		logrus.Warnf("backup of disk %s of domain %s without freezing its filesystems: %v",
+			ds.DisplayName, domainName, err)
+	} else {
+		logrus.Infof("froze %d filesystems of domain %s", count, domainName)
+		frozen = true
+	}
+	thaw := func() {
+		if !frozen {
+			return
+		}
+		frozen = false
+		if _, err := execGuestFsThaw(qgaFile); err != nil {
+			logrus.Errorf("failed to thaw the filesystems of domain %s: %v", domainName, err)
+		}
+	}
+	defer thaw()
+	device := kvmDiskDevice(diskStatusList, index)
+	if err := execBlockdevBackup(getQmpExecutorSocket(domainName), device, target, diskBackupTimeout, thaw); err != nil {
+		return logError("backup of %s for domain %s failed: %v",
+			device, domainName, err)
+	}
+	return nil
+}
+
+// GuestShutdown asks the guest agent to power the domain off
+func (ctx kvmContext) GuestShutdown(domainName string) error {
+	return execGuestShutdown(getQgaSocket(domainName))
+}
+
+// SyncGuestTime sets the clock of the guest to the one of the host
+func (ctx kvmContext) SyncGuestTime(domainName string) error {
+	return execGuestSetTime(getQgaSocket(domainName), time.Now())
+}
+
+// GetGuestInfo returns what the guest agent reports about the guest
+func (ctx kvmContext) GetGuestInfo(domainName string) (types.GuestInfo, error) {
+	return getGuestInfo(getQgaSocket(domainName))
+}
+
+// FreezeGuestFs has the guest agent freeze the filesystems of the guest
+func (ctx kvmContext) FreezeGuestFs(domainName string) (int, error) {
+	return execGuestFsFreeze(getQgaSocket(domainName))
+}
+
+// ThawGuestFs has the guest agent thaw the filesystems of the guest
+func (ctx kvmContext) ThawGuestFs(domainName string) (int, error) {
+	return execGuestFsThaw(getQgaSocket(domainName))
+}
+
+// SerialConsoleSocket returns the socket of the serial console of a domain
+func (ctx kvmContext) SerialConsoleSocket(domainName string) string {
+	return kvmStateDir + domainName + "/cons"
+}
+
+// ThrottleDisk changes the I/O limits of a disk of a running domain
+// by means of block_set_io_throttle
+func (ctx kvmContext) ThrottleDisk(domainName string, diskStatusList []types.DiskStatus, index int, limits types.VolumeIOLimits) error {
+	if index < 0 || index >= len(diskStatusList) {
+		return logError("no disk %d for domain %s", index, domainName)
+	}
+	ds := diskStatusList[index]
+	switch {
+	case ds.Devtype == "" || ds.Devtype == "cdrom" || ds.Devtype == "9P":
+		return logError("disk %s of domain %s of type %q cannot be throttled",
+			ds.DisplayName, domainName, ds.Devtype)
+	case ds.WWN != "":
+		return logError("disk %s of domain %s is attached over vhost-scsi and cannot be throttled",
+			ds.DisplayName, domainName)
+	}
+	device := kvmDiskDevice(diskStatusList, index)
+	if err := execBlockSetIOThrottle(getQmpExecutorSocket(domainName), device, limits); err != nil {
+		return logError("block_set_io_throttle of %s for domain %s failed: %v",
+			device, domainName, err)
+	}
+	return nil
+}
+
+// GetDiskIOStats returns the I/O counters of the disks of a running domain
+// which QEMU accesses itself
+func (ctx kvmContext) GetDiskIOStats(domainName string, diskStatusList []types.DiskStatus) ([]types.DiskIOMetric, error) {
+	stats, err := getQemuBlockStats(getQmpExecutorSocket(domainName))
+	if err != nil {
+		return nil, logError("query-blockstats for domain %s failed: %v", domainName, err)
+	}
+	var metrics []types.DiskIOMetric
+	for i, ds := range diskStatusList {
+		if ds.Devtype == "" || ds.Devtype == "cdrom" || ds.Devtype == "9P" || ds.WWN != "" {
+			continue
+		}
+		st, ok := stats[kvmDiskDevice(diskStatusList, i)]
+		if !ok {
+			continue
+		}
+		st.VolumeKey = ds.VolumeKey
+		st.IOLimits = ds.IOLimits
+		metrics = append(metrics, st)
+	}
+	return metrics, nil
+}
+
+// SetBalloonTarget sets the memory of the guest to target bytes
+func (ctx kvmContext) SetBalloonTarget(domainName string, target uint64) error {
+	if err := execBalloon(getQmpExecutorSocket(domainName), target); err != nil {
+		return logError("balloon of domain %s to %d failed: %v", domainName, target, err)
+	}
+	return nil
+}
+
+// GetBalloonStats returns the memory of the guest and the statistics of
+// its balloon driver
+func (ctx kvmContext) GetBalloonStats(domainName string) (types.BalloonMetric, error) {
+	var metric types.BalloonMetric
+	qmpFile := getQmpExecutorSocket(domainName)
+	actual, err := getQemuBalloon(qmpFile)
+	if err != nil {
+		return metric, logError("query-balloon for domain %s failed: %v", domainName, err)
+	}
+	metric.ActualMB = uint32(actual >> 20)
+	stats, err := getQemuBalloonStats(qmpFile, kvmBalloonDevice)
+	if err != nil {
+		return metric, logError("balloon statistics of domain %s failed: %v", domainName, err)
+	}
+	if stats.LastUpdate == 0 {
+		// The guest did not report any yet
+		return metric, nil
+	}
+	positive := func(v int64) uint64 {
+		if v < 0 {
+			return 0
+		}
+		return uint64(v)
+	}
+	metric.FreeMB = uint32(positive(stats.Stats.FreeMemory) >> 20)
+	metric.AvailableMB = uint32(positive(stats.Stats.AvailableMemory) >> 20)
+	metric.DiskCachesMB = uint32(positive(stats.Stats.DiskCaches) >> 20)
+	metric.MajorFaults = positive(stats.Stats.MajorFaults)
+	metric.SwapInBytes = positive(stats.Stats.SwapIn)
+	metric.SwapOutBytes = positive(stats.Stats.SwapOut)
+	metric.LastUpdate = time.Unix(stats.LastUpdate, 0)
+	return metric, nil
+}
+
+// kvmVtpmDriver returns the device of the TPM interface; the virt machine
+// of arm64 only has a TIS on the system bus
+func kvmVtpmDriver(machine string, model types.VtpmModel) string {
+	if machine == "virt" {
+		return "tpm-tis-device"
+	}
+	if model == types.VtpmCRB {
+		return "tpm-crb"
+	}
+	return "tpm-tis"
+}
+
+// startSwtpm starts the software TPM of a domain with its state in
+// stateDir, which it keeps across restarts of the domain. It terminates
+// when qemu disconnects.
+func startSwtpm(domainName, stateDir string) error {
+	stopSwtpm(domainName)
+	// The state is only created once the vault is unlocked; the boot of
+	// the domain is retried until then
+	encrypted, err := vault.DefaultVaultEncrypted()
+	if err != nil {
+		return fmt.Errorf("no vTPM state before the vault is unlocked: %v", err)
+	}
+	if !encrypted {
+		// As the rest of the vault, e.g. on devices without a TPM
+		logrus.Warnf("state of the vTPM of domain %s is not encrypted", domainName)
+	}
+	if err := os.MkdirAll(stateDir, 0700); err != nil {
+		return err
+	}
+	args := []string{"socket", "--tpm2",
+		"--tpmstate", "dir=" + stateDir + ",mode=0600",
+		"--ctrl", "type=unixio,path=" + kvmStateDir + domainName + "/swtpm.sock",
+		"--pid", "file=" + kvmStateDir + domainName + "/swtpm.pid",
+		"--log", "file=" + kvmStateDir + domainName + "/swtpm.log",
+		"--daemon", "--terminate"}
+	logrus.Infof("starting vTPM of domain %s: %s %v", domainName, swtpmExec, args)
+	if out, err := exec.Command(swtpmExec, args...).CombinedOutput(); err != nil {
+		return fmt.Errorf("%s failed: %v: %s", swtpmExec, err, out)
+	}
+	return nil
+}
+
+// stopSwtpm stops the software TPM of a domain if it runs
+func stopSwtpm(domainName string) {
+	pidFile := kvmStateDir + domainName + "/swtpm.pid"
+	b, err := ioutil.ReadFile(pidFile)
+	if err != nil {
+		return
+	}
+	os.Remove(pidFile)
+	pid, err := strconv.Atoi(strings.TrimSpace(string(b)))
+	if err != nil {
+		logrus.Errorf("bad pid in %s: %v", pidFile, err)
+		return
+	}
+	if err := syscall.Kill(pid, syscall.SIGTERM); err != nil && err != syscall.ESRCH {
+		logrus.Errorf("failed to stop vTPM of domain %s: %v", domainName, err)
+	}
+}
+
+// pinRealtimeVCPUs pins each vcpu to one of the isolated cpus and
+// schedules it as SCHED_FIFO
+func pinRealtimeVCPUs(qmpFile string, cpus string) error {
+	list, err := utils.ParseCPUList(cpus)
+	if err != nil {
+		return err
+	}
+	threads, err := getQemuVCPUThreads(qmpFile)
+	if err != nil {
+		return err
+	}
+	if len(threads) > len(list) {
+		return fmt.Errorf("%d vcpus on %d CPUs", len(threads), len(list))
+	}
+	for i, tid := range threads {
+		if err := setRealtimeThread(tid, list[i], kvmRealtimePriority); err != nil {
+			return fmt.Errorf("vcpu %d thread %d: %v", i, tid, err)
+		}
+		logrus.Infof("pinned vcpu %d thread %d to CPU %d", i, tid, list[i])
+	}
+	return nil
+}
+
+// kvmDiskDevice returns the QMP device of a disk; disks get their DiskID
+// the same way as in CreateDomConfig.
+func kvmDiskDevice(diskStatusList []types.DiskStatus, index int) string {
+	diskID := 0
+	for _, prev := range diskStatusList[:index] {
+		if prev.Devtype != "" {
+			diskID++
+		}
+	}
+	return fmt.Sprintf("drive-virtio-disk%d", diskID)
+}
+
 func (ctx kvmContext) PCIReserve(long string) error {
 	logrus.Infof("PCIReserve long addr is %s", long)
 
@@ -920,3 +1381,7 @@ func getQmpExecutorSocket(domainName string) string {
 func getQmpListenerSocket(domainName string) string {
 	return kvmStateDir + domainName + "/listener.qmp"
 }
+
+func getQgaSocket(domainName string) string {
+	return kvmStateDir + domainName + "/qga"
+}
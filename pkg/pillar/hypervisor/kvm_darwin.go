@@ -3,6 +3,12 @@
 
 package hypervisor
 
+import "fmt"
+
 func getOsVersion() string {
 	return ""
 }
+
+func setRealtimeThread(tid int, cpu int, priority int) error {
+	return fmt.Errorf("realtime threads are not supported")
+}
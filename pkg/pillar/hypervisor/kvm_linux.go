@@ -3,7 +3,15 @@
 
 package hypervisor
 
-import "syscall"
+import (
+	"syscall"
+	"unsafe"
+
+	"golang.org/x/sys/unix"
+)
+
+// SCHED_FIFO policy of sched_setscheduler(2)
+const schedFIFO = 1
 
 func getOsVersion() string {
 	var uname syscall.Utsname
@@ -17,3 +25,20 @@ func getOsVersion() string {
 
 	return string(b)
 }
+
+// setRealtimeThread pins the thread tid to cpu and schedules it as
+// SCHED_FIFO with priority
+func setRealtimeThread(tid int, cpu int, priority int) error {
+	var set unix.CPUSet
+	set.Set(cpu)
+	if err := unix.SchedSetaffinity(tid, &set); err != nil {
+		return err
+	}
+	param := struct{ priority int32 }{int32(priority)}
+	_, _, errno := unix.Syscall(unix.SYS_SCHED_SETSCHEDULER, uintptr(tid),
+		schedFIFO, uintptr(unsafe.Pointer(&param)))
+	if errno != 0 {
+		return errno
+	}
+	return nil
+}
@@ -4,6 +4,7 @@ import (
 	"io/ioutil"
 	"os"
 	"os/exec"
+	"strings"
 	"testing"
 
 	zconfig "github.com/lf-edge/eve/api/go/config"
@@ -193,6 +194,17 @@ func TestCreateDomConfigOnlyCom1(t *testing.T) {
   chardev = "charserial0"
   name = "org.lfedge.eve.console.0"
 
+[chardev "charqga"]
+  backend = "socket"
+  path = "/run/hypervisor/kvm/test/qga"
+  server = "on"
+  wait = "off"
+
+[device]
+  driver = "virtserialport"
+  chardev = "charqga"
+  name = "org.qemu.guest_agent.0"
+
 
 #[device "video0"]
 #  driver = "qxl-vga"
@@ -455,6 +467,17 @@ func TestCreateDomConfigOnlyCom1(t *testing.T) {
   chardev = "charserial0"
   name = "org.lfedge.eve.console.0"
 
+[chardev "charqga"]
+  backend = "socket"
+  path = "/run/hypervisor/kvm/test/qga"
+  server = "on"
+  wait = "off"
+
+[device]
+  driver = "virtserialport"
+  chardev = "charqga"
+  name = "org.qemu.guest_agent.0"
+
 
 #[device "video0"]
 #  driver = "qxl-vga"
@@ -693,6 +716,17 @@ func TestCreateDomConfigOnlyCom1(t *testing.T) {
   chardev = "charserial0"
   name = "org.lfedge.eve.console.0"
 
+[chardev "charqga"]
+  backend = "socket"
+  path = "/run/hypervisor/kvm/test/qga"
+  server = "on"
+  wait = "off"
+
+[device]
+  driver = "virtserialport"
+  chardev = "charqga"
+  name = "org.qemu.guest_agent.0"
+
 
 #[device "video0"]
 #  driver = "qxl-vga"
@@ -1035,6 +1069,17 @@ func TestCreateDomConfig(t *testing.T) {
   chardev = "charserial0"
   name = "org.lfedge.eve.console.0"
 
+[chardev "charqga"]
+  backend = "socket"
+  path = "/run/hypervisor/kvm/test/qga"
+  server = "on"
+  wait = "off"
+
+[device]
+  driver = "virtserialport"
+  chardev = "charqga"
+  name = "org.qemu.guest_agent.0"
+
 
 #[device "video0"]
 #  driver = "qxl-vga"
@@ -1330,6 +1375,17 @@ func TestCreateDomConfig(t *testing.T) {
   chardev = "charserial0"
   name = "org.lfedge.eve.console.0"
 
+[chardev "charqga"]
+  backend = "socket"
+  path = "/run/hypervisor/kvm/test/qga"
+  server = "on"
+  wait = "off"
+
+[device]
+  driver = "virtserialport"
+  chardev = "charqga"
+  name = "org.qemu.guest_agent.0"
+
 
 #[device "video0"]
 #  driver = "qxl-vga"
@@ -1644,6 +1700,17 @@ func TestCreateDomConfig(t *testing.T) {
   chardev = "charserial0"
   name = "org.lfedge.eve.console.0"
 
+[chardev "charqga"]
+  backend = "socket"
+  path = "/run/hypervisor/kvm/test/qga"
+  server = "on"
+  wait = "off"
+
+[device]
+  driver = "virtserialport"
+  chardev = "charqga"
+  name = "org.qemu.guest_agent.0"
+
 
 #[device "video0"]
 #  driver = "qxl-vga"
@@ -1929,6 +1996,17 @@ func TestCreateDomConfig(t *testing.T) {
   chardev = "charserial0"
   name = "org.lfedge.eve.console.0"
 
+[chardev "charqga"]
+  backend = "socket"
+  path = "/run/hypervisor/kvm/test/qga"
+  server = "on"
+  wait = "off"
+
+[device]
+  driver = "virtserialport"
+  chardev = "charqga"
+  name = "org.qemu.guest_agent.0"
+
 
 #[device "video0"]
 #  driver = "qxl-vga"
@@ -2127,6 +2205,77 @@ func TestCreateDomConfig(t *testing.T) {
 	})
 }
 
+func TestCreateDomConfigVtpm(t *testing.T) {
+	initTest(t)
+	id, err := uuid.NewV4()
+	if err != nil {
+		t.Errorf("NewV4 failed: %v", err)
+	}
+	config := types.DomainConfig{
+		UUIDandVersion: types.UUIDandVersion{UUID: id, Version: "1.0"},
+		VmConfig: types.VmConfig{
+			Memory: 1024 * 1024 * 10,
+			VCpus:  2,
+		},
+	}
+	aa := types.AssignableAdapters{Initialized: true}
+	conf, err := ioutil.TempFile("/tmp", "config")
+	if err != nil {
+		t.Errorf("Can't create config file for a domain %v", err)
+	} else {
+		defer os.Remove(conf.Name())
+	}
+	vtpm := func(driver string) string {
+		return `
+[chardev "charvtpm"]
+  backend = "socket"
+  path = "/run/hypervisor/kvm/test/swtpm.sock"
+
+[tpmdev "vtpm0"]
+  type = "emulator"
+  chardev = "charvtpm"
+
+[device "vtpm"]
+  driver = "` + driver + `"
+  tpmdev = "vtpm0"
+`
+	}
+
+	testMatrix := map[string]struct {
+		ctx    kvmContext
+		model  types.VtpmModel
+		driver string
+	}{
+		"amd64-tis":  {ctx: kvmIntel, model: types.VtpmTIS, driver: "tpm-tis"},
+		"amd64-crb":  {ctx: kvmIntel, model: types.VtpmCRB, driver: "tpm-crb"},
+		"arm64-tis":  {ctx: kvmArm, model: types.VtpmTIS, driver: "tpm-tis-device"},
+		"arm64-crb":  {ctx: kvmArm, model: types.VtpmCRB, driver: "tpm-tis-device"},
+		"amd64-none": {ctx: kvmIntel, model: types.VtpmNone},
+	}
+	for name, test := range testMatrix {
+		t.Run(name, func(t *testing.T) {
+			conf.Seek(0, 0)
+			defer os.Truncate(conf.Name(), 0)
+			config.Vtpm = test.model
+			if err := test.ctx.CreateDomConfig("test", config, nil, &aa, conf); err != nil {
+				t.Errorf("CreateDomConfig failed %v", err)
+			}
+			result, err := ioutil.ReadFile(conf.Name())
+			if err != nil {
+				t.Errorf("reading conf file failed %v", err)
+			}
+			if test.driver == "" {
+				if strings.Contains(string(result), "vtpm") {
+					t.Errorf("got a vTPM without one configured:\n%s", result)
+				}
+			} else if !strings.Contains(string(result), vtpm(test.driver)) {
+				t.Errorf("got an unexpected vTPM, expected %s in:\n%s",
+					test.driver, result)
+			}
+		})
+	}
+}
+
 func TestCreateDom(t *testing.T) {
 	initTest(t)
 	if exec.Command("qemu-system-x86_64", "--version").Run() != nil {
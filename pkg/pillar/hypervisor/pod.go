@@ -0,0 +1,188 @@
+// Copyright (c) 2022 Zededa, Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package hypervisor
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/lf-edge/eve/pkg/pillar/containerd"
+	"github.com/lf-edge/eve/pkg/pillar/types"
+	"github.com/opencontainers/runtime-spec/specs-go"
+	"github.com/sirupsen/logrus"
+)
+
+// The init containers of a pod have to exit within that time
+const podInitTimeout = 10 * time.Minute
+
+// setupPodContainer creates a container of the pod of a domain, which
+// gets the volumes of its config and the resolv.conf of the domain
+func (ctx ctrdContext) setupPodContainer(status types.DomainStatus, config types.DomainConfig,
+	pc types.PodContainerConfig, resolv string) error {
+
+	spec, err := ctx.ctrdClient.NewOciSpec(containerd.PodContainerID(status.DomainName, pc.Name))
+	if err != nil {
+		return err
+	}
+	if err := spec.UpdateFromVolume(pc.Image.FileLocation); err != nil {
+		return err
+	}
+	spec.UpdateFromPodContainer(&config, pc)
+	var disks []types.DiskStatus
+	for _, disk := range pc.Disks {
+		disks = append(disks, types.DiskStatus{
+			VolumeKey:    disk.VolumeKey,
+			ReadOnly:     disk.ReadOnly,
+			FileLocation: disk.FileLocation,
+			Format:       disk.Format,
+			MountDir:     disk.MountDir,
+			DisplayName:  disk.DisplayName,
+			Shared:       disk.Shared,
+		})
+	}
+	if err := spec.UpdateMounts(disks); err != nil {
+		return err
+	}
+	spec.UpdateEnvVar(pc.EnvVariables)
+	spec.Get().Mounts = append(spec.Get().Mounts, specs.Mount{
+		Type:        "bind",
+		Source:      resolv,
+		Destination: "/etc/resolv.conf",
+		Options:     []string{"rbind", "ro"}})
+	return spec.CreateContainer(true)
+}
+
+// podContainers returns the init and the sidecar containers of the pod of
+// a domain
+func (ctx ctrdContext) podContainers(ctrdCtx context.Context, domainName string) ([]string, []string) {
+	annotations, err := ctx.ctrdClient.CtrGetContainerAnnotations(ctrdCtx, domainName)
+	if err != nil {
+		return nil, nil
+	}
+	return containerd.PodContainerNames(annotations)
+}
+
+// createPodTasks creates the tasks of the sidecar containers in the network
+// namespace of the main task pid. The init containers only join it since
+// their tasks are created in turn.
+func (ctx ctrdContext) createPodTasks(ctrdCtx context.Context, domainName string, pid int) error {
+	inits, sidecars := ctx.podContainers(ctrdCtx, domainName)
+	for _, name := range append(inits, sidecars...) {
+		id := containerd.PodContainerID(domainName, name)
+		// Like the main container, get rid of a stale task
+		_ = ctx.ctrdClient.CtrStopContainer(ctrdCtx, id, true)
+		if err := ctx.ctrdClient.CtrJoinNetNamespace(ctrdCtx, id, pid); err != nil {
+			return fmt.Errorf("pod container %s cannot join the network: %v", name, err)
+		}
+	}
+	for _, name := range sidecars {
+		id := containerd.PodContainerID(domainName, name)
+		if _, err := ctx.ctrdClient.CtrCreateTask(ctrdCtx, id); err != nil {
+			return fmt.Errorf("pod container %s: %v", name, err)
+		}
+	}
+	return nil
+}
+
+// runInitContainers runs the init containers of the pod of a domain one
+// after the other; each of them has to succeed
+func (ctx ctrdContext) runInitContainers(ctrdCtx context.Context, domainName string) error {
+	inits, _ := ctx.podContainers(ctrdCtx, domainName)
+	for _, name := range inits {
+		id := containerd.PodContainerID(domainName, name)
+		if _, err := ctx.ctrdClient.CtrCreateTask(ctrdCtx, id); err != nil {
+			return fmt.Errorf("init container %s: %v", name, err)
+		}
+		runCtx, cancel := context.WithTimeout(ctrdCtx, podInitTimeout)
+		code, err := ctx.ctrdClient.CtrRunTask(runCtx, id)
+		cancel()
+		if err != nil {
+			return fmt.Errorf("init container %s failed: %v", name, err)
+		}
+		if code != 0 {
+			return fmt.Errorf("init container %s exited with status %d", name, code)
+		}
+		logrus.Infof("init container %s of %s succeeded", name, domainName)
+	}
+	return nil
+}
+
+// startSidecars starts the tasks of the sidecar containers
+func (ctx ctrdContext) startSidecars(ctrdCtx context.Context, domainName string) error {
+	_, sidecars := ctx.podContainers(ctrdCtx, domainName)
+	for _, name := range sidecars {
+		id := containerd.PodContainerID(domainName, name)
+		if err := ctx.ctrdClient.CtrStartTask(ctrdCtx, id); err != nil {
+			return fmt.Errorf("sidecar container %s: %v", name, err)
+		}
+	}
+	return nil
+}
+
+// stopPodContainers stops, or deletes, the containers of the pod of a domain
+func (ctx ctrdContext) stopPodContainers(ctrdCtx context.Context, domainName string,
+	force, delete bool) {
+
+	inits, sidecars := ctx.podContainers(ctrdCtx, domainName)
+	for _, name := range append(sidecars, inits...) {
+		id := containerd.PodContainerID(domainName, name)
+		var err error
+		if delete {
+			err = ctx.ctrdClient.CtrDeleteContainer(ctrdCtx, id)
+		} else {
+			err = ctx.ctrdClient.CtrStopContainer(ctrdCtx, id, force)
+		}
+		if err != nil {
+			logrus.Debugf("stopping pod container %s: %v", id, err)
+		}
+	}
+}
+
+// GetPodContainerInfo returns the state of the containers of the pod of a domain
+func (ctx ctrdContext) GetPodContainerInfo(domainName string) ([]types.PodContainerInfo, error) {
+	ctrdCtx, done := ctx.ctrdClient.CtrNewUserServicesCtx()
+	defer done()
+	inits, sidecars := ctx.podContainers(ctrdCtx, domainName)
+	var infos []types.PodContainerInfo
+	for i, name := range append(inits, sidecars...) {
+		info := types.PodContainerInfo{Name: name, Init: i < len(inits)}
+		_, exit, status, err := ctx.ctrdClient.CtrContainerInfo(ctrdCtx,
+			containerd.PodContainerID(domainName, name))
+		switch {
+		case err != nil:
+			info.State = types.UNKNOWN
+		case status == "stopped" && exit != 0:
+			info.State = types.BROKEN
+			info.ExitCode = exit
+		default:
+			info.State = ctrdTaskStates[status]
+		}
+		infos = append(infos, info)
+	}
+	return infos, nil
+}
+
+// RestartPodContainer restarts a sidecar container in the network namespace
+// of the running main task
+func (ctx ctrdContext) RestartPodContainer(domainName string, name string) error {
+	ctrdCtx, done := ctx.ctrdClient.CtrNewUserServicesCtx()
+	defer done()
+	pid, _, status, err := ctx.ctrdClient.CtrContainerInfo(ctrdCtx, domainName)
+	if err != nil {
+		return err
+	}
+	if status != "running" {
+		return fmt.Errorf("domain %s is %s", domainName, status)
+	}
+	id := containerd.PodContainerID(domainName, name)
+	_ = ctx.ctrdClient.CtrStopContainer(ctrdCtx, id, true)
+	if err := ctx.ctrdClient.CtrJoinNetNamespace(ctrdCtx, id, pid); err != nil {
+		return err
+	}
+	if _, err := ctx.ctrdClient.CtrCreateTask(ctrdCtx, id); err != nil {
+		return err
+	}
+	return ctx.ctrdClient.CtrStartTask(ctrdCtx, id)
+}
@@ -0,0 +1,235 @@
+// Copyright (c) 2022 Zededa, Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package hypervisor
+
+import (
+	"bufio"
+	"encoding/json"
+	"fmt"
+	"math/rand"
+	"net"
+	"sync"
+	"time"
+
+	"github.com/lf-edge/eve/pkg/pillar/types"
+	"github.com/sirupsen/logrus"
+)
+
+// this file implements subset of
+//     https://qemu.readthedocs.io/en/latest/interop/qemu-ga-ref.html
+
+// The guest agent has to answer within that time, which is also how long
+// we wait for a guest without an agent
+const qgaTimeout = 5 * time.Second
+
+// Freezing the filesystems may have to wait for the guest to flush them
+const qgaFreezeTimeout = 30 * time.Second
+
+// The agent serves one client at a time, hence one lock per socket
+var qgaLocks sync.Map
+
+type qgaConn struct {
+	conn   net.Conn
+	dec    *json.Decoder
+	unlock func()
+}
+
+// dialGuestAgent connects to the guest agent behind socket. Since the
+// agent may still have the answers to abandoned commands to send, it
+// first has to echo a random id behind a 0xff delimiter.
+func dialGuestAgent(socket string) (*qgaConn, error) {
+	lock, _ := qgaLocks.LoadOrStore(socket, &sync.Mutex{})
+	lock.(*sync.Mutex).Lock()
+	conn, err := net.DialTimeout("unix", socket, qgaTimeout)
+	if err != nil {
+		lock.(*sync.Mutex).Unlock()
+		return nil, err
+	}
+	c := &qgaConn{conn: conn, unlock: lock.(*sync.Mutex).Unlock}
+	id := rand.Int31()
+	if err := c.send("guest-sync-delimited", map[string]int32{"id": id}); err != nil {
+		c.close()
+		return nil, err
+	}
+	reader := bufio.NewReader(conn)
+	conn.SetReadDeadline(time.Now().Add(qgaTimeout))
+	if _, err := reader.ReadBytes(0xff); err != nil {
+		c.close()
+		return nil, fmt.Errorf("no guest agent: %v", err)
+	}
+	c.dec = json.NewDecoder(reader)
+	var echo int32
+	if err := c.receive(&echo, qgaTimeout); err != nil {
+		c.close()
+		return nil, err
+	}
+	if echo != id {
+		c.close()
+		return nil, fmt.Errorf("guest agent echoed %d instead of %d", echo, id)
+	}
+	return c, nil
+}
+
+func (c *qgaConn) send(cmd string, args interface{}) error {
+	req := struct {
+		Execute   string      `json:"execute"`
+		Arguments interface{} `json:"arguments,omitempty"`
+	}{cmd, args}
+	raw, err := json.Marshal(req)
+	if err != nil {
+		return err
+	}
+	logrus.Debugf("executing guest agent command: %s", raw)
+	c.conn.SetWriteDeadline(time.Now().Add(qgaTimeout))
+	_, err = c.conn.Write(raw)
+	return err
+}
+
+func (c *qgaConn) receive(result interface{}, timeout time.Duration) error {
+	var resp struct {
+		Return json.RawMessage `json:"return"`
+		Error  *struct {
+			Class string `json:"class"`
+			Desc  string `json:"desc"`
+		} `json:"error"`
+	}
+	c.conn.SetReadDeadline(time.Now().Add(timeout))
+	if err := c.dec.Decode(&resp); err != nil {
+		return err
+	}
+	if resp.Error != nil {
+		return fmt.Errorf("%s: %s", resp.Error.Class, resp.Error.Desc)
+	}
+	if result == nil {
+		return nil
+	}
+	return json.Unmarshal(resp.Return, result)
+}
+
+func (c *qgaConn) close() {
+	c.conn.Close()
+	c.unlock()
+}
+
+// execGuestAgentCmd runs cmd and unmarshals what it returns into result
+// unless result is nil
+func execGuestAgentCmd(socket, cmd string, args, result interface{}, timeout time.Duration) error {
+	c, err := dialGuestAgent(socket)
+	if err != nil {
+		return err
+	}
+	defer c.close()
+	if err := c.send(cmd, args); err != nil {
+		return err
+	}
+	if err := c.receive(result, timeout); err != nil {
+		return fmt.Errorf("%s failed: %v", cmd, err)
+	}
+	return nil
+}
+
+// execGuestShutdown asks the guest to power off, which it does not answer
+func execGuestShutdown(socket string) error {
+	c, err := dialGuestAgent(socket)
+	if err != nil {
+		return err
+	}
+	defer c.close()
+	return c.send("guest-shutdown", map[string]string{"mode": "powerdown"})
+}
+
+// execGuestFsFreeze freezes the filesystems of the guest and returns how many
+func execGuestFsFreeze(socket string) (int, error) {
+	var count int
+	err := execGuestAgentCmd(socket, "guest-fsfreeze-freeze", nil, &count, qgaFreezeTimeout)
+	return count, err
+}
+
+// execGuestFsThaw thaws the filesystems of the guest and returns how many
+func execGuestFsThaw(socket string) (int, error) {
+	var count int
+	err := execGuestAgentCmd(socket, "guest-fsfreeze-thaw", nil, &count, qgaFreezeTimeout)
+	return count, err
+}
+
+// execGuestSetTime sets the clock of the guest to t
+func execGuestSetTime(socket string, t time.Time) error {
+	return execGuestAgentCmd(socket, "guest-set-time",
+		map[string]int64{"time": t.UnixNano()}, nil, qgaTimeout)
+}
+
+// getGuestInfo returns what the guest agent reports about the guest. Only
+// the OS info is required since older or restricted agents may not have
+// the other commands.
+func getGuestInfo(socket string) (types.GuestInfo, error) {
+	var info types.GuestInfo
+	var osInfo struct {
+		Name          string `json:"name"`
+		PrettyName    string `json:"pretty-name"`
+		Version       string `json:"version"`
+		KernelRelease string `json:"kernel-release"`
+	}
+	if err := execGuestAgentCmd(socket, "guest-get-osinfo", nil, &osInfo, qgaTimeout); err != nil {
+		return info, err
+	}
+	info.OSName = osInfo.PrettyName
+	if info.OSName == "" {
+		info.OSName = osInfo.Name
+	}
+	info.OSVersion = osInfo.Version
+	info.KernelRelease = osInfo.KernelRelease
+
+	var hostName struct {
+		HostName string `json:"host-name"`
+	}
+	if err := execGuestAgentCmd(socket, "guest-get-host-name", nil, &hostName, qgaTimeout); err != nil {
+		logrus.Debugf("getGuestInfo(%s): %v", socket, err)
+	}
+	info.Hostname = hostName.HostName
+
+	var interfaces []struct {
+		Name            string `json:"name"`
+		HardwareAddress string `json:"hardware-address"`
+		IPAddresses     []struct {
+			IPAddress string `json:"ip-address"`
+		} `json:"ip-addresses"`
+	}
+	if err := execGuestAgentCmd(socket, "guest-network-get-interfaces", nil, &interfaces, qgaTimeout); err != nil {
+		logrus.Debugf("getGuestInfo(%s): %v", socket, err)
+	}
+	for _, intf := range interfaces {
+		gi := types.GuestInterface{Name: intf.Name, MacAddress: intf.HardwareAddress}
+		for _, addr := range intf.IPAddresses {
+			ip := net.ParseIP(addr.IPAddress)
+			if ip == nil || ip.IsLoopback() {
+				continue
+			}
+			gi.IPAddrs = append(gi.IPAddrs, ip)
+		}
+		// Leave out the loopback interface
+		if len(gi.IPAddrs) == 0 && len(intf.IPAddresses) != 0 {
+			continue
+		}
+		info.Interfaces = append(info.Interfaces, gi)
+	}
+
+	var filesystems []struct {
+		Mountpoint string `json:"mountpoint"`
+		Type       string `json:"type"`
+		TotalBytes uint64 `json:"total-bytes"`
+		UsedBytes  uint64 `json:"used-bytes"`
+	}
+	if err := execGuestAgentCmd(socket, "guest-get-fsinfo", nil, &filesystems, qgaTimeout); err != nil {
+		logrus.Debugf("getGuestInfo(%s): %v", socket, err)
+	}
+	for _, fs := range filesystems {
+		info.Filesystems = append(info.Filesystems, types.GuestFilesystem{
+			Mountpoint: fs.Mountpoint,
+			Type:       fs.Type,
+			TotalBytes: fs.TotalBytes,
+			UsedBytes:  fs.UsedBytes,
+		})
+	}
+	return info, nil
+}
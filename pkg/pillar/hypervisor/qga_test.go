@@ -0,0 +1,160 @@
+// Copyright (c) 2022 Zededa, Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package hypervisor
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net"
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/lf-edge/eve/pkg/pillar/types"
+)
+
+// fakeGuestAgent answers the commands in replies with their return value,
+// and the others with an error. It first sends the stale answer to a
+// previous command, which the client has to skip.
+func fakeGuestAgent(t *testing.T, replies map[string]string) (string, func()) {
+	dir, err := ioutil.TempDir("", "qga")
+	if err != nil {
+		t.Fatal(err)
+	}
+	socket := filepath.Join(dir, "qga")
+	listener, err := net.Listen("unix", socket)
+	if err != nil {
+		t.Fatal(err)
+	}
+	go func() {
+		for {
+			conn, err := listener.Accept()
+			if err != nil {
+				return
+			}
+			dec := json.NewDecoder(conn)
+			fmt.Fprint(conn, `{"return": {}}`)
+			for {
+				var req struct {
+					Execute   string `json:"execute"`
+					Arguments struct {
+						ID int32 `json:"id"`
+					} `json:"arguments"`
+				}
+				if err := dec.Decode(&req); err != nil {
+					break
+				}
+				reply, ok := replies[req.Execute]
+				switch {
+				case req.Execute == "guest-sync-delimited":
+					fmt.Fprintf(conn, "\xff{\"return\": %d}\n", req.Arguments.ID)
+				case req.Execute == "guest-shutdown":
+				case ok:
+					fmt.Fprintf(conn, "{\"return\": %s}\n", reply)
+				default:
+					fmt.Fprintf(conn, "{\"error\": {\"class\": \"CommandNotFound\", \"desc\": \"%s\"}}\n",
+						req.Execute)
+				}
+			}
+			conn.Close()
+		}
+	}()
+	return socket, func() {
+		listener.Close()
+		os.RemoveAll(dir)
+	}
+}
+
+func TestGetGuestInfo(t *testing.T) {
+	type testEntry struct {
+		replies  map[string]string
+		expected types.GuestInfo
+		fail     bool
+	}
+	testMatrix := map[string]testEntry{
+		"full": {
+			replies: map[string]string{
+				"guest-get-osinfo":    `{"id": "ubuntu", "name": "Ubuntu", "pretty-name": "Ubuntu 20.04.4 LTS", "version": "20.04.4 LTS (Focal Fossa)", "kernel-release": "5.4.0-109-generic"}`,
+				"guest-get-host-name": `{"host-name": "app1"}`,
+				"guest-network-get-interfaces": `[
+					{"name": "lo", "hardware-address": "00:00:00:00:00:00", "ip-addresses": [{"ip-address-type": "ipv4", "ip-address": "127.0.0.1", "prefix": 8}, {"ip-address-type": "ipv6", "ip-address": "::1", "prefix": 128}]},
+					{"name": "eth0", "hardware-address": "52:54:00:12:34:56", "ip-addresses": [{"ip-address-type": "ipv4", "ip-address": "10.1.0.2", "prefix": 24}, {"ip-address-type": "ipv6", "ip-address": "fe80::5054:ff:fe12:3456", "prefix": 64}]},
+					{"name": "eth1", "hardware-address": "52:54:00:12:34:57"}]`,
+				"guest-get-fsinfo": `[{"name": "vda1", "mountpoint": "/", "type": "ext4", "total-bytes": 10000, "used-bytes": 4000, "disk": []}]`,
+			},
+			expected: types.GuestInfo{
+				OSName:        "Ubuntu 20.04.4 LTS",
+				OSVersion:     "20.04.4 LTS (Focal Fossa)",
+				KernelRelease: "5.4.0-109-generic",
+				Hostname:      "app1",
+				Interfaces: []types.GuestInterface{
+					{Name: "eth0", MacAddress: "52:54:00:12:34:56",
+						IPAddrs: []net.IP{net.ParseIP("10.1.0.2"), net.ParseIP("fe80::5054:ff:fe12:3456")}},
+					{Name: "eth1", MacAddress: "52:54:00:12:34:57"},
+				},
+				Filesystems: []types.GuestFilesystem{
+					{Mountpoint: "/", Type: "ext4", TotalBytes: 10000, UsedBytes: 4000},
+				},
+			},
+		},
+		"only os info": {
+			replies: map[string]string{
+				"guest-get-osinfo": `{"name": "Microsoft Windows", "version": "Microsoft Windows 10", "kernel-release": "19041"}`,
+			},
+			expected: types.GuestInfo{
+				OSName:        "Microsoft Windows",
+				OSVersion:     "Microsoft Windows 10",
+				KernelRelease: "19041",
+			},
+		},
+		"no os info": {
+			replies: map[string]string{},
+			fail:    true,
+		},
+	}
+	for testname, test := range testMatrix {
+		t.Logf("Running test case %s", testname)
+		socket, done := fakeGuestAgent(t, test.replies)
+		info, err := getGuestInfo(socket)
+		done()
+		if test.fail {
+			if err == nil {
+				t.Errorf("%s: expected getGuestInfo to fail", testname)
+			}
+			continue
+		}
+		if err != nil {
+			t.Errorf("%s: getGuestInfo failed: %v", testname, err)
+		} else if !reflect.DeepEqual(info, test.expected) {
+			t.Errorf("%s: got %+v instead of %+v", testname, info, test.expected)
+		}
+	}
+}
+
+func TestGuestAgentCommands(t *testing.T) {
+	socket, done := fakeGuestAgent(t, map[string]string{
+		"guest-fsfreeze-freeze": "2",
+		"guest-fsfreeze-thaw":   "2",
+		"guest-set-time":        "{}",
+	})
+	defer done()
+	if count, err := execGuestFsFreeze(socket); err != nil || count != 2 {
+		t.Errorf("execGuestFsFreeze returned %d, %v", count, err)
+	}
+	if count, err := execGuestFsThaw(socket); err != nil || count != 2 {
+		t.Errorf("execGuestFsThaw returned %d, %v", count, err)
+	}
+	if err := execGuestSetTime(socket, time.Now()); err != nil {
+		t.Errorf("execGuestSetTime failed: %v", err)
+	}
+	if err := execGuestShutdown(socket); err != nil {
+		t.Errorf("execGuestShutdown failed: %v", err)
+	}
+	if err := execGuestSetTime(filepath.Join(filepath.Dir(socket), "none"), time.Now()); err == nil {
+		t.Errorf("execGuestSetTime without a guest agent succeeded")
+	}
+}
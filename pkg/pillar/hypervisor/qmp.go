@@ -4,6 +4,7 @@ import (
 	"encoding/json"
 	"fmt"
 	"github.com/digitalocean/go-qemu/qmp"
+	"github.com/lf-edge/eve/pkg/pillar/types"
 	"github.com/sirupsen/logrus"
 	"os"
 	"time"
@@ -66,6 +67,210 @@ func execVNCPassword(socket string, password string) error {
 	return err
 }
 
+func execBlockResize(socket, device string, size uint64) error {
+	blockResize := fmt.Sprintf(`{ "execute": "block_resize", "arguments": { "device": "%s", "size": %d } }`, device, size)
+	_, err := execRawCmd(socket, blockResize)
+	return err
+}
+
+// execBlockdevBackup copies device into the existing qcow2 image target
+// by means of a blockdev-backup job and waits for the job to finish.
+// The copy is consistent as of the start of the job, which calls started.
+func execBlockdevBackup(socket, device, target string, timeout time.Duration, started func()) error {
+	node := "backup-" + device
+	job := "job-" + device
+	blockdevAdd := fmt.Sprintf(`{ "execute": "blockdev-add", "arguments": { "driver": "qcow2", "node-name": "%s", "file": { "driver": "file", "filename": "%s" } } }`,
+		node, target)
+	if _, err := execRawCmd(socket, blockdevAdd); err != nil {
+		return fmt.Errorf("blockdev-add %s failed: %v", target, err)
+	}
+	defer func() {
+		blockdevDel := fmt.Sprintf(`{ "execute": "blockdev-del", "arguments": { "node-name": "%s" } }`, node)
+		if _, err := execRawCmd(socket, blockdevDel); err != nil {
+			logrus.Errorf("blockdev-del %s failed: %v", node, err)
+		}
+	}()
+	backup := fmt.Sprintf(`{ "execute": "blockdev-backup", "arguments": { "job-id": "%s", "device": "%s", "target": "%s", "sync": "full", "auto-dismiss": false } }`,
+		job, device, node)
+	if _, err := execRawCmd(socket, backup); err != nil {
+		return fmt.Errorf("blockdev-backup of %s failed: %v", device, err)
+	}
+	started()
+	defer func() {
+		dismiss := fmt.Sprintf(`{ "execute": "job-dismiss", "arguments": { "id": "%s" } }`, job)
+		if _, err := execRawCmd(socket, dismiss); err != nil {
+			logrus.Errorf("job-dismiss %s failed: %v", job, err)
+		}
+	}()
+	deadline := time.Now().Add(timeout)
+	for {
+		raw, err := execRawCmd(socket, `{ "execute": "query-jobs" }`)
+		if err != nil {
+			return err
+		}
+		var result struct {
+			Return []struct {
+				ID     string `json:"id"`
+				Status string `json:"status"`
+				Error  string `json:"error"`
+			} `json:"return"`
+		}
+		if err := json.Unmarshal(raw, &result); err != nil {
+			return err
+		}
+		found := false
+		for _, j := range result.Return {
+			if j.ID != job {
+				continue
+			}
+			found = true
+			if j.Status == "concluded" {
+				if j.Error != "" {
+					return fmt.Errorf("backup job of %s failed: %s", device, j.Error)
+				}
+				return nil
+			}
+		}
+		if !found {
+			return fmt.Errorf("backup job of %s disappeared", device)
+		}
+		if time.Now().After(deadline) {
+			cancel := fmt.Sprintf(`{ "execute": "job-cancel", "arguments": { "id": "%s" } }`, job)
+			execRawCmd(socket, cancel)
+			return fmt.Errorf("backup job of %s timed out after %v", device, timeout)
+		}
+		time.Sleep(time.Second)
+	}
+}
+
+// execBlockSetIOThrottle sets the limits of device; zero means unlimited
+func execBlockSetIOThrottle(socket, device string, limits types.VolumeIOLimits) error {
+	throttle := fmt.Sprintf(`{ "execute": "block_set_io_throttle", "arguments": { "device": "%s", "bps": 0, "bps_rd": %d, "bps_wr": %d, "iops": 0, "iops_rd": %d, "iops_wr": %d, "group": "%s" } }`,
+		device, limits.ReadBps, limits.WriteBps, limits.ReadIops, limits.WriteIops, device)
+	_, err := execRawCmd(socket, throttle)
+	return err
+}
+
+// getQemuBlockStats returns the I/O counters of the block devices by device
+func getQemuBlockStats(socket string) (map[string]types.DiskIOMetric, error) {
+	raw, err := execRawCmd(socket, `{ "execute": "query-blockstats" }`)
+	if err != nil {
+		return nil, err
+	}
+	var result struct {
+		Return []struct {
+			Device string `json:"device"`
+			Stats  struct {
+				RdBytes      uint64 `json:"rd_bytes"`
+				WrBytes      uint64 `json:"wr_bytes"`
+				RdOperations uint64 `json:"rd_operations"`
+				WrOperations uint64 `json:"wr_operations"`
+			} `json:"stats"`
+		} `json:"return"`
+	}
+	if err := json.Unmarshal(raw, &result); err != nil {
+		return nil, err
+	}
+	stats := make(map[string]types.DiskIOMetric)
+	for _, dev := range result.Return {
+		stats[dev.Device] = types.DiskIOMetric{
+			ReadBytes:  dev.Stats.RdBytes,
+			WriteBytes: dev.Stats.WrBytes,
+			ReadOps:    dev.Stats.RdOperations,
+			WriteOps:   dev.Stats.WrOperations,
+		}
+	}
+	return stats, nil
+}
+
+// execBalloon sets the memory of the guest to target bytes
+func execBalloon(socket string, target uint64) error {
+	balloon := fmt.Sprintf(`{ "execute": "balloon", "arguments": { "value": %d } }`, target)
+	_, err := execRawCmd(socket, balloon)
+	return err
+}
+
+// getQemuBalloon returns the memory of the guest in bytes; fails if it
+// has no balloon device
+func getQemuBalloon(socket string) (uint64, error) {
+	raw, err := execRawCmd(socket, `{ "execute": "query-balloon" }`)
+	if err != nil {
+		return 0, err
+	}
+	var result struct {
+		Return struct {
+			Actual uint64 `json:"actual"`
+		} `json:"return"`
+	}
+	if err := json.Unmarshal(raw, &result); err != nil {
+		return 0, err
+	}
+	return result.Return.Actual, nil
+}
+
+// execBalloonStatsPolling makes the guest report the statistics of the
+// balloon device every interval
+func execBalloonStatsPolling(socket, device string, interval time.Duration) error {
+	polling := fmt.Sprintf(`{ "execute": "qom-set", "arguments": { "path": "/machine/peripheral/%s", "property": "guest-stats-polling-interval", "value": %d } }`,
+		device, int(interval.Seconds()))
+	_, err := execRawCmd(socket, polling)
+	return err
+}
+
+// qemuBalloonStats are the statistics of the balloon device; those the
+// guest does not report are negative
+type qemuBalloonStats struct {
+	Stats struct {
+		SwapIn          int64 `json:"stat-swap-in"`
+		SwapOut         int64 `json:"stat-swap-out"`
+		MajorFaults     int64 `json:"stat-major-faults"`
+		FreeMemory      int64 `json:"stat-free-memory"`
+		AvailableMemory int64 `json:"stat-available-memory"`
+		DiskCaches      int64 `json:"stat-disk-caches"`
+	} `json:"stats"`
+	LastUpdate int64 `json:"last-update"`
+}
+
+// getQemuBalloonStats returns the statistics of the balloon device
+func getQemuBalloonStats(socket, device string) (qemuBalloonStats, error) {
+	var result struct {
+		Return qemuBalloonStats `json:"return"`
+	}
+	stats := fmt.Sprintf(`{ "execute": "qom-get", "arguments": { "path": "/machine/peripheral/%s", "property": "guest-stats" } }`,
+		device)
+	raw, err := execRawCmd(socket, stats)
+	if err != nil {
+		return result.Return, err
+	}
+	err = json.Unmarshal(raw, &result)
+	return result.Return, err
+}
+
+// getQemuVCPUThreads returns the threads of the vcpus by their index
+func getQemuVCPUThreads(socket string) ([]int, error) {
+	raw, err := execRawCmd(socket, `{ "execute": "query-cpus-fast" }`)
+	if err != nil {
+		return nil, err
+	}
+	var result struct {
+		Return []struct {
+			CPUIndex int `json:"cpu-index"`
+			ThreadID int `json:"thread-id"`
+		} `json:"return"`
+	}
+	if err := json.Unmarshal(raw, &result); err != nil {
+		return nil, err
+	}
+	threads := make([]int, len(result.Return))
+	for _, cpu := range result.Return {
+		if cpu.CPUIndex < 0 || cpu.CPUIndex >= len(threads) {
+			return nil, fmt.Errorf("unexpected vcpu index %d", cpu.CPUIndex)
+		}
+		threads[cpu.CPUIndex] = cpu.ThreadID
+	}
+	return threads, nil
+}
+
 func getQemuStatus(socket string) (string, error) {
 	if raw, err := execRawCmd(socket, `{ "execute": "query-status" }`); err == nil {
 		var result struct {
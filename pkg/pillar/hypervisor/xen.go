@@ -131,7 +131,24 @@ func (ctx xenContext) Task(status *types.DomainStatus) types.Task {
 	}
 }
 
+// ThrottleDisk overrides the one of containerd which throttles tasks only
+func (ctx xenContext) ThrottleDisk(domainName string, diskStatusList []types.DiskStatus, index int, limits types.VolumeIOLimits) error {
+	return logError("throttling disks of running domain %s is not supported by %s",
+		domainName, ctx.Name())
+}
+
+// GetDiskIOStats overrides the one of containerd which reports tasks only
+func (ctx xenContext) GetDiskIOStats(domainName string, diskStatusList []types.DiskStatus) ([]types.DiskIOMetric, error) {
+	return nil, nil
+}
+
 func (ctx xenContext) Setup(status types.DomainStatus, config types.DomainConfig, aa *types.AssignableAdapters, file *os.File) error {
+	if config.Vtpm != types.VtpmNone {
+		return logError("vTPM of domain %s is not supported by xen", status.DomainName)
+	}
+	if config.SecureBoot {
+		return logError("Secure Boot of domain %s is not supported by xen", status.DomainName)
+	}
 	// first lets build the domain config
 	if err := ctx.CreateDomConfig(status.DomainName, config, status.DiskStatusList, aa, file); err != nil {
 		return logError("failed to build domain config: %v", err)
@@ -303,8 +320,12 @@ func (ctx xenContext) CreateDomConfig(domainName string, config types.DomainConf
 		switch ds.Devtype {
 		case "":
 		case "9P":
+			tag := ds.MountTag
+			if tag == "" {
+				tag = "share_dir"
+			}
 			p9Strings = append(p9Strings,
-				fmt.Sprintf("'tag=share_dir,security_model=none,path=%s'", ds.FileLocation))
+				fmt.Sprintf("'tag=%s,security_model=none,path=%s'", tag, ds.FileLocation))
 		default:
 			access := "rw"
 			if ds.ReadOnly {
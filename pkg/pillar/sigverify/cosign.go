@@ -0,0 +1,390 @@
+// Copyright (c) 2022 Zededa, Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package sigverify
+
+import (
+	"bytes"
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/asn1"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/lf-edge/eve/pkg/pillar/types"
+)
+
+var (
+	// OIDC issuer extensions of Fulcio certificates; the first one is
+	// the raw string, the second one is DER encoded
+	oidIssuerV1 = asn1.ObjectIdentifier{1, 3, 6, 1, 4, 1, 57264, 1, 1}
+	oidIssuerV2 = asn1.ObjectIdentifier{1, 3, 6, 1, 4, 1, 57264, 1, 8}
+)
+
+// simpleSigning is the payload of a cosign signature
+type simpleSigning struct {
+	Critical struct {
+		Image struct {
+			DockerManifestDigest string `json:"docker-manifest-digest"`
+		} `json:"image"`
+		Type string `json:"type"`
+	} `json:"critical"`
+}
+
+// dsseEnvelope is the envelope of a cosign attestation
+type dsseEnvelope struct {
+	PayloadType string `json:"payloadType"`
+	Payload     []byte `json:"payload"`
+	Signatures  []struct {
+		Sig []byte `json:"sig"`
+	} `json:"signatures"`
+}
+
+// inTotoStatement is the payload of a cosign attestation
+type inTotoStatement struct {
+	Subject []struct {
+		Digest map[string]string `json:"digest"`
+	} `json:"subject"`
+	PredicateType string `json:"predicateType"`
+}
+
+// rekorBundle is the proof that a signature is in the transparency log
+type rekorBundle struct {
+	SignedEntryTimestamp []byte
+	Payload              rekorPayload
+}
+
+// rekorPayload is signed by the log in its canonical JSON form, hence
+// the fields are in the order of their names
+type rekorPayload struct {
+	Body           string `json:"body"`
+	IntegratedTime int64  `json:"integratedTime"`
+	LogID          string `json:"logID"`
+	LogIndex       int64  `json:"logIndex"`
+}
+
+// rekorEntry is the body of a hashedrekord entry, for signatures, or of
+// an intoto entry, for attestations, in the transparency log
+type rekorEntry struct {
+	Kind string `json:"kind"`
+	Spec struct {
+		// hashedrekord
+		Data struct {
+			Hash rekorHash `json:"hash"`
+		} `json:"data"`
+		Signature struct {
+			Content   string `json:"content"`
+			PublicKey struct {
+				Content string `json:"content"`
+			} `json:"publicKey"`
+		} `json:"signature"`
+		// intoto; the key is in the spec in version 0.0.1 and with the
+		// signatures of the envelope in version 0.0.2
+		Content struct {
+			PayloadHash rekorHash `json:"payloadHash"`
+			Envelope    struct {
+				Signatures []struct {
+					PublicKey string `json:"publicKey"`
+				} `json:"signatures"`
+			} `json:"envelope"`
+		} `json:"content"`
+		PublicKey string `json:"publicKey"`
+	} `json:"spec"`
+}
+
+type rekorHash struct {
+	Algorithm string `json:"algorithm"`
+	Value     string `json:"value"`
+}
+
+func verifyCosign(v types.CosignVerifier, digest string,
+	sig types.ImageSignature) error {
+
+	var signed [][]byte // alternatives for a DSSE envelope
+	var sigs [][]byte
+	switch {
+	case v.PredicateType == "" && sig.Kind == types.ImageSignatureCosign:
+		var payload simpleSigning
+		if err := json.Unmarshal(sig.Payload, &payload); err != nil {
+			return fmt.Errorf("parsing cosign payload failed: %v", err)
+		}
+		if strings.ToLower(payload.Critical.Image.DockerManifestDigest) != digest {
+			return fmt.Errorf("cosign signature is for image %s",
+				payload.Critical.Image.DockerManifestDigest)
+		}
+		raw, err := base64.StdEncoding.DecodeString(sig.Signature)
+		if err != nil {
+			return fmt.Errorf("decoding cosign signature failed: %v", err)
+		}
+		signed = append(signed, sig.Payload)
+		sigs = append(sigs, raw)
+	case v.PredicateType != "" && sig.Kind == types.ImageSignatureCosignAttestation:
+		var envelope dsseEnvelope
+		if err := json.Unmarshal(sig.Payload, &envelope); err != nil {
+			return fmt.Errorf("parsing attestation failed: %v", err)
+		}
+		if err := checkStatement(envelope.Payload, v.PredicateType, digest); err != nil {
+			return err
+		}
+		pae := dssePAE(envelope.PayloadType, envelope.Payload)
+		for _, s := range envelope.Signatures {
+			signed = append(signed, pae)
+			sigs = append(sigs, s.Sig)
+		}
+	default:
+		return fmt.Errorf("%s does not apply to %s", describeCosign(v), sig.Kind)
+	}
+
+	pub, err := cosignPublicKey(v, sig)
+	if err != nil {
+		return err
+	}
+	err = errors.New("no signature in attestation")
+	for i := range sigs {
+		if err = verifyASN1(pub, signed[i], sigs[i]); err == nil {
+			return nil
+		}
+	}
+	return fmt.Errorf("%s: %v", sig.Kind, err)
+}
+
+// checkStatement checks that the in-toto statement is of the predicate
+// type and about the image
+func checkStatement(statement []byte, predicateType, digest string) error {
+	var s inTotoStatement
+	if err := json.Unmarshal(statement, &s); err != nil {
+		return fmt.Errorf("parsing in-toto statement failed: %v", err)
+	}
+	if s.PredicateType != predicateType {
+		return fmt.Errorf("attestation is of predicate type %s", s.PredicateType)
+	}
+	for _, subject := range s.Subject {
+		if "sha256:"+strings.ToLower(subject.Digest["sha256"]) == digest {
+			return nil
+		}
+	}
+	return fmt.Errorf("attestation is not about image %s", digest)
+}
+
+// dssePAE is the pre-authentication encoding of a DSSE envelope, which
+// is what is signed
+func dssePAE(payloadType string, payload []byte) []byte {
+	return []byte(fmt.Sprintf("DSSEv1 %d %s %d %s", len(payloadType),
+		payloadType, len(payload), payload))
+}
+
+// cosignPublicKey returns the key of the verifier, or the key of the
+// certificate of the signature once it is verified
+func cosignPublicKey(v types.CosignVerifier,
+	sig types.ImageSignature) (crypto.PublicKey, error) {
+
+	if v.PublicKeyPEM != "" {
+		pub, err := parsePublicKey(v.PublicKeyPEM)
+		if err != nil {
+			return nil, fmt.Errorf("parsing cosign public key failed: %v", err)
+		}
+		return pub, nil
+	}
+	// Keyless certificates are short lived; they have to be valid when
+	// the signature was logged. Any certificate from the roots would do
+	// without an identity or an issuer.
+	if v.RekorPublicKeyPEM == "" {
+		return nil, errors.New("keyless cosign verifier has no transparency log key")
+	}
+	if v.Identity == "" && v.Issuer == "" {
+		return nil, errors.New("keyless cosign verifier has neither identity nor issuer")
+	}
+	certs, err := parseCertificates(sig.Certificate)
+	if err != nil || len(certs) == 0 {
+		return nil, fmt.Errorf("%s has no valid certificate: %v", sig.Kind, err)
+	}
+	cert := certs[0]
+	chain, err := parseCertificates(sig.Chain)
+	if err != nil {
+		return nil, fmt.Errorf("parsing certificate chain failed: %v", err)
+	}
+	signedAt, err := verifyBundle(v.RekorPublicKeyPEM, cert, sig)
+	if err != nil {
+		return nil, err
+	}
+	if err := verifyChain(cert, chain, v.RootsPEM, signedAt); err != nil {
+		return nil, err
+	}
+	if v.Identity != "" && !hasIdentity(cert, v.Identity) {
+		return nil, fmt.Errorf("certificate is not of identity %s", v.Identity)
+	}
+	if v.Issuer != "" {
+		if issuer := certIssuer(cert); issuer != v.Issuer {
+			return nil, fmt.Errorf("certificate is from issuer %q, not %s",
+				issuer, v.Issuer)
+		}
+	}
+	return cert.PublicKey, nil
+}
+
+// verifyBundle verifies that the signature, with the certificate, is in
+// the transparency log and returns when it was logged
+func verifyBundle(rekorKeyPEM string, cert *x509.Certificate,
+	sig types.ImageSignature) (time.Time, error) {
+
+	if sig.Bundle == "" {
+		return time.Time{}, errors.New("signature has no transparency log bundle")
+	}
+	pub, err := parsePublicKey(rekorKeyPEM)
+	if err != nil {
+		return time.Time{}, fmt.Errorf("parsing rekor public key failed: %v", err)
+	}
+	key, ok := pub.(*ecdsa.PublicKey)
+	if !ok {
+		return time.Time{}, fmt.Errorf("unsupported rekor public key type %T", pub)
+	}
+	var bundle rekorBundle
+	if err := json.Unmarshal([]byte(sig.Bundle), &bundle); err != nil {
+		return time.Time{}, fmt.Errorf("parsing transparency log bundle failed: %v", err)
+	}
+	canonical, err := json.Marshal(bundle.Payload)
+	if err != nil {
+		return time.Time{}, err
+	}
+	hash := sha256.Sum256(canonical)
+	if !ecdsa.VerifyASN1(key, hash[:], bundle.SignedEntryTimestamp) {
+		return time.Time{}, errors.New("invalid transparency log bundle")
+	}
+	body, err := base64.StdEncoding.DecodeString(bundle.Payload.Body)
+	if err != nil {
+		return time.Time{}, fmt.Errorf("decoding transparency log entry failed: %v", err)
+	}
+	var entry rekorEntry
+	if err := json.Unmarshal(body, &entry); err != nil {
+		return time.Time{}, fmt.Errorf("parsing transparency log entry failed: %v", err)
+	}
+	if err := checkEntry(entry, cert, sig); err != nil {
+		return time.Time{}, fmt.Errorf("transparency log entry is not for the %s: %v",
+			sig.Kind, err)
+	}
+	return time.Unix(bundle.Payload.IntegratedTime, 0), nil
+}
+
+// checkEntry checks that the log entry is of the certificate and of the
+// signature, or attestation
+func checkEntry(entry rekorEntry, cert *x509.Certificate,
+	sig types.ImageSignature) error {
+
+	var keys []string
+	switch {
+	case entry.Kind == "hashedrekord" && sig.Kind == types.ImageSignatureCosign:
+		raw, err := base64.StdEncoding.DecodeString(sig.Signature)
+		if err != nil {
+			return fmt.Errorf("decoding cosign signature failed: %v", err)
+		}
+		logged, err := base64.StdEncoding.DecodeString(entry.Spec.Signature.Content)
+		if err != nil || !bytes.Equal(logged, raw) {
+			return errors.New("other signature")
+		}
+		keys = append(keys, entry.Spec.Signature.PublicKey.Content)
+		if !hashes(entry.Spec.Data.Hash, sig.Payload) {
+			return errors.New("other payload")
+		}
+	case entry.Kind == "intoto" && sig.Kind == types.ImageSignatureCosignAttestation:
+		var envelope dsseEnvelope
+		if err := json.Unmarshal(sig.Payload, &envelope); err != nil {
+			return fmt.Errorf("parsing attestation failed: %v", err)
+		}
+		keys = append(keys, entry.Spec.PublicKey)
+		for _, s := range entry.Spec.Content.Envelope.Signatures {
+			keys = append(keys, s.PublicKey)
+		}
+		if !hashes(entry.Spec.Content.PayloadHash, envelope.Payload) {
+			return errors.New("other statement")
+		}
+	default:
+		return fmt.Errorf("entry of kind %q", entry.Kind)
+	}
+	for _, key := range keys {
+		certPEM, err := base64.StdEncoding.DecodeString(key)
+		if err != nil {
+			continue
+		}
+		certs, err := parseCertificates(string(certPEM))
+		if err == nil && len(certs) != 0 && certs[0].Equal(cert) {
+			return nil
+		}
+	}
+	return errors.New("other certificate")
+}
+
+// hashes returns true if the hash of the log entry is the one of data
+func hashes(hash rekorHash, data []byte) bool {
+	sum := sha256.Sum256(data)
+	return hash.Algorithm == "sha256" &&
+		strings.ToLower(hash.Value) == hex.EncodeToString(sum[:])
+}
+
+// hasIdentity returns true if the identity is a subject alternative name
+// of the certificate
+func hasIdentity(cert *x509.Certificate, identity string) bool {
+	for _, email := range cert.EmailAddresses {
+		if email == identity {
+			return true
+		}
+	}
+	for _, uri := range cert.URIs {
+		if uri.String() == identity {
+			return true
+		}
+	}
+	return false
+}
+
+// certIssuer returns the OIDC issuer in a Fulcio certificate
+func certIssuer(cert *x509.Certificate) string {
+	for _, ext := range cert.Extensions {
+		if ext.Id.Equal(oidIssuerV2) {
+			var issuer string
+			if _, err := asn1.Unmarshal(ext.Value, &issuer); err == nil {
+				return issuer
+			}
+		}
+	}
+	for _, ext := range cert.Extensions {
+		if ext.Id.Equal(oidIssuerV1) {
+			return string(ext.Value)
+		}
+	}
+	return ""
+}
+
+// cosignTag returns the tag of the cosign signatures, or attestations,
+// of the image with the digest
+func cosignTag(digest string, attestation bool) string {
+	tag := strings.Replace(digest, ":", "-", 1)
+	if attestation {
+		return tag + ".att"
+	}
+	return tag + ".sig"
+}
+
+// CosignSignatureTag returns the tag of the cosign signatures of the image
+func CosignSignatureTag(digest string) string {
+	return cosignTag(digest, false)
+}
+
+// CosignAttestationTag returns the tag of the cosign attestations of the
+// image
+func CosignAttestationTag(digest string) string {
+	return cosignTag(digest, true)
+}
+
+// ReferrersTag returns the tag of the index of the artifacts which refer
+// to the image, e.g. Notation signatures, in registries without the
+// referrers API
+func ReferrersTag(digest string) string {
+	return strings.Replace(digest, ":", "-", 1)
+}
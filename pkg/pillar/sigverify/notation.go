@@ -0,0 +1,273 @@
+// Copyright (c) 2022 Zededa, Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package sigverify
+
+import (
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/rsa"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math/big"
+	"strings"
+	"time"
+
+	"github.com/lf-edge/eve/pkg/pillar/types"
+)
+
+// NotationMediaType is the media type of the envelopes of Notation
+// signatures which are verified
+const NotationMediaType = "application/jose+json"
+
+// NotationArtifactType is the artifact type of Notation signatures
+const NotationArtifactType = "application/vnd.cncf.notary.signature"
+
+// jwsEnvelope is a Notation signature in JWS JSON serialization
+type jwsEnvelope struct {
+	Payload   string `json:"payload"`
+	Protected string `json:"protected"`
+	Header    struct {
+		X5c [][]byte `json:"x5c"`
+	} `json:"header"`
+	Signature string `json:"signature"`
+}
+
+type jwsProtected struct {
+	Alg           string   `json:"alg"`
+	Crit          []string `json:"crit"`
+	SigningScheme string   `json:"io.cncf.notary.signingScheme"`
+	Expiry        string   `json:"io.cncf.notary.expiry"`
+}
+
+// notationCritical are the critical headers which are understood. The
+// authentic signing time is not trusted without verifying the timestamp
+// countersignature, but this does not matter since the certificate chain
+// is checked at the time of the verification.
+var notationCritical = map[string]bool{
+	"io.cncf.notary.signingScheme":        true,
+	"io.cncf.notary.expiry":               true,
+	"io.cncf.notary.authenticSigningTime": true,
+}
+
+type notationPayload struct {
+	TargetArtifact struct {
+		Digest string `json:"digest"`
+	} `json:"targetArtifact"`
+}
+
+// verifyNotation returns the subject of the certificate which signed the
+// image with the digest. The signing time is set by the signer, hence the
+// certificate chain is checked now: timestamp countersignatures are not
+// verified.
+func verifyNotation(v types.NotationVerifier, digest string,
+	sig types.ImageSignature, now time.Time) (string, error) {
+
+	if sig.Kind != types.ImageSignatureNotation {
+		return "", fmt.Errorf("notation verifier does not apply to %s", sig.Kind)
+	}
+	if sig.MediaType != NotationMediaType {
+		return "", fmt.Errorf("unsupported notation signature envelope %s",
+			sig.MediaType)
+	}
+	var envelope jwsEnvelope
+	if err := json.Unmarshal(sig.Payload, &envelope); err != nil {
+		return "", fmt.Errorf("parsing notation signature failed: %v", err)
+	}
+	var protected jwsProtected
+	if err := decodeSegment(envelope.Protected, &protected); err != nil {
+		return "", fmt.Errorf("parsing notation protected header failed: %v", err)
+	}
+	if err := checkCritical(envelope.Protected, protected.Crit); err != nil {
+		return "", err
+	}
+	switch protected.SigningScheme {
+	case "", "notary.x509", "notary.x509.signingAuthority":
+	default:
+		return "", fmt.Errorf("unsupported notation signing scheme %s",
+			protected.SigningScheme)
+	}
+	var payload notationPayload
+	if err := decodeSegment(envelope.Payload, &payload); err != nil {
+		return "", fmt.Errorf("parsing notation payload failed: %v", err)
+	}
+	if strings.ToLower(payload.TargetArtifact.Digest) != digest {
+		return "", fmt.Errorf("notation signature is for image %s",
+			payload.TargetArtifact.Digest)
+	}
+	if protected.Expiry != "" {
+		expiry, err := time.Parse(time.RFC3339, protected.Expiry)
+		if err != nil {
+			return "", fmt.Errorf("parsing notation expiry failed: %v", err)
+		}
+		if now.After(expiry) {
+			return "", fmt.Errorf("notation signature expired at %s",
+				protected.Expiry)
+		}
+	}
+	var chain []*x509.Certificate
+	for _, der := range envelope.Header.X5c {
+		cert, err := x509.ParseCertificate(der)
+		if err != nil {
+			return "", fmt.Errorf("parsing notation certificate failed: %v", err)
+		}
+		chain = append(chain, cert)
+	}
+	if len(chain) == 0 {
+		return "", errors.New("notation signature has no certificate")
+	}
+	cert := chain[0]
+	if err := verifyChain(cert, chain[1:], v.RootsPEM, now); err != nil {
+		return "", err
+	}
+	if !isTrustedIdentity(cert, v.TrustedIdentities) {
+		return "", fmt.Errorf("%s is not a trusted identity", cert.Subject)
+	}
+
+	raw, err := base64.RawURLEncoding.DecodeString(envelope.Signature)
+	if err != nil {
+		return "", fmt.Errorf("decoding notation signature failed: %v", err)
+	}
+	signed := []byte(envelope.Protected + "." + envelope.Payload)
+	if err := verifyJWS(protected.Alg, cert.PublicKey, signed, raw); err != nil {
+		return "", err
+	}
+	return cert.Subject.String(), nil
+}
+
+// checkCritical fails unless all the critical headers are understood
+// and in the protected header
+func checkCritical(segment string, crit []string) error {
+	var headers map[string]json.RawMessage
+	if err := decodeSegment(segment, &headers); err != nil {
+		return fmt.Errorf("parsing notation protected header failed: %v", err)
+	}
+	for _, name := range crit {
+		if !notationCritical[name] {
+			return fmt.Errorf("unsupported critical notation header %s", name)
+		}
+		if _, ok := headers[name]; !ok {
+			return fmt.Errorf("critical notation header %s is missing", name)
+		}
+	}
+	return nil
+}
+
+// decodeSegment decodes a base64url JSON segment of a JWS
+func decodeSegment(segment string, v interface{}) error {
+	data, err := base64.RawURLEncoding.DecodeString(segment)
+	if err != nil {
+		return err
+	}
+	return json.Unmarshal(data, v)
+}
+
+// verifyJWS verifies a JWS signature with the algorithms Notation allows
+func verifyJWS(alg string, pub crypto.PublicKey, data, sig []byte) error {
+	var hash crypto.Hash
+	switch alg {
+	case "PS256", "ES256":
+		hash = crypto.SHA256
+	case "PS384", "ES384":
+		hash = crypto.SHA384
+	case "PS512", "ES512":
+		hash = crypto.SHA512
+	default:
+		return fmt.Errorf("unsupported notation signature algorithm %s", alg)
+	}
+	h := hash.New()
+	h.Write(data)
+	digest := h.Sum(nil)
+	switch key := pub.(type) {
+	case *rsa.PublicKey:
+		if alg[0] != 'P' {
+			return fmt.Errorf("%s signature with an RSA key", alg)
+		}
+		opts := &rsa.PSSOptions{SaltLength: rsa.PSSSaltLengthEqualsHash}
+		if err := rsa.VerifyPSS(key, hash, digest, sig, opts); err != nil {
+			return fmt.Errorf("invalid notation signature: %v", err)
+		}
+	case *ecdsa.PublicKey:
+		if alg[0] != 'E' {
+			return fmt.Errorf("%s signature with an ECDSA key", alg)
+		}
+		// The signature is r || s, each of the size of the curve
+		size := (key.Curve.Params().BitSize + 7) / 8
+		if len(sig) != 2*size {
+			return errors.New("invalid notation signature size")
+		}
+		r := new(big.Int).SetBytes(sig[:size])
+		s := new(big.Int).SetBytes(sig[size:])
+		if !ecdsa.Verify(key, digest, r, s) {
+			return errors.New("invalid notation signature")
+		}
+	default:
+		return fmt.Errorf("unsupported public key type %T", pub)
+	}
+	return nil
+}
+
+// isTrustedIdentity returns true if the subject of the certificate has
+// all the attributes of any of the identities, which are in the form
+// "x509.subject: C=US, O=Example"
+func isTrustedIdentity(cert *x509.Certificate, identities []string) bool {
+	if len(identities) == 0 {
+		return true
+	}
+	subject := make(map[string][]string)
+	for _, name := range cert.Subject.Names {
+		key := attributeNames[name.Type.String()]
+		if key == "" {
+			key = name.Type.String()
+		}
+		subject[key] = append(subject[key], fmt.Sprint(name.Value))
+	}
+	for _, identity := range identities {
+		if identity == "*" {
+			return true
+		}
+		attrs := strings.TrimPrefix(identity, "x509.subject:")
+		if attrs == identity {
+			continue
+		}
+		if hasAttributes(subject, attrs) {
+			return true
+		}
+	}
+	return false
+}
+
+// attributeNames are the short names of the subject attributes
+var attributeNames = map[string]string{
+	"2.5.4.3":  "CN",
+	"2.5.4.6":  "C",
+	"2.5.4.7":  "L",
+	"2.5.4.8":  "ST",
+	"2.5.4.10": "O",
+	"2.5.4.11": "OU",
+}
+
+func hasAttributes(subject map[string][]string, attrs string) bool {
+	found := false
+	for _, attr := range strings.Split(attrs, ",") {
+		kv := strings.SplitN(strings.TrimSpace(attr), "=", 2)
+		if len(kv) != 2 {
+			return false
+		}
+		match := false
+		for _, value := range subject[kv[0]] {
+			if value == kv[1] {
+				match = true
+				break
+			}
+		}
+		if !match {
+			return false
+		}
+		found = true
+	}
+	return found
+}
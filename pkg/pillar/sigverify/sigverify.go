@@ -0,0 +1,173 @@
+// Copyright (c) 2022 Zededa, Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+// Package sigverify verifies the cosign and Notation signatures of OCI
+// images against a SignaturePolicy. The signatures are fetched from the
+// registry by the downloader; this package only does the cryptography
+// and has no network access, hence keyless verification relies on the
+// locally configured trust roots.
+package sigverify
+
+import (
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/ed25519"
+	"crypto/rsa"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/lf-edge/eve/pkg/pillar/types"
+)
+
+// Verify returns a description of what verified one of the signatures of
+// the image with the digest (sha256:<hex>) according to the policy, or
+// an error which tells why none did
+func Verify(policy types.SignaturePolicy, digest string,
+	signatures []types.ImageSignature, now time.Time) (string, error) {
+
+	digest = strings.ToLower(digest)
+	if !strings.HasPrefix(digest, "sha256:") {
+		digest = "sha256:" + digest
+	}
+	if len(signatures) == 0 {
+		return "", fmt.Errorf("no signatures found for image %s", digest)
+	}
+	var errs []string
+	addErr := func(err error) {
+		for _, e := range errs {
+			if e == err.Error() {
+				return
+			}
+		}
+		errs = append(errs, err.Error())
+	}
+	for _, v := range policy.Cosign {
+		for _, sig := range signatures {
+			err := verifyCosign(v, digest, sig)
+			if err == nil {
+				return describeCosign(v), nil
+			}
+			addErr(err)
+		}
+	}
+	for _, v := range policy.Notation {
+		for _, sig := range signatures {
+			subject, err := verifyNotation(v, digest, sig, now)
+			if err == nil {
+				return "notation certificate of " + subject, nil
+			}
+			addErr(err)
+		}
+	}
+	return "", fmt.Errorf("no signature of image %s meets the signature policy: %s",
+		digest, strings.Join(errs, "; "))
+}
+
+func describeCosign(v types.CosignVerifier) string {
+	var desc string
+	if v.PublicKeyPEM != "" {
+		desc = "cosign key"
+	} else {
+		desc = "cosign certificate"
+		if v.Identity != "" {
+			desc += " of " + v.Identity
+		}
+		if v.Issuer != "" {
+			desc += " from " + v.Issuer
+		}
+	}
+	if v.PredicateType != "" {
+		desc += " attesting " + v.PredicateType
+	}
+	return desc
+}
+
+// parsePublicKey parses a PEM public key
+func parsePublicKey(keyPEM string) (crypto.PublicKey, error) {
+	block, _ := pem.Decode([]byte(keyPEM))
+	if block == nil {
+		return nil, errors.New("no PEM public key")
+	}
+	return x509.ParsePKIXPublicKey(block.Bytes)
+}
+
+// parseCertificates parses the PEM certificates
+func parseCertificates(certsPEM string) ([]*x509.Certificate, error) {
+	var certs []*x509.Certificate
+	rest := []byte(certsPEM)
+	for {
+		var block *pem.Block
+		block, rest = pem.Decode(rest)
+		if block == nil {
+			break
+		}
+		if block.Type != "CERTIFICATE" {
+			continue
+		}
+		cert, err := x509.ParseCertificate(block.Bytes)
+		if err != nil {
+			return nil, err
+		}
+		certs = append(certs, cert)
+	}
+	return certs, nil
+}
+
+// verifyChain verifies that the certificate is issued for code signing
+// by the roots at time t
+func verifyChain(cert *x509.Certificate, intermediates []*x509.Certificate,
+	rootsPEM string, t time.Time) error {
+
+	roots, err := parseCertificates(rootsPEM)
+	if err != nil {
+		return fmt.Errorf("parsing roots failed: %v", err)
+	}
+	if len(roots) == 0 {
+		return errors.New("no roots to verify the certificate")
+	}
+	opts := x509.VerifyOptions{
+		Roots:         x509.NewCertPool(),
+		Intermediates: x509.NewCertPool(),
+		CurrentTime:   t,
+		KeyUsages:     []x509.ExtKeyUsage{x509.ExtKeyUsageCodeSigning},
+	}
+	for _, root := range roots {
+		opts.Roots.AddCert(root)
+	}
+	for _, intermediate := range intermediates {
+		opts.Intermediates.AddCert(intermediate)
+	}
+	if _, err := cert.Verify(opts); err != nil {
+		return fmt.Errorf("certificate of %s is not trusted: %v",
+			cert.Subject, err)
+	}
+	return nil
+}
+
+// verifyASN1 verifies a cosign signature of data; ECDSA signatures are
+// ASN.1 encoded
+func verifyASN1(pub crypto.PublicKey, data, sig []byte) error {
+	hash := sha256.Sum256(data)
+	switch key := pub.(type) {
+	case *ecdsa.PublicKey:
+		if !ecdsa.VerifyASN1(key, hash[:], sig) {
+			return errors.New("invalid ECDSA signature")
+		}
+	case *rsa.PublicKey:
+		if err := rsa.VerifyPKCS1v15(key, crypto.SHA256, hash[:], sig); err != nil {
+			return fmt.Errorf("invalid RSA signature: %v", err)
+		}
+	case ed25519.PublicKey:
+		if !ed25519.Verify(key, data, sig) {
+			return errors.New("invalid Ed25519 signature")
+		}
+	default:
+		return fmt.Errorf("unsupported public key type %T", pub)
+	}
+	return nil
+}
@@ -0,0 +1,536 @@
+// Copyright (c) 2022 Zededa, Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package sigverify
+
+import (
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/sha256"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/asn1"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
+	"encoding/pem"
+	"fmt"
+	"math/big"
+	"net/url"
+	"testing"
+	"time"
+
+	"github.com/lf-edge/eve/pkg/pillar/types"
+	"github.com/stretchr/testify/assert"
+)
+
+const (
+	testDigest    = "sha256:4e07408562bedb8b60ce05c1decfe3ad16b72230967de01f640b7e4729b49fce"
+	otherDigest   = "sha256:0000000000000000000000000000000000000000000000000000000000000000"
+	testIdentity  = "builder@example.com"
+	testIssuer    = "https://issuer.example.com"
+	testPredicate = "https://slsa.dev/provenance/v0.2"
+)
+
+type testPKI struct {
+	rootPEM string
+	leaf    *x509.Certificate
+	leafPEM string
+	leafKey *ecdsa.PrivateKey
+	signKey *ecdsa.PrivateKey // cosign key pair
+	pubPEM  string
+	rekor   *ecdsa.PrivateKey
+	rekPEM  string
+}
+
+func newKey(t *testing.T) *ecdsa.PrivateKey {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	assert.NoError(t, err)
+	return key
+}
+
+func pubKeyPEM(t *testing.T, pub crypto.PublicKey) string {
+	der, err := x509.MarshalPKIXPublicKey(pub)
+	assert.NoError(t, err)
+	return string(pem.EncodeToMemory(&pem.Block{Type: "PUBLIC KEY", Bytes: der}))
+}
+
+func certPEM(der []byte) string {
+	return string(pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der}))
+}
+
+func newTestPKI(t *testing.T) testPKI {
+	return newTestPKIAt(t, time.Now())
+}
+
+// newTestPKIAt returns a PKI with a short lived signing certificate
+// issued at the time
+func newTestPKIAt(t *testing.T, at time.Time) testPKI {
+	var pki testPKI
+	rootKey := newKey(t)
+	rootTemplate := &x509.Certificate{
+		SerialNumber:          big.NewInt(1),
+		Subject:               pkix.Name{CommonName: "Test Root"},
+		NotBefore:             at.Add(-time.Hour),
+		NotAfter:              at.Add(24 * time.Hour),
+		KeyUsage:              x509.KeyUsageCertSign,
+		BasicConstraintsValid: true,
+		IsCA:                  true,
+	}
+	rootDER, err := x509.CreateCertificate(rand.Reader, rootTemplate,
+		rootTemplate, &rootKey.PublicKey, rootKey)
+	assert.NoError(t, err)
+	root, err := x509.ParseCertificate(rootDER)
+	assert.NoError(t, err)
+	pki.rootPEM = certPEM(rootDER)
+
+	issuer, err := asn1.Marshal(testIssuer)
+	assert.NoError(t, err)
+	uri, err := url.Parse("https://example.com/workflow")
+	assert.NoError(t, err)
+	pki.leafKey = newKey(t)
+	leafTemplate := &x509.Certificate{
+		SerialNumber: big.NewInt(2),
+		Subject: pkix.Name{CommonName: "Signer", Organization: []string{"Example"},
+			Country: []string{"US"}},
+		NotBefore:      at.Add(-time.Minute),
+		NotAfter:       at.Add(10 * time.Minute),
+		KeyUsage:       x509.KeyUsageDigitalSignature,
+		ExtKeyUsage:    []x509.ExtKeyUsage{x509.ExtKeyUsageCodeSigning},
+		EmailAddresses: []string{testIdentity},
+		URIs:           []*url.URL{uri},
+		ExtraExtensions: []pkix.Extension{
+			{Id: oidIssuerV2, Value: issuer},
+		},
+	}
+	leafDER, err := x509.CreateCertificate(rand.Reader, leafTemplate, root,
+		&pki.leafKey.PublicKey, rootKey)
+	assert.NoError(t, err)
+	pki.leaf, err = x509.ParseCertificate(leafDER)
+	assert.NoError(t, err)
+	pki.leafPEM = certPEM(leafDER)
+
+	pki.signKey = newKey(t)
+	pki.pubPEM = pubKeyPEM(t, &pki.signKey.PublicKey)
+	pki.rekor = newKey(t)
+	pki.rekPEM = pubKeyPEM(t, &pki.rekor.PublicKey)
+	return pki
+}
+
+func signASN1(t *testing.T, key *ecdsa.PrivateKey, data []byte) []byte {
+	hash := sha256.Sum256(data)
+	sig, err := ecdsa.SignASN1(rand.Reader, key, hash[:])
+	assert.NoError(t, err)
+	return sig
+}
+
+func cosignPayload(digest string) []byte {
+	return []byte(fmt.Sprintf(`{"critical":{"identity":{"docker-reference":"example.com/app"},"image":{"docker-manifest-digest":"%s"},"type":"cosign container image signature"},"optional":null}`, digest))
+}
+
+func cosignSignature(t *testing.T, key *ecdsa.PrivateKey, digest string) types.ImageSignature {
+	payload := cosignPayload(digest)
+	return types.ImageSignature{
+		Kind:      types.ImageSignatureCosign,
+		Payload:   payload,
+		Signature: base64.StdEncoding.EncodeToString(signASN1(t, key, payload)),
+	}
+}
+
+func sha256Hex(data []byte) string {
+	sum := sha256.Sum256(data)
+	return hex.EncodeToString(sum[:])
+}
+
+// rekorBundleFor returns a bundle of the log entry of the signature, or
+// attestation, with its certificate
+func rekorBundleFor(t *testing.T, key *ecdsa.PrivateKey, sig types.ImageSignature,
+	integrated time.Time) string {
+
+	cert := base64.StdEncoding.EncodeToString([]byte(sig.Certificate))
+	var body string
+	if sig.Kind == types.ImageSignatureCosignAttestation {
+		var envelope dsseEnvelope
+		assert.NoError(t, json.Unmarshal(sig.Payload, &envelope))
+		body = fmt.Sprintf(`{"apiVersion":"0.0.1","kind":"intoto","spec":{"content":{"hash":{"algorithm":"sha256","value":"%s"},"payloadHash":{"algorithm":"sha256","value":"%s"}},"publicKey":"%s"}}`,
+			sha256Hex(sig.Payload), sha256Hex(envelope.Payload), cert)
+	} else {
+		body = fmt.Sprintf(`{"apiVersion":"0.0.1","kind":"hashedrekord","spec":{"data":{"hash":{"algorithm":"sha256","value":"%s"}},"signature":{"content":"%s","publicKey":{"content":"%s"}}}}`,
+			sha256Hex(sig.Payload), sig.Signature, cert)
+	}
+	payload := rekorPayload{
+		Body:           base64.StdEncoding.EncodeToString([]byte(body)),
+		IntegratedTime: integrated.Unix(),
+		LogID:          "c0d23d6ad406973f9559f3ba2d1ca01f84147d8ffc5b8445c224f98b9591801d",
+		LogIndex:       42,
+	}
+	canonical, err := json.Marshal(payload)
+	assert.NoError(t, err)
+	bundle, err := json.Marshal(rekorBundle{
+		SignedEntryTimestamp: signASN1(t, key, canonical),
+		Payload:              payload,
+	})
+	assert.NoError(t, err)
+	return string(bundle)
+}
+
+func attestation(t *testing.T, key *ecdsa.PrivateKey, predicateType, digest string) types.ImageSignature {
+	statement := []byte(fmt.Sprintf(`{"_type":"https://in-toto.io/Statement/v0.1","predicateType":"%s","subject":[{"name":"example.com/app","digest":{"sha256":"%s"}}],"predicate":{}}`,
+		predicateType, digest[len("sha256:"):]))
+	payloadType := "application/vnd.in-toto+json"
+	envelope := dsseEnvelope{
+		PayloadType: payloadType,
+		Payload:     statement,
+	}
+	envelope.Signatures = append(envelope.Signatures, struct {
+		Sig []byte `json:"sig"`
+	}{Sig: signASN1(t, key, dssePAE(payloadType, statement))})
+	data, err := json.Marshal(envelope)
+	assert.NoError(t, err)
+	return types.ImageSignature{
+		Kind:    types.ImageSignatureCosignAttestation,
+		Payload: data,
+	}
+}
+
+func notationSignature(t *testing.T, pki testPKI, digest string, expiry time.Time) types.ImageSignature {
+	return notationSignatureWith(t, pki, digest, map[string]interface{}{
+		"io.cncf.notary.signingTime": time.Now().Format(time.RFC3339),
+		"io.cncf.notary.expiry":      expiry.Format(time.RFC3339),
+	})
+}
+
+// notationSignatureWith returns a notation signature with the headers
+// added to the protected header
+func notationSignatureWith(t *testing.T, pki testPKI, digest string,
+	headers map[string]interface{}) types.ImageSignature {
+	protected := map[string]interface{}{
+		"alg":                          "ES256",
+		"cty":                          "application/vnd.cncf.notary.payload.v1+json",
+		"crit":                         []string{"io.cncf.notary.signingScheme"},
+		"io.cncf.notary.signingScheme": "notary.x509",
+	}
+	for name, value := range headers {
+		protected[name] = value
+	}
+	payload := map[string]interface{}{
+		"targetArtifact": map[string]interface{}{
+			"mediaType": "application/vnd.oci.image.manifest.v1+json",
+			"digest":    digest,
+			"size":      1234,
+		},
+	}
+	encode := func(v interface{}) string {
+		data, err := json.Marshal(v)
+		assert.NoError(t, err)
+		return base64.RawURLEncoding.EncodeToString(data)
+	}
+	envelope := jwsEnvelope{
+		Protected: encode(protected),
+		Payload:   encode(payload),
+	}
+	envelope.Header.X5c = [][]byte{pki.leaf.Raw}
+	hash := sha256.Sum256([]byte(envelope.Protected + "." + envelope.Payload))
+	r, s, err := ecdsa.Sign(rand.Reader, pki.leafKey, hash[:])
+	assert.NoError(t, err)
+	sig := make([]byte, 64)
+	r.FillBytes(sig[:32])
+	s.FillBytes(sig[32:])
+	envelope.Signature = base64.RawURLEncoding.EncodeToString(sig)
+	data, err := json.Marshal(envelope)
+	assert.NoError(t, err)
+	return types.ImageSignature{
+		Kind:      types.ImageSignatureNotation,
+		MediaType: NotationMediaType,
+		Payload:   data,
+	}
+}
+
+func TestVerify(t *testing.T) {
+	pki := newTestPKI(t)
+	keyless := cosignSignature(t, pki.leafKey, testDigest)
+	keyless.Certificate = pki.leafPEM
+	logged := keyless
+	logged.Bundle = rekorBundleFor(t, pki.rekor, keyless, time.Now())
+	forgedLog := keyless
+	forgedLog.Bundle = rekorBundleFor(t, newKey(t), keyless, time.Now())
+	// A bundle of another signature with the same certificate
+	other := cosignSignature(t, pki.leafKey, otherDigest)
+	other.Certificate = pki.leafPEM
+	swappedLog := keyless
+	swappedLog.Bundle = rekorBundleFor(t, pki.rekor, other, time.Now())
+	loggedAttestation := attestation(t, pki.leafKey, testPredicate, testDigest)
+	loggedAttestation.Certificate = pki.leafPEM
+	loggedAttestation.Bundle = rekorBundleFor(t, pki.rekor, loggedAttestation, time.Now())
+	// The certificate expired an hour ago; it was valid when logged then
+	expiredPKI := newTestPKIAt(t, time.Now().Add(-time.Hour))
+	expired := cosignSignature(t, expiredPKI.leafKey, testDigest)
+	expired.Certificate = expiredPKI.leafPEM
+	expiredLog := expired
+	expiredLog.Bundle = rekorBundleFor(t, pki.rekor, expired, time.Now().Add(-time.Hour))
+	expired.Bundle = rekorBundleFor(t, pki.rekor, expired, time.Now())
+	keyed := types.CosignVerifier{PublicKeyPEM: pki.pubPEM}
+	keylessVerifier := types.CosignVerifier{
+		RootsPEM: pki.rootPEM,
+		Identity: testIdentity,
+		Issuer:   testIssuer,
+	}
+	rekorVerifier := keylessVerifier
+	rekorVerifier.RekorPublicKeyPEM = pki.rekPEM
+	expiredVerifier := rekorVerifier
+	expiredVerifier.RootsPEM = expiredPKI.rootPEM
+
+	testMatrix := map[string]struct {
+		policy     types.SignaturePolicy
+		signatures []types.ImageSignature
+		verifiedBy string
+		fail       bool
+	}{
+		"No signatures": {
+			policy: types.SignaturePolicy{Cosign: []types.CosignVerifier{keyed}},
+			fail:   true,
+		},
+		"Cosign key": {
+			policy:     types.SignaturePolicy{Cosign: []types.CosignVerifier{keyed}},
+			signatures: []types.ImageSignature{cosignSignature(t, pki.signKey, testDigest)},
+			verifiedBy: "cosign key",
+		},
+		"Cosign key of other image": {
+			policy:     types.SignaturePolicy{Cosign: []types.CosignVerifier{keyed}},
+			signatures: []types.ImageSignature{cosignSignature(t, pki.signKey, otherDigest)},
+			fail:       true,
+		},
+		"Cosign other key": {
+			policy:     types.SignaturePolicy{Cosign: []types.CosignVerifier{keyed}},
+			signatures: []types.ImageSignature{cosignSignature(t, newKey(t), testDigest)},
+			fail:       true,
+		},
+		"Cosign any of the signatures": {
+			policy: types.SignaturePolicy{Cosign: []types.CosignVerifier{keyed}},
+			signatures: []types.ImageSignature{
+				cosignSignature(t, newKey(t), testDigest),
+				cosignSignature(t, pki.signKey, testDigest),
+			},
+			verifiedBy: "cosign key",
+		},
+		"Cosign keyless": {
+			policy:     types.SignaturePolicy{Cosign: []types.CosignVerifier{rekorVerifier}},
+			signatures: []types.ImageSignature{logged},
+			verifiedBy: "cosign certificate of " + testIdentity + " from " + testIssuer,
+		},
+		"Cosign keyless other identity": {
+			policy: types.SignaturePolicy{Cosign: []types.CosignVerifier{{
+				RootsPEM:          pki.rootPEM,
+				RekorPublicKeyPEM: pki.rekPEM,
+				Identity:          "someone@example.com",
+			}}},
+			signatures: []types.ImageSignature{logged},
+			fail:       true,
+		},
+		"Cosign keyless URI identity": {
+			policy: types.SignaturePolicy{Cosign: []types.CosignVerifier{{
+				RootsPEM:          pki.rootPEM,
+				RekorPublicKeyPEM: pki.rekPEM,
+				Identity:          "https://example.com/workflow",
+			}}},
+			signatures: []types.ImageSignature{logged},
+			verifiedBy: "cosign certificate of https://example.com/workflow",
+		},
+		"Cosign keyless other issuer": {
+			policy: types.SignaturePolicy{Cosign: []types.CosignVerifier{{
+				RootsPEM:          pki.rootPEM,
+				RekorPublicKeyPEM: pki.rekPEM,
+				Issuer:            "https://other.example.com",
+			}}},
+			signatures: []types.ImageSignature{logged},
+			fail:       true,
+		},
+		"Cosign keyless untrusted root": {
+			policy: types.SignaturePolicy{Cosign: []types.CosignVerifier{{
+				RootsPEM:          newTestPKI(t).rootPEM,
+				RekorPublicKeyPEM: pki.rekPEM,
+				Issuer:            testIssuer,
+			}}},
+			signatures: []types.ImageSignature{logged},
+			fail:       true,
+		},
+		"Cosign keyless without log key": {
+			policy:     types.SignaturePolicy{Cosign: []types.CosignVerifier{keylessVerifier}},
+			signatures: []types.ImageSignature{logged},
+			fail:       true,
+		},
+		"Cosign keyless without identity or issuer": {
+			policy: types.SignaturePolicy{Cosign: []types.CosignVerifier{{
+				RootsPEM:          pki.rootPEM,
+				RekorPublicKeyPEM: pki.rekPEM,
+			}}},
+			signatures: []types.ImageSignature{logged},
+			fail:       true,
+		},
+		"Cosign keyless without log": {
+			policy:     types.SignaturePolicy{Cosign: []types.CosignVerifier{rekorVerifier}},
+			signatures: []types.ImageSignature{keyless},
+			fail:       true,
+		},
+		"Cosign keyless with forged log": {
+			policy:     types.SignaturePolicy{Cosign: []types.CosignVerifier{rekorVerifier}},
+			signatures: []types.ImageSignature{forgedLog},
+			fail:       true,
+		},
+		"Cosign keyless with log of other signature": {
+			policy:     types.SignaturePolicy{Cosign: []types.CosignVerifier{rekorVerifier}},
+			signatures: []types.ImageSignature{swappedLog},
+			fail:       true,
+		},
+		"Cosign keyless expired certificate logged when valid": {
+			policy:     types.SignaturePolicy{Cosign: []types.CosignVerifier{expiredVerifier}},
+			signatures: []types.ImageSignature{expiredLog},
+			verifiedBy: "cosign certificate of " + testIdentity + " from " + testIssuer,
+		},
+		"Cosign keyless expired certificate": {
+			policy:     types.SignaturePolicy{Cosign: []types.CosignVerifier{expiredVerifier}},
+			signatures: []types.ImageSignature{expired},
+			fail:       true,
+		},
+		"Cosign keyless attestation": {
+			policy: types.SignaturePolicy{Cosign: []types.CosignVerifier{{
+				RootsPEM:          pki.rootPEM,
+				RekorPublicKeyPEM: pki.rekPEM,
+				Identity:          testIdentity,
+				PredicateType:     testPredicate,
+			}}},
+			signatures: []types.ImageSignature{loggedAttestation},
+			verifiedBy: "cosign certificate of " + testIdentity + " attesting " + testPredicate,
+		},
+		"Cosign attestation": {
+			policy: types.SignaturePolicy{Cosign: []types.CosignVerifier{{
+				PublicKeyPEM:  pki.pubPEM,
+				PredicateType: testPredicate,
+			}}},
+			signatures: []types.ImageSignature{
+				cosignSignature(t, pki.signKey, testDigest),
+				attestation(t, pki.signKey, testPredicate, testDigest),
+			},
+			verifiedBy: "cosign key attesting " + testPredicate,
+		},
+		"Cosign attestation of other predicate": {
+			policy: types.SignaturePolicy{Cosign: []types.CosignVerifier{{
+				PublicKeyPEM:  pki.pubPEM,
+				PredicateType: testPredicate,
+			}}},
+			signatures: []types.ImageSignature{
+				attestation(t, pki.signKey, "https://spdx.dev/Document", testDigest),
+			},
+			fail: true,
+		},
+		"Cosign attestation of other image": {
+			policy: types.SignaturePolicy{Cosign: []types.CosignVerifier{{
+				PublicKeyPEM:  pki.pubPEM,
+				PredicateType: testPredicate,
+			}}},
+			signatures: []types.ImageSignature{
+				attestation(t, pki.signKey, testPredicate, otherDigest),
+			},
+			fail: true,
+		},
+		"Notation": {
+			policy: types.SignaturePolicy{Notation: []types.NotationVerifier{{
+				RootsPEM:          pki.rootPEM,
+				TrustedIdentities: []string{"x509.subject: C=US, O=Example, CN=Signer"},
+			}}},
+			signatures: []types.ImageSignature{
+				notationSignature(t, pki, testDigest, time.Now().Add(time.Hour)),
+			},
+			verifiedBy: "notation certificate of CN=Signer,O=Example,C=US",
+		},
+		"Notation untrusted identity": {
+			policy: types.SignaturePolicy{Notation: []types.NotationVerifier{{
+				RootsPEM:          pki.rootPEM,
+				TrustedIdentities: []string{"x509.subject: C=US, O=Other"},
+			}}},
+			signatures: []types.ImageSignature{
+				notationSignature(t, pki, testDigest, time.Now().Add(time.Hour)),
+			},
+			fail: true,
+		},
+		"Notation expired": {
+			policy: types.SignaturePolicy{Notation: []types.NotationVerifier{{
+				RootsPEM: pki.rootPEM,
+			}}},
+			signatures: []types.ImageSignature{
+				notationSignature(t, pki, testDigest, time.Now().Add(-time.Hour)),
+			},
+			fail: true,
+		},
+		"Notation of other image": {
+			policy: types.SignaturePolicy{Notation: []types.NotationVerifier{{
+				RootsPEM: pki.rootPEM,
+			}}},
+			signatures: []types.ImageSignature{
+				notationSignature(t, pki, otherDigest, time.Now().Add(time.Hour)),
+			},
+			fail: true,
+		},
+		"Notation with backdated signing time": {
+			policy: types.SignaturePolicy{Notation: []types.NotationVerifier{{
+				RootsPEM: expiredPKI.rootPEM,
+			}}},
+			signatures: []types.ImageSignature{
+				notationSignatureWith(t, expiredPKI, testDigest, map[string]interface{}{
+					"io.cncf.notary.signingTime": time.Now().Add(-time.Hour).Format(time.RFC3339),
+				}),
+			},
+			fail: true,
+		},
+		"Notation with unknown critical header": {
+			policy: types.SignaturePolicy{Notation: []types.NotationVerifier{{
+				RootsPEM: pki.rootPEM,
+			}}},
+			signatures: []types.ImageSignature{
+				notationSignatureWith(t, pki, testDigest, map[string]interface{}{
+					"crit":               []string{"io.cncf.notary.signingScheme", "io.example.unknown"},
+					"io.example.unknown": true,
+				}),
+			},
+			fail: true,
+		},
+		"Notation with missing critical header": {
+			policy: types.SignaturePolicy{Notation: []types.NotationVerifier{{
+				RootsPEM: pki.rootPEM,
+			}}},
+			signatures: []types.ImageSignature{
+				notationSignatureWith(t, pki, testDigest, map[string]interface{}{
+					"crit": []string{"io.cncf.notary.signingScheme", "io.cncf.notary.expiry"},
+				}),
+			},
+			fail: true,
+		},
+		"Notation does not verify cosign": {
+			policy: types.SignaturePolicy{Notation: []types.NotationVerifier{{
+				RootsPEM: pki.rootPEM,
+			}}},
+			signatures: []types.ImageSignature{keyless},
+			fail:       true,
+		},
+	}
+
+	for testname, test := range testMatrix {
+		t.Logf("Running test case %s", testname)
+		verifiedBy, err := Verify(test.policy, testDigest, test.signatures, time.Now())
+		if test.fail {
+			assert.Error(t, err, testname)
+			t.Logf("%s: %v", testname, err)
+		} else {
+			assert.NoError(t, err, testname)
+			assert.Equal(t, test.verifiedBy, verifiedBy, testname)
+		}
+	}
+}
+
+func TestTags(t *testing.T) {
+	assert.Equal(t, "sha256-abc.sig", CosignSignatureTag("sha256:abc"))
+	assert.Equal(t, "sha256-abc.att", CosignAttestationTag("sha256:abc"))
+	assert.Equal(t, "sha256-abc", ReferrersTag("sha256:abc"))
+}
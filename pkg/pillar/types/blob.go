@@ -44,6 +44,12 @@ type BlobStatus struct {
 	CurrentSize            int64 // current total downloaded size as reported by the downloader
 	// Progress percentage downloaded 0-100, defined by CurrentSize/TotalSize
 	Progress uint
+	// PrefetchBy is the deadline of the prefetch of the blob, zero if it
+	// is downloaded at once
+	PrefetchBy time.Time
+	// DeferredUntil and Rate are reported by the downloader
+	DeferredUntil time.Time
+	Rate          uint64 // bytes per second
 	// ErrorAndTimeWithSource provide common error handling capabilities
 	ErrorAndTimeWithSource
 }
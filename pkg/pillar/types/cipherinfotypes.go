@@ -143,4 +143,9 @@ type EncryptionBlock struct {
 	WifiUserName      string // If the authentication type is EAP
 	WifiPassword      string
 	ProtectedUserData string
+	UefiPK            []byte
+	UefiKEK           []byte
+	UefiDb            []byte
+	UefiDbx           []byte
+	BackupKey         []byte
 }
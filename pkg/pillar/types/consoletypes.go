@@ -0,0 +1,100 @@
+// Copyright (c) 2022 Zededa, Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package types
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	uuid "github.com/satori/go.uuid"
+)
+
+// ConsoleBrokerAddr is where domainmgr accepts the console sessions which
+// the remote console tunnel relays when app.console.brokered is set
+const ConsoleBrokerAddr = "127.0.0.1:4823"
+
+// GuacdAddr is where guacd accepts the remote console tunnel otherwise
+const GuacdAddr = "localhost:4822"
+
+const (
+	// ConsolePrefix - console recording file prefix string; the app
+	// instance and session UUIDs and the start time in msec follow
+	ConsolePrefix = "console."
+	// ConsoleRecordingMaxBytes is the maximum of console output, before
+	// it is compressed, after which the rest of the session is not
+	// recorded
+	ConsoleRecordingMaxBytes = 64 * 1024 * 1024
+)
+
+// ConsoleType is which console of an app instance a session is for; must
+// match the values in the proto definition
+type ConsoleType uint8
+
+// The types of consoles
+const (
+	ConsoleTypeUnspecified ConsoleType = iota
+	ConsoleTypeVNC
+	ConsoleTypeSerial
+)
+
+// String returns the string name
+func (t ConsoleType) String() string {
+	switch t {
+	case ConsoleTypeUnspecified:
+		return "unspecified"
+	case ConsoleTypeVNC:
+		return "vnc"
+	case ConsoleTypeSerial:
+		return "serial"
+	default:
+		return fmt.Sprintf("Unknown ConsoleType %d", t)
+	}
+}
+
+// ConsoleSession is a console session which the controller authorized.
+// It ends at NotAfter, or after MaxDuration if not zero.
+type ConsoleSession struct {
+	ID          uuid.UUID
+	Type        ConsoleType
+	TokenSha256 []byte
+	NotAfter    time.Time
+	MaxDuration time.Duration
+	Record      bool
+}
+
+// ConsoleRecordingName returns the name of the recording file of a session
+// which started at startTime
+func ConsoleRecordingName(appUUID uuid.UUID, sessionID uuid.UUID,
+	startTime time.Time) string {
+
+	return fmt.Sprintf("%s%s.%s.%d.gz", ConsolePrefix, appUUID, sessionID,
+		startTime.UnixNano()/int64(time.Millisecond))
+}
+
+// ParseConsoleRecordingName returns the app instance and session UUIDs and
+// the start time in the name of a recording file
+func ParseConsoleRecordingName(name string) (uuid.UUID, uuid.UUID, time.Time, error) {
+	fields := strings.Split(strings.TrimSuffix(
+		strings.TrimPrefix(name, ConsolePrefix), ".gz"), ".")
+	if !strings.HasPrefix(name, ConsolePrefix) || !strings.HasSuffix(name, ".gz") ||
+		len(fields) != 3 {
+		return uuid.UUID{}, uuid.UUID{}, time.Time{},
+			fmt.Errorf("%s is not a console recording", name)
+	}
+	appUUID, err := uuid.FromString(fields[0])
+	if err != nil {
+		return uuid.UUID{}, uuid.UUID{}, time.Time{}, err
+	}
+	sessionID, err := uuid.FromString(fields[1])
+	if err != nil {
+		return uuid.UUID{}, uuid.UUID{}, time.Time{}, err
+	}
+	msec, err := strconv.ParseInt(fields[2], 10, 64)
+	if err != nil {
+		return uuid.UUID{}, uuid.UUID{}, time.Time{}, err
+	}
+	return appUUID, sessionID, time.Unix(0, msec*int64(time.Millisecond)), nil
+}
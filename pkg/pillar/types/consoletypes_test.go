@@ -0,0 +1,37 @@
+// Copyright (c) 2022 Zededa, Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package types
+
+import (
+	"testing"
+	"time"
+
+	uuid "github.com/satori/go.uuid"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestConsoleRecordingName(t *testing.T) {
+	appUUID := uuid.FromStringOrNil("6ba7b810-9dad-11d1-80b4-00c04fd430c8")
+	sessionID := uuid.FromStringOrNil("8e3f7b2c-3d1a-4c55-9a0e-0b8f5f7d1a21")
+	start := time.Unix(1650000000, 123000000)
+	name := ConsoleRecordingName(appUUID, sessionID, start)
+	assert.Equal(t, "console.6ba7b810-9dad-11d1-80b4-00c04fd430c8."+
+		"8e3f7b2c-3d1a-4c55-9a0e-0b8f5f7d1a21.1650000000123.gz", name)
+	gotApp, gotSession, gotStart, err := ParseConsoleRecordingName(name)
+	assert.NoError(t, err)
+	assert.Equal(t, appUUID, gotApp)
+	assert.Equal(t, sessionID, gotSession)
+	assert.True(t, start.Equal(gotStart))
+
+	for _, bad := range []string{
+		"app.6ba7b810-9dad-11d1-80b4-00c04fd430c8.log.1650000000123.gz",
+		"console.6ba7b810-9dad-11d1-80b4-00c04fd430c8.1650000000123.gz",
+		"." + name,
+		"console.6ba7b810-9dad-11d1-80b4-00c04fd430c8." +
+			"8e3f7b2c-3d1a-4c55-9a0e-0b8f5f7d1a21.now.gz",
+	} {
+		_, _, _, err := ParseConsoleRecordingName(bad)
+		assert.Error(t, err, bad)
+	}
+}
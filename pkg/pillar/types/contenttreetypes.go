@@ -24,6 +24,10 @@ type ContentTreeConfig struct {
 	MaxDownloadSize   uint64
 	GenerationCounter int64
 	DisplayName       string
+	// Schedule is set when the content is prefetched ahead of its activation
+	Schedule ContentSchedule
+	// SignaturePolicy of the content tree; else the one of the datastore
+	SignaturePolicy SignaturePolicy
 }
 
 // Key is content info UUID which will be unique
@@ -122,6 +126,17 @@ type ContentTreeStatus struct {
 	NameIsURL    bool
 	// Blobs the sha256 hashes of the blobs that are in this tree, the first of which always is the root
 	Blobs []string
+	// Schedule from the ContentTreeConfig
+	Schedule ContentSchedule
+	// ETA is when the download is expected to complete, if known
+	ETA time.Time
+	// DeferredUntil is set while the prefetch waits for a download window
+	DeferredUntil time.Time
+	// SignaturePolicy from the ContentTreeConfig
+	SignaturePolicy SignaturePolicy
+	// SignatureVerifiedBy describes what verified the signature of the
+	// image, once the policy is met
+	SignatureVerifiedBy string
 
 	ErrorAndTimeWithSource
 }
@@ -34,6 +34,7 @@ const (
 	EdgeNodeDiskConfigTypeZfsOffline                                // included in zfs and offline
 	EdgeNodeDiskConfigTypeAppDirect                                 // for direct assignment
 	EdgeNodeDiskConfigTypeUnused                                    // removed from zfs/app-direct
+	EdgeNodeDiskConfigTypeZfsSpare                                  // included in zfs as a hot spare
 )
 
 //EdgeNodeDiskArrayType should be in sync with api
@@ -43,6 +43,70 @@ type DomainConfig struct {
 
 	// MetaDataType for select type of metadata service for app
 	MetaDataType MetaDataType
+
+	RestartPolicy RestartPolicy
+
+	// SecureBootKeys, for the encrypted UEFI Secure Boot keys
+	SecureBootKeys CipherBlockStatus
+
+	// PodContainers run with the main container of a NOHYPER domain
+	PodContainers []PodContainerConfig
+
+	// HealthCheck of the main container of a NOHYPER domain
+	HealthCheck HealthCheck
+
+	// ConsoleSessions the controller may open to the consoles
+	ConsoleSessions []ConsoleSession
+}
+
+// OVMFBootLoader is the UEFI firmware of the domains
+const OVMFBootLoader = "/usr/lib/xen/boot/ovmf.bin"
+
+// HasUefiNvram returns true if the domain boots OVMF, which keeps its
+// variables in UefiNvramFile
+func (config DomainConfig) HasUefiNvram() bool {
+	return config.BootLoader == OVMFBootLoader && config.GetOCIConfigDir() == ""
+}
+
+// RestartMode tells when a domain which halted by itself is restarted
+// must match the values in the proto definition
+type RestartMode uint8
+
+// The modes of the restart policy
+const (
+	RestartModeDefault   RestartMode = iota // Retried after DomainBootRetryTime
+	RestartModeNever                        // Never restarted
+	RestartModeOnFailure                    // Restarted if it failed
+	RestartModeAlways                       // Always restarted
+)
+
+// String returns the string name
+func (mode RestartMode) String() string {
+	switch mode {
+	case RestartModeDefault:
+		return "default"
+	case RestartModeNever:
+		return "never"
+	case RestartModeOnFailure:
+		return "on-failure"
+	case RestartModeAlways:
+		return "always"
+	default:
+		return fmt.Sprintf("Unknown RestartMode %d", mode)
+	}
+}
+
+// RestartPolicy of an app instance. The restarts are delayed by Backoff,
+// which is doubled after each restart within CrashLoopWindow up to
+// MaxBackoff. CrashLoopRestarts restarts within CrashLoopWindow are a
+// crash loop which stops the restarts.
+type RestartPolicy struct {
+	Mode              RestartMode
+	MaxAttempts       uint32 // With RestartModeOnFailure; zero is unlimited
+	Backoff           time.Duration
+	MaxBackoff        time.Duration
+	CrashLoopRestarts uint32
+	CrashLoopWindow   time.Duration
 }
 
 // MetaDataType of metadata service for app
@@ -215,6 +279,22 @@ type VmConfig struct {
 	VncDisplay         uint32
 	VncPasswd          string
 	DisableLogs        bool
+	// Realtime domains run on isolated CPUs, one per VCpu, which
+	// domainmgr reserves and sets in CPUs when it activates the domain
+	Realtime bool
+	// Vtpm attaches a software TPM with its state in VtpmStateDir;
+	// VtpmResetOnPurge clears that state when the app instance is purged
+	Vtpm             VtpmModel
+	VtpmResetOnPurge bool
+	// SecureBoot boots OVMF with UEFI Secure Boot
+	SecureBoot bool
+}
+
+// Ballooned returns true if the memory of the domain is ballooned between
+// Memory, which is guaranteed, and MaxMem
+func (config VmConfig) Ballooned() bool {
+	return config.MaxMem > config.Memory &&
+		config.VirtualizationMode != NOHYPER && !config.Realtime
 }
 
 type VmMode uint8
@@ -228,6 +308,29 @@ const (
 	LEGACY
 )
 
+// VtpmModel is the interface of the software TPM of a domain
+// must match the values in the proto definition
+type VtpmModel uint8
+
+// The interfaces of the software TPM
+const (
+	VtpmNone VtpmModel = iota // No TPM
+	VtpmTIS                   // TPM Interface Specification
+	VtpmCRB                   // Command Response Buffer
+)
+
+// VtpmStateDir returns the directory in the vault with the state of the
+// software TPM of an app instance
+func VtpmStateDir(appUUID uuid.UUID) string {
+	return VtpmStateDirname + "/" + appUUID.String()
+}
+
+// UefiNvramFile returns the file in the vault with the variables of the
+// UEFI firmware of an app instance
+func UefiNvramFile(appUUID uuid.UUID) string {
+	return UefiNvramDirname + "/" + appUUID.String() + ".fd"
+}
+
 // Task represents any runnable entity on EVE
 type Task interface {
 	Setup(DomainStatus, DomainConfig, *AssignableAdapters, *os.File) error
@@ -264,9 +367,29 @@ type DomainStatus struct {
 	ErrorAndTime
 	BootFailed     bool
 	AdaptersFailed bool
-	OCIConfigDir   string            // folder holding an OCI Image config for this domain (empty string means no config)
-	EnvVariables   map[string]string // List of environment variables to be set in container
-	VmConfig                         // From DomainConfig
+	// RestartCount is the number of restarts by the RestartPolicy since
+	// the domain was activated, and RestartTimes the times of those within
+	// its CrashLoopWindow. NextRestartTime is set while a restart waits
+	// for its backoff.
+	RestartCount    uint32
+	RestartTimes    []time.Time
+	NextRestartTime time.Time
+	LastExitReason  string
+	LastExitTime    time.Time
+	OCIConfigDir    string            // folder holding an OCI Image config for this domain (empty string means no config)
+	EnvVariables    map[string]string // List of environment variables to be set in container
+	VmConfig                          // From DomainConfig
+	// IsolatedCPUs are reserved for a Realtime domain while it is activated
+	IsolatedCPUs []int
+	// PodContainerStatusList has the init and sidecar containers
+	PodContainerStatusList []PodContainerStatus
+	// PodImageList has the locations of the images of the pod containers
+	// while they are mounted
+	PodImageList []string
+	// Health is the result of the HealthCheck since the domain booted
+	Health HealthStatus
+	// GuestInfo is what the guest agent last reported
+	GuestInfo GuestInfo
 }
 
 func (status DomainStatus) Key() string {
@@ -380,6 +503,9 @@ type DiskConfig struct {
 	MountDir     string
 	DisplayName  string
 	WWN          string
+	MaxVolSize   uint64 // Grows online for running domains if supported
+	Shared       bool   // A directory shared with other app instances
+	IOLimits     VolumeIOLimits
 }
 
 type DiskStatus struct {
@@ -392,6 +518,10 @@ type DiskStatus struct {
 	Devtype      string // XXX used internally by hypervisor; deprecate?
 	Vdev         string // Allocated
 	WWN          string
+	MaxVolSize   uint64         // From DiskConfig
+	Shared       bool           // From DiskConfig
+	MountTag     string         // For Devtype 9P, share_dir if not set
+	IOLimits     VolumeIOLimits // Applied to the running domain
 }
 
 // DomainMetric carries CPU and memory usage. UUID=devUUID for the dom0/host metrics overhead
@@ -406,6 +536,35 @@ type DomainMetric struct {
 	UsedMemoryPercent float64
 	LastHeard         time.Time
 	Activated         bool
+	DiskIO            []DiskIOMetric // If the hypervisor reports them
+	Balloon           *BalloonMetric // If the domain has a memory balloon
+}
+
+// BalloonMetric has the state of the memory balloon of a domain, and the
+// statistics reported by the balloon driver of the guest if any
+type BalloonMetric struct {
+	GuaranteedMB uint32
+	MaxMB        uint32
+	ActualMB     uint32 // Memory of the guest now
+	TargetMB     uint32 // Memory of the guest asked for by the policy
+	FreeMB       uint32
+	AvailableMB  uint32
+	DiskCachesMB uint32
+	MajorFaults  uint64
+	SwapInBytes  uint64
+	SwapOutBytes uint64
+	LastUpdate   time.Time // Of the statistics of the guest; zero if none
+}
+
+// DiskIOMetric has the I/O counters of a disk of a domain
+// since the domain started
+type DiskIOMetric struct {
+	VolumeKey  string
+	ReadBytes  uint64
+	WriteBytes uint64
+	ReadOps    uint64
+	WriteOps   uint64
+	IOLimits   VolumeIOLimits // Applied to the disk
 }
 
 // Key returns the key for pubsub
@@ -22,6 +22,9 @@ type DownloaderConfig struct {
 	Size        uint64 // In bytes
 	FinalObjDir string // final Object Store
 	RefCount    uint
+	// PrefetchBy is set when the object is prefetched; it is then only
+	// downloaded during the download windows unless that misses the deadline
+	PrefetchBy time.Time
 }
 
 func (config DownloaderConfig) Key() string {
@@ -111,6 +114,9 @@ type DownloaderStatus struct {
 	Progress      uint      // In percent i.e., 0-100, given by CurrentSize/ExpectedSize
 	ModTime       time.Time
 	ContentType   string // content-type header, if provided
+	// DeferredUntil is set while a prefetch waits for a download window
+	DeferredUntil time.Time
+	Rate          uint64 // bytes per second of the download in progress
 	// ErrorAndTime provides SetErrorNow() and ClearError()
 	ErrorAndTime
 	RetryCount int
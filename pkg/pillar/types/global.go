@@ -166,6 +166,15 @@ const (
 	VaultReadyCutOffTime GlobalSettingKey = "timer.vault.ready.cutoff"
 	// LogRemainToSendMBytes Max gzip log files remain on device to be sent in Mbytes
 	LogRemainToSendMBytes GlobalSettingKey = "newlog.gzipfiles.ondisk.maxmegabytes"
+	// DeferredJournalMaxKBytes Max size of the info messages kept on disk until sent in Kbytes
+	DeferredJournalMaxKBytes GlobalSettingKey = "storage.deferred.journal.maxkbytes"
+	// DeferredJournalMaxAge Max age of the info messages kept on disk until sent
+	DeferredJournalMaxAge GlobalSettingKey = "timer.deferred.journal.maxage"
+	// ZfsScrubInterval global setting key, seconds between scrubs of zfs pools (0 disables)
+	ZfsScrubInterval GlobalSettingKey = "storage.zfs.scrub.interval"
+	// VolumeOvercommitPercent global setting key, how much of the disk space
+	// available for apps the sum of volume sizes may reach, in percent
+	VolumeOvercommitPercent GlobalSettingKey = "storage.volume.overcommit.percent"
 
 	// ForceFallbackCounter global setting key
 	ForceFallbackCounter = "force.fallback.counter"
@@ -182,6 +191,8 @@ const (
 	VgaAccess GlobalSettingKey = "debug.enable.vga"
 	// AllowAppVnc global setting key
 	AllowAppVnc GlobalSettingKey = "app.allow.vnc"
+	// ConsoleBrokered global setting key
+	ConsoleBrokered GlobalSettingKey = "app.console.brokered"
 	// EveMemoryLimitInBytes global setting key
 	EveMemoryLimitInBytes GlobalSettingKey = "memory.eve.limit.bytes"
 	// IgnoreMemoryCheckForApps global setting key
@@ -190,6 +201,9 @@ const (
 	IgnoreDiskCheckForApps GlobalSettingKey = "storage.apps.ignore.disk.check"
 	// AllowLogFastupload global setting key
 	AllowLogFastupload GlobalSettingKey = "newlog.allow.fastupload"
+	// OfflineUSBBundle global setting key to look for signed config bundles
+	// on USB sticks and to export info, metrics and logs to them
+	OfflineUSBBundle GlobalSettingKey = "offline.usb.bundle"
 
 	// TriState Items
 	// NetworkFallbackAnyEth global setting key
@@ -764,14 +778,23 @@ func NewConfigItemSpecMap() ConfigItemSpecMap {
 	// LogRemainToSendMBytes - Default is 2 Gbytes, minimum is 10 Mbytes
 	configItemSpecMap.AddIntItem(LogRemainToSendMBytes, 2048, 10, 0xFFFFFFFF)
 	configItemSpecMap.AddIntItem(DownloadMaxPortCost, 0, 0, 255)
+	// DeferredJournalMaxKBytes - Default is zero, which disables the journal
+	configItemSpecMap.AddIntItem(DeferredJournalMaxKBytes, 0, 0, 1024*1024)
+	configItemSpecMap.AddIntItem(DeferredJournalMaxAge, 7*24*3600, MinuteInSec, 0xFFFFFFFF)
+	// ZfsScrubInterval - Default is 30 days, zero disables periodic scrubs
+	configItemSpecMap.AddIntItem(ZfsScrubInterval, 30*24*HourInSec, 0, 365*24*HourInSec)
+	// VolumeOvercommitPercent - Default is 100 i.e. no thin-provisioning overcommit
+	configItemSpecMap.AddIntItem(VolumeOvercommitPercent, 100, 100, 1000)
 
 	// Add Bool Items
 	configItemSpecMap.AddBoolItem(UsbAccess, true) // Controller likely default to false
 	configItemSpecMap.AddBoolItem(VgaAccess, true) // Controller likely default to false
 	configItemSpecMap.AddBoolItem(AllowAppVnc, false)
+	configItemSpecMap.AddBoolItem(ConsoleBrokered, false)
 	configItemSpecMap.AddBoolItem(IgnoreMemoryCheckForApps, false)
 	configItemSpecMap.AddBoolItem(IgnoreDiskCheckForApps, false)
 	configItemSpecMap.AddBoolItem(AllowLogFastupload, false)
+	configItemSpecMap.AddBoolItem(OfflineUSBBundle, true)
 	configItemSpecMap.AddBoolItem(DisableDHCPAllOnesNetMask, false)
 	configItemSpecMap.AddBoolItem(ProcessCloudInitMultiPart, false)
 
@@ -174,14 +174,20 @@ func TestNewConfigItemSpecMap(t *testing.T) {
 		ForceFallbackCounter,
 		LogRemainToSendMBytes,
 		DownloadMaxPortCost,
+		DeferredJournalMaxKBytes,
+		DeferredJournalMaxAge,
+		ZfsScrubInterval,
+		VolumeOvercommitPercent,
 		// Bool Items
 		UsbAccess,
 		VgaAccess,
 		AllowAppVnc,
+		ConsoleBrokered,
 		EveMemoryLimitInBytes,
 		IgnoreMemoryCheckForApps,
 		IgnoreDiskCheckForApps,
 		AllowLogFastupload,
+		OfflineUSBBundle,
 		// TriState Items
 		NetworkFallbackAnyEth,
 		MaintenanceMode,
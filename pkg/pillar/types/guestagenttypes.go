@@ -0,0 +1,36 @@
+// Copyright (c) 2022 Zededa, Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package types
+
+import (
+	"net"
+	"time"
+)
+
+// GuestInterface is an interface of the guest of a domain and its addresses
+type GuestInterface struct {
+	Name       string
+	MacAddress string
+	IPAddrs    []net.IP
+}
+
+// GuestFilesystem is a mounted filesystem of the guest of a domain
+type GuestFilesystem struct {
+	Mountpoint string
+	Type       string
+	TotalBytes uint64
+	UsedBytes  uint64
+}
+
+// GuestInfo is what the guest agent in a domain reports about the guest.
+// UpdateTime is zero unless the agent answered since the domain booted.
+type GuestInfo struct {
+	OSName        string
+	OSVersion     string
+	KernelRelease string
+	Hostname      string
+	Interfaces    []GuestInterface
+	Filesystems   []GuestFilesystem
+	UpdateTime    time.Time
+}
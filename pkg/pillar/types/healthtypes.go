@@ -0,0 +1,101 @@
+// Copyright (c) 2022 Zededa, Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package types
+
+import (
+	"fmt"
+	"time"
+)
+
+// HealthCheckType is how the health of the main container of an app
+// instance is checked; must match the values in the proto definition
+type HealthCheckType uint8
+
+// The types of health checks
+const (
+	HealthCheckImage HealthCheckType = iota // The HEALTHCHECK of the image, if any
+	HealthCheckNone                         // Not checked
+	HealthCheckExec                         // A command in the container
+	HealthCheckTCP                          // A connection to a port
+	HealthCheckHTTP                         // A GET of a path on a port
+)
+
+// String returns the string name
+func (t HealthCheckType) String() string {
+	switch t {
+	case HealthCheckImage:
+		return "image"
+	case HealthCheckNone:
+		return "none"
+	case HealthCheckExec:
+		return "exec"
+	case HealthCheckTCP:
+		return "tcp"
+	case HealthCheckHTTP:
+		return "http"
+	default:
+		return fmt.Sprintf("Unknown HealthCheckType %d", t)
+	}
+}
+
+// HealthCheck of an app instance. The zero timings and Retries are those
+// of the HEALTHCHECK of the image, else the defaults below.
+type HealthCheck struct {
+	Type        HealthCheckType
+	Command     []string // With HealthCheckExec
+	Port        uint16   // With HealthCheckTCP and HealthCheckHTTP
+	HTTPPath    string
+	Interval    time.Duration
+	Timeout     time.Duration
+	StartPeriod time.Duration
+	Retries     uint32 // Consecutive failures which are unhealthy
+	Restart     bool   // Restart the app instance once unhealthy
+}
+
+// The defaults of the health checks, which are those of Docker
+const (
+	DefaultHealthCheckInterval = 30 * time.Second
+	DefaultHealthCheckTimeout  = 30 * time.Second
+	DefaultHealthCheckRetries  = 3
+)
+
+// HealthState of an app instance; must match the values in the proto
+// definition
+type HealthState uint8
+
+// The states of the health of an app instance
+const (
+	HealthUnknown   HealthState = iota // Not checked
+	HealthStarting                     // Within the start period
+	HealthHealthy                      // The last check succeeded
+	HealthUnhealthy                    // Retries checks failed
+)
+
+// String returns the string name
+func (state HealthState) String() string {
+	switch state {
+	case HealthUnknown:
+		return "unknown"
+	case HealthStarting:
+		return "starting"
+	case HealthHealthy:
+		return "healthy"
+	case HealthUnhealthy:
+		return "unhealthy"
+	default:
+		return fmt.Sprintf("Unknown HealthState %d", state)
+	}
+}
+
+// HealthStatus is the result of the health checks of an app instance
+// since it was activated. RestartCount counts the restarts because it was
+// unhealthy.
+type HealthStatus struct {
+	State         HealthState
+	FailingStreak uint32
+	LastOutput    string
+	LastCheckTime time.Time
+	NextCheckTime time.Time
+	RestartCount  uint32
+}
@@ -25,6 +25,10 @@ const (
 	ClearDirName = PersistDir + "/clear"
 	// VolumeClearDirName - Not encrypted directory used to store volumes
 	VolumeClearDirName = ClearDirName + "/volumes"
+	// VolumeKeyedDirName - directory of the volumes encrypted with keys of their own
+	VolumeKeyedDirName = PersistDir + "/keyed/volumes"
+	// VolumeKeysDirName - sealed directory with the wrapped keys of the volumes
+	VolumeKeysDirName = SealedDirName + "/volumekeys"
 	// PersistDebugDir - Location for service specific debug/traces
 	PersistDebugDir = PersistDir + "/agentdebug"
 	// PersistInstallerDir - location for installer output
@@ -78,6 +82,8 @@ const (
 	NewlogUploadDevDir = NewlogDir + "/devUpload"
 	// NewlogUploadAppDir - newlog app gzip file directory ready for upload
 	NewlogUploadAppDir = NewlogDir + "/appUpload"
+	// NewlogUploadConsoleDir - console recording gzip file directory ready for upload
+	NewlogUploadConsoleDir = NewlogDir + "/consoleUpload"
 	// NewlogKeepSentQueueDir - a circular queue of gzip files already been sent
 	NewlogKeepSentQueueDir = NewlogDir + "/keepSentQueue"
 	// EveMemoryLimitFile - stores memory reserved for eve
@@ -86,11 +92,23 @@ const (
 	EveMemoryUsageFile = "/hostfs/sys/fs/cgroup/memory/eve/memory.usage_in_bytes"
 	// EveKmemUsageFile - current kernel usage
 	EveKmemUsageFile = "/hostfs/sys/fs/cgroup/memory/eve/memory.kmem.usage_in_bytes"
+	// EveCPUSetDir - cpuset cgroup of eve and its services
+	EveCPUSetDir = "/hostfs/sys/fs/cgroup/cpuset/eve"
+	// UserAppsCPUSetDir - cpuset cgroup of the containers of the app instances
+	UserAppsCPUSetDir = "/hostfs/sys/fs/cgroup/cpuset/eve-user-apps"
+	// IRQDir - affinity of the interrupts
+	IRQDir = "/proc/irq"
 	// ZFSArcMaxSizeFile - file with zfs_arc_max size in bytes
 	ZFSArcMaxSizeFile = "/hostfs/sys/module/zfs/parameters/zfs_arc_max"
 
 	// ContainerdContentDir - path to containerd`s content store
 	ContainerdContentDir = SealedDirName + "/containerd/io.containerd.content.v1.content"
+	// VolumeBackupDirname - local state and staging area of volume backups
+	VolumeBackupDirname = SealedDirName + "/volumebackup"
+	// VtpmStateDirname - state of the software TPMs of the app instances
+	VtpmStateDirname = SealedDirName + "/vtpm"
+	// UefiNvramDirname - variables of the UEFI firmware of the app instances
+	UefiNvramDirname = SealedDirName + "/nvram"
 )
 
 var (
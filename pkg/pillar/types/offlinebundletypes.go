@@ -0,0 +1,137 @@
+// Copyright (c) 2022 Zededa, Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package types
+
+import (
+	"time"
+
+	"github.com/lf-edge/eve/pkg/pillar/base"
+)
+
+// OfflineBlobImportConfig is published by zedagent to have volumemgr load
+// a blob from a verified offline bundle, e.g., on a USB stick, into the CAS.
+// The blob is checked against its sha256 when it is loaded.
+type OfflineBlobImportConfig struct {
+	Sha256     string // lower case, without the "sha256:" prefix
+	MediaType  string
+	Size       uint64
+	Path       string // where the mounted bundle has the blob
+	BundleHash string // ConfigHash of the bundle which references the blob
+}
+
+// Key :
+func (config OfflineBlobImportConfig) Key() string {
+	return config.Sha256
+}
+
+// LogCreate :
+func (config OfflineBlobImportConfig) LogCreate(logBase *base.LogObject) {
+	logObject := base.NewLogObject(logBase, base.OfflineBlobImportConfigLogType, config.Path,
+		nilUUID, config.LogKey())
+	if logObject == nil {
+		return
+	}
+	logObject.CloneAndAddField("blobtype-string", config.MediaType).
+		AddField("size-int64", config.Size).
+		AddField("bundle-hash", config.BundleHash).
+		Noticef("Offline blob import config create")
+}
+
+// LogModify :
+func (config OfflineBlobImportConfig) LogModify(logBase *base.LogObject, old interface{}) {
+	logObject := base.EnsureLogObject(logBase, base.OfflineBlobImportConfigLogType, config.Path,
+		nilUUID, config.LogKey())
+
+	oldConfig, ok := old.(OfflineBlobImportConfig)
+	if !ok {
+		logObject.Clone().Fatalf("LogModify: Old object interface passed is not of OfflineBlobImportConfig type")
+	}
+	if oldConfig.Path != config.Path ||
+		oldConfig.BundleHash != config.BundleHash {
+
+		logObject.CloneAndAddField("bundle-hash", config.BundleHash).
+			AddField("old-path", oldConfig.Path).
+			AddField("old-bundle-hash", oldConfig.BundleHash).
+			Noticef("Offline blob import config modify")
+	}
+}
+
+// LogDelete :
+func (config OfflineBlobImportConfig) LogDelete(logBase *base.LogObject) {
+	logObject := base.EnsureLogObject(logBase, base.OfflineBlobImportConfigLogType, config.Path,
+		nilUUID, config.LogKey())
+	logObject.CloneAndAddField("bundle-hash", config.BundleHash).
+		Noticef("Offline blob import config delete")
+
+	base.DeleteLogObject(logBase, config.LogKey())
+}
+
+// LogKey :
+func (config OfflineBlobImportConfig) LogKey() string {
+	return string(base.OfflineBlobImportConfigLogType) + "-" + config.Key()
+}
+
+// OfflineBlobImportStatus is published by volumemgr once it loaded the blob
+// into the CAS, or failed to do so.
+type OfflineBlobImportStatus struct {
+	Sha256     string
+	BundleHash string
+	Loaded     bool
+	LoadTime   time.Time
+	// ErrorAndTime provides SetErrorNow() and ClearError()
+	ErrorAndTime
+}
+
+// Key :
+func (status OfflineBlobImportStatus) Key() string {
+	return status.Sha256
+}
+
+// LogCreate :
+func (status OfflineBlobImportStatus) LogCreate(logBase *base.LogObject) {
+	logObject := base.NewLogObject(logBase, base.OfflineBlobImportStatusLogType, status.Sha256,
+		nilUUID, status.LogKey())
+	if logObject == nil {
+		return
+	}
+	logObject.CloneAndAddField("loaded-bool", status.Loaded).
+		AddField("bundle-hash", status.BundleHash).
+		AddField("error", status.Error).
+		Noticef("Offline blob import status create")
+}
+
+// LogModify :
+func (status OfflineBlobImportStatus) LogModify(logBase *base.LogObject, old interface{}) {
+	logObject := base.EnsureLogObject(logBase, base.OfflineBlobImportStatusLogType, status.Sha256,
+		nilUUID, status.LogKey())
+
+	oldStatus, ok := old.(OfflineBlobImportStatus)
+	if !ok {
+		logObject.Clone().Fatalf("LogModify: Old object interface passed is not of OfflineBlobImportStatus type")
+	}
+	if oldStatus.Loaded != status.Loaded ||
+		oldStatus.Error != status.Error {
+
+		logObject.CloneAndAddField("loaded-bool", status.Loaded).
+			AddField("error", status.Error).
+			AddField("old-loaded-bool", oldStatus.Loaded).
+			AddField("old-error", oldStatus.Error).
+			Noticef("Offline blob import status modify")
+	}
+}
+
+// LogDelete :
+func (status OfflineBlobImportStatus) LogDelete(logBase *base.LogObject) {
+	logObject := base.EnsureLogObject(logBase, base.OfflineBlobImportStatusLogType, status.Sha256,
+		nilUUID, status.LogKey())
+	logObject.CloneAndAddField("loaded-bool", status.Loaded).
+		Noticef("Offline blob import status delete")
+
+	base.DeleteLogObject(logBase, status.LogKey())
+}
+
+// LogKey :
+func (status OfflineBlobImportStatus) LogKey() string {
+	return string(base.OfflineBlobImportStatusLogType) + "-" + status.Key()
+}
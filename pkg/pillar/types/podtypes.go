@@ -0,0 +1,60 @@
+// Copyright (c) 2022 Zededa, Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package types
+
+import (
+	"time"
+
+	uuid "github.com/satori/go.uuid"
+)
+
+// PodContainerConfig is an init or a sidecar container of the pod of an
+// app instance with the NOHYPER virtualization mode. It runs next to the
+// main container of the app instance in its network namespace.
+type PodContainerConfig struct {
+	Name         string
+	VolumeID     uuid.UUID // The volume with the OCI image
+	Init         bool      // Runs to completion before the main container
+	Args         []string  // Overrides the entrypoint and the command
+	EnvVariables map[string]string
+	Memory       int // in kbytes; zero is the limit of the app instance
+	VCpus        int // zero is the limit of the app instance
+	RestartMode  RestartMode
+	Mounts       []PodMount
+
+	// Image and Disks are the volumes of the container, which are set by
+	// zedmanager in the DomainConfig
+	Image DiskConfig
+	Disks []DiskConfig
+}
+
+// PodMount is a volume of the app instance mounted in a container of its pod
+type PodMount struct {
+	VolumeID uuid.UUID
+	MountDir string
+	ReadOnly bool
+}
+
+// PodContainerStatus is the state of a container of the pod of an app
+// instance. RestartCount counts the restarts of a sidecar by its
+// RestartMode since the domain was activated, and NextRestartTime is set
+// while a restart waits for its backoff.
+type PodContainerStatus struct {
+	Name            string
+	State           SwState
+	ExitCode        int
+	RestartCount    uint32
+	NextRestartTime time.Time
+	LastExitReason  string
+	LastExitTime    time.Time
+}
+
+// PodContainerInfo is the state of a container of a pod as reported by
+// the hypervisor
+type PodContainerInfo struct {
+	Name     string
+	Init     bool
+	State    SwState
+	ExitCode int
+}
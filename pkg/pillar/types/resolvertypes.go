@@ -19,6 +19,9 @@ type ResolveConfig struct {
 	DatastoreID uuid.UUID
 	Name        string
 	Counter     uint32
+	// FetchSignatures asks for the signatures of the image, which are
+	// verified before the image is used
+	FetchSignatures bool
 }
 
 // Key : DatastoreID, name and sequence counter are used
@@ -73,7 +76,10 @@ type ResolveStatus struct {
 	Name        string
 	ImageSha256 string
 	Counter     uint32
-	RetryCount  int
+	// Signatures of the image when they are fetched
+	Signatures        []ImageSignature
+	SignaturesFetched bool
+	RetryCount        int
 	// ErrorAndTime provides SetErrorNow() and ClearError()
 	ErrorAndTime
 	// We save the original error when we do a retry
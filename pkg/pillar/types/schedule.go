@@ -0,0 +1,189 @@
+// Copyright (c) 2022 Zededa, Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package types
+
+import (
+	"time"
+)
+
+const (
+	minutesPerDay = 24 * 60
+	daysPerWeek   = 7
+)
+
+// TimeWindow is a daily window of time in UTC. A window which ends
+// before it starts spans midnight, and one which ends when it starts
+// lasts all day.
+type TimeWindow struct {
+	StartMinute uint32 // minutes after midnight
+	EndMinute   uint32 // minutes after midnight
+	Weekdays    uint32 // bit 0 is Sunday; every day if zero
+}
+
+// onDay returns true if the window opens on the weekday
+func (w TimeWindow) onDay(day time.Weekday) bool {
+	return w.Weekdays == 0 || w.Weekdays&(1<<uint(day)) != 0
+}
+
+// opening returns when the window opens on the day starting at midnight
+// and when it closes
+func (w TimeWindow) opening(day time.Time) (time.Time, time.Time) {
+	start := day.Add(time.Duration(w.StartMinute%minutesPerDay) * time.Minute)
+	end := day.Add(time.Duration(w.EndMinute%minutesPerDay) * time.Minute)
+	if !end.After(start) {
+		end = end.Add(24 * time.Hour)
+	}
+	return start, end
+}
+
+// Contains returns true if the window is open at t
+func (w TimeWindow) Contains(t time.Time) bool {
+	return !w.End(t).IsZero()
+}
+
+// End returns when the window which is open at t closes, or zero if
+// the window is not open at t
+func (w TimeWindow) End(t time.Time) time.Time {
+	t = t.UTC()
+	today := t.Truncate(24 * time.Hour)
+	// the opening of yesterday may last until today
+	for _, day := range []time.Time{today.AddDate(0, 0, -1), today} {
+		if !w.onDay(day.Weekday()) {
+			continue
+		}
+		start, end := w.opening(day)
+		if !t.Before(start) && t.Before(end) {
+			return end
+		}
+	}
+	return time.Time{}
+}
+
+// NextStart returns t if the window is open at t, otherwise when it
+// next opens. Returns zero if the window never opens.
+func (w TimeWindow) NextStart(t time.Time) time.Time {
+	if w.Contains(t) {
+		return t
+	}
+	t = t.UTC()
+	today := t.Truncate(24 * time.Hour)
+	for i := 0; i <= daysPerWeek; i++ {
+		day := today.AddDate(0, 0, i)
+		if !w.onDay(day.Weekday()) {
+			continue
+		}
+		start, _ := w.opening(day)
+		if start.After(t) {
+			return start
+		}
+	}
+	return time.Time{}
+}
+
+// DownloadWindow is a window when content which is prefetched may be
+// downloaded using ports up to MaxPortCost
+type DownloadWindow struct {
+	TimeWindow
+	MaxPortCost uint8
+}
+
+// DeviceSchedule is the schedule from the controller of the windows for
+// prefetching content and for activating new versions. Published by
+// zedagent.
+type DeviceSchedule struct {
+	DownloadWindows    []DownloadWindow
+	MaintenanceWindows []TimeWindow
+}
+
+// Key is global since there is only one schedule
+func (s DeviceSchedule) Key() string {
+	return "global"
+}
+
+// PrefetchWindow returns the download window which is open at now if
+// content which should be downloaded by prefetchBy is restricted to it,
+// with the time when the window closes. If the download has to wait for
+// a window, deferred is set and until is when the next one opens.
+// The download is not restricted if prefetchBy is not set, if there are
+// no download windows or if no window opens before prefetchBy.
+func (s DeviceSchedule) PrefetchWindow(prefetchBy, now time.Time) (window *DownloadWindow, until time.Time, deferred bool) {
+	if prefetchBy.IsZero() || len(s.DownloadWindows) == 0 {
+		return nil, time.Time{}, false
+	}
+	var next time.Time
+	for i := range s.DownloadWindows {
+		w := s.DownloadWindows[i]
+		if end := w.End(now); !end.IsZero() {
+			return &w, end, false
+		}
+		start := w.NextStart(now)
+		if !start.IsZero() && (next.IsZero() || start.Before(next)) {
+			next = start
+		}
+	}
+	if next.IsZero() || !next.Before(prefetchBy) {
+		// the deadline cannot be met within the windows
+		return nil, time.Time{}, false
+	}
+	return nil, next, true
+}
+
+// NextActivation returns zero if a new version which has the content
+// schedule may be activated at now. Otherwise it returns when it may be
+// activated next, which is not before ActivateAfter and inside a
+// maintenance window. Versions without a schedule are activated at once.
+func (s DeviceSchedule) NextActivation(schedule ContentSchedule, now time.Time) time.Time {
+	if !schedule.IsSet() {
+		return time.Time{}
+	}
+	t := now
+	if schedule.ActivateAfter.After(now) {
+		t = schedule.ActivateAfter
+	}
+	var next time.Time
+	for _, w := range s.MaintenanceWindows {
+		start := w.NextStart(t)
+		if !start.IsZero() && (next.IsZero() || start.Before(next)) {
+			next = start
+		}
+	}
+	if next.IsZero() {
+		next = t
+	}
+	if !next.After(now) {
+		return time.Time{}
+	}
+	return next
+}
+
+// ContentSchedule asks to prefetch content by PrefetchBy and not to
+// activate it before ActivateAfter
+type ContentSchedule struct {
+	PrefetchBy    time.Time
+	ActivateAfter time.Time
+}
+
+// IsSet returns true if the content is prefetched ahead of its
+// activation
+func (schedule ContentSchedule) IsSet() bool {
+	return !schedule.PrefetchBy.IsZero() || !schedule.ActivateAfter.IsZero()
+}
+
+// Equal returns true if both schedules are the same
+func (schedule ContentSchedule) Equal(other ContentSchedule) bool {
+	return schedule.PrefetchBy.Equal(other.PrefetchBy) &&
+		schedule.ActivateAfter.Equal(other.ActivateAfter)
+}
+
+// Merge returns the schedule of something which uses the content of both
+// schedules; it is activated once all of its content may be
+func (schedule ContentSchedule) Merge(other ContentSchedule) ContentSchedule {
+	if other.PrefetchBy.After(schedule.PrefetchBy) {
+		schedule.PrefetchBy = other.PrefetchBy
+	}
+	if other.ActivateAfter.After(schedule.ActivateAfter) {
+		schedule.ActivateAfter = other.ActivateAfter
+	}
+	return schedule
+}
@@ -0,0 +1,177 @@
+// Copyright (c) 2022 Zededa, Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package types
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// 2022-06-01 is a Wednesday
+func scheduleTime(day, hour, minute int) time.Time {
+	return time.Date(2022, time.June, day, hour, minute, 0, 0, time.UTC)
+}
+
+func TestTimeWindow(t *testing.T) {
+	testMatrix := map[string]struct {
+		window    TimeWindow
+		at        time.Time
+		end       time.Time
+		nextStart time.Time
+	}{
+		"inside": {
+			window:    TimeWindow{StartMinute: 60, EndMinute: 120},
+			at:        scheduleTime(1, 1, 30),
+			end:       scheduleTime(1, 2, 0),
+			nextStart: scheduleTime(1, 1, 30),
+		},
+		"before": {
+			window:    TimeWindow{StartMinute: 60, EndMinute: 120},
+			at:        scheduleTime(1, 0, 30),
+			nextStart: scheduleTime(1, 1, 0),
+		},
+		"after": {
+			window:    TimeWindow{StartMinute: 60, EndMinute: 120},
+			at:        scheduleTime(1, 2, 0),
+			nextStart: scheduleTime(2, 1, 0),
+		},
+		"spanning midnight after midnight": {
+			window:    TimeWindow{StartMinute: 22 * 60, EndMinute: 2 * 60},
+			at:        scheduleTime(2, 1, 0),
+			end:       scheduleTime(2, 2, 0),
+			nextStart: scheduleTime(2, 1, 0),
+		},
+		"spanning midnight from a weekday": {
+			// opens on Wednesday only
+			window:    TimeWindow{StartMinute: 22 * 60, EndMinute: 2 * 60, Weekdays: 1 << 3},
+			at:        scheduleTime(2, 1, 0),
+			end:       scheduleTime(2, 2, 0),
+			nextStart: scheduleTime(2, 1, 0),
+		},
+		"weekday": {
+			// opens on Saturday only
+			window:    TimeWindow{StartMinute: 60, EndMinute: 120, Weekdays: 1 << 6},
+			at:        scheduleTime(1, 1, 30),
+			nextStart: scheduleTime(4, 1, 0),
+		},
+		"same weekday next week": {
+			// opens on Wednesday only
+			window:    TimeWindow{StartMinute: 60, EndMinute: 120, Weekdays: 1 << 3},
+			at:        scheduleTime(1, 3, 0),
+			nextStart: scheduleTime(8, 1, 0),
+		},
+		"all day": {
+			window:    TimeWindow{StartMinute: 0, EndMinute: 0, Weekdays: 1 << 3},
+			at:        scheduleTime(1, 12, 0),
+			end:       scheduleTime(2, 0, 0),
+			nextStart: scheduleTime(1, 12, 0),
+		},
+	}
+	for testname, test := range testMatrix {
+		t.Logf("Running test case %s", testname)
+		assert.Equal(t, test.end, test.window.End(test.at), testname)
+		assert.Equal(t, !test.end.IsZero(), test.window.Contains(test.at), testname)
+		assert.Equal(t, test.nextStart, test.window.NextStart(test.at), testname)
+	}
+}
+
+func TestPrefetchWindow(t *testing.T) {
+	night := DownloadWindow{
+		TimeWindow:  TimeWindow{StartMinute: 0, EndMinute: 4 * 60},
+		MaxPortCost: 10,
+	}
+	testMatrix := map[string]struct {
+		schedule   DeviceSchedule
+		prefetchBy time.Time
+		now        time.Time
+		window     *DownloadWindow
+		until      time.Time
+		deferred   bool
+	}{
+		"not prefetched": {
+			schedule: DeviceSchedule{DownloadWindows: []DownloadWindow{night}},
+			now:      scheduleTime(1, 12, 0),
+		},
+		"no windows": {
+			prefetchBy: scheduleTime(3, 0, 0),
+			now:        scheduleTime(1, 12, 0),
+		},
+		"inside window": {
+			schedule:   DeviceSchedule{DownloadWindows: []DownloadWindow{night}},
+			prefetchBy: scheduleTime(3, 0, 0),
+			now:        scheduleTime(1, 1, 0),
+			window:     &night,
+			until:      scheduleTime(1, 4, 0),
+		},
+		"waiting for window": {
+			schedule:   DeviceSchedule{DownloadWindows: []DownloadWindow{night}},
+			prefetchBy: scheduleTime(3, 0, 0),
+			now:        scheduleTime(1, 12, 0),
+			until:      scheduleTime(2, 0, 0),
+			deferred:   true,
+		},
+		"deadline before window": {
+			schedule:   DeviceSchedule{DownloadWindows: []DownloadWindow{night}},
+			prefetchBy: scheduleTime(1, 18, 0),
+			now:        scheduleTime(1, 12, 0),
+		},
+	}
+	for testname, test := range testMatrix {
+		t.Logf("Running test case %s", testname)
+		window, until, deferred := test.schedule.PrefetchWindow(test.prefetchBy, test.now)
+		assert.Equal(t, test.window, window, testname)
+		assert.Equal(t, test.until, until, testname)
+		assert.Equal(t, test.deferred, deferred, testname)
+	}
+}
+
+func TestNextActivation(t *testing.T) {
+	maintenance := DeviceSchedule{
+		MaintenanceWindows: []TimeWindow{{StartMinute: 2 * 60, EndMinute: 3 * 60}},
+	}
+	testMatrix := map[string]struct {
+		schedule     DeviceSchedule
+		contentSched ContentSchedule
+		now          time.Time
+		next         time.Time
+	}{
+		"not scheduled": {
+			schedule: maintenance,
+			now:      scheduleTime(1, 12, 0),
+		},
+		"inside maintenance window": {
+			schedule:     maintenance,
+			contentSched: ContentSchedule{PrefetchBy: scheduleTime(1, 0, 0)},
+			now:          scheduleTime(1, 2, 30),
+		},
+		"outside maintenance window": {
+			schedule:     maintenance,
+			contentSched: ContentSchedule{PrefetchBy: scheduleTime(1, 0, 0)},
+			now:          scheduleTime(1, 12, 0),
+			next:         scheduleTime(2, 2, 0),
+		},
+		"before activate after": {
+			schedule:     maintenance,
+			contentSched: ContentSchedule{ActivateAfter: scheduleTime(3, 0, 0)},
+			now:          scheduleTime(1, 2, 30),
+			next:         scheduleTime(3, 2, 0),
+		},
+		"activate after without windows": {
+			contentSched: ContentSchedule{ActivateAfter: scheduleTime(3, 0, 0)},
+			now:          scheduleTime(1, 2, 30),
+			next:         scheduleTime(3, 0, 0),
+		},
+		"activate after passed without windows": {
+			contentSched: ContentSchedule{ActivateAfter: scheduleTime(1, 0, 0)},
+			now:          scheduleTime(1, 2, 30),
+		},
+	}
+	for testname, test := range testMatrix {
+		t.Logf("Running test case %s", testname)
+		assert.Equal(t, test.next,
+			test.schedule.NextActivation(test.contentSched, test.now), testname)
+	}
+}
@@ -0,0 +1,76 @@
+// Copyright (c) 2022 Zededa, Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package types
+
+// CosignVerifier verifies cosign signatures, or attestations of
+// PredicateType, of OCI images. The verification is keyless when
+// PublicKeyPEM is empty.
+type CosignVerifier struct {
+	PublicKeyPEM      string
+	RootsPEM          string // Keyless: issuers of the certificates
+	RekorPublicKeyPEM string // Keyless: required, as is a bundle from the log
+	Identity          string // Keyless: email or URI of the signer
+	Issuer            string // Keyless: OIDC issuer
+	PredicateType     string
+}
+
+// NotationVerifier verifies Notation signatures of OCI images
+type NotationVerifier struct {
+	RootsPEM          string
+	TrustedIdentities []string // Any subject if empty
+}
+
+// SignaturePolicy requires OCI images to be verified by any of its
+// verifiers before they are used
+type SignaturePolicy struct {
+	Cosign   []CosignVerifier
+	Notation []NotationVerifier
+}
+
+// IsSet returns true if the policy requires verification
+func (policy SignaturePolicy) IsSet() bool {
+	return len(policy.Cosign) != 0 || len(policy.Notation) != 0
+}
+
+// ImageSignatureKind is where an ImageSignature comes from
+type ImageSignatureKind uint8
+
+const (
+	// ImageSignatureCosign is a cosign simple signing payload
+	ImageSignatureCosign ImageSignatureKind = iota + 1
+	// ImageSignatureCosignAttestation is a cosign DSSE envelope of an
+	// in-toto statement
+	ImageSignatureCosignAttestation
+	// ImageSignatureNotation is a Notation signature envelope
+	ImageSignatureNotation
+)
+
+// String returns the name of the kind
+func (kind ImageSignatureKind) String() string {
+	switch kind {
+	case ImageSignatureCosign:
+		return "cosign signature"
+	case ImageSignatureCosignAttestation:
+		return "cosign attestation"
+	case ImageSignatureNotation:
+		return "notation signature"
+	default:
+		return "unknown signature"
+	}
+}
+
+// ImageSignature is a signature of an OCI image as it is stored in the
+// registry; it is verified against a SignaturePolicy
+type ImageSignature struct {
+	Kind      ImageSignatureKind
+	MediaType string
+	// Payload is the signed payload of cosign signatures, or the
+	// envelope of attestations and Notation signatures
+	Payload []byte
+	// The annotations of cosign
+	Signature   string // base64
+	Certificate string // PEM
+	Chain       string // PEM
+	Bundle      string // JSON
+}
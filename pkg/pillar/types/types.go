@@ -47,6 +47,7 @@ const (
 	PURGING    // Purging due to config change
 	BROKEN     // Domain is still alive, but its device model has failed
 	UNKNOWN    // State of the domain can't be determined
+	CRASH_LOOP // Domain is no longer restarted since it failed too often
 	MAXSTATE
 )
 
@@ -101,6 +102,8 @@ func (state SwState) String() string {
 		return "START_DELAYED"
 	case UNKNOWN:
 		return "UNKNOWN"
+	case CRASH_LOOP:
+		return "CRASH_LOOP"
 	default:
 		return fmt.Sprintf("Unknown state %d", state)
 	}
@@ -172,6 +175,8 @@ func (state SwState) ZSwState() info.ZSwState {
 	// known soon.
 	case UNKNOWN:
 		return info.ZSwState_RUNNING
+	case CRASH_LOOP:
+		return info.ZSwState_CRASH_LOOP
 	default:
 		logrus.Fatalf("Unknown state %d", state)
 	}
@@ -0,0 +1,143 @@
+// Copyright (c) 2022 Zededa, Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package types
+
+import (
+	"github.com/lf-edge/eve/pkg/pillar/base"
+	uuid "github.com/satori/go.uuid"
+)
+
+// UploaderConfig asks downloader to upload a local file to a datastore.
+// The key/index to this is the Name of the object in the datastore.
+type UploaderConfig struct {
+	Name        string // object name relative to the path of the datastore
+	DatastoreID uuid.UUID
+	Source      string // local file to upload
+	Size        uint64 // In bytes
+}
+
+// Key :
+func (config UploaderConfig) Key() string {
+	return config.Name
+}
+
+// LogCreate :
+func (config UploaderConfig) LogCreate(logBase *base.LogObject) {
+	logObject := base.NewLogObject(logBase, base.UploaderConfigLogType, config.Name,
+		nilUUID, config.LogKey())
+	if logObject == nil {
+		return
+	}
+	logObject.CloneAndAddField("source", config.Source).
+		AddField("datastore-id", config.DatastoreID).
+		AddField("size-int64", config.Size).
+		Noticef("Upload config create")
+}
+
+// LogModify :
+func (config UploaderConfig) LogModify(logBase *base.LogObject, old interface{}) {
+	logObject := base.EnsureLogObject(logBase, base.UploaderConfigLogType, config.Name,
+		nilUUID, config.LogKey())
+
+	oldConfig, ok := old.(UploaderConfig)
+	if !ok {
+		logObject.Clone().Fatalf("LogModify: Old object interface passed is not of UploaderConfig type")
+	}
+	if oldConfig.Source != config.Source ||
+		oldConfig.DatastoreID != config.DatastoreID ||
+		oldConfig.Size != config.Size {
+
+		logObject.CloneAndAddField("source", config.Source).
+			AddField("datastore-id", config.DatastoreID).
+			AddField("size-int64", config.Size).
+			AddField("old-source", oldConfig.Source).
+			AddField("old-datastore-id", oldConfig.DatastoreID).
+			AddField("old-size-int64", oldConfig.Size).
+			Noticef("Upload config modify")
+	}
+}
+
+// LogDelete :
+func (config UploaderConfig) LogDelete(logBase *base.LogObject) {
+	logObject := base.EnsureLogObject(logBase, base.UploaderConfigLogType, config.Name,
+		nilUUID, config.LogKey())
+	logObject.CloneAndAddField("source", config.Source).
+		AddField("datastore-id", config.DatastoreID).
+		Noticef("Upload config delete")
+
+	base.DeleteLogObject(logBase, config.LogKey())
+}
+
+// LogKey :
+func (config UploaderConfig) LogKey() string {
+	return string(base.UploaderConfigLogType) + "-" + config.Key()
+}
+
+// UploaderStatus is published by downloader for each UploaderConfig.
+// An upload is not retried; the publisher of the config deletes it once
+// Done or HasError and decides whether to try again.
+type UploaderStatus struct {
+	Name        string
+	DatastoreID uuid.UUID
+	Size        uint64
+	CurrentSize int64
+	Progress    uint // In percent i.e., 0-100
+	Done        bool
+	// ErrorAndTime provides SetErrorNow() and ClearError()
+	ErrorAndTime
+}
+
+// Key :
+func (status UploaderStatus) Key() string {
+	return status.Name
+}
+
+// LogCreate :
+func (status UploaderStatus) LogCreate(logBase *base.LogObject) {
+	logObject := base.NewLogObject(logBase, base.UploaderStatusLogType, status.Name,
+		nilUUID, status.LogKey())
+	if logObject == nil {
+		return
+	}
+	logObject.CloneAndAddField("datastore-id", status.DatastoreID).
+		AddField("progress-int64", status.Progress).
+		AddField("done-bool", status.Done).
+		Noticef("Upload status create")
+}
+
+// LogModify :
+func (status UploaderStatus) LogModify(logBase *base.LogObject, old interface{}) {
+	logObject := base.EnsureLogObject(logBase, base.UploaderStatusLogType, status.Name,
+		nilUUID, status.LogKey())
+
+	oldStatus, ok := old.(UploaderStatus)
+	if !ok {
+		logObject.Clone().Fatalf("LogModify: Old object interface passed is not of UploaderStatus type")
+	}
+	if oldStatus.Done != status.Done ||
+		oldStatus.Error != status.Error {
+
+		logObject.CloneAndAddField("done-bool", status.Done).
+			AddField("error", status.Error).
+			AddField("old-done-bool", oldStatus.Done).
+			AddField("old-error", oldStatus.Error).
+			Noticef("Upload status modify")
+	}
+}
+
+// LogDelete :
+func (status UploaderStatus) LogDelete(logBase *base.LogObject) {
+	logObject := base.EnsureLogObject(logBase, base.UploaderStatusLogType, status.Name,
+		nilUUID, status.LogKey())
+	logObject.CloneAndAddField("done-bool", status.Done).
+		AddField("error", status.Error).
+		Noticef("Upload status delete")
+
+	base.DeleteLogObject(logBase, status.LogKey())
+}
+
+// LogKey :
+func (status UploaderStatus) LogKey() string {
+	return string(base.UploaderStatusLogType) + "-" + status.Key()
+}
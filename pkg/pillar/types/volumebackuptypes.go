@@ -0,0 +1,246 @@
+// Copyright (c) 2022 Zededa, Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package types
+
+import (
+	"time"
+
+	"github.com/lf-edge/eve/api/go/info"
+	"github.com/lf-edge/eve/pkg/pillar/base"
+	uuid "github.com/satori/go.uuid"
+)
+
+// DefaultMaxIncrementalBackups is used if the policy does not say after
+// how many incremental backups a full one is taken
+const DefaultMaxIncrementalBackups = 7
+
+// VolumeBackupPolicy describes when and where a volume is backed up
+type VolumeBackupPolicy struct {
+	DatastoreID    uuid.UUID
+	Interval       uint32 // In seconds; zero means on demand only
+	Counter        uint32 // A change takes a backup on demand
+	Incremental    bool
+	MaxIncremental uint32
+}
+
+// Enabled returns true if the volume is to be backed up
+func (policy VolumeBackupPolicy) Enabled() bool {
+	return policy.DatastoreID != nilUUID
+}
+
+// IncrementalLimit returns the number of incremental backups after
+// which a full backup is taken
+func (policy VolumeBackupPolicy) IncrementalLimit() int {
+	if policy.MaxIncremental == 0 {
+		return DefaultMaxIncrementalBackups
+	}
+	return int(policy.MaxIncremental)
+}
+
+// VolumeBackupRef is the backup a volume is restored from
+type VolumeBackupRef struct {
+	DatastoreID uuid.UUID
+	Name        string // Name of the backup manifest in the datastore
+	Sha256      string // Sha256 of the backup manifest
+}
+
+// VolumeBackupState is the state of the backups of a volume
+type VolumeBackupState uint8
+
+// Enum of VolumeBackupState variants
+const (
+	VolumeBackupStateUnspecified VolumeBackupState = iota
+	VolumeBackupStateIdle
+	VolumeBackupStateSnapshotting
+	VolumeBackupStateUploading
+	VolumeBackupStateFailed
+)
+
+// String returns the string name
+func (state VolumeBackupState) String() string {
+	switch state {
+	case VolumeBackupStateIdle:
+		return "IDLE"
+	case VolumeBackupStateSnapshotting:
+		return "SNAPSHOTTING"
+	case VolumeBackupStateUploading:
+		return "UPLOADING"
+	case VolumeBackupStateFailed:
+		return "FAILED"
+	default:
+		return "UNSPECIFIED"
+	}
+}
+
+// ZVolumeBackupState returns the state to report to the controller
+func (state VolumeBackupState) ZVolumeBackupState() info.VolumeBackupState {
+	switch state {
+	case VolumeBackupStateIdle:
+		return info.VolumeBackupState_VOLUME_BACKUP_STATE_IDLE
+	case VolumeBackupStateSnapshotting:
+		return info.VolumeBackupState_VOLUME_BACKUP_STATE_SNAPSHOTTING
+	case VolumeBackupStateUploading:
+		return info.VolumeBackupState_VOLUME_BACKUP_STATE_UPLOADING
+	case VolumeBackupStateFailed:
+		return info.VolumeBackupState_VOLUME_BACKUP_STATE_FAILED
+	default:
+		return info.VolumeBackupState_VOLUME_BACKUP_STATE_UNSPECIFIED
+	}
+}
+
+// VolumeBackupObject is a local file of a backup in progress which
+// is uploaded to the datastore under Name
+type VolumeBackupObject struct {
+	Name   string
+	Path   string
+	Sha256 string
+	Size   uint64
+}
+
+// VolumeBackupStatus is the state of the backups of a volume
+type VolumeBackupStatus struct {
+	State           VolumeBackupState
+	Counter         uint32 // Last VolumeBackupPolicy.Counter we took a backup for
+	LastName        string // Manifest of the last successful backup
+	LastSha256      string
+	LastTime        time.Time
+	LastIncremental bool
+	LastSize        uint64    // Uploaded bytes of the last backup
+	NextTime        time.Time // Zero if on demand only
+	Progress        uint      // In percent of the current upload
+
+	// The backup in progress
+	LiveCopy string               // Copy or zfs snapshot requested from domainmgr
+	Pending  []VolumeBackupObject // Left to upload, the manifest is last
+	// ErrorAndTime provides SetErrorNow() and ClearError()
+	ErrorAndTime
+}
+
+// DiskBackupConfig asks domainmgr for a consistent copy of the disk
+// of a running domain; the hypervisor has the image locked while running.
+// For a zvol it asks for a snapshot taken while the guest agent, if any,
+// has the filesystems of the guest frozen.
+// The key/index to this is the key of the VolumeStatus.
+type DiskBackupConfig struct {
+	VolumeKey    string
+	FileLocation string // Image of the disk
+	Target       string // qcow2 file to create, or zfs snapshot to take
+	Snapshot     bool   // Target is a zfs snapshot
+}
+
+// Key :
+func (config DiskBackupConfig) Key() string {
+	return config.VolumeKey
+}
+
+// LogCreate :
+func (config DiskBackupConfig) LogCreate(logBase *base.LogObject) {
+	logObject := base.NewLogObject(logBase, base.DiskBackupConfigLogType, config.VolumeKey,
+		nilUUID, config.LogKey())
+	if logObject == nil {
+		return
+	}
+	logObject.CloneAndAddField("filelocation", config.FileLocation).
+		AddField("target", config.Target).
+		AddField("snapshot", config.Snapshot).
+		Noticef("Disk backup config create")
+}
+
+// LogModify :
+func (config DiskBackupConfig) LogModify(logBase *base.LogObject, old interface{}) {
+	logObject := base.EnsureLogObject(logBase, base.DiskBackupConfigLogType, config.VolumeKey,
+		nilUUID, config.LogKey())
+
+	oldConfig, ok := old.(DiskBackupConfig)
+	if !ok {
+		logObject.Clone().Fatalf("LogModify: Old object interface passed is not of DiskBackupConfig type")
+	}
+	if oldConfig.Target != config.Target {
+		logObject.CloneAndAddField("target", config.Target).
+			AddField("old-target", oldConfig.Target).
+			Noticef("Disk backup config modify")
+	}
+}
+
+// LogDelete :
+func (config DiskBackupConfig) LogDelete(logBase *base.LogObject) {
+	logObject := base.EnsureLogObject(logBase, base.DiskBackupConfigLogType, config.VolumeKey,
+		nilUUID, config.LogKey())
+	logObject.CloneAndAddField("target", config.Target).
+		Noticef("Disk backup config delete")
+
+	base.DeleteLogObject(logBase, config.LogKey())
+}
+
+// LogKey :
+func (config DiskBackupConfig) LogKey() string {
+	return string(base.DiskBackupConfigLogType) + "-" + config.Key()
+}
+
+// DiskBackupStatus is published by domainmgr for each DiskBackupConfig
+type DiskBackupStatus struct {
+	VolumeKey string
+	Target    string
+	Done      bool
+	// NotRunning is set if no running domain uses the disk,
+	// hence the image can be copied directly
+	NotRunning bool
+	// ErrorAndTime provides SetErrorNow() and ClearError()
+	ErrorAndTime
+}
+
+// Key :
+func (status DiskBackupStatus) Key() string {
+	return status.VolumeKey
+}
+
+// LogCreate :
+func (status DiskBackupStatus) LogCreate(logBase *base.LogObject) {
+	logObject := base.NewLogObject(logBase, base.DiskBackupStatusLogType, status.VolumeKey,
+		nilUUID, status.LogKey())
+	if logObject == nil {
+		return
+	}
+	logObject.CloneAndAddField("done-bool", status.Done).
+		AddField("not-running-bool", status.NotRunning).
+		Noticef("Disk backup status create")
+}
+
+// LogModify :
+func (status DiskBackupStatus) LogModify(logBase *base.LogObject, old interface{}) {
+	logObject := base.EnsureLogObject(logBase, base.DiskBackupStatusLogType, status.VolumeKey,
+		nilUUID, status.LogKey())
+
+	oldStatus, ok := old.(DiskBackupStatus)
+	if !ok {
+		logObject.Clone().Fatalf("LogModify: Old object interface passed is not of DiskBackupStatus type")
+	}
+	if oldStatus.Done != status.Done ||
+		oldStatus.NotRunning != status.NotRunning ||
+		oldStatus.Error != status.Error {
+
+		logObject.CloneAndAddField("done-bool", status.Done).
+			AddField("not-running-bool", status.NotRunning).
+			AddField("error", status.Error).
+			AddField("old-done-bool", oldStatus.Done).
+			AddField("old-not-running-bool", oldStatus.NotRunning).
+			AddField("old-error", oldStatus.Error).
+			Noticef("Disk backup status modify")
+	}
+}
+
+// LogDelete :
+func (status DiskBackupStatus) LogDelete(logBase *base.LogObject) {
+	logObject := base.EnsureLogObject(logBase, base.DiskBackupStatusLogType, status.VolumeKey,
+		nilUUID, status.LogKey())
+	logObject.CloneAndAddField("done-bool", status.Done).
+		Noticef("Disk backup status delete")
+
+	base.DeleteLogObject(logBase, status.LogKey())
+}
+
+// LogKey :
+func (status DiskBackupStatus) LogKey() string {
+	return string(base.DiskBackupStatusLogType) + "-" + status.Key()
+}
@@ -5,6 +5,7 @@ package types
 
 import (
 	"fmt"
+	"path/filepath"
 	"strings"
 	"time"
 
@@ -27,6 +28,13 @@ type VolumeConfig struct {
 	Encrypted               bool
 	DisplayName             string
 	HasNoAppReferences      bool
+	BackupPolicy            VolumeBackupPolicy
+	BackupRef               VolumeBackupRef   // Set for VCOT_BACKUP
+	BackupKey               CipherBlockStatus // Key of the backups and of BackupRef
+	Shared                  bool              // A directory used by several app instances
+	IOLimits                VolumeIOLimits
+	KeyPolicy               VolumeKeyPolicy
+	Filesystem              VolumeFilesystem
 }
 
 // Key is volume UUID which will be unique
@@ -132,6 +140,16 @@ type VolumeStatus struct {
 	PreReboot               bool // Was volume last use prior to device reboot?
 	ReferenceName           string
 	WWN                     string
+	BackupPolicy            VolumeBackupPolicy
+	BackupRef               VolumeBackupRef   // Set for VCOT_BACKUP
+	BackupKey               CipherBlockStatus // From VolumeConfig
+	BackupShas              []string          // Downloads to restore from, the manifest first
+	Backup                  VolumeBackupStatus
+	Shared                  bool             // From VolumeConfig
+	IOLimits                VolumeIOLimits   // From VolumeConfig
+	KeyPolicy               VolumeKeyPolicy  // From VolumeConfig
+	DataKey                 VolumeKeyStatus  // Set if KeyPolicy.OwnKey
+	Filesystem              VolumeFilesystem // From VolumeConfig
 
 	ErrorAndTimeWithSource
 }
@@ -151,12 +169,74 @@ func (status VolumeStatus) IsContainer() bool {
 	return false
 }
 
+// VolumeIOLimits caps the disk I/O of app instances on a volume,
+// zero means unlimited
+type VolumeIOLimits struct {
+	ReadBps   uint64 // Bytes per second
+	WriteBps  uint64 // Bytes per second
+	ReadIops  uint64
+	WriteIops uint64
+}
+
+// IsSet returns true if any limit is set
+func (limits VolumeIOLimits) IsSet() bool {
+	return limits != VolumeIOLimits{}
+}
+
+// VolumeKeyPolicy asks for a data key of the volume's own instead of the
+// key of the vault
+type VolumeKeyPolicy struct {
+	OwnKey        bool
+	RotateCounter uint32 // A change rotates the key
+}
+
+// VolumeKeyStatus reports the data key of a volume
+type VolumeKeyStatus struct {
+	RotateCounter uint32 // Last VolumeKeyPolicy.RotateCounter the key was rotated for
+	Generation    uint32 // Number of rotations of the key
+	LastRotation  time.Time
+	// ErrorAndTime provides SetErrorNow() and ClearError()
+	ErrorAndTime
+}
+
+// VolumeFilesystem is the filesystem a blank volume is formatted with
+// when it is created
+type VolumeFilesystem struct {
+	Type  zconfig.VolumeFsType // Not formatted if unspecified
+	Label string
+	UID   uint32 // Owner of the root directory
+	GID   uint32
+}
+
+// IsSet returns true if the volume has to be formatted
+func (fs VolumeFilesystem) IsSet() bool {
+	return fs.Type != zconfig.VolumeFsType_VOLUME_FS_TYPE_UNSPECIFIED
+}
+
+// SharedVolumeSuffix is the extension of the directories of shared volumes
+const SharedVolumeSuffix = "shared"
+
+// VolumeKeyedDir returns the directory which is encrypted with the data key
+// of a volume on ext4
+func VolumeKeyedDir(volumeKey string) string {
+	return filepath.Join(VolumeKeyedDirName, volumeKey)
+}
+
 // PathName returns the path of the volume
 func (status VolumeStatus) PathName() string {
 	baseDir := VolumeClearDirName
 	if status.Encrypted {
 		baseDir = VolumeEncryptedDirName
 	}
+	if status.KeyPolicy.OwnKey {
+		baseDir = VolumeKeyedDir(status.Key())
+	}
+	if status.Shared {
+		// shared volumes are directories with any content format
+		return fmt.Sprintf("%s/%s#%d.%s", baseDir, status.VolumeID.String(),
+			status.GenerationCounter+status.LocalGenerationCounter,
+			SharedVolumeSuffix)
+	}
 	return fmt.Sprintf("%s/%s#%d.%s", baseDir, status.VolumeID.String(),
 		status.GenerationCounter+status.LocalGenerationCounter,
 		strings.ToLower(status.ContentFormat.String()))
@@ -244,6 +324,10 @@ type VolumeRefConfig struct {
 	RefCount               uint
 	MountDir               string
 	VerifyOnly             bool
+	// SharedOwner is set by zedagent for the app instance which is started
+	// first of the app instances which share the volume. It is not
+	// used in the VolumeRefConfig published by zedmanager.
+	SharedOwner bool
 }
 
 // Key : VolumeRefConfig unique key
@@ -324,6 +408,8 @@ type VolumeRefStatus struct {
 	PendingAdd             bool // Flag to identify whether volume ref config published or not
 	WWN                    string
 	VerifyOnly             bool
+	Shared                 bool           // From VolumeStatus
+	IOLimits               VolumeIOLimits // From VolumeStatus
 
 	ErrorAndTimeWithSource
 }
@@ -439,6 +525,7 @@ type VolumeCreatePending struct {
 	LocalGenerationCounter int64
 	ContentFormat          zconfig.Format
 	Encrypted              bool
+	OwnKey                 bool
 }
 
 // Key : VolumeCreatePending unique key
@@ -458,6 +545,9 @@ func (status VolumeCreatePending) PathName() string {
 	if status.Encrypted {
 		baseDir = VolumeEncryptedDirName
 	}
+	if status.OwnKey {
+		baseDir = VolumeKeyedDir(status.Key())
+	}
 	return fmt.Sprintf("%s/%s#%d.%s", baseDir, status.VolumeID.String(),
 		status.GenerationCounter+status.LocalGenerationCounter,
 		strings.ToLower(status.ContentFormat.String()))
@@ -477,6 +567,7 @@ func VolumeCreatePendingFromVolumeStatus(status VolumeStatus) VolumeCreatePendin
 		LocalGenerationCounter: status.LocalGenerationCounter,
 		ContentFormat:          status.ContentFormat,
 		Encrypted:              status.Encrypted,
+		OwnKey:                 status.KeyPolicy.OwnKey,
 	}
 }
 
@@ -26,6 +26,16 @@ type BaseOsConfig struct {
 	ContentTreeConfigList []ContentTreeConfig
 	RetryCount            int32
 	Activate              bool
+	Delta                 *BaseOsDelta // Downloaded instead of the image if set
+}
+
+// BaseOsDelta is a bsdiff patch which turns the image of the running
+// base OS into the image of a BaseOsConfig
+type BaseOsDelta struct {
+	ContentTreeConfig ContentTreeConfig // The patch
+	BaseSha256        string            // Image the patch applies to
+	BaseSize          uint64
+	ImageSha256       string // Patched image
 }
 
 func (config BaseOsConfig) Key() string {
@@ -96,6 +106,13 @@ type BaseOsStatus struct {
 	// error strings across all steps/StorageStatus
 	// ErrorAndTime provides SetErrorNow() and ClearError()
 	ErrorAndTime
+	// ActivationDeferredUntil is set while the version waits for the
+	// schedule of its content; when it is checked next
+	ActivationDeferredUntil time.Time
+	// UsingDelta is set while the ContentTreeStatusList is the delta of
+	// the config; DeltaFailed once the delta was given up for the image
+	UsingDelta  bool
+	DeltaFailed bool
 }
 
 func (status BaseOsStatus) Key() string {
@@ -199,6 +216,9 @@ type DatastoreConfig struct {
 	Region    string
 	DsCertPEM [][]byte // cert chain used for the datastore
 
+	// SignaturePolicy for the OCI images of the datastore
+	SignaturePolicy SignaturePolicy
+
 	// CipherBlockStatus, for encrypted credentials
 	CipherBlockStatus
 }
@@ -647,13 +667,30 @@ type LocalCommands struct {
 	AppCounters map[string]*LocalAppCounters // key: app UUID
 	// Local volume generation counters.
 	VolumeGenCounters map[string]int64 // key: volume UUID
+
+	// Local changes of the configuration from the controller.
+	// These are applied on top of it whenever it is parsed.
+	AppActivate    map[string]bool           // key: app UUID
+	PortMaps       map[string][]LocalPortMap // key: app UUID
+	GlobalSettings map[string]string         // key: global setting key
+	BaseOsUpdate   *LocalBaseOsUpdate
+	// Timestamp of the last handled operation with the given ID.
+	// Used to skip operations which the local server submits again.
+	Operations map[string]uint64 // key: operation ID
 }
 
 // Empty : returns true if there were no commands triggered locally
 // (for currently deployed apps and volumes).
 func (lc *LocalCommands) Empty() bool {
 	return len(lc.AppCommands) == 0 && len(lc.AppCounters) == 0 &&
-		len(lc.VolumeGenCounters) == 0
+		len(lc.VolumeGenCounters) == 0 && !lc.HasLocalChanges()
+}
+
+// HasLocalChanges : returns true if the configuration from the controller
+// is locally changed.
+func (lc *LocalCommands) HasLocalChanges() bool {
+	return len(lc.AppActivate) != 0 || len(lc.PortMaps) != 0 ||
+		len(lc.GlobalSettings) != 0 || lc.BaseOsUpdate != nil
 }
 
 // AppCommand : application command requested to run by a local server.
@@ -695,6 +732,96 @@ type LocalAppCounters struct {
 	PurgeCmd AppInstanceOpsCmd
 }
 
+// LocalPortMap : port map added, changed or removed by a local server.
+type LocalPortMap struct {
+	NetworkInstance uuid.UUID
+	Protocol        string // "tcp" or "udp"
+	AppPort         int
+	// ExternalPort is zero if the port map for AppPort is removed.
+	ExternalPort int
+}
+
+// LocalBaseOsUpdate : base OS update requested by a local server.
+type LocalBaseOsUpdate struct {
+	BaseOsVersion string
+	URL           string
+	Sha256        string
+	// ControllerConfigHash : hash of the base OS configuration from the
+	// controller when the update was requested. The update is dropped
+	// once the controller changes its base OS configuration.
+	ControllerConfigHash string
+}
+
+// LocalOperationsPolicy : operations which the controller permits
+// a local server to request.
+type LocalOperationsPolicy struct {
+	AppActivate    bool
+	GlobalSettings []string
+	PortMaps       bool
+	BaseOsUpdate   bool
+}
+
+// GlobalSettingPermitted : returns true if the local server may change
+// the global setting.
+func (policy LocalOperationsPolicy) GlobalSettingPermitted(key string) bool {
+	for _, permitted := range policy.GlobalSettings {
+		if permitted == key {
+			return true
+		}
+	}
+	return false
+}
+
+// LocalOperationType : type of an operation requested by a local server.
+type LocalOperationType uint8
+
+// Integer values are in-sync with proto enum info.LocalOperationType.
+const (
+	// LocalOperationTypeUnspecified : operation type was not specified.
+	LocalOperationTypeUnspecified LocalOperationType = iota
+	// LocalOperationTypeAppActivate : activate or deactivate an app.
+	LocalOperationTypeAppActivate
+	// LocalOperationTypeGlobalSetting : override a global setting.
+	LocalOperationTypeGlobalSetting
+	// LocalOperationTypePortMap : change a port map of an app.
+	LocalOperationTypePortMap
+	// LocalOperationTypeBaseOsUpdate : update EVE.
+	LocalOperationTypeBaseOsUpdate
+)
+
+// LocalOperationResult : outcome of an operation requested by a local server.
+type LocalOperationResult uint8
+
+// Integer values are in-sync with proto enum info.LocalOperationResult.
+const (
+	// LocalOperationResultUnspecified : unknown outcome.
+	LocalOperationResultUnspecified LocalOperationResult = iota
+	// LocalOperationResultApplied : the change was applied.
+	LocalOperationResultApplied
+	// LocalOperationResultRejected : not permitted by the policy.
+	LocalOperationResultRejected
+	// LocalOperationResultFailed : permitted but could not be applied.
+	LocalOperationResultFailed
+	// LocalOperationResultReverted : the change was reverted by the local server.
+	LocalOperationResultReverted
+	// LocalOperationResultRevoked : the change was dropped due to a change
+	// of the policy or of the configuration from the controller.
+	LocalOperationResultRevoked
+)
+
+// LocalOperationRecord : audit trail entry for an operation requested
+// by a local server.
+type LocalOperationRecord struct {
+	Time               time.Time
+	OperationID        string
+	OperationTimestamp uint64
+	Type               LocalOperationType
+	Target             string
+	Details            string
+	Result             LocalOperationResult
+	Error              string
+}
+
 // DevCommand : application command requested to run by a local server.
 type DevCommand uint8
 
@@ -33,6 +33,8 @@ type UrlcloudMetrics struct {
 	RecvByteCount  int64 // Based on content-length which could be off
 	TotalTimeSpent int64
 	SessionResume  int64
+	SentBytesSaved int64 // By compressing the requests
+	RecvBytesSaved int64 // By the controller compressing the responses
 }
 
 // AddInto adds metrics from this instance of MetricsMap
@@ -83,6 +85,8 @@ func (m MetricsMap) AddInto(toMap MetricsMap) {
 			dstURL.RecvByteCount += srcURL.RecvByteCount
 			dstURL.TotalTimeSpent += srcURL.TotalTimeSpent
 			dstURL.SessionResume += srcURL.SessionResume
+			dstURL.SentBytesSaved += srcURL.SentBytesSaved
+			dstURL.RecvBytesSaved += srcURL.RecvBytesSaved
 			dstURLs[url] = dstURL
 		}
 		toMap[ifname] = dst
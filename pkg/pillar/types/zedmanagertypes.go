@@ -77,6 +77,57 @@ type AppInstanceConfig struct {
 	ProfileList []string
 
 	Delay time.Duration
+
+	// Activation is the merged schedule of the content trees of the
+	// volumes; a new version is not activated until it allows
+	Activation ContentSchedule
+
+	// StartAfter are the app instances which have to be ready before
+	// this one starts; it halts before them
+	StartAfter []AppDependency
+
+	RestartPolicy RestartPolicy
+
+	// SecureBootKeys, for the encrypted UEFI Secure Boot keys
+	SecureBootKeys CipherBlockStatus
+
+	// PodContainers run with the main container of a NOHYPER app instance
+	PodContainers []PodContainerConfig
+
+	// HealthCheck of the main container of a NOHYPER app instance
+	HealthCheck HealthCheck
+
+	// ConsoleSessions the controller may open to the consoles
+	ConsoleSessions []ConsoleSession
+}
+
+// AppReadiness is when an app instance is ready for the app instances
+// which start after it
+type AppReadiness uint8
+
+const (
+	// AppReadinessRunning is ready once running
+	AppReadinessRunning AppReadiness = iota
+	// AppReadinessHealthOK is ready once running and healthy
+	AppReadinessHealthOK
+)
+
+// String returns the name of the readiness
+func (readiness AppReadiness) String() string {
+	switch readiness {
+	case AppReadinessRunning:
+		return "running"
+	case AppReadinessHealthOK:
+		return "health-ok"
+	default:
+		return fmt.Sprintf("Unknown readiness %d", readiness)
+	}
+}
+
+// AppDependency is an app instance which another one starts after
+type AppDependency struct {
+	AppUUID   uuid.UUID
+	Readiness AppReadiness
 }
 
 type AppInstanceOpsCmd struct {
@@ -176,6 +227,24 @@ type AppInstanceStatus struct {
 	ErrorAndTimeWithSource
 	// Effective time, when the application should start
 	StartTime time.Time
+	// ActivationDeferredUntil is set while a new version waits for the
+	// schedule of its content; when it is checked next
+	ActivationDeferredUntil time.Time
+	// WaitingForApp is the display name of the app instance which has to
+	// be ready before this one starts, or has to halt before this one halts
+	WaitingForApp string
+	// RestartCount is the number of restarts by the restart policy since
+	// the domain was started; LastExitReason and LastExitTime tell why
+	// and when it last halted by itself
+	RestartCount   uint32
+	LastExitReason string
+	LastExitTime   time.Time
+	// PodContainerStatusList has the init and sidecar containers of the pod
+	PodContainerStatusList []PodContainerStatus
+	// Health is the result of the health check of the domain
+	Health HealthStatus
+	// GuestInfo is what the guest agent of the domain last reported
+	GuestInfo GuestInfo
 }
 
 // AppCount is uint8 and it should be sufficient for the number of apps we can support
@@ -991,6 +991,163 @@ type CellConfig struct {
 	DisableProbe bool
 	// Enable to get location info from the GNSS receiver of the LTE modem.
 	LocationTracking bool
+	// SimProfiles : per-SIM settings, the first profile is the primary one.
+	SimProfiles []CellSimProfile
+	Failover    CellFailover
+}
+
+// CellSimProfile : settings for one SIM slot of a cellular modem.
+type CellSimProfile struct {
+	// SimSlot number, starting with 1.
+	SimSlot uint8
+	// APN overrides CellConfig.APN if not empty.
+	APN   string
+	Quota CellDataQuota
+}
+
+// CellFailover : failover between SIM profiles of a cellular modem.
+type CellFailover struct {
+	Enable bool
+	// ProbeFailureTimeout : switch to the next SIM profile when the connectivity
+	// probe keeps failing for this many seconds.
+	ProbeFailureTimeout uint32
+	// OnQuotaExhausted : switch to the next SIM profile when the data quota
+	// of the current one is exhausted.
+	OnQuotaExhausted bool
+	// RevertInterval : try to revert to the primary SIM profile after this many
+	// seconds. Zero means to stay with the backup SIM profile.
+	RevertInterval uint32
+}
+
+const (
+	// DefaultCellProbeFailureTimeout : default for CellFailover.ProbeFailureTimeout
+	DefaultCellProbeFailureTimeout = 900
+	// DefaultCellSoftThresholdPercent : default for CellDataQuota.SoftThresholdPercent
+	DefaultCellSoftThresholdPercent = 80
+)
+
+// TrafficClass : class of traffic which can be restricted from using
+// a cellular connection when the data quota is running out.
+// Management traffic between EVE and the controller is never restricted.
+type TrafficClass uint8
+
+const (
+	// TrafficClassUnspecified : traffic class is not specified
+	TrafficClassUnspecified TrafficClass = iota
+	// TrafficClassLogs : logs and flow logs uploaded to the controller
+	TrafficClassLogs
+	// TrafficClassMetrics : device and application metrics
+	TrafficClassMetrics
+	// TrafficClassDownloads : downloads of base OS and application images
+	TrafficClassDownloads
+	// TrafficClassApps : traffic of applications forwarded by the device
+	TrafficClassApps
+)
+
+// RestrictableTrafficClasses : all traffic classes which can be restricted.
+var RestrictableTrafficClasses = []TrafficClass{
+	TrafficClassLogs, TrafficClassMetrics, TrafficClassDownloads, TrafficClassApps,
+}
+
+// String returns the name of the traffic class.
+func (tc TrafficClass) String() string {
+	switch tc {
+	case TrafficClassLogs:
+		return "logs"
+	case TrafficClassMetrics:
+		return "metrics"
+	case TrafficClassDownloads:
+		return "downloads"
+	case TrafficClassApps:
+		return "apps"
+	}
+	return "unspecified"
+}
+
+// CellDataQuota : limit of data transferred using a SIM card in one billing cycle.
+type CellDataQuota struct {
+	// LimitBytes : zero means no quota.
+	LimitBytes uint64
+	// BillingCycleDay : day of the month (1-31) when a billing cycle starts
+	// (at midnight UTC). Clamped to the last day of shorter months.
+	BillingCycleDay uint8
+	// SoftThresholdPercent : percentage of LimitBytes after which only traffic
+	// classes from SoftAllowed can use the SIM card.
+	SoftThresholdPercent uint8
+	SoftAllowed          []TrafficClass
+	// HardAllowed : traffic classes which can use the SIM card after the quota
+	// was exhausted.
+	HardAllowed []TrafficClass
+}
+
+// BillingCycle returns the start and the end of the billing cycle
+// which the given time falls into.
+func (q CellDataQuota) BillingCycle(now time.Time) (start, end time.Time) {
+	day := int(q.BillingCycleDay)
+	if day < 1 {
+		day = 1
+	}
+	cycleStart := func(year int, month time.Month) time.Time {
+		// Day zero of the next month is the last day of this month.
+		lastDay := time.Date(year, month+1, 0, 0, 0, 0, 0, time.UTC).Day()
+		if day > lastDay {
+			return time.Date(year, month, lastDay, 0, 0, 0, 0, time.UTC)
+		}
+		return time.Date(year, month, day, 0, 0, 0, 0, time.UTC)
+	}
+	now = now.UTC()
+	start = cycleStart(now.Year(), now.Month())
+	if start.After(now) {
+		start = cycleStart(now.Year(), now.Month()-1)
+	}
+	end = cycleStart(start.Year(), start.Month()+1)
+	return start, end
+}
+
+// State returns the quota state for the given number of bytes used
+// in the current billing cycle.
+func (q CellDataQuota) State(usedBytes uint64) WwanQuotaState {
+	if q.LimitBytes == 0 {
+		return WwanQuotaStateOK
+	}
+	if usedBytes >= q.LimitBytes {
+		return WwanQuotaStateHardLimit
+	}
+	percent := uint64(q.SoftThresholdPercent)
+	if percent == 0 {
+		percent = DefaultCellSoftThresholdPercent
+	}
+	if float64(usedBytes) >= float64(q.LimitBytes)*float64(percent)/100 {
+		return WwanQuotaStateSoftLimit
+	}
+	return WwanQuotaStateOK
+}
+
+// BlockedTraffic returns traffic classes which are not allowed to use the SIM card
+// in the given quota state.
+func (q CellDataQuota) BlockedTraffic(state WwanQuotaState) (blocked []TrafficClass) {
+	var allowed []TrafficClass
+	switch state {
+	case WwanQuotaStateSoftLimit:
+		allowed = q.SoftAllowed
+	case WwanQuotaStateHardLimit:
+		allowed = q.HardAllowed
+	default:
+		return nil
+	}
+	for _, tc := range RestrictableTrafficClasses {
+		var isAllowed bool
+		for _, allowedTc := range allowed {
+			if tc == allowedTc {
+				isAllowed = true
+				break
+			}
+		}
+		if !isAllowed {
+			blocked = append(blocked, tc)
+		}
+	}
+	return blocked
 }
 
 // WirelessConfig - wireless structure
@@ -1155,12 +1312,26 @@ type NetworkPortStatus struct {
 	DefaultRouters []net.IP
 	WirelessCfg    WirelessConfig
 	WirelessStatus WirelessStatus
+	// BlockedTraffic : traffic classes which are not allowed to use this port
+	// because the data quota of the SIM card is running out.
+	BlockedTraffic []TrafficClass
 	ProxyConfig
 	L2LinkConfig
 	// TestResults provides recording of failure and success
 	TestResults
 }
 
+// IsTrafficBlocked returns true if the given traffic class is not allowed
+// to use this port.
+func (port NetworkPortStatus) IsTrafficBlocked(tc TrafficClass) bool {
+	for _, blocked := range port.BlockedTraffic {
+		if blocked == tc {
+			return true
+		}
+	}
+	return false
+}
+
 // HasIPAndDNS - Check if the given port has a valid unicast IP along with DNS & Gateway.
 func (port NetworkPortStatus) HasIPAndDNS() bool {
 	foundUnicast := false
@@ -1364,7 +1535,8 @@ func (status DeviceNetworkStatus) MostlyEqual(status2 DeviceNetworkStatus) bool
 		}
 
 		if !reflect.DeepEqual(p1.ProxyConfig, p2.ProxyConfig) ||
-			!reflect.DeepEqual(p1.WirelessStatus, p2.WirelessStatus) {
+			!reflect.DeepEqual(p1.WirelessStatus, p2.WirelessStatus) ||
+			!reflect.DeepEqual(p1.BlockedTraffic, p2.BlockedTraffic) {
 			return false
 		}
 	}
@@ -1431,6 +1603,19 @@ func (status *DeviceNetworkStatus) GetPortByLogicallabel(
 	return nil
 }
 
+// WithoutBlockedTraffic returns a copy of DeviceNetworkStatus without ports
+// which the given traffic class is not allowed to use.
+func (status DeviceNetworkStatus) WithoutBlockedTraffic(tc TrafficClass) DeviceNetworkStatus {
+	filtered := status
+	filtered.Ports = nil
+	for _, port := range status.Ports {
+		if !port.IsTrafficBlocked(tc) {
+			filtered.Ports = append(filtered.Ports, port)
+		}
+	}
+	return filtered
+}
+
 // HasErrors - DeviceNetworkStatus has errors on any of it's ports?
 func (status DeviceNetworkStatus) HasErrors() bool {
 	for _, port := range status.Ports {
@@ -3336,6 +3521,9 @@ type WwanNetworkConfig struct {
 	// microservice. This is further distributed to the controller and
 	// to applications by zedagent.
 	LocationTracking bool `json:"location-tracking"`
+	// SIM slot to use, starting with 1.
+	// Zero means to use whatever SIM slot is currently active.
+	SimSlot uint8 `json:"sim-slot,omitempty"`
 }
 
 // WwanProbe : cellular connectivity verification probe.
@@ -3357,7 +3545,8 @@ func (wnc WwanNetworkConfig) Equal(wnc2 WwanNetworkConfig) bool {
 		wnc.Probe.Disable != wnc2.Probe.Disable {
 		return false
 	}
-	if wnc.LocationTracking != wnc2.LocationTracking {
+	if wnc.LocationTracking != wnc2.LocationTracking ||
+		wnc.SimSlot != wnc2.SimSlot {
 		return false
 	}
 	if len(wnc.Apns) != len(wnc2.Apns) {
@@ -3457,6 +3646,9 @@ type WwanNetworkStatus struct {
 	ConfigError  string         `json:"config-error"`
 	ProbeError   string         `json:"probe-error"`
 	Providers    []WwanProvider `json:"providers"`
+	// SIM slot currently used by the modem, starting with 1.
+	// Zero if not known.
+	CurrentSimSlot uint8 `json:"current-sim-slot"`
 }
 
 // WwanCellModule contains cellular module specs.
@@ -3474,6 +3666,9 @@ type WwanSimCard struct {
 	Name  string `json:"name,omitempty"`
 	ICCID string `json:"iccid"`
 	IMSI  string `json:"imsi"`
+	// SlotNumber : SIM slot the card is inserted into (starting with 1).
+	// Zero if not known.
+	SlotNumber uint8 `json:"slot-number,omitempty"`
 }
 
 // WwanProvider contains information about a cellular connectivity provider.
@@ -3580,7 +3775,10 @@ type WwanNetworkMetrics struct {
 	LogicalLabel string          `json:"logical-label"`
 	PhysAddrs    WwanPhysAddrs   `json:"physical-addrs"`
 	PacketStats  WwanPacketStats `json:"packet-stats"`
-	SignalInfo   WwanSignalInfo  `json:"signal-info"`
+	// ConnectionID changes whenever the connection is (re)started and
+	// the packet counters start from zero. Empty if not known.
+	ConnectionID string         `json:"connection-id"`
+	SignalInfo   WwanSignalInfo `json:"signal-info"`
 }
 
 // WwanPacketStats contains packet statistics recorded by a cellular modem.
@@ -3606,6 +3804,124 @@ type WwanSignalInfo struct {
 	SNR int32 `json:"snr"`
 }
 
+// WwanQuotaState : state of the data quota of a SIM card.
+type WwanQuotaState uint8
+
+const (
+	// WwanQuotaStateNone : quota state is not known
+	WwanQuotaStateNone WwanQuotaState = iota
+	// WwanQuotaStateOK : usage is below the soft threshold (or there is no quota)
+	WwanQuotaStateOK
+	// WwanQuotaStateSoftLimit : usage crossed the soft threshold
+	WwanQuotaStateSoftLimit
+	// WwanQuotaStateHardLimit : quota is exhausted
+	WwanQuotaStateHardLimit
+)
+
+// String returns the name of the quota state.
+func (s WwanQuotaState) String() string {
+	switch s {
+	case WwanQuotaStateOK:
+		return "ok"
+	case WwanQuotaStateSoftLimit:
+		return "soft-limit"
+	case WwanQuotaStateHardLimit:
+		return "hard-limit"
+	}
+	return "none"
+}
+
+// WwanDataUsage : data usage of a SIM card in the current billing cycle,
+// accounted by nim from the modem packet counters.
+// Published persistently by nim so that the usage survives reboots.
+type WwanDataUsage struct {
+	// SimKey : ICCID of the SIM card if known, otherwise derived from the logical
+	// label of the modem and the SIM slot.
+	SimKey       string
+	ICCID        string
+	LogicalLabel string
+	SimSlot      uint8
+	CycleStart   time.Time
+	CycleEnd     time.Time
+	RxBytes      uint64
+	TxBytes      uint64
+	// LimitBytes : configured quota, zero if there is none.
+	LimitBytes     uint64
+	QuotaState     WwanQuotaState
+	BlockedTraffic []TrafficClass
+	// Modem packet counters seen in the last update, used to compute
+	// the delta for the next update.
+	LastModemRxBytes uint64
+	LastModemTxBytes uint64
+	// Connection of the modem the counters above were seen for.
+	LastModemConnectionID string
+	LastUpdate            time.Time
+}
+
+// WwanSimKey returns the key of WwanDataUsage for a SIM card.
+func WwanSimKey(iccid, logicalLabel string, simSlot uint8) string {
+	if iccid != "" {
+		return iccid
+	}
+	return fmt.Sprintf("%s-sim%d", logicalLabel, simSlot)
+}
+
+// UsedBytes returns the number of bytes transferred in the current billing cycle.
+func (du WwanDataUsage) UsedBytes() uint64 {
+	return du.RxBytes + du.TxBytes
+}
+
+// Key is used for pubsub
+func (du WwanDataUsage) Key() string {
+	return du.SimKey
+}
+
+// LogCreate :
+func (du WwanDataUsage) LogCreate(logBase *base.LogObject) {
+	logObject := base.NewLogObject(logBase, base.WwanDataUsageLogType, "",
+		nilUUID, du.LogKey())
+	if logObject == nil {
+		return
+	}
+	logObject.CloneAndAddField("used-bytes", du.UsedBytes()).
+		AddField("quota-state", du.QuotaState.String()).
+		Noticef("Wwan data usage create")
+}
+
+// LogModify :
+func (du WwanDataUsage) LogModify(logBase *base.LogObject, old interface{}) {
+	logObject := base.EnsureLogObject(logBase, base.WwanDataUsageLogType, "",
+		nilUUID, du.LogKey())
+
+	oldDu, ok := old.(WwanDataUsage)
+	if !ok {
+		logObject.Clone().Fatalf("LogModify: Old object passed is not of WwanDataUsage type")
+	}
+	if oldDu.QuotaState != du.QuotaState || !oldDu.CycleStart.Equal(du.CycleStart) {
+		logObject.CloneAndAddField("used-bytes", du.UsedBytes()).
+			AddField("quota-state", du.QuotaState.String()).
+			AddField("old-quota-state", oldDu.QuotaState.String()).
+			Noticef("Wwan data usage modify")
+	} else {
+		// Usage changes with every metrics update.
+		logObject.CloneAndAddField("diff", cmp.Diff(oldDu, du)).
+			Metricf("Wwan data usage modify other change")
+	}
+}
+
+// LogDelete :
+func (du WwanDataUsage) LogDelete(logBase *base.LogObject) {
+	logObject := base.EnsureLogObject(logBase, base.WwanDataUsageLogType, "",
+		nilUUID, du.LogKey())
+	logObject.Noticef("Wwan data usage delete")
+	base.DeleteLogObject(logBase, du.LogKey())
+}
+
+// LogKey :
+func (du WwanDataUsage) LogKey() string {
+	return string(base.WwanDataUsageLogType) + "-" + du.Key()
+}
+
 // WwanLocationInfo contains device location information obtained from a GNSS
 // receiver integrated into an LTE modem.
 type WwanLocationInfo struct {
@@ -1380,3 +1380,74 @@ func TestGetLocalAddrList(t *testing.T) {
 		}
 	}
 }
+
+func TestCellDataQuotaBillingCycle(t *testing.T) {
+	date := func(year int, month time.Month, day, hour int) time.Time {
+		return time.Date(year, month, day, hour, 0, 0, 0, time.UTC)
+	}
+	testMatrix := map[string]struct {
+		cycleDay      uint8
+		now           time.Time
+		expectedStart time.Time
+		expectedEnd   time.Time
+	}{
+		"Default day": {
+			cycleDay:      0,
+			now:           date(2022, time.June, 15, 10),
+			expectedStart: date(2022, time.June, 1, 0),
+			expectedEnd:   date(2022, time.July, 1, 0),
+		},
+		"Before the cycle day": {
+			cycleDay:      20,
+			now:           date(2022, time.June, 15, 10),
+			expectedStart: date(2022, time.May, 20, 0),
+			expectedEnd:   date(2022, time.June, 20, 0),
+		},
+		"On the cycle day": {
+			cycleDay:      15,
+			now:           date(2022, time.June, 15, 0),
+			expectedStart: date(2022, time.June, 15, 0),
+			expectedEnd:   date(2022, time.July, 15, 0),
+		},
+		"Clamped to the end of February": {
+			cycleDay:      31,
+			now:           date(2022, time.March, 10, 10),
+			expectedStart: date(2022, time.February, 28, 0),
+			expectedEnd:   date(2022, time.March, 31, 0),
+		},
+		"Across the year": {
+			cycleDay:      10,
+			now:           date(2023, time.January, 5, 10),
+			expectedStart: date(2022, time.December, 10, 0),
+			expectedEnd:   date(2023, time.January, 10, 0),
+		},
+	}
+	for testname, test := range testMatrix {
+		t.Logf("Running test case %s", testname)
+		quota := CellDataQuota{BillingCycleDay: test.cycleDay}
+		start, end := quota.BillingCycle(test.now)
+		assert.Equal(t, test.expectedStart, start)
+		assert.Equal(t, test.expectedEnd, end)
+	}
+}
+
+func TestCellDataQuotaState(t *testing.T) {
+	quota := CellDataQuota{
+		LimitBytes:  1000,
+		SoftAllowed: []TrafficClass{TrafficClassApps, TrafficClassMetrics},
+	}
+	assert.Equal(t, WwanQuotaStateOK, quota.State(799))
+	assert.Equal(t, WwanQuotaStateSoftLimit, quota.State(800))
+	assert.Equal(t, WwanQuotaStateHardLimit, quota.State(1000))
+	assert.Empty(t, quota.BlockedTraffic(WwanQuotaStateOK))
+	assert.Equal(t, []TrafficClass{TrafficClassLogs, TrafficClassDownloads},
+		quota.BlockedTraffic(WwanQuotaStateSoftLimit))
+	assert.Equal(t, RestrictableTrafficClasses,
+		quota.BlockedTraffic(WwanQuotaStateHardLimit))
+
+	quota.SoftThresholdPercent = 50
+	assert.Equal(t, WwanQuotaStateSoftLimit, quota.State(500))
+
+	// No quota.
+	assert.Equal(t, WwanQuotaStateOK, CellDataQuota{}.State(1<<40))
+}
@@ -6,6 +6,7 @@ package types
 import (
 	"fmt"
 	"strings"
+	"time"
 )
 
 const (
@@ -95,6 +96,8 @@ type ZFSPoolStatus struct {
 	Disks            []*StorageDiskState
 	CollectorErrors  string
 	Children         []*StorageChildren
+	Scan             StorageScanStatus
+	Spares           []*StorageDiskState
 }
 
 //Key for pubsub
@@ -111,8 +114,49 @@ type DiskDescription struct {
 
 //StorageDiskState represent state of disk
 type StorageDiskState struct {
-	DiskName *DiskDescription
-	Status   StorageStatus
+	DiskName         *DiskDescription
+	Status           StorageStatus
+	Resilvering      bool  // disk is being resilvered, e.g. replaces another disk
+	ResilverProgress uint8 // percentage of resilver done
+	Spare            bool  // hot spare of the pool
+}
+
+// StorageScanType indicates type of the scan of zfs pool
+type StorageScanType int32
+
+// StorageScanType enum should be in sync with info api
+const (
+	StorageScanTypeUnspecified StorageScanType = 0 // no scan was done
+	StorageScanTypeScrub       StorageScanType = 1 // verification of all data
+	StorageScanTypeResilver    StorageScanType = 2 // reconstruction of data on new disks
+)
+
+// StorageScanState indicates state of the scan of zfs pool
+type StorageScanState int32
+
+// StorageScanState enum should be in sync with info api
+const (
+	StorageScanStateUnspecified StorageScanState = 0
+	StorageScanStateScanning    StorageScanState = 1
+	StorageScanStateFinished    StorageScanState = 2
+	StorageScanStateCanceled    StorageScanState = 3
+)
+
+// StorageScanStatus stores status of the last or ongoing scan (scrub or resilver)
+type StorageScanStatus struct {
+	Type      StorageScanType
+	State     StorageScanState
+	StartTime time.Time
+	EndTime   time.Time // zero while scanning
+	ToExamine uint64    // bytes
+	Examined  uint64    // bytes
+	Progress  uint8     // percentage of scan done
+	Errors    uint64
+}
+
+// InProgress returns true if the scan is running
+func (s StorageScanStatus) InProgress() bool {
+	return s.State == StorageScanStateScanning
 }
 
 // StorageChildren stores children of zfs pool
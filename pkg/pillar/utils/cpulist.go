@@ -0,0 +1,67 @@
+// Copyright (c) 2022 Zededa, Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package utils
+
+import (
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// ParseCPUList returns the sorted CPUs of a list in the format of the
+// kernel, e.g. "0-2,5"
+func ParseCPUList(list string) ([]int, error) {
+	set := make(map[int]bool)
+	for _, item := range strings.Split(strings.TrimSpace(list), ",") {
+		if item == "" {
+			continue
+		}
+		bounds := strings.SplitN(item, "-", 2)
+		first, err := strconv.Atoi(bounds[0])
+		if err != nil {
+			return nil, fmt.Errorf("invalid CPU list %s: %v", list, err)
+		}
+		last := first
+		if len(bounds) == 2 {
+			last, err = strconv.Atoi(bounds[1])
+			if err != nil {
+				return nil, fmt.Errorf("invalid CPU list %s: %v", list, err)
+			}
+		}
+		if first < 0 || last < first {
+			return nil, fmt.Errorf("invalid CPU list %s: bad range %s",
+				list, item)
+		}
+		for cpu := first; cpu <= last; cpu++ {
+			set[cpu] = true
+		}
+	}
+	var cpus []int
+	for cpu := range set {
+		cpus = append(cpus, cpu)
+	}
+	sort.Ints(cpus)
+	return cpus, nil
+}
+
+// FormatCPUList returns the CPUs as a list in the format of the kernel
+func FormatCPUList(cpus []int) string {
+	sorted := append([]int{}, cpus...)
+	sort.Ints(sorted)
+	var items []string
+	for i := 0; i < len(sorted); {
+		j := i
+		for j+1 < len(sorted) && sorted[j+1] <= sorted[j]+1 {
+			j++
+		}
+		if sorted[j] == sorted[i] {
+			items = append(items, strconv.Itoa(sorted[i]))
+		} else {
+			items = append(items, fmt.Sprintf("%d-%d", sorted[i], sorted[j]))
+		}
+		i = j + 1
+	}
+	return strings.Join(items, ",")
+}
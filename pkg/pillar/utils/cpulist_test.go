@@ -0,0 +1,68 @@
+// Copyright (c) 2022 Zededa, Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package utils
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestCPUList(t *testing.T) {
+	testMatrix := map[string]struct {
+		list      string
+		cpus      []int
+		formatted string
+		fail      bool
+	}{
+		"Empty": {
+			list:      "",
+			formatted: "",
+		},
+		"Single": {
+			list:      "3",
+			cpus:      []int{3},
+			formatted: "3",
+		},
+		"Ranges": {
+			list:      "0-2,5,7-8\n",
+			cpus:      []int{0, 1, 2, 5, 7, 8},
+			formatted: "0-2,5,7-8",
+		},
+		"Unsorted with overlaps": {
+			list:      "6,1-3,2",
+			cpus:      []int{1, 2, 3, 6},
+			formatted: "1-3,6",
+		},
+		"Bad range": {
+			list: "3-1",
+			fail: true,
+		},
+		"Not a number": {
+			list: "1,x",
+			fail: true,
+		},
+	}
+	for testname, test := range testMatrix {
+		t.Logf("Running test case %s", testname)
+		cpus, err := ParseCPUList(test.list)
+		if test.fail {
+			if err == nil {
+				t.Errorf("ParseCPUList(%s) did not fail", test.list)
+			}
+			continue
+		}
+		if err != nil {
+			t.Errorf("ParseCPUList(%s) failed: %v", test.list, err)
+			continue
+		}
+		if !reflect.DeepEqual(cpus, test.cpus) {
+			t.Errorf("ParseCPUList(%s) = %v, expected %v",
+				test.list, cpus, test.cpus)
+		}
+		if formatted := FormatCPUList(cpus); formatted != test.formatted {
+			t.Errorf("FormatCPUList(%v) = %s, expected %s",
+				cpus, formatted, test.formatted)
+		}
+	}
+}
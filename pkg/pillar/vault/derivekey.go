@@ -0,0 +1,30 @@
+// Copyright (c) 2022 Zededa, Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package vault
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"fmt"
+
+	"github.com/lf-edge/eve/pkg/pillar/base"
+	etpm "github.com/lf-edge/eve/pkg/pillar/evetpm"
+)
+
+// DeriveKey returns a 32 byte key for the purpose derived from the vault key
+// in the TPM. Data protected with the key can only be recovered by this
+// device and only while the vault can be unlocked.
+func DeriveKey(log *base.LogObject, purpose string) ([]byte, error) {
+	if !etpm.IsTpmEnabled() {
+		return nil, fmt.Errorf("no TPM to derive the %s key from", purpose)
+	}
+	// vaultmgr has fetched or created the key at boot
+	vaultKey, err := etpm.FetchSealedVaultKey(log)
+	if err != nil {
+		return nil, fmt.Errorf("cannot fetch the vault key for %s: %v", purpose, err)
+	}
+	mac := hmac.New(sha256.New, vaultKey)
+	mac.Write([]byte("eve-" + purpose))
+	return mac.Sum(nil), nil
+}
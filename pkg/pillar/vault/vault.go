@@ -5,9 +5,12 @@ package vault
 
 import (
 	"bytes"
+	"errors"
+	"fmt"
 	"io/ioutil"
 	"os"
 	"os/exec"
+	"regexp"
 	"strings"
 
 	"github.com/lf-edge/eve/api/go/info"
@@ -98,6 +101,40 @@ func GetOperInfo(log *base.LogObject) (info.DataSecAtRestStatus, string) {
 	}
 }
 
+// DefaultVaultEncrypted returns whether the default vault is encrypted,
+// which it is not without a TPM, or an error if it is locked. What is
+// written to it before it is unlocked either fails or ends up unencrypted
+// under its mount point.
+func DefaultVaultEncrypted() (bool, error) {
+	if !etpm.IsTpmEnabled() {
+		return false, nil
+	}
+	switch ReadPersistType() {
+	case types.PersistExt4:
+		stdOut, _, err := execCmd(FscryptPath, "status", types.SealedDirName)
+		if err != nil {
+			// Not encrypted, e.g. it was not empty when fscrypt was set up
+			return false, nil
+		}
+		if !strings.Contains(stdOut, "Unlocked: Yes") {
+			return false, errors.New("vault is locked")
+		}
+		return true, nil
+	case types.PersistZFS:
+		dataset := DefaultZpool + "/vault"
+		stdOut, stdErr, err := execCmd(ZfsPath, getOperStatusParams(dataset)...)
+		if err != nil {
+			return false, fmt.Errorf("no vault dataset %s: %v: %s", dataset, err, stdErr)
+		}
+		if !regexp.MustCompile(`mounted\s+yes\s`).MatchString(stdOut) ||
+			regexp.MustCompile(`keystatus\s+unavailable\s`).MatchString(stdOut) {
+			return false, errors.New("vault is locked")
+		}
+		return true, nil
+	}
+	return false, nil
+}
+
 func execCmd(command string, args ...string) (string, string, error) {
 	var stdout, stderr bytes.Buffer
 
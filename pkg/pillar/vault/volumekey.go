@@ -0,0 +1,240 @@
+// Copyright (c) 2022 Zededa, Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package vault
+
+// Volumes which are encrypted with keys of their own get a random data key
+// which is kept wrapped by a key derived from the vault key. The wrapped
+// data key is removed along with the volume. This is not a crypto-erase:
+// on a copy-on-write filesystem or on flash the old content of the key
+// file may survive, and the vault key still unwraps it.
+
+import (
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+
+	"github.com/lf-edge/eve/pkg/pillar/base"
+	"github.com/lf-edge/eve/pkg/pillar/types"
+	fileutils "github.com/lf-edge/eve/pkg/pillar/utils/file"
+)
+
+const (
+	// VolumeKeyLen is the length of the data keys of the volumes
+	VolumeKeyLen = 32
+
+	volumeKeyPurpose = "volume-keys"
+	volumeKeySuffix  = ".key"
+	// a rotated key is kept aside until the storage uses it
+	volumeNextKeySuffix = ".next"
+)
+
+// wrappedVolumeKey is the content of the key files
+type wrappedVolumeKey struct {
+	Generation uint32
+	Nonce      []byte
+	Key        []byte
+}
+
+func volumeKeyFile(volumeKey string) string {
+	return filepath.Join(types.VolumeKeysDirName, volumeKey+volumeKeySuffix)
+}
+
+func volumeNextKeyFile(volumeKey string) string {
+	return filepath.Join(types.VolumeKeysDirName, volumeKey+volumeNextKeySuffix)
+}
+
+// wrapVolumeKey encrypts the data key with AES-GCM. The key of the volume
+// is authenticated as well hence a wrapped key cannot be used for another
+// volume.
+func wrapVolumeKey(wrappingKey []byte, volumeKey string, generation uint32, key []byte) (*wrappedVolumeKey, error) {
+	block, err := aes.NewCipher(wrappingKey)
+	if err != nil {
+		return nil, err
+	}
+	gcm, err := cipher.NewGCM(block)
+	if err != nil {
+		return nil, err
+	}
+	nonce := make([]byte, gcm.NonceSize())
+	if _, err := rand.Read(nonce); err != nil {
+		return nil, err
+	}
+	return &wrappedVolumeKey{
+		Generation: generation,
+		Nonce:      nonce,
+		Key:        gcm.Seal(nil, nonce, key, []byte(volumeKey)),
+	}, nil
+}
+
+// unwrapVolumeKey is the reverse of wrapVolumeKey
+func unwrapVolumeKey(wrappingKey []byte, volumeKey string, wrapped *wrappedVolumeKey) ([]byte, error) {
+	block, err := aes.NewCipher(wrappingKey)
+	if err != nil {
+		return nil, err
+	}
+	gcm, err := cipher.NewGCM(block)
+	if err != nil {
+		return nil, err
+	}
+	if len(wrapped.Nonce) != gcm.NonceSize() {
+		return nil, fmt.Errorf("bad nonce length %d", len(wrapped.Nonce))
+	}
+	key, err := gcm.Open(nil, wrapped.Nonce, wrapped.Key, []byte(volumeKey))
+	if err != nil {
+		return nil, fmt.Errorf("cannot unwrap the key of volume %s: %v", volumeKey, err)
+	}
+	return key, nil
+}
+
+func writeVolumeKey(log *base.LogObject, fileName, volumeKey string, generation uint32) ([]byte, error) {
+	wrappingKey, err := DeriveKey(log, volumeKeyPurpose)
+	if err != nil {
+		return nil, err
+	}
+	key := make([]byte, VolumeKeyLen)
+	if _, err := rand.Read(key); err != nil {
+		return nil, err
+	}
+	wrapped, err := wrapVolumeKey(wrappingKey, volumeKey, generation, key)
+	if err != nil {
+		return nil, err
+	}
+	b, err := json.Marshal(wrapped)
+	if err != nil {
+		return nil, err
+	}
+	if err := os.MkdirAll(types.VolumeKeysDirName, 0700); err != nil {
+		return nil, err
+	}
+	if err := fileutils.WriteRename(fileName, b); err != nil {
+		return nil, err
+	}
+	return key, nil
+}
+
+func readVolumeKey(log *base.LogObject, fileName, volumeKey string) ([]byte, uint32, error) {
+	b, err := ioutil.ReadFile(fileName)
+	if err != nil {
+		return nil, 0, err
+	}
+	var wrapped wrappedVolumeKey
+	if err := json.Unmarshal(b, &wrapped); err != nil {
+		return nil, 0, fmt.Errorf("cannot parse %s: %v", fileName, err)
+	}
+	wrappingKey, err := DeriveKey(log, volumeKeyPurpose)
+	if err != nil {
+		return nil, 0, err
+	}
+	key, err := unwrapVolumeKey(wrappingKey, volumeKey, &wrapped)
+	if err != nil {
+		return nil, 0, err
+	}
+	return key, wrapped.Generation, nil
+}
+
+// eraseFile overwrites the file before removing it. This only clears the
+// blocks of the file in place, which copy-on-write filesystems and flash
+// do not guarantee.
+func eraseFile(fileName string) error {
+	f, err := os.OpenFile(fileName, os.O_WRONLY, 0)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil
+		}
+		return err
+	}
+	st, err := f.Stat()
+	if err == nil {
+		_, err = f.WriteAt(make([]byte, st.Size()), 0)
+	}
+	if err == nil {
+		err = f.Sync()
+	}
+	f.Close()
+	if err != nil {
+		return fmt.Errorf("cannot overwrite %s: %v", fileName, err)
+	}
+	return os.Remove(fileName)
+}
+
+// CreateVolumeKey creates the data key of a volume. An existing key is
+// replaced hence the key has to be created before the storage.
+func CreateVolumeKey(log *base.LogObject, volumeKey string) ([]byte, error) {
+	if err := eraseFile(volumeNextKeyFile(volumeKey)); err != nil {
+		return nil, err
+	}
+	return writeVolumeKey(log, volumeKeyFile(volumeKey), volumeKey, 0)
+}
+
+// ReadVolumeKey returns the data key of a volume and the number of times
+// it has been rotated
+func ReadVolumeKey(log *base.LogObject, volumeKey string) ([]byte, uint32, error) {
+	return readVolumeKey(log, volumeKeyFile(volumeKey), volumeKey)
+}
+
+// ReadNextVolumeKey returns the rotated data key of a volume which is not
+// committed yet
+func ReadNextVolumeKey(log *base.LogObject, volumeKey string) ([]byte, uint32, error) {
+	return readVolumeKey(log, volumeNextKeyFile(volumeKey), volumeKey)
+}
+
+// RotateVolumeKey creates the next data key of a volume. The caller
+// re-keys the storage and then commits the next key. Until then both keys
+// are kept, the current one in case of a failure and the next one in case
+// of a crash after the storage was re-keyed.
+func RotateVolumeKey(log *base.LogObject, volumeKey string) ([]byte, error) {
+	_, generation, err := ReadVolumeKey(log, volumeKey)
+	if err != nil {
+		return nil, err
+	}
+	return writeVolumeKey(log, volumeNextKeyFile(volumeKey), volumeKey, generation+1)
+}
+
+// CommitVolumeKey makes the next data key the current one
+func CommitVolumeKey(volumeKey string) error {
+	if err := os.Rename(volumeNextKeyFile(volumeKey), volumeKeyFile(volumeKey)); err != nil {
+		return err
+	}
+	return fileutils.DirSync(types.VolumeKeysDirName)
+}
+
+// DiscardNextVolumeKey removes the next data key after a failed rotation
+func DiscardNextVolumeKey(volumeKey string) error {
+	return eraseFile(volumeNextKeyFile(volumeKey))
+}
+
+// DestroyVolumeKey removes the data keys of a volume
+func DestroyVolumeKey(volumeKey string) error {
+	if err := eraseFile(volumeNextKeyFile(volumeKey)); err != nil {
+		return err
+	}
+	if err := eraseFile(volumeKeyFile(volumeKey)); err != nil {
+		return err
+	}
+	return fileutils.DirSync(types.VolumeKeysDirName)
+}
+
+// ListVolumeKeys returns the volumes which have data keys
+func ListVolumeKeys() ([]string, error) {
+	files, err := ioutil.ReadDir(types.VolumeKeysDirName)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	var volumeKeys []string
+	for _, f := range files {
+		name := f.Name()
+		if filepath.Ext(name) == volumeKeySuffix {
+			volumeKeys = append(volumeKeys, name[:len(name)-len(volumeKeySuffix)])
+		}
+	}
+	return volumeKeys, nil
+}
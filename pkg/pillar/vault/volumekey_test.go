@@ -0,0 +1,38 @@
+// Copyright (c) 2022 Zededa, Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package vault
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestWrapVolumeKey(t *testing.T) {
+	wrappingKey := bytes.Repeat([]byte{1}, 32)
+	key := bytes.Repeat([]byte{2}, VolumeKeyLen)
+	volumeKey := "4b1d5a7e-8a4c-4b5e-9d2a-0f6e1c3d2b1a#0"
+
+	wrapped, err := wrapVolumeKey(wrappingKey, volumeKey, 3, key)
+	assert.Nil(t, err)
+	assert.Equal(t, uint32(3), wrapped.Generation)
+	assert.NotContains(t, string(wrapped.Key), string(key))
+
+	unwrapped, err := unwrapVolumeKey(wrappingKey, volumeKey, wrapped)
+	assert.Nil(t, err)
+	assert.Equal(t, key, unwrapped)
+
+	// the wrapped key belongs to one volume only
+	_, err = unwrapVolumeKey(wrappingKey, "4b1d5a7e-8a4c-4b5e-9d2a-0f6e1c3d2b1a#1", wrapped)
+	assert.NotNil(t, err)
+
+	// and cannot be unwrapped without the vault key
+	_, err = unwrapVolumeKey(bytes.Repeat([]byte{3}, 32), volumeKey, wrapped)
+	assert.NotNil(t, err)
+
+	wrapped.Nonce = wrapped.Nonce[1:]
+	_, err = unwrapVolumeKey(wrappingKey, volumeKey, wrapped)
+	assert.NotNil(t, err)
+}
@@ -0,0 +1,171 @@
+// Copyright (c) 2022 Zededa, Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package vault
+
+// On ext4 the volumes with keys of their own are kept in directories with
+// fscrypt policies of their own. The data key of the volume is the raw key
+// of the protector of the policy.
+
+import (
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strings"
+
+	"github.com/lf-edge/eve/pkg/pillar/base"
+)
+
+const (
+	volumeKeyStageDir       = "/run/volumekeys"
+	volumeProtectorPrefix   = "VolumeKey-"
+	fscryptAlreadyUnlocked  = "already unlocked"
+	fscryptProtectorPattern = "([[:xdigit:]]+)  No      raw key protector \"%s\""
+	fscryptPolicyPattern    = "([[:xdigit:]]+)  No        %s"
+)
+
+// stageVolumeKey writes the key into a tmpfs file for fscrypt
+func stageVolumeKey(volumeKey, suffix string, key []byte) (string, error) {
+	if err := os.MkdirAll(volumeKeyStageDir, 0700); err != nil {
+		return "", err
+	}
+	keyFile := filepath.Join(volumeKeyStageDir,
+		strings.ReplaceAll(volumeKey, "#", ".")+suffix)
+	if err := ioutil.WriteFile(keyFile, key, 0600); err != nil {
+		return "", err
+	}
+	return keyFile, nil
+}
+
+func unstageVolumeKey(log *base.LogObject, keyFile string) {
+	if err := eraseFile(keyFile); err != nil {
+		log.Errorf("unstageVolumeKey: %v", err)
+	}
+}
+
+func volumeProtectorName(volumeKey string) string {
+	return volumeProtectorPrefix + strings.ReplaceAll(volumeKey, "#", ".")
+}
+
+func getVolumeProtectorID(volumeKey string) (string, error) {
+	stdOut, stdErr, err := execCmd(FscryptPath, StatusParams...)
+	if err != nil {
+		return "", fmt.Errorf("fscrypt status failed: %v: %s", err, stdErr)
+	}
+	protector := regexp.MustCompile(fmt.Sprintf(fscryptProtectorPattern,
+		volumeProtectorName(volumeKey)))
+	match := protector.FindStringSubmatch(stdOut)
+	if match == nil {
+		return "", nil
+	}
+	return match[1], nil
+}
+
+func getVolumePolicyID(protectorID string) (string, error) {
+	stdOut, stdErr, err := execCmd(FscryptPath, StatusParams...)
+	if err != nil {
+		return "", fmt.Errorf("fscrypt status failed: %v: %s", err, stdErr)
+	}
+	policy := regexp.MustCompile(fmt.Sprintf(fscryptPolicyPattern, protectorID))
+	match := policy.FindStringSubmatch(stdOut)
+	if match == nil {
+		return "", nil
+	}
+	return match[1], nil
+}
+
+// EncryptVolumeDir sets up the fscrypt policy of the empty directory dir
+// with the data key of the volume and unlocks it
+func EncryptVolumeDir(log *base.LogObject, dir, volumeKey string, key []byte) error {
+	keyFile, err := stageVolumeKey(volumeKey, volumeKeySuffix, key)
+	if err != nil {
+		return err
+	}
+	defer unstageVolumeKey(log, keyFile)
+	args := []string{"encrypt", dir, "--key=" + keyFile, "--source=raw_key",
+		"--name=" + volumeProtectorName(volumeKey), "--user=root"}
+	if stdOut, stdErr, err := execCmd(FscryptPath, args...); err != nil {
+		return fmt.Errorf("fscrypt encrypt %s failed: %v: %s %s", dir, err, stdOut, stdErr)
+	}
+	return nil
+}
+
+// UnlockVolumeDir unlocks the directory of a volume after a reboot
+func UnlockVolumeDir(log *base.LogObject, dir, volumeKey string, key []byte) error {
+	keyFile, err := stageVolumeKey(volumeKey, volumeKeySuffix, key)
+	if err != nil {
+		return err
+	}
+	defer unstageVolumeKey(log, keyFile)
+	args := []string{"unlock", dir, "--key=" + keyFile, "--user=root"}
+	stdOut, stdErr, err := execCmd(FscryptPath, args...)
+	if err != nil && !strings.Contains(stdErr, fscryptAlreadyUnlocked) {
+		return fmt.Errorf("fscrypt unlock %s failed: %v: %s %s", dir, err, stdOut, stdErr)
+	}
+	return nil
+}
+
+// ChangeVolumeDirKey changes the protector of the directory of a volume
+// from the old to the new data key. fscrypt keeps the key which encrypts
+// the files and re-wraps it.
+func ChangeVolumeDirKey(log *base.LogObject, volumeKey string, oldKey, newKey []byte) error {
+	protectorID, err := getVolumeProtectorID(volumeKey)
+	if err != nil {
+		return err
+	}
+	if protectorID == "" {
+		return fmt.Errorf("no fscrypt protector for volume %s", volumeKey)
+	}
+	oldKeyFile, err := stageVolumeKey(volumeKey, volumeKeySuffix, oldKey)
+	if err != nil {
+		return err
+	}
+	defer unstageVolumeKey(log, oldKeyFile)
+	newKeyFile, err := stageVolumeKey(volumeKey, volumeNextKeySuffix, newKey)
+	if err != nil {
+		return err
+	}
+	defer unstageVolumeKey(log, newKeyFile)
+	args := []string{"metadata", "change-passphrase", "--key=" + newKeyFile,
+		"--old-key=" + oldKeyFile, "--source=raw_key",
+		"--protector=" + MountPoint + ":" + protectorID}
+	if stdOut, stdErr, err := execCmd(FscryptPath, args...); err != nil {
+		return fmt.Errorf("fscrypt change-passphrase of volume %s failed: %v: %s %s",
+			volumeKey, err, stdOut, stdErr)
+	}
+	return nil
+}
+
+// DestroyVolumeDirPolicy locks the directory of a volume and removes the
+// fscrypt metadata of it. The files cannot be read any more.
+func DestroyVolumeDirPolicy(log *base.LogObject, dir, volumeKey string) error {
+	if _, _, err := execCmd(FscryptPath, "lock", dir, "--user=root"); err != nil {
+		// still in use or locked already
+		log.Warnf("DestroyVolumeDirPolicy: fscrypt lock %s failed: %v", dir, err)
+	}
+	protectorID, err := getVolumeProtectorID(volumeKey)
+	if err != nil || protectorID == "" {
+		return err
+	}
+	policyID, err := getVolumePolicyID(protectorID)
+	if err != nil {
+		return err
+	}
+	args := []string{"metadata", "destroy", "--protector=" + MountPoint + ":" + protectorID,
+		"--quiet", "--force"}
+	if stdOut, stdErr, err := execCmd(FscryptPath, args...); err != nil {
+		return fmt.Errorf("fscrypt destroy of protector %s failed: %v: %s %s",
+			protectorID, err, stdOut, stdErr)
+	}
+	if policyID != "" {
+		args := []string{"metadata", "destroy", "--policy=" + MountPoint + ":" + policyID,
+			"--quiet", "--force"}
+		if stdOut, stdErr, err := execCmd(FscryptPath, args...); err != nil {
+			return fmt.Errorf("fscrypt destroy of policy %s failed: %v: %s %s",
+				policyID, err, stdOut, stdErr)
+		}
+	}
+	return nil
+}
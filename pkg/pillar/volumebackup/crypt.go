@@ -0,0 +1,268 @@
+// Copyright (c) 2022 Zededa, Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package volumebackup
+
+import (
+	"bytes"
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/binary"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+)
+
+// The encrypted stream is a header followed by chunks. Every chunk is
+// sealed with AES-256-GCM on its own, using the nonce prefix from the header
+// and the number of the chunk as nonce, hence chunks cannot be reordered.
+// The last chunk is marked in its additional data so a truncated stream
+// is detected.
+//
+//	header: magic | nonce prefix (8 bytes)
+//	chunk:  plaintext length (4 bytes, big endian) | sealed plaintext
+const (
+	magic           = "EVEVBK1\n"
+	noncePrefixSize = 8
+	chunkSize       = 1024 * 1024
+	// KeySize is the size of the key for Encrypt and Decrypt
+	KeySize = 32
+)
+
+var (
+	// ErrTruncated is returned if the stream ends before its last chunk
+	ErrTruncated = errors.New("encrypted backup is truncated")
+)
+
+func newAEAD(key []byte) (cipher.AEAD, error) {
+	if len(key) != KeySize {
+		return nil, fmt.Errorf("key size %d, expected %d", len(key), KeySize)
+	}
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return nil, err
+	}
+	return cipher.NewGCM(block)
+}
+
+func chunkNonce(prefix []byte, counter uint32) []byte {
+	nonce := make([]byte, noncePrefixSize+4)
+	copy(nonce, prefix)
+	binary.BigEndian.PutUint32(nonce[noncePrefixSize:], counter)
+	return nonce
+}
+
+func chunkAD(last bool) []byte {
+	if last {
+		return []byte{1}
+	}
+	return []byte{0}
+}
+
+// Encrypt reads src until EOF and writes it encrypted with key to dst
+func Encrypt(key []byte, dst io.Writer, src io.Reader) error {
+	aead, err := newAEAD(key)
+	if err != nil {
+		return err
+	}
+	prefix := make([]byte, noncePrefixSize)
+	if _, err := rand.Read(prefix); err != nil {
+		return err
+	}
+	if _, err := dst.Write(append([]byte(magic), prefix...)); err != nil {
+		return err
+	}
+	// read one chunk ahead to know which chunk is the last one
+	cur := make([]byte, chunkSize)
+	next := make([]byte, chunkSize)
+	n, err := io.ReadFull(src, cur)
+	last := err == io.EOF || err == io.ErrUnexpectedEOF
+	if err != nil && !last {
+		return err
+	}
+	sealed := make([]byte, 0, 4+chunkSize+aead.Overhead())
+	for counter := uint32(0); ; counter++ {
+		var m int
+		if !last {
+			m, err = io.ReadFull(src, next)
+			if err == io.EOF {
+				// cur is the last chunk after all
+				last = true
+			} else if err != nil && err != io.ErrUnexpectedEOF {
+				return err
+			}
+		}
+		sealed = sealed[:4]
+		binary.BigEndian.PutUint32(sealed, uint32(n))
+		sealed = aead.Seal(sealed, chunkNonce(prefix, counter), cur[:n], chunkAD(last))
+		if _, err := dst.Write(sealed); err != nil {
+			return err
+		}
+		if last {
+			return nil
+		}
+		if counter == ^uint32(0) {
+			return errors.New("too many chunks to encrypt")
+		}
+		cur, next = next, cur
+		n = m
+		last = m < chunkSize
+	}
+}
+
+// Decrypt reads the encrypted src and writes it decrypted with key to dst.
+// Nothing written to dst can be trusted unless Decrypt returns nil.
+func Decrypt(key []byte, dst io.Writer, src io.Reader) error {
+	aead, err := newAEAD(key)
+	if err != nil {
+		return err
+	}
+	header := make([]byte, len(magic)+noncePrefixSize)
+	if _, err := io.ReadFull(src, header); err != nil {
+		return fmt.Errorf("cannot read header: %v", err)
+	}
+	if !bytes.Equal(header[:len(magic)], []byte(magic)) {
+		return errors.New("not an encrypted backup")
+	}
+	prefix := header[len(magic):]
+	lenBuf := make([]byte, 4)
+	buf := make([]byte, chunkSize+aead.Overhead())
+	// not in place, a failed Open clears its output
+	plainBuf := make([]byte, chunkSize)
+	for counter := uint32(0); ; counter++ {
+		if _, err := io.ReadFull(src, lenBuf); err != nil {
+			if err == io.EOF {
+				return ErrTruncated
+			}
+			return err
+		}
+		n := binary.BigEndian.Uint32(lenBuf)
+		if n > chunkSize {
+			return fmt.Errorf("chunk %d is too large: %d", counter, n)
+		}
+		sealed := buf[:int(n)+aead.Overhead()]
+		if _, err := io.ReadFull(src, sealed); err != nil {
+			if err == io.EOF || err == io.ErrUnexpectedEOF {
+				return ErrTruncated
+			}
+			return err
+		}
+		nonce := chunkNonce(prefix, counter)
+		last := true
+		plain, err := aead.Open(plainBuf[:0], nonce, sealed, chunkAD(last))
+		if err != nil {
+			last = false
+			plain, err = aead.Open(plainBuf[:0], nonce, sealed, chunkAD(last))
+		}
+		if err != nil {
+			return fmt.Errorf("chunk %d cannot be decrypted: %v", counter, err)
+		}
+		if _, err := dst.Write(plain); err != nil {
+			return err
+		}
+		if last {
+			if _, err := io.ReadFull(src, lenBuf[:1]); err != io.EOF {
+				return errors.New("data after the last chunk")
+			}
+			return nil
+		}
+	}
+}
+
+// EncryptToFile encrypts src into the file dst.
+// Returns the sha256 and the size of dst.
+func EncryptToFile(key []byte, dst string, src io.Reader) (string, uint64, error) {
+	f, err := os.Create(dst)
+	if err != nil {
+		return "", 0, err
+	}
+	defer f.Close()
+	h := sha256.New()
+	cw := &countingWriter{w: io.MultiWriter(f, h)}
+	if err := Encrypt(key, cw, src); err != nil {
+		return "", 0, err
+	}
+	if err := f.Sync(); err != nil {
+		return "", 0, err
+	}
+	return hex.EncodeToString(h.Sum(nil)), cw.n, nil
+}
+
+// EncryptFile encrypts the file src into the file dst.
+// Returns the sha256 and the size of dst.
+func EncryptFile(key []byte, dst, src string) (string, uint64, error) {
+	f, err := os.Open(src)
+	if err != nil {
+		return "", 0, err
+	}
+	defer f.Close()
+	return EncryptToFile(key, dst, f)
+}
+
+// DecryptFromFile checks the sha256 of the encrypted file src and
+// writes it decrypted to dst
+func DecryptFromFile(key []byte, dst io.Writer, src, sha string) error {
+	if err := CheckSha256(src, sha); err != nil {
+		return err
+	}
+	f, err := os.Open(src)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+	return Decrypt(key, dst, f)
+}
+
+// DecryptFile checks the sha256 of the encrypted file src and decrypts it
+// into the file dst. dst is removed on failure.
+func DecryptFile(key []byte, dst, src, sha string) error {
+	f, err := os.Create(dst)
+	if err != nil {
+		return err
+	}
+	err = DecryptFromFile(key, f, src, sha)
+	if err == nil {
+		err = f.Sync()
+	}
+	if cerr := f.Close(); err == nil {
+		err = cerr
+	}
+	if err != nil {
+		os.Remove(dst)
+	}
+	return err
+}
+
+// CheckSha256 returns an error if the sha256 of the file differs from sha
+func CheckSha256(filename, sha string) error {
+	f, err := os.Open(filename)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+	h := sha256.New()
+	if _, err := io.Copy(h, f); err != nil {
+		return err
+	}
+	got := hex.EncodeToString(h.Sum(nil))
+	if !strings.EqualFold(got, sha) {
+		return fmt.Errorf("sha256 of %s is %s, expected %s", filename, got, sha)
+	}
+	return nil
+}
+
+type countingWriter struct {
+	w io.Writer
+	n uint64
+}
+
+func (cw *countingWriter) Write(p []byte) (int, error) {
+	n, err := cw.w.Write(p)
+	cw.n += uint64(n)
+	return n, err
+}
@@ -0,0 +1,152 @@
+// Copyright (c) 2022 Zededa, Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+// Package volumebackup has the format of the volume backups which
+// volumemgr uploads to datastores. A backup is a chain of encrypted parts,
+// a full one followed by incremental ones, and an encrypted manifest which
+// lists the chain. Restoring a backup needs the manifest and all its parts.
+package volumebackup
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+)
+
+const (
+	// FormatZFS parts are zfs send streams of a zvol
+	FormatZFS = "zfs"
+	// FormatQcow2 parts are qcow2 images, never incremental
+	FormatQcow2 = "qcow2"
+
+	// PartSuffix is the suffix of the object name of a part
+	PartSuffix = ".part"
+	// ManifestSuffix is the suffix of the object name of a manifest
+	ManifestSuffix = ".manifest"
+
+	manifestVersion = 1
+	timeFormat      = "20060102T150405Z"
+	snapshotPrefix  = "backup-"
+)
+
+// Part is one backup in a chain
+type Part struct {
+	Name        string // Object name in the datastore
+	Sha256      string // Of the encrypted part
+	Size        uint64 // Of the encrypted part
+	Incremental bool   // Changes since the previous part
+	Snapshot    string // zfs snapshot the part was sent from
+}
+
+// Manifest describes a backup of a volume
+type Manifest struct {
+	Version    int
+	VolumeID   string
+	Format     string
+	MaxVolSize uint64
+	Time       time.Time
+	Parts      []Part // The full part first, then the incremental ones in order
+}
+
+// BaseName returns the object name of a backup of the volume taken at t
+// without a suffix
+func BaseName(volumeID string, t time.Time) string {
+	return fmt.Sprintf("%s/%s", volumeID, t.UTC().Format(timeFormat))
+}
+
+// SnapshotName returns the name of the zfs snapshot of a backup taken at t
+func SnapshotName(t time.Time) string {
+	return snapshotPrefix + t.UTC().Format(timeFormat)
+}
+
+// IsSnapshotName returns true if the zfs snapshot was taken for a backup
+func IsSnapshotName(name string) bool {
+	return len(name) > len(snapshotPrefix) && name[:len(snapshotPrefix)] == snapshotPrefix
+}
+
+// NewManifest returns the manifest of a backup which consists of
+// the part appended to the chain of the previous backup. An incremental
+// part needs a previous zfs backup.
+func NewManifest(prev *Manifest, volumeID, format string, maxVolSize uint64,
+	t time.Time, part Part) (Manifest, error) {
+
+	m := Manifest{
+		Version:    manifestVersion,
+		VolumeID:   volumeID,
+		Format:     format,
+		MaxVolSize: maxVolSize,
+		Time:       t,
+	}
+	if part.Incremental {
+		if prev == nil || prev.Format != format || len(prev.Parts) == 0 {
+			return m, errors.New("incremental backup without a previous backup")
+		}
+		m.Parts = append(m.Parts, prev.Parts...)
+	}
+	m.Parts = append(m.Parts, part)
+	return m, m.Validate()
+}
+
+// Validate returns an error if the manifest cannot be restored
+func (m Manifest) Validate() error {
+	if m.Version != manifestVersion {
+		return fmt.Errorf("unsupported manifest version %d", m.Version)
+	}
+	if m.Format != FormatZFS && m.Format != FormatQcow2 {
+		return fmt.Errorf("unsupported backup format %q", m.Format)
+	}
+	if len(m.Parts) == 0 {
+		return errors.New("backup without parts")
+	}
+	for i, part := range m.Parts {
+		if part.Name == "" || part.Sha256 == "" {
+			return fmt.Errorf("part %d has no name or sha256", i)
+		}
+		if part.Incremental != (i != 0) {
+			return fmt.Errorf("part %d: only the first part is a full backup", i)
+		}
+		if part.Incremental && m.Format != FormatZFS {
+			return fmt.Errorf("part %d: %s backups cannot be incremental", i, m.Format)
+		}
+	}
+	return nil
+}
+
+// Last returns the part of the backup the manifest was written for
+func (m Manifest) Last() Part {
+	return m.Parts[len(m.Parts)-1]
+}
+
+// Incrementals returns the number of incremental parts in the chain
+func (m Manifest) Incrementals() int {
+	return len(m.Parts) - 1
+}
+
+// WriteManifest writes the manifest encrypted with key to filename.
+// Returns the sha256 and the size of the file.
+func WriteManifest(key []byte, filename string, m Manifest) (string, uint64, error) {
+	if err := m.Validate(); err != nil {
+		return "", 0, err
+	}
+	b, err := json.Marshal(m)
+	if err != nil {
+		return "", 0, err
+	}
+	return EncryptToFile(key, filename, bytes.NewReader(b))
+}
+
+// ReadManifest reads the manifest encrypted with key from filename
+// and checks it has the sha256
+func ReadManifest(key []byte, filename, sha string) (Manifest, error) {
+	var m Manifest
+	var b bytes.Buffer
+	if err := DecryptFromFile(key, &b, filename, sha); err != nil {
+		return m, err
+	}
+	if err := json.Unmarshal(b.Bytes(), &m); err != nil {
+		return m, err
+	}
+	return m, m.Validate()
+}
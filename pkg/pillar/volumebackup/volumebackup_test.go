@@ -0,0 +1,104 @@
+// Copyright (c) 2022 Zededa, Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package volumebackup
+
+import (
+	"bytes"
+	"crypto/rand"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func testKey(t *testing.T) []byte {
+	key := make([]byte, KeySize)
+	_, err := rand.Read(key)
+	assert.Nil(t, err)
+	return key
+}
+
+func encrypt(t *testing.T, key, plain []byte) []byte {
+	var enc bytes.Buffer
+	assert.Nil(t, Encrypt(key, &enc, bytes.NewReader(plain)))
+	return enc.Bytes()
+}
+
+func TestEncryptDecrypt(t *testing.T) {
+	key := testKey(t)
+	sizes := []int{0, 1, chunkSize - 1, chunkSize, chunkSize + 1, 3 * chunkSize}
+	for _, size := range sizes {
+		plain := make([]byte, size)
+		_, err := rand.Read(plain)
+		assert.Nil(t, err)
+		enc := encrypt(t, key, plain)
+
+		var dec bytes.Buffer
+		assert.Nil(t, Decrypt(key, &dec, bytes.NewReader(enc)), "size %d", size)
+		assert.Equal(t, size, dec.Len(), "size %d", size)
+		assert.True(t, bytes.Equal(plain, dec.Bytes()), "size %d", size)
+	}
+}
+
+func TestDecryptTampered(t *testing.T) {
+	key := testKey(t)
+	plain := make([]byte, 2*chunkSize+10)
+	enc := encrypt(t, key, plain)
+
+	var dec bytes.Buffer
+	assert.NotNil(t, Decrypt(testKey(t), &dec, bytes.NewReader(enc)),
+		"wrong key")
+
+	flipped := append([]byte{}, enc...)
+	flipped[len(flipped)/2] ^= 1
+	assert.NotNil(t, Decrypt(key, &dec, bytes.NewReader(flipped)),
+		"flipped bit")
+
+	// cut after the first chunk
+	firstChunk := len(magic) + noncePrefixSize + 4 + chunkSize + 16
+	assert.Equal(t, ErrTruncated,
+		Decrypt(key, &dec, bytes.NewReader(enc[:firstChunk])))
+
+	extra := append(append([]byte{}, enc...), 0)
+	assert.NotNil(t, Decrypt(key, &dec, bytes.NewReader(extra)),
+		"data after the last chunk")
+}
+
+func TestManifest(t *testing.T) {
+	key := testKey(t)
+	now := time.Now()
+	full := Part{Name: "v/1.part", Sha256: "aa", Size: 10}
+	_, err := NewManifest(nil, "v", FormatZFS, 100, now,
+		Part{Name: "v/2.part", Sha256: "bb", Incremental: true})
+	assert.NotNil(t, err, "incremental without a previous backup")
+
+	m1, err := NewManifest(nil, "v", FormatZFS, 100, now, full)
+	assert.Nil(t, err)
+	m2, err := NewManifest(&m1, "v", FormatZFS, 100, now,
+		Part{Name: "v/2.part", Sha256: "bb", Incremental: true})
+	assert.Nil(t, err)
+	assert.Equal(t, 1, m2.Incrementals())
+	assert.Equal(t, "v/2.part", m2.Last().Name)
+
+	_, err = NewManifest(&m1, "v", FormatQcow2, 100, now,
+		Part{Name: "v/2.part", Sha256: "bb", Incremental: true})
+	assert.NotNil(t, err, "qcow2 cannot be incremental")
+
+	filename := filepath.Join(t.TempDir(), "manifest")
+	sha, _, err := WriteManifest(key, filename, m2)
+	assert.Nil(t, err)
+	read, err := ReadManifest(key, filename, sha)
+	assert.Nil(t, err)
+	assert.Equal(t, m2.Parts, read.Parts)
+	_, err = ReadManifest(key, filename, "00")
+	assert.NotNil(t, err, "wrong sha256")
+}
+
+func TestSnapshotName(t *testing.T) {
+	name := SnapshotName(time.Date(2022, 3, 4, 5, 6, 7, 0, time.UTC))
+	assert.Equal(t, "backup-20220304T050607Z", name)
+	assert.True(t, IsSnapshotName(name))
+	assert.False(t, IsSnapshotName("daily"))
+}
@@ -0,0 +1,121 @@
+// Copyright (c) 2022 Zededa, Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package zboot
+
+import (
+	"bufio"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+
+	"github.com/lf-edge/eve/pkg/pillar/base"
+	"github.com/lf-edge/eve/pkg/pillar/bspatch"
+	"github.com/lf-edge/eve/pkg/pillar/types"
+)
+
+// deltaFilename is where the patch is kept while it is applied since the
+// patch has to be read at random
+const deltaFilename = types.PersistDir + "/baseos-delta.bsdiff"
+
+// WriteDeltaToPartition applies the delta image to the image of the
+// current partition and writes the result to partition partName.
+// Returns an error if the current image is not the one the delta applies
+// to, or if the result is not the expected image; then the content of
+// partName is undefined.
+func WriteDeltaToPartition(log *base.LogObject, image string, partName string,
+	delta types.BaseOsDelta) error {
+
+	if !IsOtherPartition(partName) {
+		errStr := fmt.Sprintf("not other partition %s", partName)
+		log.Errorf("WriteDeltaToPartition failed %s\n", errStr)
+		return errors.New(errStr)
+	}
+	devName := GetPartitionDevname(partName)
+	if devName == "" {
+		errStr := fmt.Sprintf("null devname for partition %s", partName)
+		log.Errorf("WriteDeltaToPartition failed %s\n", errStr)
+		return errors.New(errStr)
+	}
+	baseDevName := GetCurrentPartitionDevName()
+	if baseDevName == "" {
+		errStr := "null devname for current partition"
+		log.Errorf("WriteDeltaToPartition failed %s\n", errStr)
+		return errors.New(errStr)
+	}
+
+	log.Functionf("WriteDeltaToPartition %s, %s from %s: %v\n",
+		partName, devName, baseDevName, image)
+
+	baseImage, err := os.Open(baseDevName)
+	if err != nil {
+		return fmt.Errorf("error opening current partition device at %s: %v",
+			baseDevName, err)
+	}
+	defer baseImage.Close()
+	baseSize := int64(delta.BaseSize)
+	err = checkSha256(io.NewSectionReader(baseImage, 0, baseSize), baseSize,
+		delta.BaseSha256)
+	if err != nil {
+		return fmt.Errorf("delta does not apply to the current image: %v", err)
+	}
+
+	patch, err := os.OpenFile(deltaFilename, os.O_RDWR|os.O_CREATE|os.O_TRUNC, 0600)
+	if err != nil {
+		return fmt.Errorf("error creating %s: %v", deltaFilename, err)
+	}
+	defer os.Remove(deltaFilename)
+	defer patch.Close()
+	if err := pullImage(log, image, patch); err != nil {
+		return err
+	}
+	patchSize, err := patch.Seek(0, io.SeekEnd)
+	if err != nil {
+		return fmt.Errorf("error getting size of %s: %v", deltaFilename, err)
+	}
+
+	f, err := openPartitionForWrite(log, devName)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+	h := sha256.New()
+	w := bufio.NewWriterSize(io.MultiWriter(f, h), 1024*1024)
+	size, err := bspatch.Patch(baseImage, baseSize, patch, patchSize, w)
+	if err != nil {
+		return fmt.Errorf("error applying delta: %v", err)
+	}
+	if err := w.Flush(); err != nil {
+		return fmt.Errorf("error writing to partition device at %s: %v",
+			devName, err)
+	}
+	sum := hex.EncodeToString(h.Sum(nil))
+	if sum != strings.ToLower(delta.ImageSha256) {
+		return fmt.Errorf("patched image of %d bytes has sha256 %s, expected %s",
+			size, sum, delta.ImageSha256)
+	}
+	log.Noticef("WriteDeltaToPartition %s: wrote %d bytes with sha256 %s",
+		partName, size, sum)
+	return nil
+}
+
+// checkSha256 checks that r has size bytes with the sha256
+func checkSha256(r io.Reader, size int64, expected string) error {
+	h := sha256.New()
+	n, err := io.Copy(h, r)
+	if err != nil {
+		return err
+	}
+	if n != size {
+		return fmt.Errorf("image has %d bytes, expected %d", n, size)
+	}
+	sum := hex.EncodeToString(h.Sum(nil))
+	if sum != strings.ToLower(expected) {
+		return fmt.Errorf("image has sha256 %s, expected %s", sum, expected)
+	}
+	return nil
+}
@@ -353,6 +353,50 @@ func ecdsakeyBytes(pubKey *ecdsa.PublicKey) (int, error) {
 	return keyBytes, nil
 }
 
+// VerifyAuthContainer - verify an AuthContainer which did not come over
+// the network, e.g., from an offline bundle, using the given signing
+// certificate in PEM format. Returns the payload.
+func VerifyAuthContainer(ctx *ZedCloudContext, content []byte, signingCert []byte) ([]byte, error) {
+	sm := &zauth.AuthContainer{}
+	if err := proto.Unmarshal(content, sm); err != nil {
+		return nil, fmt.Errorf("VerifyAuthContainer: unmarshal error, %v", err)
+	}
+	block, _ := pem.Decode(signingCert)
+	if block == nil {
+		return nil, errors.New("VerifyAuthContainer: signing certificate decode fail")
+	}
+	cert, err := x509.ParseCertificate(block.Bytes)
+	if err != nil {
+		return nil, fmt.Errorf("VerifyAuthContainer: signing certificate parse fail, %v", err)
+	}
+	certHash := ComputeSha(signingCert)
+	switch sm.Algo {
+	case zcommon.HashAlgorithm_HASH_ALGORITHM_SHA256_32BYTES:
+	case zcommon.HashAlgorithm_HASH_ALGORITHM_SHA256_16BYTES:
+		certHash = certHash[:hashSha256Len16]
+	default:
+		return nil, errors.New("VerifyAuthContainer: hash algorithm is not supported")
+	}
+	if !bytes.Equal(sm.GetSenderCertHash(), certHash) {
+		return nil, errors.New("VerifyAuthContainer: signing certificate hash does not match")
+	}
+	data := sm.ProtectedPayload.GetPayload()
+	if err := verifyAuthSig(ctx, sm.GetSignatureHash(), cert, ComputeSha(data)); err != nil {
+		return nil, fmt.Errorf("VerifyAuthContainer: signature verification fail, %v", err)
+	}
+	return data, nil
+}
+
+// SignAuthContainer - wrap the payload in an AuthContainer signed with the
+// device certificate, as done for the messages we send to the controller
+func SignAuthContainer(ctx *ZedCloudContext, payload []byte) ([]byte, error) {
+	buf, err := addAuthentication(ctx, bytes.NewBuffer(payload), false)
+	if err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
 // ComputeSha - Compute sha256 on data
 func ComputeSha(data []byte) []byte {
 	h := sha256.New()
@@ -0,0 +1,185 @@
+// Copyright (c) 2022 Zededa, Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+// Support for sending several deferred items in one request
+
+package zedcloud
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/golang/protobuf/proto"
+	"github.com/lf-edge/eve/api/go/batch"
+	"github.com/lf-edge/eve/pkg/pillar/base"
+	"github.com/lf-edge/eve/pkg/pillar/types"
+)
+
+const (
+	batchURLMarker = "/api/v2/edgedevice/id/"
+	batchAction    = "batch"
+)
+
+// splitBatchURL splits a V2 API URL of the device into the prefix
+// ending with the device UUID and the path of the action.
+// Returns false for URLs which can not be sent as part of a batch.
+func splitBatchURL(url string) (prefix string, path string, ok bool) {
+	idx := strings.Index(url, batchURLMarker)
+	if idx < 0 {
+		return "", "", false
+	}
+	idx += len(batchURLMarker)
+	slash := strings.Index(url[idx:], "/")
+	if slash <= 0 {
+		return "", "", false
+	}
+	prefix = url[:idx+slash+1]
+	path = url[len(prefix):]
+	if path == "" || path == batchAction {
+		return "", "", false
+	}
+	return prefix, path, true
+}
+
+// takeBatch returns the items which can be sent together with the first one
+// in a single batch request, and the remaining items.
+// Returns a nil batch if batching is not possible.
+func (ctx *DeferredContext) takeBatch(pending []*deferredItem) ([]*deferredItem, []*deferredItem) {
+	maxItems := ctx.zedcloudCtx.batchMaxItems()
+	if maxItems < 2 || len(pending) < 2 {
+		return nil, pending
+	}
+	prefix, _, ok := splitBatchURL(pending[0].url)
+	if !ok {
+		return nil, pending
+	}
+	var items, rest []*deferredItem
+	for _, item := range pending {
+		itemPrefix, _, ok := splitBatchURL(item.url)
+		if ok && itemPrefix == prefix && len(items) < maxItems {
+			items = append(items, item)
+		} else {
+			rest = append(rest, item)
+		}
+	}
+	if len(items) < 2 {
+		return nil, pending
+	}
+	return items, rest
+}
+
+// sendBatch sends the items in one request to the batch endpoint and applies
+// the per-item results. If the batch request itself fails the items are sent
+// one by one, and if the controller rejects it we stop batching.
+// Returns the number of items sent and whether we should give up for now.
+func (ctx *DeferredContext) sendBatch(log *base.LogObject, ctxWork context.Context,
+	items []*deferredItem) (int, bool) {
+
+	prefix, _, _ := splitBatchURL(items[0].url)
+	req := &batch.BatchRequest{}
+	for _, item := range items {
+		_, path, _ := splitBatchURL(item.url)
+		req.Items = append(req.Items, &batch.BatchItem{
+			Path: path,
+			Body: item.buf.Bytes(),
+		})
+	}
+	buf, err := proto.Marshal(req)
+	if err != nil {
+		log.Errorf("sendBatch: marshal failed: %v", err)
+		return 0, true
+	}
+	log.Functionf("sendBatch: Trying to send %d items to %s", len(items), prefix)
+
+	resp, contents, result, err := SendOnAllIntf(ctxWork, ctx.zedcloudCtx,
+		prefix+batchAction, int64(len(buf)), bytes.NewBuffer(buf),
+		ctx.iteration, true)
+	var batchResp batch.BatchResponse
+	if err == nil && result == types.SenderStatusNone {
+		err = proto.Unmarshal(contents, &batchResp)
+		if err == nil && len(batchResp.Results) != len(items) {
+			err = fmt.Errorf("got %d results for %d items",
+				len(batchResp.Results), len(items))
+		}
+		if err != nil {
+			log.Errorf("sendBatch: bad response: %v", err)
+			ctx.zedcloudCtx.disableBatching()
+		}
+	}
+	if err != nil || result != types.SenderStatusNone {
+		if resp != nil && resp.StatusCode >= 400 && resp.StatusCode < 600 {
+			log.Warnf("sendBatch: batch request rejected with code %d, disabling batching",
+				resp.StatusCode)
+			ctx.zedcloudCtx.disableBatching()
+		}
+		// The status is the one of the batch request, not of the items,
+		// e.g. 404 from a controller without the batch endpoint, hence
+		// the handlers only get the status of their own item
+		log.Functionf("sendBatch: failed %v status %d, sending the items one by one",
+			err, result)
+		sent := 0
+		for _, item := range items {
+			if !ctx.sendItem(log, ctxWork, item) {
+				return sent, true
+			}
+			sent++
+		}
+		return sent, false
+	}
+
+	sent := 0
+	exit := false
+	for i, item := range items {
+		code := int(batchResp.Results[i].StatusCode)
+		accepted := code >= 200 && code < 300
+		result := senderStatusFromCode(code)
+		done := true
+		switch {
+		case accepted:
+		case item.bailOnHTTPErr && code >= 400 && code < 600:
+			log.Functionf("sendBatch: for %s ignore code %d",
+				item.key, code)
+		default:
+			log.Functionf("sendBatch: for %s failed with code %d",
+				item.key, code)
+			done = false
+			exit = true
+		}
+		// The handler takes SenderStatusNone as sent, hence only tell it
+		// about the rejected items with a status of their own
+		if ctx.sentHandler != nil && (accepted || result != types.SenderStatusNone) {
+			f := *ctx.sentHandler
+			f(item.itemType, item.buf, result)
+		}
+		if done {
+			item.buf = nil
+			sent++
+		}
+	}
+	return sent, exit
+}
+
+// GetDeferredBatchItems returns a copy of the queued deferred items as batch
+// items, e.g., to export them when there is no connectivity to the
+// controller. Items which can not be part of a batch are skipped.
+func GetDeferredBatchItems(zedcloudCtx *ZedCloudContext) []*batch.BatchItem {
+	ctx := &zedcloudCtx.deferredCtx
+	ctx.lock.Lock()
+	defer ctx.lock.Unlock()
+	var items []*batch.BatchItem
+	for _, item := range ctx.deferredItems {
+		if item.buf == nil {
+			continue
+		}
+		_, path, ok := splitBatchURL(item.url)
+		if !ok {
+			continue
+		}
+		body := make([]byte, item.buf.Len())
+		copy(body, item.buf.Bytes())
+		items = append(items, &batch.BatchItem{Path: path, Body: body})
+	}
+	return items
+}
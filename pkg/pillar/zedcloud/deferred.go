@@ -7,6 +7,7 @@ package zedcloud
 
 import (
 	"bytes"
+	"context"
 	"github.com/lf-edge/eve/pkg/pillar/types"
 	"sync"
 	"time"
@@ -33,6 +34,8 @@ type deferredItem struct {
 	size          int64
 	url           string
 	bailOnHTTPErr bool // Return 4xx and 5xx without trying other interfaces
+	created       time.Time
+	notJournaled  bool // Did not fit in the journal
 }
 
 const maxTimeToHandleDeferred = time.Minute
@@ -48,6 +51,7 @@ type DeferredContext struct {
 	sentHandler            *SentHandlerFunction
 	zedcloudCtx            *ZedCloudContext
 	iteration              int
+	journal                *deferredJournal // nil unless enabled
 }
 
 //TypePriorityCheckFunction returns true in case of find type with high priority
@@ -104,8 +108,8 @@ func (ctx *DeferredContext) handleDeferred(log *base.LogObject, event time.Time,
 	ctxWork, cancel := GetContextForAllIntfFunctions(ctx.zedcloudCtx)
 	defer cancel()
 	for _, f := range ctx.priorityCheckFunctions {
+		var pending []*deferredItem
 		for _, item := range ctx.deferredItems {
-			key := item.key
 			//check with current priority function
 			if !f(item.itemType) {
 				continue
@@ -113,32 +117,32 @@ func (ctx *DeferredContext) handleDeferred(log *base.LogObject, event time.Time,
 			if item.buf == nil {
 				continue
 			}
-			log.Functionf("handleDeferred: Trying to send for %s", key)
 			if item.buf.Len() == 0 {
 				log.Functionf("handleDeferred: Zero length deferred item for %s",
-					key)
+					item.key)
 				continue
 			}
-
-			//SenderStatusNone indicates no problems
-			resp, _, result, err := SendOnAllIntf(ctxWork, ctx.zedcloudCtx, item.url,
-				item.size, item.buf, ctx.iteration, item.bailOnHTTPErr)
-			if item.bailOnHTTPErr && resp != nil &&
-				resp.StatusCode >= 400 && resp.StatusCode < 600 {
-				log.Functionf("handleDeferred: for %s ignore code %d",
-					key, resp.StatusCode)
-			} else if err != nil {
-				log.Functionf("handleDeferred: for %s failed %s",
-					key, err)
-				exit = true
-			} else if result != types.SenderStatusNone {
-				log.Functionf("handleDeferred: for %s received unexpected status %d",
-					key, result)
-				exit = true
+			pending = append(pending, item)
+		}
+		for len(pending) != 0 {
+			// Send several items in one request if the controller
+			// supports it, unless we are asked to send just one
+			var items []*deferredItem
+			if !sendOne {
+				items, pending = ctx.takeBatch(pending)
 			}
-			if ctx.sentHandler != nil {
-				f := *ctx.sentHandler
-				f(item.itemType, item.buf, result)
+			if items != nil {
+				var batchSent int
+				batchSent, exit = ctx.sendBatch(log, ctxWork, items)
+				sent += batchSent
+			} else {
+				item := pending[0]
+				pending = pending[1:]
+				if ctx.sendItem(log, ctxWork, item) {
+					sent++
+				} else {
+					exit = true
+				}
 			}
 
 			//try with another interface next time
@@ -147,8 +151,6 @@ func (ctx *DeferredContext) handleDeferred(log *base.LogObject, event time.Time,
 			if exit {
 				break
 			}
-			item.buf = nil
-			sent++
 
 			if sendOne {
 				exit = true
@@ -184,6 +186,9 @@ func (ctx *DeferredContext) handleDeferred(log *base.LogObject, event time.Time,
 		}
 		ctx.deferredItems = newDeferredItems
 	}
+	if ctx.journal != nil {
+		ctx.journal.flush(ctx.deferredItems)
+	}
 
 	if len(ctx.deferredItems) == 0 {
 		stopTimer(log, ctx)
@@ -192,6 +197,40 @@ func (ctx *DeferredContext) handleDeferred(log *base.LogObject, event time.Time,
 	return len(ctx.deferredItems) == 0
 }
 
+// sendItem sends a single deferred item.
+// Returns false if we should give up for now.
+func (ctx *DeferredContext) sendItem(log *base.LogObject, ctxWork context.Context,
+	item *deferredItem) bool {
+
+	key := item.key
+	log.Functionf("handleDeferred: Trying to send for %s", key)
+	ok := true
+	//SenderStatusNone indicates no problems
+	resp, _, result, err := SendOnAllIntf(ctxWork, ctx.zedcloudCtx, item.url,
+		item.size, item.buf, ctx.iteration, item.bailOnHTTPErr)
+	if item.bailOnHTTPErr && resp != nil &&
+		resp.StatusCode >= 400 && resp.StatusCode < 600 {
+		log.Functionf("handleDeferred: for %s ignore code %d",
+			key, resp.StatusCode)
+	} else if err != nil {
+		log.Functionf("handleDeferred: for %s failed %s",
+			key, err)
+		ok = false
+	} else if result != types.SenderStatusNone {
+		log.Functionf("handleDeferred: for %s received unexpected status %d",
+			key, result)
+		ok = false
+	}
+	if ctx.sentHandler != nil {
+		f := *ctx.sentHandler
+		f(item.itemType, item.buf, result)
+	}
+	if ok {
+		item.buf = nil
+	}
+	return ok
+}
+
 // Replace any item for the specified key. If timer not running start it
 // SetDeferred uses the key for identifying the channel. Please note that
 // for deviceUUID key is used for attestUrl, which is not the same for
@@ -221,6 +260,7 @@ func (ctx *DeferredContext) setDeferred(zedcloudCtx *ZedCloudContext,
 		size:          size,
 		url:           url,
 		bailOnHTTPErr: bailOnHTTPErr,
+		created:       time.Now(),
 	}
 	found := false
 	ind := 0
@@ -238,6 +278,8 @@ func (ctx *DeferredContext) setDeferred(zedcloudCtx *ZedCloudContext,
 		log.Tracef("Adding key %s", key)
 		ctx.deferredItems = append(ctx.deferredItems, &item)
 	}
+	// Persist it soon, since a power loss may come before we get to send it
+	ctx.scheduleJournalFlush()
 }
 
 // RemoveDeferred removes key from deferred items if exists
@@ -257,6 +299,7 @@ func (ctx *DeferredContext) removeDeferred(zedcloudCtx *ZedCloudContext, key str
 		if itemList.key == key {
 			log.Tracef("Deleting key %s", key)
 			ctx.deferredItems = append(ctx.deferredItems[:ind], ctx.deferredItems[ind+1:]...)
+			ctx.scheduleJournalFlush()
 			break
 		}
 	}
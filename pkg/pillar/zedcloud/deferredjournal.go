@@ -0,0 +1,461 @@
+// Copyright (c) 2022 Zededa, Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+// Optional persistent backing for the deferred items so that messages which
+// could not be sent survive a reboot or a power loss.
+//
+// The journal is an append-only file of records, each one being a 4 byte
+// length and a 4 byte CRC32C of the payload followed by the JSON payload.
+// A record either sets the item for a key or removes the key.
+// Records are written shortly after items are queued, replaced or removed,
+// once for all the changes in that time, and when items are sent, so that
+// an item survives a power loss soon after it is queued without a write of
+// the journal for every item.
+// A torn or corrupted record at the end of the journal, as left behind by
+// a power loss, is ignored and the journal is compacted into a new file
+// when it is loaded and when it grows well beyond the size of its content.
+
+package zedcloud
+
+import (
+	"bytes"
+	"encoding/binary"
+	"encoding/json"
+	"fmt"
+	"hash/crc32"
+	"io/ioutil"
+	"os"
+	"sort"
+	"time"
+
+	"github.com/lf-edge/eve/pkg/pillar/base"
+	fileutils "github.com/lf-edge/eve/pkg/pillar/utils/file"
+)
+
+const (
+	journalOpSet    = "set"
+	journalOpRemove = "remove"
+
+	journalHeaderLen = 8
+	// Delay of the write of the items queued in the meantime
+	journalFlushDelay = 5 * time.Second
+	// Compact when the file is larger than twice its content plus this
+	journalCompactSlack = 64 * 1024
+)
+
+var journalCRCTable = crc32.MakeTable(crc32.Castagnoli)
+
+// DeferredJournalOptions - options to be passed at EnableDeferredJournal
+type DeferredJournalOptions struct {
+	// Path of the journal file
+	Path string
+	// Maximum size of the items kept in the journal; the oldest items
+	// are only kept in memory when exceeded. Zero means no items.
+	MaxSize int64
+	// Items older than this are not kept in the journal
+	MaxAge time.Duration
+	// EncodeItemType returns the string stored for the itemType of an item,
+	// or false if items of this type should not be persisted.
+	EncodeItemType func(itemType interface{}) (string, bool)
+	// DecodeItemType returns the itemType for the string from EncodeItemType
+	DecodeItemType func(itemType string) (interface{}, error)
+}
+
+type journalRecord struct {
+	Op            string
+	Key           string
+	ItemType      string    `json:",omitempty"`
+	URL           string    `json:",omitempty"`
+	Size          int64     `json:",omitempty"`
+	BailOnHTTPErr bool      `json:",omitempty"`
+	Created       time.Time `json:",omitempty"`
+	Body          []byte    `json:",omitempty"`
+}
+
+type journalEntry struct {
+	item    *deferredItem
+	recSize int64
+}
+
+type deferredJournal struct {
+	log            *base.LogObject
+	path           string
+	file           *os.File
+	fileSize       int64
+	maxSize        int64
+	maxAge         time.Duration
+	encodeItemType func(itemType interface{}) (string, bool)
+	decodeItemType func(itemType string) (interface{}, error)
+	// Items for which the journal holds the latest set record, by key
+	persisted map[string]journalEntry
+	// Set after a write error to rewrite the journal on the next flush
+	broken bool
+	// Pending write of the changes since the last flush
+	flushTimer *time.Timer
+}
+
+// EnableDeferredJournal makes the deferred items persist in a journal.
+// Must be called after GetDeferredChan.
+// The items found in the journal from before are queued in priority order
+// and will be sent by HandleDeferred. Items for the same key which were
+// added since GetDeferredChan take precedence over those in the journal.
+func EnableDeferredJournal(zedcloudCtx *ZedCloudContext, opts DeferredJournalOptions) error {
+	return zedcloudCtx.deferredCtx.enableJournal(zedcloudCtx.log, opts)
+}
+
+// SetDeferredJournalLimits updates the size and age bounds of the journal
+func SetDeferredJournalLimits(zedcloudCtx *ZedCloudContext, maxSize int64, maxAge time.Duration) {
+	ctx := &zedcloudCtx.deferredCtx
+	ctx.lock.Lock()
+	defer ctx.lock.Unlock()
+	if ctx.journal == nil {
+		return
+	}
+	if ctx.journal.maxSize == maxSize && ctx.journal.maxAge == maxAge {
+		return
+	}
+	zedcloudCtx.log.Functionf("SetDeferredJournalLimits(%d, %v)", maxSize, maxAge)
+	ctx.journal.maxSize = maxSize
+	ctx.journal.maxAge = maxAge
+	// Items which were left out may fit now
+	for _, item := range ctx.deferredItems {
+		item.notJournaled = false
+	}
+	ctx.journal.flush(ctx.deferredItems)
+}
+
+func (ctx *DeferredContext) enableJournal(log *base.LogObject, opts DeferredJournalOptions) error {
+	ctx.lock.Lock()
+	defer ctx.lock.Unlock()
+
+	if ctx.journal != nil {
+		return fmt.Errorf("deferred journal %s already enabled", ctx.journal.path)
+	}
+	j := &deferredJournal{
+		log:            log,
+		path:           opts.Path,
+		maxSize:        opts.MaxSize,
+		maxAge:         opts.MaxAge,
+		encodeItemType: opts.EncodeItemType,
+		decodeItemType: opts.DecodeItemType,
+		persisted:      make(map[string]journalEntry),
+	}
+	items, err := j.load()
+	if err != nil {
+		// Start over with an empty journal
+		log.Errorf("enableJournal: %v", err)
+	}
+
+	// Replay in the order of priority, then of age
+	priority := func(item *deferredItem) int {
+		for i, f := range ctx.priorityCheckFunctions {
+			if f(item.itemType) {
+				return i
+			}
+		}
+		return len(ctx.priorityCheckFunctions)
+	}
+	sort.SliceStable(items, func(i, k int) bool {
+		pi, pk := priority(items[i]), priority(items[k])
+		if pi != pk {
+			return pi < pk
+		}
+		return items[i].created.Before(items[k].created)
+	})
+	queued := make(map[string]bool)
+	for _, item := range ctx.deferredItems {
+		queued[item.key] = true
+	}
+	var replayed []*deferredItem
+	for _, item := range items {
+		if queued[item.key] {
+			log.Tracef("enableJournal: %s superseded", item.key)
+			continue
+		}
+		replayed = append(replayed, item)
+	}
+	if len(replayed) != 0 {
+		log.Noticef("enableJournal: replaying %d deferred items from %s",
+			len(replayed), j.path)
+		if len(ctx.deferredItems) == 0 {
+			startTimer(log, ctx)
+		}
+		ctx.deferredItems = append(replayed, ctx.deferredItems...)
+	}
+	ctx.journal = j
+	// Rewrite what we keep into a clean journal
+	j.broken = true
+	j.flush(ctx.deferredItems)
+	return nil
+}
+
+// load reads the journal and returns the items it holds.
+// A torn or corrupted record ends the journal.
+func (j *deferredJournal) load() ([]*deferredItem, error) {
+	data, err := ioutil.ReadFile(j.path)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	var order []string
+	seen := make(map[string]bool)
+	records := make(map[string]journalRecord)
+	offset := 0
+	for offset < len(data) {
+		rec, recLen, err := decodeJournalRecord(data[offset:])
+		if err != nil {
+			j.log.Warnf("load: %s ends at offset %d of %d: %v",
+				j.path, offset, len(data), err)
+			break
+		}
+		offset += recLen
+		switch rec.Op {
+		case journalOpSet:
+			if !seen[rec.Key] {
+				seen[rec.Key] = true
+				order = append(order, rec.Key)
+			}
+			records[rec.Key] = rec
+		case journalOpRemove:
+			delete(records, rec.Key)
+		default:
+			j.log.Warnf("load: unknown op %s for %s", rec.Op, rec.Key)
+		}
+	}
+	var items []*deferredItem
+	for _, key := range order {
+		rec, ok := records[key]
+		if !ok {
+			continue
+		}
+		if j.maxAge != 0 && time.Since(rec.Created) > j.maxAge {
+			j.log.Functionf("load: dropping %s from %v", key, rec.Created)
+			continue
+		}
+		itemType, err := j.decodeItemType(rec.ItemType)
+		if err != nil {
+			j.log.Warnf("load: dropping %s: %v", key, err)
+			continue
+		}
+		items = append(items, &deferredItem{
+			key:           key,
+			itemType:      itemType,
+			buf:           bytes.NewBuffer(rec.Body),
+			size:          rec.Size,
+			url:           rec.URL,
+			bailOnHTTPErr: rec.BailOnHTTPErr,
+			created:       rec.Created,
+		})
+	}
+	return items, nil
+}
+
+func encodeJournalRecord(rec journalRecord) ([]byte, error) {
+	payload, err := json.Marshal(rec)
+	if err != nil {
+		return nil, err
+	}
+	out := make([]byte, journalHeaderLen+len(payload))
+	binary.LittleEndian.PutUint32(out[0:4], uint32(len(payload)))
+	binary.LittleEndian.PutUint32(out[4:8], crc32.Checksum(payload, journalCRCTable))
+	copy(out[journalHeaderLen:], payload)
+	return out, nil
+}
+
+func decodeJournalRecord(data []byte) (journalRecord, int, error) {
+	var rec journalRecord
+	if len(data) < journalHeaderLen {
+		return rec, 0, fmt.Errorf("short header")
+	}
+	payloadLen := int(binary.LittleEndian.Uint32(data[0:4]))
+	crc := binary.LittleEndian.Uint32(data[4:8])
+	if payloadLen > len(data)-journalHeaderLen {
+		return rec, 0, fmt.Errorf("short record")
+	}
+	payload := data[journalHeaderLen : journalHeaderLen+payloadLen]
+	if crc32.Checksum(payload, journalCRCTable) != crc {
+		return rec, 0, fmt.Errorf("checksum mismatch")
+	}
+	if err := json.Unmarshal(payload, &rec); err != nil {
+		return rec, 0, err
+	}
+	return rec, journalHeaderLen + payloadLen, nil
+}
+
+// keep returns the items, by key, which belong in the journal
+func (j *deferredJournal) keep(items []*deferredItem) map[string]*deferredItem {
+	keep := make(map[string]*deferredItem)
+	var size int64
+	var candidates []*deferredItem
+	for _, item := range items {
+		if item.buf == nil || item.buf.Len() == 0 || item.notJournaled {
+			continue
+		}
+		if j.maxAge != 0 && time.Since(item.created) > j.maxAge {
+			continue
+		}
+		if _, ok := j.encodeItemType(item.itemType); !ok {
+			continue
+		}
+		candidates = append(candidates, item)
+		size += int64(item.buf.Len())
+	}
+	// Leave out the oldest items to honor the size limit
+	sort.SliceStable(candidates, func(i, k int) bool {
+		return candidates[i].created.Before(candidates[k].created)
+	})
+	for _, item := range candidates {
+		if size > j.maxSize {
+			j.log.Functionf("keep: %s does not fit in the journal", item.key)
+			item.notJournaled = true
+			size -= int64(item.buf.Len())
+			continue
+		}
+		keep[item.key] = item
+	}
+	return keep
+}
+
+func (j *deferredJournal) setRecord(item *deferredItem) journalRecord {
+	itemType, _ := j.encodeItemType(item.itemType)
+	return journalRecord{
+		Op:            journalOpSet,
+		Key:           item.key,
+		ItemType:      itemType,
+		URL:           item.url,
+		Size:          item.size,
+		BailOnHTTPErr: item.bailOnHTTPErr,
+		Created:       item.created,
+		Body:          item.buf.Bytes(),
+	}
+}
+
+// scheduleJournalFlush writes the changes to the queued items after
+// journalFlushDelay, together with the ones made in the meantime.
+// Called with the DeferredContext lock held.
+func (ctx *DeferredContext) scheduleJournalFlush() {
+	if ctx.journal == nil || ctx.journal.flushTimer != nil {
+		return
+	}
+	ctx.journal.flushTimer = time.AfterFunc(journalFlushDelay, ctx.flushJournal)
+}
+
+func (ctx *DeferredContext) flushJournal() {
+	ctx.lock.Lock()
+	defer ctx.lock.Unlock()
+	if ctx.journal != nil {
+		ctx.journal.flush(ctx.deferredItems)
+	}
+}
+
+// flush brings the journal in sync with the queued items.
+// Called with the DeferredContext lock held.
+func (j *deferredJournal) flush(items []*deferredItem) {
+	if j.flushTimer != nil {
+		j.flushTimer.Stop()
+		j.flushTimer = nil
+	}
+	keep := j.keep(items)
+	if j.broken {
+		j.compact(keep)
+		return
+	}
+	var out []byte
+	var liveSize int64
+	appendRecord := func(rec journalRecord) int64 {
+		data, err := encodeJournalRecord(rec)
+		if err != nil {
+			j.log.Errorf("flush: encode %s: %v", rec.Key, err)
+			return 0
+		}
+		out = append(out, data...)
+		return int64(len(data))
+	}
+	for key := range j.persisted {
+		if _, ok := keep[key]; !ok {
+			appendRecord(journalRecord{Op: journalOpRemove, Key: key})
+			delete(j.persisted, key)
+		}
+	}
+	for _, item := range items {
+		if keep[item.key] != item {
+			continue
+		}
+		if entry, ok := j.persisted[item.key]; ok && entry.item == item {
+			continue
+		}
+		recSize := appendRecord(j.setRecord(item))
+		j.persisted[item.key] = journalEntry{item: item, recSize: recSize}
+	}
+	for _, entry := range j.persisted {
+		liveSize += entry.recSize
+	}
+	if len(out) != 0 {
+		if err := j.append(out); err != nil {
+			j.log.Errorf("flush: %v", err)
+			j.broken = true
+			return
+		}
+	}
+	if j.fileSize > 2*liveSize+journalCompactSlack {
+		j.compact(keep)
+	}
+}
+
+func (j *deferredJournal) append(data []byte) error {
+	if j.file == nil {
+		f, err := os.OpenFile(j.path, os.O_WRONLY|os.O_APPEND|os.O_CREATE, 0600)
+		if err != nil {
+			return err
+		}
+		j.file = f
+	}
+	if _, err := j.file.Write(data); err != nil {
+		return err
+	}
+	if err := j.file.Sync(); err != nil {
+		return err
+	}
+	j.fileSize += int64(len(data))
+	return nil
+}
+
+// compact atomically replaces the journal with one holding just
+// a set record for each item to keep
+func (j *deferredJournal) compact(keep map[string]*deferredItem) {
+	var keys []string
+	for key := range keep {
+		keys = append(keys, key)
+	}
+	sort.Slice(keys, func(i, k int) bool {
+		return keep[keys[i]].created.Before(keep[keys[k]].created)
+	})
+	var out []byte
+	persisted := make(map[string]journalEntry)
+	for _, key := range keys {
+		item := keep[key]
+		data, err := encodeJournalRecord(j.setRecord(item))
+		if err != nil {
+			j.log.Errorf("compact: encode %s: %v", key, err)
+			continue
+		}
+		out = append(out, data...)
+		persisted[key] = journalEntry{item: item, recSize: int64(len(data))}
+	}
+	if j.file != nil {
+		j.file.Close()
+		j.file = nil
+	}
+	if err := fileutils.WriteRename(j.path, out); err != nil {
+		j.log.Errorf("compact: %v", err)
+		j.broken = true
+		return
+	}
+	j.log.Functionf("compact: %s has %d items in %d bytes",
+		j.path, len(persisted), len(out))
+	j.persisted = persisted
+	j.fileSize = int64(len(out))
+	j.broken = false
+}
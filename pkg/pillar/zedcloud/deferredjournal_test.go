@@ -0,0 +1,89 @@
+// Copyright (c) 2022 Zededa, Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package zedcloud
+
+import (
+	"bytes"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/lf-edge/eve/pkg/pillar/base"
+	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+)
+
+func newJournalTestContext(t *testing.T, path string) *ZedCloudContext {
+	log := base.NewSourceLogObject(logrus.StandardLogger(), "test", 1234)
+	ctx := &ZedCloudContext{log: log}
+	GetDeferredChan(ctx, nil, func(itemType interface{}) bool {
+		return itemType == "urgent"
+	})
+	err := EnableDeferredJournal(ctx, DeferredJournalOptions{
+		Path:    path,
+		MaxSize: 1024 * 1024,
+		MaxAge:  time.Hour,
+		EncodeItemType: func(itemType interface{}) (string, bool) {
+			s, ok := itemType.(string)
+			return s, ok && s != "volatile"
+		},
+		DecodeItemType: func(itemType string) (interface{}, error) {
+			return itemType, nil
+		},
+	})
+	assert.NoError(t, err)
+	return ctx
+}
+
+func deferredKeysAndBodies(ctx *ZedCloudContext) ([]string, []string) {
+	var keys, bodies []string
+	for _, item := range ctx.deferredCtx.deferredItems {
+		keys = append(keys, item.key)
+		bodies = append(bodies, item.buf.String())
+	}
+	return keys, bodies
+}
+
+func TestDeferredJournal(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "deferred.journal")
+
+	ctx := newJournalTestContext(t, path)
+	SetDeferred(ctx, "a", bytes.NewBufferString("a1"), 2, "url/a", true, "normal")
+	SetDeferred(ctx, "b", bytes.NewBufferString("b1"), 2, "url/b", true, "urgent")
+	SetDeferred(ctx, "v", bytes.NewBufferString("v1"), 2, "url/v", true, "volatile")
+	SetDeferred(ctx, "a", bytes.NewBufferString("a2"), 2, "url/a", true, "normal")
+	// Persisted at once after the delay, without an attempt to send them
+	assert.NotNil(t, ctx.deferredCtx.journal.flushTimer)
+	ctx.deferredCtx.flushJournal()
+	assert.Nil(t, ctx.deferredCtx.journal.flushTimer)
+
+	// Simulate a write torn by a power loss
+	f, err := os.OpenFile(path, os.O_WRONLY|os.O_APPEND, 0600)
+	assert.NoError(t, err)
+	_, err = f.Write([]byte{0x10, 0, 0, 0, 1, 2})
+	assert.NoError(t, err)
+	f.Close()
+
+	// Replayed in priority order, superseded and volatile items dropped
+	ctx = newJournalTestContext(t, path)
+	keys, bodies := deferredKeysAndBodies(ctx)
+	assert.Equal(t, []string{"b", "a"}, keys)
+	assert.Equal(t, []string{"b1", "a2"}, bodies)
+	assert.Equal(t, "url/a", ctx.deferredCtx.deferredItems[1].url)
+
+	RemoveDeferred(ctx, "b")
+	ctx.deferredCtx.flushJournal()
+	ctx = newJournalTestContext(t, path)
+	keys, _ = deferredKeysAndBodies(ctx)
+	assert.Equal(t, []string{"a"}, keys)
+
+	// Nothing fits in the journal any more, but stays queued
+	SetDeferredJournalLimits(ctx, 0, time.Hour)
+	keys, _ = deferredKeysAndBodies(ctx)
+	assert.Equal(t, []string{"a"}, keys)
+	ctx = newJournalTestContext(t, path)
+	keys, _ = deferredKeysAndBodies(ctx)
+	assert.Empty(t, keys)
+}
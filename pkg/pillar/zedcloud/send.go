@@ -45,6 +45,7 @@ type ZedCloudContext struct {
 	TlsConfig           *tls.Config
 	FailureFunc         func(log *base.LogObject, intf string, url string, reqLen int64, respLen int64, authFail bool)
 	SuccessFunc         func(log *base.LogObject, intf string, url string, reqLen int64, respLen int64, timeSpent int64, resume bool)
+	CompressionFunc     func(log *base.LogObject, intf string, url string, sentSaved int64, recvSaved int64)
 	NoLedManager        bool // Don't call UpdateLedManagerConfig
 	DevUUID             uuid.UUID
 	DevSerial           string
@@ -63,6 +64,7 @@ type ZedCloudContext struct {
 	onBoardCertBytes      []byte
 	log                   *base.LogObject
 	deferredCtx           DeferredContext
+	shared                *sharedState
 }
 
 // ContextOptions - options to be passed at NewContext
@@ -134,6 +136,34 @@ func GetContextForAllIntfFunctions(ctx *ZedCloudContext) (context.Context, conte
 	return context.WithTimeout(context.Background(), maxWaitDuration)
 }
 
+type trafficClassKey struct{}
+
+// WithTrafficClass returns a context for AllIntf functions which makes them
+// skip management ports that the given class of traffic is not allowed to use,
+// e.g. cellular ports with the data quota running out.
+func WithTrafficClass(ctx context.Context, tc types.TrafficClass) context.Context {
+	return context.WithValue(ctx, trafficClassKey{}, tc)
+}
+
+// dropBlockedIntfs removes interfaces which the traffic class of the context
+// is not allowed to use.
+func dropBlockedIntfs(ctxWork context.Context, ctx *ZedCloudContext,
+	intfs []string) []string {
+	tc, ok := ctxWork.Value(trafficClassKey{}).(types.TrafficClass)
+	if !ok || tc == types.TrafficClassUnspecified {
+		return intfs
+	}
+	var allowed []string
+	for _, intf := range intfs {
+		port := ctx.DeviceNetworkStatus.GetPortByIfName(intf)
+		if port != nil && port.IsTrafficBlocked(tc) {
+			continue
+		}
+		allowed = append(allowed, intf)
+	}
+	return allowed
+}
+
 // Tries all interfaces (free first) until one succeeds. interation arg
 // ensure load spreading across multiple interfaces.
 // Returns response for first success. Caller can not use resp.Body but can
@@ -165,6 +195,13 @@ func SendOnAllIntf(ctxWork context.Context, ctx *ZedCloudContext, url string, re
 		log.Error(err.Error())
 		return nil, nil, senderStatus, err
 	}
+	intfs = dropBlockedIntfs(ctxWork, ctx, intfs)
+	if len(intfs) == 0 {
+		err := fmt.Errorf("Can not connect to %s: "+
+			"traffic is blocked on all management interfaces", url)
+		log.Warn(err.Error())
+		return nil, nil, senderStatus, err
+	}
 
 	for _, intf := range intfs {
 		const useOnboard = false
@@ -175,13 +212,8 @@ func SendOnAllIntf(ctxWork context.Context, ctx *ZedCloudContext, url string, re
 			senderStatus = status
 		}
 		if resp != nil {
-			switch resp.StatusCode {
-			case http.StatusServiceUnavailable:
-				senderStatus = types.SenderStatusUpgrade
-			case http.StatusNotFound, http.StatusBadRequest:
-				senderStatus = types.SenderStatusNotFound
-			case http.StatusForbidden:
-				senderStatus = types.SenderStatusForbidden
+			if status := senderStatusFromCode(resp.StatusCode); status != types.SenderStatusNone {
+				senderStatus = status
 			}
 		}
 
@@ -435,26 +467,16 @@ func SendOnIntf(workContext context.Context, ctx *ZedCloudContext, destURL strin
 		return nil, nil, senderStatus, err
 	}
 
-	// Get the transport header with proxy information filled
+	// Get the proxy information for the transport
 	proxyUrl, err := LookupProxy(ctx.log, ctx.DeviceNetworkStatus, intf, reqUrl)
-	var transport *http.Transport
 	var usedProxy bool
 	if err == nil && proxyUrl != nil && allowProxy {
 		log.Tracef("sendOnIntf: For input URL %s, proxy found is %s",
 			reqUrl, proxyUrl.String())
 		usedProxy = true
-		transport = &http.Transport{
-			TLSClientConfig: ctx.TlsConfig,
-			Proxy:           http.ProxyURL(proxyUrl),
-		}
 	} else {
-		transport = &http.Transport{
-			TLSClientConfig: ctx.TlsConfig,
-		}
+		proxyUrl = nil
 	}
-	// Since we recreate the transport on each call there is no benefit
-	// to keeping the connections open.
-	defer transport.CloseIdleConnections()
 
 	var attempts []SendAttempt
 	var sessionResume bool
@@ -471,29 +493,10 @@ func SendOnIntf(workContext context.Context, ctx *ZedCloudContext, destURL strin
 			IfName:     intf,
 			SourceAddr: localAddr,
 		}
-		localTCPAddr := net.TCPAddr{IP: localAddr}
-		localUDPAddr := net.UDPAddr{IP: localAddr}
 		log.Tracef("Connecting to %s using intf %s source %v\n",
-			reqUrl, intf, localTCPAddr)
+			reqUrl, intf, localAddr)
 		var dnsIsAvail bool
-		resolverDial := func(ctx context.Context, network, address string) (net.Conn, error) {
-			log.Tracef("resolverDial %v %v", network, address)
-			ip := net.ParseIP(strings.Split(address, ":")[0])
-			for _, dnsServer := range dnsServers {
-				if dnsServer != nil && dnsServer.Equal(ip) {
-					dnsIsAvail = true
-					// XXX can we fallback to TCP? Would get a mismatched address if we do
-					d := net.Dialer{LocalAddr: &localUDPAddr}
-					return d.Dial(network, address)
-				}
-			}
-			return nil, fmt.Errorf("DNS server %s is from a different network, skipping",
-				ip.String())
-		}
-		r := net.Resolver{Dial: resolverDial, PreferGo: true,
-			StrictErrors: false}
-		d := net.Dialer{Resolver: &r, LocalAddr: &localTCPAddr}
-		transport.Dial = d.Dial
+		transport := ctx.getTransport(intf, localAddr, dnsServers, proxyUrl)
 
 		client := &http.Client{Transport: transport}
 		if ctx.NetworkSendTimeout != 0 {
@@ -514,6 +517,22 @@ func SendOnIntf(workContext context.Context, ctx *ZedCloudContext, destURL strin
 			b2 = b
 		}
 
+		// Compress the body if the controller told us it can handle it
+		var encoding string
+		var sentSaved int64
+		if b2 != nil {
+			if encoding = ctx.requestEncoding(); encoding != "" {
+				compressed := compressBody(encoding, b2.Bytes())
+				if compressed != nil {
+					sentSaved = int64(b2.Len() - len(compressed))
+					b2 = bytes.NewBuffer(compressed)
+					reqlen = int64(len(compressed))
+				} else {
+					encoding = ""
+				}
+			}
+		}
+
 		if b2 != nil {
 			req, err = http.NewRequest("POST", reqUrl, b2)
 		} else {
@@ -529,6 +548,10 @@ func SendOnIntf(workContext context.Context, ctx *ZedCloudContext, destURL strin
 		if b2 != nil {
 			req.Header.Add("Content-Type", ContentTypeProto)
 		}
+		if encoding != "" {
+			req.Header.Add("Content-Encoding", encoding)
+		}
+		req.Header.Add("Accept-Encoding", acceptEncoding)
 		// Add a per-request UUID to the HTTP Header
 		// for traceability in the controller
 		id, err := uuid.NewV4()
@@ -556,9 +579,13 @@ func SendOnIntf(workContext context.Context, ctx *ZedCloudContext, destURL strin
 
 		trace := &httptrace.ClientTrace{
 			GotConn: func(connInfo httptrace.GotConnInfo) {
-				log.Tracef("Got RemoteAddr: %+v, LocalAddr: %+v\n",
+				log.Tracef("Got RemoteAddr: %+v, LocalAddr: %+v, Reused: %t\n",
 					connInfo.Conn.RemoteAddr(),
-					connInfo.Conn.LocalAddr())
+					connInfo.Conn.LocalAddr(), connInfo.Reused)
+				if connInfo.Reused {
+					// No new connection hence no DNS lookup
+					dnsIsAvail = true
+				}
 			},
 			DNSDone: func(dnsInfo httptrace.DNSDoneInfo) {
 				log.Tracef("DNS Info: %+v\n", dnsInfo)
@@ -570,7 +597,8 @@ func SendOnIntf(workContext context.Context, ctx *ZedCloudContext, destURL strin
 				sessionResume = state.DidResume
 			},
 		}
-		req = req.WithContext(httptrace.WithClientTrace(workContext,
+		reqContext := withDNSAvailFlag(workContext, &dnsIsAvail)
+		req = req.WithContext(httptrace.WithClientTrace(reqContext,
 			trace))
 		log.Tracef("SendOnIntf: req method %s, isget %v, url %s",
 			req.Method, isGet, reqUrl)
@@ -645,6 +673,23 @@ func SendOnIntf(workContext context.Context, ctx *ZedCloudContext, destURL strin
 		resp.Body.Close()
 		resp.Body = nil
 		resplen := int64(len(contents))
+		ctx.learnFromResponse(resp, encoding)
+		var recvSaved int64
+		if contentEncoding := resp.Header.Get("Content-Encoding"); contentEncoding != "" {
+			decompressed, err := decompressBody(contentEncoding, contents)
+			if err != nil {
+				log.Errorf("SendOnIntf: response from %s: %v", reqUrl, err)
+				attempt.Err = err
+				attempts = append(attempts, attempt)
+				if ctx.FailureFunc != nil {
+					ctx.FailureFunc(log, intf, reqUrl, reqlen,
+						resplen, false)
+				}
+				continue
+			}
+			recvSaved = int64(len(decompressed)) - resplen
+			contents = decompressed
+		}
 
 		if useTLS {
 			connState := resp.TLS
@@ -700,6 +745,9 @@ func SendOnIntf(workContext context.Context, ctx *ZedCloudContext, destURL strin
 		if ctx.SuccessFunc != nil {
 			ctx.SuccessFunc(log, intf, reqUrl, reqlen, resplen, totalTimeMillis, sessionResume)
 		}
+		if ctx.CompressionFunc != nil && (sentSaved != 0 || recvSaved != 0) {
+			ctx.CompressionFunc(log, intf, reqUrl, sentSaved, recvSaved)
+		}
 
 		switch resp.StatusCode {
 		case http.StatusOK, http.StatusCreated, http.StatusNotModified:
@@ -759,6 +807,20 @@ func SendOnIntf(workContext context.Context, ctx *ZedCloudContext, destURL strin
 	return nil, nil, senderStatus, err
 }
 
+// senderStatusFromCode returns the SenderResult for the HTTP status codes
+// which tell the caller more than a failure, and SenderStatusNone otherwise
+func senderStatusFromCode(code int) types.SenderResult {
+	switch code {
+	case http.StatusServiceUnavailable:
+		return types.SenderStatusUpgrade
+	case http.StatusNotFound, http.StatusBadRequest:
+		return types.SenderStatusNotFound
+	case http.StatusForbidden:
+		return types.SenderStatusForbidden
+	}
+	return types.SenderStatusNone
+}
+
 //SendLocal uses local routes to request the data
 func SendLocal(ctx *ZedCloudContext, destURL string, intf string, ipSrc net.IP,
 	reqlen int64, b *bytes.Buffer, reqContentType string) (*http.Response, []byte, error) {
@@ -1002,10 +1064,12 @@ func NewContext(log *base.LogObject, opt ContextOptions) ZedCloudContext {
 		DevSoftSerial:       opt.SoftSerial,
 		AgentName:           opt.AgentName,
 		log:                 log,
+		shared:              newSharedState(),
 	}
 	if opt.AgentMetrics != nil {
 		ctx.FailureFunc = opt.AgentMetrics.RecordFailure
 		ctx.SuccessFunc = opt.AgentMetrics.RecordSuccess
+		ctx.CompressionFunc = opt.AgentMetrics.RecordCompression
 	}
 	return ctx
 }
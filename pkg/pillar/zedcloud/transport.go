@@ -0,0 +1,362 @@
+// Copyright (c) 2022 Zededa, Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+// Connection reuse and content encoding negotiation with the controller
+
+package zedcloud
+
+import (
+	"bytes"
+	"compress/gzip"
+	"context"
+	"crypto/tls"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/klauspost/compress/zstd"
+	"github.com/lf-edge/eve/pkg/pillar/base"
+)
+
+const (
+	encodingGzip = "gzip"
+	encodingZstd = "zstd"
+
+	// acceptEncoding lists the response encodings we can decode,
+	// in the order of preference.
+	acceptEncoding = encodingZstd + ", " + encodingGzip
+
+	// batchMaxItemsHeader is set by a controller which accepts batched
+	// requests on the "batch" endpoint; the value is the maximum number
+	// of items in one request.
+	batchMaxItemsHeader = "X-Batch-Max-Items"
+
+	// minCompressLen is the smallest request body we bother to compress
+	minCompressLen = 512
+
+	// maxDecompressedLen protects us from a response which expands
+	// into something unreasonably large
+	maxDecompressedLen = 256 * 1024 * 1024
+
+	// idleConnTimeout is how long an unused connection to the controller
+	// is kept open. Transports which were not used for twice that long
+	// are dropped from the cache.
+	idleConnTimeout = 90 * time.Second
+)
+
+var (
+	zstdEncoder, _ = zstd.NewWriter(nil)
+	zstdDecoder, _ = zstd.NewReader(nil,
+		zstd.WithDecoderMaxMemory(maxDecompressedLen))
+)
+
+// sharedState is the part of ZedCloudContext which is shared by all the
+// copies of the context and which is updated while sending:
+// the cached transports and what we learnt about the controller.
+type sharedState struct {
+	sync.Mutex
+	tlsConfig  *tls.Config
+	transports map[transportKey]*cachedTransport
+	// Encoding accepted by the controller for request bodies.
+	// Empty until the controller announced it using Accept-Encoding.
+	reqEncoding string
+	// Maximum number of items the controller accepts in a batch request.
+	// Zero if batching is not supported.
+	batchMaxItems int
+}
+
+// transportKey has what newTransport captures, so that a change of the
+// DNS servers or the proxy of an interface gets a new transport
+type transportKey struct {
+	intf       string
+	localAddr  string
+	dnsServers string
+	proxyURL   string
+}
+
+type cachedTransport struct {
+	transport *http.Transport
+	lastUsed  time.Time
+}
+
+type dnsAvailKey struct{}
+
+func newSharedState() *sharedState {
+	return &sharedState{
+		transports: make(map[transportKey]*cachedTransport),
+	}
+}
+
+// getTransport returns a transport which dials from localAddr, possibly
+// through the proxy. The transport is cached in the context when possible so
+// that the TLS sessions and the HTTP/2 connections to the controller are
+// reused by subsequent requests.
+// Use withDNSAvailFlag on the request context to learn whether the DNS
+// server of the interface was used by a new connection.
+func (ctx *ZedCloudContext) getTransport(intf string, localAddr net.IP,
+	dnsServers []net.IP, proxyURL *url.URL) *http.Transport {
+
+	key := transportKey{intf: intf, localAddr: localAddr.String()}
+	for _, dnsServer := range dnsServers {
+		key.dnsServers += dnsServer.String() + " "
+	}
+	if proxyURL != nil {
+		key.proxyURL = proxyURL.String()
+	}
+	state := ctx.shared
+	if state == nil {
+		// Context was not created by NewContext; nothing to cache in
+		return newTransport(ctx.log, ctx.TlsConfig, localAddr, dnsServers, proxyURL)
+	}
+	state.Lock()
+	defer state.Unlock()
+	if state.tlsConfig != ctx.TlsConfig {
+		// New certificates; do not reuse connections made with the old ones
+		for k, ct := range state.transports {
+			ct.transport.CloseIdleConnections()
+			delete(state.transports, k)
+		}
+		state.tlsConfig = ctx.TlsConfig
+	}
+	now := time.Now()
+	for k, ct := range state.transports {
+		if now.Sub(ct.lastUsed) > 2*idleConnTimeout {
+			ct.transport.CloseIdleConnections()
+			delete(state.transports, k)
+		}
+	}
+	ct, ok := state.transports[key]
+	if !ok {
+		ct = &cachedTransport{
+			transport: newTransport(ctx.log, ctx.TlsConfig, localAddr,
+				dnsServers, proxyURL),
+		}
+		state.transports[key] = ct
+	}
+	ct.lastUsed = now
+	return ct.transport
+}
+
+// CloseIdleConnections closes the connections to the controller which are
+// kept open for reuse.
+func (ctx *ZedCloudContext) CloseIdleConnections() {
+	state := ctx.shared
+	if state == nil {
+		return
+	}
+	state.Lock()
+	defer state.Unlock()
+	for k, ct := range state.transports {
+		ct.transport.CloseIdleConnections()
+		delete(state.transports, k)
+	}
+}
+
+func newTransport(log *base.LogObject, tlsConfig *tls.Config, localAddr net.IP,
+	dnsServers []net.IP, proxyURL *url.URL) *http.Transport {
+
+	localTCPAddr := net.TCPAddr{IP: localAddr}
+	localUDPAddr := net.UDPAddr{IP: localAddr}
+	resolverDial := func(ctx context.Context, network, address string) (net.Conn, error) {
+		log.Tracef("resolverDial %v %v", network, address)
+		ip := net.ParseIP(strings.Split(address, ":")[0])
+		for _, dnsServer := range dnsServers {
+			if dnsServer != nil && dnsServer.Equal(ip) {
+				if dnsIsAvail, ok := ctx.Value(dnsAvailKey{}).(*bool); ok {
+					*dnsIsAvail = true
+				}
+				// XXX can we fallback to TCP? Would get a mismatched address if we do
+				d := net.Dialer{LocalAddr: &localUDPAddr}
+				return d.DialContext(ctx, network, address)
+			}
+		}
+		return nil, fmt.Errorf("DNS server %s is from a different network, skipping",
+			ip.String())
+	}
+	r := net.Resolver{Dial: resolverDial, PreferGo: true,
+		StrictErrors: false}
+	d := net.Dialer{Resolver: &r, LocalAddr: &localTCPAddr}
+	transport := &http.Transport{
+		TLSClientConfig:   tlsConfig,
+		DialContext:       d.DialContext,
+		ForceAttemptHTTP2: true,
+		IdleConnTimeout:   idleConnTimeout,
+		// We negotiate and decode the content encoding ourselves
+		DisableCompression: true,
+	}
+	if proxyURL != nil {
+		transport.Proxy = http.ProxyURL(proxyURL)
+	}
+	return transport
+}
+
+// withDNSAvailFlag returns a context which makes the resolver of the transport
+// set the flag when it talks to a DNS server of the interface.
+func withDNSAvailFlag(ctx context.Context, dnsIsAvail *bool) context.Context {
+	return context.WithValue(ctx, dnsAvailKey{}, dnsIsAvail)
+}
+
+// requestEncoding returns the content encoding to use for request bodies
+func (ctx *ZedCloudContext) requestEncoding() string {
+	state := ctx.shared
+	if state == nil {
+		return ""
+	}
+	state.Lock()
+	defer state.Unlock()
+	return state.reqEncoding
+}
+
+// batchMaxItems returns the maximum number of items in a batch request,
+// or zero if the controller does not support batching.
+func (ctx *ZedCloudContext) batchMaxItems() int {
+	state := ctx.shared
+	if state == nil {
+		return 0
+	}
+	state.Lock()
+	defer state.Unlock()
+	return state.batchMaxItems
+}
+
+// disableBatching is used when the controller rejected a batch request
+func (ctx *ZedCloudContext) disableBatching() {
+	state := ctx.shared
+	if state == nil {
+		return
+	}
+	state.Lock()
+	defer state.Unlock()
+	state.batchMaxItems = 0
+}
+
+// learnFromResponse records what the controller announced in the response
+// headers about the request encodings and the batching it supports.
+// A controller which does not support the encoding we used responds with
+// 415 Unsupported Media Type (RFC 7694).
+func (ctx *ZedCloudContext) learnFromResponse(resp *http.Response, usedEncoding string) {
+	state := ctx.shared
+	if state == nil {
+		return
+	}
+	state.Lock()
+	defer state.Unlock()
+	if values := resp.Header.Values("Accept-Encoding"); len(values) != 0 {
+		state.reqEncoding = selectEncoding(values)
+	} else if usedEncoding != "" &&
+		resp.StatusCode == http.StatusUnsupportedMediaType {
+		state.reqEncoding = ""
+	}
+	if value := resp.Header.Get(batchMaxItemsHeader); value != "" {
+		maxItems, err := strconv.Atoi(value)
+		if err != nil || maxItems < 0 {
+			ctx.log.Warnf("learnFromResponse: bad %s value %q",
+				batchMaxItemsHeader, value)
+			maxItems = 0
+		}
+		state.batchMaxItems = maxItems
+	}
+}
+
+// selectEncoding returns our preferred encoding among those listed
+// in Accept-Encoding header values
+func selectEncoding(values []string) string {
+	accepted := make(map[string]bool)
+	for _, value := range values {
+		for _, enc := range strings.Split(value, ",") {
+			params := strings.Split(enc, ";")
+			name := strings.ToLower(strings.TrimSpace(params[0]))
+			rejected := false
+			for _, param := range params[1:] {
+				param = strings.TrimSpace(param)
+				if !strings.HasPrefix(param, "q=") {
+					continue
+				}
+				q, err := strconv.ParseFloat(param[len("q="):], 64)
+				if err == nil && q == 0 {
+					rejected = true
+				}
+			}
+			if !rejected {
+				accepted[name] = true
+			}
+		}
+	}
+	for _, enc := range []string{encodingZstd, encodingGzip} {
+		if accepted[enc] {
+			return enc
+		}
+	}
+	return ""
+}
+
+// compressBody returns the body encoded using encoding,
+// or nil if compressing is not worth it.
+func compressBody(encoding string, body []byte) []byte {
+	if len(body) < minCompressLen {
+		return nil
+	}
+	var out []byte
+	switch encoding {
+	case encodingZstd:
+		out = zstdEncoder.EncodeAll(body, make([]byte, 0, len(body)/2))
+	case encodingGzip:
+		var buf bytes.Buffer
+		w := gzip.NewWriter(&buf)
+		if _, err := w.Write(body); err != nil {
+			return nil
+		}
+		if err := w.Close(); err != nil {
+			return nil
+		}
+		out = buf.Bytes()
+	default:
+		return nil
+	}
+	if len(out) >= len(body) {
+		return nil
+	}
+	return out
+}
+
+// decompressBody decodes a response body according to its Content-Encoding
+func decompressBody(encoding string, body []byte) ([]byte, error) {
+	switch strings.ToLower(strings.TrimSpace(encoding)) {
+	case "", "identity":
+		return body, nil
+	case encodingZstd:
+		out, err := zstdDecoder.DecodeAll(body, nil)
+		if err != nil {
+			return nil, fmt.Errorf("zstd decode failed: %v", err)
+		}
+		if len(out) > maxDecompressedLen {
+			return nil, fmt.Errorf("zstd decoded response exceeds %d bytes",
+				maxDecompressedLen)
+		}
+		return out, nil
+	case encodingGzip:
+		r, err := gzip.NewReader(bytes.NewReader(body))
+		if err != nil {
+			return nil, fmt.Errorf("gzip decode failed: %v", err)
+		}
+		defer r.Close()
+		out, err := ioutil.ReadAll(io.LimitReader(r, maxDecompressedLen+1))
+		if err != nil {
+			return nil, fmt.Errorf("gzip decode failed: %v", err)
+		}
+		if len(out) > maxDecompressedLen {
+			return nil, fmt.Errorf("gzip decoded response exceeds %d bytes",
+				maxDecompressedLen)
+		}
+		return out, nil
+	default:
+		return nil, fmt.Errorf("unsupported content encoding %s", encoding)
+	}
+}
@@ -0,0 +1,74 @@
+// Copyright (c) 2022 Zededa, Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package zedcloud
+
+import (
+	"bytes"
+	"net"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSelectEncoding(t *testing.T) {
+	testMatrix := map[string]struct {
+		values   []string
+		expected string
+	}{
+		"none":          {values: []string{""}, expected: ""},
+		"identity only": {values: []string{"identity"}, expected: ""},
+		"gzip":          {values: []string{"gzip"}, expected: encodingGzip},
+		"prefer zstd":   {values: []string{"gzip, zstd"}, expected: encodingZstd},
+		"multiple":      {values: []string{"gzip", "ZSTD"}, expected: encodingZstd},
+		"rejected zstd": {values: []string{"gzip, zstd;q=0"}, expected: encodingGzip},
+		"weighted":      {values: []string{"zstd;q=0.5, gzip;q=1.0"}, expected: encodingZstd},
+	}
+	for name, test := range testMatrix {
+		t.Logf("Running test case %s", name)
+		assert.Equal(t, test.expected, selectEncoding(test.values))
+	}
+}
+
+func TestCompressRoundTrip(t *testing.T) {
+	body := bytes.Repeat([]byte("deferred info message "), 100)
+	for _, enc := range []string{encodingZstd, encodingGzip} {
+		compressed := compressBody(enc, body)
+		assert.NotNil(t, compressed, enc)
+		assert.Less(t, len(compressed), len(body), enc)
+		out, err := decompressBody(enc, compressed)
+		assert.NoError(t, err, enc)
+		assert.Equal(t, body, out, enc)
+	}
+	// Too short to bother
+	assert.Nil(t, compressBody(encodingZstd, []byte("short")))
+	_, err := decompressBody("br", body)
+	assert.Error(t, err)
+}
+
+func TestSplitBatchURL(t *testing.T) {
+	const devPrefix = "zedcloud.example.com/api/v2/edgedevice/id/0bd1c2e4-1c3a-4c4f-a1bb-7fd5fbd3a3a4/"
+	prefix, path, ok := splitBatchURL(devPrefix + "info")
+	assert.True(t, ok)
+	assert.Equal(t, devPrefix, prefix)
+	assert.Equal(t, "info", path)
+
+	_, _, ok = splitBatchURL(devPrefix + batchAction)
+	assert.False(t, ok)
+	_, _, ok = splitBatchURL("zedcloud.example.com/api/v1/edgedevice/info")
+	assert.False(t, ok)
+	_, _, ok = splitBatchURL("zedcloud.example.com/api/v2/edgedevice/register")
+	assert.False(t, ok)
+}
+
+func TestGetTransport(t *testing.T) {
+	ctx := &ZedCloudContext{shared: newSharedState()}
+	localAddr := net.ParseIP("192.168.1.10")
+	dns1 := []net.IP{net.ParseIP("192.168.1.1")}
+	dns2 := []net.IP{net.ParseIP("192.168.1.2")}
+	transport := ctx.getTransport("eth0", localAddr, dns1, nil)
+	assert.Same(t, transport, ctx.getTransport("eth0", localAddr, dns1, nil))
+	// The resolver of a transport only talks to its DNS servers
+	assert.NotSame(t, transport, ctx.getTransport("eth0", localAddr, dns2, nil))
+	assert.NotSame(t, transport, ctx.getTransport("eth1", localAddr, dns1, nil))
+}
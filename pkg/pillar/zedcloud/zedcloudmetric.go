@@ -108,6 +108,21 @@ func (am *AgentMetrics) RecordSuccess(log *base.LogObject, ifname, url string, r
 	am.metrics[ifname] = m
 }
 
+// RecordCompression records bytes saved by compressing the request
+// and the response of a successful zedcloud API request.
+func (am *AgentMetrics) RecordCompression(log *base.LogObject, ifname, url string, sentSaved, recvSaved int64) {
+	release := am.acquire(log)
+	defer release()
+	log.Tracef("RecordCompression(%s, %s, %d, %d)",
+		ifname, url, sentSaved, recvSaved)
+	m := am.getInterfaceMetrics(ifname)
+	u := m.URLCounters[url]
+	u.SentBytesSaved += sentSaved
+	u.RecvBytesSaved += recvSaved
+	m.URLCounters[url] = u
+	am.metrics[ifname] = m
+}
+
 // Publish the recorded metrics through the given publisher.
 func (am *AgentMetrics) Publish(log *base.LogObject, publication pubsub.Publication, key string) error {
 	release := am.acquire(log)
@@ -6,8 +6,11 @@ package zfs
 import (
 	"bufio"
 	"bytes"
+	"context"
 	"fmt"
+	"io"
 	"io/ioutil"
+	"os/exec"
 	"path/filepath"
 	"strconv"
 	"strings"
@@ -131,10 +134,37 @@ func GetDatasetOption(log *base.LogObject, dataset string, option string) (strin
 }
 
 //CreateVolumeDataset creates dataset of zvol type in zfs
-func CreateVolumeDataset(log *base.LogObject, dataset string, size uint64, compression string) (string, error) {
+//sparse volumes do not reserve their size in the pool
+func CreateVolumeDataset(log *base.LogObject, dataset string, size uint64, compression string, sparse bool) (string, error) {
+	args := volumeDatasetArgs(dataset, size, compression, sparse)
+	stdoutStderr, err := base.Exec(log, vault.ZfsPath, args...).CombinedOutput()
+	if err != nil {
+		return string(stdoutStderr), err
+	}
+	return string(stdoutStderr), nil
+}
+
+//CreateEncryptedVolumeDataset creates dataset of zvol type in zfs
+//which is encrypted with the raw key instead of the key of its parent
+func CreateEncryptedVolumeDataset(log *base.LogObject, dataset string, size uint64, compression string, sparse bool, key []byte) (string, error) {
+	args := volumeDatasetArgs(dataset, size, compression, sparse)
+	// the key is read from stdin
+	args = append(args[:len(args)-1],
+		"-o", "encryption=aes-256-gcm",
+		"-o", "keyformat=raw",
+		"-o", "keylocation=prompt",
+		dataset)
+	return execWithKey(vault.ZfsPath, args, key)
+}
+
+func volumeDatasetArgs(dataset string, size uint64, compression string, sparse bool) []string {
 	alignedSize := alignUpToBlockSize(size)
 
-	args := append(zfsPath, "create", "-p",
+	args := append(zfsPath, "create", "-p")
+	if sparse {
+		args = append(args, "-s")
+	}
+	return append(args,
 		"-V", strconv.FormatUint(alignedSize, 10),
 		"-o", "volmode=dev",
 		"-o", fmt.Sprintf("compression=%s", compression),
@@ -142,7 +172,39 @@ func CreateVolumeDataset(log *base.LogObject, dataset string, size uint64, compr
 		"-o", "logbias=throughput",
 		"-o", "redundant_metadata=most",
 		dataset)
+}
+
+//LoadDatasetKey loads the raw key of an encrypted dataset
+//which does not use the key of its parent
+func LoadDatasetKey(dataset string, key []byte) (string, error) {
+	args := append(zfsPath, "load-key", dataset)
+	return execWithKey(vault.ZfsPath, args, key)
+}
+
+//ChangeDatasetKey changes the raw key of an encrypted dataset.
+//zfs keeps the key which encrypts the data and re-wraps it.
+func ChangeDatasetKey(dataset string, key []byte) (string, error) {
+	args := append(zfsPath, "change-key",
+		"-o", "keyformat=raw",
+		"-o", "keylocation=prompt",
+		dataset)
+	return execWithKey(vault.ZfsPath, args, key)
+}
 
+//execWithKey passes the key to the command on stdin
+//instead of the command line or a file
+func execWithKey(command string, args []string, key []byte) (string, error) {
+	cmd := exec.Command(command, args...)
+	cmd.Stdin = bytes.NewReader(key)
+	stdoutStderr, err := cmd.CombinedOutput()
+	return string(stdoutStderr), err
+}
+
+//SetVolumeSize grows zvol to size aligned to the volume block size
+func SetVolumeSize(log *base.LogObject, dataset string, size uint64) (string, error) {
+	alignedSize := alignUpToBlockSize(size)
+	args := append(zfsPath, "set",
+		fmt.Sprintf("volsize=%d", alignedSize), dataset)
 	stdoutStderr, err := base.Exec(log, vault.ZfsPath, args...).CombinedOutput()
 	if err != nil {
 		return string(stdoutStderr), err
@@ -171,6 +233,74 @@ func GetVolumesInDataset(log *base.LogObject, dataset string) ([]string, error)
 	return lines, nil
 }
 
+//CreateSnapshot creates snapshot in form of dataset@name
+func CreateSnapshot(log *base.LogObject, snapshot string) (string, error) {
+	args := append(zfsPath, "snapshot", snapshot)
+	stdoutStderr, err := base.Exec(log, vault.ZfsPath, args...).CombinedOutput()
+	if err != nil {
+		return string(stdoutStderr), err
+	}
+	return string(stdoutStderr), nil
+}
+
+//GetSnapshots returns names of snapshots of dataset, oldest first
+func GetSnapshots(log *base.LogObject, dataset string) ([]string, error) {
+	args := append(zfsPath, "list", "-H", "-d", "1",
+		"-o", "name",
+		"-t", "snapshot",
+		"-s", "createtxg",
+		dataset)
+	stdoutStderr, err := base.Exec(log, vault.ZfsPath, args...).CombinedOutput()
+	if err != nil {
+		return nil, fmt.Errorf("GetSnapshots: output=%s error=%s", stdoutStderr, err)
+	}
+	var snapshots []string
+	sc := bufio.NewScanner(bytes.NewReader(stdoutStderr))
+	for sc.Scan() {
+		line := strings.TrimSpace(sc.Text())
+		if line != "" {
+			snapshots = append(snapshots, line)
+		}
+	}
+	return snapshots, nil
+}
+
+//SendSnapshot writes the send stream of snapshot to w. The stream is
+//incremental from the snapshot from of the same dataset if from is not empty.
+//Streams of large volumes take long, so it has no timeout besides ctx.
+func SendSnapshot(ctx context.Context, snapshot, from string, w io.Writer) error {
+	args := append(zfsPath, "send")
+	if from != "" {
+		args = append(args, "-i", from)
+	}
+	args = append(args, snapshot)
+	cmd := exec.CommandContext(ctx, vault.ZfsPath, args...)
+	var stderr bytes.Buffer
+	cmd.Stdout = w
+	cmd.Stderr = &stderr
+	if err := cmd.Run(); err != nil {
+		return fmt.Errorf("zfs send %s failed: %v: %s", snapshot, err, stderr.String())
+	}
+	return nil
+}
+
+//ReceiveStream receives a send stream from r into dataset as zvol
+//with the same options as CreateVolumeDataset uses
+func ReceiveStream(ctx context.Context, dataset, compression string, r io.Reader) error {
+	args := append(zfsPath, "receive", "-F",
+		"-o", "volmode=dev",
+		"-o", fmt.Sprintf("compression=%s", compression),
+		dataset)
+	cmd := exec.CommandContext(ctx, vault.ZfsPath, args...)
+	var stderr bytes.Buffer
+	cmd.Stdin = r
+	cmd.Stderr = &stderr
+	if err := cmd.Run(); err != nil {
+		return fmt.Errorf("zfs receive %s failed: %v: %s", dataset, err, stderr.String())
+	}
+	return nil
+}
+
 //GetDatasetByDevice returns dataset for provided device path
 func GetDatasetByDevice(device string) string {
 	if !strings.HasPrefix(device, types.ZVolDevicePrefix) {
@@ -257,6 +387,37 @@ func AddVDev(log *base.LogObject, pool, vdev string) (string, error) {
 	return strings.TrimSpace(string(stdoutStderr)), nil
 }
 
+//AddRaidzVDev add raidz vdev with provided parity (1 or 2) built from vdevs to pool
+func AddRaidzVDev(log *base.LogObject, pool string, parity int, vdevs []string) (string, error) {
+	args := append(zpoolPath, "add", "-f", pool, fmt.Sprintf("raidz%d", parity))
+	args = append(args, vdevs...)
+	stdoutStderr, err := base.Exec(log, vault.ZfsPath, args...).CombinedOutput()
+	if err != nil {
+		return string(stdoutStderr), err
+	}
+	return strings.TrimSpace(string(stdoutStderr)), nil
+}
+
+//AddSpareVDev add vdev to pool as a hot spare
+func AddSpareVDev(log *base.LogObject, pool, vdev string) (string, error) {
+	args := append(zpoolPath, "add", "-f", pool, "spare", vdev)
+	stdoutStderr, err := base.Exec(log, vault.ZfsPath, args...).CombinedOutput()
+	if err != nil {
+		return string(stdoutStderr), err
+	}
+	return strings.TrimSpace(string(stdoutStderr)), nil
+}
+
+//ScrubPool starts scrub of the pool
+func ScrubPool(log *base.LogObject, pool string) (string, error) {
+	args := append(zpoolPath, "scrub", pool)
+	stdoutStderr, err := base.Exec(log, vault.ZfsPath, args...).CombinedOutput()
+	if err != nil {
+		return string(stdoutStderr), err
+	}
+	return strings.TrimSpace(string(stdoutStderr)), nil
+}
+
 //ReplaceVDev replaces vdev from the pool
 func ReplaceVDev(log *base.LogObject, pool, oldVdev, newVdev string) (string, error) {
 	args := append(zpoolPath, "replace", pool, oldVdev, newVdev)
@@ -351,7 +512,9 @@ func GetRaidTypeFromStr(raidName string) types.StorageRaidType {
 func GetZpoolRaidType(vdevs libzfs.VDevTree) types.StorageRaidType {
 	vdevsCount := 0
 	for _, vdev := range vdevs.Devices {
-		if vdev.Type == libzfs.VDevTypeMirror || vdev.Type == libzfs.VDevTypeRaidz || vdev.Type == libzfs.VDevTypeDisk {
+		switch vdev.Type {
+		case libzfs.VDevTypeMirror, libzfs.VDevTypeRaidz, libzfs.VDevTypeDisk,
+			libzfs.VDevTypeFile, libzfs.VDevTypeReplacing, libzfs.VDevTypeSpare:
 			vdevsCount++
 		}
 	}
@@ -393,6 +556,13 @@ func GetZfsDeviceStatusFromStr(statusStr string) types.StorageStatus {
 // GetZfsDiskAndStatus takes a libzfs.VDevTree as input and returns
 // *info.StorageDiskState.
 func GetZfsDiskAndStatus(disk libzfs.VDevTree) (*types.StorageDiskState, error) {
+	if disk.Type == libzfs.VDevTypeFile {
+		// file-backed vdev
+		rDiskStatus := new(types.StorageDiskState)
+		rDiskStatus.DiskName = &types.DiskDescription{Name: disk.Name, Serial: "unknown"}
+		rDiskStatus.Status = GetZfsDeviceStatusFromStr(disk.Stat.State.String())
+		return rDiskStatus, nil
+	}
 	if disk.Type != libzfs.VDevTypeDisk {
 		return nil, fmt.Errorf("%s is not a disk", disk.Name)
 	}
@@ -424,3 +594,80 @@ func GetZfsDiskAndStatus(disk libzfs.VDevTree) (*types.StorageDiskState, error)
 	rDiskStatus.Status = GetZfsDeviceStatusFromStr(disk.Stat.State.String())
 	return rDiskStatus, nil
 }
+
+// GetZfsDisksAndStatus takes a libzfs.VDevTree of a disk or of a vdev
+// grouping disks (mirror, raidz, disk being replaced or spare in use)
+// and returns states of all disks in it.
+// Disks which are being resilvered into the vdev (the new disk when replacing
+// and the hot spare) are marked with progress of the resilver.
+func GetZfsDisksAndStatus(vdev libzfs.VDevTree, scan types.StorageScanStatus) []*types.StorageDiskState {
+	var disks []*types.StorageDiskState
+	switch vdev.Type {
+	case libzfs.VDevTypeDisk, libzfs.VDevTypeFile:
+		rDiskStatus, err := GetZfsDiskAndStatus(vdev)
+		if err == nil {
+			disks = append(disks, rDiskStatus)
+		}
+	case libzfs.VDevTypeReplacing, libzfs.VDevTypeSpare:
+		resilvering := scan.Type == types.StorageScanTypeResilver && scan.InProgress()
+		for i, child := range vdev.Devices {
+			childDisks := GetZfsDisksAndStatus(child, scan)
+			// the first one is the original disk
+			if i > 0 && resilvering {
+				for _, disk := range childDisks {
+					disk.Resilvering = true
+					disk.ResilverProgress = scan.Progress
+				}
+			}
+			disks = append(disks, childDisks...)
+		}
+	case libzfs.VDevTypeMirror, libzfs.VDevTypeRaidz:
+		for _, child := range vdev.Devices {
+			disks = append(disks, GetZfsDisksAndStatus(child, scan)...)
+		}
+	}
+	return disks
+}
+
+// GetZfsScanStatus takes a libzfs.PoolScanStat of the pool and returns
+// status of the last or ongoing scrub or resilver
+func GetZfsScanStatus(stat libzfs.PoolScanStat) types.StorageScanStatus {
+	var scan types.StorageScanStatus
+	switch stat.Func {
+	case libzfs.PoolScanScrub:
+		scan.Type = types.StorageScanTypeScrub
+	case libzfs.PoolScanResilver:
+		scan.Type = types.StorageScanTypeResilver
+	default:
+		// never scanned
+		return scan
+	}
+	switch stat.State {
+	case libzfs.DSSScanning:
+		scan.State = types.StorageScanStateScanning
+	case libzfs.DSSFinished:
+		scan.State = types.StorageScanStateFinished
+	case libzfs.DSSCanceled:
+		scan.State = types.StorageScanStateCanceled
+	}
+	if stat.StartTime != 0 {
+		scan.StartTime = time.Unix(int64(stat.StartTime), 0)
+	}
+	if stat.EndTime != 0 && !scan.InProgress() {
+		scan.EndTime = time.Unix(int64(stat.EndTime), 0)
+	}
+	scan.ToExamine = stat.ToExamine
+	scan.Examined = stat.Examined
+	scan.Errors = stat.Errors
+	switch {
+	case scan.State == types.StorageScanStateFinished:
+		scan.Progress = 100
+	case stat.ToExamine > 0:
+		progress := stat.Examined * 100 / stat.ToExamine
+		if progress > 100 {
+			progress = 100
+		}
+		scan.Progress = uint8(progress)
+	}
+	return scan
+}
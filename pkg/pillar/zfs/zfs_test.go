@@ -0,0 +1,110 @@
+// Copyright (c) 2022 Zededa, Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package zfs
+
+import (
+	"testing"
+	"time"
+
+	libzfs "github.com/bicomsystems/go-libzfs"
+	"github.com/lf-edge/eve/pkg/pillar/types"
+	"github.com/stretchr/testify/assert"
+)
+
+func fileVDev(name string, state libzfs.VDevState) libzfs.VDevTree {
+	return libzfs.VDevTree{
+		Type: libzfs.VDevTypeFile,
+		Name: name,
+		Path: name,
+		Stat: libzfs.VDevStat{State: state},
+	}
+}
+
+func TestGetZfsScanStatus(t *testing.T) {
+	start := time.Date(2022, time.May, 1, 10, 0, 0, 0, time.UTC)
+	end := start.Add(time.Hour)
+
+	scan := GetZfsScanStatus(libzfs.PoolScanStat{})
+	assert.Equal(t, types.StorageScanStatus{}, scan)
+
+	scan = GetZfsScanStatus(libzfs.PoolScanStat{
+		Func:      libzfs.PoolScanResilver,
+		State:     libzfs.DSSScanning,
+		StartTime: uint64(start.Unix()),
+		EndTime:   uint64(end.Unix()),
+		ToExamine: 4000,
+		Examined:  1000,
+	})
+	assert.Equal(t, types.StorageScanTypeResilver, scan.Type)
+	assert.True(t, scan.InProgress())
+	assert.True(t, scan.StartTime.Equal(start))
+	assert.True(t, scan.EndTime.IsZero())
+	assert.Equal(t, uint8(25), scan.Progress)
+
+	scan = GetZfsScanStatus(libzfs.PoolScanStat{
+		Func:      libzfs.PoolScanScrub,
+		State:     libzfs.DSSFinished,
+		StartTime: uint64(start.Unix()),
+		EndTime:   uint64(end.Unix()),
+		ToExamine: 4000,
+		Examined:  3900,
+		Errors:    2,
+	})
+	assert.Equal(t, types.StorageScanTypeScrub, scan.Type)
+	assert.Equal(t, types.StorageScanStateFinished, scan.State)
+	assert.True(t, scan.EndTime.Equal(end))
+	assert.Equal(t, uint8(100), scan.Progress)
+	assert.Equal(t, uint64(2), scan.Errors)
+}
+
+func TestGetZfsDisksAndStatus(t *testing.T) {
+	// raidz1 with the third disk being replaced
+	raidz := libzfs.VDevTree{
+		Type: libzfs.VDevTypeRaidz,
+		Name: "raidz1-0",
+		Devices: []libzfs.VDevTree{
+			fileVDev("/var/tmp/vdev1", libzfs.VDevStateHealthy),
+			fileVDev("/var/tmp/vdev2", libzfs.VDevStateHealthy),
+			{
+				Type: libzfs.VDevTypeReplacing,
+				Name: "replacing-2",
+				Devices: []libzfs.VDevTree{
+					fileVDev("/var/tmp/vdev3", libzfs.VDevStateOffline),
+					fileVDev("/var/tmp/vdev4", libzfs.VDevStateHealthy),
+				},
+			},
+		},
+	}
+	scan := types.StorageScanStatus{
+		Type:     types.StorageScanTypeResilver,
+		State:    types.StorageScanStateScanning,
+		Progress: 42,
+	}
+	disks := GetZfsDisksAndStatus(raidz, scan)
+	assert.Len(t, disks, 4)
+	for i, disk := range disks[:3] {
+		assert.False(t, disk.Resilvering, "disk %d", i)
+	}
+	assert.Equal(t, "/var/tmp/vdev3", disks[2].DiskName.Name)
+	assert.Equal(t, types.StorageStatusOffline, disks[2].Status)
+	assert.Equal(t, "/var/tmp/vdev4", disks[3].DiskName.Name)
+	assert.True(t, disks[3].Resilvering)
+	assert.Equal(t, uint8(42), disks[3].ResilverProgress)
+
+	// resilver finished
+	scan.State = types.StorageScanStateFinished
+	disks = GetZfsDisksAndStatus(raidz, scan)
+	assert.Len(t, disks, 4)
+	assert.False(t, disks[3].Resilvering)
+
+	assert.Equal(t, types.StorageRaidTypeRAID5, GetZpoolRaidType(libzfs.VDevTree{
+		Type:    libzfs.VDevTypeRoot,
+		Devices: []libzfs.VDevTree{raidz},
+	}))
+	assert.Equal(t, types.StorageRaidTypeRAID0, GetZpoolRaidType(libzfs.VDevTree{
+		Type: libzfs.VDevTypeRoot,
+		Devices: []libzfs.VDevTree{raidz,
+			fileVDev("/var/tmp/vdev5", libzfs.VDevStateHealthy)},
+	}))
+}
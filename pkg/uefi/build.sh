@@ -15,7 +15,14 @@ case $(uname -m) in
              ;;
     aarch64) build -b RELEASE -t GCC5 -a AARCH64 -p ArmVirtPkg/ArmVirtQemu.dsc
              cp Build/ArmVirtQemu-AARCH64/RELEASE_GCC5/FV/QEMU_EFI.fd OVMF.fd
+             cp Build/ArmVirtQemu-AARCH64/RELEASE_GCC5/FV/QEMU_EFI.fd OVMF_CODE.fd
              cp Build/ArmVirtQemu-AARCH64/RELEASE_GCC5/FV/QEMU_VARS.fd OVMF_VARS.fd
+             # and the one with Secure Boot
+             build -b RELEASE -t GCC5 -a AARCH64 -p ArmVirtPkg/ArmVirtQemu.dsc -D SECURE_BOOT_ENABLE=TRUE
+             cp Build/ArmVirtQemu-AARCH64/RELEASE_GCC5/FV/QEMU_EFI.fd OVMF_CODE_SB.fd
+             cp Build/ArmVirtQemu-AARCH64/RELEASE_GCC5/FV/QEMU_VARS.fd OVMF_VARS_SB.fd
+             # the flash devices of the virt machine are 64MiB
+             truncate -s 64M OVMF_CODE.fd OVMF_CODE_SB.fd
              # now let's build PVH UEFI kernel
              make -C BaseTools/Source/C
              build -b RELEASE -t GCC5 -a AARCH64  -p ArmVirtPkg/ArmVirtXen.dsc
@@ -23,6 +30,10 @@ case $(uname -m) in
              ;;
      x86_64) build -b RELEASE -t GCC5 -a X64 -p OvmfPkg/OvmfPkgX64.dsc
              cp Build/OvmfX64/RELEASE_*/FV/OVMF*.fd .
+             # Secure Boot needs SMM to protect the variables
+             build -b RELEASE -t GCC5 -a IA32 -a X64 -p OvmfPkg/OvmfPkgIa32X64.dsc -D SECURE_BOOT_ENABLE=TRUE -D SMM_REQUIRE=TRUE
+             cp Build/Ovmf3264/RELEASE_*/FV/OVMF_CODE.fd OVMF_CODE_SB.fd
+             cp Build/Ovmf3264/RELEASE_*/FV/OVMF_VARS.fd OVMF_VARS_SB.fd
              build -b RELEASE -t GCC5 -a X64 -p OvmfPkg/OvmfXen.dsc
              BaseTools/Source/C/bin/EfiRom -f 0x1F96 -i 0x0778 -e Build/OvmfX64/RELEASE_*/X64/IgdAssignmentDxe.efi
              cp Build/OvmfX64/RELEASE_*/X64/IgdAssignmentDxe.rom IgdAssignmentDxe.rom
@@ -91,6 +91,12 @@ mbus_publish() {
   cat > "$BBS/${1}.json"
 }
 
+# new_connection_id marks a (re)started connection, whose packet counters
+# start from zero
+new_connection_id() {
+  cat /proc/sys/kernel/random/uuid | mbus_publish "connection_id_$IFACE"
+}
+
 # parse value of an attribute returned by mbimcli or qmicli
 parse_modem_attr() {
   local STDOUT="$1"
@@ -251,6 +257,26 @@ probe() {
   fi
 }
 
+get_current_sim_slot() {
+  local CURRENT_SLOT="$("${PROTOCOL}_get_sim_slot")"
+  echo "${CURRENT_SLOT:-0}"
+}
+
+select_sim_slot() {
+  if [ -z "$SIM_SLOT" ]; then
+    return 0
+  fi
+  local CURRENT_SLOT="$("${PROTOCOL}_get_sim_slot")"
+  if [ "$CURRENT_SLOT" = "$SIM_SLOT" ]; then
+    return 0
+  fi
+  echo "[$CDC_DEV] Switching from SIM slot ${CURRENT_SLOT:-unknown} to SIM slot ${SIM_SLOT}"
+  if ! "${PROTOCOL}_switch_sim_slot" "$SIM_SLOT"; then
+    echo "Failed to switch to SIM slot ${SIM_SLOT}" >&2
+    return 1
+  fi
+}
+
 collect_network_status() {
   local QUICK="$1"
   local PROVIDERS="[]"
@@ -272,6 +298,7 @@ collect_network_status() {
     "$(json_attr     physical-addrs   "$ADDRS")" \
     "$(json_attr     cellular-module  "$MODULE")" \
     "$(json_attr     sim-cards        "$("${PROTOCOL}_get_sim_cards")")" \
+    "$(json_attr     current-sim-slot "$(get_current_sim_slot)")" \
     "$(json_str_attr config-error     "$CONFIG_ERROR")" \
     "$(json_str_attr probe-error      "$PROBE_ERROR")" \
     "$(json_attr     providers        "$PROVIDERS")")"
@@ -283,6 +310,7 @@ collect_network_metrics() {
     "$(json_str_attr logical-label  "$LOGICAL_LABEL")" \
     "$(json_attr     physical-addrs "$ADDRS")" \
     "$(json_attr     packet-stats   "$("${PROTOCOL}_get_packet_stats")")" \
+    "$(json_str_attr connection-id  "$(cat "${BBS}/connection_id_${IFACE}.json" 2>/dev/null)")" \
     "$(json_attr     signal-info    "$("${PROTOCOL}_get_signal_info")")")"
   METRICS="${METRICS}${NETWORK_METRICS}\n"
 }
@@ -394,6 +422,7 @@ event_stream | while read -r EVENT; do
     PROBE_ADDR="${PROBE_ADDR:-$DEFAULT_PROBE_ADDR}"
     APN="$(parse_json_attr "$NETWORK" "apns[0]")" # FIXME XXX limited to a single APN for now
     APN="${APN:-$DEFAULT_APN}"
+    SIM_SLOT="$(parse_json_attr "$NETWORK" "\"sim-slot\"")"
     LOC_TRACKING="$(parse_json_attr "$NETWORK" "\"location-tracking\"")"
 
     if ! lookup_modem "${IFACE}" "${USB_ADDR}" "${PCI_ADDR}" 2>/tmp/wwan.stderr; then
@@ -437,10 +466,12 @@ event_stream | while read -r EVENT; do
         echo "[$CDC_DEV] Restarting connection (APN=${APN}, interface=${IFACE})"
         {
           "${PROTOCOL}_stop_network"      &&\
+          select_sim_slot                 &&\
           "${PROTOCOL}_toggle_rf" on      &&\
           "${PROTOCOL}_wait_for_sim"      &&\
           "${PROTOCOL}_wait_for_register" &&\
           "${PROTOCOL}_start_network"     &&\
+          new_connection_id               &&\
           "${PROTOCOL}_wait_for_wds"      &&\
           "${PROTOCOL}_wait_for_settings" &&\
           bringup_iface                   &&\
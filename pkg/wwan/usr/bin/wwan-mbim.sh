@@ -117,10 +117,24 @@ mbim_get_sim_cards() {
   # Remove trailing Fs that modem may add as a padding.
   ICCID="$(echo "$ICCID" | tr -d "F")"
   local IMSI=$(parse_modem_attr "$SUBSCRIBER" "Subscriber ID")
-  SIM="$(json_struct "$(json_str_attr "iccid" "$ICCID")" "$(json_str_attr "imsi" "$IMSI")")\n"
+  local SLOT="$(mbim_get_sim_slot)"
+  SIM="$(json_struct "$(json_str_attr "iccid" "$ICCID")" "$(json_str_attr "imsi" "$IMSI")" \
+    "$(json_attr "slot-number" "${SLOT:-0}")")\n"
   printf "%b" "$SIM" | json_array
 }
 
+mbim_get_sim_slot() {
+  # Prints number of the slot mapped to the (only) executor, starting with 1
+  # (nothing if not supported by the modem).
+  mbim --ms-query-device-slot-mappings 2>/dev/null | awk '
+    /Executor 0: slot [0-9]+/ {print $4 + 1; exit}'
+}
+
+mbim_switch_sim_slot() {
+  # MBIM slot indexes start with 0.
+  mbim --ms-set-device-slot-mappings="$(($1 - 1))" >/dev/null
+}
+
 mbim_get_ip_settings() {
   if ! SETTINGS="$(mbim --query-ip-configuration)"; then
     return 1
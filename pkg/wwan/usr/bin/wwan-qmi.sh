@@ -208,10 +208,24 @@ qmi_get_sim_cards() {
     echo "[]"
     return 1
   fi
-  SIM="$(json_struct "$(json_str_attr "iccid" "$ICCID")" "$(json_str_attr "imsi" "$IMSI")")\n"
+  local SLOT="$(qmi_get_sim_slot)"
+  SIM="$(json_struct "$(json_str_attr "iccid" "$ICCID")" "$(json_str_attr "imsi" "$IMSI")" \
+    "$(json_attr "slot-number" "${SLOT:-0}")")\n"
   printf "%b" "$SIM" | json_array
 }
 
+qmi_get_sim_slot() {
+  # Prints number of the active physical slot (nothing if not supported by the modem).
+  qmi --uim-get-slot-status 2>/dev/null | awk '
+    /Physical slot [0-9]+:/ {slot=$3; sub(":", "", slot)}
+    /Slot status: active/ {print slot; exit}'
+}
+
+qmi_switch_sim_slot() {
+  # Logical slot 1 is the one used by the modem for data connections.
+  qmi --uim-switch-slot="$1" >/dev/null
+}
+
 qmi_get_ip_settings() {
   if ! SETTINGS="$(qmi --wds-get-current-settings)"; then
     return 1
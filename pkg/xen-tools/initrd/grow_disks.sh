@@ -0,0 +1,35 @@
+#!/bin/sh
+
+# EVE grows volumes of running apps online, so we watch the sizes of the
+# block devices mounted by /mount_disk.sh and grow their file systems
+
+sizes=""
+while true; do
+  newSizes=""
+  for disk in $(find /sys/block/ -maxdepth 1 -regex '.*[sv]d.*' -exec basename '{}' ';'); do
+    size=$(cat "/sys/block/$disk/size")
+    newSizes="$newSizes $disk:$size"
+    case "$sizes" in
+      *" $disk:$size"*|"")
+        ;;
+      *)
+        fsType=$(awk -v dev="/dev/$disk" '$1 == dev {print $3; exit}' /proc/mounts)
+        if [ "$(cat "/sys/block/$disk/ro")" -eq 0 ] && [ -n "$fsType" ]; then
+          # Like /mount_disk.sh, only grow the file systems we have the
+          # tools for in the initrd
+          case "$fsType" in
+            ext*)
+              echo "Growing file system on /dev/$disk"
+              resize2fs "/dev/$disk" || echo "Failed to grow file system on /dev/$disk"
+              ;;
+            *)
+              echo "Not growing $fsType file system on /dev/$disk"
+              ;;
+          esac
+        fi
+        ;;
+    esac
+  done
+  sizes="$newSizes"
+  sleep 10
+done
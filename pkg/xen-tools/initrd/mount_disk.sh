@@ -15,19 +15,31 @@ find /sys/block/ -maxdepth 1 -regex '.*[sv]d.*' -exec basename '{}' ';'| sort |
 
   #Checking and creating a file system inside the partition
   fileSystem="ext2"
-  existingFileSystem="$(eval "$(blkid "/dev/$disk" | awk ' { print $3 } ')"; echo "$TYPE")"
+  existingFileSystem="$(blkid "/dev/$disk" | sed -n 's/.* TYPE="\([^"]*\)".*/\1/p')"
   if [ "$existingFileSystem" = "" ]; then
     echo "Creating $fileSystem file system on /dev/$disk"
     mke2fs -t $fileSystem "/dev/$disk" && \
     echo "Successfully created $fileSystem file system on /dev/$disk" || \
     echo "Failed to create $fileSystem file system on /dev/$disk"
     echo
+    existingFileSystem=$fileSystem
   fi
 
   #Mounting the partition onto a target directory
   diskAccess=$(cat "/sys/block/$disk/ro")
   if [ "$diskAccess" -eq 0 ]; then
     accessRight=rw
+    #Growing the file system if the volume was grown while we were down,
+    #e2fsck returns 1 if it corrected errors. Volumes formatted by EVE
+    #with other file systems are not grown.
+    case "$existingFileSystem" in
+    ext*)
+      e2fsck -f -p "/dev/$disk"
+      if [ $? -le 1 ]; then
+        resize2fs "/dev/$disk" || echo "Failed to grow file system on /dev/$disk"
+      fi
+      ;;
+    esac
   else
     accessRight=ro
   fi